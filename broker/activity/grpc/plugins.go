@@ -37,8 +37,10 @@ import (
 	"github.com/pydio/cells/common/micro"
 	"github.com/pydio/cells/common/plugins"
 	proto "github.com/pydio/cells/common/proto/activity"
+	"github.com/pydio/cells/common/proto/chat"
 	"github.com/pydio/cells/common/proto/idm"
 	"github.com/pydio/cells/common/proto/jobs"
+	"github.com/pydio/cells/common/proto/rest"
 	"github.com/pydio/cells/common/proto/tree"
 	"github.com/pydio/cells/common/service"
 	"github.com/pydio/cells/common/service/context"
@@ -97,6 +99,18 @@ func init() {
 					return err
 				}
 
+				if err := s.Subscribe(s.NewSubscriber(common.TOPIC_CHAT_EVENT, func(ctx context.Context, msg *chat.ChatEvent) error {
+					return subscriber.HandleChatEvent(ctx, msg)
+				})); err != nil {
+					return err
+				}
+
+				if err := s.Subscribe(s.NewSubscriber(common.TOPIC_FILE_REQUEST, func(ctx context.Context, msg *rest.FileRequestDrop) error {
+					return subscriber.HandleFileRequestDrop(ctx, msg)
+				})); err != nil {
+					return err
+				}
+
 				proto.RegisterActivityServiceHandler(m.Options().Server, new(Handler))
 				tree.RegisterNodeProviderStreamerHandler(m.Options().Server, new(MetaProvider))
 
@@ -22,6 +22,7 @@ package grpc
 
 import (
 	"context"
+	"encoding/json"
 	"path"
 	"strings"
 	"sync"
@@ -39,7 +40,10 @@ import (
 	"github.com/pydio/cells/common"
 	"github.com/pydio/cells/common/log"
 	activity2 "github.com/pydio/cells/common/proto/activity"
+	"github.com/pydio/cells/common/proto/chat"
+	"github.com/pydio/cells/common/proto/docstore"
 	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/rest"
 	"github.com/pydio/cells/common/proto/tree"
 	"github.com/pydio/cells/common/registry"
 	"github.com/pydio/cells/common/service/context"
@@ -51,6 +55,7 @@ import (
 type MicroEventsSubscriber struct {
 	sync.Mutex
 	treeClient tree.NodeProviderClient
+	chatClient chat.ChatServiceClient
 	usrClient  idm.UserServiceClient
 	roleClient idm.RoleServiceClient
 	wsClient   idm.WorkspaceServiceClient
@@ -87,6 +92,13 @@ func (e *MicroEventsSubscriber) getTreeClient() tree.NodeProviderClient {
 	return e.treeClient
 }
 
+func (e *MicroEventsSubscriber) getChatClient() chat.ChatServiceClient {
+	if e.chatClient == nil {
+		e.chatClient = chat.NewChatServiceClient(registry.GetClient(common.SERVICE_CHAT))
+	}
+	return e.chatClient
+}
+
 func (e *MicroEventsSubscriber) getUserClient() idm.UserServiceClient {
 	if e.usrClient == nil {
 		e.usrClient = idm.NewUserServiceClient(registry.GetClient(common.SERVICE_USER))
@@ -211,6 +223,142 @@ func (e *MicroEventsSubscriber) HandleIdmChange(ctx context.Context, msg *idm.Ch
 	return nil
 }
 
+// HandleChatEvent posts a Mention activity in the inbox of each user mentioned in a new chat message, if this
+// user can access the room of the message.
+func (e *MicroEventsSubscriber) HandleChatEvent(ctx context.Context, msg *chat.ChatEvent) error {
+
+	if msg.Message == nil || msg.Details != "" || len(msg.Message.Mentions) == 0 {
+		return nil
+	}
+	ctx = servicecontext.WithServiceName(ctx, Name)
+	ctx = servicecontext.WithServiceColor(ctx, servicecontext.ServiceColorGrpc)
+
+	var room *chat.ChatRoom
+	for t := range chat.RoomType_name {
+		stream, err := e.getChatClient().ListRooms(ctx, &chat.ListRoomsRequest{
+			ByType: chat.RoomType(t),
+			Uuids:  []string{msg.Message.RoomUuid},
+		})
+		if err != nil {
+			return err
+		}
+		for {
+			resp, er := stream.Recv()
+			if er != nil {
+				break
+			}
+			if resp != nil && resp.Room != nil {
+				room = resp.Room
+			}
+		}
+		stream.Close()
+		if room != nil {
+			break
+		}
+	}
+	if room == nil {
+		log.Logger(ctx).Debug("Ignoring mentions, cannot find chat room", zap.String("room", msg.Message.RoomUuid))
+		return nil
+	}
+
+	author := msg.Message.Author
+	ac := activity.MentionActivity(author, msg.Message, room)
+	for _, login := range msg.Message.Mentions {
+		if login == author {
+			continue
+		}
+		if !e.canAccessRoom(ctx, room, login) {
+			log.Logger(ctx).Debug("Ignoring mention of a user that cannot access the room", zap.String(common.KEY_USERNAME, login))
+			continue
+		}
+		log.Logger(ctx).Debug("Posting mention activity", zap.String(common.KEY_USERNAME, login))
+		e.dao.PostActivity(activity2.OwnerType_USER, login, activity.BoxInbox, ac)
+		publishActivityEvent(ctx, activity2.OwnerType_USER, login, activity.BoxInbox, ac)
+	}
+
+	return nil
+}
+
+// canAccessRoom checks that a user can access a chat room: by being one of the participants of user rooms,
+// or through its ACLs for workspace and node rooms.
+func (e *MicroEventsSubscriber) canAccessRoom(ctx context.Context, room *chat.ChatRoom, login string) bool {
+
+	if room.Type == chat.RoomType_USER {
+		if room.RoomTypeObject == login {
+			return true
+		}
+		for _, u := range room.Users {
+			if u == login {
+				return true
+			}
+		}
+		return false
+	}
+	accessList, _, err := permissions.AccessListFromUser(ctx, login, false)
+	if err != nil {
+		return false
+	}
+	switch room.Type {
+	case chat.RoomType_WORKSPACE:
+		_, ok := accessList.Workspaces[room.RoomTypeObject]
+		return ok
+	case chat.RoomType_NODE:
+		resp, err := e.getTreeClient().ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Uuid: room.RoomTypeObject}})
+		if err != nil || resp.Node == nil {
+			return false
+		}
+		parents, err := tree.BuildAncestorsList(ctx, e.getTreeClient(), resp.Node)
+		if err != nil {
+			return false
+		}
+		return accessList.CanRead(ctx, append([]*tree.Node{resp.Node}, parents...)...)
+	}
+	return true
+}
+
+// HandleFileRequestDrop posts an activity in the inbox of the owner of a file request link, when an
+// uploader has completed a drop session.
+func (e *MicroEventsSubscriber) HandleFileRequestDrop(ctx context.Context, drop *rest.FileRequestDrop) error {
+
+	if drop.CompletedAt == 0 || drop.FilesCount == 0 {
+		return nil
+	}
+	ctx = servicecontext.WithServiceName(ctx, Name)
+	ctx = servicecontext.WithServiceColor(ctx, servicecontext.ServiceColorGrpc)
+
+	store := docstore.NewDocStoreClient(registry.GetClient(common.SERVICE_DOCSTORE))
+	stream, er := store.ListDocuments(ctx, &docstore.ListDocumentsRequest{StoreID: common.DOCSTORE_ID_SHARES, Query: &docstore.DocumentQuery{
+		MetaQuery: "+REPOSITORY:\"" + drop.LinkUuid + "\" +SHARE_TYPE:minisite",
+	}})
+	if er != nil {
+		return er
+	}
+	defer stream.Close()
+	var owner string
+	for {
+		resp, er := stream.Recv()
+		if er != nil {
+			break
+		}
+		var linkData *docstore.ShareDocument
+		if resp.Document != nil && json.Unmarshal([]byte(resp.Document.Data), &linkData) == nil {
+			owner = linkData.OwnerId
+		}
+		break
+	}
+	if owner == "" {
+		return nil
+	}
+
+	ac := activity.FileRequestActivity(drop)
+	log.Logger(ctx).Debug("Posting file request activity", zap.String(common.KEY_USERNAME, owner))
+	e.dao.PostActivity(activity2.OwnerType_USER, owner, activity.BoxInbox, ac)
+	publishActivityEvent(ctx, activity2.OwnerType_USER, owner, activity.BoxInbox, ac)
+	e.dao.PostActivity(activity2.OwnerType_NODE, drop.FolderUuid, activity.BoxOutbox, ac)
+
+	return nil
+}
+
 func (e *MicroEventsSubscriber) ParentsFromCache(ctx context.Context, node *tree.Node, isDel bool) []string {
 
 	e.Lock()
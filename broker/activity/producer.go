@@ -26,7 +26,9 @@ import (
 	"github.com/golang/protobuf/ptypes/timestamp"
 
 	"github.com/pydio/cells/common/proto/activity"
+	"github.com/pydio/cells/common/proto/chat"
 	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/rest"
 	"github.com/pydio/cells/common/proto/tree"
 )
 
@@ -53,6 +55,59 @@ func AclActivity(author string, workspace *idm.Workspace, permission string) (ac
 	return
 }
 
+func MentionActivity(author string, message *chat.ChatMessage, room *chat.ChatRoom) (ac *activity.Object) {
+	ac = createObject()
+	ac.Type = activity.ObjectType_Mention
+	ac.Object = &activity.Object{
+		Type:    activity.ObjectType_Note,
+		Id:      message.Uuid,
+		Summary: message.Message,
+	}
+	if room != nil && room.Type == chat.RoomType_NODE {
+		ac.Target = &activity.Object{
+			Type: activity.ObjectType_Document,
+			Id:   room.RoomTypeObject,
+			Name: room.RoomLabel,
+		}
+	}
+	ac.Actor = &activity.Object{
+		Type: activity.ObjectType_Person,
+		Name: author,
+		Id:   author,
+	}
+	ac.Updated = &timestamp.Timestamp{
+		Seconds: time.Now().Unix(),
+	}
+	return
+}
+
+// FileRequestActivity builds the activity of an uploader who dropped files on a file request link.
+func FileRequestActivity(drop *rest.FileRequestDrop) (ac *activity.Object) {
+	ac = createObject()
+	ac.Type = activity.ObjectType_Add
+	ac.Name = "File Request"
+	uploader := drop.UploaderName
+	if uploader == "" {
+		uploader = drop.UploaderEmail
+	} else if drop.UploaderEmail != "" {
+		uploader += " (" + drop.UploaderEmail + ")"
+	}
+	ac.Actor = &activity.Object{
+		Type: activity.ObjectType_Person,
+		Name: uploader,
+	}
+	ac.Object = &activity.Object{
+		Type:       activity.ObjectType_Folder,
+		Id:         drop.FolderUuid,
+		Name:       drop.FolderPath,
+		TotalItems: drop.FilesCount,
+	}
+	ac.Updated = &timestamp.Timestamp{
+		Seconds: time.Now().Unix(),
+	}
+	return
+}
+
 func DocumentActivity(author string, event *tree.NodeChangeEvent) (ac *activity.Object, detectedNode *tree.Node) {
 
 	ac = createObject()
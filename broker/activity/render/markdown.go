@@ -174,6 +174,23 @@ func Markdown(object *activity.Object, pointOfView activity.SummaryPointOfView,
 			return T("SharedWsWithYou", templateData)
 		}
 
+	case activity.ObjectType_Mention:
+		if object.Target != nil {
+			templateData["Target"] = Markdown(object.Target, pointOfView, language, links...)
+			return T("MentionedYouIn", templateData)
+		}
+		return T("MentionedYou", templateData)
+
+	case activity.ObjectType_Add:
+		if object.Object != nil {
+			templateData["Count"] = object.Object.TotalItems
+		}
+		return T("DroppedFiles", templateData)
+
+	case activity.ObjectType_Note:
+
+		return "\"" + html.EscapeString(object.Summary) + "\""
+
 	case activity.ObjectType_Folder:
 
 		var docIdentifier string
@@ -208,7 +225,10 @@ func Markdown(object *activity.Object, pointOfView activity.SummaryPointOfView,
 
 		var userIdentifier string
 		uName := html.EscapeString(object.Name)
-		if link := sLinks.objectURL(ServerUrlTypeUsers, object.Id); link != "" {
+		if object.Id == "" {
+			// External person, without user page
+			userIdentifier = uName
+		} else if link := sLinks.objectURL(ServerUrlTypeUsers, object.Id); link != "" {
 			userIdentifier = makeMarkdownLink(link, uName)
 		} else {
 			userIdentifier = path.Base(uName)
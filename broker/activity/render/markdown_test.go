@@ -201,4 +201,25 @@ func TestMarkdown(t *testing.T) {
 
 	})
 
+	Convey("Test file request drop rendering", t, func() {
+
+		drop := &activity.Object{
+			Type:  activity.ObjectType_Add,
+			Actor: &activity.Object{Type: activity.ObjectType_Person, Name: "Jane (jane@example.com)"},
+			Object: &activity.Object{
+				Type:       activity.ObjectType_Folder,
+				Id:         "folder1",
+				Name:       "path/to/requests/Jane (jane@example.com)",
+				TotalItems: 3,
+			},
+		}
+		serverLinks := NewServerLinks()
+		serverLinks.URLS[ServerUrlTypeDocs], _ = url.Parse("doc://")
+		serverLinks.URLS[ServerUrlTypeUsers], _ = url.Parse("user://")
+
+		md := Markdown(drop, activity.SummaryPointOfView_GENERIC, "", serverLinks)
+		So(md, ShouldEqual, "Jane (jane@example.com) dropped 3 file(s) in Folder [Jane (jane@example.com)](doc://folder1)")
+
+	})
+
 }
@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package actions
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/micro/go-micro/client"
+	"github.com/micro/go-micro/errors"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/proto/chat"
+	"github.com/pydio/cells/common/proto/jobs"
+	"github.com/pydio/cells/scheduler/actions"
+)
+
+const (
+	retentionActionName = "broker.chat.actions.retention"
+)
+
+// RetentionAction deletes chat messages older than a given number of days. Parameters are
+// keyed by room type (GLOBAL, WORKSPACE, USER, NODE), 0 or empty meaning messages are kept forever.
+type RetentionAction struct {
+	chatClient chat.ChatServiceClient
+	retentions map[chat.RoomType]int64
+}
+
+// GetName returns the Unique Identifier of the RetentionAction.
+func (r *RetentionAction) GetName() string {
+	return retentionActionName
+}
+
+// Init passes parameters to a newly created instance.
+func (r *RetentionAction) Init(job *jobs.Job, cl client.Client, action *jobs.Action) error {
+	r.retentions = make(map[chat.RoomType]int64)
+	for name, value := range chat.RoomType_value {
+		param, ok := action.Parameters[name]
+		if !ok || param == "" {
+			continue
+		}
+		days, e := strconv.ParseInt(param, 10, 64)
+		if e != nil || days < 0 {
+			return errors.BadRequest(retentionActionName, "invalid retention for %s: %s", name, param)
+		}
+		if days > 0 {
+			r.retentions[chat.RoomType(value)] = days
+		}
+	}
+	r.chatClient = chat.NewChatServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_CHAT, cl)
+	return nil
+}
+
+// Run purges messages for each room type that has a retention policy.
+func (r *RetentionAction) Run(ctx context.Context, channels *actions.RunnableChannels, input jobs.ActionMessage) (jobs.ActionMessage, error) {
+
+	if len(r.retentions) == 0 {
+		log.TasksLogger(ctx).Info("No retention policy defined for chat rooms")
+		return input.WithIgnore(), nil
+	}
+	for roomType, days := range r.retentions {
+		olderThan := time.Now().Add(-time.Duration(days) * 24 * time.Hour).Unix()
+		resp, e := r.chatClient.PurgeMessages(ctx, &chat.PurgeMessagesRequest{RoomType: roomType, OlderThan: olderThan})
+		if e != nil {
+			return input.WithError(e), e
+		}
+		log.TasksLogger(ctx).Info(fmt.Sprintf("Deleted %d messages older than %d days in %s rooms", resp.Count, days, roomType.String()))
+	}
+
+	return input, nil
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package actions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/micro/go-micro/client"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common/proto/chat"
+	"github.com/pydio/cells/common/proto/jobs"
+	"github.com/pydio/cells/scheduler/actions"
+)
+
+type purgeClientMock struct {
+	chat.ChatServiceClient
+	requests []*chat.PurgeMessagesRequest
+}
+
+func (m *purgeClientMock) PurgeMessages(ctx context.Context, in *chat.PurgeMessagesRequest, opts ...client.CallOption) (*chat.PurgeMessagesResponse, error) {
+	m.requests = append(m.requests, in)
+	return &chat.PurgeMessagesResponse{Count: 1}, nil
+}
+
+func TestRetentionAction_GetName(t *testing.T) {
+	Convey("Test GetName", t, func() {
+		action := &RetentionAction{}
+		So(action.GetName(), ShouldEqual, retentionActionName)
+	})
+}
+
+func TestRetentionAction_Init(t *testing.T) {
+
+	Convey("Test parameters parsing", t, func() {
+		action := &RetentionAction{}
+		err := action.Init(&jobs.Job{}, nil, &jobs.Action{Parameters: map[string]string{
+			"WORKSPACE": "30",
+			"NODE":      "0",
+			"USER":      "",
+		}})
+		So(err, ShouldBeNil)
+		So(action.chatClient, ShouldNotBeNil)
+		So(action.retentions, ShouldHaveLength, 1)
+		So(action.retentions[chat.RoomType_WORKSPACE], ShouldEqual, 30)
+
+		So(action.Init(&jobs.Job{}, nil, &jobs.Action{Parameters: map[string]string{"GLOBAL": "-1"}}), ShouldNotBeNil)
+		So(action.Init(&jobs.Job{}, nil, &jobs.Action{Parameters: map[string]string{"GLOBAL": "forever"}}), ShouldNotBeNil)
+	})
+
+}
+
+func TestRetentionAction_Run(t *testing.T) {
+
+	Convey("Test purge requests", t, func() {
+		action := &RetentionAction{}
+		So(action.Init(&jobs.Job{}, nil, &jobs.Action{Parameters: map[string]string{"NODE": "10"}}), ShouldBeNil)
+		mock := &purgeClientMock{}
+		action.chatClient = mock
+
+		output, err := action.Run(context.Background(), &actions.RunnableChannels{}, jobs.ActionMessage{})
+		So(err, ShouldBeNil)
+		So(output.GetLastOutput(), ShouldBeNil)
+		So(mock.requests, ShouldHaveLength, 1)
+		So(mock.requests[0].RoomType, ShouldEqual, chat.RoomType_NODE)
+		expected := time.Now().Add(-10 * 24 * time.Hour).Unix()
+		So(mock.requests[0].OlderThan, ShouldAlmostEqual, expected, 5)
+	})
+
+	Convey("Test no retention policy", t, func() {
+		action := &RetentionAction{}
+		So(action.Init(&jobs.Job{}, nil, &jobs.Action{}), ShouldBeNil)
+		mock := &purgeClientMock{}
+		action.chatClient = mock
+
+		output, err := action.Run(context.Background(), &actions.RunnableChannels{}, jobs.ActionMessage{})
+		So(err, ShouldBeNil)
+		So(output.GetLastOutput().Ignored, ShouldBeTrue)
+		So(mock.requests, ShouldBeEmpty)
+	})
+
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package chat
+
+import (
+	"context"
+	"mime"
+	"path"
+
+	"github.com/pydio/cells/common/proto/chat"
+	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/views"
+)
+
+// ResolveAttachments loads the nodes referenced by the message attachments in the current user context.
+// Attachments pointing to nodes that cannot be read by the user are dropped, others are filled
+// with up-to-date node information.
+func ResolveAttachments(ctx context.Context, router views.Handler, message *chat.ChatMessage) {
+
+	var attachments []*chat.ChatAttachment
+	for _, a := range message.Attachments {
+		if a.NodeUuid == "" {
+			continue
+		}
+		resp, e := router.ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Uuid: a.NodeUuid}})
+		if e != nil || resp.Node == nil {
+			continue
+		}
+		node := resp.Node
+		a.Path = node.Path
+		a.Size = node.Size
+		a.IsLeaf = node.IsLeaf()
+		if a.Label == "" {
+			a.Label = path.Base(node.Path)
+		}
+		if a.IsLeaf && a.MimeType == "" {
+			a.MimeType = mime.TypeByExtension(path.Ext(node.Path))
+		}
+		attachments = append(attachments, a)
+	}
+	message.Attachments = attachments
+
+}
@@ -0,0 +1,159 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package chat
+
+import (
+	"encoding/json"
+
+	"github.com/blevesearch/bleve"
+	_ "github.com/blevesearch/bleve/analysis/analyzer/keyword"
+	"github.com/blevesearch/bleve/index/scorch"
+	"github.com/blevesearch/bleve/index/store/boltdb"
+
+	"github.com/pydio/cells/common/proto/chat"
+)
+
+const messageMappingName = "message"
+
+// Indexer maintains a full-text index of chat messages.
+type Indexer struct {
+	Index bleve.Index
+}
+
+// IndexableMessage is the document stored in the bleve index for each message.
+type IndexableMessage struct {
+	RoomUuid   string
+	ParentUuid string
+	Author     string
+	Message    string
+	Timestamp  int64
+	// Json holds the original message, it is stored but not indexed
+	Json string
+}
+
+// BleveType returns the mapping name for messages.
+func (i *IndexableMessage) BleveType() string {
+	return messageMappingName
+}
+
+// NewIndexer opens or creates a bleve index at the given path. If the path is empty, index is kept in memory.
+func NewIndexer(bleveIndexPath string) (*Indexer, error) {
+
+	index, err := bleve.Open(bleveIndexPath)
+	if err != nil {
+		indexMapping := bleve.NewIndexMapping()
+		msgMapping := bleve.NewDocumentMapping()
+		indexMapping.AddDocumentMapping(messageMappingName, msgMapping)
+
+		for _, keyword := range []string{"RoomUuid", "ParentUuid", "Author"} {
+			fieldMapping := bleve.NewTextFieldMapping()
+			fieldMapping.Analyzer = "keyword"
+			fieldMapping.IncludeInAll = false
+			msgMapping.AddFieldMappingsAt(keyword, fieldMapping)
+		}
+		jsonMapping := bleve.NewTextFieldMapping()
+		jsonMapping.Index = false
+		jsonMapping.IncludeInAll = false
+		msgMapping.AddFieldMappingsAt("Json", jsonMapping)
+
+		if bleveIndexPath == "" {
+			index, err = bleve.NewMemOnly(indexMapping)
+		} else {
+			index, err = bleve.NewUsing(bleveIndexPath, indexMapping, scorch.Name, boltdb.Name, nil)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &Indexer{Index: index}, nil
+}
+
+// IndexMessage adds or replaces a message in the index.
+func (i *Indexer) IndexMessage(msg *chat.ChatMessage) error {
+	serial, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return i.Index.Index(msg.Uuid, &IndexableMessage{
+		RoomUuid:   msg.RoomUuid,
+		ParentUuid: msg.ParentUuid,
+		Author:     msg.Author,
+		Message:    msg.Message,
+		Timestamp:  msg.Timestamp,
+		Json:       string(serial),
+	})
+}
+
+// DeleteMessages removes messages from the index.
+func (i *Indexer) DeleteMessages(msgs ...*chat.ChatMessage) error {
+	batch := i.Index.NewBatch()
+	for _, m := range msgs {
+		batch.Delete(m.Uuid)
+	}
+	return i.Index.Batch(batch)
+}
+
+// SearchMessages performs a query string search, restricted to the given rooms if any. Results are sorted
+// by relevance, then by descending timestamp.
+func (i *Indexer) SearchMessages(queryString string, roomUuids []string, offset, limit int32) ([]*chat.ChatMessage, int32, error) {
+
+	if limit <= 0 {
+		limit = 20
+	}
+	boolean := bleve.NewBooleanQuery()
+	boolean.AddMust(bleve.NewQueryStringQuery(queryString))
+	if len(roomUuids) > 0 {
+		rooms := bleve.NewBooleanQuery()
+		for _, r := range roomUuids {
+			term := bleve.NewTermQuery(r)
+			term.SetField("RoomUuid")
+			rooms.AddShould(term)
+		}
+		boolean.AddMust(rooms)
+	}
+
+	request := bleve.NewSearchRequestOptions(boolean, int(limit), int(offset), false)
+	request.Fields = []string{"Json"}
+	request.SortBy([]string{"-_score", "-Timestamp"})
+	result, err := i.Index.Search(request)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var messages []*chat.ChatMessage
+	for _, hit := range result.Hits {
+		serial, ok := hit.Fields["Json"].(string)
+		if !ok {
+			continue
+		}
+		var msg chat.ChatMessage
+		if e := json.Unmarshal([]byte(serial), &msg); e == nil {
+			messages = append(messages, &msg)
+		}
+	}
+	return messages, int32(result.Total), nil
+}
+
+// Close closes the underlying index.
+func (i *Indexer) Close() error {
+	return i.Index.Close()
+}
@@ -21,8 +21,10 @@
 package chat
 
 import (
+	"bytes"
 	"encoding/binary"
 	"encoding/json"
+	"time"
 
 	bolt "github.com/etcd-io/bbolt"
 	"github.com/micro/go-micro/errors"
@@ -41,6 +43,7 @@ type boltdbimpl struct {
 const (
 	rooms         = "rooms"
 	messages      = "messages"
+	reads         = "reads"
 	generalObject = "general"
 )
 
@@ -54,6 +57,10 @@ func (h *boltdbimpl) Init(config common.ConfigValues) error {
 		if err != nil {
 			return err
 		}
+		_, err = tx.CreateBucketIfNotExists([]byte(reads))
+		if err != nil {
+			return err
+		}
 		return nil
 	})
 
@@ -68,7 +75,10 @@ func (h *boltdbimpl) Init(config common.ConfigValues) error {
 //      	-> UUID => Rooms
 // messages
 //   -> ROOM IDS
-//      -> UUID => messages
+//      -> SEQUENCE => messages
+// reads
+//   -> ROOM IDS
+//      -> USER => SEQUENCE of last read message
 func (h *boltdbimpl) getMessagesBucket(tx *bolt.Tx, createIfNotExist bool, roomUuid string) (*bolt.Bucket, error) {
 
 	mainBucket := tx.Bucket([]byte(messages))
@@ -190,8 +200,16 @@ func (h *boltdbimpl) ListRooms(request *chat.ListRoomsRequest) (rooms []*chat.Ch
 		} else {
 
 			bucket, _ := h.getRoomsBucket(tx, false, request.ByType, "")
+			if bucket == nil {
+				return nil
+			}
 			return bucket.ForEach(func(k, v []byte) error {
 				if v != nil {
+					// Rooms stored without type object
+					var room chat.ChatRoom
+					if err := json.Unmarshal(v, &room); err == nil {
+						rooms = append(rooms, &room)
+					}
 					return nil
 				}
 				subBucket := bucket.Bucket(k)
@@ -211,30 +229,75 @@ func (h *boltdbimpl) ListRooms(request *chat.ListRoomsRequest) (rooms []*chat.Ch
 		return nil
 	})
 
+	if e == nil && len(request.Uuids) > 0 {
+		var filtered []*chat.ChatRoom
+		for _, r := range rooms {
+			for _, id := range request.Uuids {
+				if r.Uuid == id {
+					filtered = append(filtered, r)
+					break
+				}
+			}
+		}
+		rooms = filtered
+	}
+
 	return rooms, e
 }
+// ListMessages lists messages of a room, or replies of a thread if request.ParentUuid is set.
+// Messages are paginated backward: the most recent ones are returned first (skipping Offset messages),
+// or the ones preceding LastMessage if it is set. The result is always sorted chronologically.
 func (h *boltdbimpl) ListMessages(request *chat.ListMessagesRequest) (messages []*chat.ChatMessage, e error) {
 
+	limit := request.Limit
+	if limit <= 0 || limit > h.HistorySize {
+		limit = h.HistorySize
+	}
+
 	e = h.DB().View(func(tx *bolt.Tx) error {
 
 		bucket, _ := h.getMessagesBucket(tx, false, request.RoomUuid)
 		if bucket == nil {
 			return nil
 		}
-		return bucket.ForEach(func(k, v []byte) error {
+		c := bucket.Cursor()
+		k, v := c.Last()
+		if request.LastMessage != "" {
+			for ; k != nil; k, v = c.Prev() {
+				var msg chat.ChatMessage
+				if err := json.Unmarshal(v, &msg); err == nil && msg.Uuid == request.LastMessage {
+					k, v = c.Prev()
+					break
+				}
+			}
+		}
+		var skipped int64
+		for ; k != nil && int64(len(messages)) < limit; k, v = c.Prev() {
 			var msg chat.ChatMessage
-			err := json.Unmarshal(v, &msg)
-			if err != nil {
+			if err := json.Unmarshal(v, &msg); err != nil {
 				return err
 			}
+			if msg.ParentUuid != request.ParentUuid {
+				continue
+			}
+			if skipped < request.Offset {
+				skipped++
+				continue
+			}
 			messages = append(messages, &msg)
-			return nil
-		})
+		}
+		return nil
 
 	})
 
+	// Revert to chronological order
+	for i, j := 0, len(messages)-1; i < j; i, j = i+1, j-1 {
+		messages[i], messages[j] = messages[j], messages[i]
+	}
+
 	return messages, e
 }
+
 func (h *boltdbimpl) PostMessage(msg *chat.ChatMessage) (*chat.ChatMessage, error) {
 
 	if msg.Uuid == "" {
@@ -247,6 +310,15 @@ func (h *boltdbimpl) PostMessage(msg *chat.ChatMessage) (*chat.ChatMessage, erro
 			return nil
 		}
 
+		if msg.ParentUuid != "" {
+			if err := h.updateMessage(bucket, msg.ParentUuid, func(parent *chat.ChatMessage) error {
+				parent.RepliesCount++
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+
 		objectKey, _ := bucket.NextSequence()
 		k := make([]byte, 8)
 		binary.BigEndian.PutUint64(k, objectKey)
@@ -257,25 +329,291 @@ func (h *boltdbimpl) PostMessage(msg *chat.ChatMessage) (*chat.ChatMessage, erro
 	return msg, err
 }
 
-func (h *boltdbimpl) DeleteMessage(message *chat.ChatMessage) error {
+// DeleteMessage removes a message from its room, with the replies of its thread, and returns the removed messages.
+// If message.Author is set, it must match the original author.
+func (h *boltdbimpl) DeleteMessage(message *chat.ChatMessage) (removed []*chat.ChatMessage, e error) {
 
 	if message.Uuid == "" {
-		return errors.BadRequest(common.SERVICE_CHAT, "Cannot delete a message without Uuid")
+		return nil, errors.BadRequest(common.SERVICE_CHAT, "Cannot delete a message without Uuid")
 	}
 
-	err := h.DB().Update(func(tx *bolt.Tx) error {
+	e = h.DB().Update(func(tx *bolt.Tx) error {
 		bucket, err := h.getMessagesBucket(tx, false, message.RoomUuid)
 		if err != nil || bucket == nil {
 			return nil
 		}
-		return bucket.ForEach(func(k, v []byte) error {
+		var found bool
+		e := bucket.ForEach(func(k, v []byte) error {
 			var msg chat.ChatMessage
 			if err := json.Unmarshal(v, &msg); err == nil && msg.Uuid == message.Uuid {
-				return bucket.Delete(k)
+				if message.Author != "" && msg.Author != message.Author {
+					return errors.Forbidden(common.SERVICE_CHAT, "Only the author can delete a message")
+				}
+				found = true
+			}
+			return nil
+		})
+		if e != nil || !found {
+			return e
+		}
+		removed, e = h.removeMessages(bucket, func(msg *chat.ChatMessage) bool {
+			return msg.Uuid == message.Uuid
+		})
+		return e
+	})
+
+	return removed, e
+}
+
+// UpdateMessage replaces the content of an existing message, keeping previous versions in its History.
+// If message.Author is set, it must match the original author.
+func (h *boltdbimpl) UpdateMessage(message *chat.ChatMessage) (*chat.ChatMessage, error) {
+
+	if message.Uuid == "" {
+		return nil, errors.BadRequest(common.SERVICE_CHAT, "Cannot update a message without Uuid")
+	}
+
+	var updated *chat.ChatMessage
+	err := h.DB().Update(func(tx *bolt.Tx) error {
+		bucket, _ := h.getMessagesBucket(tx, false, message.RoomUuid)
+		if bucket == nil {
+			return errors.NotFound(common.SERVICE_CHAT, "Cannot find room %s", message.RoomUuid)
+		}
+		return h.updateMessage(bucket, message.Uuid, func(msg *chat.ChatMessage) error {
+			if message.Author != "" && msg.Author != message.Author {
+				return errors.Forbidden(common.SERVICE_CHAT, "Only the author can update a message")
+			}
+			if msg.Message != message.Message {
+				msg.History = append(msg.History, &chat.ChatMessageRevision{
+					Message:   msg.Message,
+					Timestamp: msg.Timestamp,
+				})
+				if msg.Edited > 0 {
+					msg.History[len(msg.History)-1].Timestamp = msg.Edited
+				}
+				msg.Message = message.Message
+			}
+			msg.Mentions = message.Mentions
+			msg.Attachments = message.Attachments
+			msg.Edited = time.Now().Unix()
+			updated = msg
+			return nil
+		})
+	})
+
+	return updated, err
+}
+
+// ReactToMessage adds or removes a user to the reactions of a message.
+func (h *boltdbimpl) ReactToMessage(request *chat.ReactToMessageRequest) (*chat.ChatMessage, error) {
+
+	if request.MessageUuid == "" || request.Emoji == "" || request.User == "" {
+		return nil, errors.BadRequest(common.SERVICE_CHAT, "Please provide a message, an emoji and a user")
+	}
+
+	var updated *chat.ChatMessage
+	err := h.DB().Update(func(tx *bolt.Tx) error {
+		bucket, _ := h.getMessagesBucket(tx, false, request.RoomUuid)
+		if bucket == nil {
+			return errors.NotFound(common.SERVICE_CHAT, "Cannot find room %s", request.RoomUuid)
+		}
+		return h.updateMessage(bucket, request.MessageUuid, func(msg *chat.ChatMessage) error {
+			var reaction *chat.ChatReaction
+			for _, r := range msg.Reactions {
+				if r.Emoji == request.Emoji {
+					reaction = r
+					break
+				}
+			}
+			if reaction == nil {
+				reaction = &chat.ChatReaction{Emoji: request.Emoji}
+				msg.Reactions = append(msg.Reactions, reaction)
+			}
+			var users []string
+			for _, u := range reaction.Users {
+				if u != request.User {
+					users = append(users, u)
+				}
+			}
+			if !request.Remove {
+				users = append(users, request.User)
 			}
+			reaction.Users = users
+			// Clean empty reactions
+			var reactions []*chat.ChatReaction
+			for _, r := range msg.Reactions {
+				if len(r.Users) > 0 {
+					reactions = append(reactions, r)
+				}
+			}
+			msg.Reactions = reactions
+			updated = msg
 			return nil
 		})
 	})
 
-	return err
+	return updated, err
+}
+
+// MarkRoomRead stores the last message read by a user in a given room.
+func (h *boltdbimpl) MarkRoomRead(roomUuid string, user string, lastMessage string) error {
+
+	return h.DB().Update(func(tx *bolt.Tx) error {
+		bucket, _ := h.getMessagesBucket(tx, false, roomUuid)
+		if bucket == nil {
+			return nil
+		}
+		var lastKey []byte
+		c := bucket.Cursor()
+		if lastMessage == "" {
+			lastKey, _ = c.Last()
+		} else {
+			for k, v := c.Last(); k != nil; k, v = c.Prev() {
+				var msg chat.ChatMessage
+				if err := json.Unmarshal(v, &msg); err == nil && msg.Uuid == lastMessage {
+					lastKey = k
+					break
+				}
+			}
+		}
+		if lastKey == nil {
+			return nil
+		}
+		readsBucket, err := tx.Bucket([]byte(reads)).CreateBucketIfNotExists([]byte(roomUuid))
+		if err != nil {
+			return err
+		}
+		if current := readsBucket.Get([]byte(user)); current != nil && bytes.Compare(current, lastKey) >= 0 {
+			return nil
+		}
+		return readsBucket.Put([]byte(user), lastKey)
+	})
+}
+
+// CountUnread counts messages that were not posted by the user since their last read message, for each room.
+func (h *boltdbimpl) CountUnread(user string, roomUuids []string) (map[string]int32, error) {
+
+	counts := make(map[string]int32, len(roomUuids))
+	err := h.DB().View(func(tx *bolt.Tx) error {
+		for _, roomUuid := range roomUuids {
+			counts[roomUuid] = 0
+			bucket, _ := h.getMessagesBucket(tx, false, roomUuid)
+			if bucket == nil {
+				continue
+			}
+			var lastRead []byte
+			if readsBucket := tx.Bucket([]byte(reads)).Bucket([]byte(roomUuid)); readsBucket != nil {
+				lastRead = readsBucket.Get([]byte(user))
+			}
+			c := bucket.Cursor()
+			var k, v []byte
+			if lastRead == nil {
+				k, v = c.First()
+			} else if k, v = c.Seek(lastRead); k != nil && bytes.Equal(k, lastRead) {
+				k, v = c.Next()
+			}
+			for ; k != nil; k, v = c.Next() {
+				var msg chat.ChatMessage
+				if err := json.Unmarshal(v, &msg); err == nil && msg.Author != user {
+					counts[roomUuid]++
+				}
+			}
+		}
+		return nil
+	})
+
+	return counts, err
+}
+
+// PurgeMessages deletes messages posted before olderThan in all rooms of the given type, with the replies of
+// their threads, and returns them.
+func (h *boltdbimpl) PurgeMessages(roomType chat.RoomType, olderThan int64) (purged []*chat.ChatMessage, e error) {
+
+	rooms, e := h.ListRooms(&chat.ListRoomsRequest{ByType: roomType})
+	if e != nil {
+		return nil, e
+	}
+
+	e = h.DB().Update(func(tx *bolt.Tx) error {
+		for _, room := range rooms {
+			bucket, _ := h.getMessagesBucket(tx, false, room.Uuid)
+			if bucket == nil {
+				continue
+			}
+			removed, err := h.removeMessages(bucket, func(msg *chat.ChatMessage) bool {
+				return msg.Timestamp < olderThan
+			})
+			if err != nil {
+				return err
+			}
+			purged = append(purged, removed...)
+		}
+		return nil
+	})
+
+	return purged, e
+}
+
+// removeMessages deletes the messages of a room bucket matching the remove callback, and the replies of their
+// threads, so that no reply is left without its parent. Replies counters of the remaining parents are updated.
+func (h *boltdbimpl) removeMessages(bucket *bolt.Bucket, remove func(msg *chat.ChatMessage) bool) (removed []*chat.ChatMessage, e error) {
+
+	var keys [][]byte
+	removedUuids := make(map[string]bool)
+	// Replies are always stored after their parent
+	if e = bucket.ForEach(func(k, v []byte) error {
+		var msg chat.ChatMessage
+		if err := json.Unmarshal(v, &msg); err != nil {
+			return nil
+		}
+		if remove(&msg) || (msg.ParentUuid != "" && removedUuids[msg.ParentUuid]) {
+			keys = append(keys, k)
+			removed = append(removed, &msg)
+			removedUuids[msg.Uuid] = true
+		}
+		return nil
+	}); e != nil {
+		return nil, e
+	}
+	for _, k := range keys {
+		if e = bucket.Delete(k); e != nil {
+			return nil, e
+		}
+	}
+	repliesRemoved := make(map[string]int32)
+	for _, msg := range removed {
+		if msg.ParentUuid != "" && !removedUuids[msg.ParentUuid] {
+			repliesRemoved[msg.ParentUuid]++
+		}
+	}
+	for parentUuid, count := range repliesRemoved {
+		h.updateMessage(bucket, parentUuid, func(parent *chat.ChatMessage) error {
+			parent.RepliesCount -= count
+			if parent.RepliesCount < 0 {
+				parent.RepliesCount = 0
+			}
+			return nil
+		})
+	}
+
+	return removed, nil
+}
+
+// updateMessage finds a message by its Uuid inside a room bucket, applies a callback and stores it back.
+func (h *boltdbimpl) updateMessage(bucket *bolt.Bucket, msgUuid string, callback func(msg *chat.ChatMessage) error) error {
+
+	c := bucket.Cursor()
+	for k, v := c.Last(); k != nil; k, v = c.Prev() {
+		var msg chat.ChatMessage
+		if err := json.Unmarshal(v, &msg); err != nil || msg.Uuid != msgUuid {
+			continue
+		}
+		if err := callback(&msg); err != nil {
+			return err
+		}
+		serial, _ := json.Marshal(&msg)
+		return bucket.Put(k, serial)
+	}
+
+	return errors.NotFound(common.SERVICE_CHAT, "Cannot find message %s", msgUuid)
 }
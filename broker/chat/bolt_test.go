@@ -0,0 +1,268 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package chat
+
+import (
+	"os"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common/boltdb"
+	"github.com/pydio/cells/common/config"
+	"github.com/pydio/cells/common/proto/chat"
+)
+
+var (
+	tmpDbFilePath string
+)
+
+func init() {
+	tmpDbFilePath = os.TempDir() + "/chat-bolt-test.db"
+}
+
+func initDAO() *boltdbimpl {
+	tmpdao := boltdb.NewDAO("boltdb", tmpDbFilePath, "")
+	dao := NewDAO(tmpdao).(*boltdbimpl)
+	dao.Init(*config.NewMap())
+	return dao
+}
+
+func TestMessages(t *testing.T) {
+
+	Convey("Test paginated history", t, func() {
+		defer os.Remove(tmpDbFilePath)
+		dao := initDAO()
+		defer dao.CloseConn()
+
+		var posted []*chat.ChatMessage
+		for i := 0; i < 10; i++ {
+			msg, err := dao.PostMessage(&chat.ChatMessage{RoomUuid: "room", Author: "admin", Message: "message"})
+			So(err, ShouldBeNil)
+			posted = append(posted, msg)
+		}
+
+		all, err := dao.ListMessages(&chat.ListMessagesRequest{RoomUuid: "room"})
+		So(err, ShouldBeNil)
+		So(all, ShouldHaveLength, 10)
+
+		last, err := dao.ListMessages(&chat.ListMessagesRequest{RoomUuid: "room", Limit: 3})
+		So(err, ShouldBeNil)
+		So(last, ShouldHaveLength, 3)
+		So(last[0].Uuid, ShouldEqual, posted[7].Uuid)
+		So(last[2].Uuid, ShouldEqual, posted[9].Uuid)
+
+		previous, err := dao.ListMessages(&chat.ListMessagesRequest{RoomUuid: "room", Limit: 3, LastMessage: posted[7].Uuid})
+		So(err, ShouldBeNil)
+		So(previous, ShouldHaveLength, 3)
+		So(previous[0].Uuid, ShouldEqual, posted[4].Uuid)
+		So(previous[2].Uuid, ShouldEqual, posted[6].Uuid)
+
+		offset, err := dao.ListMessages(&chat.ListMessagesRequest{RoomUuid: "room", Limit: 2, Offset: 8})
+		So(err, ShouldBeNil)
+		So(offset, ShouldHaveLength, 2)
+		So(offset[0].Uuid, ShouldEqual, posted[0].Uuid)
+	})
+
+	Convey("Test threads, edits and reactions", t, func() {
+		defer os.Remove(tmpDbFilePath)
+		dao := initDAO()
+		defer dao.CloseConn()
+
+		root, err := dao.PostMessage(&chat.ChatMessage{RoomUuid: "room", Author: "admin", Message: "root", Timestamp: 10})
+		So(err, ShouldBeNil)
+		_, err = dao.PostMessage(&chat.ChatMessage{RoomUuid: "room", Author: "user", Message: "reply", ParentUuid: root.Uuid})
+		So(err, ShouldBeNil)
+
+		topLevel, _ := dao.ListMessages(&chat.ListMessagesRequest{RoomUuid: "room"})
+		So(topLevel, ShouldHaveLength, 1)
+		So(topLevel[0].RepliesCount, ShouldEqual, 1)
+		replies, _ := dao.ListMessages(&chat.ListMessagesRequest{RoomUuid: "room", ParentUuid: root.Uuid})
+		So(replies, ShouldHaveLength, 1)
+		So(replies[0].Message, ShouldEqual, "reply")
+
+		edited, err := dao.UpdateMessage(&chat.ChatMessage{RoomUuid: "room", Uuid: root.Uuid, Message: "edited root"})
+		So(err, ShouldBeNil)
+		So(edited.Message, ShouldEqual, "edited root")
+		So(edited.Edited, ShouldBeGreaterThan, 0)
+		So(edited.History, ShouldHaveLength, 1)
+		So(edited.History[0].Message, ShouldEqual, "root")
+		So(edited.History[0].Timestamp, ShouldEqual, 10)
+		So(edited.RepliesCount, ShouldEqual, 1)
+
+		_, err = dao.UpdateMessage(&chat.ChatMessage{RoomUuid: "room", Uuid: "unknown", Message: "edited"})
+		So(err, ShouldNotBeNil)
+		_, err = dao.UpdateMessage(&chat.ChatMessage{RoomUuid: "room", Uuid: root.Uuid, Author: "user", Message: "edited"})
+		So(err, ShouldNotBeNil)
+
+		reacted, err := dao.ReactToMessage(&chat.ReactToMessageRequest{RoomUuid: "room", MessageUuid: root.Uuid, Emoji: "+1", User: "user"})
+		So(err, ShouldBeNil)
+		So(reacted.Reactions, ShouldHaveLength, 1)
+		So(reacted.Reactions[0].Users, ShouldResemble, []string{"user"})
+		reacted, _ = dao.ReactToMessage(&chat.ReactToMessageRequest{RoomUuid: "room", MessageUuid: root.Uuid, Emoji: "+1", User: "user"})
+		So(reacted.Reactions[0].Users, ShouldHaveLength, 1)
+		reacted, _ = dao.ReactToMessage(&chat.ReactToMessageRequest{RoomUuid: "room", MessageUuid: root.Uuid, Emoji: "+1", User: "user", Remove: true})
+		So(reacted.Reactions, ShouldBeEmpty)
+
+		_, err = dao.DeleteMessage(&chat.ChatMessage{RoomUuid: "room", Uuid: replies[0].Uuid, Author: "admin"})
+		So(err, ShouldNotBeNil)
+		removed, err := dao.DeleteMessage(&chat.ChatMessage{RoomUuid: "room", Uuid: replies[0].Uuid, Author: "user"})
+		So(err, ShouldBeNil)
+		So(removed, ShouldHaveLength, 1)
+		topLevel, _ = dao.ListMessages(&chat.ListMessagesRequest{RoomUuid: "room"})
+		So(topLevel[0].RepliesCount, ShouldEqual, 0)
+	})
+
+	Convey("Test thread replies are deleted with their parent", t, func() {
+		defer os.Remove(tmpDbFilePath)
+		dao := initDAO()
+		defer dao.CloseConn()
+
+		root, _ := dao.PostMessage(&chat.ChatMessage{RoomUuid: "room", Author: "admin", Message: "root"})
+		dao.PostMessage(&chat.ChatMessage{RoomUuid: "room", Author: "user", Message: "reply", ParentUuid: root.Uuid})
+		dao.PostMessage(&chat.ChatMessage{RoomUuid: "room", Author: "admin", Message: "other"})
+
+		removed, err := dao.DeleteMessage(&chat.ChatMessage{RoomUuid: "room", Uuid: root.Uuid, Author: "admin"})
+		So(err, ShouldBeNil)
+		So(removed, ShouldHaveLength, 2)
+		replies, _ := dao.ListMessages(&chat.ListMessagesRequest{RoomUuid: "room", ParentUuid: root.Uuid})
+		So(replies, ShouldBeEmpty)
+		remaining, _ := dao.ListMessages(&chat.ListMessagesRequest{RoomUuid: "room"})
+		So(remaining, ShouldHaveLength, 1)
+		So(remaining[0].Message, ShouldEqual, "other")
+	})
+
+	Convey("Test unread counters", t, func() {
+		defer os.Remove(tmpDbFilePath)
+		dao := initDAO()
+		defer dao.CloseConn()
+
+		first, _ := dao.PostMessage(&chat.ChatMessage{RoomUuid: "room", Author: "admin", Message: "first"})
+		dao.PostMessage(&chat.ChatMessage{RoomUuid: "room", Author: "admin", Message: "second"})
+		dao.PostMessage(&chat.ChatMessage{RoomUuid: "room", Author: "user", Message: "third"})
+
+		counts, err := dao.CountUnread("user", []string{"room", "other"})
+		So(err, ShouldBeNil)
+		So(counts["room"], ShouldEqual, 2)
+		So(counts["other"], ShouldEqual, 0)
+
+		So(dao.MarkRoomRead("room", "user", first.Uuid), ShouldBeNil)
+		counts, _ = dao.CountUnread("user", []string{"room"})
+		So(counts["room"], ShouldEqual, 1)
+
+		So(dao.MarkRoomRead("room", "user", ""), ShouldBeNil)
+		counts, _ = dao.CountUnread("user", []string{"room"})
+		So(counts["room"], ShouldEqual, 0)
+	})
+
+}
+
+func TestRetention(t *testing.T) {
+
+	Convey("Test purge by room type", t, func() {
+		defer os.Remove(tmpDbFilePath)
+		dao := initDAO()
+		defer dao.CloseConn()
+
+		wsRoom, _ := dao.PutRoom(&chat.ChatRoom{Type: chat.RoomType_WORKSPACE, RoomTypeObject: "ws"})
+		nodeRoom, _ := dao.PutRoom(&chat.ChatRoom{Type: chat.RoomType_NODE, RoomTypeObject: "node"})
+		globalRoom, _ := dao.PutRoom(&chat.ChatRoom{Type: chat.RoomType_GLOBAL})
+		for _, r := range []*chat.ChatRoom{wsRoom, nodeRoom, globalRoom} {
+			dao.PostMessage(&chat.ChatMessage{RoomUuid: r.Uuid, Author: "admin", Message: "old", Timestamp: 10})
+			dao.PostMessage(&chat.ChatMessage{RoomUuid: r.Uuid, Author: "admin", Message: "new", Timestamp: 100})
+		}
+
+		purged, err := dao.PurgeMessages(chat.RoomType_WORKSPACE, 50)
+		So(err, ShouldBeNil)
+		So(purged, ShouldHaveLength, 1)
+		So(purged[0].Message, ShouldEqual, "old")
+		remaining, _ := dao.ListMessages(&chat.ListMessagesRequest{RoomUuid: wsRoom.Uuid})
+		So(remaining, ShouldHaveLength, 1)
+		untouched, _ := dao.ListMessages(&chat.ListMessagesRequest{RoomUuid: nodeRoom.Uuid})
+		So(untouched, ShouldHaveLength, 2)
+
+		purged, err = dao.PurgeMessages(chat.RoomType_GLOBAL, 200)
+		So(err, ShouldBeNil)
+		So(purged, ShouldHaveLength, 2)
+	})
+
+	Convey("Test purge of threads", t, func() {
+		defer os.Remove(tmpDbFilePath)
+		dao := initDAO()
+		defer dao.CloseConn()
+
+		room, _ := dao.PutRoom(&chat.ChatRoom{Type: chat.RoomType_GLOBAL})
+		oldRoot, _ := dao.PostMessage(&chat.ChatMessage{RoomUuid: room.Uuid, Author: "admin", Message: "old root", Timestamp: 10})
+		dao.PostMessage(&chat.ChatMessage{RoomUuid: room.Uuid, Author: "user", Message: "new reply", ParentUuid: oldRoot.Uuid, Timestamp: 100})
+		newRoot, _ := dao.PostMessage(&chat.ChatMessage{RoomUuid: room.Uuid, Author: "admin", Message: "new root", Timestamp: 100})
+		dao.PostMessage(&chat.ChatMessage{RoomUuid: room.Uuid, Author: "user", Message: "old reply", ParentUuid: newRoot.Uuid, Timestamp: 30})
+		dao.PostMessage(&chat.ChatMessage{RoomUuid: room.Uuid, Author: "user", Message: "new reply", ParentUuid: newRoot.Uuid, Timestamp: 100})
+
+		purged, err := dao.PurgeMessages(chat.RoomType_GLOBAL, 50)
+		So(err, ShouldBeNil)
+		So(purged, ShouldHaveLength, 3)
+		replies, _ := dao.ListMessages(&chat.ListMessagesRequest{RoomUuid: room.Uuid, ParentUuid: oldRoot.Uuid})
+		So(replies, ShouldBeEmpty)
+		remaining, _ := dao.ListMessages(&chat.ListMessagesRequest{RoomUuid: room.Uuid})
+		So(remaining, ShouldHaveLength, 1)
+		So(remaining[0].Uuid, ShouldEqual, newRoot.Uuid)
+		So(remaining[0].RepliesCount, ShouldEqual, 1)
+	})
+
+}
+
+func TestIndexer(t *testing.T) {
+
+	Convey("Test full-text search", t, func() {
+		indexer, err := NewIndexer("")
+		So(err, ShouldBeNil)
+		defer indexer.Close()
+
+		So(indexer.IndexMessage(&chat.ChatMessage{Uuid: "m1", RoomUuid: "room1", Author: "admin", Message: "The quarterly report is ready", Timestamp: 10}), ShouldBeNil)
+		So(indexer.IndexMessage(&chat.ChatMessage{Uuid: "m2", RoomUuid: "room2", Author: "user", Message: "Where is the report?", Timestamp: 20}), ShouldBeNil)
+		So(indexer.IndexMessage(&chat.ChatMessage{Uuid: "m3", RoomUuid: "room1", Author: "user", Message: "Lunch time", Timestamp: 30}), ShouldBeNil)
+
+		results, total, err := indexer.SearchMessages("report", nil, 0, 10)
+		So(err, ShouldBeNil)
+		So(total, ShouldEqual, 2)
+		So(results, ShouldHaveLength, 2)
+
+		results, total, err = indexer.SearchMessages("report", []string{"room2"}, 0, 10)
+		So(err, ShouldBeNil)
+		So(total, ShouldEqual, 1)
+		So(results[0].Uuid, ShouldEqual, "m2")
+		So(results[0].Author, ShouldEqual, "user")
+
+		So(indexer.DeleteMessages(&chat.ChatMessage{Uuid: "m2"}), ShouldBeNil)
+		_, total, _ = indexer.SearchMessages("report", nil, 0, 10)
+		So(total, ShouldEqual, 1)
+	})
+
+}
+
+func TestParseMentions(t *testing.T) {
+
+	Convey("Test mentions parsing", t, func() {
+		So(ParseMentions("hello @admin and @john.doe, see email@domain.com @admin"), ShouldResemble, []string{"admin", "john.doe"})
+		So(ParseMentions("no mentions here"), ShouldBeEmpty)
+	})
+
+}
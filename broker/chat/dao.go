@@ -34,7 +34,12 @@ type DAO interface {
 	ListRooms(request *chat.ListRoomsRequest) ([]*chat.ChatRoom, error)
 	ListMessages(request *chat.ListMessagesRequest) ([]*chat.ChatMessage, error)
 	PostMessage(request *chat.ChatMessage) (*chat.ChatMessage, error)
-	DeleteMessage(message *chat.ChatMessage) error
+	DeleteMessage(message *chat.ChatMessage) ([]*chat.ChatMessage, error)
+	UpdateMessage(message *chat.ChatMessage) (*chat.ChatMessage, error)
+	ReactToMessage(request *chat.ReactToMessageRequest) (*chat.ChatMessage, error)
+	MarkRoomRead(roomUuid string, user string, lastMessage string) error
+	CountUnread(user string, roomUuids []string) (map[string]int32, error)
+	PurgeMessages(roomType chat.RoomType, olderThan int64) ([]*chat.ChatMessage, error)
 }
 
 func NewDAO(o dao.DAO) dao.DAO {
@@ -34,7 +34,9 @@ import (
 	"github.com/pydio/cells/common/service/context"
 )
 
-type ChatHandler struct{}
+type ChatHandler struct {
+	Indexer *chat2.Indexer
+}
 
 func (c *ChatHandler) PutRoom(ctx context.Context, req *chat.PutRoomRequest, resp *chat.PutRoomResponse) error {
 
@@ -108,11 +110,14 @@ func (c *ChatHandler) PostMessage(ctx context.Context, req *chat.PostMessageRequ
 	db := servicecontext.GetDAO(ctx).(chat2.DAO)
 
 	for _, m := range req.Messages {
+		// Mentions are never trusted from clients
+		m.Mentions = chat2.ParseMentions(m.Message)
 		newMessage, err := db.PostMessage(m)
 		if err != nil {
 			return err
 		}
 		resp.Messages = append(resp.Messages, newMessage)
+		c.index(ctx, newMessage)
 		client.Publish(ctx, client.NewPublication(common.TOPIC_CHAT_EVENT, &chat.ChatEvent{
 			Message: newMessage,
 		}))
@@ -127,15 +132,121 @@ func (c *ChatHandler) DeleteMessage(ctx context.Context, req *chat.DeleteMessage
 	db := servicecontext.GetDAO(ctx).(chat2.DAO)
 
 	for _, m := range req.Messages {
-		err := db.DeleteMessage(m)
+		removed, err := db.DeleteMessage(m)
 		if err != nil {
 			return err
 		}
-		client.Publish(ctx, client.NewPublication(common.TOPIC_CHAT_EVENT, &chat.ChatEvent{
-			Message: m,
-			Details: "DELETE",
-		}))
+		if c.Indexer != nil && len(removed) > 0 {
+			if e := c.Indexer.DeleteMessages(removed...); e != nil {
+				log.Logger(ctx).Error("Cannot remove message from index", zap.Error(e))
+			}
+		}
+		for _, r := range removed {
+			client.Publish(ctx, client.NewPublication(common.TOPIC_CHAT_EVENT, &chat.ChatEvent{
+				Message: r,
+				Details: "DELETE",
+			}))
+		}
 	}
 	resp.Success = true
 	return nil
 }
+
+func (c *ChatHandler) UpdateMessage(ctx context.Context, req *chat.UpdateMessageRequest, resp *chat.UpdateMessageResponse) error {
+
+	log.Logger(ctx).Debug("Update Message", zap.Any(common.KEY_CHAT_POST_MSG_REQ, req))
+	db := servicecontext.GetDAO(ctx).(chat2.DAO)
+
+	req.Message.Mentions = chat2.ParseMentions(req.Message.Message)
+	updated, err := db.UpdateMessage(req.Message)
+	if err != nil {
+		return err
+	}
+	resp.Message = updated
+	c.index(ctx, updated)
+	client.Publish(ctx, client.NewPublication(common.TOPIC_CHAT_EVENT, &chat.ChatEvent{
+		Message: updated,
+		Details: "UPDATE",
+	}))
+	return nil
+}
+
+func (c *ChatHandler) ReactToMessage(ctx context.Context, req *chat.ReactToMessageRequest, resp *chat.ReactToMessageResponse) error {
+
+	log.Logger(ctx).Debug("React To Message", zap.Any(common.KEY_CHAT_POST_MSG_REQ, req))
+	db := servicecontext.GetDAO(ctx).(chat2.DAO)
+
+	updated, err := db.ReactToMessage(req)
+	if err != nil {
+		return err
+	}
+	resp.Message = updated
+	client.Publish(ctx, client.NewPublication(common.TOPIC_CHAT_EVENT, &chat.ChatEvent{
+		Message: updated,
+		Details: "REACT",
+	}))
+	return nil
+}
+
+func (c *ChatHandler) MarkRoomRead(ctx context.Context, req *chat.MarkRoomReadRequest, resp *chat.MarkRoomReadResponse) error {
+
+	db := servicecontext.GetDAO(ctx).(chat2.DAO)
+	if err := db.MarkRoomRead(req.RoomUuid, req.User, req.LastMessage); err != nil {
+		return err
+	}
+	resp.Success = true
+	return nil
+}
+
+func (c *ChatHandler) CountUnread(ctx context.Context, req *chat.CountUnreadRequest, resp *chat.CountUnreadResponse) error {
+
+	db := servicecontext.GetDAO(ctx).(chat2.DAO)
+	counts, err := db.CountUnread(req.User, req.RoomUuids)
+	if err != nil {
+		return err
+	}
+	resp.Counts = counts
+	return nil
+}
+
+func (c *ChatHandler) SearchMessages(ctx context.Context, req *chat.SearchMessagesRequest, resp *chat.SearchMessagesResponse) error {
+
+	log.Logger(ctx).Debug("Search Messages", zap.Any("request", req))
+	if c.Indexer == nil {
+		return errors.New("search index is not available")
+	}
+	messages, total, err := c.Indexer.SearchMessages(req.Query, req.RoomUuids, req.Offset, req.Limit)
+	if err != nil {
+		return err
+	}
+	resp.Messages = messages
+	resp.Total = total
+	return nil
+}
+
+func (c *ChatHandler) PurgeMessages(ctx context.Context, req *chat.PurgeMessagesRequest, resp *chat.PurgeMessagesResponse) error {
+
+	db := servicecontext.GetDAO(ctx).(chat2.DAO)
+	purged, err := db.PurgeMessages(req.RoomType, req.OlderThan)
+	if err != nil {
+		return err
+	}
+	if c.Indexer != nil && len(purged) > 0 {
+		if e := c.Indexer.DeleteMessages(purged...); e != nil {
+			log.Logger(ctx).Error("Cannot remove purged messages from index", zap.Error(e))
+		}
+	}
+	log.Logger(ctx).Info("Purged chat messages", zap.String("roomType", req.RoomType.String()), zap.Int("count", len(purged)))
+	resp.Count = int32(len(purged))
+	return nil
+}
+
+// index adds a message to the full-text index, logging errors without failing the request.
+func (c *ChatHandler) index(ctx context.Context, msg *chat.ChatMessage) {
+	if c.Indexer == nil {
+		return
+	}
+	if e := c.Indexer.IndexMessage(msg); e != nil {
+		log.Logger(ctx).Error("Cannot index chat message", zap.Error(e))
+	}
+}
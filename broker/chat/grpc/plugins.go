@@ -22,11 +22,14 @@
 package grpc
 
 import (
+	"path"
+
 	"github.com/micro/go-micro"
 	"github.com/pydio/cells/common/plugins"
 
 	"github.com/pydio/cells/broker/chat"
 	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/config"
 	proto "github.com/pydio/cells/common/proto/chat"
 	"github.com/pydio/cells/common/service"
 )
@@ -40,7 +43,20 @@ func init() {
 			service.WithStorage(chat.NewDAO, "broker_chat"),
 			service.Unique(true),
 			service.WithMicro(func(m micro.Service) error {
-				proto.RegisterChatServiceHandler(m.Options().Server, new(ChatHandler))
+				serviceDir, e := config.ServiceDataDir(common.SERVICE_GRPC_NAMESPACE_ + common.SERVICE_CHAT)
+				if e != nil {
+					return e
+				}
+				indexer, err := chat.NewIndexer(path.Join(serviceDir, "chat.bleve"))
+				if err != nil {
+					return err
+				}
+				proto.RegisterChatServiceHandler(m.Options().Server, &ChatHandler{Indexer: indexer})
+
+				m.Init(micro.BeforeStop(func() error {
+					indexer.Close()
+					return nil
+				}))
 
 				return nil
 			}),
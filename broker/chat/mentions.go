@@ -0,0 +1,39 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package chat
+
+import (
+	"regexp"
+)
+
+var mentionsRegexp = regexp.MustCompile(`(?:^|[^\w@])@([\w.\-]+[\w])`)
+
+// ParseMentions extracts the list of unique logins mentioned with an @login syntax in a message.
+func ParseMentions(message string) (logins []string) {
+	seen := make(map[string]bool)
+	for _, match := range mentionsRegexp.FindAllStringSubmatch(message, -1) {
+		if login := match[1]; !seen[login] {
+			seen[login] = true
+			logins = append(logins, login)
+		}
+	}
+	return
+}
@@ -0,0 +1,201 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/emicklei/go-restful"
+	"github.com/micro/go-micro/errors"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/proto/chat"
+	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/service"
+	"github.com/pydio/cells/common/utils/permissions"
+	"github.com/pydio/cells/common/views"
+)
+
+// ExportChatRoom renders the history of a room as a Markdown document and stores it in the folder the room is
+// attached to: the workspace root for workspace rooms, the node itself (or its parent for files) for node rooms.
+func (c *ChatHandler) ExportChatRoom(req *restful.Request, rsp *restful.Response) {
+
+	ctx := req.Request.Context()
+	room, err := c.loadRoom(ctx, req.PathParameter("Uuid"))
+	if err != nil {
+		service.RestErrorDetect(req, rsp, err)
+		return
+	}
+	folder, err := c.exportFolder(ctx, room)
+	if err != nil {
+		service.RestErrorDetect(req, rsp, err)
+		return
+	}
+	content, err := c.renderHistory(ctx, room)
+	if err != nil {
+		service.RestErrorDetect(req, rsp, err)
+		return
+	}
+
+	label := room.RoomLabel
+	if label == "" {
+		label = "chat"
+	}
+	label = strings.Replace(label, "/", "-", -1)
+	fileName := fmt.Sprintf("%s - %s.md", label, time.Now().Format("2006-01-02 15-04-05"))
+	target := &tree.Node{Path: path.Join(folder, fileName)}
+
+	router := views.NewStandardRouter(views.RouterOptions{WatchRegistry: false})
+	if _, err := router.PutObject(ctx, target, bytes.NewBufferString(content), &views.PutRequestData{Size: int64(len(content))}); err != nil {
+		service.RestErrorDetect(req, rsp, err)
+		return
+	}
+	resp, err := router.ReadNode(ctx, &tree.ReadNodeRequest{Node: target})
+	if err != nil {
+		service.RestErrorDetect(req, rsp, err)
+		return
+	}
+	rsp.WriteEntity(resp.Node)
+
+}
+
+// exportFolder computes the path of the folder where the room export is stored, relative to the user root.
+func (c *ChatHandler) exportFolder(ctx context.Context, room *chat.ChatRoom) (string, error) {
+
+	accessList, err := permissions.AccessListFromContextClaims(ctx)
+	if err != nil {
+		return "", err
+	}
+	switch room.Type {
+	case chat.RoomType_WORKSPACE:
+		if ws, ok := accessList.Workspaces[room.RoomTypeObject]; ok {
+			return ws.Slug, nil
+		}
+	case chat.RoomType_NODE:
+		resp, err := c.router.ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Uuid: room.RoomTypeObject}})
+		if err != nil {
+			return "", err
+		}
+		for _, appears := range resp.Node.AppearsIn {
+			ws, ok := accessList.Workspaces[appears.WsUuid]
+			if !ok {
+				continue
+			}
+			folder := path.Join(ws.Slug, appears.Path)
+			if resp.Node.IsLeaf() {
+				folder = path.Dir(folder)
+			}
+			return folder, nil
+		}
+	default:
+		return "", errors.BadRequest(common.SERVICE_CHAT, "Only workspace and node rooms can be exported")
+	}
+
+	return "", errors.Forbidden(common.SERVICE_CHAT, "Cannot find a folder to store the export")
+}
+
+// renderHistory loads all messages of a room, including thread replies, and renders them in Markdown.
+func (c *ChatHandler) renderHistory(ctx context.Context, room *chat.ChatRoom) (string, error) {
+
+	messages, err := c.listAllMessages(ctx, room.Uuid, "")
+	if err != nil {
+		return "", err
+	}
+	buffer := &bytes.Buffer{}
+	title := room.RoomLabel
+	if title == "" {
+		title = room.Uuid
+	}
+	fmt.Fprintf(buffer, "# %s\n\n", title)
+	fmt.Fprintf(buffer, "_Exported on %s_\n\n", time.Now().Format(time.RFC1123))
+	for _, m := range messages {
+		renderMessage(buffer, m, "")
+		if m.RepliesCount == 0 {
+			continue
+		}
+		replies, err := c.listAllMessages(ctx, room.Uuid, m.Uuid)
+		if err != nil {
+			return "", err
+		}
+		for _, r := range replies {
+			renderMessage(buffer, r, "> ")
+		}
+		buffer.WriteString("\n")
+	}
+
+	return buffer.String(), nil
+}
+
+// listAllMessages pages through the history of a room or a thread and returns messages in chronological order.
+func (c *ChatHandler) listAllMessages(ctx context.Context, roomUuid string, parentUuid string) ([]*chat.ChatMessage, error) {
+
+	var all []*chat.ChatMessage
+	var lastMessage string
+	for {
+		streamer, err := c.getClient().ListMessages(ctx, &chat.ListMessagesRequest{
+			RoomUuid:    roomUuid,
+			ParentUuid:  parentUuid,
+			LastMessage: lastMessage,
+		})
+		if err != nil {
+			return nil, err
+		}
+		var page []*chat.ChatMessage
+		for {
+			resp, e := streamer.Recv()
+			if e != nil {
+				break
+			}
+			if resp != nil && resp.Message != nil {
+				page = append(page, resp.Message)
+			}
+		}
+		streamer.Close()
+		if len(page) == 0 {
+			break
+		}
+		all = append(page, all...)
+		lastMessage = page[0].Uuid
+	}
+
+	return all, nil
+}
+
+func renderMessage(buffer *bytes.Buffer, m *chat.ChatMessage, prefix string) {
+	date := time.Unix(m.Timestamp, 0).Format("2006-01-02 15:04")
+	fmt.Fprintf(buffer, "%s**%s** (%s)", prefix, m.Author, date)
+	if m.Edited > 0 {
+		buffer.WriteString(" _edited_")
+	}
+	buffer.WriteString("\n")
+	for _, line := range strings.Split(m.Message, "\n") {
+		fmt.Fprintf(buffer, "%s%s\n", prefix, line)
+	}
+	for _, a := range m.Attachments {
+		fmt.Fprintf(buffer, "%s- %s\n", prefix, a.Path)
+	}
+	buffer.WriteString(prefix + "\n")
+}
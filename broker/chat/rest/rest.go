@@ -0,0 +1,431 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package rest
+
+import (
+	"context"
+	"time"
+
+	"github.com/emicklei/go-restful"
+	"github.com/micro/go-micro/errors"
+	"go.uber.org/zap"
+
+	chat2 "github.com/pydio/cells/broker/chat"
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/proto/chat"
+	"github.com/pydio/cells/common/proto/rest"
+	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/registry"
+	"github.com/pydio/cells/common/service"
+	"github.com/pydio/cells/common/utils/permissions"
+	"github.com/pydio/cells/common/views"
+)
+
+// ChatHandler responds to chat REST requests
+type ChatHandler struct {
+	router views.Handler
+	client chat.ChatServiceClient
+}
+
+func NewChatHandler() *ChatHandler {
+	return &ChatHandler{
+		router: views.NewUuidRouter(views.RouterOptions{}),
+	}
+}
+
+// SwaggerTags list the names of the service tags declared in the swagger json implemented by this service
+func (c *ChatHandler) SwaggerTags() []string {
+	return []string{"ChatService"}
+}
+
+// Filter returns a function to filter the swagger path
+func (c *ChatHandler) Filter() func(string) string {
+	return nil
+}
+
+// Internal function to retrieve chat GRPC client
+func (c *ChatHandler) getClient() chat.ChatServiceClient {
+	if c.client != nil {
+		return c.client
+	}
+	return chat.NewChatServiceClient(registry.GetClient(common.SERVICE_CHAT))
+}
+
+// ListChatMessages returns a page of messages of a room, or the replies of a thread
+func (c *ChatHandler) ListChatMessages(req *restful.Request, rsp *restful.Response) {
+
+	ctx := req.Request.Context()
+	var input chat.ListMessagesRequest
+	if err := req.ReadEntity(&input); err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	if _, err := c.loadRoom(ctx, input.RoomUuid); err != nil {
+		service.RestErrorDetect(req, rsp, err)
+		return
+	}
+	streamer, err := c.getClient().ListMessages(ctx, &input)
+	if err != nil {
+		service.RestErrorDetect(req, rsp, err)
+		return
+	}
+	defer streamer.Close()
+	collection := &rest.ChatMessagesCollection{}
+	for {
+		resp, e := streamer.Recv()
+		if e != nil {
+			break
+		}
+		if resp == nil {
+			continue
+		}
+		collection.Messages = append(collection.Messages, resp.Message)
+	}
+	rsp.WriteEntity(collection)
+
+}
+
+// PostChatMessage posts a message in a room on behalf of the current user
+func (c *ChatHandler) PostChatMessage(req *restful.Request, rsp *restful.Response) {
+
+	ctx := req.Request.Context()
+	var message chat.ChatMessage
+	if err := req.ReadEntity(&message); err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	if _, err := c.loadRoom(ctx, message.RoomUuid); err != nil {
+		service.RestErrorDetect(req, rsp, err)
+		return
+	}
+	message.Uuid = ""
+	message.Author, _ = permissions.FindUserNameInContext(ctx)
+	message.Timestamp = time.Now().Unix()
+	message.Reactions = nil
+	message.History = nil
+	message.RepliesCount = 0
+	chat2.ResolveAttachments(ctx, c.router, &message)
+	resp, err := c.getClient().PostMessage(ctx, &chat.PostMessageRequest{Messages: []*chat.ChatMessage{&message}})
+	if err != nil {
+		service.RestErrorDetect(req, rsp, err)
+		return
+	}
+	rsp.WriteEntity(resp.Messages[0])
+
+}
+
+// UpdateChatMessage edits a message posted by the current user
+func (c *ChatHandler) UpdateChatMessage(req *restful.Request, rsp *restful.Response) {
+
+	ctx := req.Request.Context()
+	var message chat.ChatMessage
+	if err := req.ReadEntity(&message); err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	message.Uuid = req.PathParameter("Uuid")
+	if _, err := c.loadRoom(ctx, message.RoomUuid); err != nil {
+		service.RestErrorDetect(req, rsp, err)
+		return
+	}
+	message.Author, _ = permissions.FindUserNameInContext(ctx)
+	chat2.ResolveAttachments(ctx, c.router, &message)
+	resp, err := c.getClient().UpdateMessage(ctx, &chat.UpdateMessageRequest{Message: &message})
+	if err != nil {
+		service.RestErrorDetect(req, rsp, err)
+		return
+	}
+	rsp.WriteEntity(resp.Message)
+
+}
+
+// DeleteChatMessage deletes a message posted by the current user
+func (c *ChatHandler) DeleteChatMessage(req *restful.Request, rsp *restful.Response) {
+
+	ctx := req.Request.Context()
+	roomUuid := req.PathParameter("RoomUuid")
+	msgUuid := req.PathParameter("Uuid")
+	if _, err := c.loadRoom(ctx, roomUuid); err != nil {
+		service.RestErrorDetect(req, rsp, err)
+		return
+	}
+	message := &chat.ChatMessage{RoomUuid: roomUuid, Uuid: msgUuid}
+	message.Author, _ = permissions.FindUserNameInContext(ctx)
+	resp, err := c.getClient().DeleteMessage(ctx, &chat.DeleteMessageRequest{Messages: []*chat.ChatMessage{message}})
+	if err != nil {
+		service.RestErrorDetect(req, rsp, err)
+		return
+	}
+	rsp.WriteEntity(resp)
+
+}
+
+// ReactToChatMessage adds or removes a reaction of the current user
+func (c *ChatHandler) ReactToChatMessage(req *restful.Request, rsp *restful.Response) {
+
+	ctx := req.Request.Context()
+	var input chat.ReactToMessageRequest
+	if err := req.ReadEntity(&input); err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	input.MessageUuid = req.PathParameter("MessageUuid")
+	if _, err := c.loadRoom(ctx, input.RoomUuid); err != nil {
+		service.RestErrorDetect(req, rsp, err)
+		return
+	}
+	input.User, _ = permissions.FindUserNameInContext(ctx)
+	resp, err := c.getClient().ReactToMessage(ctx, &input)
+	if err != nil {
+		service.RestErrorDetect(req, rsp, err)
+		return
+	}
+	rsp.WriteEntity(resp)
+
+}
+
+// MarkChatRoomRead stores the last message read by the current user in a room
+func (c *ChatHandler) MarkChatRoomRead(req *restful.Request, rsp *restful.Response) {
+
+	ctx := req.Request.Context()
+	var input chat.MarkRoomReadRequest
+	if err := req.ReadEntity(&input); err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	input.RoomUuid = req.PathParameter("RoomUuid")
+	if _, err := c.loadRoom(ctx, input.RoomUuid); err != nil {
+		service.RestErrorDetect(req, rsp, err)
+		return
+	}
+	input.User, _ = permissions.FindUserNameInContext(ctx)
+	resp, err := c.getClient().MarkRoomRead(ctx, &input)
+	if err != nil {
+		service.RestErrorDetect(req, rsp, err)
+		return
+	}
+	rsp.WriteEntity(resp)
+
+}
+
+// CountChatUnread counts unread messages of the current user for the rooms they can access
+func (c *ChatHandler) CountChatUnread(req *restful.Request, rsp *restful.Response) {
+
+	ctx := req.Request.Context()
+	var input chat.CountUnreadRequest
+	if err := req.ReadEntity(&input); err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	var roomUuids []string
+	for _, roomUuid := range input.RoomUuids {
+		if _, err := c.loadRoom(ctx, roomUuid); err == nil {
+			roomUuids = append(roomUuids, roomUuid)
+		}
+	}
+	input.RoomUuids = roomUuids
+	input.User, _ = permissions.FindUserNameInContext(ctx)
+	resp, err := c.getClient().CountUnread(ctx, &input)
+	if err != nil {
+		service.RestErrorDetect(req, rsp, err)
+		return
+	}
+	rsp.WriteEntity(resp)
+
+}
+
+// SearchChatMessages performs a full-text search in the rooms the current user can access
+func (c *ChatHandler) SearchChatMessages(req *restful.Request, rsp *restful.Response) {
+
+	ctx := req.Request.Context()
+	var input chat.SearchMessagesRequest
+	if err := req.ReadEntity(&input); err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	var roomUuids []string
+	if len(input.RoomUuids) > 0 {
+		for _, roomUuid := range input.RoomUuids {
+			if _, err := c.loadRoom(ctx, roomUuid); err == nil {
+				roomUuids = append(roomUuids, roomUuid)
+			}
+		}
+	} else {
+		accessList, err := permissions.AccessListFromContextClaims(ctx)
+		if err != nil {
+			service.RestErrorDetect(req, rsp, err)
+			return
+		}
+		userName, _ := permissions.FindUserNameInContext(ctx)
+		if roomUuids, err = c.searchableRooms(ctx, accessList, userName); err != nil {
+			service.RestErrorDetect(req, rsp, err)
+			return
+		}
+	}
+	if len(roomUuids) == 0 {
+		rsp.WriteEntity(&chat.SearchMessagesResponse{})
+		return
+	}
+	input.RoomUuids = roomUuids
+	resp, err := c.getClient().SearchMessages(ctx, &input)
+	if err != nil {
+		service.RestErrorDetect(req, rsp, err)
+		return
+	}
+	rsp.WriteEntity(resp)
+
+}
+
+// loadRoom finds a room by its Uuid and checks that the current user can access it.
+func (c *ChatHandler) loadRoom(ctx context.Context, roomUuid string) (*chat.ChatRoom, error) {
+
+	if roomUuid == "" {
+		return nil, errors.BadRequest(common.SERVICE_CHAT, "Please provide a room Uuid")
+	}
+	var room *chat.ChatRoom
+	for t := range chat.RoomType_name {
+		streamer, err := c.getClient().ListRooms(ctx, &chat.ListRoomsRequest{ByType: chat.RoomType(t), Uuids: []string{roomUuid}})
+		if err != nil {
+			return nil, err
+		}
+		for {
+			resp, e := streamer.Recv()
+			if e != nil {
+				break
+			}
+			if resp != nil && resp.Room != nil {
+				room = resp.Room
+			}
+		}
+		streamer.Close()
+		if room != nil {
+			break
+		}
+	}
+	if room == nil {
+		return nil, errors.NotFound(common.SERVICE_CHAT, "Cannot find room %s", roomUuid)
+	}
+	if err := c.checkRoomAccess(ctx, room); err != nil {
+		return nil, err
+	}
+
+	return room, nil
+}
+
+// searchableRooms lists the rooms searched when no room is given: global rooms, the rooms of the workspaces
+// of the access list, the user rooms the user participates in and the node rooms whose node the user can read.
+func (c *ChatHandler) searchableRooms(ctx context.Context, accessList *permissions.AccessList, userName string) ([]string, error) {
+
+	var roomUuids []string
+	appendRooms := func(request *chat.ListRoomsRequest, filter func(*chat.ChatRoom) bool) error {
+		streamer, err := c.getClient().ListRooms(ctx, request)
+		if err != nil {
+			return err
+		}
+		defer streamer.Close()
+		for {
+			resp, e := streamer.Recv()
+			if e != nil {
+				break
+			}
+			if resp != nil && resp.Room != nil && filter(resp.Room) {
+				roomUuids = append(roomUuids, resp.Room.Uuid)
+			}
+		}
+		return nil
+	}
+
+	all := func(*chat.ChatRoom) bool { return true }
+	if err := appendRooms(&chat.ListRoomsRequest{ByType: chat.RoomType_GLOBAL}, all); err != nil {
+		return nil, err
+	}
+	for wsId := range accessList.Workspaces {
+		if err := appendRooms(&chat.ListRoomsRequest{ByType: chat.RoomType_WORKSPACE, TypeObject: wsId}, all); err != nil {
+			return nil, err
+		}
+	}
+	if err := appendRooms(&chat.ListRoomsRequest{ByType: chat.RoomType_USER}, func(room *chat.ChatRoom) bool {
+		return isRoomParticipant(room, userName)
+	}); err != nil {
+		return nil, err
+	}
+	// Node rooms access is checked by reading their node
+	if err := appendRooms(&chat.ListRoomsRequest{ByType: chat.RoomType_NODE}, func(room *chat.ChatRoom) bool {
+		return c.canReadNode(ctx, room.RoomTypeObject)
+	}); err != nil {
+		return nil, err
+	}
+
+	return roomUuids, nil
+}
+
+// checkRoomAccess verifies that the current user can access a room: through ACLs for node and workspace
+// rooms, or by being one of the participants of user rooms.
+func (c *ChatHandler) checkRoomAccess(ctx context.Context, room *chat.ChatRoom) error {
+
+	forbidden := errors.Forbidden(common.SERVICE_CHAT, "You are not allowed to access this room")
+	switch room.Type {
+	case chat.RoomType_NODE:
+		if !c.canReadNode(ctx, room.RoomTypeObject) {
+			return forbidden
+		}
+	case chat.RoomType_WORKSPACE:
+		accessList, err := permissions.AccessListFromContextClaims(ctx)
+		if err != nil {
+			return err
+		}
+		if _, ok := accessList.Workspaces[room.RoomTypeObject]; !ok {
+			return forbidden
+		}
+	case chat.RoomType_USER:
+		userName, _ := permissions.FindUserNameInContext(ctx)
+		if !isRoomParticipant(room, userName) {
+			return forbidden
+		}
+	}
+
+	return nil
+}
+
+// canReadNode checks through the router that the current user can read the node a room is attached to.
+func (c *ChatHandler) canReadNode(ctx context.Context, nodeUuid string) bool {
+	if _, err := c.router.ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Uuid: nodeUuid}}); err != nil {
+		log.Logger(ctx).Debug("Cannot read room node", zap.Error(err))
+		return false
+	}
+	return true
+}
+
+func isRoomParticipant(room *chat.ChatRoom, userName string) bool {
+	if userName == "" {
+		return false
+	}
+	if room.RoomTypeObject == userName {
+		return true
+	}
+	for _, u := range room.Users {
+		if u == userName {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,239 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/micro/go-micro/client"
+	"github.com/micro/go-micro/errors"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common/proto/chat"
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/utils/permissions"
+	"github.com/pydio/cells/common/views"
+)
+
+// chatClientMock serves rooms and messages from memory and records ListRooms requests.
+type chatClientMock struct {
+	chat.ChatServiceClient
+	rooms     []*chat.ChatRoom
+	messages  []*chat.ChatMessage
+	listRooms []*chat.ListRoomsRequest
+}
+
+func (m *chatClientMock) ListRooms(ctx context.Context, in *chat.ListRoomsRequest, opts ...client.CallOption) (chat.ChatService_ListRoomsClient, error) {
+	m.listRooms = append(m.listRooms, in)
+	s := &roomsStreamMock{}
+	for _, r := range m.rooms {
+		if r.Type != in.ByType || (in.TypeObject != "" && r.RoomTypeObject != in.TypeObject) {
+			continue
+		}
+		s.rooms = append(s.rooms, r)
+	}
+	return s, nil
+}
+
+func (m *chatClientMock) ListMessages(ctx context.Context, in *chat.ListMessagesRequest, opts ...client.CallOption) (chat.ChatService_ListMessagesClient, error) {
+	s := &messagesStreamMock{}
+	if in.LastMessage != "" {
+		// All messages are returned in the first page
+		return s, nil
+	}
+	for _, msg := range m.messages {
+		if msg.RoomUuid == in.RoomUuid && msg.ParentUuid == in.ParentUuid {
+			s.messages = append(s.messages, msg)
+		}
+	}
+	return s, nil
+}
+
+type roomsStreamMock struct {
+	chat.ChatService_ListRoomsClient
+	rooms []*chat.ChatRoom
+}
+
+func (s *roomsStreamMock) Recv() (*chat.ListRoomsResponse, error) {
+	if len(s.rooms) == 0 {
+		return nil, io.EOF
+	}
+	r := s.rooms[0]
+	s.rooms = s.rooms[1:]
+	return &chat.ListRoomsResponse{Room: r}, nil
+}
+
+func (s *roomsStreamMock) Close() error {
+	return nil
+}
+
+type messagesStreamMock struct {
+	chat.ChatService_ListMessagesClient
+	messages []*chat.ChatMessage
+}
+
+func (s *messagesStreamMock) Recv() (*chat.ListMessagesResponse, error) {
+	if len(s.messages) == 0 {
+		return nil, io.EOF
+	}
+	m := s.messages[0]
+	s.messages = s.messages[1:]
+	return &chat.ListMessagesResponse{Message: m}, nil
+}
+
+func (s *messagesStreamMock) Close() error {
+	return nil
+}
+
+// routerMock reads nodes by Uuid and counts the reads.
+type routerMock struct {
+	*views.HandlerMock
+	readable map[string]bool
+	reads    int
+}
+
+func (r *routerMock) ReadNode(ctx context.Context, in *tree.ReadNodeRequest, opts ...client.CallOption) (*tree.ReadNodeResponse, error) {
+	r.reads++
+	if r.readable[in.Node.Uuid] {
+		return &tree.ReadNodeResponse{Node: &tree.Node{Uuid: in.Node.Uuid}}, nil
+	}
+	return nil, errors.NotFound("router", "node not found")
+}
+
+func TestSearchableRooms(t *testing.T) {
+
+	Convey("Test rooms searched when none is given", t, func() {
+		cl := &chatClientMock{rooms: []*chat.ChatRoom{
+			{Uuid: "global", Type: chat.RoomType_GLOBAL},
+			{Uuid: "ws-allowed", Type: chat.RoomType_WORKSPACE, RoomTypeObject: "ws1"},
+			{Uuid: "ws-denied", Type: chat.RoomType_WORKSPACE, RoomTypeObject: "ws2"},
+			{Uuid: "user-allowed", Type: chat.RoomType_USER, RoomTypeObject: "other", Users: []string{"other", "john"}},
+			{Uuid: "user-denied", Type: chat.RoomType_USER, RoomTypeObject: "other", Users: []string{"other", "jane"}},
+			{Uuid: "node-allowed", Type: chat.RoomType_NODE, RoomTypeObject: "node1"},
+			{Uuid: "node-denied", Type: chat.RoomType_NODE, RoomTypeObject: "node2"},
+		}}
+		router := &routerMock{HandlerMock: views.NewHandlerMock(), readable: map[string]bool{"node1": true}}
+		h := &ChatHandler{router: router, client: cl}
+		accessList := permissions.NewAccessList([]*idm.Role{})
+		accessList.Workspaces["ws1"] = &idm.Workspace{UUID: "ws1"}
+
+		rooms, err := h.searchableRooms(context.Background(), accessList, "john")
+		So(err, ShouldBeNil)
+		So(rooms, ShouldHaveLength, 4)
+		So(rooms, ShouldContain, "global")
+		So(rooms, ShouldContain, "ws-allowed")
+		So(rooms, ShouldContain, "user-allowed")
+		So(rooms, ShouldContain, "node-allowed")
+		So(rooms, ShouldNotContain, "ws-denied")
+		So(rooms, ShouldNotContain, "user-denied")
+		So(rooms, ShouldNotContain, "node-denied")
+
+		// Workspace rooms are listed from the access list, without reading nodes
+		for _, req := range cl.listRooms {
+			if req.ByType == chat.RoomType_WORKSPACE {
+				So(req.TypeObject, ShouldEqual, "ws1")
+			}
+		}
+		So(router.reads, ShouldEqual, 2)
+
+		rooms, err = h.searchableRooms(context.Background(), permissions.NewAccessList([]*idm.Role{}), "")
+		So(err, ShouldBeNil)
+		So(rooms, ShouldResemble, []string{"global", "node-allowed"})
+	})
+
+	Convey("Test all node rooms are checked", t, func() {
+		cl := &chatClientMock{}
+		readable := make(map[string]bool)
+		for i := 0; i < 250; i++ {
+			nodeId := fmt.Sprintf("node%d", i)
+			cl.rooms = append(cl.rooms, &chat.ChatRoom{Uuid: "room-" + nodeId, Type: chat.RoomType_NODE, RoomTypeObject: nodeId})
+			readable[nodeId] = true
+		}
+		router := &routerMock{HandlerMock: views.NewHandlerMock(), readable: readable}
+		h := &ChatHandler{router: router, client: cl}
+
+		rooms, err := h.searchableRooms(context.Background(), permissions.NewAccessList([]*idm.Role{}), "john")
+		So(err, ShouldBeNil)
+		So(rooms, ShouldHaveLength, 250)
+		So(router.reads, ShouldEqual, 250)
+	})
+
+}
+
+func TestCheckRoomAccess(t *testing.T) {
+
+	Convey("Test node and global rooms access", t, func() {
+		router := &routerMock{HandlerMock: views.NewHandlerMock(), readable: map[string]bool{"node1": true}}
+		h := &ChatHandler{router: router, client: &chatClientMock{}}
+		ctx := context.Background()
+
+		So(h.checkRoomAccess(ctx, &chat.ChatRoom{Type: chat.RoomType_NODE, RoomTypeObject: "node1"}), ShouldBeNil)
+		So(h.checkRoomAccess(ctx, &chat.ChatRoom{Type: chat.RoomType_NODE, RoomTypeObject: "node2"}), ShouldNotBeNil)
+		So(h.checkRoomAccess(ctx, &chat.ChatRoom{Type: chat.RoomType_GLOBAL}), ShouldBeNil)
+		// Without claims, users do not participate in any user room
+		So(h.checkRoomAccess(ctx, &chat.ChatRoom{Type: chat.RoomType_USER, Users: []string{"john"}}), ShouldNotBeNil)
+		So(h.checkRoomAccess(ctx, &chat.ChatRoom{Type: chat.RoomType_USER}), ShouldNotBeNil)
+	})
+
+}
+
+func TestExport(t *testing.T) {
+
+	Convey("Test room history rendering", t, func() {
+		cl := &chatClientMock{messages: []*chat.ChatMessage{
+			{Uuid: "m1", RoomUuid: "room", Author: "john", Message: "Hello\nWorld", Timestamp: 10, RepliesCount: 1},
+			{Uuid: "r1", RoomUuid: "room", ParentUuid: "m1", Author: "jane", Message: "Hi", Timestamp: 20, Edited: 30},
+			{Uuid: "m2", RoomUuid: "room", Author: "jane", Message: "See attached", Timestamp: 40, Attachments: []*chat.ChatAttachment{{Path: "ws/doc.pdf"}}},
+			{Uuid: "other", RoomUuid: "other-room", Author: "john", Message: "Private", Timestamp: 50},
+		}}
+		h := &ChatHandler{client: cl}
+
+		content, err := h.renderHistory(context.Background(), &chat.ChatRoom{Uuid: "room", RoomLabel: "Project"})
+		So(err, ShouldBeNil)
+		So(content, ShouldStartWith, "# Project\n")
+		So(content, ShouldContainSubstring, "Hello\nWorld\n")
+		So(content, ShouldContainSubstring, "> **jane**")
+		So(content, ShouldContainSubstring, "_edited_")
+		So(content, ShouldContainSubstring, "- ws/doc.pdf\n")
+		So(content, ShouldNotContainSubstring, "Private")
+		So(strings.Index(content, "Hello"), ShouldBeLessThan, strings.Index(content, "> Hi"))
+		So(strings.Index(content, "> Hi"), ShouldBeLessThan, strings.Index(content, "See attached"))
+	})
+
+	Convey("Test export folder", t, func() {
+		h := &ChatHandler{client: &chatClientMock{}}
+		ctx := context.Background()
+
+		_, err := h.exportFolder(ctx, &chat.ChatRoom{Type: chat.RoomType_USER, RoomTypeObject: "john"})
+		So(err, ShouldNotBeNil)
+		So(errors.Parse(err.Error()).Code, ShouldEqual, 400)
+
+		_, err = h.exportFolder(ctx, &chat.ChatRoom{Type: chat.RoomType_WORKSPACE, RoomTypeObject: "ws1"})
+		So(err, ShouldNotBeNil)
+		So(errors.Parse(err.Error()).Code, ShouldEqual, 403)
+	})
+
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package cmd
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/config"
+	"github.com/pydio/cells/idm/scim"
+)
+
+var (
+	scimTokenRevoke bool
+)
+
+// scimTokenCmd generates the bearer token of the SCIM provisioning endpoint
+var scimTokenCmd = &cobra.Command{
+	Use:   "scim-token",
+	Short: "Generate the SCIM provisioning token",
+	Long: `Generate a new bearer token for the SCIM 2.0 provisioning endpoint, replacing the previous one
+
+The endpoint is served under /scim/v2 and is disabled until a token is generated. The token
+is printed once: only its hash is stored in the configuration. Enter it in your identity provider
+(Okta, Azure AD...) along with the endpoint URL.
+
+Users and groups created by SCIM are marked with the "scim" auth source. To let them log in with a
+SAML connector, set services/pydio.web.scim/authSource to the name of that connector.
+
+EXAMPLE
+=======
+$ cells admin scim-token
+$ cells admin scim-token --revoke
+
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := common.SERVICE_WEB_NAMESPACE_ + common.SERVICE_SCIM
+		if scimTokenRevoke {
+			config.Set("", "services", name, "tokenHash")
+			if err := config.Save("cli", "Revoke SCIM token"); err != nil {
+				return err
+			}
+			fmt.Println("SCIM token revoked, the provisioning endpoint is disabled")
+			return nil
+		}
+
+		b := make([]byte, 32)
+		if _, err := rand.Read(b); err != nil {
+			return err
+		}
+		token := base64.RawURLEncoding.EncodeToString(b)
+		config.Set(scim.HashToken(token), "services", name, "tokenHash")
+		if err := config.Save("cli", "Generate SCIM token"); err != nil {
+			return err
+		}
+		fmt.Println("New SCIM token (it will not be displayed again):")
+		fmt.Println(token)
+		return nil
+	},
+}
+
+func init() {
+	scimTokenCmd.Flags().BoolVar(&scimTokenRevoke, "revoke", false, "Remove the current token and disable the endpoint")
+	adminCmd.AddCommand(scimTokenCmd)
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/auth/mfa"
+	"github.com/pydio/cells/common/micro"
+	"github.com/pydio/cells/common/proto/idm"
+)
+
+var (
+	userMfaResetLogin string
+)
+
+// userMfaResetCmd removes the second factor of a user
+var userMfaResetCmd = &cobra.Command{
+	Use:   "user-mfa-reset",
+	Short: "Reset User second factor",
+	Long: fmt.Sprintf(`Remove the second factors (TOTP secret, security keys and recovery codes) of a user
+
+This may be handy if a user has lost their authenticator device and recovery codes.
+They will be able to log in with their password only, and to enroll a new device.
+
+EXAMPLE
+=======
+$ cells admin user-mfa-reset -u LOGIN
+
+`),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if userMfaResetLogin == "" {
+			cmd.Usage()
+			return fmt.Errorf("Missing arguments")
+		}
+		return nil
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		client := idm.NewUserServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_USER, defaults.NewClient())
+
+		users, err := searchUser(context.Background(), client, userMfaResetLogin)
+		if err != nil {
+			fmt.Printf("Cannot list users for login %s: %s\n", userMfaResetLogin, err.Error())
+		}
+
+		for _, user := range users {
+
+			if _, ok := user.Attributes[idm.UserAttrMfa]; !ok {
+				fmt.Printf("User %s has no second factor\n", user.Login)
+				break
+			}
+			mfa.ResetUser(user)
+			if _, err := client.CreateUser(context.Background(), &idm.CreateUserRequest{
+				User: user,
+			}); err != nil {
+				fmt.Printf("could not update user [%s], skipping.\n Error message: %s", user.Login, err.Error())
+				log.Println(err)
+			} else {
+				fmt.Printf("Successfully removed second factor for user %s\n", user.Login)
+			}
+			break
+		}
+	},
+}
+
+func init() {
+	userMfaResetCmd.Flags().StringVarP(&userMfaResetLogin, "username", "u", "", "Login of the user to update")
+	adminCmd.AddCommand(userMfaResetCmd)
+}
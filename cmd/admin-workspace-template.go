@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pydio/cells/common/proto/rest"
+	"github.com/pydio/cells/idm/workspace/templates"
+)
+
+var (
+	wsTemplateUuid   string
+	wsTemplateUsers  []string
+	wsTemplateGroups []string
+	wsTemplateVars   []string
+	wsTemplateSync   bool
+)
+
+// wsTemplateCmd provisions workspaces from a workspace template
+var wsTemplateCmd = &cobra.Command{
+	Use:   "workspace-template",
+	Short: "Provision workspaces from a template",
+	Long: `Create or update the workspaces of a template for a list of users or groups
+
+Templates are managed with the /workspace/templates REST API. Their label, description, slug, root
+paths and ACL roles may contain variables like {{.Department}}: they are read from the attributes of
+the target user or group (first letter uppercased), or passed with --var. {{.Uuid}}, {{.Login}},
+{{.GroupPath}} and {{.GroupLabel}} are also available.
+
+Running the command again for the same target updates its workspace. Use --sync to re-apply the
+template to all the workspaces already created from it.
+
+EXAMPLE
+=======
+$ cells admin workspace-template -t departments -g /sales -g /marketing
+$ cells admin workspace-template -t homes -u alice -u bob --var Quota=10G
+$ cells admin workspace-template -t departments --sync
+
+`,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if wsTemplateUuid == "" || (!wsTemplateSync && len(wsTemplateUsers) == 0 && len(wsTemplateGroups) == 0) {
+			cmd.Usage()
+			return fmt.Errorf("Missing arguments")
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		tpl, err := templates.LoadTemplate(ctx, wsTemplateUuid)
+		if err != nil {
+			return err
+		}
+
+		var response *rest.WorkspaceTemplateResponse
+		if wsTemplateSync {
+			response, err = templates.Sync(ctx, tpl)
+		} else {
+			vars := make(map[string]string)
+			for _, v := range wsTemplateVars {
+				parts := strings.SplitN(v, "=", 2)
+				if len(parts) != 2 {
+					return fmt.Errorf("invalid variable %s, use Key=Value", v)
+				}
+				vars[parts[0]] = parts[1]
+			}
+			var targets []*rest.WorkspaceTemplateTarget
+			for _, login := range wsTemplateUsers {
+				targets = append(targets, &rest.WorkspaceTemplateTarget{UserLogin: login, Variables: vars})
+			}
+			for _, group := range wsTemplateGroups {
+				targets = append(targets, &rest.WorkspaceTemplateTarget{GroupPath: group, Variables: vars})
+			}
+			response, err = templates.Instantiate(ctx, tpl, targets)
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, ws := range response.Instances {
+			fmt.Printf("Provisioned workspace %s (%s)\n", ws.Slug, ws.Label)
+		}
+		for _, e := range response.Errors {
+			fmt.Printf("Error: %s\n", e)
+		}
+		return nil
+	},
+}
+
+func init() {
+	wsTemplateCmd.Flags().StringVarP(&wsTemplateUuid, "template", "t", "", "Uuid of the template")
+	wsTemplateCmd.Flags().StringArrayVarP(&wsTemplateUsers, "user", "u", []string{}, "Login of a target user, can be repeated")
+	wsTemplateCmd.Flags().StringArrayVarP(&wsTemplateGroups, "group", "g", []string{}, "Full path of a target group, can be repeated")
+	wsTemplateCmd.Flags().StringArrayVar(&wsTemplateVars, "var", []string{}, "Variable passed to all targets as Key=Value, can be repeated")
+	wsTemplateCmd.Flags().BoolVar(&wsTemplateSync, "sync", false, "Re-apply the template to all its existing workspaces")
+	adminCmd.AddCommand(wsTemplateCmd)
+}
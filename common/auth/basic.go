@@ -22,18 +22,32 @@ package auth
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"sync"
 	"time"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/micro/go-micro/broker"
 	"github.com/micro/go-micro/metadata"
 
 	"github.com/pydio/cells/common"
 	"github.com/pydio/cells/common/auth/claim"
+	"github.com/pydio/cells/common/micro"
+	"github.com/pydio/cells/common/proto/auth"
 )
 
 func NewBasicAuthenticator(realm string, ttl time.Duration) *BasicAuthenticator {
 	ba := &BasicAuthenticator{}
 	ba.cache = make(map[string]*validBasicUser)
+	ba.cacheLock = &sync.Mutex{}
+	defaults.Broker().Subscribe(common.TOPIC_SESSION_EVENT, func(publication broker.Publication) error {
+		var event auth.SessionEvent
+		if e := proto.Unmarshal(publication.Message().Body, &event); e == nil {
+			ba.forgetSessions(&event)
+		}
+		return nil
+	})
 	return ba
 }
 
@@ -44,9 +58,45 @@ type validBasicUser struct {
 }
 
 type BasicAuthenticator struct {
-	TTL   time.Duration
-	Realm string
-	cache map[string]*validBasicUser
+	TTL       time.Duration
+	Realm     string
+	cache     map[string]*validBasicUser
+	cacheLock *sync.Mutex
+}
+
+// forgetSessions removes the cached credentials that were validated by a revoked session or personal access token.
+func (b *BasicAuthenticator) forgetSessions(event *auth.SessionEvent) {
+	revoked := make(map[string]struct{}, len(event.Revoked)+len(event.RevokedTokens))
+	for _, s := range event.Revoked {
+		revoked[s.Uuid] = struct{}{}
+	}
+	for _, t := range event.RevokedTokens {
+		revoked[t] = struct{}{}
+	}
+	b.cacheLock.Lock()
+	defer b.cacheLock.Unlock()
+	for user, valid := range b.cache {
+		id := valid.Claims.PersonalAccessToken
+		if id == "" {
+			id = SessionUuid(valid.Claims)
+		}
+		if _, ok := revoked[id]; ok {
+			delete(b.cache, user)
+		}
+	}
+}
+
+func (b *BasicAuthenticator) forget(user string) {
+	b.cacheLock.Lock()
+	defer b.cacheLock.Unlock()
+	delete(b.cache, user)
+}
+
+func (b *BasicAuthenticator) cached(user string) (*validBasicUser, bool) {
+	b.cacheLock.Lock()
+	defer b.cacheLock.Unlock()
+	valid, ok := b.cache[user]
+	return valid, ok
 }
 
 func (b *BasicAuthenticator) Wrap(handler http.Handler) http.HandlerFunc {
@@ -57,7 +107,22 @@ func (b *BasicAuthenticator) Wrap(handler http.Handler) http.HandlerFunc {
 
 			ctx := r.Context()
 
-			if valid, vOk := b.cache[user]; vOk && time.Now().Sub(valid.Connexion) <= time.Duration(time.Minute*10) && valid.Hash == pass {
+			jwtHelper := DefaultJWTVerifier()
+
+			if valid, vOk := b.cached(user); vOk && time.Now().Sub(valid.Connexion) <= time.Duration(time.Minute*10) && valid.Hash == pass {
+
+				claims := valid.Claims
+				if claims.PersonalAccessToken != "" {
+					// Personal access tokens may be revoked or expire at any time: check them again
+					var err error
+					if ctx, claims, err = jwtHelper.Verify(ctx, pass); err != nil || claims.Name != user {
+						b.forget(user)
+						w.Header().Set("WWW-Authenticate", `Basic realm="`+b.Realm+`"`)
+						w.WriteHeader(401)
+						w.Write([]byte("Unauthorized.\n"))
+						return
+					}
+				}
 
 				md := map[string]string{}
 				if meta, ok := metadata.FromContext(ctx); ok {
@@ -65,26 +130,41 @@ func (b *BasicAuthenticator) Wrap(handler http.Handler) http.HandlerFunc {
 						md[k] = v
 					}
 				}
-				md[common.PYDIO_CONTEXT_USER_KEY] = valid.Claims.Name
+				md[common.PYDIO_CONTEXT_USER_KEY] = claims.Name
 				ctx = metadata.NewContext(ctx, md)
 
-				r = r.WithContext(context.WithValue(ctx, claim.ContextKey, valid.Claims))
+				r = r.WithContext(context.WithValue(ctx, claim.ContextKey, claims))
 
-				valid.Connexion = time.Now()
+				if claims.PersonalAccessToken == "" {
+					valid.Connexion = time.Now()
+				}
 				handler.ServeHTTP(w, r)
 				return
 			}
 
-			jwtHelper := DefaultJWTVerifier()
-			newCtx, claims, err := jwtHelper.PasswordCredentialsToken(ctx, user, pass)
+			var newCtx context.Context
+			var claims claim.Claims
+			var err error
+			if IsPersonalAccessToken(pass) {
+				// Personal access tokens are accepted as password, for their owner only
+				newCtx, claims, err = jwtHelper.Verify(ctx, pass)
+				if err == nil && claims.Name != user {
+					err = fmt.Errorf("token does not belong to user %s", user)
+				}
+			} else {
+				newCtx, claims, err = jwtHelper.PasswordCredentialsToken(ctx, user, pass)
+			}
 			if err == nil {
 				r = r.WithContext(newCtx)
+				b.cacheLock.Lock()
 				b.cache[user] = &validBasicUser{
 					Hash:      pass,
 					Connexion: time.Now(),
 					Claims:    claims,
 				}
+				b.cacheLock.Unlock()
 				handler.ServeHTTP(w, r)
+				return
 			}
 		}
 
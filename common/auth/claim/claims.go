@@ -56,6 +56,17 @@ type Claims struct {
 	AuthSource  string    `json:"authSource"`
 	DisplayName string    `json:"displayName"`
 	GroupPath   string    `json:"groupPath"`
+
+	// Set when the user logged in with a second factor
+	MfaAuthenticated bool `json:"mfa,omitempty"`
+	// Set when a second factor is enforced but not enrolled yet: the session only allows to enroll one
+	MfaEnrollOnly bool `json:"mfaEnroll,omitempty"`
+
+	// Set when authenticated with a personal access token, restricting its scope
+	PersonalAccessToken string   `json:"pat,omitempty"`
+	ScopeWorkspaces     []string `json:"scopeWorkspaces,omitempty"`
+	ScopeReadOnly       bool     `json:"scopeReadOnly,omitempty"`
+	ScopeRestResources  []string `json:"scopeRestResources,omitempty"`
 }
 
 // Decode Subject field of the claims
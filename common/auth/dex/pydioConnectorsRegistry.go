@@ -37,6 +37,7 @@ var (
 
 func init() {
 	RegisterDexPydioConnector("pydio-api", func() PydioConnectorConfig { return new(ApiConfig) })
+	RegisterDexPydioConnector("saml", func() PydioConnectorConfig { return new(SAMLConfig) })
 }
 
 func RegisterDexPydioConnector(name string, configProvider func() PydioConnectorConfig) {
@@ -0,0 +1,388 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package dex
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/beevik/etree"
+	"github.com/coreos/dex/connector"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/russellhaering/goxmldsig/etreeutils"
+	"github.com/sirupsen/logrus"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/auth"
+	"github.com/pydio/cells/common/micro"
+	proto "github.com/pydio/cells/common/proto/auth"
+	"github.com/pydio/cells/common/proto/idm"
+	service "github.com/pydio/cells/common/service/proto"
+	"github.com/pydio/cells/common/utils/permissions"
+)
+
+// SAMLConfig configures a SAML 2.0 service provider connector using the HTTP POST binding.
+// MappingRules map assertion attributes (LeftAttribute) to Cells user attributes (RightAttribute),
+// using the same semantics as the LDAP mapping: the reserved auth.MappingRoles and auth.MappingGroupPath
+// right attributes respectively assign roles and the user group.
+type SAMLConfig struct {
+	// EntityIssuer is the issuer of the authentication requests and the expected audience of assertions.
+	// It defaults to RedirectURI.
+	EntityIssuer string `json:"entityIssuer"`
+	// SSOIssuer is the expected issuer of responses, it is not checked if empty.
+	SSOIssuer string `json:"ssoIssuer"`
+	// SSOURL is the IdP endpoint receiving authentication requests.
+	SSOURL string `json:"ssoURL"`
+	// RedirectURI is the dex callback URL, ending with /callback.
+	RedirectURI string `json:"redirectURI"`
+
+	// CA is a path to a PEM file, CAData the PEM content, of the certificates signing assertions.
+	CA     string `json:"ca"`
+	CAData string `json:"caData"`
+
+	NameIDPolicyFormat string `json:"nameIDPolicyFormat"`
+	// UsernameAttr is the attribute holding the user login, the NameID is used if empty.
+	UsernameAttr string               `json:"usernameAttr"`
+	MappingRules []*proto.LdapMapping `json:"mappingRules"`
+}
+
+// Open checks the configuration and returns a connector implementing connector.SAMLConnector.
+func (c *SAMLConfig) Open(logger logrus.FieldLogger) (connector.Connector, error) {
+	return c.openConnector(logger)
+}
+
+func (c *SAMLConfig) openConnector(logger logrus.FieldLogger) (*pydioSAMLConnector, error) {
+
+	if c.SSOURL == "" || c.RedirectURI == "" {
+		return nil, fmt.Errorf("saml: ssoURL and redirectURI are required")
+	}
+	data := []byte(c.CAData)
+	if c.CA != "" {
+		var err error
+		if data, err = ioutil.ReadFile(c.CA); err != nil {
+			return nil, fmt.Errorf("saml: read ca file: %v", err)
+		}
+	}
+	var roots []*x509.Certificate
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("saml: parse ca certificate: %v", err)
+		}
+		roots = append(roots, cert)
+	}
+	if len(roots) == 0 {
+		return nil, fmt.Errorf("saml: ca or caData must contain at least one certificate")
+	}
+	nameIDPolicyFormat := c.NameIDPolicyFormat
+	if nameIDPolicyFormat == "" {
+		nameIDPolicyFormat = samlNameIDUnspecified
+	}
+	audience := c.EntityIssuer
+	if audience == "" {
+		audience = c.RedirectURI
+	}
+
+	p := &pydioSAMLConnector{
+		SAMLConfig:         *c,
+		logger:             logger,
+		validator:          dsig.NewDefaultValidationContext(&dsig.MemoryX509CertificateStore{Roots: roots}),
+		nameIDPolicyFormat: nameIDPolicyFormat,
+		audience:           audience,
+		now:                time.Now,
+	}
+	p.provision = p.provisionUser
+	return p, nil
+}
+
+type pydioSAMLConnector struct {
+	SAMLConfig
+	logger             logrus.FieldLogger
+	validator          *dsig.ValidationContext
+	nameIDPolicyFormat string
+	audience           string
+	now                func() time.Time
+	// provision creates or updates the Cells user matching a verified assertion.
+	provision func(ctx context.Context, ext *externalUser) (*idm.User, error)
+	// authSource is the name of the wrapping connector, stored in the user AuthSource attribute.
+	authSource string
+}
+
+var (
+	_ connector.SAMLConnector = (*pydioSAMLConnector)(nil)
+)
+
+// externalUser is the result of the mapping rules applied to a verified assertion.
+type externalUser struct {
+	Login string
+	*auth.MappedUser
+}
+
+// POSTData returns an AuthnRequest for the HTTP POST binding.
+func (p *pydioSAMLConnector) POSTData(s connector.Scopes, requestID string) (string, string, error) {
+
+	r := &samlAuthnRequest{
+		ID:                          requestID,
+		Version:                     "2.0",
+		IssueInstant:                samlTime(p.now()),
+		Destination:                 p.SSOURL,
+		ProtocolBinding:             samlBindingPOST,
+		AssertionConsumerServiceURL: p.RedirectURI,
+		NameIDPolicy:                &samlNameIDPolicy{AllowCreate: true, Format: p.nameIDPolicyFormat},
+	}
+	if p.EntityIssuer != "" {
+		r.Issuer = &samlIssuer{Value: p.EntityIssuer}
+	}
+	data, err := xml.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return "", "", fmt.Errorf("saml: marshal authn request: %v", err)
+	}
+	return p.SSOURL, base64.StdEncoding.EncodeToString(data), nil
+}
+
+// HandlePOST verifies the signed SAML response, maps its attributes and provisions the corresponding Cells user.
+func (p *pydioSAMLConnector) HandlePOST(s connector.Scopes, samlResponse, inResponseTo string) (connector.Identity, error) {
+
+	ext, err := p.parseResponse(samlResponse, inResponseTo)
+	if err != nil {
+		return connector.Identity{}, err
+	}
+	user, err := p.provision(context.Background(), ext)
+	if err != nil {
+		return connector.Identity{}, err
+	}
+	return ConvertUserApiToIdentity(user, p.authSource), nil
+}
+
+// parseResponse decodes and validates a response, following the checks of the Web Browser SSO profile.
+func (p *pydioSAMLConnector) parseResponse(encoded, inResponseTo string) (*externalUser, error) {
+
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("saml: decode response: %v", err)
+	}
+	signed, rootVerified, err := p.verifySignature(raw)
+	if err != nil {
+		return nil, fmt.Errorf("saml: verify signature: %v", err)
+	}
+	var resp samlResponse
+	if err := xml.Unmarshal(signed, &resp); err != nil {
+		return nil, fmt.Errorf("saml: unmarshal response: %v", err)
+	}
+	// Elements outside of the assertion can only be trusted if the root is signed
+	if rootVerified {
+		if p.SSOIssuer != "" && resp.Issuer != nil && resp.Issuer.Value != p.SSOIssuer {
+			return nil, fmt.Errorf("saml: unexpected response issuer %q", resp.Issuer.Value)
+		}
+		if resp.InResponseTo != inResponseTo {
+			return nil, fmt.Errorf("saml: response is not related to request %s", inResponseTo)
+		}
+		if resp.Destination != "" && resp.Destination != p.RedirectURI {
+			return nil, fmt.Errorf("saml: unexpected response destination %q", resp.Destination)
+		}
+		if resp.Status == nil || resp.Status.StatusCode == nil {
+			return nil, fmt.Errorf("saml: response has no status")
+		}
+		if resp.Status.StatusCode.Value != samlStatusSuccess {
+			return nil, fmt.Errorf("saml: authentication failed with status %q %s", resp.Status.StatusCode.Value, resp.Status.StatusMessage)
+		}
+	}
+
+	assertion := resp.Assertion
+	if assertion == nil || assertion.Subject == nil {
+		return nil, fmt.Errorf("saml: response has no assertion subject")
+	}
+	if p.SSOIssuer != "" && (assertion.Issuer == nil || assertion.Issuer.Value != p.SSOIssuer) {
+		return nil, fmt.Errorf("saml: unexpected assertion issuer")
+	}
+	if err := p.validateSubject(assertion.Subject, inResponseTo); err != nil {
+		return nil, err
+	}
+	if err := p.validateConditions(assertion.Conditions); err != nil {
+		return nil, err
+	}
+
+	nameID := ""
+	if assertion.Subject.NameID != nil {
+		nameID = strings.TrimSpace(assertion.Subject.NameID.Value)
+	}
+	return p.mapAttributes(nameID, assertion.AttributeStatement.values())
+}
+
+// verifySignature checks the signature of the response root or, failing that, of its assertion. In the latter case,
+// all other children of the root are dropped as they cannot be trusted.
+func (p *pydioSAMLConnector) verifySignature(data []byte) ([]byte, bool, error) {
+
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(data); err != nil {
+		return nil, false, err
+	}
+	root := doc.Root()
+	if root == nil {
+		return nil, false, fmt.Errorf("empty document")
+	}
+	if transformed, err := p.validator.Validate(root); err == nil {
+		doc.SetRoot(transformed)
+		signed, err := doc.WriteToBytes()
+		return signed, true, err
+	}
+	assertion, err := etreeutils.NSSelectOne(root, samlNamespaceAssertion, "Assertion")
+	if err != nil || assertion == nil {
+		return nil, false, fmt.Errorf("response is not signed and has no assertion")
+	}
+	transformed, err := p.validator.Validate(assertion)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, el := range root.ChildElements() {
+		root.RemoveChild(el)
+	}
+	root.AddChild(transformed)
+	signed, err := doc.WriteToBytes()
+	return signed, false, err
+}
+
+// validateSubject requires one bearer confirmation issued for this request and this service provider.
+func (p *pydioSAMLConnector) validateSubject(subject *samlSubject, inResponseTo string) error {
+
+	now := p.now()
+	for _, c := range subject.SubjectConfirmations {
+		if c.Method != samlConfirmationBearer || c.Data == nil || c.Data.InResponseTo != inResponseTo {
+			continue
+		}
+		if c.Data.Recipient != "" && c.Data.Recipient != p.RedirectURI {
+			continue
+		}
+		if !samlTimeValid(now, c.Data.NotBefore, c.Data.NotOnOrAfter) {
+			continue
+		}
+		return nil
+	}
+	return fmt.Errorf("saml: no valid bearer subject confirmation for request %s", inResponseTo)
+}
+
+// validateConditions checks the assertion validity period and that this service provider is in every audience restriction.
+func (p *pydioSAMLConnector) validateConditions(conditions *samlConditions) error {
+
+	if conditions == nil {
+		return nil
+	}
+	if !samlTimeValid(p.now(), conditions.NotBefore, conditions.NotOnOrAfter) {
+		return fmt.Errorf("saml: assertion is expired or not yet valid")
+	}
+	for _, restriction := range conditions.AudienceRestrictions {
+		found := false
+		for _, a := range restriction.Audiences {
+			if strings.TrimSpace(a) == p.audience {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("saml: assertion audience does not contain %s", p.audience)
+		}
+	}
+	return nil
+}
+
+func samlTimeValid(now time.Time, notBefore, notOnOrAfter samlTime) bool {
+	if nb := time.Time(notBefore); !nb.IsZero() && now.Add(samlAllowedClockDrift).Before(nb) {
+		return false
+	}
+	if na := time.Time(notOnOrAfter); !na.IsZero() && !now.Before(na.Add(samlAllowedClockDrift)) {
+		return false
+	}
+	return true
+}
+
+// mapAttributes applies the mapping rules to the assertion attributes.
+func (p *pydioSAMLConnector) mapAttributes(nameID string, values map[string][]string) (*externalUser, error) {
+
+	login := nameID
+	if p.UsernameAttr != "" {
+		login = ""
+		if v := values[p.UsernameAttr]; len(v) > 0 {
+			login = strings.TrimSpace(v[0])
+		}
+	}
+	if login == "" {
+		return nil, fmt.Errorf("saml: assertion does not contain a username")
+	}
+	mapped, err := auth.ApplyMappingRules(p.MappingRules, func(attribute string) []string {
+		return values[attribute]
+	})
+	if err != nil {
+		return nil, fmt.Errorf("saml: %v", err)
+	}
+	return &externalUser{Login: login, MappedUser: mapped}, nil
+}
+
+// provisionUser creates the user on first login, or updates it with the mapped attributes, group and roles
+// (see auth.MappedUser.ApplyTo). Local users are never taken over.
+func (p *pydioSAMLConnector) provisionUser(ctx context.Context, ext *externalUser) (*idm.User, error) {
+
+	userCli := idm.NewUserServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_USER, defaults.NewClient())
+	roleCli := idm.NewRoleServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_ROLE, defaults.NewClient())
+	builder := service.NewResourcePoliciesBuilder()
+
+	user, _ := permissions.SearchUniqueUser(ctx, "", "", &idm.UserSingleQuery{Login: ext.Login})
+	create := user == nil
+	if create {
+		user = &idm.User{
+			Login:      ext.Login,
+			GroupPath:  "/",
+			Attributes: map[string]string{idm.UserAttrProfile: common.PYDIO_PROFILE_STANDARD},
+			Policies:   builder.WithStandardUserPolicies(ext.Login).Policies(),
+		}
+	} else if user.Attributes[idm.UserAttrAuthSource] != p.authSource {
+		return nil, fmt.Errorf("saml: user %s already exists and is not managed by %s", ext.Login, p.authSource)
+	}
+	if err := ext.ApplyTo(ctx, user); err != nil {
+		return nil, err
+	}
+	user.Attributes[idm.UserAttrAuthSource] = p.authSource
+
+	resp, err := userCli.CreateUser(ctx, &idm.CreateUserRequest{User: user})
+	if err != nil {
+		return nil, err
+	}
+	if create {
+		if _, err := roleCli.CreateRole(ctx, &idm.CreateRoleRequest{Role: &idm.Role{
+			Uuid:     resp.User.Uuid,
+			Label:    "User " + resp.User.Login + " role",
+			UserRole: true,
+			Policies: resp.User.Policies,
+		}}); err != nil {
+			return nil, err
+		}
+	}
+	return resp.User, nil
+}
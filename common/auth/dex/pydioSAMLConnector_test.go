@@ -0,0 +1,241 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package dex
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/pem"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/beevik/etree"
+	"github.com/coreos/dex/connector"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/sirupsen/logrus"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common/auth"
+	proto "github.com/pydio/cells/common/proto/auth"
+	"github.com/pydio/cells/common/proto/idm"
+)
+
+const (
+	testSAMLRedirect = "https://cells.example.com/auth/dex/callback"
+	testSAMLIssuer   = "https://idp.example.com/metadata"
+)
+
+// testIdP is a local identity provider stand-in, signing responses with a random key.
+type testIdP struct {
+	keyStore     dsig.X509KeyStore
+	inResponseTo string
+	audience     string
+	recipient    string
+	notOnOrAfter time.Time
+	signRoot     bool
+}
+
+func newTestIdP() *testIdP {
+	return &testIdP{
+		keyStore:     dsig.RandomKeyStoreForTest(),
+		inResponseTo: "request-id",
+		audience:     testSAMLRedirect,
+		recipient:    testSAMLRedirect,
+		notOnOrAfter: time.Now().Add(5 * time.Minute),
+		signRoot:     true,
+	}
+}
+
+func (i *testIdP) caData() string {
+	_, cert, _ := i.keyStore.GetKeyPair()
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert}))
+}
+
+// response returns a base64 encoded signed response, tamper is applied to the signed XML.
+func (i *testIdP) response(tamper func(string) string) string {
+	until := i.notOnOrAfter.UTC().Format(samlTimeFormat)
+	data := fmt.Sprintf(`<samlp:Response xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="_resp" Version="2.0" InResponseTo="%[1]s" Destination="%[5]s">
+<saml:Issuer>%[2]s</saml:Issuer>
+<samlp:Status><samlp:StatusCode Value="urn:oasis:names:tc:SAML:2.0:status:Success"/></samlp:Status>
+<saml:Assertion xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="_assertion" Version="2.0">
+<saml:Issuer>%[2]s</saml:Issuer>
+<saml:Subject>
+<saml:NameID>john-nameid</saml:NameID>
+<saml:SubjectConfirmation Method="urn:oasis:names:tc:SAML:2.0:cm:bearer"><saml:SubjectConfirmationData InResponseTo="%[1]s" Recipient="%[3]s" NotOnOrAfter="%[4]s"/></saml:SubjectConfirmation>
+</saml:Subject>
+<saml:Conditions NotOnOrAfter="%[4]s"><saml:AudienceRestriction><saml:Audience>%[6]s</saml:Audience></saml:AudienceRestriction></saml:Conditions>
+<saml:AttributeStatement>
+<saml:Attribute Name="uid"><saml:AttributeValue>john</saml:AttributeValue></saml:Attribute>
+<saml:Attribute Name="mail"><saml:AttributeValue>john@example.com</saml:AttributeValue></saml:Attribute>
+<saml:Attribute Name="memberOf"><saml:AttributeValue>cn=teachers,ou=groups,dc=example,dc=com</saml:AttributeValue><saml:AttributeValue>cn=students,ou=groups,dc=example,dc=com</saml:AttributeValue><saml:AttributeValue>cn=admins,ou=groups,dc=example,dc=com</saml:AttributeValue></saml:Attribute>
+<saml:Attribute Name="department"><saml:AttributeValue>/sales/</saml:AttributeValue></saml:Attribute>
+</saml:AttributeStatement>
+</saml:Assertion>
+</samlp:Response>`, i.inResponseTo, testSAMLIssuer, i.recipient, until, testSAMLRedirect, i.audience)
+
+	doc := etree.NewDocument()
+	So(doc.ReadFromString(data), ShouldBeNil)
+	signer := dsig.NewDefaultSigningContext(i.keyStore)
+	// Exclusive canonicalization, as used by IdPs, does not depend on namespaces declared by parents
+	signer.Canonicalizer = dsig.MakeC14N10ExclusiveCanonicalizerWithPrefixList("")
+	if i.signRoot {
+		signed, err := signer.SignEnveloped(doc.Root())
+		So(err, ShouldBeNil)
+		doc.SetRoot(signed)
+	} else {
+		root := doc.Root()
+		assertion := root.FindElement("./Assertion")
+		signed, err := signer.SignEnveloped(assertion)
+		So(err, ShouldBeNil)
+		root.RemoveChild(assertion)
+		root.AddChild(signed)
+	}
+	out, err := doc.WriteToString()
+	So(err, ShouldBeNil)
+	if tamper != nil {
+		out = tamper(out)
+	}
+	return base64.StdEncoding.EncodeToString([]byte(out))
+}
+
+func testSAMLConnector(idp *testIdP) (*pydioSAMLConnector, *[]*externalUser) {
+	c := &SAMLConfig{
+		SSOIssuer:    testSAMLIssuer,
+		SSOURL:       "https://idp.example.com/sso",
+		RedirectURI:  testSAMLRedirect,
+		CAData:       idp.caData(),
+		UsernameAttr: "uid",
+		MappingRules: []*proto.LdapMapping{
+			{LeftAttribute: "mail", RightAttribute: "email"},
+			{LeftAttribute: "memberOf", RightAttribute: auth.MappingRoles, RuleString: "teachers,students", RolePrefix: "saml_"},
+			{LeftAttribute: "department", RightAttribute: auth.MappingGroupPath},
+		},
+	}
+	conn, err := c.openConnector(logrus.New())
+	So(err, ShouldBeNil)
+	conn.authSource = "idp"
+	var provisioned []*externalUser
+	conn.provision = func(ctx context.Context, ext *externalUser) (*idm.User, error) {
+		provisioned = append(provisioned, ext)
+		return &idm.User{Uuid: "user-uuid", Login: ext.Login, Attributes: ext.Attributes}, nil
+	}
+	return conn, &provisioned
+}
+
+func TestSAMLConnector(t *testing.T) {
+
+	Convey("Test authentication request", t, func() {
+		conn, _ := testSAMLConnector(newTestIdP())
+		url, request, err := conn.POSTData(connector.Scopes{}, "request-id")
+		So(err, ShouldBeNil)
+		So(url, ShouldEqual, "https://idp.example.com/sso")
+		data, err := base64.StdEncoding.DecodeString(request)
+		So(err, ShouldBeNil)
+		var r struct {
+			XMLName xml.Name
+			ID      string `xml:"ID,attr"`
+			ACS     string `xml:"AssertionConsumerServiceURL,attr"`
+		}
+		So(xml.Unmarshal(data, &r), ShouldBeNil)
+		So(r.XMLName.Local, ShouldEqual, "AuthnRequest")
+		So(r.ID, ShouldEqual, "request-id")
+		So(r.ACS, ShouldEqual, testSAMLRedirect)
+
+		_, err = (&SAMLConfig{SSOURL: "https://idp.example.com/sso", RedirectURI: testSAMLRedirect}).Open(logrus.New())
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("Test signed responses are mapped and provisioned", t, func() {
+		idp := newTestIdP()
+		conn, provisioned := testSAMLConnector(idp)
+
+		ident, err := conn.HandlePOST(connector.Scopes{}, idp.response(nil), "request-id")
+		So(err, ShouldBeNil)
+		So(ident.Username, ShouldEqual, "john")
+		So(ident.Email, ShouldEqual, "john@example.com")
+		So(*provisioned, ShouldHaveLength, 1)
+		ext := (*provisioned)[0]
+		So(ext.Roles, ShouldResemble, []string{"saml_teachers", "saml_students"})
+		So(ext.RolePrefixes, ShouldResemble, []string{"saml_"})
+		So(ext.GroupPath, ShouldEqual, "/sales")
+
+		// Only the assertion is signed
+		idp.signRoot = false
+		ident, err = conn.HandlePOST(connector.Scopes{}, idp.response(nil), "request-id")
+		So(err, ShouldBeNil)
+		So(ident.Username, ShouldEqual, "john")
+
+		// NameID is used without username attribute
+		conn.UsernameAttr = ""
+		ident, err = conn.HandlePOST(connector.Scopes{}, idp.response(nil), "request-id")
+		So(err, ShouldBeNil)
+		So(ident.Username, ShouldEqual, "john-nameid")
+	})
+
+	Convey("Test invalid responses are rejected", t, func() {
+		idp := newTestIdP()
+		conn, provisioned := testSAMLConnector(idp)
+
+		// Tampered
+		_, err := conn.HandlePOST(connector.Scopes{}, idp.response(func(s string) string {
+			return strings.Replace(s, "cn=students", "cn=admins", 1)
+		}), "request-id")
+		So(err, ShouldNotBeNil)
+
+		// Signed by another key
+		other := newTestIdP()
+		_, err = conn.HandlePOST(connector.Scopes{}, other.response(nil), "request-id")
+		So(err, ShouldNotBeNil)
+
+		// Other request
+		_, err = conn.HandlePOST(connector.Scopes{}, idp.response(nil), "other-request-id")
+		So(err, ShouldNotBeNil)
+
+		// Expired
+		idp.notOnOrAfter = time.Now().Add(-time.Minute)
+		_, err = conn.HandlePOST(connector.Scopes{}, idp.response(nil), "request-id")
+		So(err, ShouldNotBeNil)
+
+		// Other service provider
+		idp = newTestIdP()
+		conn, provisioned = testSAMLConnector(idp)
+		idp.audience = "https://other.example.com"
+		_, err = conn.HandlePOST(connector.Scopes{}, idp.response(nil), "request-id")
+		So(err, ShouldNotBeNil)
+		idp.audience = testSAMLRedirect
+		idp.recipient = "https://other.example.com"
+		_, err = conn.HandlePOST(connector.Scopes{}, idp.response(nil), "request-id")
+		So(err, ShouldNotBeNil)
+
+		// Reserved attribute
+		idp = newTestIdP()
+		conn, provisioned = testSAMLConnector(idp)
+		conn.MappingRules = append(conn.MappingRules, &proto.LdapMapping{LeftAttribute: "mail", RightAttribute: idm.UserAttrAuthSource})
+		_, err = conn.HandlePOST(connector.Scopes{}, idp.response(nil), "request-id")
+		So(err, ShouldNotBeNil)
+
+		So(*provisioned, ShouldBeEmpty)
+	})
+
+}
@@ -0,0 +1,162 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package dex
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// XML structures of the SAML 2.0 messages used by the SAML connector,
+// see https://docs.oasis-open.org/security/saml/v2.0/saml-core-2.0-os.pdf
+
+const (
+	samlBindingPOST        = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+	samlStatusSuccess      = "urn:oasis:names:tc:SAML:2.0:status:Success"
+	samlConfirmationBearer = "urn:oasis:names:tc:SAML:2.0:cm:bearer"
+	samlNameIDUnspecified  = "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified"
+	samlNamespaceAssertion = "urn:oasis:names:tc:SAML:2.0:assertion"
+	samlTimeFormat         = "2006-01-02T15:04:05Z"
+	samlAllowedClockDrift  = 30 * time.Second
+)
+
+type samlTime time.Time
+
+func (t samlTime) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: time.Time(t).UTC().Format(samlTimeFormat)}, nil
+}
+
+// UnmarshalXMLAttr accepts times with or without fractional seconds, as IdPs send both.
+func (t *samlTime) UnmarshalXMLAttr(attr xml.Attr) error {
+	got, err := time.Parse(time.RFC3339Nano, attr.Value)
+	if err != nil {
+		return err
+	}
+	*t = samlTime(got)
+	return nil
+}
+
+type samlAuthnRequest struct {
+	XMLName xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:protocol AuthnRequest"`
+
+	ID                          string            `xml:"ID,attr"`
+	Version                     string            `xml:"Version,attr"`
+	IssueInstant                samlTime          `xml:"IssueInstant,attr"`
+	Destination                 string            `xml:"Destination,attr,omitempty"`
+	ProtocolBinding             string            `xml:"ProtocolBinding,attr,omitempty"`
+	AssertionConsumerServiceURL string            `xml:"AssertionConsumerServiceURL,attr,omitempty"`
+	Issuer                      *samlIssuer       `xml:"Issuer,omitempty"`
+	NameIDPolicy                *samlNameIDPolicy `xml:"NameIDPolicy,omitempty"`
+}
+
+type samlNameIDPolicy struct {
+	XMLName     xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:protocol NameIDPolicy"`
+	AllowCreate bool     `xml:"AllowCreate,attr"`
+	Format      string   `xml:"Format,attr,omitempty"`
+}
+
+type samlIssuer struct {
+	XMLName xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:assertion Issuer"`
+	Value   string   `xml:",chardata"`
+}
+
+type samlResponse struct {
+	XMLName xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:protocol Response"`
+
+	ID           string         `xml:"ID,attr"`
+	InResponseTo string         `xml:"InResponseTo,attr"`
+	Version      string         `xml:"Version,attr"`
+	Destination  string         `xml:"Destination,attr,omitempty"`
+	Issuer       *samlIssuer    `xml:"Issuer,omitempty"`
+	Status       *samlStatus    `xml:"Status"`
+	Assertion    *samlAssertion `xml:"Assertion,omitempty"`
+}
+
+type samlStatus struct {
+	XMLName xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:protocol Status"`
+
+	StatusCode *struct {
+		Value string `xml:"Value,attr"`
+	} `xml:"StatusCode"`
+	StatusMessage string `xml:"StatusMessage,omitempty"`
+}
+
+type samlAssertion struct {
+	XMLName xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:assertion Assertion"`
+
+	ID                 string                  `xml:"ID,attr"`
+	Version            string                  `xml:"Version,attr"`
+	Issuer             *samlIssuer             `xml:"Issuer"`
+	Subject            *samlSubject            `xml:"Subject,omitempty"`
+	Conditions         *samlConditions         `xml:"Conditions,omitempty"`
+	AttributeStatement *samlAttributeStatement `xml:"AttributeStatement,omitempty"`
+}
+
+type samlSubject struct {
+	XMLName xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:assertion Subject"`
+
+	NameID *struct {
+		Format string `xml:"Format,attr,omitempty"`
+		Value  string `xml:",chardata"`
+	} `xml:"NameID,omitempty"`
+	SubjectConfirmations []samlSubjectConfirmation `xml:"SubjectConfirmation"`
+}
+
+type samlSubjectConfirmation struct {
+	Method string `xml:"Method,attr"`
+	Data   *struct {
+		NotBefore    samlTime `xml:"NotBefore,attr,omitempty"`
+		NotOnOrAfter samlTime `xml:"NotOnOrAfter,attr,omitempty"`
+		Recipient    string   `xml:"Recipient,attr,omitempty"`
+		InResponseTo string   `xml:"InResponseTo,attr,omitempty"`
+	} `xml:"urn:oasis:names:tc:SAML:2.0:assertion SubjectConfirmationData,omitempty"`
+}
+
+type samlConditions struct {
+	XMLName xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:assertion Conditions"`
+
+	NotBefore            samlTime `xml:"NotBefore,attr,omitempty"`
+	NotOnOrAfter         samlTime `xml:"NotOnOrAfter,attr,omitempty"`
+	AudienceRestrictions []struct {
+		Audiences []string `xml:"urn:oasis:names:tc:SAML:2.0:assertion Audience"`
+	} `xml:"urn:oasis:names:tc:SAML:2.0:assertion AudienceRestriction,omitempty"`
+}
+
+type samlAttributeStatement struct {
+	XMLName xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:assertion AttributeStatement"`
+
+	Attributes []struct {
+		Name   string   `xml:"Name,attr"`
+		Values []string `xml:"urn:oasis:names:tc:SAML:2.0:assertion AttributeValue"`
+	} `xml:"urn:oasis:names:tc:SAML:2.0:assertion Attribute"`
+}
+
+// values gathers attribute values by attribute name.
+func (a *samlAttributeStatement) values() map[string][]string {
+	values := make(map[string][]string)
+	if a == nil {
+		return values
+	}
+	for _, attr := range a.Attributes {
+		values[attr.Name] = append(values[attr.Name], attr.Values...)
+	}
+	return values
+}
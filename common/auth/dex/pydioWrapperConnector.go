@@ -25,6 +25,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"time"
 
 	"github.com/coreos/dex/connector"
 	"github.com/micro/go-micro/errors"
@@ -32,8 +33,10 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/auth/mfa"
 	"github.com/pydio/cells/common/log"
 	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/utils/permissions"
 )
 
 type WrapperConfig struct {
@@ -48,10 +51,38 @@ type ConnectorConfig struct {
 	Config json.RawMessage `json:"config"`
 }
 
+// Open returns a connector trying logins on all sub-connectors, or a SAML connector if the config only holds
+// one SAML sub-connector, as dex picks the authentication flow upon the connector interfaces.
 func (c *WrapperConfig) Open(logger logrus.FieldLogger) (connector.Connector, error) {
+	if len(c.Connectors) == 1 {
+		conn, err := createConnector(logger, c.Connectors[0].Type, c.Connectors[0])
+		if err != nil {
+			return nil, err
+		}
+		if samlConn, ok := conn.(*pydioSAMLConnector); ok {
+			samlConn.authSource = c.Connectors[0].Name
+			wrapper, _ := c.openConnector(logger)
+			return &pydioSAMLWrapperConnector{wrapper: wrapper, saml: samlConn}, nil
+		}
+	}
 	return c.OpenConnector(logger)
 }
 
+// ExternalConnectors lists the sub-connectors using a browser flow, that must be exposed as separate dex connectors.
+func (c *WrapperConfig) ExternalConnectors(logger logrus.FieldLogger) (external []ConnectorConfig) {
+	for _, connConfig := range c.Connectors {
+		conn, err := createConnector(logger, connConfig.Type, connConfig)
+		if err != nil {
+			logger.Errorf(err.Error())
+			continue
+		}
+		if _, ok := conn.(connector.SAMLConnector); ok {
+			external = append(external, connConfig)
+		}
+	}
+	return
+}
+
 func (c *WrapperConfig) OpenConnector(logger logrus.FieldLogger) (interface {
 	connector.Connector
 	connector.PasswordConnector
@@ -72,13 +103,16 @@ type pydioWrapperConnector struct {
 var (
 	_ connector.PasswordConnector = (*pydioWrapperConnector)(nil)
 	_ connector.RefreshConnector  = (*pydioWrapperConnector)(nil)
+	_ connector.SAMLConnector     = (*pydioSAMLWrapperConnector)(nil)
+	_ connector.RefreshConnector  = (*pydioSAMLWrapperConnector)(nil)
 )
 
 /////////////////////////////////
 // Connector Interface Methods
 /////////////////////////////////
 
-// Login binds a user by name / password, trying on various connectors.
+// Login binds a user by name / password, trying on various connectors. If password is mfa.WebauthnPasswordless,
+// the security key assertion found in context is checked instead.
 func (p *pydioWrapperConnector) Login(ctx context.Context, s connector.Scopes, username, password string) (identity connector.Identity, validPassword bool, err error) {
 
 	in := &WrapperConnectorOperation{
@@ -87,7 +121,11 @@ func (p *pydioWrapperConnector) Login(ctx context.Context, s connector.Scopes, u
 		Password:      password,
 		Scopes:        s,
 	}
-	out, err := ApplyWrapperConnectorMiddlewares(ctx, in, p.performLoginOnConnectors)
+	core := p.performLoginOnConnectors
+	if password == mfa.WebauthnPasswordless {
+		core = p.performLoginWithSecurityKey
+	}
+	out, err := ApplyWrapperConnectorMiddlewares(ctx, in, core)
 	if err != nil {
 		return connector.Identity{}, !out.LoginError, err
 	}
@@ -111,6 +149,49 @@ func (p *pydioWrapperConnector) Refresh(ctx context.Context, s connector.Scopes,
 
 }
 
+// pydioSAMLWrapperConnector applies the wrapper middlewares to logins performed through a SAML sub-connector.
+// It must not implement connector.PasswordConnector, otherwise dex would not use the SAML flow.
+type pydioSAMLWrapperConnector struct {
+	wrapper *pydioWrapperConnector
+	saml    *pydioSAMLConnector
+}
+
+// POSTData returns the authentication request of the SAML sub-connector.
+func (p *pydioSAMLWrapperConnector) POSTData(s connector.Scopes, requestID string) (string, string, error) {
+	return p.saml.POSTData(s, requestID)
+}
+
+// HandlePOST verifies the SAML response, then applies the login middlewares to the provisioned user.
+func (p *pydioSAMLWrapperConnector) HandlePOST(s connector.Scopes, samlResponse, inResponseTo string) (connector.Identity, error) {
+
+	in := &WrapperConnectorOperation{
+		OperationType: "Login",
+		Scopes:        s,
+	}
+	out, err := ApplyWrapperConnectorMiddlewares(context.Background(), in, func(ctx context.Context, op *WrapperConnectorOperation) (*WrapperConnectorOperation, error) {
+		ident, err := p.saml.HandlePOST(s, samlResponse, inResponseTo)
+		if err != nil {
+			log.Logger(ctx).Error("SAML login failed", zap.String(common.KEY_CONNECTOR, p.saml.authSource), zap.Error(err))
+			op.LoginError = true
+			return op, errors.Unauthorized(common.SERVICE_AUTH, "invalid SAML response")
+		}
+		op.Login = ident.Username
+		op.ValidUsername = ident.Username
+		op.AuthSource = p.saml.authSource
+		op.External = true
+		return op, nil
+	})
+	if err != nil {
+		return connector.Identity{}, err
+	}
+	return out.Identity, nil
+}
+
+// Refresh applies the wrapper refresh middlewares.
+func (p *pydioSAMLWrapperConnector) Refresh(ctx context.Context, s connector.Scopes, ident connector.Identity) (connector.Identity, error) {
+	return p.wrapper.Refresh(ctx, s, ident)
+}
+
 ///////////////////////
 // Pydio API Methods
 ///////////////////////
@@ -157,6 +238,40 @@ func (p *pydioWrapperConnector) performLoginOnConnectors(ctx context.Context, op
 
 }
 
+// performLoginWithSecurityKey checks a WebAuthn assertion with user verification instead of a password.
+func (p *pydioWrapperConnector) performLoginWithSecurityKey(ctx context.Context, op *WrapperConnectorOperation) (*WrapperConnectorOperation, error) {
+
+	op.LoginError = true
+	assertion := mfa.AssertionFromContext(ctx)
+	if assertion == nil {
+		return op, errors.Unauthorized(common.SERVICE_MFA, "missing security key assertion")
+	}
+	user, err := permissions.SearchUniqueUser(ctx, "", "", &idm.UserSingleQuery{Login: op.Login})
+	if err != nil || user == nil {
+		return op, errors.Unauthorized(common.SERVICE_MFA, "invalid security key")
+	}
+	userMfa, err := mfa.Load(user)
+	if err != nil {
+		return op, errors.Unauthorized(common.SERVICE_MFA, "invalid security key")
+	}
+	if _, verifyErr := userMfa.VerifyAssertion(mfa.DefaultWebauthnConfig(), assertion, op.Login, true, time.Now()); verifyErr != nil {
+		log.Logger(ctx).Debug("Security key login failed", zap.String(common.KEY_USERNAME, op.Login), zap.Error(verifyErr))
+		return op, errors.Unauthorized(common.SERVICE_MFA, "invalid security key")
+	}
+	// Store the updated signature counter
+	if err := storeUserMfa(ctx, user, userMfa); err != nil {
+		return op, err
+	}
+	op.LoginError = false
+	op.ValidUsername = user.Login
+	op.AuthSource = user.Attributes[idm.UserAttrAuthSource]
+	op.Passwordless = true
+	// The assertion required a user verification (PIN, biometrics) by the key
+	op.MfaVerified = true
+	return op, nil
+
+}
+
 // Lists connectors from config.
 func (p *pydioWrapperConnector) getConnectorList(logger logrus.FieldLogger) (connectorList []ConnectorList, err error) {
 	// Sort
@@ -168,15 +283,20 @@ func (p *pydioWrapperConnector) getConnectorList(logger logrus.FieldLogger) (con
 			logger.Errorf(er.Error())
 			continue
 		}
+		passwordConnector, ok := connConnector.(interface {
+			connector.Connector
+			connector.PasswordConnector
+			connector.RefreshConnector
+		})
+		if !ok {
+			// Browser flows are exposed as separate connectors, see ExternalConnectors
+			continue
+		}
 		connConnectorFull := ConnectorList{
-			Type: connConfig.Type,
-			Name: connConfig.Name,
-			ID:   connConfig.ID,
-			Connector: connConnector.(interface {
-				connector.Connector
-				connector.PasswordConnector
-				connector.RefreshConnector
-			}),
+			Type:      connConfig.Type,
+			Name:      connConfig.Name,
+			ID:        connConfig.ID,
+			Connector: passwordConnector,
 		}
 		connectorList = append(connectorList, connConnectorFull)
 	}
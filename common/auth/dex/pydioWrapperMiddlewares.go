@@ -5,12 +5,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/coreos/dex/connector"
+	"github.com/coreos/dex/storage"
 	"github.com/micro/go-micro/errors"
 	"go.uber.org/zap"
 
 	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/auth/mfa"
 	"github.com/pydio/cells/common/log"
 	"github.com/pydio/cells/common/micro"
 	"github.com/pydio/cells/common/proto/idm"
@@ -29,6 +32,14 @@ type WrapperConnectorOperation struct {
 	AuthSource    string
 	User          *idm.User
 	Identity      connector.Identity
+	// Passwordless is set when the user logged in with a security key, which already is a second factor
+	Passwordless bool
+	// External is set when the user was authenticated by an external identity provider, which handles second factors
+	External bool
+	// MfaVerified is set when a second factor was verified during this login
+	MfaVerified bool
+	// MfaEnrollOnly is set when a second factor is enforced but not enrolled yet
+	MfaEnrollOnly bool
 }
 
 type WrapperConnectorProvider func(ctx context.Context, in *WrapperConnectorOperation) (*WrapperConnectorOperation, error)
@@ -216,13 +227,96 @@ func WrapWithUserLocks(middleware WrapperConnectorProvider) WrapperConnectorProv
 	}
 }
 
+// WrapWithMfa requires a valid second factor for users that enrolled one: a code or a security key assertion
+// read from the request context (see mfa.HttpCodeWrapper). Users whose roles enforce a second factor but who
+// did not enroll one yet get a session restricted to the enrollment. A wrong second factor is handled as a failed login,
+// so it must be registered before WrapWithUserLocks. Users authenticated by an external identity provider are skipped.
+func WrapWithMfa(middleware WrapperConnectorProvider) WrapperConnectorProvider {
+
+	return func(ctx context.Context, op *WrapperConnectorOperation) (*WrapperConnectorOperation, error) {
+
+		var e error
+		op, e = middleware(ctx, op)
+		if e != nil || op.User == nil || op.Passwordless || op.External {
+			return op, e
+		}
+		// Reload user without cache, as the last used code, recovery codes and challenges must be up-to-date
+		user, er := permissions.SearchUniqueUser(ctx, "", "", &idm.UserSingleQuery{Uuid: op.User.Uuid})
+		if er != nil || user == nil {
+			return op, er
+		}
+		userMfa, er := mfa.Load(user)
+		if er != nil {
+			return op, er
+		}
+		if !userMfa.Enabled() {
+			if mfa.Enforced(ctx, user) {
+				log.Auditer(ctx).Info(
+					"Restricted login for ["+user.Login+"] to the enrollment of a second factor",
+					log.GetAuditId(common.AUDIT_LOGIN_SUCCEED),
+					zap.String(common.KEY_USER_UUID, user.Uuid),
+				)
+				op.MfaEnrollOnly = true
+			}
+			return op, nil
+		}
+
+		assertion := mfa.AssertionFromContext(ctx)
+		code := mfa.CodeFromContext(ctx)
+		if (assertion == nil || len(userMfa.WebauthnCredentials) == 0) && code == "" {
+			return op, errors.Unauthorized(common.SERVICE_MFA, "second factor code is required")
+		}
+		valid := false
+		if assertion != nil && len(userMfa.WebauthnCredentials) > 0 {
+			_, verifyErr := userMfa.VerifyAssertion(mfa.DefaultWebauthnConfig(), assertion, user.Login, false, time.Now())
+			valid = verifyErr == nil
+		} else {
+			valid = userMfa.Verify(code, time.Now())
+		}
+		// Store the consumed codes and counters, even if the second factor is invalid
+		if er := storeUserMfa(ctx, user, userMfa); er != nil {
+			return op, er
+		}
+		if !valid {
+			log.Auditer(ctx).Error(
+				"Invalid second factor for ["+user.Login+"]",
+				log.GetAuditId(common.AUDIT_LOGIN_FAILED),
+				zap.String(common.KEY_USER_UUID, user.Uuid),
+			)
+			// Forget user so that WrapWithUserLocks counts a failed connection
+			op.User = nil
+			op.LoginError = true
+			return op, errors.Unauthorized(common.SERVICE_MFA, "invalid second factor code")
+		}
+		op.User = user
+		op.MfaVerified = true
+		return op, nil
+	}
+}
+
+// storeUserMfa saves the second factor data of the user. The user is not updated if they did not change, to avoid
+// an update event and an audit entry on each login.
+func storeUserMfa(ctx context.Context, user *idm.User, userMfa *mfa.UserMfa) error {
+	previous := user.Attributes[idm.UserAttrMfa]
+	userMfa.Store(user)
+	if user.Attributes[idm.UserAttrMfa] == previous {
+		return nil
+	}
+	userClient := idm.NewUserServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_USER, defaults.NewClient())
+	_, er := userClient.CreateUser(ctx, &idm.CreateUserRequest{User: user})
+	return er
+}
+
 // WrapWithIdentity converts the op.User to an identity and stores it in the current operation.
 func WrapWithIdentity(middleware WrapperConnectorProvider) WrapperConnectorProvider {
 
 	return func(ctx context.Context, op *WrapperConnectorOperation) (*WrapperConnectorOperation, error) {
 
+		var connectorData []byte
 		if op.OperationType == "Refresh" && op.Identity.Username != "" {
 			op.ValidUsername = op.Identity.Username
+			// Refreshed sessions keep the restrictions of the login
+			connectorData = op.Identity.ConnectorData
 		}
 
 		var e error
@@ -233,6 +327,10 @@ func WrapWithIdentity(middleware WrapperConnectorProvider) WrapperConnectorProvi
 		}
 
 		op.Identity = ConvertUserApiToIdentity(op.User, op.AuthSource)
+		if op.OperationType == "Login" {
+			connectorData, _ = json.Marshal(storage.PydioConnectorData{Mfa: op.MfaVerified, MfaEnroll: op.MfaEnrollOnly})
+		}
+		op.Identity.ConnectorData = connectorData
 		return op, nil
 	}
 }
@@ -44,6 +44,7 @@ import (
 	"github.com/pydio/cells/common/proto/auth"
 	"github.com/pydio/cells/common/proto/idm"
 	"github.com/pydio/cells/common/proto/rest"
+	servicecontext "github.com/pydio/cells/common/service/context"
 	"github.com/pydio/cells/common/service/proto"
 	"github.com/pydio/cells/common/utils/permissions"
 )
@@ -103,6 +104,13 @@ func (j *JWTVerifier) loadClaims(ctx context.Context, token *oidc.IDToken) (clai
 	if err != nil {
 		return claims, err
 	}
+	fillClaimsFromUser(&claims, user)
+
+	return claims, nil
+}
+
+// fillClaimsFromUser sets the claims that are read from the user in the idm.
+func fillClaimsFromUser(claims *claim.Claims, user *idm.User) {
 
 	displayName, ok := user.Attributes["displayName"]
 	if !ok {
@@ -123,8 +131,20 @@ func (j *JWTVerifier) loadClaims(ctx context.Context, token *oidc.IDToken) (clai
 	claims.Profile = profile
 	claims.Roles = strings.Join(roles, ",")
 	claims.GroupPath = user.GroupPath
+}
 
-	return claims, nil
+// claimsToContext stores the claims and the user name in the context and its metadata.
+func claimsToContext(ctx context.Context, claims claim.Claims) context.Context {
+	ctx = context.WithValue(ctx, claim.ContextKey, claims)
+	md := make(map[string]string)
+	if existing, ok := metadata.FromContext(ctx); ok {
+		for k, v := range existing {
+			md[k] = v
+		}
+	}
+	md[common.PYDIO_CONTEXT_USER_KEY] = claims.Name
+	ctx = metadata.NewContext(ctx, md)
+	return ToMetadata(ctx, claims)
 }
 
 func (j *JWTVerifier) verifyTokenWithRetry(ctx context.Context, rawIDToken string, isRetry bool) (idToken *oidc.IDToken, e error) {
@@ -171,6 +191,10 @@ func (j *JWTVerifier) verifyTokenWithRetry(ctx context.Context, rawIDToken strin
 // Verify validates an existing JWT token against the OIDC service that issued it
 func (j *JWTVerifier) Verify(ctx context.Context, rawIDToken string) (context.Context, claim.Claims, error) {
 
+	if IsPersonalAccessToken(rawIDToken) {
+		return j.verifyPersonalAccessToken(ctx, rawIDToken)
+	}
+
 	idToken, err := j.verifyTokenWithRetry(ctx, rawIDToken, false)
 	if err != nil {
 		return ctx, claim.Claims{}, err
@@ -196,18 +220,7 @@ func (j *JWTVerifier) Verify(ctx context.Context, rawIDToken string) (context.Co
 		return ctx, claims, err
 	}
 
-	ctx = context.WithValue(ctx, claim.ContextKey, claims)
-	md := make(map[string]string)
-	if existing, ok := metadata.FromContext(ctx); ok {
-		for k, v := range existing {
-			md[k] = v
-		}
-	}
-	md[common.PYDIO_CONTEXT_USER_KEY] = claims.Name
-	ctx = metadata.NewContext(ctx, md)
-	ctx = ToMetadata(ctx, claims)
-
-	return ctx, claims, nil
+	return claimsToContext(ctx, claims), claims, nil
 }
 
 // PasswordCredentialsToken will perform a call to the OIDC service with grantType "password"
@@ -226,7 +239,7 @@ func (j *JWTVerifier) PasswordCredentialsToken(ctx context.Context, userName str
 		Scopes: []string{oidc.ScopeOpenID, "profile", "email", "pydio"},
 	}
 
-	if token, err := oauth2Config.PasswordCredentialsToken(ctx, userName, password); err == nil {
+	if token, err := oauth2Config.PasswordCredentialsToken(forwardClientContext(ctx), userName, password); err == nil {
 		idToken, _ := provider.Verifier(&oidc.Config{SkipClientIDCheck: true, SkipNonceCheck: true}).Verify(ctx, token.Extra("id_token").(string))
 
 		claims, err := j.loadClaims(ctx, idToken)
@@ -252,6 +265,46 @@ func (j *JWTVerifier) PasswordCredentialsToken(ctx context.Context, userName str
 
 }
 
+// forwardHeadersTransport adds headers to the requests sent to the OIDC service
+type forwardHeadersTransport struct {
+	headers map[string]string
+	base    http.RoundTripper
+}
+
+func (t *forwardHeadersTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	forwarded := req.WithContext(req.Context())
+	forwarded.Header = make(http.Header, len(req.Header)+len(t.headers))
+	for k, v := range req.Header {
+		forwarded.Header[k] = v
+	}
+	for k, v := range t.headers {
+		forwarded.Header.Set(k, v)
+	}
+	return t.base.RoundTrip(forwarded)
+}
+
+// forwardClientContext passes the address and user agent of the client to the token endpoint, so that
+// they are recorded in the session opened by the login.
+func forwardClientContext(ctx context.Context) context.Context {
+	meta, ok := metadata.FromContext(ctx)
+	if !ok {
+		return ctx
+	}
+	headers := make(map[string]string)
+	if addr := meta[servicecontext.HttpMetaRemoteAddress]; addr != "" {
+		headers["X-Forwarded-For"] = addr
+	}
+	if ua := meta[servicecontext.HttpMetaUserAgent]; ua != "" {
+		headers["User-Agent"] = ua
+	}
+	if len(headers) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, oauth2.HTTPClient, &http.Client{
+		Transport: &forwardHeadersTransport{headers: headers, base: http.DefaultTransport},
+	})
+}
+
 // Add a fake Claims in context to impersonate user
 func WithImpersonate(ctx context.Context, user *idm.User) context.Context {
 	roles := make([]string, len(user.Roles))
@@ -267,6 +320,14 @@ func WithImpersonate(ctx context.Context, user *idm.User) context.Context {
 	return context.WithValue(ctx, claim.ContextKey, c)
 }
 
+// WithUserClaims builds the claims of a user and stores them in the context, for requests that are
+// authenticated by other means than a token issued by the OIDC provider.
+func WithUserClaims(ctx context.Context, user *idm.User) context.Context {
+	claims := claim.Claims{Name: user.Login}
+	fillClaimsFromUser(&claims, user)
+	return claimsToContext(ctx, claims)
+}
+
 // SubjectsForResourcePolicyQuery prepares a slice of strings that will be used to check for resource ownership.
 // Can be extracted either from context or by loading a given user ID from database.
 func SubjectsForResourcePolicyQuery(ctx context.Context, q *rest.ResourcePolicyQuery) (subjects []string, err error) {
@@ -21,8 +21,19 @@
 package auth
 
 import (
+	"fmt"
 	"regexp"
 	"strings"
+
+	proto "github.com/pydio/cells/common/proto/auth"
+	"github.com/pydio/cells/common/proto/idm"
+)
+
+const (
+	// MappingRoles is the reserved right attribute for mapping values to roles
+	MappingRoles = "Roles"
+	// MappingGroupPath is the reserved right attribute for mapping a value to the user group
+	MappingGroupPath = "GroupPath"
 )
 
 type MappingRule struct {
@@ -169,3 +180,72 @@ func (m MappingRule) FilterList(list []string, strs []string) []string {
 	}
 	return strs
 }
+
+// ApplyRuleString sanitizes values and keeps those accepted by the RuleString: all of them if it is empty,
+// those matching the regular expression if it starts with "preg:", or those of the comma-separated list otherwise.
+func (m MappingRule) ApplyRuleString(strs []string) []string {
+	strs = m.SanitizeValues(strs)
+	if m.RuleString == "" {
+		return strs
+	}
+	if strings.HasPrefix(m.RuleString, "preg:") {
+		return m.FilterPreg(m.RuleString, strs)
+	}
+	return m.FilterList(m.SanitizeValues(strings.Split(m.RuleString, ",")), strs)
+}
+
+// MappedUser is the result of mapping rules applied to the attributes of an external user.
+type MappedUser struct {
+	Attributes map[string]string
+	Roles      []string
+	GroupPath  string
+	// RolePrefixes lists the prefixes of the roles managed by the rules.
+	RolePrefixes []string
+}
+
+// ApplyMappingRules maps the values of an external user, read by the values function for each LeftAttribute,
+// to Cells attributes, roles and group. Rules targeting private or reserved user attributes are refused.
+func ApplyMappingRules(rules []*proto.LdapMapping, values func(attribute string) []string) (*MappedUser, error) {
+	mapped := &MappedUser{Attributes: make(map[string]string)}
+	for _, r := range rules {
+		rule := MappingRule{
+			LeftAttribute:  r.LeftAttribute,
+			RightAttribute: r.RightAttribute,
+			RuleString:     r.RuleString,
+			RolePrefix:     r.RolePrefix,
+		}
+		switch rule.RightAttribute {
+		case MappingRoles:
+			mapped.RolePrefixes = append(mapped.RolePrefixes, rule.RolePrefix)
+			roles := rule.ApplyRuleString(rule.ConvertDNtoName(values(rule.LeftAttribute)))
+			for _, role := range rule.AddPrefix(rule.RolePrefix, roles) {
+				if role != "" {
+					mapped.Roles = append(mapped.Roles, role)
+				}
+			}
+		case MappingGroupPath:
+			if v := rule.ApplyRuleString(values(rule.LeftAttribute)); len(v) > 0 && strings.Trim(v[0], "/") != "" {
+				mapped.GroupPath = "/" + strings.Trim(v[0], "/")
+			}
+		default:
+			if strings.HasPrefix(rule.RightAttribute, idm.UserAttrPrivatePrefix) || rule.RightAttribute == idm.UserAttrAuthSource {
+				return nil, fmt.Errorf("mapping rule cannot target reserved attribute %s", rule.RightAttribute)
+			}
+			if v := rule.ApplyRuleString(values(rule.LeftAttribute)); len(v) > 0 {
+				mapped.Attributes[rule.RightAttribute] = v[0]
+			}
+		}
+	}
+	return mapped, nil
+}
+
+// ManagesRole tells if a role is managed by the rules, i.e. starts with one of their prefixes.
+// A roles rule without prefix hence manages all roles.
+func (m *MappedUser) ManagesRole(id string) bool {
+	for _, prefix := range m.RolePrefixes {
+		if strings.HasPrefix(id, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -26,6 +26,9 @@ import (
 
 	"github.com/ghodss/yaml"
 	"github.com/kylelemons/godebug/pretty"
+
+	proto "github.com/pydio/cells/common/proto/auth"
+	"github.com/pydio/cells/common/proto/idm"
 )
 
 var _ = yaml.YAMLToJSON
@@ -119,6 +122,49 @@ func TestMappingRule_FilterPreg(t *testing.T) {
 	}
 }
 
+func TestMappingRule_ApplyRuleString(t *testing.T) {
+	m := getMappingRuleConfig()
+	rightValues := []string{"teacher ", " student", "teachiiing"}
+	if !testEq([]string{"teacher", "student", "teachiiing"}, m.ApplyRuleString(rightValues)) {
+		t.Errorf("Error")
+	}
+	m.RuleString = "preg:^teac*"
+	if !testEq([]string{"teacher", "teachiiing"}, m.ApplyRuleString(rightValues)) {
+		t.Errorf("Error")
+	}
+	m.RuleString = "student, researcher"
+	if !testEq([]string{"student"}, m.ApplyRuleString(rightValues)) {
+		t.Errorf("Error")
+	}
+}
+
+func TestApplyMappingRules(t *testing.T) {
+	values := map[string][]string{
+		"mail":     {"john@example.com"},
+		"memberOf": {"cn=teachers,dc=com", "cn=admins,dc=com"},
+		"ou":       {"/sales/"},
+	}
+	rules := []*proto.LdapMapping{
+		{LeftAttribute: "mail", RightAttribute: "email"},
+		{LeftAttribute: "memberOf", RightAttribute: MappingRoles, RuleString: "teachers", RolePrefix: "ext_"},
+		{LeftAttribute: "ou", RightAttribute: MappingGroupPath},
+	}
+	mapped, err := ApplyMappingRules(rules, func(attribute string) []string { return values[attribute] })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mapped.Attributes["email"] != "john@example.com" || mapped.GroupPath != "/sales" || !testEq([]string{"ext_teachers"}, mapped.Roles) {
+		t.Errorf("unexpected mapping %v", mapped)
+	}
+	if !mapped.ManagesRole("ext_admins") || mapped.ManagesRole("admins") {
+		t.Errorf("Error")
+	}
+	rules = append(rules, &proto.LdapMapping{LeftAttribute: "mail", RightAttribute: idm.UserAttrMfa})
+	if _, err := ApplyMappingRules(rules, func(attribute string) []string { return values[attribute] }); err == nil {
+		t.Errorf("private attributes must not be mapped")
+	}
+}
+
 func TestMappingRule_IsDnFormat(t *testing.T) {
 	m := getMappingRuleConfig()
 	DN := "cn=test,cn=abc,dc=com,dc=test"
@@ -0,0 +1,113 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package mfa
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+
+	"github.com/pydio/cells/common/crypto"
+)
+
+// Login challenges can be requested anonymously, so they are never written in the user attributes.
+// A challenge is a random nonce followed by its expiration date, signed with the secrets key and bound
+// to the login it was issued for. Consumed nonces are kept until they expire, so that each challenge
+// is only accepted once.
+
+const (
+	nonceLength = 16
+	macLength   = 16
+)
+
+var (
+	consumedNonces = cache.New(WebauthnTimeout, WebauthnTimeout)
+	// challengesKey is replaced in tests
+	challengesKey = func() ([]byte, error) {
+		return secretsKey(true)
+	}
+)
+
+// NewLoginChallenge creates a single-use challenge for a security key login of the given user.
+func NewLoginChallenge(login string, t time.Time) (string, error) {
+	key, e := challengesKey()
+	if e != nil {
+		return "", e
+	}
+	nonce, e := crypto.RandomBytes(nonceLength)
+	if e != nil {
+		return "", e
+	}
+	data := make([]byte, nonceLength+8, nonceLength+8+macLength)
+	copy(data, nonce)
+	binary.BigEndian.PutUint64(data[nonceLength:], uint64(t.Add(WebauthnTimeout).Unix()))
+	return b64url.EncodeToString(append(data, challengeMac(key, data, login)...)), nil
+}
+
+// ConsumeLoginChallenge checks that the challenge was issued for this login and did not expire, and
+// marks it as used.
+func ConsumeLoginChallenge(challenge string, login string, t time.Time) error {
+	challenge = strings.TrimRight(challenge, "=")
+	data, e := b64url.DecodeString(challenge)
+	if e != nil || len(data) != nonceLength+8+macLength {
+		return fmt.Errorf("invalid challenge")
+	}
+	key, e := challengesKey()
+	if e != nil {
+		return e
+	}
+	signed, mac := data[:nonceLength+8], data[nonceLength+8:]
+	if !hmac.Equal(mac, challengeMac(key, signed, login)) {
+		return fmt.Errorf("invalid challenge")
+	}
+	expiry := time.Unix(int64(binary.BigEndian.Uint64(signed[nonceLength:])), 0)
+	if t.After(expiry) {
+		return fmt.Errorf("challenge expired")
+	}
+	if e := consumedNonces.Add(challenge, true, WebauthnTimeout); e != nil {
+		return fmt.Errorf("challenge was already used")
+	}
+	return nil
+}
+
+// decoyDescriptors returns a credential that looks like a registered one, for users that do not exist or
+// have no security key. It is always the same for a given login.
+func decoyDescriptors(login string) ([]*CredentialDescriptor, error) {
+	key, e := challengesKey()
+	if e != nil {
+		return nil, e
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("webauthn-decoy/" + strings.ToLower(login)))
+	return []*CredentialDescriptor{{Type: "public-key", Id: b64url.EncodeToString(mac.Sum(nil))}}, nil
+}
+
+func challengeMac(key []byte, data []byte, login string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("webauthn-login/" + strings.ToLower(login) + "/"))
+	mac.Write(data)
+	return mac.Sum(nil)[:macLength]
+}
@@ -0,0 +1,291 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+// Package mfa provides tools for multi-factor authentication: TOTP codes, WebAuthn security keys,
+// recovery codes and their storage in the user attributes.
+//
+// Once enrolled, users must pass a code in the X-Pydio-Mfa-Code header of the password grant
+// (or the "mfaCode" AuthInfo of the frontend session), or a security key assertion in the
+// X-Pydio-Webauthn-Assertion header (or the "webauthnAssertion" AuthInfo). Sending WebauthnPasswordless
+// as password along with an assertion logs the user in without password. When enforced by a role and
+// not enrolled yet, login opens a session restricted to the /mfa REST endpoints (the "mfaEnroll" claim),
+// so that the user can enroll, then log in again with the new second factor.
+package mfa
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/config"
+	"github.com/pydio/cells/common/crypto"
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/utils/permissions"
+)
+
+const (
+	// EnforceParameter is the role parameter forcing users to enroll a second factor
+	EnforceParameter = "parameter:core.auth:MFA_ENFORCED"
+	// RecoveryCodesCount is the number of recovery codes generated at once
+	RecoveryCodesCount = 10
+)
+
+type contextKey struct{}
+type assertionContextKey struct{}
+
+var (
+	keyConfigPath = []string{"services", common.SERVICE_GRPC_NAMESPACE_ + common.SERVICE_USER, "mfa", "secretsKey"}
+	keyLock       = &sync.Mutex{}
+)
+
+func init() {
+	config.RegisterVaultKey(keyConfigPath...)
+}
+
+// UserMfa holds the second factor data of a user. It is stored as JSON in the idm.UserAttrMfa attribute.
+type UserMfa struct {
+	// TotpSecret is encrypted with a key stored in the config vault
+	TotpSecret  string `json:"totpSecret,omitempty"`
+	TotpEnabled bool   `json:"totpEnabled,omitempty"`
+	// LastCounter is the counter of the last accepted code, to prevent replays
+	LastCounter int64 `json:"lastCounter,omitempty"`
+	// RecoveryCodes are stored hashed, they are removed once used
+	RecoveryCodes []string `json:"recoveryCodes,omitempty"`
+	// WebauthnCredentials are the registered security keys
+	WebauthnCredentials []*WebauthnCredential `json:"webauthn,omitempty"`
+	// WebauthnChallenge is the pending registration challenge
+	WebauthnChallenge       string `json:"webauthnChallenge,omitempty"`
+	WebauthnChallengeExpiry int64  `json:"webauthnChallengeExpiry,omitempty"`
+}
+
+// Load reads the MFA data from the user attributes.
+func Load(user *idm.User) (*UserMfa, error) {
+	m := &UserMfa{}
+	if user.Attributes == nil {
+		return m, nil
+	}
+	if data, ok := user.Attributes[idm.UserAttrMfa]; ok && data != "" {
+		if e := json.Unmarshal([]byte(data), m); e != nil {
+			return nil, e
+		}
+	}
+	return m, nil
+}
+
+// Store writes the MFA data back in the user attributes, removing the attribute if MFA is not set up.
+func (m *UserMfa) Store(user *idm.User) {
+	if user.Attributes == nil {
+		user.Attributes = make(map[string]string)
+	}
+	if m.TotpSecret == "" && len(m.RecoveryCodes) == 0 && len(m.WebauthnCredentials) == 0 && m.WebauthnChallenge == "" {
+		delete(user.Attributes, idm.UserAttrMfa)
+		return
+	}
+	data, _ := json.Marshal(m)
+	user.Attributes[idm.UserAttrMfa] = string(data)
+}
+
+// ResetUser removes all second factors of the user.
+func ResetUser(user *idm.User) {
+	(&UserMfa{}).Store(user)
+}
+
+// SetTotpSecret encrypts and stores a new secret. TOTP is not enabled until a first code is validated.
+func (m *UserMfa) SetTotpSecret(secret string) error {
+	key, e := secretsKey(true)
+	if e != nil {
+		return e
+	}
+	sealed, e := crypto.Seal(key, []byte(secret))
+	if e != nil {
+		return e
+	}
+	m.TotpSecret = base64.StdEncoding.EncodeToString(sealed)
+	m.TotpEnabled = false
+	m.LastCounter = 0
+	return nil
+}
+
+// Secret decrypts the TOTP secret.
+func (m *UserMfa) Secret() (string, error) {
+	if m.TotpSecret == "" {
+		return "", fmt.Errorf("no totp secret")
+	}
+	data, e := base64.StdEncoding.DecodeString(m.TotpSecret)
+	if e != nil || len(data) < 12 {
+		return "", fmt.Errorf("invalid totp secret")
+	}
+	key, e := secretsKey(false)
+	if e != nil {
+		return "", e
+	}
+	plain, e := crypto.Open(key, data[:12], data[12:])
+	if e != nil {
+		return "", e
+	}
+	return string(plain), nil
+}
+
+// ValidateTotp checks a TOTP code and updates the last accepted counter.
+func (m *UserMfa) ValidateTotp(code string, t time.Time) bool {
+	secret, e := m.Secret()
+	if e != nil {
+		return false
+	}
+	counter, ok := ValidateTotp(secret, code, t, m.LastCounter)
+	if ok {
+		m.LastCounter = counter
+	}
+	return ok
+}
+
+// Verify checks a TOTP code or a recovery code, the latter being consumed. Callers must store
+// the user afterwards.
+func (m *UserMfa) Verify(code string, t time.Time) bool {
+	if !m.Enabled() {
+		return false
+	}
+	if m.TotpEnabled && m.ValidateTotp(code, t) {
+		return true
+	}
+	hashed := hashRecoveryCode(code)
+	for i, h := range m.RecoveryCodes {
+		if h == hashed {
+			m.RecoveryCodes = append(m.RecoveryCodes[:i], m.RecoveryCodes[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateRecoveryCodes replaces the recovery codes and returns them in clear.
+func (m *UserMfa) GenerateRecoveryCodes() ([]string, error) {
+	var codes, hashes []string
+	for i := 0; i < RecoveryCodesCount; i++ {
+		data, e := crypto.RandomBytes(5)
+		if e != nil {
+			return nil, e
+		}
+		code := hex.EncodeToString(data)
+		code = code[:5] + "-" + code[5:]
+		codes = append(codes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+	m.RecoveryCodes = hashes
+	return codes, nil
+}
+
+// Enforced checks whether one of the user roles requires a second factor. Roles are checked
+// from the last one, as for any other role parameter.
+func Enforced(ctx context.Context, user *idm.User) bool {
+	if len(user.Roles) == 0 {
+		return false
+	}
+	acls := permissions.GetACLsForRoles(ctx, user.Roles, &idm.ACLAction{Name: EnforceParameter})
+	for i := len(user.Roles) - 1; i >= 0; i-- {
+		for _, a := range acls {
+			if a.RoleID == user.Roles[i].Uuid && a.Action.Value != "-1" {
+				var enforced bool
+				if e := json.Unmarshal([]byte(a.Action.Value), &enforced); e == nil {
+					return enforced
+				}
+			}
+		}
+	}
+	return false
+}
+
+// HttpCodeWrapper reads the code passed in the common.XPydioMfaCode header and the assertion passed in the
+// common.XPydioWebauthnAssertion header, and stores them in the request context.
+func HttpCodeWrapper(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if code := r.Header.Get(common.XPydioMfaCode); code != "" {
+			r = r.WithContext(WithCode(r.Context(), code))
+		}
+		if encoded := r.Header.Get(common.XPydioWebauthnAssertion); encoded != "" {
+			if assertion, e := ParseAssertion(encoded); e == nil {
+				r = r.WithContext(WithAssertion(r.Context(), assertion))
+			}
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+// WithCode stores a second factor code in the context.
+func WithCode(ctx context.Context, code string) context.Context {
+	return context.WithValue(ctx, contextKey{}, code)
+}
+
+// CodeFromContext retrieves a second factor code from the context.
+func CodeFromContext(ctx context.Context) string {
+	if code, ok := ctx.Value(contextKey{}).(string); ok {
+		return code
+	}
+	return ""
+}
+
+// WithAssertion stores a security key assertion in the context.
+func WithAssertion(ctx context.Context, assertion *AssertionResponse) context.Context {
+	return context.WithValue(ctx, assertionContextKey{}, assertion)
+}
+
+// AssertionFromContext retrieves a security key assertion from the context.
+func AssertionFromContext(ctx context.Context) *AssertionResponse {
+	if a, ok := ctx.Value(assertionContextKey{}).(*AssertionResponse); ok {
+		return a
+	}
+	return nil
+}
+
+func hashRecoveryCode(code string) string {
+	sum := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(code))))
+	return hex.EncodeToString(sum[:])
+}
+
+// secretsKey loads the key used to encrypt TOTP secrets from the vault, generating it if required.
+func secretsKey(createIfNotExists bool) ([]byte, error) {
+	keyLock.Lock()
+	defer keyLock.Unlock()
+	if ref := config.Get(keyConfigPath...).String(""); ref != "" {
+		if encoded := config.GetSecret(ref).String(""); encoded != "" {
+			return base64.StdEncoding.DecodeString(encoded)
+		}
+	}
+	if !createIfNotExists {
+		return nil, fmt.Errorf("cannot find mfa secrets key")
+	}
+	key, e := crypto.RandomBytes(32)
+	if e != nil {
+		return nil, e
+	}
+	config.Set(base64.StdEncoding.EncodeToString(key), keyConfigPath...)
+	if e := config.Save(common.PYDIO_SYSTEM_USERNAME, "Generate MFA secrets key"); e != nil {
+		return nil, e
+	}
+	return key, nil
+}
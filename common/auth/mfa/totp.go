@@ -0,0 +1,115 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package mfa
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pydio/cells/common/crypto"
+)
+
+const (
+	// TotpPeriod is the validity period of a code, in seconds
+	TotpPeriod = 30
+	// TotpDigits is the number of digits of a code
+	TotpDigits = 6
+	// TotpSkew is the number of periods accepted before and after the current one
+	TotpSkew = 1
+)
+
+var b32 = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateTotpSecret creates a new random secret, base32-encoded as expected by authenticator apps.
+func GenerateTotpSecret() (string, error) {
+	data, e := crypto.RandomBytes(20)
+	if e != nil {
+		return "", e
+	}
+	return b32.EncodeToString(data), nil
+}
+
+// TotpCode computes the code for a given secret and counter, as defined by RFC 4226.
+func TotpCode(secret string, counter int64) (string, error) {
+	key, e := b32.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if e != nil {
+		return "", e
+	}
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, uint64(counter))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0xf
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	mod := uint32(1)
+	for i := 0; i < TotpDigits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", TotpDigits, value%mod), nil
+}
+
+// TotpCounter returns the counter for the given time.
+func TotpCounter(t time.Time) int64 {
+	return t.Unix() / TotpPeriod
+}
+
+// ValidateTotp checks a code against the periods around t. Codes whose counter is lower or equal to
+// lastCounter are refused to prevent replays. It returns the matching counter.
+func ValidateTotp(secret string, code string, t time.Time, lastCounter int64) (int64, bool) {
+	code = strings.Replace(code, " ", "", -1)
+	if len(code) != TotpDigits {
+		return 0, false
+	}
+	current := TotpCounter(t)
+	for c := current - TotpSkew; c <= current+TotpSkew; c++ {
+		if c <= lastCounter {
+			continue
+		}
+		expected, e := TotpCode(secret, c)
+		if e != nil {
+			return 0, false
+		}
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return c, true
+		}
+	}
+	return 0, false
+}
+
+// TotpProvisioningUri builds the otpauth:// uri to be displayed as a QR code.
+func TotpProvisioningUri(issuer, account, secret string) string {
+	values := url.Values{}
+	values.Set("secret", secret)
+	values.Set("issuer", issuer)
+	values.Set("algorithm", "SHA1")
+	values.Set("digits", fmt.Sprintf("%d", TotpDigits))
+	values.Set("period", fmt.Sprintf("%d", TotpPeriod))
+	label := url.PathEscape(issuer + ":" + account)
+	return "otpauth://totp/" + label + "?" + values.Encode()
+}
@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package mfa
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common/proto/idm"
+)
+
+// Base32 encoding of the RFC 6238 test secret "12345678901234567890"
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestTotp(t *testing.T) {
+
+	Convey("Test RFC 6238 vectors", t, func() {
+		for ts, code := range map[int64]string{59: "287082", 1111111109: "081804", 1234567890: "005924", 2000000000: "279037"} {
+			c, e := TotpCode(rfcSecret, TotpCounter(time.Unix(ts, 0)))
+			So(e, ShouldBeNil)
+			So(c, ShouldEqual, code)
+		}
+	})
+
+	Convey("Test validation window and replays", t, func() {
+		now := time.Unix(1234567890, 0)
+		counter, ok := ValidateTotp(rfcSecret, "005924", now, 0)
+		So(ok, ShouldBeTrue)
+		previous, _ := TotpCode(rfcSecret, counter-1)
+		_, ok = ValidateTotp(rfcSecret, previous, now, 0)
+		So(ok, ShouldBeTrue)
+		tooOld, _ := TotpCode(rfcSecret, counter-2)
+		_, ok = ValidateTotp(rfcSecret, tooOld, now, 0)
+		So(ok, ShouldBeFalse)
+		_, ok = ValidateTotp(rfcSecret, "005924", now, counter)
+		So(ok, ShouldBeFalse)
+		_, ok = ValidateTotp(rfcSecret, "12345", now, 0)
+		So(ok, ShouldBeFalse)
+	})
+
+	Convey("Test secrets and provisioning uri", t, func() {
+		secret, e := GenerateTotpSecret()
+		So(e, ShouldBeNil)
+		So(secret, ShouldHaveLength, 32)
+		uri := TotpProvisioningUri("Pydio Cells", "admin", secret)
+		So(strings.HasPrefix(uri, "otpauth://totp/Pydio%20Cells:admin?"), ShouldBeTrue)
+		So(uri, ShouldContainSubstring, "secret="+secret)
+	})
+
+}
+
+func TestRecoveryCodes(t *testing.T) {
+
+	Convey("Test recovery codes are consumed", t, func() {
+		m := &UserMfa{TotpEnabled: true}
+		codes, e := m.GenerateRecoveryCodes()
+		So(e, ShouldBeNil)
+		So(codes, ShouldHaveLength, RecoveryCodesCount)
+		So(m.RecoveryCodes[0], ShouldNotEqual, codes[0])
+
+		So(m.Verify(codes[3], time.Now()), ShouldBeTrue)
+		So(m.RecoveryCodes, ShouldHaveLength, RecoveryCodesCount-1)
+		So(m.Verify(codes[3], time.Now()), ShouldBeFalse)
+
+		user := &idm.User{Login: "user"}
+		m.Store(user)
+		loaded, e := Load(user)
+		So(e, ShouldBeNil)
+		So(loaded.RecoveryCodes, ShouldResemble, m.RecoveryCodes)
+		ResetUser(user)
+		_, has := user.Attributes[idm.UserAttrMfa]
+		So(has, ShouldBeFalse)
+	})
+
+}
@@ -0,0 +1,502 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package mfa
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pydio/cells/common/config"
+	"github.com/pydio/cells/common/crypto"
+	"github.com/pydio/cells/common/proto/idm"
+)
+
+const (
+	// WebauthnTimeout is the validity of a registration or login challenge
+	WebauthnTimeout = 2 * time.Minute
+	// WebauthnPasswordless is sent as password in the password grant to log in with a security key only
+	WebauthnPasswordless = "webauthn:passwordless"
+
+	// COSE key parameters, see RFC 8152
+	coseKeyType   = 1
+	coseAlg       = 3
+	coseCurve     = -1
+	coseX         = -2
+	coseY         = -3
+	coseKeyTypeEC = 2
+	coseAlgES256  = -7
+	coseCurveP256 = 1
+
+	// Authenticator data flags
+	flagUserPresent  = 0x01
+	flagUserVerified = 0x04
+	flagAttested     = 0x40
+)
+
+var b64url = base64.RawURLEncoding
+
+// WebauthnConfig describes the relying party, as seen by the browser.
+type WebauthnConfig struct {
+	RpId   string
+	RpName string
+	Origin string
+}
+
+// DefaultWebauthnConfig builds the relying party from the external url of the application.
+func DefaultWebauthnConfig() *WebauthnConfig {
+	c := &WebauthnConfig{
+		RpName: config.Get("frontend", "plugin", "core.pydio", "APPLICATION_TITLE").String("Pydio Cells"),
+	}
+	if u, e := url.Parse(config.Get("defaults", "url").String("")); e == nil {
+		c.RpId = u.Hostname()
+		c.Origin = u.Scheme + "://" + u.Host
+	}
+	return c
+}
+
+// WebauthnCredential is a public key registered by a security key.
+type WebauthnCredential struct {
+	// Id is the base64url-encoded credential identifier
+	Id   string `json:"id"`
+	Name string `json:"name"`
+	// PublicKey is COSE-encoded
+	PublicKey []byte `json:"publicKey"`
+	SignCount uint32 `json:"signCount,omitempty"`
+	Created   int64  `json:"created"`
+	LastUsed  int64  `json:"lastUsed,omitempty"`
+}
+
+// CredentialDescriptor identifies a credential in the options sent to the browser.
+type CredentialDescriptor struct {
+	Type string `json:"type"`
+	Id   string `json:"id"`
+}
+
+// CredentialCreationOptions are passed to navigator.credentials.create(). Binary values are base64url-encoded.
+type CredentialCreationOptions struct {
+	Challenge string `json:"challenge"`
+	Rp        struct {
+		Id   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"rp"`
+	User struct {
+		Id          string `json:"id"`
+		Name        string `json:"name"`
+		DisplayName string `json:"displayName"`
+	} `json:"user"`
+	PubKeyCredParams []struct {
+		Type string `json:"type"`
+		Alg  int    `json:"alg"`
+	} `json:"pubKeyCredParams"`
+	Timeout            int64                   `json:"timeout"`
+	ExcludeCredentials []*CredentialDescriptor `json:"excludeCredentials,omitempty"`
+	Attestation        string                  `json:"attestation"`
+}
+
+// CredentialRequestOptions are passed to navigator.credentials.get(). Binary values are base64url-encoded.
+type CredentialRequestOptions struct {
+	Challenge        string                  `json:"challenge"`
+	RpId             string                  `json:"rpId"`
+	Timeout          int64                   `json:"timeout"`
+	AllowCredentials []*CredentialDescriptor `json:"allowCredentials"`
+	UserVerification string                  `json:"userVerification"`
+}
+
+// AttestationResponse is the result of navigator.credentials.create(). Binary values are base64url-encoded.
+type AttestationResponse struct {
+	Id                string `json:"id"`
+	ClientDataJSON    string `json:"clientDataJSON"`
+	AttestationObject string `json:"attestationObject"`
+}
+
+// AssertionResponse is the result of navigator.credentials.get(). Binary values are base64url-encoded.
+type AssertionResponse struct {
+	Id                string `json:"id"`
+	ClientDataJSON    string `json:"clientDataJSON"`
+	AuthenticatorData string `json:"authenticatorData"`
+	Signature         string `json:"signature"`
+	UserHandle        string `json:"userHandle,omitempty"`
+}
+
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+type authenticatorData struct {
+	RpIdHash     []byte
+	Flags        byte
+	SignCount    uint32
+	CredentialId []byte
+	PublicKey    []byte
+}
+
+// ParseAssertion decodes an assertion passed as base64url-encoded JSON, as in the X-Pydio-Webauthn-Assertion header.
+func ParseAssertion(encoded string) (*AssertionResponse, error) {
+	data, e := decodeB64(encoded)
+	if e != nil {
+		return nil, e
+	}
+	a := &AssertionResponse{}
+	if e := json.Unmarshal(data, a); e != nil {
+		return nil, e
+	}
+	return a, nil
+}
+
+// Encode serializes the assertion for the X-Pydio-Webauthn-Assertion header.
+func (a *AssertionResponse) Encode() string {
+	data, _ := json.Marshal(a)
+	return b64url.EncodeToString(data)
+}
+
+// Enabled tells whether the user has set up at least one second factor.
+func (m *UserMfa) Enabled() bool {
+	return m.TotpEnabled || len(m.WebauthnCredentials) > 0
+}
+
+// BeginRegistration creates a challenge for registering a new security key.
+func (m *UserMfa) BeginRegistration(c *WebauthnConfig, user *idm.User, t time.Time) (*CredentialCreationOptions, error) {
+	challenge, e := m.newChallenge(t)
+	if e != nil {
+		return nil, e
+	}
+	o := &CredentialCreationOptions{
+		Challenge:   challenge,
+		Timeout:     int64(WebauthnTimeout / time.Millisecond),
+		Attestation: "none",
+	}
+	o.Rp.Id = c.RpId
+	o.Rp.Name = c.RpName
+	o.User.Id = b64url.EncodeToString([]byte(user.Uuid))
+	o.User.Name = user.Login
+	o.User.DisplayName = user.Login
+	if dn, ok := user.Attributes[idm.UserAttrDisplayName]; ok && dn != "" {
+		o.User.DisplayName = dn
+	}
+	o.PubKeyCredParams = append(o.PubKeyCredParams, struct {
+		Type string `json:"type"`
+		Alg  int    `json:"alg"`
+	}{Type: "public-key", Alg: coseAlgES256})
+	o.ExcludeCredentials = m.descriptors()
+	return o, nil
+}
+
+// FinishRegistration checks the attestation against the pending challenge and stores the new credential.
+// Attestation statements are not verified, as "none" attestation is requested.
+func (m *UserMfa) FinishRegistration(c *WebauthnConfig, name string, r *AttestationResponse, t time.Time) (*WebauthnCredential, error) {
+	challenge, e := m.consumeChallenge(t)
+	if e != nil {
+		return nil, e
+	}
+	if _, e := verifyClientData(c, r.ClientDataJSON, "webauthn.create", challenge); e != nil {
+		return nil, e
+	}
+	attObject, e := decodeB64(r.AttestationObject)
+	if e != nil {
+		return nil, e
+	}
+	decoded, _, e := cborDecode(attObject)
+	if e != nil {
+		return nil, fmt.Errorf("invalid attestation object: %v", e)
+	}
+	att, _ := decoded.(map[interface{}]interface{})
+	rawAuthData, ok := att["authData"].([]byte)
+	if !ok {
+		return nil, fmt.Errorf("invalid attestation object: missing authData")
+	}
+	authData, e := parseAuthenticatorData(rawAuthData)
+	if e != nil {
+		return nil, e
+	}
+	if e := authData.verify(c, false); e != nil {
+		return nil, e
+	}
+	if authData.Flags&flagAttested == 0 {
+		return nil, fmt.Errorf("missing attested credential data")
+	}
+	if _, e := parsePublicKey(authData.PublicKey); e != nil {
+		return nil, e
+	}
+	id := b64url.EncodeToString(authData.CredentialId)
+	if m.credential(id) != nil {
+		return nil, fmt.Errorf("this security key is already registered")
+	}
+	if name == "" {
+		name = fmt.Sprintf("Security key #%d", len(m.WebauthnCredentials)+1)
+	}
+	cred := &WebauthnCredential{
+		Id:        id,
+		Name:      name,
+		PublicKey: authData.PublicKey,
+		SignCount: authData.SignCount,
+		Created:   t.Unix(),
+	}
+	m.WebauthnCredentials = append(m.WebauthnCredentials, cred)
+	return cred, nil
+}
+
+// BeginLogin creates a challenge for signing in with one of the registered security keys. It does not
+// modify the user, so that it can be called anonymously: users without security keys (including unknown
+// logins) get a decoy credential instead, making the response look the same for everyone.
+func (m *UserMfa) BeginLogin(c *WebauthnConfig, login string, t time.Time) (*CredentialRequestOptions, error) {
+	challenge, e := NewLoginChallenge(login, t)
+	if e != nil {
+		return nil, e
+	}
+	descriptors := m.descriptors()
+	if len(descriptors) == 0 {
+		if descriptors, e = decoyDescriptors(login); e != nil {
+			return nil, e
+		}
+	}
+	return &CredentialRequestOptions{
+		Challenge:        challenge,
+		RpId:             c.RpId,
+		Timeout:          int64(WebauthnTimeout / time.Millisecond),
+		AllowCredentials: descriptors,
+		UserVerification: "preferred",
+	}, nil
+}
+
+// VerifyAssertion checks an assertion against a challenge issued by BeginLogin for this login, and updates
+// the signature counter of the credential. User verification (PIN, biometrics) is required for passwordless
+// logins. Callers must store the user afterwards.
+func (m *UserMfa) VerifyAssertion(c *WebauthnConfig, r *AssertionResponse, login string, requireUserVerification bool, t time.Time) (*WebauthnCredential, error) {
+	challenge, e := assertedChallenge(r)
+	if e != nil {
+		return nil, e
+	}
+	if e := ConsumeLoginChallenge(challenge, login, t); e != nil {
+		return nil, e
+	}
+	cred := m.credential(r.Id)
+	if cred == nil {
+		return nil, fmt.Errorf("unknown security key")
+	}
+	rawClientData, e := verifyClientData(c, r.ClientDataJSON, "webauthn.get", challenge)
+	if e != nil {
+		return nil, e
+	}
+	rawAuthData, e := decodeB64(r.AuthenticatorData)
+	if e != nil {
+		return nil, e
+	}
+	authData, e := parseAuthenticatorData(rawAuthData)
+	if e != nil {
+		return nil, e
+	}
+	if e := authData.verify(c, requireUserVerification); e != nil {
+		return nil, e
+	}
+	signature, e := decodeB64(r.Signature)
+	if e != nil {
+		return nil, e
+	}
+	pub, e := parsePublicKey(cred.PublicKey)
+	if e != nil {
+		return nil, e
+	}
+	clientHash := sha256.Sum256(rawClientData)
+	signed := sha256.Sum256(append(append([]byte{}, rawAuthData...), clientHash[:]...))
+	var sig struct{ R, S *big.Int }
+	if _, e := asn1.Unmarshal(signature, &sig); e != nil || !ecdsa.Verify(pub, signed[:], sig.R, sig.S) {
+		return nil, fmt.Errorf("invalid signature")
+	}
+	// Authenticators that do not implement a counter always return 0
+	if (authData.SignCount != 0 || cred.SignCount != 0) && authData.SignCount <= cred.SignCount {
+		return nil, fmt.Errorf("invalid signature counter, this security key may have been cloned")
+	}
+	cred.SignCount = authData.SignCount
+	cred.LastUsed = t.Unix()
+	return cred, nil
+}
+
+// RemoveCredential deletes a registered security key.
+func (m *UserMfa) RemoveCredential(id string) bool {
+	for i, c := range m.WebauthnCredentials {
+		if c.Id == id {
+			m.WebauthnCredentials = append(m.WebauthnCredentials[:i], m.WebauthnCredentials[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (m *UserMfa) credential(id string) *WebauthnCredential {
+	for _, c := range m.WebauthnCredentials {
+		if c.Id == strings.TrimRight(id, "=") {
+			return c
+		}
+	}
+	return nil
+}
+
+func (m *UserMfa) descriptors() []*CredentialDescriptor {
+	descriptors := []*CredentialDescriptor{}
+	for _, c := range m.WebauthnCredentials {
+		descriptors = append(descriptors, &CredentialDescriptor{Type: "public-key", Id: c.Id})
+	}
+	return descriptors
+}
+
+func (m *UserMfa) newChallenge(t time.Time) (string, error) {
+	data, e := crypto.RandomBytes(32)
+	if e != nil {
+		return "", e
+	}
+	m.WebauthnChallenge = b64url.EncodeToString(data)
+	m.WebauthnChallengeExpiry = t.Add(WebauthnTimeout).Unix()
+	return m.WebauthnChallenge, nil
+}
+
+// assertedChallenge reads the challenge signed by the authenticator.
+func assertedChallenge(r *AssertionResponse) (string, error) {
+	raw, e := decodeB64(r.ClientDataJSON)
+	if e != nil {
+		return "", e
+	}
+	cd := &clientData{}
+	if e := json.Unmarshal(raw, cd); e != nil {
+		return "", fmt.Errorf("invalid client data: %v", e)
+	}
+	return strings.TrimRight(cd.Challenge, "="), nil
+}
+
+// consumeChallenge returns the pending challenge, which can only be used once.
+func (m *UserMfa) consumeChallenge(t time.Time) (string, error) {
+	challenge, expiry := m.WebauthnChallenge, m.WebauthnChallengeExpiry
+	m.WebauthnChallenge = ""
+	m.WebauthnChallengeExpiry = 0
+	if challenge == "" || t.Unix() > expiry {
+		return "", fmt.Errorf("no pending challenge or challenge expired")
+	}
+	return challenge, nil
+}
+
+func verifyClientData(c *WebauthnConfig, encoded string, expectedType string, challenge string) ([]byte, error) {
+	raw, e := decodeB64(encoded)
+	if e != nil {
+		return nil, e
+	}
+	cd := &clientData{}
+	if e := json.Unmarshal(raw, cd); e != nil {
+		return nil, fmt.Errorf("invalid client data: %v", e)
+	}
+	if cd.Type != expectedType {
+		return nil, fmt.Errorf("invalid client data type %s", cd.Type)
+	}
+	if subtle.ConstantTimeCompare([]byte(strings.TrimRight(cd.Challenge, "=")), []byte(challenge)) != 1 {
+		return nil, fmt.Errorf("challenge does not match")
+	}
+	if cd.Origin != c.Origin {
+		return nil, fmt.Errorf("invalid origin %s", cd.Origin)
+	}
+	return raw, nil
+}
+
+func parseAuthenticatorData(data []byte) (*authenticatorData, error) {
+	if len(data) < 37 {
+		return nil, fmt.Errorf("authenticator data too short")
+	}
+	a := &authenticatorData{
+		RpIdHash:  data[:32],
+		Flags:     data[32],
+		SignCount: binary.BigEndian.Uint32(data[33:37]),
+	}
+	if a.Flags&flagAttested == 0 {
+		return a, nil
+	}
+	// Attested credential data: aaguid (16), credential id length (2), credential id, COSE public key
+	rest := data[37:]
+	if len(rest) < 18 {
+		return nil, fmt.Errorf("invalid attested credential data")
+	}
+	idLength := int(binary.BigEndian.Uint16(rest[16:18]))
+	rest = rest[18:]
+	if len(rest) < idLength {
+		return nil, fmt.Errorf("invalid attested credential data")
+	}
+	a.CredentialId = rest[:idLength]
+	rest = rest[idLength:]
+	// The key may be followed by extensions: decode a single CBOR item to find its length
+	_, extensions, e := cborDecode(rest)
+	if e != nil {
+		return nil, fmt.Errorf("invalid credential public key: %v", e)
+	}
+	a.PublicKey = rest[:len(rest)-len(extensions)]
+	return a, nil
+}
+
+func (a *authenticatorData) verify(c *WebauthnConfig, requireUserVerification bool) error {
+	rpIdHash := sha256.Sum256([]byte(c.RpId))
+	if subtle.ConstantTimeCompare(a.RpIdHash, rpIdHash[:]) != 1 {
+		return fmt.Errorf("relying party does not match")
+	}
+	if a.Flags&flagUserPresent == 0 {
+		return fmt.Errorf("user presence is required")
+	}
+	if requireUserVerification && a.Flags&flagUserVerified == 0 {
+		return fmt.Errorf("user verification is required")
+	}
+	return nil
+}
+
+// parsePublicKey decodes a COSE key. Only ES256 keys on the P-256 curve are supported.
+func parsePublicKey(data []byte) (*ecdsa.PublicKey, error) {
+	decoded, _, e := cborDecode(data)
+	if e != nil {
+		return nil, fmt.Errorf("invalid credential public key: %v", e)
+	}
+	key, _ := decoded.(map[interface{}]interface{})
+	if key[int64(coseKeyType)] != int64(coseKeyTypeEC) || key[int64(coseAlg)] != int64(coseAlgES256) || key[int64(coseCurve)] != int64(coseCurveP256) {
+		return nil, fmt.Errorf("unsupported public key algorithm, only ES256 is supported")
+	}
+	x, okX := key[int64(coseX)].([]byte)
+	y, okY := key[int64(coseY)].([]byte)
+	if !okX || !okY {
+		return nil, fmt.Errorf("invalid credential public key")
+	}
+	pub := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+	if !pub.Curve.IsOnCurve(pub.X, pub.Y) {
+		return nil, fmt.Errorf("invalid credential public key")
+	}
+	return pub, nil
+}
+
+// decodeB64 accepts base64url values with or without padding, as browsers libraries vary.
+func decodeB64(s string) ([]byte, error) {
+	s = strings.TrimRight(s, "=")
+	s = strings.NewReplacer("+", "-", "/", "_").Replace(s)
+	return b64url.DecodeString(s)
+}
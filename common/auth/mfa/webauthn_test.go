@@ -0,0 +1,347 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package mfa
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/binary"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/pydio/cells/common/proto/idm"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// softAuthenticator emulates a security key holding a single ES256 credential.
+type softAuthenticator struct {
+	key       *ecdsa.PrivateKey
+	id        []byte
+	rpId      string
+	origin    string
+	signCount uint32
+	verified  bool
+}
+
+func newSoftAuthenticator(rpId, origin string) *softAuthenticator {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	id := make([]byte, 16)
+	rand.Read(id)
+	return &softAuthenticator{key: key, id: id, rpId: rpId, origin: origin}
+}
+
+// cborEncode encodes the few types used by authenticators.
+func cborEncode(v interface{}) []byte {
+	head := func(major byte, arg uint64) []byte {
+		switch {
+		case arg < 24:
+			return []byte{major<<5 | byte(arg)}
+		case arg <= 0xff:
+			return []byte{major<<5 | 24, byte(arg)}
+		case arg <= 0xffff:
+			b := []byte{major<<5 | 25, 0, 0}
+			binary.BigEndian.PutUint16(b[1:], uint16(arg))
+			return b
+		}
+		b := []byte{major<<5 | 27, 0, 0, 0, 0, 0, 0, 0, 0}
+		binary.BigEndian.PutUint64(b[1:], arg)
+		return b
+	}
+	switch t := v.(type) {
+	case int:
+		if t < 0 {
+			return head(1, uint64(-1-t))
+		}
+		return head(0, uint64(t))
+	case []byte:
+		return append(head(2, uint64(len(t))), t...)
+	case string:
+		return append(head(3, uint64(len(t))), t...)
+	case []interface{}:
+		data := head(4, uint64(len(t)))
+		for _, i := range t {
+			data = append(data, cborEncode(i)...)
+		}
+		return data
+	case map[interface{}]interface{}:
+		data := head(5, uint64(len(t)))
+		for k, i := range t {
+			data = append(data, cborEncode(k)...)
+			data = append(data, cborEncode(i)...)
+		}
+		return data
+	case bool:
+		if t {
+			return []byte{0xf5}
+		}
+		return []byte{0xf4}
+	}
+	return []byte{0xf6}
+}
+
+func (a *softAuthenticator) clientData(typ, challenge string) []byte {
+	data, _ := json.Marshal(map[string]string{"type": typ, "challenge": challenge, "origin": a.origin})
+	return data
+}
+
+func (a *softAuthenticator) authData(attested bool) []byte {
+	rpIdHash := sha256.Sum256([]byte(a.rpId))
+	data := append([]byte{}, rpIdHash[:]...)
+	flags := byte(flagUserPresent)
+	if a.verified {
+		flags |= flagUserVerified
+	}
+	if attested {
+		flags |= flagAttested
+	}
+	data = append(data, flags)
+	counter := make([]byte, 4)
+	binary.BigEndian.PutUint32(counter, a.signCount)
+	data = append(data, counter...)
+	if attested {
+		data = append(data, make([]byte, 16)...)
+		length := make([]byte, 2)
+		binary.BigEndian.PutUint16(length, uint16(len(a.id)))
+		data = append(data, length...)
+		data = append(data, a.id...)
+		data = append(data, cborEncode(map[interface{}]interface{}{
+			coseKeyType: coseKeyTypeEC,
+			coseAlg:     coseAlgES256,
+			coseCurve:   coseCurveP256,
+			coseX:       a.key.X.Bytes(),
+			coseY:       a.key.Y.Bytes(),
+		})...)
+	}
+	return data
+}
+
+func (a *softAuthenticator) create(o *CredentialCreationOptions) *AttestationResponse {
+	att := cborEncode(map[interface{}]interface{}{
+		"fmt":      "none",
+		"attStmt":  map[interface{}]interface{}{},
+		"authData": a.authData(true),
+	})
+	return &AttestationResponse{
+		Id:                b64url.EncodeToString(a.id),
+		ClientDataJSON:    b64url.EncodeToString(a.clientData("webauthn.create", o.Challenge)),
+		AttestationObject: b64url.EncodeToString(att),
+	}
+}
+
+func (a *softAuthenticator) get(o *CredentialRequestOptions) *AssertionResponse {
+	a.signCount++
+	clientData := a.clientData("webauthn.get", o.Challenge)
+	authData := a.authData(false)
+	clientHash := sha256.Sum256(clientData)
+	signed := sha256.Sum256(append(append([]byte{}, authData...), clientHash[:]...))
+	r, s, _ := ecdsa.Sign(rand.Reader, a.key, signed[:])
+	signature, _ := asn1.Marshal(struct{ R, S *big.Int }{r, s})
+	return &AssertionResponse{
+		Id:                b64url.EncodeToString(a.id),
+		ClientDataJSON:    b64url.EncodeToString(clientData),
+		AuthenticatorData: b64url.EncodeToString(authData),
+		Signature:         b64url.EncodeToString(signature),
+	}
+}
+
+func TestCbor(t *testing.T) {
+
+	Convey("Test CBOR decoding", t, func() {
+		data := cborEncode(map[interface{}]interface{}{
+			"a":  []interface{}{1, -7, 500, 70000, true, nil},
+			-3:   []byte("bytes"),
+			"ok": "text",
+		})
+		v, rest, e := cborDecode(append(data, 0xa0))
+		So(e, ShouldBeNil)
+		So(rest, ShouldResemble, []byte{0xa0})
+		m := v.(map[interface{}]interface{})
+		So(m["a"], ShouldResemble, []interface{}{int64(1), int64(-7), int64(500), int64(70000), true, nil})
+		So(m[int64(-3)], ShouldResemble, []byte("bytes"))
+		So(m["ok"], ShouldEqual, "text")
+
+		_, _, e = cborDecode(data[:len(data)-2])
+		So(e, ShouldNotBeNil)
+		_, _, e = cborDecode([]byte{0x9f})
+		So(e, ShouldNotBeNil)
+		_, _, e = cborDecode([]byte{0x5b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff})
+		So(e, ShouldNotBeNil)
+	})
+
+}
+
+func TestWebauthn(t *testing.T) {
+
+	c := &WebauthnConfig{RpId: "cells.example.com", RpName: "Cells", Origin: "https://cells.example.com"}
+	user := &idm.User{Uuid: "user-uuid", Login: "john"}
+	now := time.Now()
+	challengesKey = func() ([]byte, error) {
+		return []byte("0123456789abcdef0123456789abcdef"), nil
+	}
+
+	Convey("Test registration and login with a software authenticator", t, func() {
+		m := &UserMfa{}
+		auth := newSoftAuthenticator(c.RpId, c.Origin)
+
+		options, e := m.BeginRegistration(c, user, now)
+		So(e, ShouldBeNil)
+		So(options.Rp.Id, ShouldEqual, c.RpId)
+		So(options.ExcludeCredentials, ShouldBeEmpty)
+		cred, e := m.FinishRegistration(c, "My key", auth.create(options), now)
+		So(e, ShouldBeNil)
+		So(cred.Name, ShouldEqual, "My key")
+		So(m.Enabled(), ShouldBeTrue)
+		So(m.WebauthnChallenge, ShouldBeEmpty)
+
+		// Same key cannot be registered twice
+		options, _ = m.BeginRegistration(c, user, now)
+		So(options.ExcludeCredentials, ShouldHaveLength, 1)
+		_, e = m.FinishRegistration(c, "Again", auth.create(options), now)
+		So(e, ShouldNotBeNil)
+
+		second := newSoftAuthenticator(c.RpId, c.Origin)
+		options, _ = m.BeginRegistration(c, user, now)
+		cred, e = m.FinishRegistration(c, "", second.create(options), now)
+		So(e, ShouldBeNil)
+		So(cred.Name, ShouldEqual, "Security key #2")
+		So(m.WebauthnCredentials, ShouldHaveLength, 2)
+
+		login, e := m.BeginLogin(c, "john", now)
+		So(e, ShouldBeNil)
+		So(login.AllowCredentials, ShouldHaveLength, 2)
+		assertion := auth.get(login)
+		cred, e = m.VerifyAssertion(c, assertion, "john", false, now)
+		So(e, ShouldBeNil)
+		So(cred.SignCount, ShouldEqual, 1)
+
+		// Challenge is consumed
+		_, e = m.VerifyAssertion(c, assertion, "john", false, now)
+		So(e, ShouldNotBeNil)
+
+		// Replayed counter
+		login, _ = m.BeginLogin(c, "john", now)
+		auth.signCount = 0
+		_, e = m.VerifyAssertion(c, auth.get(login), "john", false, now)
+		So(e, ShouldNotBeNil)
+
+		// Expired challenge
+		login, _ = m.BeginLogin(c, "john", now)
+		_, e = m.VerifyAssertion(c, second.get(login), "john", false, now.Add(WebauthnTimeout+time.Second))
+		So(e, ShouldNotBeNil)
+
+		// Passwordless requires user verification
+		login, _ = m.BeginLogin(c, "john", now)
+		_, e = m.VerifyAssertion(c, second.get(login), "john", true, now)
+		So(e, ShouldNotBeNil)
+		second.verified = true
+		login, _ = m.BeginLogin(c, "john", now)
+		_, e = m.VerifyAssertion(c, second.get(login), "john", true, now)
+		So(e, ShouldBeNil)
+
+		So(m.RemoveCredential(cred.Id), ShouldBeTrue)
+		So(m.WebauthnCredentials, ShouldHaveLength, 1)
+	})
+
+	Convey("Test invalid assertions", t, func() {
+		m := &UserMfa{}
+		auth := newSoftAuthenticator(c.RpId, c.Origin)
+		options, _ := m.BeginRegistration(c, user, now)
+		_, e := m.FinishRegistration(c, "key", auth.create(options), now)
+		So(e, ShouldBeNil)
+
+		// Wrong origin
+		phishing := *auth
+		phishing.origin = "https://evil.example.com"
+		login, _ := m.BeginLogin(c, "john", now)
+		_, e = m.VerifyAssertion(c, phishing.get(login), "john", false, now)
+		So(e, ShouldNotBeNil)
+
+		// Wrong relying party
+		phishing = *auth
+		phishing.rpId = "evil.example.com"
+		login, _ = m.BeginLogin(c, "john", now)
+		_, e = m.VerifyAssertion(c, phishing.get(login), "john", false, now)
+		So(e, ShouldNotBeNil)
+
+		// Other key with the same id
+		other := newSoftAuthenticator(c.RpId, c.Origin)
+		other.id = auth.id
+		login, _ = m.BeginLogin(c, "john", now)
+		_, e = m.VerifyAssertion(c, other.get(login), "john", false, now)
+		So(e, ShouldNotBeNil)
+
+		// Storage round trip, and header encoding
+		user := &idm.User{}
+		m.Store(user)
+		loaded, e := Load(user)
+		So(e, ShouldBeNil)
+		So(loaded.WebauthnCredentials, ShouldHaveLength, 1)
+		login, _ = loaded.BeginLogin(c, "john", now)
+		data, _ := json.Marshal(auth.get(login))
+		parsed, e := ParseAssertion(b64url.EncodeToString(data))
+		So(e, ShouldBeNil)
+		_, e = loaded.VerifyAssertion(c, parsed, "john", false, now)
+		So(e, ShouldBeNil)
+
+		So(loaded.Verify("000000", now), ShouldBeFalse)
+	})
+
+	Convey("Test login challenges", t, func() {
+		m := &UserMfa{}
+		auth := newSoftAuthenticator(c.RpId, c.Origin)
+		options, _ := m.BeginRegistration(c, user, now)
+		_, e := m.FinishRegistration(c, "key", auth.create(options), now)
+		So(e, ShouldBeNil)
+
+		// Starting a login does not touch the user
+		login, e := m.BeginLogin(c, "john", now)
+		So(e, ShouldBeNil)
+		So(m.WebauthnChallenge, ShouldBeEmpty)
+
+		// Challenge is bound to a login
+		_, e = m.VerifyAssertion(c, auth.get(login), "jane", false, now)
+		So(e, ShouldNotBeNil)
+		login, _ = m.BeginLogin(c, "john", now)
+		_, e = m.VerifyAssertion(c, auth.get(login), "John", false, now)
+		So(e, ShouldBeNil)
+
+		// Forged challenge
+		login, _ = m.BeginLogin(c, "john", now)
+		login.Challenge = b64url.EncodeToString(make([]byte, 40))
+		_, e = m.VerifyAssertion(c, auth.get(login), "john", false, now)
+		So(e, ShouldNotBeNil)
+
+		// Users without security key get a stable decoy
+		unknown, e := (&UserMfa{}).BeginLogin(c, "nobody", now)
+		So(e, ShouldBeNil)
+		So(unknown.AllowCredentials, ShouldHaveLength, 1)
+		again, _ := (&UserMfa{}).BeginLogin(c, "nobody", now)
+		So(again.AllowCredentials[0].Id, ShouldEqual, unknown.AllowCredentials[0].Id)
+		So(again.Challenge, ShouldNotEqual, unknown.Challenge)
+	})
+
+}
@@ -0,0 +1,160 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package auth
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/micro/go-micro/errors"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/config"
+)
+
+// PasswordPolicy holds the rules that passwords must comply with. It is configured with the
+// parameters of the core.auth plugin.
+type PasswordPolicy struct {
+	// Minimum number of characters
+	MinLength int
+	// Minimum number of characters classes (lowercase, uppercase, digits, others)
+	MinClasses int
+	// Number of previous passwords that cannot be reused
+	History int
+	// Passwords older than this must be changed, disabled if zero
+	MaxAge time.Duration
+	// Folder containing the breached passwords hashes, disabled if empty
+	BreachedList string
+}
+
+// DefaultPasswordPolicy loads the policy from the configuration.
+func DefaultPasswordPolicy() *PasswordPolicy {
+	return &PasswordPolicy{
+		MinLength:    passwordPolicyInt("PASSWORD_MINLENGTH", 8),
+		MinClasses:   passwordPolicyInt("PASSWORD_MIN_CLASSES", 0),
+		History:      passwordPolicyInt("PASSWORD_HISTORY", 0),
+		MaxAge:       time.Duration(passwordPolicyInt("PASSWORD_MAX_AGE", 0)) * 24 * time.Hour,
+		BreachedList: config.Get("frontend", "plugin", "core.auth", "PASSWORD_BREACHED_LIST").String(""),
+	}
+}
+
+// passwordPolicyInt reads an integer parameter, that may be stored as a string.
+func passwordPolicyInt(name string, def int) int {
+	value := config.Get("frontend", "plugin", "core.auth", name)
+	if value.Int(-1) != -1 {
+		return value.Int(def)
+	} else if s := value.String(""); s != "" {
+		if parsed, e := strconv.Atoi(s); e == nil {
+			return parsed
+		}
+	}
+	return def
+}
+
+// Validate checks the length and the characters classes of a new password, and that it is not
+// part of the breached passwords list. History is checked by the user service that stores the hashes.
+func (p *PasswordPolicy) Validate(password string) error {
+	if len([]rune(password)) < p.MinLength {
+		return errors.Forbidden(common.SERVICE_USER, "password must contain at least %d characters", p.MinLength)
+	}
+	if p.MinClasses > 0 && PasswordClasses(password) < p.MinClasses {
+		return errors.Forbidden(common.SERVICE_USER, "password must mix at least %d of lowercase letters, uppercase letters, digits and other characters", p.MinClasses)
+	}
+	if p.BreachedList != "" {
+		breached, e := PasswordBreached(p.BreachedList, password)
+		if e != nil {
+			return e
+		}
+		if breached {
+			return errors.Forbidden(common.SERVICE_USER, "this password appears in a list of breached passwords, please choose another one")
+		}
+	}
+	return nil
+}
+
+// Expired checks if a password changed at a given time must be changed again.
+func (p *PasswordPolicy) Expired(changed time.Time) bool {
+	return p.MaxAge > 0 && time.Now().After(changed.Add(p.MaxAge))
+}
+
+// PasswordClasses counts the classes of characters used by a password, among lowercase
+// letters, uppercase letters, digits and others.
+func PasswordClasses(password string) int {
+	var lower, upper, digit, other int
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = 1
+		case unicode.IsUpper(r):
+			upper = 1
+		case unicode.IsDigit(r):
+			digit = 1
+		default:
+			other = 1
+		}
+	}
+	return lower + upper + digit + other
+}
+
+// PasswordBreached looks for a password in a breached passwords list, using the k-anonymity format of
+// the "Pwned Passwords" range API: the folder contains one file per 5 first hexadecimal characters of
+// the password SHA-1 (named like "5BAA6" or "5BAA6.txt"), listing the remaining 35 characters of the
+// breached hashes followed by a colon and the number of occurrences.
+func PasswordBreached(folder string, password string) (bool, error) {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := hash[:5], hash[5:]
+
+	var file *os.File
+	for _, name := range []string{prefix, prefix + ".txt", strings.ToLower(prefix), strings.ToLower(prefix) + ".txt"} {
+		f, e := os.Open(filepath.Join(folder, name))
+		if e == nil {
+			file = f
+			break
+		}
+		if !os.IsNotExist(e) {
+			return false, e
+		}
+	}
+	if file == nil {
+		return false, nil
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if i := strings.Index(line, ":"); i > -1 {
+			line = line[:i]
+		}
+		if strings.EqualFold(line, suffix) {
+			return true, nil
+		}
+	}
+	return false, scanner.Err()
+}
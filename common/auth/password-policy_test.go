@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package auth
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPasswordPolicy(t *testing.T) {
+
+	Convey("Test length and classes", t, func() {
+		p := &PasswordPolicy{MinLength: 8, MinClasses: 3}
+		So(p.Validate("Ab1"), ShouldNotBeNil)
+		So(p.Validate("abcdefgh"), ShouldNotBeNil)
+		So(p.Validate("abcdEFGH"), ShouldNotBeNil)
+		So(p.Validate("abcdEF12"), ShouldBeNil)
+		So(p.Validate("abcdef1!"), ShouldBeNil)
+		So(PasswordClasses("éèàÉ"), ShouldEqual, 2)
+	})
+
+	Convey("Test expiration", t, func() {
+		p := &PasswordPolicy{}
+		So(p.Expired(time.Now().Add(-1000*24*time.Hour)), ShouldBeFalse)
+		p.MaxAge = 90 * 24 * time.Hour
+		So(p.Expired(time.Now().Add(-100*24*time.Hour)), ShouldBeTrue)
+		So(p.Expired(time.Now().Add(-10*24*time.Hour)), ShouldBeFalse)
+	})
+
+	Convey("Test breached passwords list", t, func() {
+		folder, _ := ioutil.TempDir("", "breached")
+		defer os.RemoveAll(folder)
+		// SHA-1 of "password" is 5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8
+		ioutil.WriteFile(filepath.Join(folder, "5BAA6.txt"), []byte("003D68EB55068C33ACE09247EE4C639306B:3\r\n1E4C9B93F3F0682250B6CF8331B7EE68FD8:3730471\r\n"), 0644)
+
+		breached, e := PasswordBreached(folder, "password")
+		So(e, ShouldBeNil)
+		So(breached, ShouldBeTrue)
+		breached, e = PasswordBreached(folder, "correct horse battery staple")
+		So(e, ShouldBeNil)
+		So(breached, ShouldBeFalse)
+
+		p := &PasswordPolicy{MinLength: 8, BreachedList: folder}
+		So(p.Validate("password"), ShouldNotBeNil)
+		So(p.Validate("passw0rd-not-breached"), ShouldBeNil)
+	})
+}
@@ -0,0 +1,189 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/auth/claim"
+	"github.com/pydio/cells/common/micro"
+	"github.com/pydio/cells/common/proto/auth"
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/utils/permissions"
+)
+
+const (
+	// PersonalAccessTokenPrefix starts the value of every personal access token, telling them apart from JWTs.
+	PersonalAccessTokenPrefix = "pat_"
+	// PersonalAccessTokenConnector is used as connector ID in the subject of the claims built from a token.
+	PersonalAccessTokenConnector = "pat"
+)
+
+// readOnlyPostResources are REST resources that are called with POST but do not modify anything.
+var readOnlyPostResources = []string{
+	"/acl",
+	"/activity/stream",
+	"/activity/subscriptions",
+	"/chat/messages",
+	"/chat/search",
+	"/chat/unread",
+	"/config/processes",
+	"/jobs/tasks/logs",
+	"/jobs/user",
+	"/log/sys",
+	"/meta/bulk/get",
+	"/meta/get/*",
+	"/policy",
+	"/search/nodes",
+	"/tree/admin/list",
+	"/tree/admin/stat",
+	"/tree/stats",
+	"/user",
+	"/user-meta/bookmarks",
+	"/user-meta/search",
+	"/workspace",
+}
+
+// mfaEnrollResources are the only REST resources allowed to sessions restricted to the enrollment of a second factor.
+var mfaEnrollResources = []string{
+	"/auth/token/revoke",
+	"/frontend/*",
+	"/mfa/*",
+}
+
+// IsPersonalAccessToken checks if a raw credential is a personal access token rather than a JWT.
+func IsPersonalAccessToken(value string) bool {
+	return strings.HasPrefix(value, PersonalAccessTokenPrefix)
+}
+
+// NewPersonalAccessTokenValue generates a random token value. It only contains URL-safe characters,
+// so that it can also be used as an S3 access key.
+func NewPersonalAccessTokenValue() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return PersonalAccessTokenPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// HashPersonalAccessToken computes the hash under which a token is stored.
+func HashPersonalAccessToken(value string) string {
+	h := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(h[:])
+}
+
+// RestScopeAllows checks if a REST request is in the scope of the personal access token the claims were built from,
+// or of a session restricted to the enrollment of a second factor.
+// Resources are paths relative to the REST endpoint, ending with "*" to match a whole branch.
+func RestScopeAllows(claims claim.Claims, method string, path string) bool {
+	if claims.MfaEnrollOnly {
+		return matchesResource(mfaEnrollResources, resourcePath(path))
+	}
+	if claims.PersonalAccessToken == "" {
+		return true
+	}
+	path = resourcePath(path)
+	if claims.ScopeReadOnly {
+		switch method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+		case http.MethodPost:
+			if !matchesResource(readOnlyPostResources, path) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	if len(claims.ScopeRestResources) == 0 {
+		return true
+	}
+	return matchesResource(claims.ScopeRestResources, path)
+}
+
+func matchesResource(resources []string, path string) bool {
+	for _, r := range resources {
+		if strings.HasSuffix(r, "*") {
+			if strings.HasPrefix(path, resourcePath(strings.TrimSuffix(r, "*"))) {
+				return true
+			}
+		} else if path == resourcePath(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// resourcePath normalizes a REST path, with or without the "/a" endpoint prefix.
+func resourcePath(p string) string {
+	p = strings.TrimPrefix(p, "/")
+	if p == "a" || strings.HasPrefix(p, "a/") {
+		p = strings.TrimPrefix(p, "a")
+	}
+	return "/" + strings.Trim(p, "/")
+}
+
+// verifyPersonalAccessToken checks a token against the auth service and builds the claims of its owner,
+// restricted to the token scope.
+func (j *JWTVerifier) verifyPersonalAccessToken(ctx context.Context, value string) (context.Context, claim.Claims, error) {
+
+	cli := auth.NewPersonalAccessTokenServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_AUTH, defaults.NewClient())
+	rsp, err := cli.Verify(ctx, &auth.PatVerifyRequest{Value: value})
+	if err != nil {
+		return ctx, claim.Claims{}, err
+	}
+	token := rsp.Token
+
+	user, err := permissions.SearchUniqueUser(ctx, "", token.UserUuid)
+	if err != nil {
+		return ctx, claim.Claims{}, err
+	}
+	if permissions.IsUserLocked(user) {
+		return ctx, claim.Claims{}, errors.New("user is locked")
+	}
+
+	subject, _ := proto.Marshal(&claim.IDTokenSubject{UserId: user.Uuid, ConnId: PersonalAccessTokenConnector})
+	claims := claim.Claims{
+		Issuer:              j.IssuerUrl,
+		Subject:             base64.RawURLEncoding.EncodeToString(subject),
+		Name:                user.Login,
+		Email:               user.Attributes[idm.UserAttrEmail],
+		Expiry:              time.Unix(token.ExpiresAt, 0),
+		AuthSource:          user.Attributes[idm.UserAttrAuthSource],
+		PersonalAccessToken: token.Uuid,
+		ScopeWorkspaces:     token.Workspaces,
+		ScopeReadOnly:       token.ReadOnly,
+		ScopeRestResources:  token.RestResources,
+	}
+	fillClaimsFromUser(&claims, user)
+
+	return claimsToContext(ctx, claims), claims, nil
+}
@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package auth
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common/auth/claim"
+)
+
+func TestPersonalAccessTokenValue(t *testing.T) {
+
+	Convey("Test token values", t, func() {
+		v1, e := NewPersonalAccessTokenValue()
+		So(e, ShouldBeNil)
+		v2, _ := NewPersonalAccessTokenValue()
+		So(v1, ShouldNotEqual, v2)
+		So(IsPersonalAccessToken(v1), ShouldBeTrue)
+		So(IsPersonalAccessToken("eyJhbGciOiJSUzI1NiJ9.e30.sig"), ShouldBeFalse)
+		So(v1, ShouldNotContainSubstring, "/")
+		So(HashPersonalAccessToken(v1), ShouldEqual, HashPersonalAccessToken(v1))
+		So(HashPersonalAccessToken(v1), ShouldNotEqual, HashPersonalAccessToken(v2))
+	})
+}
+
+func TestRestScopeAllows(t *testing.T) {
+
+	Convey("Test claims without token", t, func() {
+		So(RestScopeAllows(claim.Claims{ScopeReadOnly: true}, "DELETE", "/user/admin"), ShouldBeTrue)
+	})
+
+	Convey("Test read-only token", t, func() {
+		c := claim.Claims{PersonalAccessToken: "pat", ScopeReadOnly: true}
+		So(RestScopeAllows(c, "GET", "/workspace"), ShouldBeTrue)
+		So(RestScopeAllows(c, "POST", "/tree/stats"), ShouldBeTrue)
+		So(RestScopeAllows(c, "POST", "/a/meta/get/folder/file.txt"), ShouldBeTrue)
+		So(RestScopeAllows(c, "POST", "/tree/create"), ShouldBeFalse)
+		So(RestScopeAllows(c, "PUT", "/user/john"), ShouldBeFalse)
+		So(RestScopeAllows(c, "DELETE", "/auth/pat/uuid"), ShouldBeFalse)
+		So(RestScopeAllows(c, "POST", "/auth/token/revoke"), ShouldBeFalse)
+	})
+
+	Convey("Test token restricted to resources", t, func() {
+		c := claim.Claims{PersonalAccessToken: "pat", ScopeRestResources: []string{"/a/tree/stats", "/meta/*"}}
+		So(RestScopeAllows(c, "POST", "/tree/stats"), ShouldBeTrue)
+		So(RestScopeAllows(c, "POST", "/tree/stats/"), ShouldBeTrue)
+		So(RestScopeAllows(c, "POST", "/meta/set/folder"), ShouldBeTrue)
+		So(RestScopeAllows(c, "POST", "/tree/create"), ShouldBeFalse)
+		So(RestScopeAllows(c, "GET", "/auth/pat"), ShouldBeFalse)
+	})
+
+	Convey("Test session restricted to the enrollment of a second factor", t, func() {
+		c := claim.Claims{MfaEnrollOnly: true}
+		So(RestScopeAllows(c, "GET", "/a/mfa"), ShouldBeTrue)
+		So(RestScopeAllows(c, "POST", "/mfa/totp/enroll"), ShouldBeTrue)
+		So(RestScopeAllows(c, "GET", "/frontend/state"), ShouldBeTrue)
+		So(RestScopeAllows(c, "GET", "/workspace"), ShouldBeFalse)
+		So(RestScopeAllows(c, "POST", "/tree/stats"), ShouldBeFalse)
+	})
+}
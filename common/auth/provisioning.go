@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package auth
+
+import (
+	"context"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/micro"
+	"github.com/pydio/cells/common/proto/idm"
+	service "github.com/pydio/cells/common/service/proto"
+	"github.com/pydio/cells/common/utils/permissions"
+)
+
+// ApplyTo updates a user with the mapped attributes and group. Its roles managed by the mapping rules are replaced
+// by the mapped ones, that are created if necessary. Other roles are kept.
+func (m *MappedUser) ApplyTo(ctx context.Context, user *idm.User) error {
+	if user.Attributes == nil {
+		user.Attributes = make(map[string]string)
+	}
+	for k, v := range m.Attributes {
+		user.Attributes[k] = v
+	}
+	if m.GroupPath != "" {
+		user.GroupPath = m.GroupPath
+	}
+	var roles []*idm.Role
+	for _, r := range user.Roles {
+		if r.UserRole || r.GroupRole || m.ManagesRole(r.Uuid) {
+			continue
+		}
+		roles = append(roles, r)
+	}
+	for _, id := range m.Roles {
+		role, err := EnsureRole(ctx, id)
+		if err != nil {
+			return err
+		}
+		roles = append(roles, role)
+	}
+	user.Roles = roles
+	return nil
+}
+
+// EnsureRole loads a role by its id, creating it with the default policies if it does not exist.
+func EnsureRole(ctx context.Context, id string) (*idm.Role, error) {
+	if roles := permissions.GetRoles(ctx, []string{id}); len(roles) > 0 {
+		return roles[0], nil
+	}
+	roleCli := idm.NewRoleServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_ROLE, defaults.NewClient())
+	resp, err := roleCli.CreateRole(ctx, &idm.CreateRoleRequest{Role: &idm.Role{
+		Uuid:     id,
+		Label:    id,
+		Policies: service.NewResourcePoliciesBuilder().WithProfileRead(common.PYDIO_PROFILE_STANDARD).WithProfileWrite(common.PYDIO_PROFILE_ADMIN).Policies(),
+	}})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Role, nil
+}
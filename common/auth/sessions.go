@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/pydio/cells/common/auth/claim"
+)
+
+// SessionUuid computes the identifier of the session an IdToken belongs to. Tokens obtained by refreshing
+// a token share the nonce of the initial login, so they are grouped in the same session. It returns an
+// empty string for personal access tokens, that are managed separately.
+func SessionUuid(claims claim.Claims) string {
+	if claims.PersonalAccessToken != "" {
+		return ""
+	}
+	userUuid, e := claims.DecodeUserUuid()
+	if e != nil || userUuid == "" {
+		userUuid = claims.Subject
+	}
+	sum := sha256.Sum256([]byte(userUuid + "/" + claims.ClientApp + "/" + claims.Nonce))
+	return hex.EncodeToString(sum[:16])
+}
+
+// DeviceFromUserAgent builds a short description of a client from its User-Agent, like "Firefox on Linux".
+func DeviceFromUserAgent(userAgent string) string {
+	ua := strings.ToLower(userAgent)
+	if ua == "" {
+		return ""
+	}
+	switch {
+	case strings.Contains(ua, "cells-sync"):
+		return "Cells Sync"
+	case strings.Contains(ua, "pydiosync") || strings.Contains(ua, "pydio.sync"):
+		return "PydioSync"
+	}
+
+	var app, system string
+	switch {
+	case strings.Contains(ua, "edg/") || strings.Contains(ua, "edge/"):
+		app = "Edge"
+	case strings.Contains(ua, "opr/") || strings.Contains(ua, "opera"):
+		app = "Opera"
+	case strings.Contains(ua, "firefox/"):
+		app = "Firefox"
+	case strings.Contains(ua, "chrome/") || strings.Contains(ua, "crios/"):
+		app = "Chrome"
+	case strings.Contains(ua, "safari/"):
+		app = "Safari"
+	case strings.Contains(ua, "trident/") || strings.Contains(ua, "msie"):
+		app = "Internet Explorer"
+	}
+	switch {
+	case strings.Contains(ua, "iphone") || strings.Contains(ua, "ipad"):
+		system = "iOS"
+	case strings.Contains(ua, "android"):
+		system = "Android"
+	case strings.Contains(ua, "windows"):
+		system = "Windows"
+	case strings.Contains(ua, "mac os x") || strings.Contains(ua, "macintosh"):
+		system = "macOS"
+	case strings.Contains(ua, "linux"):
+		system = "Linux"
+	}
+
+	if app == "" {
+		// Not a browser, use the product name
+		fields := strings.Fields(userAgent)
+		if len(fields) == 0 {
+			return ""
+		}
+		app = fields[0]
+		if i := strings.Index(app, "/"); i > 0 {
+			app = app[:i]
+		}
+	}
+	if system == "" {
+		return app
+	}
+	return app + " on " + system
+}
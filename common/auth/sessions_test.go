@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package auth
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common/auth/claim"
+)
+
+func TestSessionUuid(t *testing.T) {
+
+	Convey("Test session identifiers", t, func() {
+		data, _ := proto.Marshal(&claim.IDTokenSubject{UserId: "user-uuid", ConnId: "pydio"})
+		c := claim.Claims{Subject: base64.RawURLEncoding.EncodeToString(data), ClientApp: "cells-front", Nonce: "nonce1"}
+		id := SessionUuid(c)
+		So(id, ShouldNotBeEmpty)
+
+		refreshed := c
+		refreshed.Name = "admin"
+		So(SessionUuid(refreshed), ShouldEqual, id)
+
+		other := c
+		other.Nonce = "nonce2"
+		So(SessionUuid(other), ShouldNotEqual, id)
+
+		other = c
+		other.ClientApp = "cells-sync"
+		So(SessionUuid(other), ShouldNotEqual, id)
+
+		c.PersonalAccessToken = "pat-uuid"
+		So(SessionUuid(c), ShouldBeEmpty)
+	})
+}
+
+func TestDeviceFromUserAgent(t *testing.T) {
+
+	Convey("Test devices descriptions", t, func() {
+		So(DeviceFromUserAgent(""), ShouldBeEmpty)
+		So(DeviceFromUserAgent(" \v\u00a0"), ShouldBeEmpty)
+		So(DeviceFromUserAgent("Mozilla/5.0 (X11; Linux x86_64; rv:68.0) Gecko/20100101 Firefox/68.0"), ShouldEqual, "Firefox on Linux")
+		So(DeviceFromUserAgent("Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/76.0.3809.100 Safari/537.36"), ShouldEqual, "Chrome on Windows")
+		So(DeviceFromUserAgent("Mozilla/5.0 (Macintosh; Intel Mac OS X 10_14_6) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/12.1.2 Safari/605.1.15"), ShouldEqual, "Safari on macOS")
+		So(DeviceFromUserAgent("Mozilla/5.0 (iPhone; CPU iPhone OS 12_4 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/12.1.2 Mobile/15E148 Safari/604.1"), ShouldEqual, "Safari on iOS")
+		So(DeviceFromUserAgent("cells-sync/0.9.0"), ShouldEqual, "Cells Sync")
+		So(DeviceFromUserAgent("Microsoft-WebDAV-MiniRedir/10.0.17134"), ShouldEqual, "Microsoft-WebDAV-MiniRedir")
+		So(DeviceFromUserAgent("curl/7.58.0"), ShouldEqual, "curl")
+	})
+}
@@ -60,6 +60,9 @@ const (
 	SERVICE_AUTH      = "auth"
 	SERVICE_WORKSPACE = "workspace"
 	SERVICE_POLICY    = "policy"
+	SERVICE_MFA       = "mfa"
+	SERVICE_SCIM      = "scim"
+	SERVICE_OCM       = "ocm"
 	SERVICE_GRAPH     = "graph"
 	SERVICE_USER_META = "user-meta"
 
@@ -76,6 +79,7 @@ const (
 	SERVICE_MAILER        = "mailer"
 	SERVICE_WEBSOCKET     = "websocket"
 	SERVICE_CHAT          = "chat"
+	SERVICE_PRESENCE      = "presence"
 	SERVICE_FRONTEND      = "frontend"
 	SERVICE_FRONT_STATICS = "statics"
 
@@ -121,8 +125,11 @@ const (
 	TOPIC_IDM_EVENT        = "topic.pydio.idm.event"
 	TOPIC_ACTIVITY_EVENT   = "topic.pydio.activity.event"
 	TOPIC_CHAT_EVENT       = "topic.pydio.chat.event"
+	TOPIC_PRESENCE_EVENT   = "topic.pydio.presence.event"
 	TOPIC_DATASOURCE_EVENT = "topic.pydio.datasource.event"
 	TOPIC_INDEX_EVENT      = "topic.pydio.index.event"
+	TOPIC_SESSION_EVENT    = "topic.pydio.session.event"
+	TOPIC_FILE_REQUEST     = "topic.pydio.share.filerequest"
 )
 
 // Define constants for metadata and fixed datasources
@@ -132,6 +139,7 @@ const (
 	META_NAMESPACE_NODE_TEST_LOCAL_FOLDER = "pydio:test:local-folder-storage"
 	META_NAMESPACE_RECYCLE_RESTORE        = "pydio:recycle_restore"
 	META_NAMESPACE_NODENAME               = "name"
+	META_NAMESPACE_INHERITED_ORIGINS      = "meta_inherited_origins"
 	RECYCLE_BIN_NAME                      = "recycle_bin"
 
 	PYDIO_THUMBSTORE_NAMESPACE        = "pydio-thumbstore"
@@ -154,6 +162,10 @@ const (
 	XPydioSessionUuid            = "X-Pydio-Session"
 	XPydioIndexationSessionUuid  = "X-Pydio-Indexation-Session"
 	XPydioMoveUuid               = "X-Pydio-Move"
+	XPydioMfaCode                = "X-Pydio-Mfa-Code"
+	XPydioWebauthnAssertion      = "X-Pydio-Webauthn-Assertion"
+	XPydioDropSession            = "X-Pydio-Drop-Session"
+	XPydioLinkRecipient          = "X-Pydio-Link-Recipient"
 
 	PYDIO_PROFILE_ADMIN    = "admin"
 	PYDIO_PROFILE_STANDARD = "standard"
@@ -178,6 +190,8 @@ var (
 		XPydioSessionUuid,
 		XPydioIndexationSessionUuid,
 		XPydioMoveUuid,
+		XPydioDropSession,
+		XPydioLinkRecipient,
 	}
 )
 
@@ -188,6 +202,12 @@ const (
 	DOCSTORE_ID_VERSIONING_POLICIES = "versioningPolicies"
 	DOCSTORE_ID_SHARES              = "share"
 	DOCSTORE_ID_RESET_PASS_KEYS     = "resetPasswordKeys"
+	DOCSTORE_ID_FILE_REQUEST_DROPS  = "fileRequestDrops"
+	DOCSTORE_ID_OCM_SHARES          = "ocmShares"
+	DOCSTORE_ID_LINK_ACCESS_LOG     = "linkAccessLog"
+	DOCSTORE_ID_SHARE_APPROVALS     = "shareApprovals"
+	DOCSTORE_ID_WORKSPACE_TEMPLATES = "workspaceTemplates"
+	DOCSTORE_ID_META_TAGS           = "user_meta_tags"
 )
 
 // Define constants for Loggging configuration
@@ -16,6 +16,23 @@ It has these top-level messages:
 	RevokeTokenResponse
 	PruneTokensRequest
 	PruneTokensResponse
+	PersonalAccessToken
+	PatGenerateRequest
+	PatGenerateResponse
+	PatVerifyRequest
+	PatVerifyResponse
+	PatListRequest
+	PatListResponse
+	PatRevokeRequest
+	PatRevokeResponse
+	Session
+	SessionRegisterRequest
+	SessionRegisterResponse
+	SessionListRequest
+	SessionListResponse
+	SessionRevokeRequest
+	SessionRevokeResponse
+	SessionEvent
 	LdapSearchFilter
 	LdapMapping
 	LdapMemberOfMapping
@@ -138,3 +155,201 @@ func (h *AuthTokenRevoker) Revoke(ctx context.Context, in *RevokeTokenRequest, o
 func (h *AuthTokenRevoker) PruneTokens(ctx context.Context, in *PruneTokensRequest, out *PruneTokensResponse) error {
 	return h.AuthTokenRevokerHandler.PruneTokens(ctx, in, out)
 }
+
+// Client API for PersonalAccessTokenService service
+
+type PersonalAccessTokenServiceClient interface {
+	// Generate creates a token and returns its value, that is only stored hashed
+	Generate(ctx context.Context, in *PatGenerateRequest, opts ...client.CallOption) (*PatGenerateResponse, error)
+	// Verify finds the valid token matching a value
+	Verify(ctx context.Context, in *PatVerifyRequest, opts ...client.CallOption) (*PatVerifyResponse, error)
+	// List the tokens of a user, or all tokens
+	List(ctx context.Context, in *PatListRequest, opts ...client.CallOption) (*PatListResponse, error)
+	// Revoke deletes a token
+	Revoke(ctx context.Context, in *PatRevokeRequest, opts ...client.CallOption) (*PatRevokeResponse, error)
+}
+
+type personalAccessTokenServiceClient struct {
+	c           client.Client
+	serviceName string
+}
+
+func NewPersonalAccessTokenServiceClient(serviceName string, c client.Client) PersonalAccessTokenServiceClient {
+	if c == nil {
+		c = client.NewClient()
+	}
+	if len(serviceName) == 0 {
+		serviceName = "auth"
+	}
+	return &personalAccessTokenServiceClient{
+		c:           c,
+		serviceName: serviceName,
+	}
+}
+
+func (c *personalAccessTokenServiceClient) Generate(ctx context.Context, in *PatGenerateRequest, opts ...client.CallOption) (*PatGenerateResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "PersonalAccessTokenService.Generate", in)
+	out := new(PatGenerateResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personalAccessTokenServiceClient) Verify(ctx context.Context, in *PatVerifyRequest, opts ...client.CallOption) (*PatVerifyResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "PersonalAccessTokenService.Verify", in)
+	out := new(PatVerifyResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personalAccessTokenServiceClient) List(ctx context.Context, in *PatListRequest, opts ...client.CallOption) (*PatListResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "PersonalAccessTokenService.List", in)
+	out := new(PatListResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personalAccessTokenServiceClient) Revoke(ctx context.Context, in *PatRevokeRequest, opts ...client.CallOption) (*PatRevokeResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "PersonalAccessTokenService.Revoke", in)
+	out := new(PatRevokeResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for PersonalAccessTokenService service
+
+type PersonalAccessTokenServiceHandler interface {
+	// Generate creates a token and returns its value, that is only stored hashed
+	Generate(context.Context, *PatGenerateRequest, *PatGenerateResponse) error
+	// Verify finds the valid token matching a value
+	Verify(context.Context, *PatVerifyRequest, *PatVerifyResponse) error
+	// List the tokens of a user, or all tokens
+	List(context.Context, *PatListRequest, *PatListResponse) error
+	// Revoke deletes a token
+	Revoke(context.Context, *PatRevokeRequest, *PatRevokeResponse) error
+}
+
+func RegisterPersonalAccessTokenServiceHandler(s server.Server, hdlr PersonalAccessTokenServiceHandler, opts ...server.HandlerOption) {
+	s.Handle(s.NewHandler(&PersonalAccessTokenService{hdlr}, opts...))
+}
+
+type PersonalAccessTokenService struct {
+	PersonalAccessTokenServiceHandler
+}
+
+func (h *PersonalAccessTokenService) Generate(ctx context.Context, in *PatGenerateRequest, out *PatGenerateResponse) error {
+	return h.PersonalAccessTokenServiceHandler.Generate(ctx, in, out)
+}
+
+func (h *PersonalAccessTokenService) Verify(ctx context.Context, in *PatVerifyRequest, out *PatVerifyResponse) error {
+	return h.PersonalAccessTokenServiceHandler.Verify(ctx, in, out)
+}
+
+func (h *PersonalAccessTokenService) List(ctx context.Context, in *PatListRequest, out *PatListResponse) error {
+	return h.PersonalAccessTokenServiceHandler.List(ctx, in, out)
+}
+
+func (h *PersonalAccessTokenService) Revoke(ctx context.Context, in *PatRevokeRequest, out *PatRevokeResponse) error {
+	return h.PersonalAccessTokenServiceHandler.Revoke(ctx, in, out)
+}
+
+// Client API for SessionService service
+
+type SessionServiceClient interface {
+	// Register creates or updates the session of an issued IdToken
+	Register(ctx context.Context, in *SessionRegisterRequest, opts ...client.CallOption) (*SessionRegisterResponse, error)
+	// List the active sessions of a user, or all active sessions
+	List(ctx context.Context, in *SessionListRequest, opts ...client.CallOption) (*SessionListResponse, error)
+	// Revoke invalidates one session or all the sessions of a user
+	Revoke(ctx context.Context, in *SessionRevokeRequest, opts ...client.CallOption) (*SessionRevokeResponse, error)
+}
+
+type sessionServiceClient struct {
+	c           client.Client
+	serviceName string
+}
+
+func NewSessionServiceClient(serviceName string, c client.Client) SessionServiceClient {
+	if c == nil {
+		c = client.NewClient()
+	}
+	if len(serviceName) == 0 {
+		serviceName = "auth"
+	}
+	return &sessionServiceClient{
+		c:           c,
+		serviceName: serviceName,
+	}
+}
+
+func (c *sessionServiceClient) Register(ctx context.Context, in *SessionRegisterRequest, opts ...client.CallOption) (*SessionRegisterResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "SessionService.Register", in)
+	out := new(SessionRegisterResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) List(ctx context.Context, in *SessionListRequest, opts ...client.CallOption) (*SessionListResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "SessionService.List", in)
+	out := new(SessionListResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) Revoke(ctx context.Context, in *SessionRevokeRequest, opts ...client.CallOption) (*SessionRevokeResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "SessionService.Revoke", in)
+	out := new(SessionRevokeResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for SessionService service
+
+type SessionServiceHandler interface {
+	// Register creates or updates the session of an issued IdToken
+	Register(context.Context, *SessionRegisterRequest, *SessionRegisterResponse) error
+	// List the active sessions of a user, or all active sessions
+	List(context.Context, *SessionListRequest, *SessionListResponse) error
+	// Revoke invalidates one session or all the sessions of a user
+	Revoke(context.Context, *SessionRevokeRequest, *SessionRevokeResponse) error
+}
+
+func RegisterSessionServiceHandler(s server.Server, hdlr SessionServiceHandler, opts ...server.HandlerOption) {
+	s.Handle(s.NewHandler(&SessionService{hdlr}, opts...))
+}
+
+type SessionService struct {
+	SessionServiceHandler
+}
+
+func (h *SessionService) Register(ctx context.Context, in *SessionRegisterRequest, out *SessionRegisterResponse) error {
+	return h.SessionServiceHandler.Register(ctx, in, out)
+}
+
+func (h *SessionService) List(ctx context.Context, in *SessionListRequest, out *SessionListResponse) error {
+	return h.SessionServiceHandler.List(ctx, in, out)
+}
+
+func (h *SessionService) Revoke(ctx context.Context, in *SessionRevokeRequest, out *SessionRevokeResponse) error {
+	return h.SessionServiceHandler.Revoke(ctx, in, out)
+}
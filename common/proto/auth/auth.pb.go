@@ -16,6 +16,23 @@ It has these top-level messages:
 	RevokeTokenResponse
 	PruneTokensRequest
 	PruneTokensResponse
+	PersonalAccessToken
+	PatGenerateRequest
+	PatGenerateResponse
+	PatVerifyRequest
+	PatVerifyResponse
+	PatListRequest
+	PatListResponse
+	PatRevokeRequest
+	PatRevokeResponse
+	Session
+	SessionRegisterRequest
+	SessionRegisterResponse
+	SessionListRequest
+	SessionListResponse
+	SessionRevokeRequest
+	SessionRevokeResponse
+	SessionEvent
 	LdapSearchFilter
 	LdapMapping
 	LdapMemberOfMapping
@@ -179,6 +196,505 @@ func (m *PruneTokensResponse) GetTokens() []string {
 	return nil
 }
 
+type PersonalAccessToken struct {
+	Uuid       string `protobuf:"bytes,1,opt,name=Uuid" json:"Uuid,omitempty"`
+	Label      string `protobuf:"bytes,2,opt,name=Label" json:"Label,omitempty"`
+	UserUuid   string `protobuf:"bytes,3,opt,name=UserUuid" json:"UserUuid,omitempty"`
+	UserLogin  string `protobuf:"bytes,4,opt,name=UserLogin" json:"UserLogin,omitempty"`
+	CreatedAt  int64  `protobuf:"varint,5,opt,name=CreatedAt" json:"CreatedAt,omitempty"`
+	ExpiresAt  int64  `protobuf:"varint,6,opt,name=ExpiresAt" json:"ExpiresAt,omitempty"`
+	LastUsedAt int64  `protobuf:"varint,7,opt,name=LastUsedAt" json:"LastUsedAt,omitempty"`
+	// Restrict the token to these workspaces, given by UUID or slug
+	Workspaces []string `protobuf:"bytes,8,rep,name=Workspaces" json:"Workspaces,omitempty"`
+	// Only grant read access to the workspaces and read requests on the REST API
+	ReadOnly bool `protobuf:"varint,9,opt,name=ReadOnly" json:"ReadOnly,omitempty"`
+	// Restrict the token to these REST resources, like /tree/stats or /meta/*
+	RestResources []string `protobuf:"bytes,10,rep,name=RestResources" json:"RestResources,omitempty"`
+}
+
+func (m *PersonalAccessToken) Reset()                    { *m = PersonalAccessToken{} }
+func (m *PersonalAccessToken) String() string            { return proto.CompactTextString(m) }
+func (*PersonalAccessToken) ProtoMessage()               {}
+func (*PersonalAccessToken) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *PersonalAccessToken) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *PersonalAccessToken) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *PersonalAccessToken) GetUserUuid() string {
+	if m != nil {
+		return m.UserUuid
+	}
+	return ""
+}
+
+func (m *PersonalAccessToken) GetUserLogin() string {
+	if m != nil {
+		return m.UserLogin
+	}
+	return ""
+}
+
+func (m *PersonalAccessToken) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *PersonalAccessToken) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *PersonalAccessToken) GetLastUsedAt() int64 {
+	if m != nil {
+		return m.LastUsedAt
+	}
+	return 0
+}
+
+func (m *PersonalAccessToken) GetWorkspaces() []string {
+	if m != nil {
+		return m.Workspaces
+	}
+	return nil
+}
+
+func (m *PersonalAccessToken) GetReadOnly() bool {
+	if m != nil {
+		return m.ReadOnly
+	}
+	return false
+}
+
+func (m *PersonalAccessToken) GetRestResources() []string {
+	if m != nil {
+		return m.RestResources
+	}
+	return nil
+}
+
+type PatGenerateRequest struct {
+	Token *PersonalAccessToken `protobuf:"bytes,1,opt,name=Token" json:"Token,omitempty"`
+}
+
+func (m *PatGenerateRequest) Reset()                    { *m = PatGenerateRequest{} }
+func (m *PatGenerateRequest) String() string            { return proto.CompactTextString(m) }
+func (*PatGenerateRequest) ProtoMessage()               {}
+func (*PatGenerateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *PatGenerateRequest) GetToken() *PersonalAccessToken {
+	if m != nil {
+		return m.Token
+	}
+	return nil
+}
+
+type PatGenerateResponse struct {
+	Token *PersonalAccessToken `protobuf:"bytes,1,opt,name=Token" json:"Token,omitempty"`
+	Value string               `protobuf:"bytes,2,opt,name=Value" json:"Value,omitempty"`
+}
+
+func (m *PatGenerateResponse) Reset()                    { *m = PatGenerateResponse{} }
+func (m *PatGenerateResponse) String() string            { return proto.CompactTextString(m) }
+func (*PatGenerateResponse) ProtoMessage()               {}
+func (*PatGenerateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *PatGenerateResponse) GetToken() *PersonalAccessToken {
+	if m != nil {
+		return m.Token
+	}
+	return nil
+}
+
+func (m *PatGenerateResponse) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type PatVerifyRequest struct {
+	Value string `protobuf:"bytes,1,opt,name=Value" json:"Value,omitempty"`
+}
+
+func (m *PatVerifyRequest) Reset()                    { *m = PatVerifyRequest{} }
+func (m *PatVerifyRequest) String() string            { return proto.CompactTextString(m) }
+func (*PatVerifyRequest) ProtoMessage()               {}
+func (*PatVerifyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *PatVerifyRequest) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type PatVerifyResponse struct {
+	Token *PersonalAccessToken `protobuf:"bytes,1,opt,name=Token" json:"Token,omitempty"`
+}
+
+func (m *PatVerifyResponse) Reset()                    { *m = PatVerifyResponse{} }
+func (m *PatVerifyResponse) String() string            { return proto.CompactTextString(m) }
+func (*PatVerifyResponse) ProtoMessage()               {}
+func (*PatVerifyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *PatVerifyResponse) GetToken() *PersonalAccessToken {
+	if m != nil {
+		return m.Token
+	}
+	return nil
+}
+
+type PatListRequest struct {
+	UserUuid string `protobuf:"bytes,1,opt,name=UserUuid" json:"UserUuid,omitempty"`
+}
+
+func (m *PatListRequest) Reset()                    { *m = PatListRequest{} }
+func (m *PatListRequest) String() string            { return proto.CompactTextString(m) }
+func (*PatListRequest) ProtoMessage()               {}
+func (*PatListRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+func (m *PatListRequest) GetUserUuid() string {
+	if m != nil {
+		return m.UserUuid
+	}
+	return ""
+}
+
+type PatListResponse struct {
+	Tokens []*PersonalAccessToken `protobuf:"bytes,1,rep,name=Tokens" json:"Tokens,omitempty"`
+}
+
+func (m *PatListResponse) Reset()                    { *m = PatListResponse{} }
+func (m *PatListResponse) String() string            { return proto.CompactTextString(m) }
+func (*PatListResponse) ProtoMessage()               {}
+func (*PatListResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *PatListResponse) GetTokens() []*PersonalAccessToken {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+type PatRevokeRequest struct {
+	Uuid string `protobuf:"bytes,1,opt,name=Uuid" json:"Uuid,omitempty"`
+}
+
+func (m *PatRevokeRequest) Reset()                    { *m = PatRevokeRequest{} }
+func (m *PatRevokeRequest) String() string            { return proto.CompactTextString(m) }
+func (*PatRevokeRequest) ProtoMessage()               {}
+func (*PatRevokeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+func (m *PatRevokeRequest) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+type PatRevokeResponse struct {
+	Success bool `protobuf:"varint,1,opt,name=Success" json:"Success,omitempty"`
+}
+
+func (m *PatRevokeResponse) Reset()                    { *m = PatRevokeResponse{} }
+func (m *PatRevokeResponse) String() string            { return proto.CompactTextString(m) }
+func (*PatRevokeResponse) ProtoMessage()               {}
+func (*PatRevokeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+
+func (m *PatRevokeResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+// Session groups the tokens issued by a login and their refreshes
+type Session struct {
+	Uuid      string `protobuf:"bytes,1,opt,name=Uuid" json:"Uuid,omitempty"`
+	UserUuid  string `protobuf:"bytes,2,opt,name=UserUuid" json:"UserUuid,omitempty"`
+	UserLogin string `protobuf:"bytes,3,opt,name=UserLogin" json:"UserLogin,omitempty"`
+	ClientId  string `protobuf:"bytes,4,opt,name=ClientId" json:"ClientId,omitempty"`
+	// Nonce passed at login, shared by the refreshed tokens
+	Nonce         string `protobuf:"bytes,5,opt,name=Nonce" json:"Nonce,omitempty"`
+	RemoteAddress string `protobuf:"bytes,6,opt,name=RemoteAddress" json:"RemoteAddress,omitempty"`
+	UserAgent     string `protobuf:"bytes,7,opt,name=UserAgent" json:"UserAgent,omitempty"`
+	// One of web, sync, dav or api
+	ClientType string `protobuf:"bytes,8,opt,name=ClientType" json:"ClientType,omitempty"`
+	// Human readable description of the client
+	Device       string `protobuf:"bytes,9,opt,name=Device" json:"Device,omitempty"`
+	CreatedAt    int64  `protobuf:"varint,10,opt,name=CreatedAt" json:"CreatedAt,omitempty"`
+	LastActivity int64  `protobuf:"varint,11,opt,name=LastActivity" json:"LastActivity,omitempty"`
+	// Expiration of the last issued IdToken
+	ExpiresAt int64 `protobuf:"varint,12,opt,name=ExpiresAt" json:"ExpiresAt,omitempty"`
+	// Issuance of the last IdToken
+	IssuedAt        int64 `protobuf:"varint,13,opt,name=IssuedAt" json:"IssuedAt,omitempty"`
+	HasRefreshToken bool  `protobuf:"varint,14,opt,name=HasRefreshToken" json:"HasRefreshToken,omitempty"`
+	// IdTokens issued before this date are rejected
+	RevokedAt int64 `protobuf:"varint,15,opt,name=RevokedAt" json:"RevokedAt,omitempty"`
+}
+
+func (m *Session) Reset()                    { *m = Session{} }
+func (m *Session) String() string            { return proto.CompactTextString(m) }
+func (*Session) ProtoMessage()               {}
+func (*Session) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+
+func (m *Session) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *Session) GetUserUuid() string {
+	if m != nil {
+		return m.UserUuid
+	}
+	return ""
+}
+
+func (m *Session) GetUserLogin() string {
+	if m != nil {
+		return m.UserLogin
+	}
+	return ""
+}
+
+func (m *Session) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *Session) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
+func (m *Session) GetRemoteAddress() string {
+	if m != nil {
+		return m.RemoteAddress
+	}
+	return ""
+}
+
+func (m *Session) GetUserAgent() string {
+	if m != nil {
+		return m.UserAgent
+	}
+	return ""
+}
+
+func (m *Session) GetClientType() string {
+	if m != nil {
+		return m.ClientType
+	}
+	return ""
+}
+
+func (m *Session) GetDevice() string {
+	if m != nil {
+		return m.Device
+	}
+	return ""
+}
+
+func (m *Session) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *Session) GetLastActivity() int64 {
+	if m != nil {
+		return m.LastActivity
+	}
+	return 0
+}
+
+func (m *Session) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *Session) GetIssuedAt() int64 {
+	if m != nil {
+		return m.IssuedAt
+	}
+	return 0
+}
+
+func (m *Session) GetHasRefreshToken() bool {
+	if m != nil {
+		return m.HasRefreshToken
+	}
+	return false
+}
+
+func (m *Session) GetRevokedAt() int64 {
+	if m != nil {
+		return m.RevokedAt
+	}
+	return 0
+}
+
+type SessionRegisterRequest struct {
+	IdToken         string `protobuf:"bytes,1,opt,name=IdToken" json:"IdToken,omitempty"`
+	HasRefreshToken bool   `protobuf:"varint,2,opt,name=HasRefreshToken" json:"HasRefreshToken,omitempty"`
+}
+
+func (m *SessionRegisterRequest) Reset()                    { *m = SessionRegisterRequest{} }
+func (m *SessionRegisterRequest) String() string            { return proto.CompactTextString(m) }
+func (*SessionRegisterRequest) ProtoMessage()               {}
+func (*SessionRegisterRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+
+func (m *SessionRegisterRequest) GetIdToken() string {
+	if m != nil {
+		return m.IdToken
+	}
+	return ""
+}
+
+func (m *SessionRegisterRequest) GetHasRefreshToken() bool {
+	if m != nil {
+		return m.HasRefreshToken
+	}
+	return false
+}
+
+type SessionRegisterResponse struct {
+	Session *Session `protobuf:"bytes,1,opt,name=Session" json:"Session,omitempty"`
+}
+
+func (m *SessionRegisterResponse) Reset()                    { *m = SessionRegisterResponse{} }
+func (m *SessionRegisterResponse) String() string            { return proto.CompactTextString(m) }
+func (*SessionRegisterResponse) ProtoMessage()               {}
+func (*SessionRegisterResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+
+func (m *SessionRegisterResponse) GetSession() *Session {
+	if m != nil {
+		return m.Session
+	}
+	return nil
+}
+
+type SessionListRequest struct {
+	UserUuid string `protobuf:"bytes,1,opt,name=UserUuid" json:"UserUuid,omitempty"`
+}
+
+func (m *SessionListRequest) Reset()                    { *m = SessionListRequest{} }
+func (m *SessionListRequest) String() string            { return proto.CompactTextString(m) }
+func (*SessionListRequest) ProtoMessage()               {}
+func (*SessionListRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+
+func (m *SessionListRequest) GetUserUuid() string {
+	if m != nil {
+		return m.UserUuid
+	}
+	return ""
+}
+
+type SessionListResponse struct {
+	Sessions []*Session `protobuf:"bytes,1,rep,name=Sessions" json:"Sessions,omitempty"`
+}
+
+func (m *SessionListResponse) Reset()                    { *m = SessionListResponse{} }
+func (m *SessionListResponse) String() string            { return proto.CompactTextString(m) }
+func (*SessionListResponse) ProtoMessage()               {}
+func (*SessionListResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+
+func (m *SessionListResponse) GetSessions() []*Session {
+	if m != nil {
+		return m.Sessions
+	}
+	return nil
+}
+
+type SessionRevokeRequest struct {
+	Uuid     string `protobuf:"bytes,1,opt,name=Uuid" json:"Uuid,omitempty"`
+	UserUuid string `protobuf:"bytes,2,opt,name=UserUuid" json:"UserUuid,omitempty"`
+}
+
+func (m *SessionRevokeRequest) Reset()                    { *m = SessionRevokeRequest{} }
+func (m *SessionRevokeRequest) String() string            { return proto.CompactTextString(m) }
+func (*SessionRevokeRequest) ProtoMessage()               {}
+func (*SessionRevokeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+
+func (m *SessionRevokeRequest) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *SessionRevokeRequest) GetUserUuid() string {
+	if m != nil {
+		return m.UserUuid
+	}
+	return ""
+}
+
+type SessionRevokeResponse struct {
+	Sessions []*Session `protobuf:"bytes,1,rep,name=Sessions" json:"Sessions,omitempty"`
+}
+
+func (m *SessionRevokeResponse) Reset()                    { *m = SessionRevokeResponse{} }
+func (m *SessionRevokeResponse) String() string            { return proto.CompactTextString(m) }
+func (*SessionRevokeResponse) ProtoMessage()               {}
+func (*SessionRevokeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+
+func (m *SessionRevokeResponse) GetSessions() []*Session {
+	if m != nil {
+		return m.Sessions
+	}
+	return nil
+}
+
+// SessionEvent is published on common.TOPIC_SESSION_EVENT when sessions or personal access tokens are revoked
+type SessionEvent struct {
+	Revoked       []*Session `protobuf:"bytes,1,rep,name=Revoked" json:"Revoked,omitempty"`
+	RevokedTokens []string   `protobuf:"bytes,2,rep,name=RevokedTokens" json:"RevokedTokens,omitempty"`
+}
+
+func (m *SessionEvent) Reset()                    { *m = SessionEvent{} }
+func (m *SessionEvent) String() string            { return proto.CompactTextString(m) }
+func (*SessionEvent) ProtoMessage()               {}
+func (*SessionEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+
+func (m *SessionEvent) GetRevoked() []*Session {
+	if m != nil {
+		return m.Revoked
+	}
+	return nil
+}
+
+func (m *SessionEvent) GetRevokedTokens() []string {
+	if m != nil {
+		return m.RevokedTokens
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Token)(nil), "auth.Token")
 	proto.RegisterType((*MatchInvalidTokenRequest)(nil), "auth.MatchInvalidTokenRequest")
@@ -187,33 +703,92 @@ func init() {
 	proto.RegisterType((*RevokeTokenResponse)(nil), "auth.RevokeTokenResponse")
 	proto.RegisterType((*PruneTokensRequest)(nil), "auth.PruneTokensRequest")
 	proto.RegisterType((*PruneTokensResponse)(nil), "auth.PruneTokensResponse")
+	proto.RegisterType((*PersonalAccessToken)(nil), "auth.PersonalAccessToken")
+	proto.RegisterType((*PatGenerateRequest)(nil), "auth.PatGenerateRequest")
+	proto.RegisterType((*PatGenerateResponse)(nil), "auth.PatGenerateResponse")
+	proto.RegisterType((*PatVerifyRequest)(nil), "auth.PatVerifyRequest")
+	proto.RegisterType((*PatVerifyResponse)(nil), "auth.PatVerifyResponse")
+	proto.RegisterType((*PatListRequest)(nil), "auth.PatListRequest")
+	proto.RegisterType((*PatListResponse)(nil), "auth.PatListResponse")
+	proto.RegisterType((*PatRevokeRequest)(nil), "auth.PatRevokeRequest")
+	proto.RegisterType((*PatRevokeResponse)(nil), "auth.PatRevokeResponse")
+	proto.RegisterType((*Session)(nil), "auth.Session")
+	proto.RegisterType((*SessionRegisterRequest)(nil), "auth.SessionRegisterRequest")
+	proto.RegisterType((*SessionRegisterResponse)(nil), "auth.SessionRegisterResponse")
+	proto.RegisterType((*SessionListRequest)(nil), "auth.SessionListRequest")
+	proto.RegisterType((*SessionListResponse)(nil), "auth.SessionListResponse")
+	proto.RegisterType((*SessionRevokeRequest)(nil), "auth.SessionRevokeRequest")
+	proto.RegisterType((*SessionRevokeResponse)(nil), "auth.SessionRevokeResponse")
+	proto.RegisterType((*SessionEvent)(nil), "auth.SessionEvent")
 	proto.RegisterEnum("auth.State", State_name, State_value)
 }
 
 func init() { proto.RegisterFile("auth.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 346 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4d, 0x4f, 0xfa, 0x40,
-	0x10, 0xc6, 0xe9, 0xff, 0x2f, 0x6f, 0x53, 0x42, 0xc8, 0x40, 0x4c, 0xe1, 0xa0, 0xb0, 0x07, 0x43,
-	0x4c, 0x44, 0x83, 0x07, 0x8f, 0x86, 0x08, 0x89, 0xc4, 0x20, 0xba, 0x10, 0xae, 0x66, 0x2d, 0x4b,
-	0x20, 0x90, 0x2e, 0xd2, 0x2d, 0x9f, 0xda, 0x0f, 0x61, 0xf6, 0xa5, 0xa6, 0x15, 0xb8, 0xf1, 0x3c,
-	0x33, 0xf3, 0xec, 0x6f, 0x86, 0x02, 0xb0, 0x48, 0x2e, 0x3b, 0xdb, 0x9d, 0x90, 0x02, 0xcf, 0xd4,
-	0x6f, 0x32, 0x80, 0xec, 0x54, 0xac, 0x79, 0x80, 0x35, 0xc8, 0xce, 0xd8, 0x26, 0xe2, 0x9e, 0xd3,
-	0x74, 0xda, 0x45, 0x6a, 0x04, 0x5e, 0x41, 0xb9, 0x37, 0x9f, 0xaf, 0xe4, 0x4a, 0x04, 0x6c, 0x33,
-	0x0c, 0x16, 0xc2, 0xfb, 0xa7, 0xcb, 0x7f, 0x5c, 0x72, 0x07, 0xde, 0x88, 0x49, 0x7f, 0x39, 0x0c,
-	0xf6, 0x6c, 0xb3, 0x9a, 0xeb, 0x48, 0xca, 0xbf, 0x22, 0x1e, 0x4a, 0x95, 0xac, 0x75, 0x9c, 0xac,
-	0x05, 0x59, 0x40, 0xfd, 0xc8, 0x44, 0xb8, 0x15, 0x41, 0xc8, 0xb1, 0x05, 0xd9, 0x89, 0x64, 0xd2,
-	0xc0, 0x94, 0xbb, 0x6e, 0x47, 0x73, 0x6b, 0x8b, 0x9a, 0x8a, 0x22, 0xa3, 0x7c, 0x2f, 0x7c, 0xa6,
-	0x28, 0x92, 0x64, 0x69, 0x97, 0x3c, 0x00, 0x2a, 0x67, 0xcd, 0x53, 0x4c, 0xad, 0x24, 0x93, 0x1b,
-	0x3f, 0x60, 0x5a, 0x2c, 0xe0, 0x2d, 0x54, 0x53, 0x83, 0x16, 0xcd, 0x83, 0xfc, 0x24, 0xf2, 0x7d,
-	0x1e, 0x86, 0x7a, 0xb6, 0x40, 0x63, 0x49, 0x6a, 0x80, 0x6f, 0xbb, 0x28, 0x30, 0xfd, 0xa1, 0x7d,
-	0x89, 0xdc, 0x40, 0x35, 0xe5, 0xda, 0x98, 0x73, 0xc8, 0x49, 0xed, 0x78, 0x4e, 0xf3, 0x7f, 0xbb,
-	0x48, 0xad, 0xba, 0x26, 0x76, 0x73, 0x2c, 0x41, 0xe1, 0x75, 0xfc, 0x31, 0xea, 0x4d, 0x9f, 0x9e,
-	0x2b, 0x19, 0x74, 0x21, 0x4f, 0x07, 0xb3, 0xf1, 0xcb, 0xa0, 0x5f, 0x71, 0xba, 0xdf, 0x0e, 0x54,
-	0x7a, 0x91, 0x5c, 0x5a, 0x30, 0xc5, 0xb8, 0xc3, 0x77, 0x28, 0x25, 0xef, 0x89, 0x17, 0x66, 0xa5,
-	0x53, 0xff, 0x4a, 0xe3, 0xf2, 0x64, 0xdd, 0x10, 0x92, 0x0c, 0x3e, 0x42, 0xce, 0xa4, 0xa3, 0x67,
-	0x9a, 0x0f, 0x0f, 0xd9, 0xa8, 0x1f, 0xa9, 0xfc, 0x06, 0xf4, 0xc1, 0x4d, 0xec, 0x1e, 0xa7, 0x1c,
-	0x1e, 0x29, 0x4e, 0x39, 0x72, 0x28, 0x92, 0xf9, 0xcc, 0xe9, 0xef, 0xf5, 0xfe, 0x27, 0x00, 0x00,
-	0xff, 0xff, 0x5f, 0x36, 0x50, 0x49, 0xbd, 0x02, 0x00, 0x00,
+	// 1022 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xed, 0x72, 0xdb, 0x44,
+	0x17, 0xae, 0xed, 0xc4, 0x96, 0x8f, 0x1d, 0x27, 0x5d, 0xa7, 0x89, 0xac, 0xb7, 0x6f, 0x49, 0x77,
+	0x98, 0xd6, 0x30, 0xf4, 0x83, 0x30, 0x0c, 0x3f, 0x18, 0x06, 0xd4, 0xd8, 0x50, 0x0f, 0x69, 0x63,
+	0x94, 0x0f, 0xfe, 0x94, 0x61, 0x54, 0x6b, 0x93, 0x68, 0x62, 0x24, 0xa3, 0x5d, 0x7b, 0xc8, 0x5d,
+	0x70, 0x37, 0x5c, 0x03, 0x57, 0xc1, 0x0d, 0x70, 0x11, 0xcc, 0x7e, 0x7a, 0x25, 0x2b, 0x29, 0xe5,
+	0xdf, 0x9e, 0xe7, 0x39, 0x7b, 0xce, 0xd9, 0x3d, 0xe7, 0xd1, 0x0a, 0x20, 0x9c, 0xb3, 0xcb, 0xa7,
+	0xb3, 0x2c, 0x65, 0x29, 0x5a, 0xe3, 0x6b, 0x3c, 0x84, 0xf5, 0x93, 0xf4, 0x8a, 0x24, 0x68, 0x1b,
+	0xd6, 0xcf, 0xc2, 0xe9, 0x9c, 0xb8, 0x95, 0xbd, 0x4a, 0xbf, 0x19, 0x48, 0x03, 0x3d, 0x82, 0x8e,
+	0x1f, 0x45, 0x31, 0x8b, 0xd3, 0x24, 0x9c, 0x8e, 0x92, 0xf3, 0xd4, 0xad, 0x0a, 0xba, 0x80, 0xe2,
+	0xe7, 0xe0, 0xbe, 0x0a, 0xd9, 0xe4, 0x72, 0x94, 0x2c, 0xc2, 0x69, 0x1c, 0x89, 0x90, 0x01, 0xf9,
+	0x75, 0x4e, 0x28, 0xe3, 0x91, 0x85, 0xad, 0x23, 0x0b, 0x03, 0x9f, 0x43, 0xaf, 0x64, 0x07, 0x9d,
+	0xa5, 0x09, 0x25, 0xe8, 0x21, 0xac, 0x1f, 0xb3, 0x90, 0xc9, 0x62, 0x3a, 0xfb, 0xad, 0xa7, 0xa2,
+	0x6e, 0x01, 0x05, 0x92, 0xe1, 0x95, 0x05, 0x64, 0x91, 0x4e, 0x42, 0x5e, 0x85, 0x5d, 0x59, 0x1e,
+	0xc5, 0x5f, 0x00, 0xe2, 0xc8, 0x15, 0xc9, 0xd5, 0xf4, 0xd0, 0xae, 0xa9, 0xa5, 0x13, 0x48, 0x17,
+	0x55, 0xe0, 0x33, 0xe8, 0xe6, 0x36, 0xaa, 0xd2, 0x5c, 0x68, 0x1c, 0xcf, 0x27, 0x13, 0x42, 0xa9,
+	0xd8, 0xeb, 0x04, 0xda, 0xc4, 0xdb, 0x80, 0xc6, 0xd9, 0x3c, 0x91, 0xfe, 0x54, 0x65, 0xc2, 0x4f,
+	0xa0, 0x9b, 0x43, 0x55, 0x98, 0x1d, 0xa8, 0x33, 0x81, 0xb8, 0x95, 0xbd, 0x5a, 0xbf, 0x19, 0x28,
+	0x0b, 0xff, 0x51, 0x85, 0xee, 0x98, 0x64, 0x94, 0xdf, 0xac, 0x2f, 0xe2, 0xca, 0xf6, 0x20, 0x58,
+	0x3b, 0x9d, 0xc7, 0x91, 0xba, 0x43, 0xb1, 0xe6, 0x17, 0x7b, 0x18, 0xbe, 0x25, 0x53, 0x75, 0x72,
+	0x69, 0x20, 0x0f, 0x9c, 0x53, 0x4a, 0x32, 0xe1, 0x5d, 0x13, 0x84, 0xb1, 0xd1, 0x7d, 0x68, 0xf2,
+	0xf5, 0x61, 0x7a, 0x11, 0x27, 0xee, 0x9a, 0x20, 0x97, 0x00, 0x67, 0x0f, 0x32, 0x12, 0x32, 0x12,
+	0xf9, 0xcc, 0x5d, 0xdf, 0xab, 0xf4, 0x6b, 0xc1, 0x12, 0xe0, 0xec, 0xf0, 0xb7, 0x59, 0x9c, 0x11,
+	0xea, 0x33, 0xb7, 0x2e, 0x59, 0x03, 0xa0, 0x07, 0x00, 0x87, 0x21, 0x65, 0xa7, 0x54, 0x6c, 0x6e,
+	0x08, 0xda, 0x42, 0x38, 0xff, 0x63, 0x9a, 0x5d, 0xd1, 0x59, 0x38, 0x21, 0xd4, 0x75, 0xc4, 0x99,
+	0x2d, 0x84, 0x57, 0x1d, 0x90, 0x30, 0x3a, 0x4a, 0xa6, 0xd7, 0x6e, 0x53, 0xdc, 0xab, 0xb1, 0xd1,
+	0x87, 0xb0, 0x11, 0x10, 0xca, 0x02, 0x42, 0xd3, 0x79, 0xc6, 0xb7, 0x83, 0xd8, 0x9e, 0x07, 0xf1,
+	0x10, 0xd0, 0x38, 0x64, 0xdf, 0x91, 0x84, 0x64, 0x7c, 0x4c, 0x54, 0xa3, 0x9f, 0xe5, 0x1b, 0xdd,
+	0x93, 0x8d, 0x2e, 0xb9, 0x61, 0xdd, 0xf6, 0x37, 0xd0, 0xcd, 0x85, 0x51, 0xfd, 0x7a, 0xdf, 0x38,
+	0x4b, 0x3d, 0x55, 0x2d, 0x3d, 0xe1, 0x3e, 0x6c, 0x8d, 0x43, 0x76, 0x46, 0xb2, 0xf8, 0xfc, 0xda,
+	0xd2, 0xc7, 0xaa, 0xf2, 0xf0, 0x00, 0xee, 0x5a, 0x9e, 0xff, 0xb1, 0x0a, 0xfc, 0x09, 0x74, 0xc6,
+	0x21, 0x3b, 0x8c, 0x29, 0xd3, 0xd9, 0xec, 0xf1, 0xa8, 0xe4, 0xc7, 0x03, 0x0f, 0x60, 0xd3, 0x78,
+	0xab, 0x8c, 0x9f, 0x42, 0xfd, 0x64, 0x39, 0xa7, 0xb7, 0xa6, 0x54, 0x8e, 0xf8, 0x91, 0x38, 0xa3,
+	0xd4, 0x8e, 0xce, 0x5a, 0x32, 0xbe, 0xf8, 0x09, 0xdc, 0xb5, 0xfc, 0xde, 0x29, 0xaf, 0x3f, 0x6b,
+	0xd0, 0x38, 0x26, 0x94, 0xc6, 0x69, 0xb9, 0x1a, 0xec, 0x83, 0x55, 0x6f, 0x9b, 0xfb, 0x5a, 0x71,
+	0xee, 0x3d, 0x70, 0x0e, 0xa6, 0x31, 0x49, 0xd8, 0x28, 0x52, 0xa2, 0x30, 0x36, 0x6f, 0xce, 0xeb,
+	0x34, 0x99, 0x10, 0xa1, 0x87, 0x66, 0x20, 0x0d, 0x39, 0x91, 0xbf, 0xa4, 0x8c, 0xf8, 0x51, 0x94,
+	0xf1, 0x5a, 0xeb, 0x82, 0xcd, 0x83, 0x3a, 0xab, 0x7f, 0x41, 0x12, 0x29, 0x89, 0x66, 0xb0, 0x04,
+	0xb8, 0x22, 0x64, 0x96, 0x93, 0xeb, 0x19, 0x71, 0x1d, 0x41, 0x5b, 0x08, 0xff, 0x42, 0x0c, 0xc8,
+	0x22, 0x9e, 0x10, 0xa1, 0x87, 0x66, 0xa0, 0xac, 0xbc, 0x4a, 0xa1, 0xa8, 0x52, 0x0c, 0x6d, 0xae,
+	0x3a, 0x7f, 0xc2, 0xe2, 0x45, 0xcc, 0xae, 0xdd, 0x96, 0x70, 0xc8, 0x61, 0x79, 0x25, 0xb7, 0x8b,
+	0x4a, 0xf6, 0xc0, 0x19, 0x51, 0x3a, 0x17, 0xe1, 0x37, 0x04, 0x69, 0x6c, 0xd4, 0x87, 0xcd, 0x97,
+	0x21, 0x0d, 0xc8, 0x79, 0x46, 0xe8, 0xa5, 0x9c, 0xc4, 0x8e, 0xe8, 0x52, 0x11, 0xe6, 0x39, 0x64,
+	0x67, 0x79, 0x98, 0x4d, 0x99, 0xc3, 0x00, 0xf8, 0x0d, 0xec, 0xa8, 0x56, 0x06, 0xe4, 0x22, 0xa6,
+	0x8c, 0x64, 0x7a, 0x50, 0x5c, 0x68, 0x8c, 0x22, 0xfb, 0xb9, 0xd0, 0x66, 0x59, 0xee, 0x6a, 0x69,
+	0x6e, 0xfc, 0x02, 0x76, 0x57, 0xa2, 0xab, 0xf1, 0x7a, 0x6c, 0x66, 0x48, 0x49, 0x68, 0x43, 0x3d,
+	0x2d, 0xca, 0x5f, 0xb3, 0xf8, 0x39, 0x20, 0xb5, 0xfc, 0xb7, 0xe2, 0xf9, 0x06, 0xba, 0xb9, 0x1d,
+	0x2a, 0xe3, 0x47, 0xe0, 0x28, 0x58, 0x4b, 0xa8, 0x90, 0xd2, 0xd0, 0xf8, 0x5b, 0xd8, 0xd6, 0xe0,
+	0xbb, 0xc4, 0x73, 0xdb, 0xb4, 0xe3, 0x17, 0x70, 0xaf, 0x10, 0xe7, 0xfd, 0x6b, 0xf9, 0x09, 0xda,
+	0x6a, 0x3d, 0x5c, 0xf0, 0x69, 0x7d, 0x0c, 0x0d, 0xd5, 0xbe, 0xf2, 0x9d, 0x9a, 0x95, 0xd2, 0x10,
+	0x4b, 0xf5, 0xdd, 0xa8, 0xea, 0x8f, 0xb5, 0x05, 0x7e, 0x8c, 0xd5, 0x03, 0x8f, 0xda, 0xe0, 0xbc,
+	0x3e, 0xfa, 0xf9, 0x95, 0x7f, 0x72, 0xf0, 0x72, 0xeb, 0x0e, 0x6a, 0x41, 0x23, 0x18, 0x9e, 0x1d,
+	0x7d, 0x3f, 0x1c, 0x6c, 0x55, 0xf6, 0xff, 0xae, 0xc0, 0x96, 0x3f, 0x67, 0x97, 0xea, 0xfd, 0xe5,
+	0xdb, 0x33, 0xf4, 0x03, 0xb4, 0xed, 0xdf, 0x06, 0xf4, 0x40, 0x96, 0x71, 0xd3, 0xcf, 0x87, 0xf7,
+	0xc1, 0x8d, 0xbc, 0xbc, 0x13, 0x7c, 0x07, 0x7d, 0x0d, 0x75, 0x19, 0x1d, 0xb9, 0xd2, 0x79, 0xf5,
+	0x7f, 0xc1, 0xeb, 0x95, 0x30, 0x26, 0xc0, 0x00, 0x5a, 0xd6, 0x13, 0xaf, 0xa3, 0xac, 0xfe, 0x0b,
+	0x78, 0xbd, 0x12, 0x46, 0x47, 0xd9, 0xff, 0xbd, 0x0a, 0x5e, 0xc9, 0x67, 0xf5, 0x98, 0x64, 0x42,
+	0xf6, 0x3e, 0x38, 0xfa, 0x51, 0x32, 0x19, 0x56, 0x9e, 0x3b, 0xaf, 0x57, 0xc2, 0x98, 0x3a, 0xbf,
+	0x84, 0xba, 0x7c, 0x4f, 0xd0, 0x8e, 0x71, 0xcb, 0x3d, 0x45, 0xde, 0xee, 0x0a, 0x6e, 0x36, 0x7f,
+	0x0e, 0x6b, 0x7c, 0xae, 0xd1, 0xb6, 0x71, 0xb1, 0x84, 0xe1, 0xdd, 0x2b, 0xa0, 0x76, 0x4e, 0x75,
+	0xb9, 0xcb, 0x9c, 0xb9, 0xe9, 0xf6, 0x76, 0x57, 0x70, 0x73, 0x25, 0x7f, 0x55, 0xa0, 0xa3, 0x06,
+	0x4c, 0x5f, 0xc3, 0x08, 0x1c, 0x2d, 0x6a, 0x74, 0x3f, 0x3f, 0x82, 0xf9, 0x2f, 0x89, 0xf7, 0xff,
+	0x1b, 0x58, 0x53, 0xda, 0x57, 0xea, 0x44, 0x6e, 0xce, 0xd1, 0x3e, 0x55, 0xaf, 0x84, 0x31, 0xdb,
+	0x0f, 0xcc, 0xc9, 0xbc, 0x42, 0x26, 0xfb, 0x74, 0xff, 0x2b, 0xe5, 0x74, 0x90, 0xb7, 0x75, 0xf1,
+	0x2f, 0xfe, 0xd9, 0x3f, 0x03, 0x00, 0xe1, 0x72, 0x17, 0xdb, 0x99, 0x0b, 0x00, 0x00,
 }
@@ -319,7 +319,7 @@ func init() {
 func init() { proto.RegisterFile("ldap.proto", fileDescriptor1) }
 
 var fileDescriptor1 = []byte{
-	// 596 bytes of a gzipped FileDescriptorProto
+	// 593 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcd, 0x6e, 0x13, 0x31,
 	0x10, 0x56, 0x48, 0xda, 0xa6, 0x93, 0xfe, 0x04, 0x03, 0xc5, 0x70, 0x40, 0x51, 0x84, 0x50, 0x55,
 	0xa4, 0x1c, 0xca, 0x8f, 0x2a, 0x6e, 0x25, 0xab, 0x42, 0xa5, 0x12, 0x22, 0xaf, 0x28, 0x67, 0x37,
@@ -356,6 +356,6 @@ var fileDescriptor1 = []byte{
 	0x61, 0xbf, 0xb6, 0x53, 0x74, 0xd7, 0xfe, 0xed, 0x49, 0x50, 0xb9, 0x2a, 0x60, 0xf5, 0x8a, 0xda,
 	0xf6, 0xee, 0xd5, 0xb7, 0xd7, 0x9c, 0x31, 0x9e, 0xcc, 0x31, 0x29, 0x53, 0xa4, 0xfb, 0xd5, 0x8d,
 	0xfb, 0xdc, 0xbc, 0x5c, 0x3e, 0x56, 0x11, 0x6a, 0x2e, 0xd2, 0x82, 0x76, 0xab, 0x97, 0xab, 0x8e,
-	0x5f, 0x6d, 0xda, 0x37, 0xf5, 0xd5, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x44, 0x07, 0x62, 0xa2,
-	0x61, 0x05, 0x00, 0x00,
+	0x5f, 0x6d, 0xda, 0x37, 0xf5, 0xd5, 0xbf, 0x01, 0x00, 0x44, 0x07, 0x62, 0xa2, 0x61, 0x05, 0x00,
+	0x00,
 }
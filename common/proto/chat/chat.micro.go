@@ -30,6 +30,9 @@ It is generated from these files:
 It has these top-level messages:
 	ChatRoom
 	ChatMessage
+	ChatAttachment
+	ChatReaction
+	ChatMessageRevision
 	PutRoomRequest
 	PutRoomResponse
 	PostMessageRequest
@@ -38,6 +41,18 @@ It has these top-level messages:
 	DeleteMessageResponse
 	ListMessagesRequest
 	ListMessagesResponse
+	UpdateMessageRequest
+	UpdateMessageResponse
+	ReactToMessageRequest
+	ReactToMessageResponse
+	MarkRoomReadRequest
+	MarkRoomReadResponse
+	CountUnreadRequest
+	CountUnreadResponse
+	SearchMessagesRequest
+	SearchMessagesResponse
+	PurgeMessagesRequest
+	PurgeMessagesResponse
 	ListRoomsRequest
 	ListRoomsResponse
 	DeleteRoomRequest
@@ -83,6 +98,12 @@ type ChatServiceClient interface {
 	ListMessages(ctx context.Context, in *ListMessagesRequest, opts ...client.CallOption) (ChatService_ListMessagesClient, error)
 	PostMessage(ctx context.Context, in *PostMessageRequest, opts ...client.CallOption) (*PostMessageResponse, error)
 	DeleteMessage(ctx context.Context, in *DeleteMessageRequest, opts ...client.CallOption) (*DeleteMessageResponse, error)
+	UpdateMessage(ctx context.Context, in *UpdateMessageRequest, opts ...client.CallOption) (*UpdateMessageResponse, error)
+	ReactToMessage(ctx context.Context, in *ReactToMessageRequest, opts ...client.CallOption) (*ReactToMessageResponse, error)
+	MarkRoomRead(ctx context.Context, in *MarkRoomReadRequest, opts ...client.CallOption) (*MarkRoomReadResponse, error)
+	CountUnread(ctx context.Context, in *CountUnreadRequest, opts ...client.CallOption) (*CountUnreadResponse, error)
+	SearchMessages(ctx context.Context, in *SearchMessagesRequest, opts ...client.CallOption) (*SearchMessagesResponse, error)
+	PurgeMessages(ctx context.Context, in *PurgeMessagesRequest, opts ...client.CallOption) (*PurgeMessagesResponse, error)
 }
 
 type chatServiceClient struct {
@@ -231,6 +252,66 @@ func (c *chatServiceClient) DeleteMessage(ctx context.Context, in *DeleteMessage
 	return out, nil
 }
 
+func (c *chatServiceClient) UpdateMessage(ctx context.Context, in *UpdateMessageRequest, opts ...client.CallOption) (*UpdateMessageResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "ChatService.UpdateMessage", in)
+	out := new(UpdateMessageResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ReactToMessage(ctx context.Context, in *ReactToMessageRequest, opts ...client.CallOption) (*ReactToMessageResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "ChatService.ReactToMessage", in)
+	out := new(ReactToMessageResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) MarkRoomRead(ctx context.Context, in *MarkRoomReadRequest, opts ...client.CallOption) (*MarkRoomReadResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "ChatService.MarkRoomRead", in)
+	out := new(MarkRoomReadResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) CountUnread(ctx context.Context, in *CountUnreadRequest, opts ...client.CallOption) (*CountUnreadResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "ChatService.CountUnread", in)
+	out := new(CountUnreadResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) SearchMessages(ctx context.Context, in *SearchMessagesRequest, opts ...client.CallOption) (*SearchMessagesResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "ChatService.SearchMessages", in)
+	out := new(SearchMessagesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) PurgeMessages(ctx context.Context, in *PurgeMessagesRequest, opts ...client.CallOption) (*PurgeMessagesResponse, error) {
+	req := c.c.NewRequest(c.serviceName, "ChatService.PurgeMessages", in)
+	out := new(PurgeMessagesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for ChatService service
 
 type ChatServiceHandler interface {
@@ -240,6 +321,12 @@ type ChatServiceHandler interface {
 	ListMessages(context.Context, *ListMessagesRequest, ChatService_ListMessagesStream) error
 	PostMessage(context.Context, *PostMessageRequest, *PostMessageResponse) error
 	DeleteMessage(context.Context, *DeleteMessageRequest, *DeleteMessageResponse) error
+	UpdateMessage(context.Context, *UpdateMessageRequest, *UpdateMessageResponse) error
+	ReactToMessage(context.Context, *ReactToMessageRequest, *ReactToMessageResponse) error
+	MarkRoomRead(context.Context, *MarkRoomReadRequest, *MarkRoomReadResponse) error
+	CountUnread(context.Context, *CountUnreadRequest, *CountUnreadResponse) error
+	SearchMessages(context.Context, *SearchMessagesRequest, *SearchMessagesResponse) error
+	PurgeMessages(context.Context, *PurgeMessagesRequest, *PurgeMessagesResponse) error
 }
 
 func RegisterChatServiceHandler(s server.Server, hdlr ChatServiceHandler, opts ...server.HandlerOption) {
@@ -335,3 +422,27 @@ func (h *ChatService) PostMessage(ctx context.Context, in *PostMessageRequest, o
 func (h *ChatService) DeleteMessage(ctx context.Context, in *DeleteMessageRequest, out *DeleteMessageResponse) error {
 	return h.ChatServiceHandler.DeleteMessage(ctx, in, out)
 }
+
+func (h *ChatService) UpdateMessage(ctx context.Context, in *UpdateMessageRequest, out *UpdateMessageResponse) error {
+	return h.ChatServiceHandler.UpdateMessage(ctx, in, out)
+}
+
+func (h *ChatService) ReactToMessage(ctx context.Context, in *ReactToMessageRequest, out *ReactToMessageResponse) error {
+	return h.ChatServiceHandler.ReactToMessage(ctx, in, out)
+}
+
+func (h *ChatService) MarkRoomRead(ctx context.Context, in *MarkRoomReadRequest, out *MarkRoomReadResponse) error {
+	return h.ChatServiceHandler.MarkRoomRead(ctx, in, out)
+}
+
+func (h *ChatService) CountUnread(ctx context.Context, in *CountUnreadRequest, out *CountUnreadResponse) error {
+	return h.ChatServiceHandler.CountUnread(ctx, in, out)
+}
+
+func (h *ChatService) SearchMessages(ctx context.Context, in *SearchMessagesRequest, out *SearchMessagesResponse) error {
+	return h.ChatServiceHandler.SearchMessages(ctx, in, out)
+}
+
+func (h *ChatService) PurgeMessages(ctx context.Context, in *PurgeMessagesRequest, out *PurgeMessagesResponse) error {
+	return h.ChatServiceHandler.PurgeMessages(ctx, in, out)
+}
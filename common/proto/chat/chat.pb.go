@@ -30,6 +30,9 @@ It is generated from these files:
 It has these top-level messages:
 	ChatRoom
 	ChatMessage
+	ChatAttachment
+	ChatReaction
+	ChatMessageRevision
 	PutRoomRequest
 	PutRoomResponse
 	PostMessageRequest
@@ -38,6 +41,18 @@ It has these top-level messages:
 	DeleteMessageResponse
 	ListMessagesRequest
 	ListMessagesResponse
+	UpdateMessageRequest
+	UpdateMessageResponse
+	ReactToMessageRequest
+	ReactToMessageResponse
+	MarkRoomReadRequest
+	MarkRoomReadResponse
+	CountUnreadRequest
+	CountUnreadResponse
+	SearchMessagesRequest
+	SearchMessagesResponse
+	PurgeMessagesRequest
+	PurgeMessagesResponse
 	ListRoomsRequest
 	ListRoomsResponse
 	DeleteRoomRequest
@@ -100,6 +115,9 @@ const (
 	WsMessageType_HISTORY     WsMessageType = 4
 	WsMessageType_DELETE_MSG  WsMessageType = 5
 	WsMessageType_DELETE_ROOM WsMessageType = 6
+	WsMessageType_EDIT_MSG    WsMessageType = 7
+	WsMessageType_REACT_MSG   WsMessageType = 8
+	WsMessageType_READ_ROOM   WsMessageType = 9
 )
 
 var WsMessageType_name = map[int32]string{
@@ -110,6 +128,9 @@ var WsMessageType_name = map[int32]string{
 	4: "HISTORY",
 	5: "DELETE_MSG",
 	6: "DELETE_ROOM",
+	7: "EDIT_MSG",
+	8: "REACT_MSG",
+	9: "READ_ROOM",
 }
 var WsMessageType_value = map[string]int32{
 	"JOIN":        0,
@@ -119,6 +140,9 @@ var WsMessageType_value = map[string]int32{
 	"HISTORY":     4,
 	"DELETE_MSG":  5,
 	"DELETE_ROOM": 6,
+	"EDIT_MSG":    7,
+	"REACT_MSG":   8,
+	"READ_ROOM":   9,
 }
 
 func (x WsMessageType) String() string {
@@ -189,6 +213,19 @@ type ChatMessage struct {
 	Author    string           `protobuf:"bytes,4,opt,name=Author" json:"Author,omitempty"`
 	Timestamp int64            `protobuf:"varint,5,opt,name=Timestamp" json:"Timestamp,omitempty"`
 	Activity  *activity.Object `protobuf:"bytes,6,opt,name=Activity" json:"Activity,omitempty"`
+	// Uuid of the parent message if this message is a reply in a thread
+	ParentUuid string `protobuf:"bytes,7,opt,name=ParentUuid" json:"ParentUuid,omitempty"`
+	// Logins of users mentioned with @login in the message
+	Mentions []string `protobuf:"bytes,8,rep,name=Mentions" json:"Mentions,omitempty"`
+	// References to nodes attached to this message
+	Attachments []*ChatAttachment `protobuf:"bytes,9,rep,name=Attachments" json:"Attachments,omitempty"`
+	Reactions   []*ChatReaction   `protobuf:"bytes,10,rep,name=Reactions" json:"Reactions,omitempty"`
+	// Timestamp of the last edition, 0 if never edited
+	Edited int64 `protobuf:"varint,11,opt,name=Edited" json:"Edited,omitempty"`
+	// Previous versions of the message
+	History []*ChatMessageRevision `protobuf:"bytes,12,rep,name=History" json:"History,omitempty"`
+	// Number of replies if this message is a thread root
+	RepliesCount int32 `protobuf:"varint,13,opt,name=RepliesCount" json:"RepliesCount,omitempty"`
 }
 
 func (m *ChatMessage) Reset()                    { *m = ChatMessage{} }
@@ -238,6 +275,159 @@ func (m *ChatMessage) GetActivity() *activity.Object {
 	return nil
 }
 
+func (m *ChatMessage) GetParentUuid() string {
+	if m != nil {
+		return m.ParentUuid
+	}
+	return ""
+}
+
+func (m *ChatMessage) GetMentions() []string {
+	if m != nil {
+		return m.Mentions
+	}
+	return nil
+}
+
+func (m *ChatMessage) GetAttachments() []*ChatAttachment {
+	if m != nil {
+		return m.Attachments
+	}
+	return nil
+}
+
+func (m *ChatMessage) GetReactions() []*ChatReaction {
+	if m != nil {
+		return m.Reactions
+	}
+	return nil
+}
+
+func (m *ChatMessage) GetEdited() int64 {
+	if m != nil {
+		return m.Edited
+	}
+	return 0
+}
+
+func (m *ChatMessage) GetHistory() []*ChatMessageRevision {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+func (m *ChatMessage) GetRepliesCount() int32 {
+	if m != nil {
+		return m.RepliesCount
+	}
+	return 0
+}
+
+type ChatAttachment struct {
+	NodeUuid string `protobuf:"bytes,1,opt,name=NodeUuid" json:"NodeUuid,omitempty"`
+	Path     string `protobuf:"bytes,2,opt,name=Path" json:"Path,omitempty"`
+	Label    string `protobuf:"bytes,3,opt,name=Label" json:"Label,omitempty"`
+	MimeType string `protobuf:"bytes,4,opt,name=MimeType" json:"MimeType,omitempty"`
+	Size     int64  `protobuf:"varint,5,opt,name=Size" json:"Size,omitempty"`
+	IsLeaf   bool   `protobuf:"varint,6,opt,name=IsLeaf" json:"IsLeaf,omitempty"`
+}
+
+func (m *ChatAttachment) Reset()                    { *m = ChatAttachment{} }
+func (m *ChatAttachment) String() string            { return proto.CompactTextString(m) }
+func (*ChatAttachment) ProtoMessage()               {}
+func (*ChatAttachment) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *ChatAttachment) GetNodeUuid() string {
+	if m != nil {
+		return m.NodeUuid
+	}
+	return ""
+}
+
+func (m *ChatAttachment) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *ChatAttachment) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *ChatAttachment) GetMimeType() string {
+	if m != nil {
+		return m.MimeType
+	}
+	return ""
+}
+
+func (m *ChatAttachment) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *ChatAttachment) GetIsLeaf() bool {
+	if m != nil {
+		return m.IsLeaf
+	}
+	return false
+}
+
+type ChatReaction struct {
+	Emoji string   `protobuf:"bytes,1,opt,name=Emoji" json:"Emoji,omitempty"`
+	Users []string `protobuf:"bytes,2,rep,name=Users" json:"Users,omitempty"`
+}
+
+func (m *ChatReaction) Reset()                    { *m = ChatReaction{} }
+func (m *ChatReaction) String() string            { return proto.CompactTextString(m) }
+func (*ChatReaction) ProtoMessage()               {}
+func (*ChatReaction) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *ChatReaction) GetEmoji() string {
+	if m != nil {
+		return m.Emoji
+	}
+	return ""
+}
+
+func (m *ChatReaction) GetUsers() []string {
+	if m != nil {
+		return m.Users
+	}
+	return nil
+}
+
+type ChatMessageRevision struct {
+	Message   string `protobuf:"bytes,1,opt,name=Message" json:"Message,omitempty"`
+	Timestamp int64  `protobuf:"varint,2,opt,name=Timestamp" json:"Timestamp,omitempty"`
+}
+
+func (m *ChatMessageRevision) Reset()                    { *m = ChatMessageRevision{} }
+func (m *ChatMessageRevision) String() string            { return proto.CompactTextString(m) }
+func (*ChatMessageRevision) ProtoMessage()               {}
+func (*ChatMessageRevision) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *ChatMessageRevision) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ChatMessageRevision) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
 type PutRoomRequest struct {
 	Room *ChatRoom `protobuf:"bytes,1,opt,name=Room" json:"Room,omitempty"`
 }
@@ -245,7 +435,7 @@ type PutRoomRequest struct {
 func (m *PutRoomRequest) Reset()                    { *m = PutRoomRequest{} }
 func (m *PutRoomRequest) String() string            { return proto.CompactTextString(m) }
 func (*PutRoomRequest) ProtoMessage()               {}
-func (*PutRoomRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+func (*PutRoomRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
 
 func (m *PutRoomRequest) GetRoom() *ChatRoom {
 	if m != nil {
@@ -261,7 +451,7 @@ type PutRoomResponse struct {
 func (m *PutRoomResponse) Reset()                    { *m = PutRoomResponse{} }
 func (m *PutRoomResponse) String() string            { return proto.CompactTextString(m) }
 func (*PutRoomResponse) ProtoMessage()               {}
-func (*PutRoomResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (*PutRoomResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
 
 func (m *PutRoomResponse) GetRoom() *ChatRoom {
 	if m != nil {
@@ -277,7 +467,7 @@ type PostMessageRequest struct {
 func (m *PostMessageRequest) Reset()                    { *m = PostMessageRequest{} }
 func (m *PostMessageRequest) String() string            { return proto.CompactTextString(m) }
 func (*PostMessageRequest) ProtoMessage()               {}
-func (*PostMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (*PostMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
 
 func (m *PostMessageRequest) GetMessages() []*ChatMessage {
 	if m != nil {
@@ -294,7 +484,7 @@ type PostMessageResponse struct {
 func (m *PostMessageResponse) Reset()                    { *m = PostMessageResponse{} }
 func (m *PostMessageResponse) String() string            { return proto.CompactTextString(m) }
 func (*PostMessageResponse) ProtoMessage()               {}
-func (*PostMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (*PostMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
 
 func (m *PostMessageResponse) GetSuccess() bool {
 	if m != nil {
@@ -317,7 +507,7 @@ type DeleteMessageRequest struct {
 func (m *DeleteMessageRequest) Reset()                    { *m = DeleteMessageRequest{} }
 func (m *DeleteMessageRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteMessageRequest) ProtoMessage()               {}
-func (*DeleteMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (*DeleteMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
 
 func (m *DeleteMessageRequest) GetMessages() []*ChatMessage {
 	if m != nil {
@@ -333,7 +523,7 @@ type DeleteMessageResponse struct {
 func (m *DeleteMessageResponse) Reset()                    { *m = DeleteMessageResponse{} }
 func (m *DeleteMessageResponse) String() string            { return proto.CompactTextString(m) }
 func (*DeleteMessageResponse) ProtoMessage()               {}
-func (*DeleteMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+func (*DeleteMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
 
 func (m *DeleteMessageResponse) GetSuccess() bool {
 	if m != nil {
@@ -348,12 +538,14 @@ type ListMessagesRequest struct {
 	LastMessage string `protobuf:"bytes,2,opt,name=LastMessage" json:"LastMessage,omitempty"`
 	Offset      int64  `protobuf:"varint,3,opt,name=Offset" json:"Offset,omitempty"`
 	Limit       int64  `protobuf:"varint,4,opt,name=Limit" json:"Limit,omitempty"`
+	// List replies of a given thread. If empty, only top-level messages are listed
+	ParentUuid string `protobuf:"bytes,5,opt,name=ParentUuid" json:"ParentUuid,omitempty"`
 }
 
 func (m *ListMessagesRequest) Reset()                    { *m = ListMessagesRequest{} }
 func (m *ListMessagesRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListMessagesRequest) ProtoMessage()               {}
-func (*ListMessagesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (*ListMessagesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
 
 func (m *ListMessagesRequest) GetRoomUuid() string {
 	if m != nil {
@@ -383,6 +575,13 @@ func (m *ListMessagesRequest) GetLimit() int64 {
 	return 0
 }
 
+func (m *ListMessagesRequest) GetParentUuid() string {
+	if m != nil {
+		return m.ParentUuid
+	}
+	return ""
+}
+
 type ListMessagesResponse struct {
 	Message *ChatMessage `protobuf:"bytes,1,opt,name=Message" json:"Message,omitempty"`
 }
@@ -390,7 +589,7 @@ type ListMessagesResponse struct {
 func (m *ListMessagesResponse) Reset()                    { *m = ListMessagesResponse{} }
 func (m *ListMessagesResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListMessagesResponse) ProtoMessage()               {}
-func (*ListMessagesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+func (*ListMessagesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
 
 func (m *ListMessagesResponse) GetMessage() *ChatMessage {
 	if m != nil {
@@ -399,15 +598,309 @@ func (m *ListMessagesResponse) GetMessage() *ChatMessage {
 	return nil
 }
 
+type UpdateMessageRequest struct {
+	Message *ChatMessage `protobuf:"bytes,1,opt,name=Message" json:"Message,omitempty"`
+}
+
+func (m *UpdateMessageRequest) Reset()                    { *m = UpdateMessageRequest{} }
+func (m *UpdateMessageRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateMessageRequest) ProtoMessage()               {}
+func (*UpdateMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *UpdateMessageRequest) GetMessage() *ChatMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+type UpdateMessageResponse struct {
+	Message *ChatMessage `protobuf:"bytes,1,opt,name=Message" json:"Message,omitempty"`
+}
+
+func (m *UpdateMessageResponse) Reset()                    { *m = UpdateMessageResponse{} }
+func (m *UpdateMessageResponse) String() string            { return proto.CompactTextString(m) }
+func (*UpdateMessageResponse) ProtoMessage()               {}
+func (*UpdateMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+func (m *UpdateMessageResponse) GetMessage() *ChatMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+type ReactToMessageRequest struct {
+	RoomUuid    string `protobuf:"bytes,1,opt,name=RoomUuid" json:"RoomUuid,omitempty"`
+	MessageUuid string `protobuf:"bytes,2,opt,name=MessageUuid" json:"MessageUuid,omitempty"`
+	Emoji       string `protobuf:"bytes,3,opt,name=Emoji" json:"Emoji,omitempty"`
+	User        string `protobuf:"bytes,4,opt,name=User" json:"User,omitempty"`
+	// Remove the reaction instead of adding it
+	Remove bool `protobuf:"varint,5,opt,name=Remove" json:"Remove,omitempty"`
+}
+
+func (m *ReactToMessageRequest) Reset()                    { *m = ReactToMessageRequest{} }
+func (m *ReactToMessageRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReactToMessageRequest) ProtoMessage()               {}
+func (*ReactToMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+
+func (m *ReactToMessageRequest) GetRoomUuid() string {
+	if m != nil {
+		return m.RoomUuid
+	}
+	return ""
+}
+
+func (m *ReactToMessageRequest) GetMessageUuid() string {
+	if m != nil {
+		return m.MessageUuid
+	}
+	return ""
+}
+
+func (m *ReactToMessageRequest) GetEmoji() string {
+	if m != nil {
+		return m.Emoji
+	}
+	return ""
+}
+
+func (m *ReactToMessageRequest) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *ReactToMessageRequest) GetRemove() bool {
+	if m != nil {
+		return m.Remove
+	}
+	return false
+}
+
+type ReactToMessageResponse struct {
+	Message *ChatMessage `protobuf:"bytes,1,opt,name=Message" json:"Message,omitempty"`
+}
+
+func (m *ReactToMessageResponse) Reset()                    { *m = ReactToMessageResponse{} }
+func (m *ReactToMessageResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReactToMessageResponse) ProtoMessage()               {}
+func (*ReactToMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+
+func (m *ReactToMessageResponse) GetMessage() *ChatMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+type MarkRoomReadRequest struct {
+	RoomUuid string `protobuf:"bytes,1,opt,name=RoomUuid" json:"RoomUuid,omitempty"`
+	User     string `protobuf:"bytes,2,opt,name=User" json:"User,omitempty"`
+	// Last message read by the user. If empty, the whole room is marked as read
+	LastMessage string `protobuf:"bytes,3,opt,name=LastMessage" json:"LastMessage,omitempty"`
+}
+
+func (m *MarkRoomReadRequest) Reset()                    { *m = MarkRoomReadRequest{} }
+func (m *MarkRoomReadRequest) String() string            { return proto.CompactTextString(m) }
+func (*MarkRoomReadRequest) ProtoMessage()               {}
+func (*MarkRoomReadRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+
+func (m *MarkRoomReadRequest) GetRoomUuid() string {
+	if m != nil {
+		return m.RoomUuid
+	}
+	return ""
+}
+
+func (m *MarkRoomReadRequest) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *MarkRoomReadRequest) GetLastMessage() string {
+	if m != nil {
+		return m.LastMessage
+	}
+	return ""
+}
+
+type MarkRoomReadResponse struct {
+	Success bool `protobuf:"varint,1,opt,name=Success" json:"Success,omitempty"`
+}
+
+func (m *MarkRoomReadResponse) Reset()                    { *m = MarkRoomReadResponse{} }
+func (m *MarkRoomReadResponse) String() string            { return proto.CompactTextString(m) }
+func (*MarkRoomReadResponse) ProtoMessage()               {}
+func (*MarkRoomReadResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+
+func (m *MarkRoomReadResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+type CountUnreadRequest struct {
+	User      string   `protobuf:"bytes,1,opt,name=User" json:"User,omitempty"`
+	RoomUuids []string `protobuf:"bytes,2,rep,name=RoomUuids" json:"RoomUuids,omitempty"`
+}
+
+func (m *CountUnreadRequest) Reset()                    { *m = CountUnreadRequest{} }
+func (m *CountUnreadRequest) String() string            { return proto.CompactTextString(m) }
+func (*CountUnreadRequest) ProtoMessage()               {}
+func (*CountUnreadRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+
+func (m *CountUnreadRequest) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *CountUnreadRequest) GetRoomUuids() []string {
+	if m != nil {
+		return m.RoomUuids
+	}
+	return nil
+}
+
+type CountUnreadResponse struct {
+	Counts map[string]int32 `protobuf:"bytes,1,rep,name=Counts" json:"Counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+}
+
+func (m *CountUnreadResponse) Reset()                    { *m = CountUnreadResponse{} }
+func (m *CountUnreadResponse) String() string            { return proto.CompactTextString(m) }
+func (*CountUnreadResponse) ProtoMessage()               {}
+func (*CountUnreadResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+
+func (m *CountUnreadResponse) GetCounts() map[string]int32 {
+	if m != nil {
+		return m.Counts
+	}
+	return nil
+}
+
+type SearchMessagesRequest struct {
+	// Full-text query, using the bleve query string syntax
+	Query string `protobuf:"bytes,1,opt,name=Query" json:"Query,omitempty"`
+	// Restrict search to these rooms. Callers are responsible for checking access to them
+	RoomUuids []string `protobuf:"bytes,2,rep,name=RoomUuids" json:"RoomUuids,omitempty"`
+	Offset    int32    `protobuf:"varint,3,opt,name=Offset" json:"Offset,omitempty"`
+	Limit     int32    `protobuf:"varint,4,opt,name=Limit" json:"Limit,omitempty"`
+}
+
+func (m *SearchMessagesRequest) Reset()                    { *m = SearchMessagesRequest{} }
+func (m *SearchMessagesRequest) String() string            { return proto.CompactTextString(m) }
+func (*SearchMessagesRequest) ProtoMessage()               {}
+func (*SearchMessagesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+
+func (m *SearchMessagesRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *SearchMessagesRequest) GetRoomUuids() []string {
+	if m != nil {
+		return m.RoomUuids
+	}
+	return nil
+}
+
+func (m *SearchMessagesRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *SearchMessagesRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type SearchMessagesResponse struct {
+	Messages []*ChatMessage `protobuf:"bytes,1,rep,name=Messages" json:"Messages,omitempty"`
+	Total    int32          `protobuf:"varint,2,opt,name=Total" json:"Total,omitempty"`
+}
+
+func (m *SearchMessagesResponse) Reset()                    { *m = SearchMessagesResponse{} }
+func (m *SearchMessagesResponse) String() string            { return proto.CompactTextString(m) }
+func (*SearchMessagesResponse) ProtoMessage()               {}
+func (*SearchMessagesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+
+func (m *SearchMessagesResponse) GetMessages() []*ChatMessage {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+func (m *SearchMessagesResponse) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+type PurgeMessagesRequest struct {
+	RoomType RoomType `protobuf:"varint,1,opt,name=RoomType,enum=chat.RoomType" json:"RoomType,omitempty"`
+	// Unix timestamp: messages posted before this date are deleted
+	OlderThan int64 `protobuf:"varint,2,opt,name=OlderThan" json:"OlderThan,omitempty"`
+}
+
+func (m *PurgeMessagesRequest) Reset()                    { *m = PurgeMessagesRequest{} }
+func (m *PurgeMessagesRequest) String() string            { return proto.CompactTextString(m) }
+func (*PurgeMessagesRequest) ProtoMessage()               {}
+func (*PurgeMessagesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+
+func (m *PurgeMessagesRequest) GetRoomType() RoomType {
+	if m != nil {
+		return m.RoomType
+	}
+	return RoomType_GLOBAL
+}
+
+func (m *PurgeMessagesRequest) GetOlderThan() int64 {
+	if m != nil {
+		return m.OlderThan
+	}
+	return 0
+}
+
+type PurgeMessagesResponse struct {
+	Count int32 `protobuf:"varint,1,opt,name=Count" json:"Count,omitempty"`
+}
+
+func (m *PurgeMessagesResponse) Reset()                    { *m = PurgeMessagesResponse{} }
+func (m *PurgeMessagesResponse) String() string            { return proto.CompactTextString(m) }
+func (*PurgeMessagesResponse) ProtoMessage()               {}
+func (*PurgeMessagesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+
+func (m *PurgeMessagesResponse) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
 type ListRoomsRequest struct {
 	ByType     RoomType `protobuf:"varint,1,opt,name=ByType,enum=chat.RoomType" json:"ByType,omitempty"`
 	TypeObject string   `protobuf:"bytes,2,opt,name=TypeObject" json:"TypeObject,omitempty"`
+	Uuids      []string `protobuf:"bytes,3,rep,name=Uuids" json:"Uuids,omitempty"`
 }
 
 func (m *ListRoomsRequest) Reset()                    { *m = ListRoomsRequest{} }
 func (m *ListRoomsRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListRoomsRequest) ProtoMessage()               {}
-func (*ListRoomsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+func (*ListRoomsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
 
 func (m *ListRoomsRequest) GetByType() RoomType {
 	if m != nil {
@@ -423,6 +916,13 @@ func (m *ListRoomsRequest) GetTypeObject() string {
 	return ""
 }
 
+func (m *ListRoomsRequest) GetUuids() []string {
+	if m != nil {
+		return m.Uuids
+	}
+	return nil
+}
+
 type ListRoomsResponse struct {
 	Room *ChatRoom `protobuf:"bytes,1,opt,name=Room" json:"Room,omitempty"`
 }
@@ -430,7 +930,7 @@ type ListRoomsResponse struct {
 func (m *ListRoomsResponse) Reset()                    { *m = ListRoomsResponse{} }
 func (m *ListRoomsResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListRoomsResponse) ProtoMessage()               {}
-func (*ListRoomsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+func (*ListRoomsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
 
 func (m *ListRoomsResponse) GetRoom() *ChatRoom {
 	if m != nil {
@@ -446,7 +946,7 @@ type DeleteRoomRequest struct {
 func (m *DeleteRoomRequest) Reset()                    { *m = DeleteRoomRequest{} }
 func (m *DeleteRoomRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteRoomRequest) ProtoMessage()               {}
-func (*DeleteRoomRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+func (*DeleteRoomRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
 
 func (m *DeleteRoomRequest) GetRoom() *ChatRoom {
 	if m != nil {
@@ -462,7 +962,7 @@ type DeleteRoomResponse struct {
 func (m *DeleteRoomResponse) Reset()                    { *m = DeleteRoomResponse{} }
 func (m *DeleteRoomResponse) String() string            { return proto.CompactTextString(m) }
 func (*DeleteRoomResponse) ProtoMessage()               {}
-func (*DeleteRoomResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+func (*DeleteRoomResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
 
 func (m *DeleteRoomResponse) GetSuccess() bool {
 	if m != nil {
@@ -480,7 +980,7 @@ type ChatEvent struct {
 func (m *ChatEvent) Reset()                    { *m = ChatEvent{} }
 func (m *ChatEvent) String() string            { return proto.CompactTextString(m) }
 func (*ChatEvent) ProtoMessage()               {}
-func (*ChatEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+func (*ChatEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
 
 func (m *ChatEvent) GetMessage() *ChatMessage {
 	if m != nil {
@@ -504,15 +1004,17 @@ func (m *ChatEvent) GetDetails() string {
 }
 
 type WebSocketMessage struct {
-	Type    WsMessageType `protobuf:"varint,1,opt,name=Type,json=@type,enum=chat.WsMessageType" json:"Type,omitempty"`
-	Room    *ChatRoom     `protobuf:"bytes,2,opt,name=Room" json:"Room,omitempty"`
-	Message *ChatMessage  `protobuf:"bytes,3,opt,name=Message" json:"Message,omitempty"`
+	Type     WsMessageType          `protobuf:"varint,1,opt,name=Type,json=@type,enum=chat.WsMessageType" json:"Type,omitempty"`
+	Room     *ChatRoom              `protobuf:"bytes,2,opt,name=Room" json:"Room,omitempty"`
+	Message  *ChatMessage           `protobuf:"bytes,3,opt,name=Message" json:"Message,omitempty"`
+	History  *ListMessagesRequest   `protobuf:"bytes,4,opt,name=History" json:"History,omitempty"`
+	Reaction *ReactToMessageRequest `protobuf:"bytes,5,opt,name=Reaction" json:"Reaction,omitempty"`
 }
 
 func (m *WebSocketMessage) Reset()                    { *m = WebSocketMessage{} }
 func (m *WebSocketMessage) String() string            { return proto.CompactTextString(m) }
 func (*WebSocketMessage) ProtoMessage()               {}
-func (*WebSocketMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+func (*WebSocketMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
 
 func (m *WebSocketMessage) GetType() WsMessageType {
 	if m != nil {
@@ -535,9 +1037,26 @@ func (m *WebSocketMessage) GetMessage() *ChatMessage {
 	return nil
 }
 
+func (m *WebSocketMessage) GetHistory() *ListMessagesRequest {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+func (m *WebSocketMessage) GetReaction() *ReactToMessageRequest {
+	if m != nil {
+		return m.Reaction
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ChatRoom)(nil), "chat.ChatRoom")
 	proto.RegisterType((*ChatMessage)(nil), "chat.ChatMessage")
+	proto.RegisterType((*ChatAttachment)(nil), "chat.ChatAttachment")
+	proto.RegisterType((*ChatReaction)(nil), "chat.ChatReaction")
+	proto.RegisterType((*ChatMessageRevision)(nil), "chat.ChatMessageRevision")
 	proto.RegisterType((*PutRoomRequest)(nil), "chat.PutRoomRequest")
 	proto.RegisterType((*PutRoomResponse)(nil), "chat.PutRoomResponse")
 	proto.RegisterType((*PostMessageRequest)(nil), "chat.PostMessageRequest")
@@ -546,6 +1065,18 @@ func init() {
 	proto.RegisterType((*DeleteMessageResponse)(nil), "chat.DeleteMessageResponse")
 	proto.RegisterType((*ListMessagesRequest)(nil), "chat.ListMessagesRequest")
 	proto.RegisterType((*ListMessagesResponse)(nil), "chat.ListMessagesResponse")
+	proto.RegisterType((*UpdateMessageRequest)(nil), "chat.UpdateMessageRequest")
+	proto.RegisterType((*UpdateMessageResponse)(nil), "chat.UpdateMessageResponse")
+	proto.RegisterType((*ReactToMessageRequest)(nil), "chat.ReactToMessageRequest")
+	proto.RegisterType((*ReactToMessageResponse)(nil), "chat.ReactToMessageResponse")
+	proto.RegisterType((*MarkRoomReadRequest)(nil), "chat.MarkRoomReadRequest")
+	proto.RegisterType((*MarkRoomReadResponse)(nil), "chat.MarkRoomReadResponse")
+	proto.RegisterType((*CountUnreadRequest)(nil), "chat.CountUnreadRequest")
+	proto.RegisterType((*CountUnreadResponse)(nil), "chat.CountUnreadResponse")
+	proto.RegisterType((*SearchMessagesRequest)(nil), "chat.SearchMessagesRequest")
+	proto.RegisterType((*SearchMessagesResponse)(nil), "chat.SearchMessagesResponse")
+	proto.RegisterType((*PurgeMessagesRequest)(nil), "chat.PurgeMessagesRequest")
+	proto.RegisterType((*PurgeMessagesResponse)(nil), "chat.PurgeMessagesResponse")
 	proto.RegisterType((*ListRoomsRequest)(nil), "chat.ListRoomsRequest")
 	proto.RegisterType((*ListRoomsResponse)(nil), "chat.ListRoomsResponse")
 	proto.RegisterType((*DeleteRoomRequest)(nil), "chat.DeleteRoomRequest")
@@ -559,58 +1090,99 @@ func init() {
 func init() { proto.RegisterFile("chat.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 847 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdd, 0x8e, 0xdb, 0x44,
-	0x14, 0xae, 0xe3, 0x24, 0x9b, 0x1c, 0xb3, 0xa9, 0x77, 0x76, 0xdb, 0xba, 0x06, 0xa1, 0xc8, 0x17,
-	0x55, 0xd4, 0x42, 0x02, 0xe1, 0xa7, 0xe2, 0x06, 0xc8, 0x26, 0xd6, 0xb6, 0xe0, 0xad, 0xa3, 0x71,
-	0xc2, 0x0a, 0x2e, 0xa8, 0x1c, 0x67, 0xda, 0x18, 0xd6, 0xeb, 0x90, 0x99, 0x44, 0xca, 0x25, 0x0f,
-	0xc0, 0x23, 0xf0, 0x24, 0xdc, 0xf0, 0x68, 0x68, 0x7e, 0xec, 0x38, 0x3f, 0xb0, 0x5d, 0x71, 0xe7,
-	0xf3, 0xf7, 0xcd, 0x77, 0xce, 0x7c, 0x73, 0x12, 0x80, 0x68, 0x16, 0xb2, 0xf6, 0x7c, 0x91, 0xb2,
-	0x14, 0x95, 0xf9, 0xb7, 0x3d, 0x78, 0x1b, 0xb3, 0xd9, 0x72, 0xd2, 0x8e, 0xd2, 0xa4, 0x33, 0x5f,
-	0x4f, 0xe3, 0xb4, 0x43, 0xc9, 0x62, 0x15, 0x47, 0x84, 0x76, 0xa2, 0x34, 0x49, 0xd2, 0x9b, 0x8e,
-	0xc8, 0xee, 0x84, 0x11, 0x8b, 0x57, 0x31, 0x5b, 0xe7, 0x1f, 0x94, 0x2d, 0x48, 0x98, 0x48, 0x2c,
-	0xe7, 0x6f, 0x0d, 0x6a, 0xfd, 0x59, 0xc8, 0x70, 0x9a, 0x26, 0x08, 0x41, 0x79, 0xbc, 0x8c, 0xa7,
-	0x96, 0xd6, 0xd4, 0x5a, 0x75, 0x2c, 0xbe, 0x91, 0x03, 0xe5, 0xd1, 0x7a, 0x4e, 0xac, 0x52, 0x53,
-	0x6b, 0x35, 0xba, 0x8d, 0xb6, 0xe0, 0xc1, 0xb3, 0xb9, 0x17, 0x8b, 0x18, 0x7a, 0x02, 0x8d, 0xcc,
-	0xe3, 0x4f, 0x7e, 0x21, 0x11, 0xb3, 0x74, 0x81, 0xb0, 0xe3, 0x45, 0x1f, 0x40, 0x9d, 0x7b, 0xbc,
-	0x70, 0x42, 0xae, 0xad, 0xb2, 0x48, 0xd9, 0x38, 0xd0, 0x19, 0x54, 0xc6, 0x94, 0x2c, 0xa8, 0x55,
-	0x69, 0xea, 0xad, 0x3a, 0x96, 0x06, 0x6a, 0x82, 0xe1, 0x85, 0x94, 0x8d, 0xe7, 0xd3, 0x90, 0x91,
-	0xa9, 0x55, 0x6d, 0x6a, 0xad, 0x0a, 0x2e, 0xba, 0x9c, 0xbf, 0x34, 0x30, 0x78, 0x0b, 0x97, 0x84,
-	0xd2, 0xf0, 0x2d, 0x39, 0xd8, 0x85, 0x0d, 0x35, 0x7e, 0x90, 0xf0, 0x97, 0x84, 0x3f, 0xb7, 0x91,
-	0x05, 0x47, 0xaa, 0x54, 0xd1, 0xce, 0x4c, 0xf4, 0x10, 0xaa, 0xbd, 0x25, 0x9b, 0xa5, 0x0b, 0x45,
-	0x56, 0x59, 0xbc, 0x8f, 0x51, 0x9c, 0x10, 0xca, 0xc2, 0x64, 0x6e, 0x55, 0x9a, 0x5a, 0x4b, 0xc7,
-	0x1b, 0x07, 0xfa, 0x08, 0x6a, 0x3d, 0x35, 0x6a, 0x41, 0xd7, 0xe8, 0x9a, 0xed, 0x6c, 0xf6, 0x6d,
-	0x39, 0x09, 0x9c, 0x67, 0x38, 0x9f, 0x43, 0x63, 0xb8, 0x14, 0xe3, 0xc7, 0xe4, 0xb7, 0x25, 0xa1,
-	0x8c, 0x4f, 0x9c, 0x9b, 0x82, 0xbf, 0x91, 0x4d, 0x3c, 0xbb, 0x23, 0x2c, 0x62, 0xce, 0x17, 0x70,
-	0x3f, 0xaf, 0xa2, 0xf3, 0xf4, 0x86, 0x92, 0x77, 0x2a, 0xeb, 0x03, 0x1a, 0xa6, 0x34, 0x9b, 0x54,
-	0x76, 0xe0, 0xc7, 0x50, 0x53, 0x1e, 0x6a, 0x69, 0x4d, 0xbd, 0x65, 0x74, 0x4f, 0x36, 0xd5, 0x59,
-	0x6e, 0x9e, 0xe2, 0xfc, 0x0c, 0xa7, 0x5b, 0x20, 0xea, 0x7c, 0x0b, 0x8e, 0x82, 0x65, 0x14, 0x11,
-	0x4a, 0x05, 0x85, 0x1a, 0xce, 0xcc, 0x2d, 0xfc, 0xd2, 0xed, 0xf8, 0x2e, 0x9c, 0x0d, 0xc8, 0x35,
-	0x61, 0xe4, 0xff, 0xd1, 0xfc, 0x14, 0x1e, 0xec, 0xc0, 0xdc, 0x46, 0xd4, 0xf9, 0x5d, 0x83, 0x53,
-	0x2f, 0xce, 0x5b, 0xa3, 0xd9, 0xc9, 0x45, 0xf5, 0x68, 0x3b, 0xea, 0x51, 0xfa, 0xcc, 0x14, 0x24,
-	0xc5, 0x55, 0x74, 0x71, 0x15, 0xf9, 0x6f, 0xde, 0x50, 0x22, 0x5f, 0x85, 0x8e, 0x95, 0xc5, 0xf5,
-	0xee, 0xc5, 0x49, 0xcc, 0x84, 0xb8, 0x74, 0x2c, 0x0d, 0xa7, 0x0f, 0x67, 0xdb, 0x14, 0x14, 0xeb,
-	0x67, 0x1b, 0x95, 0xca, 0x1b, 0x3e, 0xd0, 0x7c, 0x96, 0xe1, 0xfc, 0x04, 0x26, 0x07, 0xe1, 0x24,
-	0xf3, 0x26, 0x9e, 0x40, 0xf5, 0x7c, 0x2d, 0x9e, 0xb2, 0x76, 0xf0, 0x29, 0xab, 0x28, 0xfa, 0x10,
-	0xa0, 0xf0, 0x90, 0x65, 0x3f, 0x05, 0x8f, 0xf3, 0x1c, 0x4e, 0x0a, 0xd8, 0x77, 0x10, 0xdf, 0x73,
-	0x38, 0x91, 0x17, 0x72, 0x57, 0xb1, 0xb7, 0x01, 0x15, 0x0b, 0x6f, 0xbd, 0xc6, 0x15, 0xd4, 0x39,
-	0x82, 0xbb, 0x22, 0x37, 0xec, 0x4e, 0x73, 0xcb, 0xd9, 0x94, 0xfe, 0x9d, 0x0d, 0x3f, 0x77, 0x40,
-	0x58, 0x18, 0x5f, 0xd3, 0x6c, 0x5d, 0x28, 0xd3, 0xf9, 0x43, 0x03, 0xf3, 0x8a, 0x4c, 0x82, 0x34,
-	0xfa, 0x95, 0xe4, 0xb7, 0xdf, 0x52, 0xfb, 0x53, 0x0e, 0xfd, 0x54, 0x42, 0x5e, 0x51, 0x15, 0x16,
-	0x93, 0xaf, 0x7c, 0xcb, 0xf8, 0xe0, 0xdf, 0xe5, 0xf0, 0x67, 0xdb, 0xbb, 0xea, 0x3f, 0xbb, 0x79,
-	0xfa, 0x95, 0x94, 0xad, 0xb8, 0x55, 0x80, 0xea, 0x85, 0xe7, 0x9f, 0xf7, 0x3c, 0xf3, 0x1e, 0x3a,
-	0x86, 0xfa, 0x95, 0x8f, 0xbf, 0x0f, 0x86, 0xbd, 0xbe, 0x6b, 0x6a, 0xa8, 0x06, 0xe5, 0x71, 0xe0,
-	0x62, 0xb3, 0xc4, 0xbf, 0x5e, 0xf9, 0x03, 0xd7, 0xd4, 0x9f, 0x26, 0x70, 0xbc, 0xc5, 0x91, 0x87,
-	0xbe, 0xf3, 0x5f, 0xbe, 0x32, 0xef, 0xa1, 0x3a, 0x54, 0x3c, 0xb7, 0xf7, 0x83, 0xaa, 0x1c, 0xfa,
-	0xc1, 0xc8, 0x2c, 0xa1, 0xfb, 0x60, 0x60, 0xdf, 0xbf, 0x7c, 0x3d, 0x1e, 0x0e, 0x7a, 0x23, 0xd7,
-	0xd4, 0x91, 0x01, 0x47, 0x2f, 0x5e, 0x06, 0x23, 0x1f, 0xff, 0x68, 0x96, 0x51, 0x03, 0x60, 0xe0,
-	0x7a, 0xee, 0xc8, 0x7d, 0x7d, 0x19, 0x5c, 0x98, 0x15, 0x9e, 0xad, 0x6c, 0x5e, 0x64, 0x56, 0xbb,
-	0x7f, 0xea, 0x72, 0x85, 0x07, 0xf2, 0x17, 0x0c, 0x7d, 0x09, 0x47, 0x6a, 0xbd, 0xa1, 0x33, 0xd9,
-	0xe0, 0xf6, 0x8e, 0xb4, 0x1f, 0xec, 0x78, 0x95, 0x26, 0xbe, 0x01, 0xd8, 0x28, 0x05, 0x3d, 0x92,
-	0x49, 0x7b, 0xa2, 0xb3, 0xad, 0xfd, 0x80, 0x02, 0xf8, 0x1a, 0xea, 0xb9, 0xb8, 0xd1, 0x43, 0x99,
-	0xb6, 0xfb, 0x92, 0xec, 0x47, 0x7b, 0x7e, 0x59, 0xfd, 0x89, 0x86, 0x2e, 0xe0, 0xbd, 0xe2, 0xeb,
-	0x45, 0x8f, 0x37, 0xa9, 0x3b, 0x4b, 0xc5, 0xb6, 0x0f, 0x85, 0x72, 0xa0, 0x73, 0x30, 0x0a, 0x4b,
-	0x16, 0x29, 0xc6, 0xfb, 0xcb, 0xdb, 0x7e, 0x7c, 0x20, 0xa2, 0x9a, 0x79, 0x01, 0xc7, 0x5b, 0x1b,
-	0x10, 0xd9, 0xc5, 0xbe, 0x77, 0x70, 0xde, 0x3f, 0x18, 0x93, 0x48, 0x93, 0xaa, 0xf8, 0xb3, 0xf0,
-	0xd9, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x96, 0x6a, 0xe1, 0x85, 0x86, 0x08, 0x00, 0x00,
+	// 1497 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xdd, 0x72, 0xda, 0x46,
+	0x14, 0x8e, 0xc0, 0x60, 0x38, 0xd8, 0x8e, 0xb2, 0xc6, 0x8e, 0x22, 0x67, 0x3a, 0x8c, 0x66, 0x9a,
+	0x61, 0x92, 0xc6, 0x4e, 0x9d, 0x36, 0x69, 0x32, 0xd3, 0x1f, 0x6c, 0xd4, 0xc4, 0x0d, 0x0e, 0x74,
+	0xc1, 0xcd, 0xf4, 0xa2, 0x4d, 0x65, 0xd8, 0x18, 0x25, 0x80, 0xa8, 0xb4, 0x30, 0x43, 0x5f, 0xa2,
+	0x97, 0x9d, 0xde, 0x74, 0xa6, 0x6f, 0xd2, 0x67, 0xe8, 0x45, 0x5f, 0xa6, 0x37, 0x9d, 0xfd, 0x91,
+	0xb4, 0x12, 0x8a, 0x1d, 0xa7, 0x77, 0x3a, 0xe7, 0xec, 0x7e, 0x7b, 0xfe, 0xcf, 0x01, 0x80, 0xfe,
+	0xd0, 0xa1, 0xbb, 0x53, 0xdf, 0xa3, 0x1e, 0x5a, 0x61, 0xdf, 0x66, 0xe3, 0xcc, 0xa5, 0xc3, 0xd9,
+	0xe9, 0x6e, 0xdf, 0x1b, 0xef, 0x4d, 0x17, 0x03, 0xd7, 0xdb, 0xeb, 0x93, 0xd1, 0x28, 0xd8, 0xeb,
+	0x7b, 0xe3, 0xb1, 0x37, 0xd9, 0xe3, 0x47, 0xf7, 0x9c, 0x3e, 0x75, 0xe7, 0x2e, 0x5d, 0x44, 0x1f,
+	0x01, 0xf5, 0x89, 0x33, 0x16, 0x40, 0xd6, 0x5f, 0x1a, 0x94, 0x0e, 0x87, 0x0e, 0xc5, 0x9e, 0x37,
+	0x46, 0x08, 0x56, 0x4e, 0x66, 0xee, 0xc0, 0xd0, 0x6a, 0x5a, 0xbd, 0x8c, 0xf9, 0x37, 0xb2, 0x60,
+	0xa5, 0xb7, 0x98, 0x12, 0x23, 0x57, 0xd3, 0xea, 0x1b, 0xfb, 0x1b, 0xbb, 0x5c, 0x09, 0x76, 0x9a,
+	0x71, 0x31, 0x97, 0xa1, 0x5b, 0xb0, 0x11, 0x72, 0xda, 0xa7, 0xaf, 0x49, 0x9f, 0x1a, 0x79, 0x8e,
+	0x90, 0xe2, 0xa2, 0x9b, 0x50, 0x66, 0x9c, 0x96, 0x73, 0x4a, 0x46, 0xc6, 0x0a, 0x3f, 0x12, 0x33,
+	0x50, 0x15, 0x0a, 0x27, 0x01, 0xf1, 0x03, 0xa3, 0x50, 0xcb, 0xd7, 0xcb, 0x58, 0x10, 0xa8, 0x06,
+	0x95, 0x96, 0x13, 0xd0, 0x93, 0xe9, 0xc0, 0xa1, 0x64, 0x60, 0x14, 0x6b, 0x5a, 0xbd, 0x80, 0x55,
+	0x96, 0xf5, 0x77, 0x1e, 0x2a, 0xcc, 0x84, 0x63, 0x12, 0x04, 0xce, 0x19, 0xc9, 0xb4, 0xc2, 0x84,
+	0x12, 0x7b, 0x88, 0xf3, 0x73, 0x9c, 0x1f, 0xd1, 0xc8, 0x80, 0x55, 0x79, 0x55, 0xaa, 0x1d, 0x92,
+	0x68, 0x1b, 0x8a, 0x8d, 0x19, 0x1d, 0x7a, 0xbe, 0x54, 0x56, 0x52, 0xcc, 0x8e, 0x9e, 0x3b, 0x26,
+	0x01, 0x75, 0xc6, 0x53, 0xa3, 0x50, 0xd3, 0xea, 0x79, 0x1c, 0x33, 0xd0, 0x47, 0x50, 0x6a, 0x48,
+	0x57, 0x73, 0x75, 0x2b, 0xfb, 0xfa, 0x6e, 0xe8, 0xfb, 0x5d, 0xe1, 0x09, 0x1c, 0x9d, 0x40, 0x1f,
+	0x00, 0x74, 0x1c, 0x9f, 0x4c, 0x28, 0xd7, 0x6d, 0x95, 0xbf, 0xa3, 0x70, 0x98, 0xe6, 0xc7, 0x64,
+	0x42, 0x5d, 0x6f, 0x12, 0x18, 0x25, 0xee, 0x98, 0x88, 0x46, 0x0f, 0xa0, 0xd2, 0xa0, 0xd4, 0xe9,
+	0x0f, 0xc7, 0x64, 0x42, 0x03, 0xa3, 0x5c, 0xcb, 0xd7, 0x2b, 0xfb, 0x55, 0x11, 0x22, 0xe6, 0x91,
+	0x58, 0x88, 0xd5, 0x83, 0xe8, 0x1e, 0x94, 0x31, 0x71, 0xfa, 0x02, 0x14, 0xf8, 0x2d, 0x14, 0xdf,
+	0x0a, 0x45, 0x38, 0x3e, 0xc4, 0x3c, 0x61, 0x0f, 0x5c, 0x16, 0x80, 0x0a, 0x37, 0x57, 0x52, 0xe8,
+	0x3e, 0xac, 0x3e, 0x75, 0x03, 0xea, 0xf9, 0x0b, 0x63, 0x8d, 0xe3, 0xdc, 0x88, 0x71, 0xa4, 0x17,
+	0x31, 0x99, 0xbb, 0x01, 0x83, 0x0b, 0x4f, 0x22, 0x0b, 0xd6, 0x30, 0x99, 0x8e, 0x5c, 0x12, 0x1c,
+	0x7a, 0xb3, 0x09, 0x35, 0xd6, 0x79, 0x4c, 0x13, 0x3c, 0xeb, 0x0f, 0x0d, 0x36, 0x92, 0x26, 0x30,
+	0x4f, 0x3c, 0xf7, 0x06, 0x44, 0x89, 0x6d, 0x44, 0xb3, 0x98, 0x77, 0x1c, 0x3a, 0x94, 0xb1, 0xe5,
+	0xdf, 0x2c, 0x9f, 0x44, 0xa6, 0x89, 0xa8, 0x0a, 0x82, 0xfb, 0xd3, 0x1d, 0x13, 0x9e, 0xd3, 0x22,
+	0xaa, 0x11, 0xcd, 0x50, 0xba, 0xee, 0x2f, 0x44, 0x86, 0x94, 0x7f, 0x33, 0xcb, 0x8f, 0x82, 0x16,
+	0x71, 0x5e, 0xf1, 0x58, 0x96, 0xb0, 0xa4, 0xac, 0xc7, 0xb0, 0xa6, 0x3a, 0x8b, 0xbd, 0x66, 0x8f,
+	0xbd, 0xd7, 0xae, 0x54, 0x4d, 0x10, 0x71, 0x4e, 0xe7, 0x94, 0x9c, 0xb6, 0x8e, 0x61, 0x33, 0xc3,
+	0x41, 0x6a, 0x22, 0x6a, 0xc9, 0x44, 0x4c, 0x24, 0x5c, 0x2e, 0x95, 0x70, 0xd6, 0x27, 0xb0, 0xd1,
+	0x99, 0xf1, 0x0a, 0xc6, 0xe4, 0xe7, 0x19, 0x09, 0x28, 0x2b, 0x5a, 0x46, 0x72, 0x98, 0x4a, 0x58,
+	0xb4, 0x61, 0x99, 0x63, 0x2e, 0xb3, 0x3e, 0x85, 0xab, 0xd1, 0xad, 0x60, 0xea, 0x4d, 0x02, 0xf2,
+	0x4e, 0xd7, 0x0e, 0x01, 0x75, 0xbc, 0x20, 0xd6, 0x5d, 0x3c, 0x78, 0x17, 0x4a, 0x92, 0x13, 0x18,
+	0x1a, 0x4f, 0x84, 0x6b, 0xcb, 0x89, 0x10, 0x1d, 0xb1, 0x7e, 0x84, 0xcd, 0x04, 0x88, 0x7c, 0xdf,
+	0x80, 0xd5, 0xee, 0xac, 0xdf, 0x27, 0x41, 0xc0, 0x55, 0x28, 0xe1, 0x90, 0x4c, 0xe0, 0xe7, 0x2e,
+	0xc6, 0xb7, 0xa1, 0xda, 0x24, 0x23, 0x42, 0xc9, 0xff, 0x53, 0xf3, 0x63, 0xd8, 0x4a, 0xc1, 0x5c,
+	0xa4, 0xa8, 0xf5, 0xa7, 0x06, 0x9b, 0x2d, 0x37, 0x32, 0x2d, 0x08, 0x5f, 0x56, 0x1b, 0x90, 0x96,
+	0x6a, 0x40, 0xb2, 0xc5, 0x85, 0xb1, 0x17, 0x39, 0xac, 0xb2, 0x58, 0x12, 0xb6, 0x5f, 0xbd, 0x0a,
+	0x88, 0x68, 0xac, 0x79, 0x2c, 0x29, 0x9e, 0xe2, 0xee, 0xd8, 0xa5, 0x3c, 0x93, 0xf3, 0x58, 0x10,
+	0xa9, 0x96, 0x52, 0x48, 0xb7, 0x14, 0xeb, 0x10, 0xaa, 0x49, 0x15, 0xa5, 0x55, 0x77, 0x92, 0xf9,
+	0x97, 0xe9, 0x9c, 0xf0, 0x04, 0x03, 0x11, 0x0d, 0x38, 0xe5, 0xe2, 0x4b, 0x81, 0x34, 0x61, 0x2b,
+	0x05, 0xf2, 0x3e, 0xaa, 0xfc, 0xa6, 0xc1, 0x16, 0xaf, 0xc3, 0x9e, 0x97, 0x52, 0xe6, 0x02, 0xaf,
+	0xcb, 0xd3, 0xca, 0x54, 0x50, 0x59, 0x71, 0x49, 0xe7, 0xd5, 0x92, 0x66, 0xe3, 0x25, 0x20, 0xe1,
+	0x48, 0xe0, 0xdf, 0x2c, 0x3e, 0x98, 0x8c, 0xbd, 0xb9, 0x68, 0x1d, 0x25, 0x2c, 0x29, 0xcb, 0x86,
+	0xed, 0xb4, 0x62, 0xef, 0x63, 0xe0, 0x19, 0x6c, 0x1e, 0x3b, 0xfe, 0x1b, 0x51, 0xab, 0xce, 0xe0,
+	0x5d, 0xac, 0x0b, 0xb5, 0xcc, 0x29, 0x5a, 0xa6, 0xf2, 0x2c, 0xbf, 0x94, 0x67, 0xd6, 0x3d, 0xa8,
+	0x26, 0x1f, 0xba, 0x30, 0xdf, 0xbf, 0x06, 0xc4, 0x1b, 0xf6, 0xc9, 0xc4, 0x57, 0x34, 0x0b, 0x5f,
+	0xd7, 0x94, 0xd7, 0xe5, 0xf0, 0x67, 0xda, 0x85, 0xed, 0x30, 0x66, 0x58, 0xbf, 0x6a, 0xb0, 0x99,
+	0x00, 0x92, 0x2f, 0x7f, 0x0e, 0x45, 0xce, 0x0e, 0xeb, 0xf5, 0x43, 0xe9, 0xa6, 0xe5, 0xa3, 0x82,
+	0x17, 0xd8, 0x13, 0xea, 0x2f, 0xb0, 0xbc, 0x64, 0x3e, 0x82, 0x8a, 0xc2, 0x46, 0x3a, 0xe4, 0xdf,
+	0x90, 0x85, 0x54, 0x8b, 0x7d, 0xb2, 0x18, 0xcf, 0x9d, 0xd1, 0x4c, 0x54, 0x5d, 0x01, 0x0b, 0xe2,
+	0x71, 0xee, 0x33, 0xcd, 0x5a, 0xc0, 0x56, 0x97, 0x38, 0x7e, 0x7f, 0x98, 0x2e, 0xe5, 0x2a, 0x14,
+	0xbe, 0x9d, 0x11, 0x3f, 0x84, 0x11, 0xc4, 0xf9, 0xe6, 0xa5, 0x0a, 0xb8, 0x90, 0x5d, 0xc0, 0x05,
+	0x59, 0xc0, 0xd6, 0x0f, 0xb0, 0x9d, 0x7e, 0x5a, 0xba, 0xe3, 0x72, 0x0d, 0x8c, 0xc1, 0xf7, 0x3c,
+	0xea, 0x8c, 0x42, 0xeb, 0x38, 0x61, 0xfd, 0x04, 0xd5, 0xce, 0xcc, 0x3f, 0x23, 0x69, 0xc3, 0x6e,
+	0x8b, 0x7c, 0xe2, 0xa3, 0x51, 0xcb, 0x5c, 0xf7, 0x22, 0x39, 0x33, 0xb7, 0x3d, 0x1a, 0x10, 0xbf,
+	0x37, 0x74, 0x26, 0xe1, 0x44, 0x8a, 0x18, 0xd6, 0x5d, 0xd8, 0x4a, 0xbd, 0x20, 0xf5, 0xaf, 0x42,
+	0x41, 0xcc, 0x7c, 0x4d, 0x28, 0xc4, 0x09, 0x6b, 0x0a, 0x3a, 0x6b, 0x48, 0x0c, 0x3c, 0x52, 0xe6,
+	0x16, 0x14, 0x0f, 0x16, 0xe7, 0xa8, 0x22, 0xa5, 0xac, 0xd9, 0x29, 0x7b, 0xa7, 0x48, 0x77, 0x85,
+	0xc3, 0x27, 0x30, 0x8f, 0x49, 0x5e, 0x4e, 0x60, 0x9e, 0x6e, 0x0f, 0xe1, 0x9a, 0xf2, 0xe2, 0x25,
+	0xc6, 0xdf, 0x43, 0xb8, 0x26, 0x46, 0xc2, 0x65, 0xc7, 0xed, 0x2e, 0x20, 0xf5, 0xe2, 0x85, 0x85,
+	0x35, 0x87, 0x32, 0x43, 0xb0, 0xe7, 0x6c, 0xf5, 0xb9, 0x4c, 0xb7, 0x88, 0xb4, 0xc9, 0xbd, 0x5d,
+	0x1b, 0xf6, 0x6e, 0x93, 0x50, 0xc7, 0x1d, 0x05, 0xe1, 0xce, 0x2b, 0x49, 0xeb, 0x5f, 0x0d, 0xf4,
+	0x17, 0xe4, 0xb4, 0xeb, 0xf5, 0xdf, 0x90, 0x68, 0xfe, 0xd4, 0xe5, 0x8f, 0x00, 0x11, 0x8a, 0x4d,
+	0x01, 0xf9, 0x22, 0x90, 0x62, 0x26, 0xc2, 0x85, 0xaf, 0xe8, 0x62, 0xfa, 0x6e, 0x8f, 0xdf, 0x49,
+	0x2e, 0xdc, 0xe7, 0x5b, 0xa3, 0x6c, 0x98, 0x2b, 0x35, 0x2d, 0xde, 0x30, 0x33, 0x86, 0x6c, 0xbc,
+	0x61, 0x3e, 0x84, 0x52, 0xb8, 0x98, 0xf1, 0x8e, 0x5c, 0xd9, 0xdf, 0x11, 0xb7, 0x32, 0xc7, 0x04,
+	0x8e, 0x0e, 0xdf, 0x7e, 0x14, 0x97, 0x00, 0x02, 0x28, 0x3e, 0x69, 0xb5, 0x0f, 0x1a, 0x2d, 0xfd,
+	0x0a, 0x5a, 0x87, 0xf2, 0x8b, 0x36, 0x7e, 0xd6, 0xed, 0x34, 0x0e, 0x6d, 0x5d, 0x43, 0x25, 0x58,
+	0x39, 0xe9, 0xda, 0x58, 0xcf, 0xb1, 0xaf, 0xe7, 0xed, 0xa6, 0xad, 0xe7, 0x6f, 0xff, 0xae, 0xc1,
+	0x7a, 0xc2, 0x25, 0x4c, 0xf6, 0x4d, 0xfb, 0xe8, 0xb9, 0x7e, 0x05, 0x95, 0xa1, 0xd0, 0xb2, 0x1b,
+	0xdf, 0xc9, 0xab, 0x9d, 0x76, 0xb7, 0xa7, 0xe7, 0xd0, 0x55, 0xa8, 0xe0, 0x76, 0xfb, 0xf8, 0xe5,
+	0x49, 0xa7, 0xd9, 0xe8, 0xd9, 0x7a, 0x1e, 0x55, 0x60, 0xf5, 0xe9, 0x51, 0xb7, 0xd7, 0xc6, 0xdf,
+	0xeb, 0x2b, 0x68, 0x03, 0xa0, 0x69, 0xb7, 0xec, 0x9e, 0xfd, 0xf2, 0xb8, 0xfb, 0x44, 0x2f, 0xb0,
+	0xd3, 0x92, 0x66, 0x97, 0xf4, 0x22, 0x5a, 0x83, 0x92, 0xdd, 0x3c, 0xea, 0x71, 0xf1, 0x2a, 0x53,
+	0x10, 0xdb, 0x8d, 0x43, 0x41, 0x96, 0x24, 0xd9, 0x14, 0x67, 0xcb, 0xfb, 0xff, 0x14, 0xc5, 0x4f,
+	0xa4, 0x2e, 0xf1, 0xe7, 0x6e, 0x9f, 0xa0, 0x07, 0xb0, 0x2a, 0x77, 0x3f, 0x24, 0x7f, 0x2e, 0x24,
+	0x17, 0x48, 0x73, 0x2b, 0xc5, 0x95, 0xe9, 0xfa, 0x25, 0x40, 0x9c, 0xc4, 0xe8, 0xba, 0x38, 0xb4,
+	0x54, 0x0f, 0xa6, 0xb1, 0x2c, 0x90, 0x00, 0x5f, 0x40, 0x39, 0xaa, 0x3b, 0xb4, 0x1d, 0x47, 0x52,
+	0x2d, 0x7d, 0xf3, 0xfa, 0x12, 0x5f, 0xdc, 0xbe, 0xa7, 0xa1, 0x27, 0xb0, 0xa6, 0x06, 0x1e, 0xbd,
+	0x3d, 0x19, 0x4c, 0x33, 0x4b, 0x14, 0x01, 0x1d, 0x40, 0x45, 0xd9, 0x40, 0x91, 0xd4, 0x78, 0x79,
+	0xb3, 0x35, 0x6f, 0x64, 0x48, 0xa4, 0x31, 0x4f, 0x61, 0x3d, 0xb1, 0x1e, 0x22, 0x53, 0xb5, 0x3b,
+	0x85, 0xb3, 0x93, 0x29, 0x8b, 0x91, 0x12, 0x7b, 0x50, 0x88, 0x94, 0xb5, 0x61, 0x99, 0x3b, 0x99,
+	0x32, 0x89, 0xf4, 0x0c, 0x36, 0x92, 0x39, 0x8e, 0xce, 0xcb, 0x7c, 0xf3, 0x66, 0xb6, 0x50, 0x82,
+	0xd9, 0xb0, 0xa6, 0xae, 0x03, 0xa1, 0xb7, 0x33, 0x76, 0x11, 0xd3, 0xcc, 0x12, 0x49, 0x98, 0x03,
+	0xa8, 0x28, 0xf3, 0x3a, 0xf4, 0xf5, 0xf2, 0xda, 0x60, 0xde, 0xc8, 0x90, 0xc4, 0x76, 0x25, 0x47,
+	0x62, 0x68, 0x57, 0xe6, 0x8c, 0x36, 0x6f, 0x66, 0x0b, 0x63, 0x77, 0x27, 0xc6, 0x53, 0xe8, 0xee,
+	0xac, 0xa9, 0x68, 0xee, 0x64, 0xca, 0x04, 0xd2, 0x69, 0x91, 0xff, 0x8b, 0x72, 0xff, 0xbf, 0x01,
+	0x00, 0x59, 0x55, 0x55, 0xcc, 0x9c, 0x11, 0x00, 0x00,
 }
@@ -24,4 +24,10 @@ type ShareDocument struct {
 	RestrictToTargetUsers bool                        `json:"RESTRICT_TO_TARGET_USERS"`
 	OwnerId               string                      `json:"OWNER_ID"`
 	PreUserUuid           string                      `json:"USER_UUID"`
+	FileRequest           bool                        `json:"FILE_REQUEST"`
+	UploadMaxSize         int64                       `json:"UPLOAD_MAX_SIZE"`
+	UploadExtensions      []string                    `json:"UPLOAD_EXTENSIONS"`
+	ExpiryNotified        bool                        `json:"EXPIRY_NOTIFIED"`
+	DisabledAt            int64                       `json:"DISABLED_AT"`
+	PendingApproval       bool                        `json:"PENDING_APPROVAL"`
 }
@@ -57,6 +57,9 @@ It has these top-level messages:
 	ChangeEvent
 	PolicyEngineRequest
 	PolicyEngineResponse
+	PolicyConditionTrace
+	PolicyTrace
+	PolicyEngineExplanation
 	PolicyCondition
 	Policy
 	PolicyGroup
@@ -1312,6 +1315,7 @@ func (x *userMetaServiceListUserMetaNamespaceStream) Send(m *ListUserMetaNamespa
 
 type PolicyEngineServiceClient interface {
 	IsAllowed(ctx context.Context, in *PolicyEngineRequest, opts ...client.CallOption) (*PolicyEngineResponse, error)
+	ExplainIsAllowed(ctx context.Context, in *PolicyEngineRequest, opts ...client.CallOption) (*PolicyEngineExplanation, error)
 	StorePolicyGroup(ctx context.Context, in *StorePolicyGroupRequest, opts ...client.CallOption) (*StorePolicyGroupResponse, error)
 	ListPolicyGroups(ctx context.Context, in *ListPolicyGroupsRequest, opts ...client.CallOption) (*ListPolicyGroupsResponse, error)
 	DeletePolicyGroup(ctx context.Context, in *DeletePolicyGroupRequest, opts ...client.CallOption) (*DeletePolicyGroupResponse, error)
@@ -1345,6 +1349,16 @@ func (c *policyEngineServiceClient) IsAllowed(ctx context.Context, in *PolicyEng
 	return out, nil
 }
 
+func (c *policyEngineServiceClient) ExplainIsAllowed(ctx context.Context, in *PolicyEngineRequest, opts ...client.CallOption) (*PolicyEngineExplanation, error) {
+	req := c.c.NewRequest(c.serviceName, "PolicyEngineService.ExplainIsAllowed", in)
+	out := new(PolicyEngineExplanation)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *policyEngineServiceClient) StorePolicyGroup(ctx context.Context, in *StorePolicyGroupRequest, opts ...client.CallOption) (*StorePolicyGroupResponse, error) {
 	req := c.c.NewRequest(c.serviceName, "PolicyEngineService.StorePolicyGroup", in)
 	out := new(StorePolicyGroupResponse)
@@ -1379,6 +1393,7 @@ func (c *policyEngineServiceClient) DeletePolicyGroup(ctx context.Context, in *D
 
 type PolicyEngineServiceHandler interface {
 	IsAllowed(context.Context, *PolicyEngineRequest, *PolicyEngineResponse) error
+	ExplainIsAllowed(context.Context, *PolicyEngineRequest, *PolicyEngineExplanation) error
 	StorePolicyGroup(context.Context, *StorePolicyGroupRequest, *StorePolicyGroupResponse) error
 	ListPolicyGroups(context.Context, *ListPolicyGroupsRequest, *ListPolicyGroupsResponse) error
 	DeletePolicyGroup(context.Context, *DeletePolicyGroupRequest, *DeletePolicyGroupResponse) error
@@ -1396,6 +1411,10 @@ func (h *PolicyEngineService) IsAllowed(ctx context.Context, in *PolicyEngineReq
 	return h.PolicyEngineServiceHandler.IsAllowed(ctx, in, out)
 }
 
+func (h *PolicyEngineService) ExplainIsAllowed(ctx context.Context, in *PolicyEngineRequest, out *PolicyEngineExplanation) error {
+	return h.PolicyEngineServiceHandler.ExplainIsAllowed(ctx, in, out)
+}
+
 func (h *PolicyEngineService) StorePolicyGroup(ctx context.Context, in *StorePolicyGroupRequest, out *StorePolicyGroupResponse) error {
 	return h.PolicyEngineServiceHandler.StorePolicyGroup(ctx, in, out)
 }
@@ -57,6 +57,9 @@ It has these top-level messages:
 	ChangeEvent
 	PolicyEngineRequest
 	PolicyEngineResponse
+	PolicyConditionTrace
+	PolicyTrace
+	PolicyEngineExplanation
 	PolicyCondition
 	Policy
 	PolicyGroup
@@ -1788,6 +1791,114 @@ func (m *PolicyEngineResponse) GetDefaultDeny() bool {
 	return false
 }
 
+// Evaluation of a policy condition against the request context
+type PolicyConditionTrace struct {
+	Key       string `protobuf:"bytes,1,opt,name=Key" json:"Key,omitempty"`
+	Type      string `protobuf:"bytes,2,opt,name=Type" json:"Type,omitempty"`
+	Fulfilled bool   `protobuf:"varint,3,opt,name=Fulfilled" json:"Fulfilled,omitempty"`
+}
+
+func (m *PolicyConditionTrace) Reset()                    { *m = PolicyConditionTrace{} }
+func (m *PolicyConditionTrace) String() string            { return proto.CompactTextString(m) }
+func (*PolicyConditionTrace) ProtoMessage()               {}
+func (*PolicyConditionTrace) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+
+func (m *PolicyConditionTrace) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *PolicyConditionTrace) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *PolicyConditionTrace) GetFulfilled() bool {
+	if m != nil {
+		return m.Fulfilled
+	}
+	return false
+}
+
+// Policy whose actions, subjects and resources match a request, in evaluation order
+type PolicyTrace struct {
+	Subject    string                  `protobuf:"bytes,1,opt,name=Subject" json:"Subject,omitempty"`
+	Policy     *Policy                 `protobuf:"bytes,2,opt,name=Policy" json:"Policy,omitempty"`
+	Conditions []*PolicyConditionTrace `protobuf:"bytes,3,rep,name=Conditions" json:"Conditions,omitempty"`
+	// True if all conditions are fulfilled
+	Matched bool `protobuf:"varint,4,opt,name=Matched" json:"Matched,omitempty"`
+	// True if this policy decided of the response
+	Decisive bool `protobuf:"varint,5,opt,name=Decisive" json:"Decisive,omitempty"`
+}
+
+func (m *PolicyTrace) Reset()                    { *m = PolicyTrace{} }
+func (m *PolicyTrace) String() string            { return proto.CompactTextString(m) }
+func (*PolicyTrace) ProtoMessage()               {}
+func (*PolicyTrace) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+
+func (m *PolicyTrace) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *PolicyTrace) GetPolicy() *Policy {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
+}
+
+func (m *PolicyTrace) GetConditions() []*PolicyConditionTrace {
+	if m != nil {
+		return m.Conditions
+	}
+	return nil
+}
+
+func (m *PolicyTrace) GetMatched() bool {
+	if m != nil {
+		return m.Matched
+	}
+	return false
+}
+
+func (m *PolicyTrace) GetDecisive() bool {
+	if m != nil {
+		return m.Decisive
+	}
+	return false
+}
+
+type PolicyEngineExplanation struct {
+	Response *PolicyEngineResponse `protobuf:"bytes,1,opt,name=Response" json:"Response,omitempty"`
+	Traces   []*PolicyTrace        `protobuf:"bytes,2,rep,name=Traces" json:"Traces,omitempty"`
+}
+
+func (m *PolicyEngineExplanation) Reset()                    { *m = PolicyEngineExplanation{} }
+func (m *PolicyEngineExplanation) String() string            { return proto.CompactTextString(m) }
+func (*PolicyEngineExplanation) ProtoMessage()               {}
+func (*PolicyEngineExplanation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
+
+func (m *PolicyEngineExplanation) GetResponse() *PolicyEngineResponse {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *PolicyEngineExplanation) GetTraces() []*PolicyTrace {
+	if m != nil {
+		return m.Traces
+	}
+	return nil
+}
+
 type PolicyCondition struct {
 	Type        string `protobuf:"bytes,1,opt,name=type" json:"type,omitempty"`
 	JsonOptions string `protobuf:"bytes,2,opt,name=jsonOptions" json:"jsonOptions,omitempty"`
@@ -1796,7 +1907,7 @@ type PolicyCondition struct {
 func (m *PolicyCondition) Reset()                    { *m = PolicyCondition{} }
 func (m *PolicyCondition) String() string            { return proto.CompactTextString(m) }
 func (*PolicyCondition) ProtoMessage()               {}
-func (*PolicyCondition) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+func (*PolicyCondition) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
 
 func (m *PolicyCondition) GetType() string {
 	if m != nil {
@@ -1825,7 +1936,7 @@ type Policy struct {
 func (m *Policy) Reset()                    { *m = Policy{} }
 func (m *Policy) String() string            { return proto.CompactTextString(m) }
 func (*Policy) ProtoMessage()               {}
-func (*Policy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+func (*Policy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
 
 func (m *Policy) GetId() string {
 	if m != nil {
@@ -1889,7 +2000,7 @@ type PolicyGroup struct {
 func (m *PolicyGroup) Reset()                    { *m = PolicyGroup{} }
 func (m *PolicyGroup) String() string            { return proto.CompactTextString(m) }
 func (*PolicyGroup) ProtoMessage()               {}
-func (*PolicyGroup) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
+func (*PolicyGroup) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
 
 func (m *PolicyGroup) GetUuid() string {
 	if m != nil {
@@ -1948,7 +2059,7 @@ type StorePolicyGroupRequest struct {
 func (m *StorePolicyGroupRequest) Reset()                    { *m = StorePolicyGroupRequest{} }
 func (m *StorePolicyGroupRequest) String() string            { return proto.CompactTextString(m) }
 func (*StorePolicyGroupRequest) ProtoMessage()               {}
-func (*StorePolicyGroupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
+func (*StorePolicyGroupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
 
 func (m *StorePolicyGroupRequest) GetPolicyGroup() *PolicyGroup {
 	if m != nil {
@@ -1964,7 +2075,7 @@ type StorePolicyGroupResponse struct {
 func (m *StorePolicyGroupResponse) Reset()                    { *m = StorePolicyGroupResponse{} }
 func (m *StorePolicyGroupResponse) String() string            { return proto.CompactTextString(m) }
 func (*StorePolicyGroupResponse) ProtoMessage()               {}
-func (*StorePolicyGroupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
+func (*StorePolicyGroupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
 
 func (m *StorePolicyGroupResponse) GetPolicyGroup() *PolicyGroup {
 	if m != nil {
@@ -1980,7 +2091,7 @@ type DeletePolicyGroupRequest struct {
 func (m *DeletePolicyGroupRequest) Reset()                    { *m = DeletePolicyGroupRequest{} }
 func (m *DeletePolicyGroupRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeletePolicyGroupRequest) ProtoMessage()               {}
-func (*DeletePolicyGroupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
+func (*DeletePolicyGroupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
 
 func (m *DeletePolicyGroupRequest) GetPolicyGroup() *PolicyGroup {
 	if m != nil {
@@ -1996,7 +2107,7 @@ type DeletePolicyGroupResponse struct {
 func (m *DeletePolicyGroupResponse) Reset()                    { *m = DeletePolicyGroupResponse{} }
 func (m *DeletePolicyGroupResponse) String() string            { return proto.CompactTextString(m) }
 func (*DeletePolicyGroupResponse) ProtoMessage()               {}
-func (*DeletePolicyGroupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+func (*DeletePolicyGroupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
 
 func (m *DeletePolicyGroupResponse) GetSuccess() bool {
 	if m != nil {
@@ -2011,7 +2122,7 @@ type ListPolicyGroupsRequest struct {
 func (m *ListPolicyGroupsRequest) Reset()                    { *m = ListPolicyGroupsRequest{} }
 func (m *ListPolicyGroupsRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListPolicyGroupsRequest) ProtoMessage()               {}
-func (*ListPolicyGroupsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+func (*ListPolicyGroupsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
 
 type ListPolicyGroupsResponse struct {
 	PolicyGroups []*PolicyGroup `protobuf:"bytes,1,rep,name=PolicyGroups" json:"PolicyGroups,omitempty"`
@@ -2021,7 +2132,7 @@ type ListPolicyGroupsResponse struct {
 func (m *ListPolicyGroupsResponse) Reset()                    { *m = ListPolicyGroupsResponse{} }
 func (m *ListPolicyGroupsResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListPolicyGroupsResponse) ProtoMessage()               {}
-func (*ListPolicyGroupsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+func (*ListPolicyGroupsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
 
 func (m *ListPolicyGroupsResponse) GetPolicyGroups() []*PolicyGroup {
 	if m != nil {
@@ -2087,6 +2198,9 @@ func init() {
 	proto.RegisterType((*ChangeEvent)(nil), "idm.ChangeEvent")
 	proto.RegisterType((*PolicyEngineRequest)(nil), "idm.PolicyEngineRequest")
 	proto.RegisterType((*PolicyEngineResponse)(nil), "idm.PolicyEngineResponse")
+	proto.RegisterType((*PolicyConditionTrace)(nil), "idm.PolicyConditionTrace")
+	proto.RegisterType((*PolicyTrace)(nil), "idm.PolicyTrace")
+	proto.RegisterType((*PolicyEngineExplanation)(nil), "idm.PolicyEngineExplanation")
 	proto.RegisterType((*PolicyCondition)(nil), "idm.PolicyCondition")
 	proto.RegisterType((*Policy)(nil), "idm.Policy")
 	proto.RegisterType((*PolicyGroup)(nil), "idm.PolicyGroup")
@@ -2108,183 +2222,193 @@ func init() {
 func init() { proto.RegisterFile("idm.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 2844 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x1a, 0x4d, 0x6f, 0x1b, 0xc7,
-	0x55, 0x4b, 0x8a, 0x12, 0xf7, 0x51, 0xa6, 0xa8, 0x89, 0x2c, 0xd1, 0xb4, 0xe4, 0x28, 0x9b, 0x34,
-	0x95, 0xd4, 0x84, 0x72, 0xe9, 0x26, 0x90, 0x63, 0xc7, 0x31, 0x4d, 0xd2, 0x32, 0x6b, 0x99, 0x54,
-	0x56, 0x62, 0x82, 0x1c, 0x57, 0xe4, 0x48, 0xde, 0x98, 0xda, 0x65, 0x77, 0x97, 0x92, 0x75, 0x2b,
-	0x7a, 0x29, 0xd0, 0x4b, 0xd1, 0x53, 0xfe, 0x40, 0x8f, 0xbd, 0xf5, 0x67, 0x04, 0x05, 0x7a, 0x29,
-	0x10, 0xa0, 0x87, 0x02, 0x01, 0x0a, 0xb4, 0xf7, 0x5e, 0x7a, 0x2b, 0xe6, 0x73, 0x67, 0x97, 0x4b,
-	0x9a, 0x52, 0x7d, 0x11, 0x38, 0xef, 0x73, 0xde, 0x9b, 0xf7, 0xde, 0xbc, 0x37, 0x2b, 0xd0, 0xed,
-	0xde, 0x59, 0x79, 0xe0, 0xb9, 0x81, 0x8b, 0xd2, 0x76, 0xef, 0xac, 0xb4, 0x7b, 0x6a, 0x07, 0x2f,
-	0x87, 0xc7, 0xe5, 0xae, 0x7b, 0xb6, 0x33, 0xb8, 0xec, 0xd9, 0xee, 0x4e, 0x17, 0xf7, 0xfb, 0xfe,
-	0x4e, 0xd7, 0x3d, 0x3b, 0x73, 0x9d, 0x1d, 0x1f, 0x7b, 0xe7, 0x76, 0x17, 0xef, 0x50, 0x0e, 0x0e,
-	0x64, 0xec, 0xa5, 0x7b, 0x93, 0x39, 0x19, 0x47, 0xe0, 0x61, 0x4c, 0xff, 0x70, 0xa6, 0x4f, 0x15,
-	0xa6, 0xb3, 0x0b, 0x3b, 0x78, 0xe5, 0x5e, 0xec, 0x9c, 0xba, 0x1f, 0x53, 0xe4, 0xc7, 0xe7, 0x56,
-	0xdf, 0xee, 0x59, 0x81, 0xeb, 0xf9, 0x3b, 0xf2, 0x27, 0xe3, 0x33, 0x2a, 0xb0, 0x54, 0xf3, 0xb0,
-	0x15, 0x60, 0xd3, 0xed, 0x63, 0x13, 0xff, 0x6a, 0x88, 0xfd, 0x00, 0xad, 0xc3, 0x2c, 0x59, 0x16,
-	0xb5, 0x0d, 0x6d, 0x33, 0x57, 0xd1, 0xcb, 0xc4, 0x34, 0x8a, 0xa7, 0x60, 0xe3, 0x1e, 0x20, 0x95,
-	0xc7, 0x1f, 0xb8, 0x8e, 0x8f, 0xdf, 0xc4, 0x74, 0x1f, 0x96, 0xea, 0xb8, 0x8f, 0xa3, 0x8a, 0x3e,
-	0x80, 0xcc, 0x97, 0x43, 0xec, 0x5d, 0x72, 0xa6, 0x7c, 0x99, 0xbb, 0xa5, 0x4c, 0xa1, 0x26, 0x43,
-	0x1a, 0x9f, 0x02, 0x52, 0x59, 0xb9, 0xbe, 0x0d, 0xc8, 0x99, 0xee, 0x85, 0xcf, 0x30, 0x3d, 0x2a,
-	0x21, 0x6d, 0xaa, 0x20, 0xa2, 0xf2, 0x10, 0x5b, 0x5e, 0xf7, 0xe5, 0xd5, 0x55, 0xde, 0x03, 0xa4,
-	0xb2, 0x4e, 0x67, 0xe2, 0xdf, 0x53, 0x0c, 0x8f, 0x10, 0xcc, 0x76, 0x86, 0x36, 0xdb, 0x93, 0x6e,
-	0xd2, 0xdf, 0x68, 0x19, 0x32, 0xfb, 0xd6, 0x31, 0xee, 0x17, 0x53, 0x14, 0xc8, 0x16, 0x68, 0x05,
-	0xe6, 0x9a, 0xfe, 0x11, 0xb6, 0xce, 0x8a, 0xe9, 0x0d, 0x6d, 0x33, 0x6b, 0xf2, 0x15, 0x5a, 0x03,
-	0x7d, 0xcf, 0x73, 0x87, 0x03, 0xaa, 0x6e, 0x96, 0xa2, 0x42, 0x00, 0x2a, 0x41, 0xb6, 0xe3, 0x63,
-	0x8f, 0x22, 0x33, 0x14, 0x29, 0xd7, 0xc4, 0x2d, 0xfb, 0x96, 0x1f, 0x74, 0x06, 0x3d, 0x8b, 0xb8,
-	0x65, 0x6e, 0x43, 0xdb, 0xcc, 0x98, 0x2a, 0x88, 0x50, 0x54, 0x87, 0x81, 0x5b, 0x1d, 0x0c, 0xfa,
-	0x36, 0xf6, 0x8b, 0xf3, 0x1b, 0xe9, 0x4d, 0xdd, 0x54, 0x41, 0xe8, 0x1e, 0x64, 0x0f, 0xdc, 0xbe,
-	0xdd, 0x25, 0xe8, 0xec, 0x46, 0x7a, 0x33, 0x57, 0x59, 0x95, 0x6e, 0x32, 0xb1, 0xef, 0x0e, 0xbd,
-	0x2e, 0xa6, 0x04, 0x97, 0xa6, 0x24, 0x44, 0xbb, 0xb0, 0x2a, 0x7e, 0xd7, 0x5c, 0x27, 0xc0, 0xaf,
-	0x83, 0x46, 0xcf, 0x0e, 0xac, 0xe3, 0x3e, 0x2e, 0xea, 0x74, 0x8f, 0xe3, 0xd0, 0xe8, 0x03, 0xb8,
-	0xf1, 0xd4, 0xf5, 0xba, 0xb8, 0x7d, 0x8e, 0x3d, 0xcf, 0xee, 0xe1, 0x22, 0x50, 0xfa, 0x28, 0xd0,
-	0xf8, 0x5e, 0x83, 0x45, 0x62, 0xe1, 0xa1, 0xed, 0x9c, 0xf6, 0x31, 0x3d, 0x26, 0xc5, 0xd1, 0xe9,
-	0x6b, 0x3a, 0x7a, 0x03, 0x72, 0x4d, 0x3f, 0xee, 0x6a, 0x15, 0x84, 0xee, 0x00, 0x34, 0xfd, 0x98,
-	0xbb, 0x15, 0x08, 0x32, 0x60, 0xe1, 0x99, 0xe5, 0x0b, 0xf7, 0x5d, 0x52, 0x8f, 0x67, 0xcd, 0x08,
-	0x0c, 0x15, 0x20, 0xed, 0xb8, 0x41, 0x71, 0x9e, 0xa2, 0xc8, 0xcf, 0x30, 0xef, 0xa8, 0x9c, 0x30,
-	0xef, 0xc8, 0x32, 0x12, 0x5f, 0x14, 0x4f, 0xc1, 0x61, 0xde, 0x31, 0x9e, 0x30, 0x28, 0x27, 0x31,
-	0x35, 0x61, 0xf1, 0x89, 0xed, 0xf4, 0x54, 0x35, 0x25, 0xc8, 0x0e, 0x7d, 0xec, 0xb5, 0xac, 0x33,
-	0xcc, 0x43, 0x54, 0xae, 0x09, 0x6e, 0x60, 0xf9, 0xfe, 0x85, 0xeb, 0xf5, 0xb8, 0x03, 0xe5, 0xda,
-	0xf8, 0x39, 0x14, 0x42, 0x51, 0xd3, 0x69, 0x97, 0x59, 0xaf, 0xea, 0xbf, 0x62, 0xd6, 0x47, 0xf4,
-	0x5d, 0x21, 0xeb, 0xaf, 0xae, 0x52, 0x66, 0xfd, 0x55, 0x4c, 0xdc, 0x82, 0xa5, 0x9a, 0x3b, 0x74,
-	0x82, 0x08, 0xcf, 0x32, 0x64, 0x28, 0x90, 0x32, 0x65, 0x4c, 0xb6, 0x30, 0xfe, 0x9a, 0x66, 0xa2,
-	0x12, 0x0b, 0x84, 0x48, 0xf9, 0x03, 0x2b, 0x78, 0xc9, 0x5d, 0x1f, 0x02, 0xd0, 0x7d, 0x80, 0x6a,
-	0x10, 0x78, 0xf6, 0xf1, 0x30, 0xc0, 0x7e, 0x31, 0x4d, 0x93, 0xf2, 0x96, 0xdc, 0x4a, 0x39, 0xc4,
-	0x35, 0x9c, 0xc0, 0xbb, 0x34, 0x15, 0x62, 0xf4, 0x2e, 0x64, 0x48, 0xa0, 0xfa, 0xc5, 0x59, 0xca,
-	0xa5, 0x94, 0x2d, 0x06, 0xa7, 0x19, 0xe3, 0x9e, 0xda, 0x0e, 0x0d, 0x6e, 0x92, 0x31, 0x64, 0x41,
-	0x22, 0xe1, 0x40, 0x44, 0xc2, 0x1c, 0x8b, 0x04, 0xb1, 0x26, 0xa7, 0xd0, 0xee, 0xf7, 0x24, 0x3a,
-	0x47, 0xd1, 0x2a, 0x08, 0x15, 0x61, 0x9e, 0x27, 0x11, 0x8f, 0x7a, 0xb1, 0x24, 0xf9, 0x44, 0x7f,
-	0xb0, 0x24, 0xcd, 0x52, 0x56, 0x05, 0x12, 0x29, 0x3e, 0xfa, 0x5b, 0x28, 0x3e, 0x30, 0xb1, 0xf8,
-	0x94, 0x3e, 0x87, 0xc5, 0x98, 0xf3, 0x48, 0xb6, 0xbe, 0xc2, 0x97, 0xfc, 0x70, 0xc8, 0x4f, 0xe2,
-	0xa1, 0x73, 0xab, 0x3f, 0xc4, 0xa2, 0xa6, 0xd0, 0xc5, 0x67, 0xa9, 0x5d, 0xcd, 0xf8, 0x4d, 0x1a,
-	0x16, 0xc9, 0x09, 0x24, 0x55, 0xa5, 0x5c, 0xac, 0xfc, 0x53, 0x1f, 0x6b, 0xe3, 0x7c, 0x9c, 0x8a,
-	0xf9, 0x38, 0x12, 0x0f, 0xe9, 0x78, 0x3c, 0xac, 0x81, 0x6e, 0xe2, 0xee, 0xd0, 0xf3, 0xed, 0x73,
-	0x79, 0x41, 0x48, 0x00, 0x91, 0xfb, 0x74, 0xd8, 0xef, 0x53, 0xd6, 0x05, 0x26, 0x57, 0xac, 0x49,
-	0xb5, 0x95, 0x06, 0xd3, 0x12, 0xc0, 0x4e, 0x3d, 0x0a, 0x44, 0x1f, 0x42, 0x5e, 0x02, 0xbe, 0xa2,
-	0xa6, 0xb3, 0x18, 0x88, 0x41, 0xd1, 0x47, 0xb0, 0x24, 0x21, 0x55, 0xe7, 0x92, 0x91, 0xb2, 0x13,
-	0x1f, 0x45, 0x90, 0xa8, 0x78, 0x66, 0xf9, 0xb4, 0x90, 0xb2, 0x83, 0x17, 0x4b, 0xb4, 0x05, 0xd9,
-	0x96, 0xdb, 0xc3, 0x47, 0x97, 0x03, 0x76, 0x5d, 0xe4, 0x2b, 0x37, 0x68, 0x9c, 0x0a, 0xa0, 0x29,
-	0xd1, 0xa2, 0x98, 0x42, 0x58, 0x4c, 0x9f, 0xc2, 0x0a, 0x2b, 0x8c, 0x5f, 0xbb, 0xde, 0x2b, 0x7f,
-	0x60, 0x75, 0xe5, 0x6d, 0xff, 0x11, 0xe8, 0x12, 0x26, 0x73, 0x9f, 0xc8, 0x0d, 0x29, 0x43, 0x02,
-	0x63, 0x0f, 0x56, 0x47, 0xe4, 0xf0, 0x84, 0xbe, 0x9a, 0xa0, 0x47, 0xb0, 0xc2, 0xca, 0xd1, 0xc8,
-	0x86, 0xa6, 0x2b, 0x44, 0x0f, 0x60, 0x75, 0x84, 0x7f, 0xea, 0x02, 0xf8, 0x08, 0x56, 0x58, 0x15,
-	0xbb, 0xa6, 0xf2, 0x3d, 0x58, 0x1d, 0xe1, 0xbf, 0x96, 0x17, 0x7e, 0x3b, 0xab, 0x90, 0xd3, 0xac,
-	0xe8, 0x34, 0xeb, 0xb2, 0xe6, 0x75, 0x9a, 0x75, 0xb4, 0x1e, 0xb9, 0xab, 0x9f, 0xcc, 0xff, 0xf8,
-	0x8f, 0x77, 0xd3, 0xaf, 0xff, 0x93, 0x16, 0x97, 0xf6, 0x16, 0xe4, 0xea, 0xd8, 0xef, 0x7a, 0xf6,
-	0x20, 0xb0, 0x5d, 0x87, 0x25, 0x01, 0x27, 0xfa, 0xd7, 0xbc, 0xa9, 0xe2, 0xd0, 0x6d, 0x98, 0x3d,
-	0xec, 0x0f, 0x4f, 0x69, 0x2a, 0x28, 0x82, 0x28, 0x10, 0x6d, 0x41, 0xe6, 0xb0, 0xeb, 0x0e, 0x58,
-	0xa8, 0xe7, 0x2b, 0xef, 0x44, 0xb7, 0x4c, 0x51, 0x26, 0xa3, 0x98, 0xa2, 0x7d, 0x52, 0xeb, 0xd3,
-	0xfc, 0xb4, 0xf5, 0xe9, 0x4e, 0xa4, 0x7c, 0xf3, 0xa2, 0xa7, 0xd4, 0x68, 0x92, 0xce, 0xae, 0x1b,
-	0x10, 0xa7, 0xb0, 0xaa, 0xa7, 0x9b, 0x21, 0x00, 0x3d, 0x60, 0x58, 0x92, 0x01, 0x7e, 0x31, 0x47,
-	0x75, 0xae, 0x47, 0x6d, 0x28, 0x4b, 0x3c, 0xab, 0xff, 0x21, 0xfd, 0xa4, 0xd2, 0xb8, 0x30, 0xb9,
-	0x34, 0x3e, 0x83, 0x7c, 0x54, 0x6c, 0x42, 0x65, 0xdc, 0x50, 0x2b, 0x63, 0xae, 0x02, 0x65, 0x3a,
-	0x93, 0x10, 0x16, 0xb5, 0x4a, 0xfe, 0x59, 0x83, 0xe5, 0xd0, 0xdf, 0xd1, 0x52, 0x39, 0x54, 0x2e,
-	0xc2, 0x21, 0x2f, 0x95, 0x7d, 0xb5, 0x81, 0xa3, 0x0b, 0x72, 0x30, 0xbd, 0x78, 0x2c, 0x98, 0x2a,
-	0x88, 0xc8, 0xf2, 0x65, 0x08, 0x98, 0xf4, 0x37, 0x39, 0x79, 0xff, 0x8d, 0x27, 0x4f, 0x29, 0x44,
-	0x59, 0x99, 0x0b, 0xcb, 0x4a, 0x19, 0x0a, 0xac, 0x1c, 0x54, 0x6b, 0xfb, 0x61, 0xef, 0x94, 0xae,
-	0xd6, 0xf6, 0x79, 0xec, 0x67, 0xa9, 0x38, 0x82, 0x25, 0x40, 0x63, 0x47, 0xf4, 0x74, 0x94, 0x9e,
-	0xa7, 0xcc, 0x24, 0x86, 0x5d, 0x28, 0xb0, 0xa4, 0x55, 0x14, 0x4c, 0x97, 0xa3, 0x9f, 0x88, 0xbe,
-	0x4a, 0x55, 0xf5, 0xe6, 0xd2, 0xb0, 0x0b, 0x05, 0x96, 0xda, 0x57, 0x56, 0xb8, 0x23, 0xba, 0xaa,
-	0x69, 0x6d, 0xfb, 0x04, 0xf4, 0x6a, 0x6d, 0xbf, 0xda, 0x15, 0x47, 0xa3, 0x74, 0x9b, 0xf4, 0x37,
-	0x39, 0xe6, 0xaf, 0xd4, 0x3b, 0x95, 0x2e, 0x8c, 0xdf, 0x6b, 0x54, 0x26, 0xca, 0x43, 0x4a, 0xd6,
-	0x8a, 0x54, 0xb3, 0x8e, 0x3e, 0x84, 0x39, 0x26, 0x8b, 0x07, 0x5a, 0x5e, 0x68, 0x63, 0x50, 0x93,
-	0x63, 0x49, 0x9f, 0x4f, 0xee, 0x93, 0x66, 0x9d, 0x47, 0x08, 0x5f, 0x11, 0xdf, 0xc8, 0x63, 0x6f,
-	0xd6, 0x79, 0x8c, 0xa8, 0x20, 0xc2, 0x49, 0xc2, 0xb6, 0x59, 0xe7, 0x17, 0x22, 0x5f, 0x19, 0x7f,
-	0xd4, 0x20, 0x5f, 0xad, 0xed, 0xab, 0x51, 0xbb, 0x09, 0xf3, 0x4c, 0x9d, 0x4f, 0x27, 0x8f, 0xd1,
-	0xdd, 0x08, 0x34, 0xb9, 0xf0, 0xd8, 0x06, 0xfc, 0x62, 0x8a, 0x66, 0xb5, 0x58, 0x92, 0xb1, 0x41,
-	0xd1, 0xce, 0x5a, 0x3a, 0xdd, 0x8c, 0xc0, 0x08, 0x37, 0xdb, 0x04, 0xeb, 0xdd, 0x74, 0x53, 0x2c,
-	0x45, 0xb0, 0x66, 0xc2, 0x60, 0xfd, 0xa7, 0xc6, 0x86, 0xc2, 0x17, 0x38, 0xb0, 0x12, 0xfb, 0xcb,
-	0x12, 0xbb, 0x61, 0x29, 0x9c, 0xf7, 0x1a, 0x62, 0x4d, 0xca, 0x0f, 0x39, 0x13, 0x56, 0xd7, 0x79,
-	0xaf, 0x21, 0x01, 0x04, 0xfb, 0x4b, 0xdf, 0x75, 0xd8, 0x69, 0x31, 0xcf, 0x85, 0x80, 0x48, 0x3d,
-	0xcc, 0xbc, 0x85, 0x7e, 0x6d, 0x6e, 0x62, 0x51, 0x32, 0x7e, 0xd0, 0x60, 0x49, 0xd8, 0x19, 0xd9,
-	0x62, 0x68, 0x80, 0x16, 0x37, 0x20, 0x79, 0x24, 0x5c, 0x86, 0x4c, 0xdb, 0xeb, 0x61, 0x8f, 0x1a,
-	0x9c, 0x31, 0xd9, 0x82, 0x48, 0x6a, 0x3a, 0x3d, 0xfc, 0x9a, 0xee, 0x85, 0x37, 0x56, 0x12, 0x40,
-	0xda, 0x22, 0x62, 0x79, 0x1d, 0x9f, 0xd8, 0x8e, 0x4d, 0xc3, 0x91, 0x05, 0x4b, 0x0c, 0x1a, 0x71,
-	0xca, 0xdc, 0x94, 0x4e, 0x31, 0xfe, 0xa4, 0xc1, 0x4d, 0x76, 0xcb, 0x08, 0x03, 0x45, 0x8e, 0xd6,
-	0x40, 0x6f, 0x0f, 0xb0, 0x67, 0x51, 0x8d, 0x1a, 0x2d, 0x65, 0x3f, 0x61, 0xcd, 0x7f, 0x12, 0x79,
-	0x59, 0xac, 0xdb, 0x03, 0x33, 0xe4, 0x43, 0x3f, 0x03, 0x9d, 0x00, 0xeb, 0x56, 0x60, 0x89, 0x09,
-	0xe2, 0x86, 0x9c, 0x20, 0x28, 0x7b, 0x88, 0x37, 0xde, 0x03, 0x08, 0xa5, 0xa0, 0x79, 0x48, 0x1f,
-	0x74, 0x8e, 0x0a, 0x33, 0x08, 0x60, 0xae, 0xde, 0xd8, 0x6f, 0x1c, 0x35, 0x0a, 0x9a, 0xd1, 0x80,
-	0x95, 0xb8, 0x7a, 0x5e, 0x17, 0xae, 0xa4, 0xe9, 0xdf, 0x1a, 0xdc, 0x0c, 0xa7, 0x2e, 0xd5, 0xea,
-	0x35, 0x26, 0x86, 0x44, 0xa8, 0xcf, 0x47, 0xfc, 0x10, 0x40, 0x8f, 0x9c, 0xc7, 0xaf, 0x48, 0xae,
-	0x10, 0xf0, 0x86, 0x88, 0xae, 0xc0, 0xb2, 0x38, 0x85, 0xc3, 0xe1, 0xf1, 0xb7, 0xb8, 0x1b, 0xb4,
-	0x2f, 0x1c, 0xec, 0xf1, 0xe0, 0x4e, 0xc4, 0xa1, 0x27, 0x70, 0x43, 0xc0, 0x59, 0xbd, 0xcc, 0xd0,
-	0x42, 0xb4, 0x36, 0xe6, 0x5c, 0x59, 0xf5, 0x8c, 0xb2, 0x18, 0x35, 0xd1, 0x9a, 0x8d, 0xb8, 0x6c,
-	0x2b, 0xcc, 0x5e, 0x5e, 0x4f, 0x63, 0x1e, 0x93, 0x68, 0xe3, 0x2f, 0x1a, 0xdc, 0x89, 0x3a, 0x5e,
-	0x1a, 0x26, 0x3c, 0xd7, 0x1a, 0x8d, 0x97, 0xbb, 0x09, 0xf1, 0x12, 0xe7, 0x93, 0xda, 0x5a, 0x7e,
-	0x34, 0x74, 0x3e, 0x05, 0x90, 0xb4, 0xcc, 0xd9, 0xb9, 0xca, 0x4a, 0x64, 0x7f, 0xa1, 0x28, 0x85,
-	0xd2, 0x78, 0x1f, 0x16, 0x54, 0x91, 0xc9, 0x71, 0xf4, 0x0d, 0xbc, 0x3b, 0x76, 0x5b, 0xdc, 0x3b,
-	0x51, 0xfd, 0xda, 0xd4, 0xfa, 0xef, 0xc0, 0xda, 0xbe, 0xed, 0x07, 0xe3, 0xec, 0x35, 0x30, 0xac,
-	0x8f, 0xc1, 0x73, 0xc5, 0xf5, 0x84, 0x62, 0xc3, 0xcf, 0x67, 0x9c, 0xfe, 0x51, 0x06, 0xe3, 0xbb,
-	0x34, 0xe4, 0x6a, 0x2f, 0x2d, 0xe7, 0x14, 0x37, 0xce, 0xb1, 0x13, 0xa0, 0x55, 0xc8, 0x7e, 0xeb,
-	0xbb, 0x0e, 0x1d, 0x76, 0xf8, 0x3c, 0xf8, 0x38, 0x20, 0xa3, 0xcd, 0x26, 0xcc, 0x52, 0x60, 0x8a,
-	0x1e, 0xd9, 0x32, 0xd5, 0xa0, 0x30, 0xd2, 0x41, 0x88, 0x52, 0xc8, 0x47, 0x89, 0x74, 0xe2, 0xa3,
-	0x84, 0x7c, 0xa9, 0x9c, 0x4d, 0x7c, 0xa9, 0x8c, 0xf6, 0xf1, 0x99, 0x37, 0xf4, 0xf1, 0xf4, 0x9a,
-	0xef, 0xf6, 0x69, 0x61, 0x8e, 0x5e, 0xf3, 0xdd, 0x3e, 0x7a, 0x08, 0x37, 0xa2, 0xce, 0xc9, 0x4e,
-	0x74, 0x4e, 0x94, 0x18, 0x3d, 0x8e, 0xb4, 0xc5, 0xac, 0x9b, 0xde, 0x88, 0x5b, 0x3d, 0xe9, 0x71,
-	0xe3, 0xff, 0x1d, 0xdf, 0x7f, 0xd4, 0xe0, 0x1d, 0x96, 0xaf, 0x0d, 0xe7, 0xd4, 0x76, 0xb0, 0xf2,
-	0x44, 0x26, 0x32, 0x57, 0x3c, 0x91, 0x89, 0x35, 0x69, 0x14, 0x94, 0x56, 0x44, 0x97, 0xad, 0x47,
-	0x09, 0xb2, 0xbc, 0x60, 0x88, 0xdb, 0x5c, 0xae, 0xd1, 0x17, 0x30, 0xcf, 0x2f, 0x32, 0xfe, 0x0a,
-	0xc3, 0xca, 0x77, 0x82, 0xea, 0xb2, 0xb8, 0xf0, 0xa8, 0xa9, 0x82, 0xab, 0xf4, 0x19, 0x2c, 0xa8,
-	0x88, 0x2b, 0x19, 0x79, 0x0e, 0xcb, 0x51, 0x45, 0x3c, 0xb8, 0x8b, 0x30, 0x5f, 0xed, 0xf7, 0xdd,
-	0x0b, 0xde, 0x2b, 0x66, 0x4d, 0xb1, 0x24, 0xcd, 0x49, 0xe3, 0xf5, 0x80, 0x5c, 0x4b, 0x41, 0x1d,
-	0x3b, 0x97, 0x54, 0x64, 0xd6, 0x8c, 0xc0, 0x48, 0x47, 0x55, 0xc7, 0x27, 0xd6, 0xb0, 0xcf, 0x48,
-	0xd8, 0xb3, 0xaa, 0x0a, 0x32, 0xf6, 0x60, 0x91, 0xe9, 0xad, 0xb9, 0x4e, 0xcf, 0x16, 0x8d, 0x60,
-	0x10, 0x46, 0x3d, 0xfd, 0x4d, 0x04, 0x91, 0x6c, 0x68, 0x0f, 0x58, 0x47, 0xc5, 0xb6, 0xaf, 0x82,
-	0x8c, 0xef, 0x53, 0x30, 0xc7, 0x24, 0x91, 0xbe, 0x50, 0xf6, 0x35, 0x29, 0xbb, 0x17, 0x1f, 0x0b,
-	0x52, 0xa3, 0x63, 0x41, 0x09, 0xb2, 0x7e, 0xec, 0x58, 0xc4, 0x9a, 0xdc, 0x12, 0x1e, 0x3f, 0x56,
-	0xd1, 0x62, 0x85, 0x00, 0xe2, 0x1f, 0x8b, 0xb7, 0x79, 0x19, 0xd6, 0x7e, 0xf1, 0x25, 0xda, 0x82,
-	0x39, 0x7c, 0x72, 0x82, 0xbb, 0x6c, 0x5c, 0xc8, 0x57, 0x96, 0xd4, 0xd3, 0xa4, 0x08, 0x93, 0x13,
-	0xa0, 0x07, 0x00, 0x5d, 0x61, 0xbe, 0x08, 0xf1, 0xdb, 0x0a, 0x79, 0x59, 0x3a, 0x47, 0x44, 0x77,
-	0x48, 0x5e, 0x3a, 0x84, 0xc5, 0x18, 0x3a, 0xe1, 0xe0, 0xb7, 0xa3, 0x23, 0xd8, 0xb2, 0x22, 0x5c,
-	0x32, 0xab, 0xe1, 0xf0, 0xeb, 0x14, 0xe4, 0x18, 0x9a, 0x3d, 0xc8, 0x25, 0x35, 0x8b, 0xa2, 0x61,
-	0x4f, 0x29, 0x0d, 0xfb, 0x46, 0xc2, 0x34, 0x1e, 0x1d, 0xc2, 0xd7, 0x40, 0xa7, 0x77, 0x25, 0x15,
-	0xc7, 0x1b, 0x45, 0x09, 0x40, 0x8f, 0xc2, 0x0b, 0x94, 0x3d, 0x0c, 0xb2, 0x99, 0xac, 0xa8, 0xec,
-	0x37, 0x82, 0x37, 0xa3, 0xe4, 0x53, 0x8c, 0xe6, 0x3f, 0x1d, 0x19, 0xcd, 0x73, 0xaa, 0xf0, 0xb0,
-	0xd3, 0x7a, 0x01, 0xab, 0x87, 0x81, 0xeb, 0x61, 0xc5, 0x0d, 0x22, 0xf3, 0x2b, 0x11, 0xe7, 0xf0,
-	0x5a, 0x5f, 0x50, 0xc4, 0x30, 0x6a, 0x95, 0xc8, 0x68, 0x41, 0x71, 0x54, 0x1c, 0x4f, 0xb2, 0x6b,
-	0xca, 0x63, 0x13, 0xdb, 0x5b, 0xda, 0xdf, 0x27, 0x70, 0x2b, 0x41, 0x5e, 0x58, 0x05, 0x0e, 0x87,
-	0xdd, 0x2e, 0xf6, 0x7d, 0x51, 0x05, 0xf8, 0xd2, 0xb8, 0x05, 0xab, 0xe4, 0x76, 0x54, 0x98, 0x7c,
-	0x71, 0x71, 0x9e, 0x40, 0x71, 0x14, 0xc5, 0x05, 0xfe, 0x02, 0x16, 0x54, 0x38, 0xbf, 0xae, 0x47,
-	0xb7, 0x18, 0xa1, 0x22, 0xe5, 0xeb, 0xc8, 0x0d, 0x2c, 0xd6, 0xa3, 0x67, 0x4c, 0xb6, 0xd8, 0xfe,
-	0x28, 0x7c, 0x16, 0x44, 0x39, 0x98, 0xef, 0xb4, 0x9e, 0xb7, 0xda, 0x5f, 0xb7, 0x0a, 0x33, 0x28,
-	0x0b, 0xb3, 0x9d, 0xc3, 0x86, 0x59, 0xd0, 0x90, 0x0e, 0x99, 0x3d, 0xb3, 0xdd, 0x39, 0x28, 0xa4,
-	0xb6, 0x77, 0x21, 0x1f, 0x9d, 0xed, 0x49, 0xc3, 0x51, 0x6d, 0x7d, 0x53, 0x98, 0x21, 0x54, 0xd5,
-	0xfa, 0x8b, 0x66, 0xab, 0xa0, 0x11, 0x56, 0xb3, 0xdd, 0x7e, 0x51, 0x48, 0x91, 0x5f, 0xfb, 0xcd,
-	0xd6, 0xf3, 0x42, 0x7a, 0xbb, 0x03, 0x8b, 0xb1, 0x7b, 0x96, 0xb4, 0x28, 0x35, 0xb3, 0x51, 0x3d,
-	0x6a, 0x30, 0x6d, 0x66, 0xa3, 0x5a, 0x2f, 0x68, 0x04, 0xda, 0x39, 0xa8, 0x13, 0x68, 0x4a, 0x69,
-	0x62, 0xd2, 0x84, 0xe2, 0x49, 0xb3, 0x55, 0x2f, 0xcc, 0x12, 0xe8, 0x7e, 0x7b, 0xaf, 0xdd, 0x39,
-	0x2a, 0x64, 0xb6, 0xef, 0x0a, 0x57, 0xb0, 0xa2, 0x40, 0x4c, 0x18, 0x3a, 0xaf, 0x1c, 0xf7, 0xc2,
-	0x61, 0x42, 0x7b, 0xd8, 0xb9, 0x64, 0x26, 0x58, 0xa4, 0xf2, 0x16, 0x52, 0xdb, 0x15, 0x71, 0x1f,
-	0x45, 0x63, 0x3f, 0x0b, 0xb3, 0x1e, 0xf6, 0x83, 0xc2, 0x0c, 0xb1, 0xc8, 0xea, 0xf6, 0x99, 0x19,
-	0xae, 0xdd, 0xeb, 0x16, 0x52, 0x95, 0xef, 0x52, 0x64, 0xf0, 0xef, 0xe3, 0x43, 0xd6, 0x88, 0xa2,
-	0x2f, 0x00, 0xc2, 0xef, 0xb3, 0x88, 0x5d, 0xc5, 0x23, 0x1f, 0x79, 0x4b, 0xab, 0x23, 0x70, 0x76,
-	0x7e, 0xc6, 0x0c, 0x11, 0x10, 0x7e, 0x70, 0xe5, 0x02, 0x46, 0x3e, 0xde, 0x72, 0x01, 0xa3, 0x5f,
-	0x66, 0x8d, 0x19, 0x54, 0x05, 0x08, 0x3f, 0x9f, 0x72, 0x01, 0x23, 0x9f, 0x62, 0xb9, 0x80, 0xd1,
-	0xef, 0xac, 0xc6, 0xcc, 0x5d, 0x0d, 0xd5, 0x00, 0x0e, 0x03, 0x0f, 0x5b, 0x67, 0xd7, 0x14, 0xb1,
-	0xa9, 0xdd, 0xd5, 0x2a, 0x7f, 0x48, 0x43, 0x8e, 0xbe, 0xce, 0xc7, 0x3d, 0x43, 0x9b, 0x24, 0xd5,
-	0x33, 0xca, 0xc7, 0xa2, 0x88, 0x67, 0xd4, 0xaf, 0x3a, 0xaa, 0x67, 0x14, 0x01, 0x23, 0x1f, 0xb8,
-	0x22, 0x9e, 0x89, 0x09, 0xb8, 0x0f, 0x59, 0xf1, 0x0d, 0x0d, 0xb1, 0x4a, 0x1d, 0xfb, 0x3a, 0x57,
-	0xba, 0x19, 0x83, 0x4a, 0xd6, 0xcf, 0x41, 0x97, 0x1f, 0x9a, 0x22, 0x0e, 0x51, 0xb9, 0xb9, 0x4d,
-	0xf1, 0x0f, 0x52, 0xea, 0x99, 0x4c, 0xe4, 0x5f, 0x1d, 0x81, 0x27, 0x9d, 0xc9, 0x35, 0x45, 0xd0,
-	0x33, 0xf9, 0x21, 0x05, 0x85, 0x30, 0x4b, 0xf9, 0xc1, 0xb4, 0x60, 0x31, 0xf6, 0xf2, 0x8e, 0x6e,
-	0x2b, 0xa7, 0x10, 0x7f, 0xc9, 0x2e, 0xad, 0x25, 0x23, 0xa5, 0xb1, 0x2d, 0x58, 0x8c, 0x3d, 0xa0,
-	0x73, 0x79, 0xc9, 0xcf, 0xf2, 0x5c, 0xde, 0x98, 0x37, 0x77, 0x63, 0x06, 0x1d, 0xc0, 0x62, 0xec,
-	0x4d, 0x9c, 0xcb, 0x4b, 0x7e, 0x69, 0xe7, 0xf2, 0xc6, 0x3c, 0xa3, 0x53, 0x5f, 0x9a, 0xb0, 0xc8,
-	0x7c, 0xf9, 0x76, 0x24, 0x52, 0xd7, 0xfe, 0x2e, 0x05, 0x50, 0xad, 0xed, 0x0b, 0xa7, 0x3e, 0x04,
-	0x5d, 0xbe, 0x47, 0xa2, 0x9b, 0x8a, 0xc7, 0xc2, 0xd7, 0xbf, 0xd2, 0x4a, 0x1c, 0x2c, 0x4d, 0x7e,
-	0x08, 0xba, 0x7c, 0x62, 0xe4, 0xdc, 0xf1, 0xc7, 0xca, 0xd2, 0x4a, 0x1c, 0x2c, 0xb9, 0x1f, 0x81,
-	0x2e, 0xdf, 0x0b, 0x39, 0x77, 0xfc, 0xe5, 0xb1, 0xb4, 0x12, 0x07, 0x2b, 0xee, 0x79, 0x0c, 0x3a,
-	0x73, 0xcf, 0x75, 0xf8, 0xa9, 0x33, 0xfe, 0x9b, 0x62, 0x5f, 0xe6, 0xc8, 0xc4, 0x21, 0x3c, 0xf2,
-	0x1c, 0xf2, 0xd1, 0x51, 0x13, 0x95, 0xc6, 0x3f, 0xa3, 0x94, 0x6e, 0x27, 0xe2, 0xa4, 0x89, 0x2f,
-	0x20, 0x1f, 0x1d, 0xe6, 0xb9, 0xb0, 0xc4, 0xc7, 0x8c, 0xd2, 0xed, 0x44, 0x9c, 0x62, 0xf1, 0x09,
-	0xac, 0x8e, 0x19, 0x83, 0xd1, 0xfb, 0x53, 0xcc, 0xee, 0xa5, 0x0f, 0x26, 0x13, 0xc9, 0x6d, 0x1f,
-	0xc3, 0xcd, 0xc4, 0x99, 0x17, 0xbd, 0x47, 0x05, 0x4c, 0x9a, 0x97, 0x4b, 0xc6, 0x24, 0x92, 0xd0,
-	0x96, 0xca, 0xdf, 0x52, 0xd1, 0xb1, 0x4a, 0xf8, 0xff, 0x09, 0xe8, 0x4d, 0x5f, 0x0c, 0x19, 0xc5,
-	0x71, 0x23, 0x50, 0xe9, 0x56, 0x02, 0x46, 0xee, 0xff, 0x4b, 0x28, 0xc4, 0x9b, 0x2d, 0xc4, 0x93,
-	0x23, 0xb9, 0xa5, 0x2b, 0xad, 0x8f, 0xc1, 0xaa, 0x22, 0xe3, 0xdd, 0x0c, 0x17, 0x39, 0xa6, 0xff,
-	0xe1, 0x22, 0xc7, 0xb5, 0x40, 0xc6, 0x0c, 0x3a, 0x12, 0x0f, 0xf4, 0xea, 0x36, 0xd7, 0x95, 0x74,
-	0x49, 0xd8, 0xe7, 0x9d, 0x71, 0x68, 0x21, 0xf5, 0x78, 0x8e, 0xfe, 0xd3, 0xd6, 0xbd, 0xff, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0xb6, 0x36, 0xab, 0x1d, 0x6d, 0x26, 0x00, 0x00,
+	// 2993 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x1a, 0x4b, 0x6f, 0x1b, 0xc7,
+	0xd9, 0x4b, 0x8a, 0x14, 0xf7, 0xa3, 0x2c, 0xad, 0x26, 0xb2, 0x48, 0xaf, 0x25, 0x47, 0xd9, 0xa4,
+	0xa9, 0xec, 0x26, 0x92, 0x2b, 0xd7, 0x81, 0xf3, 0x72, 0x42, 0x8b, 0xb2, 0xc3, 0x5a, 0xa6, 0x94,
+	0x95, 0x94, 0x20, 0xbd, 0xad, 0xc8, 0x91, 0xbc, 0xf1, 0x6a, 0x97, 0xdd, 0x5d, 0x4a, 0x56, 0x4f,
+	0x45, 0x2f, 0x05, 0x7a, 0x29, 0x7a, 0xca, 0x0f, 0x68, 0x8f, 0xbd, 0xf5, 0xdc, 0x5f, 0x10, 0x14,
+	0xe8, 0x31, 0x40, 0x0f, 0x05, 0x02, 0x14, 0x68, 0xef, 0xb9, 0xf4, 0x56, 0xcc, 0x73, 0x67, 0x1f,
+	0xa4, 0x29, 0xd5, 0x17, 0x81, 0xf3, 0xbd, 0x66, 0xbf, 0xe7, 0x7c, 0xf3, 0x8d, 0x40, 0x77, 0xfb,
+	0x27, 0x6b, 0x83, 0x30, 0x88, 0x03, 0x54, 0x76, 0xfb, 0x27, 0xe6, 0xfd, 0x63, 0x37, 0x7e, 0x36,
+	0x3c, 0x5c, 0xeb, 0x05, 0x27, 0xeb, 0x83, 0xf3, 0xbe, 0x1b, 0xac, 0xf7, 0xb0, 0xe7, 0x45, 0xeb,
+	0xbd, 0xe0, 0xe4, 0x24, 0xf0, 0xd7, 0x23, 0x1c, 0x9e, 0xba, 0x3d, 0xbc, 0x4e, 0x39, 0x38, 0x90,
+	0xb1, 0x9b, 0x77, 0xc7, 0x73, 0x32, 0x8e, 0x38, 0xc4, 0x98, 0xfe, 0xe1, 0x4c, 0xef, 0x29, 0x4c,
+	0x27, 0x67, 0x6e, 0xfc, 0x3c, 0x38, 0x5b, 0x3f, 0x0e, 0xde, 0xa5, 0xc8, 0x77, 0x4f, 0x1d, 0xcf,
+	0xed, 0x3b, 0x71, 0x10, 0x46, 0xeb, 0xf2, 0x27, 0xe3, 0xb3, 0x36, 0x60, 0x7e, 0x33, 0xc4, 0x4e,
+	0x8c, 0xed, 0xc0, 0xc3, 0x36, 0xfe, 0xe5, 0x10, 0x47, 0x31, 0x5a, 0x86, 0x29, 0xb2, 0x6c, 0x6a,
+	0x2b, 0xda, 0x6a, 0x7d, 0x43, 0x5f, 0x23, 0xaa, 0x51, 0x3c, 0x05, 0x5b, 0x77, 0x01, 0xa9, 0x3c,
+	0xd1, 0x20, 0xf0, 0x23, 0xfc, 0x32, 0xa6, 0xf7, 0x61, 0xbe, 0x8d, 0x3d, 0x9c, 0xde, 0xe8, 0x2d,
+	0xa8, 0x7c, 0x3e, 0xc4, 0xe1, 0x39, 0x67, 0x9a, 0x5d, 0xe3, 0x66, 0x59, 0xa3, 0x50, 0x9b, 0x21,
+	0xad, 0xf7, 0x00, 0xa9, 0xac, 0x7c, 0xbf, 0x15, 0xa8, 0xdb, 0xc1, 0x59, 0xc4, 0x30, 0x7d, 0x2a,
+	0xa1, 0x6c, 0xab, 0x20, 0xb2, 0xe5, 0x1e, 0x76, 0xc2, 0xde, 0xb3, 0x8b, 0x6f, 0x79, 0x17, 0x90,
+	0xca, 0x3a, 0x99, 0x8a, 0xff, 0x28, 0x31, 0x3c, 0x42, 0x30, 0x75, 0x30, 0x74, 0xd9, 0x37, 0xe9,
+	0x36, 0xfd, 0x8d, 0x16, 0xa0, 0xb2, 0xed, 0x1c, 0x62, 0xaf, 0x59, 0xa2, 0x40, 0xb6, 0x40, 0x8b,
+	0x50, 0xed, 0x44, 0xfb, 0xd8, 0x39, 0x69, 0x96, 0x57, 0xb4, 0xd5, 0x9a, 0xcd, 0x57, 0x68, 0x09,
+	0xf4, 0xc7, 0x61, 0x30, 0x1c, 0xd0, 0xed, 0xa6, 0x28, 0x2a, 0x01, 0x20, 0x13, 0x6a, 0x07, 0x11,
+	0x0e, 0x29, 0xb2, 0x42, 0x91, 0x72, 0x4d, 0xcc, 0xb2, 0xed, 0x44, 0xf1, 0xc1, 0xa0, 0xef, 0x10,
+	0xb3, 0x54, 0x57, 0xb4, 0xd5, 0x8a, 0xad, 0x82, 0x08, 0x45, 0x6b, 0x18, 0x07, 0xad, 0xc1, 0xc0,
+	0x73, 0x71, 0xd4, 0x9c, 0x5e, 0x29, 0xaf, 0xea, 0xb6, 0x0a, 0x42, 0x77, 0xa1, 0xb6, 0x1b, 0x78,
+	0x6e, 0x8f, 0xa0, 0x6b, 0x2b, 0xe5, 0xd5, 0xfa, 0x46, 0x43, 0x9a, 0xc9, 0xc6, 0x51, 0x30, 0x0c,
+	0x7b, 0x98, 0x12, 0x9c, 0xdb, 0x92, 0x10, 0xdd, 0x87, 0x86, 0xf8, 0xbd, 0x19, 0xf8, 0x31, 0x7e,
+	0x11, 0x6f, 0xf5, 0xdd, 0xd8, 0x39, 0xf4, 0x70, 0x53, 0xa7, 0xdf, 0x38, 0x0a, 0x8d, 0xde, 0x82,
+	0xab, 0x8f, 0x82, 0xb0, 0x87, 0x77, 0x4e, 0x71, 0x18, 0xba, 0x7d, 0xdc, 0x04, 0x4a, 0x9f, 0x06,
+	0x5a, 0xdf, 0x6a, 0x30, 0x47, 0x34, 0xdc, 0x73, 0xfd, 0x63, 0x0f, 0x53, 0x37, 0x29, 0x86, 0x2e,
+	0x5f, 0xd2, 0xd0, 0x2b, 0x50, 0xef, 0x44, 0x59, 0x53, 0xab, 0x20, 0x74, 0x13, 0xa0, 0x13, 0x65,
+	0xcc, 0xad, 0x40, 0x90, 0x05, 0x33, 0x9f, 0x39, 0x91, 0x30, 0xdf, 0x39, 0xb5, 0x78, 0xcd, 0x4e,
+	0xc1, 0x90, 0x01, 0x65, 0x3f, 0x88, 0x9b, 0xd3, 0x14, 0x45, 0x7e, 0x26, 0x79, 0x47, 0xe5, 0x24,
+	0x79, 0x47, 0x96, 0xa9, 0xf8, 0xa2, 0x78, 0x0a, 0x4e, 0xf2, 0x8e, 0xf1, 0x24, 0x41, 0x39, 0x8e,
+	0xa9, 0x03, 0x73, 0x0f, 0x5d, 0xbf, 0xaf, 0x6e, 0x63, 0x42, 0x6d, 0x18, 0xe1, 0xb0, 0xeb, 0x9c,
+	0x60, 0x1e, 0xa2, 0x72, 0x4d, 0x70, 0x03, 0x27, 0x8a, 0xce, 0x82, 0xb0, 0xcf, 0x0d, 0x28, 0xd7,
+	0xd6, 0x4f, 0xc1, 0x48, 0x44, 0x4d, 0xb6, 0xbb, 0xcc, 0x7a, 0x75, 0xff, 0x0b, 0x66, 0x7d, 0x6a,
+	0xbf, 0x0b, 0x64, 0xfd, 0xc5, 0xb7, 0x94, 0x59, 0x7f, 0x11, 0x15, 0x6f, 0xc1, 0xfc, 0x66, 0x30,
+	0xf4, 0xe3, 0x14, 0xcf, 0x02, 0x54, 0x28, 0x90, 0x32, 0x55, 0x6c, 0xb6, 0xb0, 0xfe, 0x5e, 0x66,
+	0xa2, 0x0a, 0x0b, 0x84, 0x48, 0xf9, 0x5d, 0x27, 0x7e, 0xc6, 0x4d, 0x9f, 0x00, 0xd0, 0xfb, 0x00,
+	0xad, 0x38, 0x0e, 0xdd, 0xc3, 0x61, 0x8c, 0xa3, 0x66, 0x99, 0x26, 0xe5, 0x75, 0xf9, 0x29, 0x6b,
+	0x09, 0x6e, 0xcb, 0x8f, 0xc3, 0x73, 0x5b, 0x21, 0x46, 0xaf, 0x43, 0x85, 0x04, 0x6a, 0xd4, 0x9c,
+	0x5a, 0x29, 0x4b, 0x05, 0x08, 0xc4, 0x66, 0x70, 0x9a, 0x31, 0xc1, 0xb1, 0xeb, 0x37, 0x2b, 0x3c,
+	0x63, 0xc8, 0x82, 0x44, 0xc2, 0xae, 0x88, 0x84, 0x2a, 0x8b, 0x04, 0xb1, 0x26, 0x5e, 0xd8, 0xf1,
+	0xfa, 0x12, 0x5d, 0xa7, 0x68, 0x15, 0x84, 0x9a, 0x30, 0xcd, 0x93, 0x88, 0x47, 0xbd, 0x58, 0x92,
+	0x7c, 0xa2, 0x3f, 0x58, 0x92, 0xd6, 0x28, 0xab, 0x02, 0x49, 0x15, 0x1f, 0xfd, 0x15, 0x14, 0x1f,
+	0x18, 0x5b, 0x7c, 0xcc, 0x8f, 0x61, 0x2e, 0x63, 0x3c, 0x92, 0xad, 0xcf, 0xf1, 0x39, 0x77, 0x0e,
+	0xf9, 0x49, 0x2c, 0x74, 0xea, 0x78, 0x43, 0x2c, 0x6a, 0x0a, 0x5d, 0x7c, 0x50, 0xba, 0xaf, 0x59,
+	0xbf, 0x29, 0xc3, 0x1c, 0xf1, 0x40, 0x51, 0x55, 0xaa, 0x67, 0xca, 0x3f, 0xb5, 0xb1, 0x36, 0xca,
+	0xc6, 0xa5, 0x8c, 0x8d, 0x53, 0xf1, 0x50, 0xce, 0xc6, 0xc3, 0x12, 0xe8, 0x36, 0xee, 0x0d, 0xc3,
+	0xc8, 0x3d, 0x95, 0x07, 0x84, 0x04, 0x10, 0xb9, 0x8f, 0x86, 0x9e, 0x47, 0x59, 0x67, 0x98, 0x5c,
+	0xb1, 0x26, 0xd5, 0x56, 0x2a, 0x4c, 0x4b, 0x00, 0xf3, 0x7a, 0x1a, 0x88, 0xde, 0x86, 0x59, 0x09,
+	0xf8, 0x82, 0xaa, 0xce, 0x62, 0x20, 0x03, 0x45, 0xef, 0xc0, 0xbc, 0x84, 0xb4, 0xfc, 0x73, 0x46,
+	0xca, 0x3c, 0x9e, 0x47, 0x90, 0xa8, 0xf8, 0xcc, 0x89, 0x68, 0x21, 0x65, 0x8e, 0x17, 0x4b, 0x74,
+	0x0b, 0x6a, 0xdd, 0xa0, 0x8f, 0xf7, 0xcf, 0x07, 0xec, 0xb8, 0x98, 0xdd, 0xb8, 0x4a, 0xe3, 0x54,
+	0x00, 0x6d, 0x89, 0x16, 0xc5, 0x14, 0x92, 0x62, 0xfa, 0x08, 0x16, 0x59, 0x61, 0xfc, 0x32, 0x08,
+	0x9f, 0x47, 0x03, 0xa7, 0x27, 0x4f, 0xfb, 0x77, 0x40, 0x97, 0x30, 0x99, 0xfb, 0x44, 0x6e, 0x42,
+	0x99, 0x10, 0x58, 0x8f, 0xa1, 0x91, 0x93, 0xc3, 0x13, 0xfa, 0x62, 0x82, 0x1e, 0xc0, 0x22, 0x2b,
+	0x47, 0xb9, 0x0f, 0x9a, 0xac, 0x10, 0x7d, 0x08, 0x8d, 0x1c, 0xff, 0xc4, 0x05, 0xf0, 0x01, 0x2c,
+	0xb2, 0x2a, 0x76, 0xc9, 0xcd, 0x1f, 0x43, 0x23, 0xc7, 0x7f, 0x29, 0x2b, 0xfc, 0x76, 0x4a, 0x21,
+	0xa7, 0x59, 0x71, 0xd0, 0x69, 0xcb, 0x9a, 0x77, 0xd0, 0x69, 0xa3, 0xe5, 0xd4, 0x59, 0xfd, 0x70,
+	0xfa, 0xfb, 0x7f, 0xbe, 0x5e, 0x7e, 0xf1, 0x43, 0x59, 0x1c, 0xda, 0xb7, 0xa0, 0xde, 0xc6, 0x51,
+	0x2f, 0x74, 0x07, 0xb1, 0x1b, 0xf8, 0xcd, 0xb2, 0x42, 0xf4, 0xef, 0x69, 0x5b, 0xc5, 0xa1, 0x1b,
+	0x30, 0xb5, 0xe7, 0x0d, 0x8f, 0x9b, 0x53, 0x0a, 0xcd, 0x0f, 0x65, 0x9b, 0x02, 0xd1, 0x2d, 0xa8,
+	0xec, 0xf5, 0x82, 0x01, 0x0b, 0xf5, 0xd9, 0x8d, 0xd7, 0xd2, 0x9f, 0x4c, 0x51, 0x36, 0xa3, 0x98,
+	0xa0, 0x7d, 0x52, 0xeb, 0xd3, 0xf4, 0xa4, 0xf5, 0xe9, 0x66, 0xaa, 0x7c, 0xf3, 0xa2, 0x97, 0x40,
+	0x68, 0x3a, 0x07, 0x41, 0x4c, 0x8c, 0xc2, 0xaa, 0x9e, 0x6e, 0x27, 0x00, 0xf4, 0x21, 0xc3, 0x92,
+	0x0c, 0x88, 0x9a, 0x75, 0xba, 0xe7, 0x72, 0x5a, 0x87, 0x35, 0x89, 0x67, 0xf5, 0x3f, 0xa1, 0x1f,
+	0x57, 0x1a, 0x67, 0xc6, 0x97, 0xc6, 0xcf, 0x60, 0x36, 0x2d, 0xb6, 0xa0, 0x32, 0xae, 0xa8, 0x95,
+	0xb1, 0xbe, 0x01, 0x6b, 0xf4, 0x4e, 0x42, 0x58, 0xd4, 0x2a, 0xf9, 0x17, 0x0d, 0x16, 0x12, 0x7b,
+	0xa7, 0x4b, 0xe5, 0x50, 0x39, 0x08, 0x87, 0xbc, 0x54, 0x7a, 0x6a, 0x03, 0x47, 0x17, 0xc4, 0x31,
+	0xfd, 0x6c, 0x2c, 0xd8, 0x2a, 0x88, 0xc8, 0x8a, 0x64, 0x08, 0xd8, 0x53, 0x11, 0xf7, 0x7c, 0xf4,
+	0x52, 0xcf, 0x53, 0x0a, 0x51, 0x56, 0xaa, 0x49, 0x59, 0x59, 0x03, 0x83, 0x95, 0x83, 0xd6, 0xe6,
+	0x76, 0xd2, 0x3b, 0x95, 0x5b, 0x9b, 0xdb, 0x3c, 0xf6, 0x6b, 0x54, 0x1c, 0xc1, 0x12, 0xa0, 0xb5,
+	0x2e, 0x7a, 0x3a, 0x4a, 0xcf, 0x53, 0x66, 0x1c, 0xc3, 0x7d, 0x30, 0x58, 0xd2, 0x2a, 0x1b, 0x4c,
+	0x96, 0xa3, 0xf7, 0x60, 0x5e, 0xe1, 0x9c, 0xb8, 0x34, 0xdc, 0x07, 0x83, 0xa5, 0xf6, 0x85, 0x37,
+	0x5c, 0x87, 0x79, 0x85, 0x73, 0x02, 0xdd, 0xee, 0x81, 0xde, 0xda, 0xdc, 0x6e, 0xf5, 0x84, 0x6b,
+	0x94, 0x6e, 0x93, 0xfe, 0x26, 0x6e, 0xfe, 0x42, 0x3d, 0x53, 0xe9, 0xc2, 0xfa, 0xbd, 0x46, 0x65,
+	0xa2, 0x59, 0x28, 0xc9, 0x5a, 0x51, 0xea, 0xb4, 0xd1, 0xdb, 0x50, 0x65, 0xb2, 0x9a, 0x25, 0xa5,
+	0xec, 0xc8, 0x1d, 0x6c, 0x8e, 0x25, 0x7d, 0x3e, 0x39, 0x4f, 0x3a, 0x6d, 0x1e, 0x21, 0x7c, 0x45,
+	0x6c, 0x23, 0xdd, 0xde, 0x69, 0xf3, 0x18, 0x51, 0x41, 0x84, 0x93, 0x84, 0x6d, 0xa7, 0xcd, 0x0f,
+	0x44, 0xbe, 0xb2, 0xfe, 0xa4, 0xc1, 0x6c, 0x6b, 0x73, 0x5b, 0x8d, 0xda, 0x55, 0x98, 0x66, 0xdb,
+	0x45, 0xf4, 0xe6, 0x91, 0xff, 0x1a, 0x81, 0x26, 0x07, 0x1e, 0xfb, 0x80, 0xa8, 0x59, 0xa2, 0x59,
+	0x2d, 0x96, 0xe4, 0xda, 0xa0, 0xec, 0xce, 0x5a, 0x3a, 0xdd, 0x4e, 0xc1, 0x08, 0x37, 0xfb, 0x08,
+	0xd6, 0xbb, 0xe9, 0xb6, 0x58, 0x8a, 0x60, 0xad, 0x24, 0xc1, 0xfa, 0x2f, 0x8d, 0x5d, 0x0a, 0x9f,
+	0xe2, 0xd8, 0x29, 0xec, 0x2f, 0x4d, 0x76, 0xc2, 0x52, 0x38, 0xef, 0x35, 0xc4, 0x9a, 0x94, 0x1f,
+	0xe2, 0x13, 0x56, 0xd7, 0x79, 0xaf, 0x21, 0x01, 0x04, 0xfb, 0xf3, 0x28, 0xf0, 0x99, 0xb7, 0x98,
+	0xe5, 0x12, 0x40, 0xaa, 0x1e, 0x56, 0x5e, 0x41, 0xbf, 0x56, 0x1d, 0x5b, 0x94, 0xac, 0xef, 0x34,
+	0x98, 0x17, 0x7a, 0xa6, 0x3e, 0x31, 0x51, 0x40, 0xcb, 0x2a, 0x50, 0x7c, 0x25, 0x5c, 0x80, 0xca,
+	0x4e, 0xd8, 0xc7, 0x21, 0x55, 0xb8, 0x62, 0xb3, 0x05, 0x91, 0xd4, 0xf1, 0xfb, 0xf8, 0x05, 0xfd,
+	0x16, 0xde, 0x58, 0x49, 0x00, 0x69, 0x8b, 0x88, 0xe6, 0x6d, 0x7c, 0xe4, 0xfa, 0x2e, 0x0d, 0x47,
+	0x16, 0x2c, 0x19, 0x68, 0xca, 0x28, 0xd5, 0x09, 0x8d, 0x62, 0xfd, 0x59, 0x83, 0x6b, 0xec, 0x94,
+	0x11, 0x0a, 0x8a, 0x1c, 0xdd, 0x04, 0x7d, 0x67, 0x80, 0x43, 0x87, 0xee, 0xa8, 0xd1, 0x52, 0xf6,
+	0x23, 0xd6, 0xfc, 0x17, 0x91, 0xaf, 0x89, 0xf5, 0xce, 0xc0, 0x4e, 0xf8, 0xd0, 0x4f, 0x40, 0x27,
+	0xc0, 0xb6, 0x13, 0x3b, 0xe2, 0x06, 0x71, 0x55, 0xde, 0x20, 0x28, 0x7b, 0x82, 0xb7, 0xde, 0x00,
+	0x48, 0xa4, 0xa0, 0x69, 0x28, 0xef, 0x1e, 0xec, 0x1b, 0x57, 0x10, 0x40, 0xb5, 0xbd, 0xb5, 0xbd,
+	0xb5, 0xbf, 0x65, 0x68, 0xd6, 0x16, 0x2c, 0x66, 0xb7, 0xe7, 0x75, 0xe1, 0x42, 0x3b, 0xfd, 0x47,
+	0x83, 0x6b, 0xc9, 0xad, 0x4b, 0xd5, 0x7a, 0x89, 0x89, 0x21, 0x11, 0x1a, 0xf1, 0x2b, 0x7e, 0x02,
+	0xa0, 0x2e, 0xe7, 0xf1, 0x2b, 0x92, 0x2b, 0x01, 0xbc, 0x24, 0xa2, 0x37, 0x60, 0x41, 0x78, 0x61,
+	0x6f, 0x78, 0xf8, 0x35, 0xee, 0xc5, 0x3b, 0x67, 0x3e, 0x0e, 0x79, 0x70, 0x17, 0xe2, 0xd0, 0x43,
+	0xb8, 0x2a, 0xe0, 0xac, 0x5e, 0x56, 0x68, 0x21, 0x5a, 0x1a, 0xe1, 0x57, 0x4a, 0x63, 0xa7, 0x59,
+	0xac, 0x4d, 0x58, 0xcc, 0xaa, 0xca, 0x4d, 0x76, 0x2b, 0xc9, 0x5e, 0x5e, 0x4f, 0x33, 0x16, 0x93,
+	0x68, 0xeb, 0x6f, 0x1a, 0xdc, 0x4c, 0x1b, 0x5e, 0x2a, 0x26, 0x2c, 0xd7, 0xcd, 0xc7, 0xcb, 0x9d,
+	0x82, 0x78, 0xc9, 0xf2, 0xc9, 0xdd, 0xba, 0x51, 0x3a, 0x74, 0xde, 0x03, 0x90, 0xb4, 0xcc, 0xd8,
+	0xf5, 0x8d, 0xc5, 0xd4, 0xf7, 0x25, 0xa2, 0x14, 0x4a, 0xeb, 0x4d, 0x98, 0x51, 0x45, 0x16, 0xc7,
+	0xd1, 0x57, 0xf0, 0xfa, 0xc8, 0xcf, 0xe2, 0xd6, 0x49, 0xef, 0xaf, 0x4d, 0xbc, 0xff, 0x4d, 0x58,
+	0xda, 0x76, 0xa3, 0x78, 0x94, 0xbe, 0x16, 0x86, 0xe5, 0x11, 0x78, 0xbe, 0x71, 0xbb, 0xa0, 0xd8,
+	0x70, 0xff, 0x8c, 0xda, 0x3f, 0xcf, 0x60, 0x7d, 0x53, 0x86, 0xfa, 0xe6, 0x33, 0xc7, 0x3f, 0xc6,
+	0x5b, 0xa7, 0xd8, 0x8f, 0x51, 0x03, 0x6a, 0x5f, 0x47, 0x81, 0x4f, 0x2f, 0x3b, 0xfc, 0x3e, 0xf8,
+	0x69, 0x4c, 0xae, 0x36, 0xab, 0x30, 0x45, 0x81, 0x25, 0xea, 0xb2, 0x05, 0xba, 0x83, 0xc2, 0x48,
+	0x70, 0x36, 0xa5, 0x90, 0x43, 0x89, 0x72, 0xe1, 0x50, 0x42, 0x4e, 0x2a, 0xa7, 0x0a, 0x27, 0x95,
+	0xe9, 0x3e, 0xbe, 0xf2, 0x92, 0x3e, 0x9e, 0x1e, 0xf3, 0x3d, 0xaf, 0x59, 0xcd, 0x1d, 0xf3, 0x3d,
+	0x0f, 0x7d, 0x04, 0x57, 0xd3, 0xc6, 0xa9, 0x8d, 0x35, 0x4e, 0x9a, 0x18, 0x7d, 0x9a, 0x6a, 0x8b,
+	0x59, 0x37, 0xbd, 0x92, 0xd5, 0x7a, 0xdc, 0x70, 0xe3, 0xff, 0xbd, 0xbe, 0x7f, 0xaf, 0xc1, 0x6b,
+	0x2c, 0x5f, 0xb7, 0xfc, 0x63, 0xd7, 0xc7, 0xca, 0x88, 0x4c, 0x64, 0xae, 0x18, 0x91, 0x89, 0x35,
+	0x69, 0x14, 0x94, 0x56, 0x44, 0x97, 0xad, 0x87, 0x09, 0x35, 0x5e, 0x30, 0xc4, 0x69, 0x2e, 0xd7,
+	0xe8, 0x13, 0x98, 0xe6, 0x07, 0x19, 0x9f, 0xc2, 0xb0, 0xf2, 0x5d, 0xb0, 0xf5, 0x9a, 0x38, 0xf0,
+	0xa8, 0xaa, 0x82, 0xcb, 0xfc, 0x00, 0x66, 0x54, 0xc4, 0x85, 0x94, 0x3c, 0x85, 0x85, 0xf4, 0x46,
+	0x3c, 0xb8, 0x9b, 0x30, 0xdd, 0xf2, 0xbc, 0xe0, 0x8c, 0xf7, 0x8a, 0x35, 0x5b, 0x2c, 0x49, 0x73,
+	0xb2, 0xf5, 0x62, 0x40, 0x8e, 0xa5, 0xb8, 0x8d, 0xfd, 0x73, 0x2a, 0xb2, 0x66, 0xa7, 0x60, 0xa4,
+	0xa3, 0x6a, 0xe3, 0x23, 0x67, 0xe8, 0x31, 0x12, 0x36, 0x56, 0x55, 0x41, 0xd6, 0x2f, 0xc4, 0xbe,
+	0x9b, 0x81, 0xdf, 0xa7, 0xe7, 0xe2, 0x7e, 0x48, 0xbc, 0x6e, 0x40, 0xf9, 0x49, 0xf2, 0xed, 0x4f,
+	0x30, 0xbd, 0x06, 0xc8, 0xb8, 0xd7, 0x79, 0x84, 0x2f, 0x81, 0xfe, 0x68, 0xe8, 0x1d, 0xb9, 0x9e,
+	0x87, 0xfb, 0x5c, 0x7a, 0x02, 0xb0, 0xfe, 0xaa, 0x41, 0x9d, 0x09, 0x67, 0x32, 0x9b, 0x30, 0xcd,
+	0x8d, 0xcd, 0xe5, 0x8a, 0x25, 0x7a, 0x13, 0xaa, 0x8c, 0x90, 0x77, 0x8e, 0x75, 0xc5, 0xf2, 0x36,
+	0x47, 0x91, 0xf1, 0x9a, 0xfc, 0xc8, 0xf4, 0x78, 0xad, 0x48, 0x03, 0x5b, 0x21, 0x26, 0x3b, 0x3f,
+	0x75, 0xe2, 0xde, 0x33, 0xdc, 0xe7, 0xed, 0x82, 0x58, 0x92, 0x80, 0x68, 0xe3, 0x9e, 0x4b, 0x47,
+	0x34, 0x7c, 0x4c, 0x2f, 0xd6, 0xd6, 0xaf, 0xa0, 0xa1, 0xfa, 0x84, 0x58, 0xd6, 0xf1, 0x59, 0xb1,
+	0xbd, 0x07, 0x35, 0xe1, 0x22, 0x5e, 0x6a, 0xae, 0x17, 0x04, 0x0b, 0x23, 0xb0, 0x25, 0x29, 0x5a,
+	0x85, 0xea, 0x7e, 0xa8, 0xd4, 0x67, 0x43, 0x61, 0x62, 0x5f, 0xcd, 0xf1, 0xd6, 0x63, 0x98, 0xcb,
+	0x68, 0x45, 0x1c, 0x10, 0x27, 0xd5, 0x88, 0xfe, 0x26, 0x0e, 0x26, 0x55, 0x6a, 0x67, 0xc0, 0x8c,
+	0xc2, 0x7c, 0xa3, 0x82, 0xac, 0x6f, 0x4b, 0xc2, 0xb6, 0xa4, 0x5f, 0x97, 0xfd, 0x66, 0xc9, 0xed,
+	0x67, 0xaf, 0x6b, 0xa5, 0xfc, 0x75, 0xcd, 0x84, 0x5a, 0x94, 0x49, 0x17, 0xb1, 0x26, 0xbe, 0x0f,
+	0x79, 0xba, 0x89, 0xd6, 0x37, 0x01, 0x10, 0x8b, 0x3b, 0xbc, 0xfd, 0xae, 0xb0, 0xb6, 0x98, 0x2f,
+	0xd1, 0x2d, 0xa8, 0xe2, 0xa3, 0x23, 0x12, 0x04, 0x55, 0x5a, 0x41, 0xe7, 0x55, 0xc3, 0x51, 0x84,
+	0xcd, 0x09, 0xd0, 0x87, 0x00, 0xbd, 0xc4, 0xe3, 0xac, 0xf4, 0xdc, 0x50, 0xc8, 0xd7, 0x12, 0x17,
+	0xf3, 0xaa, 0x93, 0x90, 0x9b, 0x7b, 0x30, 0x97, 0x41, 0x17, 0x24, 0xe4, 0xed, 0xf4, 0xd5, 0x78,
+	0xa1, 0x28, 0x9c, 0xd4, 0x34, 0xfd, 0x75, 0x49, 0x84, 0x34, 0x1b, 0x94, 0x16, 0x35, 0xf1, 0xe2,
+	0x22, 0x55, 0x52, 0x2e, 0x52, 0x2b, 0x05, 0x53, 0x92, 0xf4, 0x70, 0x64, 0x09, 0x74, 0xda, 0xc3,
+	0x50, 0x71, 0xbc, 0x81, 0x97, 0x00, 0xf4, 0x20, 0x69, 0x6c, 0xe8, 0xc6, 0xfc, 0xae, 0xdc, 0x54,
+	0xf3, 0x44, 0xc5, 0xdb, 0x69, 0xf2, 0x09, 0x46, 0x26, 0x3f, 0xce, 0x8d, 0x4c, 0x52, 0x49, 0x28,
+	0x91, 0xd6, 0x53, 0x68, 0xec, 0xc5, 0x41, 0x88, 0x15, 0x33, 0x88, 0x8a, 0xbc, 0x91, 0x32, 0x0e,
+	0x4f, 0x0c, 0x35, 0xc6, 0x19, 0xb5, 0x4a, 0x64, 0x75, 0xa1, 0x99, 0x17, 0xc7, 0xd3, 0xe5, 0x92,
+	0xf2, 0xd8, 0x4d, 0xfa, 0x15, 0x7d, 0xdf, 0x3d, 0xb8, 0x5e, 0x20, 0x2f, 0xa9, 0xce, 0x7b, 0xc3,
+	0x5e, 0x0f, 0x47, 0x91, 0xa8, 0xce, 0x7c, 0x69, 0x5d, 0x87, 0x06, 0xe9, 0x5a, 0x14, 0xa6, 0x48,
+	0x34, 0x34, 0x47, 0xd0, 0xcc, 0xa3, 0xb8, 0xc0, 0x9f, 0xc1, 0x8c, 0x0a, 0xe7, 0x6d, 0x54, 0xfe,
+	0x13, 0x53, 0x54, 0xe4, 0x58, 0xd9, 0x0f, 0x62, 0x87, 0xdd, 0x9d, 0x2a, 0x36, 0x5b, 0xdc, 0x7e,
+	0x27, 0x19, 0xd7, 0xa2, 0x3a, 0x4c, 0x1f, 0x74, 0x9f, 0x74, 0x77, 0xbe, 0xec, 0x1a, 0x57, 0x50,
+	0x0d, 0xa6, 0x0e, 0xf6, 0xb6, 0x6c, 0x43, 0x43, 0x3a, 0x54, 0x1e, 0xdb, 0x3b, 0x07, 0xbb, 0x46,
+	0xe9, 0xf6, 0x7d, 0x98, 0x4d, 0xcf, 0x5c, 0x48, 0x23, 0xd8, 0xea, 0x7e, 0x65, 0x5c, 0x21, 0x54,
+	0xad, 0xf6, 0xd3, 0x4e, 0xd7, 0xd0, 0x08, 0xab, 0xbd, 0xb3, 0xf3, 0xd4, 0x28, 0x91, 0x5f, 0xdb,
+	0x9d, 0xee, 0x13, 0xa3, 0x7c, 0xfb, 0x00, 0xe6, 0x32, 0xfd, 0x0f, 0x69, 0x1d, 0x37, 0xed, 0xad,
+	0xd6, 0xfe, 0x16, 0xdb, 0xcd, 0xde, 0x6a, 0xb5, 0x0d, 0x8d, 0x40, 0x0f, 0x76, 0xdb, 0x04, 0x5a,
+	0x52, 0x9a, 0xcb, 0x32, 0xa1, 0x78, 0xd8, 0xe9, 0xb6, 0x8d, 0x29, 0x02, 0xdd, 0xde, 0x79, 0xbc,
+	0x73, 0xb0, 0x6f, 0x54, 0x6e, 0xdf, 0x81, 0x19, 0xb5, 0x28, 0x10, 0x15, 0x86, 0xfe, 0x73, 0x3f,
+	0x38, 0xf3, 0x99, 0xd0, 0x3e, 0xf6, 0xcf, 0x99, 0x0a, 0x0e, 0x39, 0x11, 0x8d, 0xd2, 0xed, 0x0d,
+	0xd1, 0x27, 0xa4, 0x63, 0xbf, 0x06, 0x53, 0x21, 0x8e, 0x62, 0xe3, 0x0a, 0xd1, 0xc8, 0xe9, 0x79,
+	0x4c, 0x8d, 0xc0, 0xed, 0xf7, 0x8c, 0xd2, 0xc6, 0x37, 0x25, 0x32, 0x90, 0xf1, 0xf0, 0x1e, 0xbb,
+	0x20, 0xa0, 0x4f, 0x00, 0x92, 0x77, 0x73, 0xc4, 0x5a, 0xa4, 0xdc, 0xe3, 0xbb, 0xd9, 0xc8, 0xc1,
+	0x99, 0xff, 0xac, 0x2b, 0x44, 0x40, 0xf2, 0x10, 0xce, 0x05, 0xe4, 0x1e, 0xd5, 0xcd, 0x46, 0x0e,
+	0x2e, 0x05, 0xb4, 0x00, 0x92, 0x67, 0x6d, 0x2e, 0x20, 0xf7, 0x44, 0x6e, 0x36, 0x72, 0x70, 0x21,
+	0xe0, 0x8e, 0x86, 0x36, 0x01, 0xf6, 0xe2, 0x10, 0x3b, 0x27, 0x97, 0x14, 0xb1, 0xaa, 0xdd, 0xd1,
+	0x36, 0xfe, 0x50, 0x86, 0x3a, 0x7d, 0x35, 0xc9, 0x5a, 0x86, 0x00, 0x53, 0x96, 0x51, 0x1e, 0xf1,
+	0xcc, 0x46, 0x0e, 0x9e, 0xb7, 0x8c, 0x22, 0x20, 0xf7, 0xf0, 0x68, 0x36, 0x72, 0x70, 0x29, 0xe0,
+	0x7d, 0xa8, 0x89, 0xb7, 0x4d, 0xc4, 0x2a, 0x75, 0xe6, 0xd5, 0xd4, 0xbc, 0x96, 0x81, 0x4a, 0xd6,
+	0x8f, 0x41, 0x97, 0x0f, 0x80, 0x29, 0x83, 0xa8, 0xdc, 0x5c, 0xa7, 0xec, 0x43, 0xa1, 0xea, 0x93,
+	0xb1, 0xfc, 0x8d, 0x1c, 0xbc, 0xc8, 0x27, 0x97, 0x14, 0x41, 0x7d, 0xf2, 0x5d, 0x09, 0x8c, 0x24,
+	0x4b, 0xb9, 0x63, 0xba, 0x30, 0x97, 0x79, 0x11, 0x41, 0x37, 0x14, 0x2f, 0x64, 0x5f, 0x18, 0xcc,
+	0xa5, 0x62, 0xa4, 0x54, 0xb6, 0x0b, 0x73, 0x99, 0x87, 0x0d, 0x2e, 0xaf, 0xf8, 0xb9, 0xc4, 0x5c,
+	0x2a, 0x46, 0x4a, 0x79, 0xbb, 0x30, 0x97, 0x79, 0xab, 0xe0, 0xf2, 0x8a, 0x5f, 0x40, 0xcc, 0xa5,
+	0x62, 0xa4, 0x62, 0x4b, 0x1b, 0xe6, 0x98, 0x2d, 0x5f, 0x8d, 0x44, 0x6a, 0xda, 0xdf, 0x95, 0x00,
+	0xc8, 0x08, 0x91, 0x1b, 0xf5, 0x23, 0xd0, 0xe5, 0x9c, 0x18, 0x5d, 0x53, 0x2c, 0x96, 0x4c, 0x65,
+	0xcd, 0xc5, 0x2c, 0x58, 0xaa, 0xfc, 0x11, 0xe8, 0x72, 0xf4, 0xcb, 0xb9, 0xb3, 0x43, 0x64, 0x73,
+	0x31, 0x0b, 0x96, 0xdc, 0x0f, 0x40, 0x97, 0x73, 0x5c, 0xce, 0x9d, 0x9d, 0x08, 0x9b, 0x8b, 0x59,
+	0xb0, 0x62, 0x9e, 0x4f, 0x41, 0x67, 0xe6, 0xb9, 0x0c, 0x3f, 0x35, 0xc6, 0x7f, 0x4b, 0xec, 0xc5,
+	0x94, 0xdc, 0x04, 0x85, 0x45, 0x9e, 0xc0, 0x6c, 0x7a, 0x04, 0x80, 0xcc, 0xd1, 0xe3, 0x2d, 0xf3,
+	0x46, 0x21, 0x4e, 0xaa, 0xf8, 0x14, 0x66, 0xd3, 0x43, 0x16, 0x2e, 0xac, 0x70, 0xc8, 0x64, 0xde,
+	0x28, 0xc4, 0x29, 0x1a, 0x1f, 0x41, 0x63, 0xc4, 0x78, 0x02, 0xbd, 0x39, 0xc1, 0x4c, 0xc5, 0x7c,
+	0x6b, 0x3c, 0x91, 0xfc, 0xec, 0x43, 0xb8, 0x56, 0x38, 0x8b, 0x40, 0x6f, 0x50, 0x01, 0xe3, 0xe6,
+	0x18, 0xa6, 0x35, 0x8e, 0x24, 0xd1, 0x65, 0xe3, 0x8f, 0xe5, 0xf4, 0x75, 0x57, 0xd8, 0xff, 0x21,
+	0xe8, 0x9d, 0x48, 0x5c, 0xfe, 0x9a, 0xa3, 0xae, 0xa6, 0xe6, 0xe8, 0x7b, 0x88, 0x75, 0x05, 0x6d,
+	0x83, 0x41, 0x6f, 0x31, 0xae, 0x3f, 0x89, 0xa8, 0xa5, 0x1c, 0x46, 0xb9, 0x02, 0x59, 0x57, 0xd0,
+	0xe7, 0x60, 0x64, 0x5b, 0x37, 0xc4, 0x53, 0xad, 0xb8, 0x41, 0x34, 0x97, 0x47, 0x60, 0xe5, 0x07,
+	0x7e, 0x0e, 0x46, 0xb6, 0x37, 0xe2, 0x22, 0x47, 0x74, 0x53, 0xe6, 0xf2, 0x08, 0xac, 0x14, 0xb9,
+	0x2f, 0x9e, 0x61, 0xd4, 0xcf, 0x5c, 0x56, 0x92, 0xaf, 0xe0, 0x3b, 0x6f, 0x8e, 0x42, 0x0b, 0xa9,
+	0x87, 0x55, 0xfa, 0xaf, 0x79, 0x77, 0xff, 0x37, 0x00, 0x36, 0xf7, 0xd3, 0xa9, 0x53, 0x28, 0x00,
+	0x00,
 }
@@ -403,6 +403,39 @@ func (this *PolicyEngineRequest) Validate() error {
 func (this *PolicyEngineResponse) Validate() error {
 	return nil
 }
+func (this *PolicyConditionTrace) Validate() error {
+	return nil
+}
+func (this *PolicyTrace) Validate() error {
+	if this.Policy != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Policy); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Policy", err)
+		}
+	}
+	for _, item := range this.Conditions {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Conditions", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *PolicyEngineExplanation) Validate() error {
+	if this.Response != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Response); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Response", err)
+		}
+	}
+	for _, item := range this.Traces {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Traces", err)
+			}
+		}
+	}
+	return nil
+}
 func (this *PolicyCondition) Validate() error {
 	return nil
 }
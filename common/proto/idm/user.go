@@ -12,6 +12,9 @@ const (
 	UserAttrPassHashed    = UserAttrPrivatePrefix + "password_hashed"
 	UserAttrLabelLike     = UserAttrPrivatePrefix + "labelLike"
 	UserAttrOrigin        = UserAttrPrivatePrefix + "origin"
+	UserAttrMfa           = UserAttrPrivatePrefix + "mfa"
+	UserAttrPassHistory   = UserAttrPrivatePrefix + "password_history"
+	UserAttrPassChanged   = UserAttrPrivatePrefix + "password_changed"
 
 	UserAttrDisplayName = "displayName"
 	UserAttrProfile     = "profile"
@@ -19,6 +22,8 @@ const (
 	UserAttrEmail       = "email"
 	UserAttrHasEmail    = "hasEmail"
 	UserAttrAuthSource  = "AuthSource"
+	// UserAttrGroupRule is set on dynamic groups, their members are the users matching the rule
+	UserAttrGroupRule = "groupRule"
 )
 
 func (u *User) WithPublicData(ctx context.Context, policiesContextEditable bool) *User {
@@ -20,6 +20,7 @@ It is generated from these files:
 It has these top-level messages:
 	ActivitiesCollection
 	SubscriptionsCollection
+	ChatMessagesCollection
 	LogCollection
 	LogMessageCollection
 	TimeRangeResultCollection
@@ -52,6 +53,11 @@ It has these top-level messages:
 	MetaNamespaceRequest
 	GetBulkMetaRequest
 	BulkMetaResponse
+	BulkUpdateUserMetaRequest
+	ImportUserMetaRequest
+	ExportUserMetaRequest
+	UserMetaBulkChange
+	UserMetaBulkResponse
 	HeadNodeRequest
 	HeadNodeResponse
 	CreateNodesRequest
@@ -100,6 +106,15 @@ It has these top-level messages:
 	ACLCollection
 	SearchWorkspaceRequest
 	WorkspaceCollection
+	WorkspaceTemplate
+	WorkspaceTemplateAcl
+	WorkspaceTemplateTarget
+	ListWorkspaceTemplatesRequest
+	WorkspaceTemplateCollection
+	DeleteWorkspaceTemplateRequest
+	InstantiateWorkspaceTemplateRequest
+	SyncWorkspaceTemplateRequest
+	WorkspaceTemplateResponse
 	UserMetaCollection
 	UserMetaNamespaceCollection
 	ListUserMetaTagsRequest
@@ -111,10 +126,34 @@ It has these top-level messages:
 	UserBookmarksRequest
 	RevokeRequest
 	RevokeResponse
+	PersonalAccessTokenRequest
+	PersonalAccessTokenResponse
+	ListPersonalAccessTokensRequest
+	PersonalAccessTokenCollection
+	RevokePersonalAccessTokenRequest
+	ListSessionsRequest
+	SessionCollection
+	RevokeSessionsRequest
+	RevokeSessionRequest
 	ResetPasswordTokenRequest
 	ResetPasswordTokenResponse
 	ResetPasswordRequest
 	ResetPasswordResponse
+	MfaStatusRequest
+	MfaStatusResponse
+	WebauthnCredential
+	TotpEnrollRequest
+	TotpEnrollResponse
+	MfaCodeRequest
+	MfaRecoveryCodesResponse
+	WebauthnBeginRequest
+	WebauthnOptionsResponse
+	WebauthnRegisterRequest
+	WebauthnDeleteRequest
+	PolicyExplainRequest
+	AclTrace
+	PolicyExplanation
+	GroupRulePreviewRequest
 	UserJobRequest
 	UserJobResponse
 	UserJobsCollection
@@ -122,6 +161,26 @@ It has these top-level messages:
 	Cell
 	ShareLinkTargetUser
 	ShareLink
+	ShareLinkFileRequest
+	FileRequestDrop
+	CreateFileRequestDropRequest
+	CompleteFileRequestDropRequest
+	ShareLinkAccess
+	ShareLinkRecipientReport
+	ListShareLinkAccessRequest
+	ListShareLinkAccessResponse
+	ShareApproval
+	ListShareApprovalsRequest
+	ListShareApprovalsResponse
+	DecideShareApprovalRequest
+	OcmShare
+	PutOcmShareRequest
+	ListOcmSharesRequest
+	ListOcmSharesResponse
+	AcceptOcmShareRequest
+	DeleteOcmShareRequest
+	DeleteOcmShareResponse
+	ListOcmShareNodesRequest
 	PutCellRequest
 	GetCellRequest
 	DeleteCellRequest
@@ -148,6 +207,7 @@ import fmt "fmt"
 import math "math"
 import activity "github.com/pydio/cells/common/proto/activity"
 import log "github.com/pydio/cells/common/proto/log"
+import chat "github.com/pydio/cells/common/proto/chat"
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
@@ -193,6 +253,23 @@ func (m *SubscriptionsCollection) GetSubscriptions() []*activity.Subscription {
 	return nil
 }
 
+// Collection of chat messages
+type ChatMessagesCollection struct {
+	Messages []*chat.ChatMessage `protobuf:"bytes,1,rep,name=Messages" json:"Messages,omitempty"`
+}
+
+func (m *ChatMessagesCollection) Reset()                    { *m = ChatMessagesCollection{} }
+func (m *ChatMessagesCollection) String() string            { return proto.CompactTextString(m) }
+func (*ChatMessagesCollection) ProtoMessage()               {}
+func (*ChatMessagesCollection) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *ChatMessagesCollection) GetMessages() []*chat.ChatMessage {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
 // Collection of serialized log messages
 type LogCollection struct {
 	Lines []*log.Log `protobuf:"bytes,1,rep,name=lines" json:"lines,omitempty"`
@@ -201,7 +278,7 @@ type LogCollection struct {
 func (m *LogCollection) Reset()                    { *m = LogCollection{} }
 func (m *LogCollection) String() string            { return proto.CompactTextString(m) }
 func (*LogCollection) ProtoMessage()               {}
-func (*LogCollection) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+func (*LogCollection) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
 
 func (m *LogCollection) GetLines() []*log.Log {
 	if m != nil {
@@ -218,7 +295,7 @@ type LogMessageCollection struct {
 func (m *LogMessageCollection) Reset()                    { *m = LogMessageCollection{} }
 func (m *LogMessageCollection) String() string            { return proto.CompactTextString(m) }
 func (*LogMessageCollection) ProtoMessage()               {}
-func (*LogMessageCollection) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (*LogMessageCollection) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
 
 func (m *LogMessageCollection) GetLogs() []*log.LogMessage {
 	if m != nil {
@@ -237,7 +314,7 @@ type TimeRangeResultCollection struct {
 func (m *TimeRangeResultCollection) Reset()                    { *m = TimeRangeResultCollection{} }
 func (m *TimeRangeResultCollection) String() string            { return proto.CompactTextString(m) }
 func (*TimeRangeResultCollection) ProtoMessage()               {}
-func (*TimeRangeResultCollection) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (*TimeRangeResultCollection) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
 
 func (m *TimeRangeResultCollection) GetResults() []*log.TimeRangeResult {
 	if m != nil {
@@ -256,6 +333,7 @@ func (m *TimeRangeResultCollection) GetLinks() []*log.TimeRangeCursor {
 func init() {
 	proto.RegisterType((*ActivitiesCollection)(nil), "rest.ActivitiesCollection")
 	proto.RegisterType((*SubscriptionsCollection)(nil), "rest.SubscriptionsCollection")
+	proto.RegisterType((*ChatMessagesCollection)(nil), "rest.ChatMessagesCollection")
 	proto.RegisterType((*LogCollection)(nil), "rest.LogCollection")
 	proto.RegisterType((*LogMessageCollection)(nil), "rest.LogMessageCollection")
 	proto.RegisterType((*TimeRangeResultCollection)(nil), "rest.TimeRangeResultCollection")
@@ -264,24 +342,26 @@ func init() {
 func init() { proto.RegisterFile("broker.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 295 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xcf, 0x4b, 0xf3, 0x30,
-	0x18, 0xc7, 0x79, 0x5f, 0x37, 0x95, 0x47, 0x87, 0x32, 0x86, 0xce, 0x1d, 0x44, 0xea, 0x45, 0x3c,
-	0x24, 0xfe, 0x38, 0xea, 0x65, 0xec, 0xe2, 0xa1, 0x22, 0x44, 0xc1, 0x73, 0x1b, 0x1f, 0x62, 0x5c,
-	0xda, 0xa7, 0x24, 0xa9, 0xb2, 0xff, 0x5e, 0xda, 0xac, 0x35, 0x8a, 0x07, 0x0f, 0x81, 0xf0, 0x7d,
-	0x3e, 0xdf, 0x4f, 0x12, 0x02, 0xbb, 0xb9, 0xa5, 0x25, 0x5a, 0x56, 0x59, 0xf2, 0x34, 0x1e, 0x58,
-	0x74, 0x7e, 0x36, 0x57, 0xda, 0xbf, 0xd6, 0x39, 0x93, 0x54, 0xf0, 0x6a, 0xf5, 0xa2, 0x89, 0x4b,
-	0x34, 0xc6, 0x71, 0x49, 0x45, 0x41, 0x25, 0x6f, 0x51, 0x9e, 0x49, 0xaf, 0xdf, 0xb5, 0x5f, 0xf5,
-	0x1b, 0xe7, 0x2d, 0x66, 0x45, 0x10, 0xcd, 0x2e, 0xff, 0xa2, 0x30, 0xa4, 0x9a, 0x15, 0x2a, 0xc9,
-	0x1d, 0x4c, 0xe6, 0x41, 0xa5, 0xd1, 0x2d, 0xc8, 0x18, 0x94, 0x5e, 0x53, 0x39, 0xbe, 0x00, 0xc8,
-	0xfa, 0x7c, 0xfa, 0xef, 0x64, 0xe3, 0x6c, 0xe7, 0x6a, 0x9f, 0x75, 0xa7, 0xb2, 0x87, 0xfc, 0x0d,
-	0xa5, 0x17, 0x11, 0x93, 0x3c, 0xc3, 0xe1, 0x63, 0x9d, 0x3b, 0x69, 0x75, 0xd5, 0x18, 0x62, 0xd9,
-	0x2d, 0x8c, 0x5c, 0x3c, 0x5a, 0xfb, 0x0e, 0xbe, 0x7c, 0x71, 0x53, 0x7c, 0x87, 0x13, 0x0e, 0xa3,
-	0x94, 0x54, 0xa4, 0x3b, 0x86, 0xa1, 0xd1, 0x65, 0x7f, 0xad, 0x6d, 0xd6, 0x3c, 0x27, 0x25, 0x25,
-	0x42, 0x9c, 0xdc, 0xc0, 0x24, 0x25, 0x75, 0x8f, 0xce, 0x65, 0x0a, 0xa3, 0xde, 0x29, 0x0c, 0x52,
-	0x52, 0x5d, 0x6d, 0xaf, 0xab, 0xad, 0x41, 0xd1, 0x0e, 0x93, 0x0f, 0x38, 0x7a, 0xd2, 0x05, 0x8a,
-	0xac, 0x54, 0x28, 0xd0, 0xd5, 0xc6, 0x47, 0x06, 0x06, 0x5b, 0x21, 0xeb, 0x24, 0x93, 0x56, 0xf2,
-	0xa3, 0x20, 0x3a, 0x68, 0x7c, 0x0e, 0xc3, 0x54, 0x97, 0x4b, 0x37, 0xfd, 0xff, 0x1b, 0xbd, 0xa8,
-	0xad, 0x23, 0x2b, 0x02, 0x92, 0x6f, 0xb6, 0x1f, 0x72, 0xfd, 0x19, 0x00, 0x00, 0xff, 0xff, 0x9c,
-	0xda, 0xdc, 0x76, 0x1c, 0x02, 0x00, 0x00,
+	// 323 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x41, 0x4b, 0xc3, 0x30,
+	0x14, 0x80, 0x51, 0x37, 0x1d, 0x4f, 0x87, 0x3a, 0xc6, 0x9c, 0x3b, 0x88, 0xd4, 0x8b, 0x08, 0xa6,
+	0xea, 0x8e, 0x7a, 0x19, 0x3b, 0xe8, 0xa1, 0x22, 0x44, 0xc1, 0x73, 0x1a, 0x43, 0x16, 0x97, 0xf6,
+	0x8d, 0x24, 0x55, 0xf6, 0xef, 0x65, 0x6d, 0x53, 0x33, 0xf1, 0xb0, 0x43, 0x4b, 0x79, 0xf9, 0xbe,
+	0x2f, 0x69, 0x0b, 0x07, 0xa9, 0xc1, 0xb9, 0x30, 0x64, 0x61, 0xd0, 0x61, 0xaf, 0x65, 0x84, 0x75,
+	0xa3, 0x89, 0x54, 0x6e, 0x56, 0xa4, 0x84, 0x63, 0x16, 0x2f, 0x96, 0x1f, 0x0a, 0x63, 0x2e, 0xb4,
+	0xb6, 0x31, 0xc7, 0x2c, 0xc3, 0x3c, 0x2e, 0xd1, 0x98, 0x71, 0xa7, 0xbe, 0x94, 0x5b, 0x36, 0x0f,
+	0xd6, 0x19, 0xc1, 0xb2, 0x2a, 0x34, 0xba, 0xdd, 0x24, 0xa1, 0x51, 0xae, 0xae, 0x5a, 0x19, 0x6f,
+	0xa2, 0xf0, 0x19, 0x73, 0xe5, 0xad, 0x92, 0xa2, 0x27, 0xe8, 0x4f, 0xaa, 0xfd, 0x95, 0xb0, 0x53,
+	0xd4, 0x5a, 0x70, 0xa7, 0x30, 0xef, 0xdd, 0x00, 0xb0, 0x66, 0x3e, 0xdc, 0x3a, 0xdf, 0xb9, 0xdc,
+	0xbf, 0x3b, 0x22, 0xfe, 0xa8, 0xe4, 0x25, 0xfd, 0x14, 0xdc, 0xd1, 0x80, 0x89, 0xde, 0xe1, 0xe4,
+	0xb5, 0x48, 0x2d, 0x37, 0x6a, 0xb1, 0x2a, 0x84, 0xb1, 0x07, 0xe8, 0xda, 0x70, 0xa9, 0xee, 0x0d,
+	0x7e, 0x7b, 0xa1, 0x49, 0xd7, 0xe1, 0xe8, 0x11, 0x06, 0xd3, 0x19, 0x73, 0xcf, 0xc2, 0x5a, 0x26,
+	0xd7, 0x0e, 0x79, 0x0d, 0x1d, 0x3f, 0xad, 0x93, 0xc7, 0xa4, 0x7c, 0xb7, 0x80, 0xa7, 0x0d, 0x12,
+	0xc5, 0xd0, 0x4d, 0x50, 0x06, 0xfe, 0x19, 0xb4, 0xb5, 0xca, 0x1b, 0xb9, 0x43, 0x56, 0x1f, 0x33,
+	0x41, 0x49, 0xab, 0x71, 0x74, 0x0f, 0xfd, 0x04, 0x65, 0xed, 0x07, 0xde, 0x05, 0xb4, 0x12, 0x94,
+	0x5e, 0x3b, 0xf4, 0x9a, 0xdf, 0xb1, 0x5c, 0x8c, 0xbe, 0xe1, 0xf4, 0x4d, 0x65, 0x82, 0xb2, 0x5c,
+	0x0a, 0x2a, 0x6c, 0xa1, 0x5d, 0x50, 0x20, 0xb0, 0x57, 0xcd, 0x7c, 0xa4, 0x5f, 0x46, 0xfe, 0x08,
+	0xd4, 0x43, 0xbd, 0x2b, 0x68, 0x27, 0x2a, 0x9f, 0xdb, 0xe1, 0xf6, 0x7f, 0xf4, 0xb4, 0x30, 0x16,
+	0x0d, 0xad, 0x90, 0x74, 0xb7, 0xfc, 0xb3, 0xe3, 0x9f, 0x01, 0x00, 0x24, 0x8d, 0x48, 0x7f, 0x9a,
+	0x02, 0x00, 0x00,
 }
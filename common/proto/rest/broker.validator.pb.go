@@ -8,6 +8,7 @@ import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import _ "github.com/pydio/cells/common/proto/activity"
+import _ "github.com/pydio/cells/common/proto/chat"
 import _ "github.com/pydio/cells/common/proto/log"
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -35,6 +36,16 @@ func (this *SubscriptionsCollection) Validate() error {
 	}
 	return nil
 }
+func (this *ChatMessagesCollection) Validate() error {
+	for _, item := range this.Messages {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Messages", err)
+			}
+		}
+	}
+	return nil
+}
 func (this *LogCollection) Validate() error {
 	for _, item := range this.Lines {
 		if item != nil {
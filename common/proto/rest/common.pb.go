@@ -101,7 +101,7 @@ func init() {
 func init() { proto.RegisterFile("common.proto", fileDescriptor1) }
 
 var fileDescriptor1 = []byte{
-	// 229 bytes of a gzipped FileDescriptorProto
+	// 226 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x90, 0xbb, 0x4e, 0xc4, 0x30,
 	0x10, 0x45, 0xe5, 0xcd, 0x03, 0x32, 0x20, 0x84, 0x46, 0x80, 0x2c, 0xaa, 0x68, 0xab, 0xd0, 0xa4,
 	0x80, 0x02, 0x44, 0x4b, 0xb6, 0x84, 0xc2, 0xcb, 0x0f, 0x84, 0x30, 0xc5, 0x8a, 0x24, 0xb3, 0xf2,
@@ -115,6 +115,6 @@ var fileDescriptor1 = []byte{
 	0xbc, 0x83, 0xfc, 0x95, 0x7c, 0xaf, 0xcb, 0x3a, 0x6b, 0xce, 0xee, 0xaf, 0xdb, 0x38, 0xbf, 0x95,
 	0x4b, 0xdb, 0xe8, 0x37, 0xb3, 0xb7, 0x07, 0x23, 0x95, 0xdb, 0x47, 0xa8, 0x8e, 0x0a, 0x2f, 0x21,
 	0xfb, 0xa2, 0x83, 0xbc, 0xa5, 0x32, 0x31, 0xc6, 0x3d, 0xdf, 0xfd, 0x18, 0x96, 0x91, 0x09, 0x9e,
-	0x57, 0x4f, 0xea, 0xa3, 0x94, 0xaf, 0x79, 0xf8, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x92, 0x69, 0xe1,
-	0xef, 0x2a, 0x01, 0x00, 0x00,
+	0x57, 0x4f, 0xea, 0xa3, 0x94, 0xaf, 0x79, 0xf8, 0x1b, 0x00, 0x92, 0x69, 0xe1, 0xef, 0x2a, 0x01,
+	0x00, 0x00,
 }
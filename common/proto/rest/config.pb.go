@@ -467,55 +467,55 @@ func init() {
 func init() { proto.RegisterFile("config.proto", fileDescriptor2) }
 
 var fileDescriptor2 = []byte{
-	// 791 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xeb, 0x8a, 0xe3, 0x36,
-	0x14, 0xc6, 0x99, 0x6b, 0xce, 0x5c, 0xba, 0x51, 0x67, 0x67, 0xbd, 0x59, 0x5a, 0x06, 0x51, 0xca,
-	0x40, 0xa9, 0x43, 0xb3, 0xdb, 0x6d, 0x29, 0x85, 0xb2, 0x1b, 0x6f, 0x20, 0x30, 0x9d, 0x1a, 0x67,
-	0xe8, 0x7f, 0xc5, 0x56, 0xb3, 0xea, 0xc8, 0x96, 0x2b, 0xc9, 0x81, 0xfc, 0xef, 0x6b, 0xf5, 0x01,
-	0xfa, 0x56, 0x45, 0xb2, 0xec, 0x28, 0x49, 0x17, 0xe6, 0x47, 0x62, 0x9d, 0xef, 0xdc, 0xbe, 0x73,
-	0x91, 0x0d, 0xe7, 0x99, 0x28, 0xff, 0x60, 0xcb, 0xa8, 0x92, 0x42, 0x0b, 0x74, 0x28, 0xa9, 0xd2,
-	0xc3, 0xd7, 0x4b, 0xa6, 0x3f, 0xd6, 0x8b, 0x28, 0x13, 0xc5, 0xa8, 0x5a, 0xe7, 0x4c, 0x8c, 0x32,
-	0xca, 0xb9, 0x1a, 0x65, 0xa2, 0x28, 0x44, 0x39, 0xb2, 0xa6, 0x23, 0x2d, 0x29, 0xb5, 0x7f, 0x8d,
-	0xeb, 0xf0, 0x87, 0xa7, 0x38, 0x89, 0xc5, 0x9f, 0x34, 0xd3, 0xee, 0xe1, 0x1c, 0xbf, 0x7b, 0x8a,
-	0x63, 0xa6, 0xb9, 0xf9, 0x35, 0x2e, 0xf8, 0x17, 0xb8, 0x98, 0x58, 0xda, 0xb5, 0x24, 0x9a, 0x89,
-	0x12, 0x0d, 0xe1, 0x74, 0x5a, 0x73, 0x9e, 0x10, 0xfd, 0x31, 0x0c, 0x6e, 0x82, 0xdb, 0x7e, 0xda,
-	0xc9, 0x08, 0xc1, 0x61, 0x4c, 0x34, 0x09, 0x7b, 0x16, 0xb7, 0x67, 0xfc, 0x02, 0x9e, 0xdf, 0x31,
-	0xa5, 0xcd, 0x79, 0x2e, 0x6a, 0x99, 0xd1, 0x94, 0xfe, 0x55, 0x53, 0xa5, 0xf1, 0x02, 0xae, 0x36,
-	0xe0, 0x44, 0x70, 0x4e, 0x33, 0x9b, 0xe0, 0x0d, 0x9c, 0x6d, 0x70, 0x15, 0x06, 0x37, 0x07, 0xb7,
-	0x67, 0x63, 0x14, 0xb9, 0x42, 0xbc, 0x38, 0xbe, 0x19, 0xba, 0x82, 0xa3, 0x07, 0xa1, 0x09, 0xb7,
-	0xb9, 0x8f, 0xd2, 0x46, 0xc0, 0x6f, 0x20, 0x8c, 0x29, 0xa7, 0x9a, 0xfa, 0xe9, 0x55, 0x25, 0x4a,
-	0x45, 0x51, 0x08, 0x27, 0xf3, 0x3a, 0xcb, 0xa8, 0x52, 0xb6, 0x8e, 0xd3, 0xb4, 0x15, 0xf1, 0x2b,
-	0x78, 0x69, 0x28, 0x27, 0x94, 0x4a, 0xf5, 0x2e, 0xcf, 0x25, 0x55, 0x8a, 0xaa, 0x96, 0xf6, 0x7b,
-	0x18, 0xfe, 0x9f, 0xd2, 0x05, 0xfd, 0x0a, 0x2e, 0x8c, 0xa6, 0x53, 0x58, 0xfa, 0xfd, 0x74, 0x1b,
-	0xc4, 0xf7, 0x70, 0xdd, 0xc6, 0x98, 0x0a, 0x9e, 0x53, 0xd9, 0x46, 0x47, 0x37, 0x70, 0xe6, 0x99,
-	0xba, 0x06, 0xfb, 0x90, 0xe9, 0xb1, 0xed, 0xbd, 0xeb, 0xb1, 0x39, 0xe3, 0x7f, 0x03, 0x38, 0x49,
-	0xa4, 0x30, 0xe4, 0xd1, 0x25, 0xf4, 0x66, 0xb1, 0x73, 0xec, 0xcd, 0x62, 0x33, 0xaf, 0x84, 0x48,
-	0x5a, 0xea, 0x59, 0xec, 0x7c, 0x3a, 0xd9, 0x64, 0xfb, 0x95, 0x6a, 0xc9, 0x32, 0x95, 0x08, 0xa9,
-	0xc3, 0x03, 0xdb, 0x3a, 0x1f, 0x42, 0xd7, 0x70, 0x6c, 0x92, 0xcf, 0xf2, 0xf0, 0xd0, 0xfa, 0x3a,
-	0x69, 0x97, 0xe7, 0xd1, 0x3e, 0xcf, 0x21, 0x9c, 0xce, 0x35, 0x91, 0xfa, 0x81, 0x2c, 0xc3, 0xe3,
-	0x26, 0x6f, 0x2b, 0x5b, 0x1d, 0x95, 0x2b, 0x66, 0xe6, 0x7b, 0x62, 0x1b, 0xd4, 0xc9, 0x38, 0x81,
-	0x2b, 0xdb, 0x9b, 0xa6, 0x9c, 0xae, 0xef, 0x1e, 0x93, 0x60, 0x97, 0x89, 0xf3, 0xbd, 0x27, 0x05,
-	0x75, 0x25, 0xfa, 0x10, 0x8e, 0x9b, 0x0d, 0xf4, 0x22, 0xba, 0x61, 0x7d, 0x03, 0xfd, 0x0e, 0x74,
-	0x7b, 0x76, 0x11, 0x99, 0x6b, 0x19, 0x39, 0x38, 0xdd, 0xe8, 0xf1, 0x17, 0xf0, 0xca, 0x44, 0xf9,
-	0x9d, 0x4a, 0xc5, 0x44, 0xc9, 0xca, 0x65, 0x22, 0x38, 0xcb, 0xd6, 0xed, 0x5a, 0x24, 0x30, 0xdc,
-	0x55, 0x79, 0x3b, 0x3d, 0x86, 0x53, 0x8b, 0xb1, 0x2e, 0xd1, 0x75, 0x64, 0x2f, 0xf4, 0x5e, 0xb8,
-	0xce, 0x0e, 0xbf, 0x84, 0x17, 0x36, 0x21, 0x93, 0xba, 0x26, 0xfc, 0x5e, 0xe4, 0x9b, 0x1d, 0xbc,
-	0x03, 0x64, 0x54, 0xae, 0xc8, 0xb6, 0x43, 0x6f, 0xe1, 0x7c, 0xae, 0x89, 0xae, 0xd5, 0x94, 0x71,
-	0x4d, 0xa5, 0xed, 0xd3, 0xe5, 0x18, 0x45, 0xe6, 0x32, 0x3b, 0xd3, 0x46, 0x9f, 0x6e, 0xd9, 0xe1,
-	0x39, 0x0c, 0x9c, 0xda, 0x63, 0x7c, 0xeb, 0x8d, 0xa8, 0x61, 0x7c, 0xee, 0x07, 0xda, 0x0c, 0xec,
-	0x13, 0x37, 0xef, 0xef, 0x00, 0x9e, 0x4f, 0x44, 0xa9, 0xa5, 0xe0, 0x3b, 0x34, 0x77, 0x06, 0x16,
-	0xec, 0x0d, 0xcc, 0xac, 0x87, 0x29, 0xd7, 0x9b, 0x67, 0x27, 0xa3, 0x6f, 0xe1, 0x64, 0x22, 0x8a,
-	0x82, 0x94, 0xb9, 0x5d, 0xd7, 0xcb, 0xf1, 0xe7, 0x3e, 0x2d, 0xa7, 0x4a, 0x5b, 0x1b, 0xfc, 0x16,
-	0x9e, 0xc5, 0x4c, 0x65, 0x62, 0x45, 0x65, 0x3b, 0x2a, 0x84, 0xe1, 0xfc, 0x43, 0x99, 0x57, 0x82,
-	0x95, 0xfa, 0x61, 0x5d, 0xb5, 0x0c, 0xb6, 0x30, 0xfc, 0x4f, 0x0f, 0x06, 0x9e, 0xa3, 0x5b, 0x18,
-	0xb3, 0xf5, 0x24, 0x7b, 0x24, 0x4b, 0xea, 0x39, 0xfa, 0x90, 0x89, 0xed, 0xc4, 0x3b, 0xb2, 0xa0,
-	0xdc, 0xd1, 0xdf, 0xc2, 0xcc, 0x8b, 0xc7, 0x8d, 0xdd, 0x96, 0xd0, 0x4f, 0x5b, 0x11, 0x7d, 0x09,
-	0xf0, 0xbe, 0x66, 0x3c, 0x9f, 0x6b, 0x52, 0x54, 0xf6, 0xc6, 0x1d, 0xa5, 0x1e, 0x62, 0xde, 0x2e,
-	0x56, 0x4a, 0xe9, 0x8a, 0x59, 0xff, 0xe6, 0xde, 0x6d, 0x83, 0x28, 0x86, 0x7e, 0x5b, 0x8b, 0x0a,
-	0x8f, 0xed, 0xec, 0xbe, 0x6e, 0xd6, 0x7a, 0xaf, 0xa2, 0xa8, 0x33, 0xfc, 0x50, 0x6a, 0xb9, 0x4e,
-	0x37, 0x8e, 0xc3, 0x9f, 0xe1, 0x72, 0x5b, 0x89, 0x9e, 0xc1, 0xc1, 0x23, 0x5d, 0xbb, 0xaa, 0xcd,
-	0xd1, 0x8c, 0x7e, 0x45, 0x78, 0xdd, 0x4e, 0xa9, 0x11, 0x7e, 0xea, 0xfd, 0x18, 0xe0, 0xef, 0x61,
-	0xd0, 0x7c, 0x36, 0xa6, 0x42, 0x16, 0x4f, 0x9e, 0x3c, 0x1e, 0xc0, 0x67, 0xbf, 0x55, 0xb4, 0x7c,
-	0x57, 0xb1, 0x96, 0xe1, 0xe2, 0xd8, 0x7e, 0x87, 0x5e, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0xfb,
-	0x06, 0x64, 0x26, 0x3e, 0x07, 0x00, 0x00,
+	// 789 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xeb, 0x6e, 0xdb, 0x36,
+	0x14, 0x86, 0x9d, 0xab, 0x4f, 0x2e, 0x6b, 0xb8, 0x24, 0x55, 0x5d, 0x6c, 0x08, 0x88, 0x61, 0x08,
+	0x30, 0x4c, 0xc6, 0xd2, 0xae, 0x1b, 0x86, 0x01, 0x43, 0x1b, 0x35, 0x40, 0x80, 0x2c, 0x13, 0xe4,
+	0x60, 0xff, 0x69, 0xe9, 0xcc, 0xe5, 0x4a, 0x89, 0x1a, 0x49, 0x05, 0xf0, 0xff, 0xbd, 0xd6, 0x1e,
+	0x60, 0x6f, 0x35, 0x90, 0xa2, 0x64, 0xda, 0x5e, 0x81, 0xfc, 0xb0, 0xc5, 0xf3, 0x9d, 0xdb, 0x77,
+	0x2e, 0x94, 0xe0, 0x30, 0x97, 0xd5, 0x1f, 0x7c, 0x1e, 0xd7, 0x4a, 0x1a, 0x49, 0xb6, 0x15, 0x6a,
+	0x33, 0x7e, 0x35, 0xe7, 0xe6, 0x43, 0x33, 0x8b, 0x73, 0x59, 0x4e, 0xea, 0x45, 0xc1, 0xe5, 0x24,
+	0x47, 0x21, 0xf4, 0x24, 0x97, 0x65, 0x29, 0xab, 0x89, 0x33, 0x9d, 0x18, 0x85, 0xe8, 0xfe, 0x5a,
+	0xd7, 0xf1, 0x0f, 0x4f, 0x71, 0x92, 0xb3, 0x3f, 0x31, 0x37, 0xfe, 0xe1, 0x1d, 0xbf, 0x7b, 0x8a,
+	0x63, 0x6e, 0x84, 0xfd, 0xb5, 0x2e, 0xf4, 0x17, 0x38, 0xba, 0x76, 0xb4, 0x1b, 0xc5, 0x0c, 0x97,
+	0x15, 0x19, 0xc3, 0xfe, 0x4d, 0x23, 0x44, 0xca, 0xcc, 0x87, 0x68, 0x70, 0x31, 0xb8, 0x1c, 0x65,
+	0xbd, 0x4c, 0x08, 0x6c, 0x27, 0xcc, 0xb0, 0x68, 0xe8, 0x70, 0x77, 0xa6, 0xcf, 0xe1, 0xec, 0x8e,
+	0x6b, 0x63, 0xcf, 0x53, 0xd9, 0xa8, 0x1c, 0x33, 0xfc, 0xab, 0x41, 0x6d, 0xe8, 0x0c, 0x4e, 0x97,
+	0xe0, 0xb5, 0x14, 0x02, 0x73, 0x97, 0xe0, 0x35, 0x1c, 0x2c, 0x71, 0x1d, 0x0d, 0x2e, 0xb6, 0x2e,
+	0x0f, 0xae, 0x48, 0xec, 0x0b, 0x09, 0xe2, 0x84, 0x66, 0xe4, 0x14, 0x76, 0x1e, 0xa4, 0x61, 0xc2,
+	0xe5, 0xde, 0xc9, 0x5a, 0x81, 0xbe, 0x86, 0x28, 0x41, 0x81, 0x06, 0xc3, 0xf4, 0xba, 0x96, 0x95,
+	0x46, 0x12, 0xc1, 0xde, 0xb4, 0xc9, 0x73, 0xd4, 0xda, 0xd5, 0xb1, 0x9f, 0x75, 0x22, 0x7d, 0x09,
+	0x2f, 0x2c, 0xe5, 0x14, 0x51, 0xe9, 0xb7, 0x45, 0xa1, 0x50, 0x6b, 0xd4, 0x1d, 0xed, 0x77, 0x30,
+	0xfe, 0x3f, 0xa5, 0x0f, 0xfa, 0x15, 0x1c, 0x59, 0x4d, 0xaf, 0x70, 0xf4, 0x47, 0xd9, 0x2a, 0x48,
+	0xef, 0xe1, 0xbc, 0x8b, 0x71, 0x23, 0x45, 0x81, 0xaa, 0x8b, 0x4e, 0x2e, 0xe0, 0x20, 0x30, 0xf5,
+	0x0d, 0x0e, 0x21, 0xdb, 0x63, 0xd7, 0x7b, 0xdf, 0x63, 0x7b, 0xa6, 0xff, 0x0e, 0x60, 0x2f, 0x55,
+	0xd2, 0x92, 0x27, 0xc7, 0x30, 0xbc, 0x4d, 0xbc, 0xe3, 0xf0, 0x36, 0xb1, 0xf3, 0x4a, 0x99, 0xc2,
+	0xca, 0xdc, 0x26, 0xde, 0xa7, 0x97, 0x6d, 0xb6, 0x5f, 0xd1, 0x28, 0x9e, 0xeb, 0x54, 0x2a, 0x13,
+	0x6d, 0xb9, 0xd6, 0x85, 0x10, 0x39, 0x87, 0x5d, 0x9b, 0xfc, 0xb6, 0x88, 0xb6, 0x9d, 0xaf, 0x97,
+	0xd6, 0x79, 0xee, 0x6c, 0xf2, 0x1c, 0xc3, 0xfe, 0xd4, 0x30, 0x65, 0x1e, 0xd8, 0x3c, 0xda, 0x6d,
+	0xf3, 0x76, 0xb2, 0xd3, 0xa1, 0x7a, 0xe4, 0x76, 0xbe, 0x7b, 0xae, 0x41, 0xbd, 0x4c, 0x53, 0x38,
+	0x75, 0xbd, 0x69, 0xcb, 0xe9, 0xfb, 0x1e, 0x30, 0x19, 0xac, 0x33, 0xf1, 0xbe, 0xf7, 0xac, 0x44,
+	0x5f, 0x62, 0x08, 0xd1, 0x04, 0xce, 0xd6, 0x22, 0xfa, 0x61, 0x7d, 0x03, 0xa3, 0x1e, 0xf4, 0x7b,
+	0x76, 0x14, 0x2b, 0xd4, 0x26, 0xf6, 0x70, 0xb6, 0xd4, 0xd3, 0x2f, 0xe0, 0xa5, 0x8d, 0xf2, 0x3b,
+	0x2a, 0xcd, 0x65, 0xc5, 0xab, 0x79, 0x2a, 0x05, 0xcf, 0x17, 0xdd, 0x5a, 0xa4, 0x30, 0x5e, 0x57,
+	0x05, 0x3b, 0x7d, 0x05, 0xfb, 0x0e, 0xe3, 0x7d, 0xa2, 0xf3, 0xd8, 0x5d, 0xe8, 0x8d, 0x70, 0xbd,
+	0x1d, 0x7d, 0x01, 0xcf, 0x5d, 0x42, 0xae, 0x4c, 0xc3, 0xc4, 0xbd, 0x2c, 0x96, 0x3b, 0x78, 0x07,
+	0xc4, 0xaa, 0x7c, 0x91, 0x5d, 0x87, 0xde, 0xc0, 0xe1, 0xd4, 0x30, 0xd3, 0xe8, 0x1b, 0x2e, 0x0c,
+	0x2a, 0xd7, 0xa7, 0xe3, 0x2b, 0x12, 0xdb, 0xcb, 0xec, 0x4d, 0x5b, 0x7d, 0xb6, 0x62, 0x47, 0xa7,
+	0x70, 0xe2, 0xd5, 0x01, 0xe3, 0xcb, 0x60, 0x44, 0x2d, 0xe3, 0xc3, 0x30, 0xd0, 0x72, 0x60, 0x9f,
+	0xb8, 0x79, 0x7f, 0x0f, 0xe0, 0xec, 0x5a, 0x56, 0x46, 0x49, 0xb1, 0x46, 0x73, 0x6d, 0x60, 0x83,
+	0x8d, 0x81, 0xd9, 0xf5, 0xb0, 0xe5, 0x06, 0xf3, 0xec, 0x65, 0xf2, 0x2d, 0xec, 0x5d, 0xcb, 0xb2,
+	0x64, 0x55, 0xe1, 0xd6, 0xf5, 0xf8, 0xea, 0xf3, 0x90, 0x96, 0x57, 0x65, 0x9d, 0x0d, 0x7d, 0x03,
+	0xcf, 0x12, 0xae, 0x73, 0xf9, 0x88, 0xaa, 0x1b, 0x15, 0xa1, 0x70, 0xf8, 0xbe, 0x2a, 0x6a, 0xc9,
+	0x2b, 0xf3, 0xb0, 0xa8, 0x3b, 0x06, 0x2b, 0x18, 0xfd, 0x67, 0x08, 0x27, 0x81, 0xa3, 0x5f, 0x18,
+	0xbb, 0xf5, 0x2c, 0xff, 0xc8, 0xe6, 0x18, 0x38, 0x86, 0x90, 0x8d, 0xed, 0xc5, 0x3b, 0x36, 0x43,
+	0xe1, 0xe9, 0xaf, 0x60, 0xf6, 0xc5, 0xe3, 0xc7, 0xee, 0x4a, 0x18, 0x65, 0x9d, 0x48, 0xbe, 0x04,
+	0x78, 0xd7, 0x70, 0x51, 0x4c, 0x0d, 0x2b, 0x6b, 0x77, 0xe3, 0x76, 0xb2, 0x00, 0xb1, 0x6f, 0x17,
+	0x27, 0x65, 0xf8, 0xc8, 0x9d, 0x7f, 0x7b, 0xef, 0x56, 0x41, 0x92, 0xc0, 0xa8, 0xab, 0x45, 0x47,
+	0xbb, 0x6e, 0x76, 0x5f, 0xb7, 0x6b, 0xbd, 0x51, 0x51, 0xdc, 0x1b, 0xbe, 0xaf, 0x8c, 0x5a, 0x64,
+	0x4b, 0xc7, 0xf1, 0xcf, 0x70, 0xbc, 0xaa, 0x24, 0xcf, 0x60, 0xeb, 0x23, 0x2e, 0x7c, 0xd5, 0xf6,
+	0x68, 0x47, 0xff, 0xc8, 0x44, 0xd3, 0x4d, 0xa9, 0x15, 0x7e, 0x1a, 0xfe, 0x38, 0xa0, 0xdf, 0xc3,
+	0x49, 0xfb, 0xd9, 0xb8, 0x91, 0xaa, 0x7c, 0xf2, 0xe4, 0xe9, 0x09, 0x7c, 0xf6, 0x5b, 0x8d, 0xd5,
+	0xdb, 0x9a, 0x77, 0x0c, 0x67, 0xbb, 0xee, 0x3b, 0xf4, 0xea, 0xbf, 0x01, 0x00, 0xfb, 0x06, 0x64,
+	0x26, 0x3e, 0x07, 0x00, 0x00,
 }
@@ -262,6 +262,217 @@ func (m *BulkMetaResponse) GetPagination() *Pagination {
 	return nil
 }
 
+// Apply the same user metadata change to a set of nodes
+type BulkUpdateUserMetaRequest struct {
+	// Nodes paths, as seen by the current user
+	NodePaths []string `protobuf:"bytes,1,rep,name=NodePaths" json:"NodePaths,omitempty"`
+	// Or a search query, restricted by its PathPrefix
+	Query     *tree.Query `protobuf:"bytes,2,opt,name=Query" json:"Query,omitempty"`
+	Namespace string      `protobuf:"bytes,3,opt,name=Namespace" json:"Namespace,omitempty"`
+	JsonValue string      `protobuf:"bytes,4,opt,name=JsonValue" json:"JsonValue,omitempty"`
+	Delete    bool        `protobuf:"varint,5,opt,name=Delete" json:"Delete,omitempty"`
+	// Return the list of changes without applying them
+	DryRun bool `protobuf:"varint,6,opt,name=DryRun" json:"DryRun,omitempty"`
+}
+
+func (m *BulkUpdateUserMetaRequest) Reset()                    { *m = BulkUpdateUserMetaRequest{} }
+func (m *BulkUpdateUserMetaRequest) String() string            { return proto.CompactTextString(m) }
+func (*BulkUpdateUserMetaRequest) ProtoMessage()               {}
+func (*BulkUpdateUserMetaRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{7} }
+
+func (m *BulkUpdateUserMetaRequest) GetNodePaths() []string {
+	if m != nil {
+		return m.NodePaths
+	}
+	return nil
+}
+
+func (m *BulkUpdateUserMetaRequest) GetQuery() *tree.Query {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+func (m *BulkUpdateUserMetaRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *BulkUpdateUserMetaRequest) GetJsonValue() string {
+	if m != nil {
+		return m.JsonValue
+	}
+	return ""
+}
+
+func (m *BulkUpdateUserMetaRequest) GetDelete() bool {
+	if m != nil {
+		return m.Delete
+	}
+	return false
+}
+
+func (m *BulkUpdateUserMetaRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+// Apply user metadata changes from a CSV of path, namespace and value rows
+type ImportUserMetaRequest struct {
+	CsvContent string `protobuf:"bytes,1,opt,name=CsvContent" json:"CsvContent,omitempty"`
+	DryRun     bool   `protobuf:"varint,2,opt,name=DryRun" json:"DryRun,omitempty"`
+}
+
+func (m *ImportUserMetaRequest) Reset()                    { *m = ImportUserMetaRequest{} }
+func (m *ImportUserMetaRequest) String() string            { return proto.CompactTextString(m) }
+func (*ImportUserMetaRequest) ProtoMessage()               {}
+func (*ImportUserMetaRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{8} }
+
+func (m *ImportUserMetaRequest) GetCsvContent() string {
+	if m != nil {
+		return m.CsvContent
+	}
+	return ""
+}
+
+func (m *ImportUserMetaRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+// Export user metadata of a set of nodes
+type ExportUserMetaRequest struct {
+	NodePaths []string `protobuf:"bytes,1,rep,name=NodePaths" json:"NodePaths,omitempty"`
+	// Limit export to these namespaces
+	Namespaces []string `protobuf:"bytes,2,rep,name=Namespaces" json:"Namespaces,omitempty"`
+	// Export children of folders as well
+	Recursive bool `protobuf:"varint,3,opt,name=Recursive" json:"Recursive,omitempty"`
+	// Use "csv" to download a CSV file
+	Format string `protobuf:"bytes,4,opt,name=Format" json:"Format,omitempty"`
+}
+
+func (m *ExportUserMetaRequest) Reset()                    { *m = ExportUserMetaRequest{} }
+func (m *ExportUserMetaRequest) String() string            { return proto.CompactTextString(m) }
+func (*ExportUserMetaRequest) ProtoMessage()               {}
+func (*ExportUserMetaRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{9} }
+
+func (m *ExportUserMetaRequest) GetNodePaths() []string {
+	if m != nil {
+		return m.NodePaths
+	}
+	return nil
+}
+
+func (m *ExportUserMetaRequest) GetNamespaces() []string {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
+func (m *ExportUserMetaRequest) GetRecursive() bool {
+	if m != nil {
+		return m.Recursive
+	}
+	return false
+}
+
+func (m *ExportUserMetaRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+// Single user metadata change, with its previous value
+type UserMetaBulkChange struct {
+	Path      string `protobuf:"bytes,1,opt,name=Path" json:"Path,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=Namespace" json:"Namespace,omitempty"`
+	Previous  string `protobuf:"bytes,3,opt,name=Previous" json:"Previous,omitempty"`
+	Value     string `protobuf:"bytes,4,opt,name=Value" json:"Value,omitempty"`
+	Error     string `protobuf:"bytes,5,opt,name=Error" json:"Error,omitempty"`
+}
+
+func (m *UserMetaBulkChange) Reset()                    { *m = UserMetaBulkChange{} }
+func (m *UserMetaBulkChange) String() string            { return proto.CompactTextString(m) }
+func (*UserMetaBulkChange) ProtoMessage()               {}
+func (*UserMetaBulkChange) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{10} }
+
+func (m *UserMetaBulkChange) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *UserMetaBulkChange) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *UserMetaBulkChange) GetPrevious() string {
+	if m != nil {
+		return m.Previous
+	}
+	return ""
+}
+
+func (m *UserMetaBulkChange) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *UserMetaBulkChange) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type UserMetaBulkResponse struct {
+	// Background job applying the changes
+	JobUuid string                `protobuf:"bytes,1,opt,name=JobUuid" json:"JobUuid,omitempty"`
+	Total   int32                 `protobuf:"varint,2,opt,name=Total" json:"Total,omitempty"`
+	Changes []*UserMetaBulkChange `protobuf:"bytes,3,rep,name=Changes" json:"Changes,omitempty"`
+}
+
+func (m *UserMetaBulkResponse) Reset()                    { *m = UserMetaBulkResponse{} }
+func (m *UserMetaBulkResponse) String() string            { return proto.CompactTextString(m) }
+func (*UserMetaBulkResponse) ProtoMessage()               {}
+func (*UserMetaBulkResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{11} }
+
+func (m *UserMetaBulkResponse) GetJobUuid() string {
+	if m != nil {
+		return m.JobUuid
+	}
+	return ""
+}
+
+func (m *UserMetaBulkResponse) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *UserMetaBulkResponse) GetChanges() []*UserMetaBulkChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
 type HeadNodeRequest struct {
 	Node string `protobuf:"bytes,1,opt,name=Node" json:"Node,omitempty"`
 }
@@ -269,7 +480,7 @@ type HeadNodeRequest struct {
 func (m *HeadNodeRequest) Reset()                    { *m = HeadNodeRequest{} }
 func (m *HeadNodeRequest) String() string            { return proto.CompactTextString(m) }
 func (*HeadNodeRequest) ProtoMessage()               {}
-func (*HeadNodeRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{7} }
+func (*HeadNodeRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{12} }
 
 func (m *HeadNodeRequest) GetNode() string {
 	if m != nil {
@@ -285,7 +496,7 @@ type HeadNodeResponse struct {
 func (m *HeadNodeResponse) Reset()                    { *m = HeadNodeResponse{} }
 func (m *HeadNodeResponse) String() string            { return proto.CompactTextString(m) }
 func (*HeadNodeResponse) ProtoMessage()               {}
-func (*HeadNodeResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{8} }
+func (*HeadNodeResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{13} }
 
 func (m *HeadNodeResponse) GetNode() *tree.Node {
 	if m != nil {
@@ -303,7 +514,7 @@ type CreateNodesRequest struct {
 func (m *CreateNodesRequest) Reset()                    { *m = CreateNodesRequest{} }
 func (m *CreateNodesRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateNodesRequest) ProtoMessage()               {}
-func (*CreateNodesRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{9} }
+func (*CreateNodesRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{14} }
 
 func (m *CreateNodesRequest) GetNodes() []*tree.Node {
 	if m != nil {
@@ -335,7 +546,7 @@ type CreateSelectionRequest struct {
 func (m *CreateSelectionRequest) Reset()                    { *m = CreateSelectionRequest{} }
 func (m *CreateSelectionRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateSelectionRequest) ProtoMessage()               {}
-func (*CreateSelectionRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{10} }
+func (*CreateSelectionRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{15} }
 
 func (m *CreateSelectionRequest) GetNodes() []*tree.Node {
 	if m != nil {
@@ -366,7 +577,7 @@ type CreateSelectionResponse struct {
 func (m *CreateSelectionResponse) Reset()                    { *m = CreateSelectionResponse{} }
 func (m *CreateSelectionResponse) String() string            { return proto.CompactTextString(m) }
 func (*CreateSelectionResponse) ProtoMessage()               {}
-func (*CreateSelectionResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{11} }
+func (*CreateSelectionResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{16} }
 
 func (m *CreateSelectionResponse) GetSelectionUUID() string {
 	if m != nil {
@@ -390,7 +601,7 @@ type NodesCollection struct {
 func (m *NodesCollection) Reset()                    { *m = NodesCollection{} }
 func (m *NodesCollection) String() string            { return proto.CompactTextString(m) }
 func (*NodesCollection) ProtoMessage()               {}
-func (*NodesCollection) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{12} }
+func (*NodesCollection) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{17} }
 
 func (m *NodesCollection) GetParent() *tree.Node {
 	if m != nil {
@@ -414,7 +625,7 @@ type DeleteNodesRequest struct {
 func (m *DeleteNodesRequest) Reset()                    { *m = DeleteNodesRequest{} }
 func (m *DeleteNodesRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteNodesRequest) ProtoMessage()               {}
-func (*DeleteNodesRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{13} }
+func (*DeleteNodesRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{18} }
 
 func (m *DeleteNodesRequest) GetNodes() []*tree.Node {
 	if m != nil {
@@ -439,7 +650,7 @@ type BackgroundJobResult struct {
 func (m *BackgroundJobResult) Reset()                    { *m = BackgroundJobResult{} }
 func (m *BackgroundJobResult) String() string            { return proto.CompactTextString(m) }
 func (*BackgroundJobResult) ProtoMessage()               {}
-func (*BackgroundJobResult) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{14} }
+func (*BackgroundJobResult) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{19} }
 
 func (m *BackgroundJobResult) GetUuid() string {
 	if m != nil {
@@ -469,7 +680,7 @@ type DeleteNodesResponse struct {
 func (m *DeleteNodesResponse) Reset()                    { *m = DeleteNodesResponse{} }
 func (m *DeleteNodesResponse) String() string            { return proto.CompactTextString(m) }
 func (*DeleteNodesResponse) ProtoMessage()               {}
-func (*DeleteNodesResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{15} }
+func (*DeleteNodesResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{20} }
 
 func (m *DeleteNodesResponse) GetDeleteJobs() []*BackgroundJobResult {
 	if m != nil {
@@ -485,7 +696,7 @@ type RestoreNodesRequest struct {
 func (m *RestoreNodesRequest) Reset()                    { *m = RestoreNodesRequest{} }
 func (m *RestoreNodesRequest) String() string            { return proto.CompactTextString(m) }
 func (*RestoreNodesRequest) ProtoMessage()               {}
-func (*RestoreNodesRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{16} }
+func (*RestoreNodesRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{21} }
 
 func (m *RestoreNodesRequest) GetNodes() []*tree.Node {
 	if m != nil {
@@ -501,7 +712,7 @@ type RestoreNodesResponse struct {
 func (m *RestoreNodesResponse) Reset()                    { *m = RestoreNodesResponse{} }
 func (m *RestoreNodesResponse) String() string            { return proto.CompactTextString(m) }
 func (*RestoreNodesResponse) ProtoMessage()               {}
-func (*RestoreNodesResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{17} }
+func (*RestoreNodesResponse) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{22} }
 
 func (m *RestoreNodesResponse) GetRestoreJobs() []*BackgroundJobResult {
 	if m != nil {
@@ -519,7 +730,7 @@ type ListDocstoreRequest struct {
 func (m *ListDocstoreRequest) Reset()                    { *m = ListDocstoreRequest{} }
 func (m *ListDocstoreRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListDocstoreRequest) ProtoMessage()               {}
-func (*ListDocstoreRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{18} }
+func (*ListDocstoreRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{23} }
 
 func (m *ListDocstoreRequest) GetStoreID() string {
 	if m != nil {
@@ -550,7 +761,7 @@ type DocstoreCollection struct {
 func (m *DocstoreCollection) Reset()                    { *m = DocstoreCollection{} }
 func (m *DocstoreCollection) String() string            { return proto.CompactTextString(m) }
 func (*DocstoreCollection) ProtoMessage()               {}
-func (*DocstoreCollection) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{19} }
+func (*DocstoreCollection) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{24} }
 
 func (m *DocstoreCollection) GetDocs() []*docstore.Document {
 	if m != nil {
@@ -576,7 +787,7 @@ type ChangeRequest struct {
 func (m *ChangeRequest) Reset()                    { *m = ChangeRequest{} }
 func (m *ChangeRequest) String() string            { return proto.CompactTextString(m) }
 func (*ChangeRequest) ProtoMessage()               {}
-func (*ChangeRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{20} }
+func (*ChangeRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{25} }
 
 func (m *ChangeRequest) GetSeqID() int64 {
 	if m != nil {
@@ -614,7 +825,7 @@ type ChangeCollection struct {
 func (m *ChangeCollection) Reset()                    { *m = ChangeCollection{} }
 func (m *ChangeCollection) String() string            { return proto.CompactTextString(m) }
 func (*ChangeCollection) ProtoMessage()               {}
-func (*ChangeCollection) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{21} }
+func (*ChangeCollection) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{26} }
 
 func (m *ChangeCollection) GetChanges() []*tree.SyncChange {
 	if m != nil {
@@ -638,6 +849,11 @@ func init() {
 	proto.RegisterType((*MetaNamespaceRequest)(nil), "rest.MetaNamespaceRequest")
 	proto.RegisterType((*GetBulkMetaRequest)(nil), "rest.GetBulkMetaRequest")
 	proto.RegisterType((*BulkMetaResponse)(nil), "rest.BulkMetaResponse")
+	proto.RegisterType((*BulkUpdateUserMetaRequest)(nil), "rest.BulkUpdateUserMetaRequest")
+	proto.RegisterType((*ImportUserMetaRequest)(nil), "rest.ImportUserMetaRequest")
+	proto.RegisterType((*ExportUserMetaRequest)(nil), "rest.ExportUserMetaRequest")
+	proto.RegisterType((*UserMetaBulkChange)(nil), "rest.UserMetaBulkChange")
+	proto.RegisterType((*UserMetaBulkResponse)(nil), "rest.UserMetaBulkResponse")
 	proto.RegisterType((*HeadNodeRequest)(nil), "rest.HeadNodeRequest")
 	proto.RegisterType((*HeadNodeResponse)(nil), "rest.HeadNodeResponse")
 	proto.RegisterType((*CreateNodesRequest)(nil), "rest.CreateNodesRequest")
@@ -658,63 +874,76 @@ func init() {
 func init() { proto.RegisterFile("data.proto", fileDescriptor3) }
 
 var fileDescriptor3 = []byte{
-	// 927 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x5b, 0x6f, 0x1b, 0x45,
-	0x14, 0x96, 0xe3, 0xf8, 0x76, 0x4c, 0x5a, 0x6b, 0x12, 0xb5, 0x26, 0x54, 0x55, 0x34, 0x0a, 0x55,
-	0x55, 0x81, 0x8d, 0xd2, 0x07, 0x84, 0xfa, 0xd4, 0xda, 0x12, 0x10, 0x42, 0x6a, 0xc6, 0x09, 0x0f,
-	0x54, 0x08, 0x8d, 0x77, 0x8f, 0xed, 0x55, 0xd7, 0x3b, 0xce, 0xcc, 0x6c, 0x94, 0x48, 0xfc, 0x3e,
-	0x7e, 0x07, 0x3f, 0x05, 0xcd, 0x6d, 0x2f, 0x4a, 0x80, 0x20, 0xf1, 0x92, 0xec, 0xf9, 0xce, 0xed,
-	0x3b, 0x97, 0x99, 0x31, 0x40, 0xcc, 0x35, 0x1f, 0x6d, 0xa5, 0xd0, 0x82, 0xec, 0x4a, 0x54, 0xfa,
-	0xf0, 0xf5, 0x2a, 0xd1, 0xeb, 0x7c, 0x31, 0x8a, 0xc4, 0x66, 0xbc, 0xbd, 0x8d, 0x13, 0x31, 0x8e,
-	0x30, 0x4d, 0xd5, 0x38, 0x12, 0x9b, 0x8d, 0xc8, 0xc6, 0xd6, 0x74, 0xac, 0x25, 0xa2, 0xfd, 0xe3,
-	0x5c, 0x0f, 0xdf, 0x3c, 0xc4, 0x29, 0x16, 0x91, 0xd2, 0x42, 0x62, 0xf1, 0xe1, 0x9c, 0xe9, 0x0f,
-	0xb0, 0x37, 0x47, 0x2e, 0xa3, 0x35, 0x43, 0x95, 0xa7, 0x5a, 0x91, 0x63, 0xe8, 0xf8, 0xcf, 0x61,
-	0xe3, 0xa8, 0xf9, 0xb2, 0x7f, 0x02, 0x23, 0x9b, 0xeb, 0x5c, 0xc4, 0xc8, 0x82, 0x8a, 0x1c, 0x40,
-	0xeb, 0x42, 0x68, 0x9e, 0x0e, 0x77, 0x8e, 0x1a, 0x2f, 0x5b, 0xcc, 0x09, 0xf4, 0xcf, 0x06, 0xc0,
-	0x8c, 0xaf, 0x92, 0x8c, 0xeb, 0x44, 0x64, 0xc6, 0xe8, 0x2c, 0xd9, 0x24, 0x7a, 0xd8, 0x70, 0x46,
-	0x56, 0x20, 0xc7, 0xb0, 0x37, 0xc9, 0xa5, 0xc4, 0x4c, 0xbf, 0x5f, 0x2e, 0x15, 0x6a, 0x1f, 0xa2,
-	0x0e, 0x96, 0x09, 0x9a, 0x95, 0x04, 0xe4, 0x08, 0xfa, 0xde, 0x6c, 0xc6, 0x57, 0x38, 0xdc, 0xb5,
-	0xba, 0x2a, 0x44, 0x9e, 0x03, 0x58, 0x53, 0x23, 0xa8, 0x61, 0xcb, 0x1a, 0x54, 0x10, 0xa3, 0x3f,
-	0xc7, 0x9b, 0x90, 0xba, 0xed, 0xf4, 0x25, 0x62, 0xf4, 0x33, 0x89, 0xd7, 0x5e, 0xdf, 0x71, 0xfa,
-	0x12, 0xa1, 0x53, 0xe8, 0xfe, 0x88, 0x9a, 0x9b, 0xc9, 0x91, 0x67, 0xd0, 0x3b, 0xe7, 0x1b, 0x54,
-	0x5b, 0x1e, 0xa1, 0xad, 0xb1, 0xc7, 0x4a, 0x80, 0x1c, 0x42, 0xf7, 0x54, 0x89, 0xcc, 0x58, 0xdb,
-	0x12, 0x7b, 0xac, 0x90, 0xe9, 0x2f, 0xf0, 0xc8, 0xfc, 0x9f, 0x88, 0x34, 0xc5, 0xc8, 0xf6, 0xea,
-	0x10, 0xba, 0xa6, 0xc3, 0x33, 0xae, 0xd7, 0x3e, 0x54, 0x21, 0x93, 0x2f, 0xa0, 0x17, 0x72, 0xaa,
-	0xe1, 0x8e, 0x1d, 0xca, 0xa3, 0x91, 0xd9, 0x97, 0x51, 0x80, 0x59, 0x69, 0x40, 0x67, 0x70, 0x60,
-	0x84, 0x82, 0x08, 0xc3, 0xab, 0x1c, 0x95, 0xfe, 0xc7, 0x0c, 0xb5, 0x4a, 0x4c, 0x86, 0x6a, 0x25,
-	0xf4, 0x8f, 0x06, 0x90, 0x6f, 0x51, 0xbf, 0xcb, 0xd3, 0x8f, 0x26, 0x72, 0x08, 0x68, 0x9c, 0x7c,
-	0x00, 0xb7, 0x2b, 0xc6, 0x29, 0x00, 0x41, 0x7b, 0x99, 0x27, 0xb1, 0x2a, 0x42, 0x06, 0x80, 0xbc,
-	0x82, 0xc1, 0xdb, 0x34, 0x35, 0xd1, 0x66, 0x52, 0x5c, 0x27, 0x31, 0x4a, 0x65, 0x27, 0xdd, 0x65,
-	0x77, 0x70, 0x43, 0xfc, 0x67, 0x94, 0x2a, 0x11, 0x99, 0xb2, 0x13, 0xef, 0xb2, 0x42, 0x26, 0x4f,
-	0xa0, 0xed, 0x47, 0xe5, 0x46, 0xdd, 0x2e, 0xd7, 0xc7, 0xad, 0x5e, 0xbb, 0xb2, 0x7a, 0x74, 0x09,
-	0x83, 0xb2, 0x08, 0xb5, 0x15, 0x99, 0x42, 0x72, 0x04, 0x2d, 0x43, 0xeb, 0xbe, 0x6d, 0x77, 0x0a,
-	0xf2, 0x55, 0x75, 0xa9, 0x6d, 0x9e, 0xfe, 0xc9, 0xc0, 0xf5, 0xbf, 0xc4, 0x59, 0xc5, 0x86, 0x7e,
-	0x0e, 0x8f, 0xbf, 0x43, 0x1e, 0xdb, 0x20, 0xbe, 0x59, 0x04, 0x76, 0x8d, 0xe8, 0x3b, 0x6f, 0xbf,
-	0xe9, 0x09, 0x0c, 0x4a, 0x33, 0x4f, 0xe7, 0x79, 0xc5, 0xae, 0xce, 0xc6, 0xf9, 0xdc, 0x00, 0x99,
-	0x48, 0xe4, 0x1a, 0x2d, 0xb7, 0x10, 0xfd, 0xdf, 0x8b, 0x78, 0x06, 0x3d, 0x86, 0x51, 0x2e, 0x55,
-	0x72, 0x8d, 0x76, 0x1d, 0xbb, 0xac, 0x04, 0x08, 0x85, 0x4f, 0x2e, 0x70, 0xb3, 0x4d, 0xb9, 0xc6,
-	0xcb, 0xcb, 0xef, 0xa7, 0x76, 0x14, 0x3d, 0x56, 0xc3, 0xe8, 0x0d, 0x3c, 0x71, 0x99, 0xe7, 0xe8,
-	0x97, 0xf6, 0xe1, 0xd9, 0x4d, 0x7c, 0x2e, 0x57, 0xa8, 0xdf, 0x5a, 0x47, 0x7f, 0x1e, 0x6a, 0x18,
-	0x19, 0x42, 0x67, 0x66, 0xc6, 0xaa, 0xb4, 0xdf, 0x84, 0x20, 0x52, 0x0e, 0x4f, 0xef, 0x64, 0xf6,
-	0xed, 0x3a, 0x36, 0xd7, 0x97, 0x07, 0x2d, 0x73, 0xd7, 0xdf, 0x3a, 0x58, 0x12, 0xdc, 0xf9, 0x1b,
-	0x82, 0xf4, 0x57, 0x78, 0x6c, 0x3f, 0x2a, 0x27, 0x92, 0x42, 0x7b, 0xc6, 0xcd, 0xbd, 0x72, 0xcf,
-	0x2c, 0xbc, 0x86, 0xbc, 0x80, 0xee, 0x64, 0x9d, 0xa4, 0xb1, 0xc4, 0xec, 0x9e, 0xd8, 0x85, 0x8e,
-	0x5e, 0x00, 0x99, 0x62, 0x8a, 0xff, 0xef, 0xd4, 0xe8, 0x07, 0xd8, 0x7f, 0xc7, 0xa3, 0x8f, 0x2b,
-	0x29, 0xf2, 0x2c, 0x3e, 0x15, 0x0b, 0x77, 0x39, 0x9b, 0x55, 0x33, 0x87, 0x2c, 0xac, 0x9a, 0xf9,
-	0xb6, 0xe7, 0x81, 0x2f, 0x30, 0xf5, 0x9d, 0x77, 0x42, 0xb8, 0x12, 0xac, 0x75, 0xb3, 0xbc, 0x12,
-	0x8c, 0x4c, 0x67, 0xb0, 0x5f, 0xa3, 0xec, 0x1b, 0xfe, 0x0d, 0x80, 0x83, 0x4f, 0xc5, 0x22, 0x10,
-	0xff, 0xd4, 0x1d, 0x86, 0x7b, 0xb8, 0xb0, 0x8a, 0x31, 0xfd, 0x1a, 0xf6, 0x19, 0xda, 0xb7, 0xe7,
-	0xbf, 0x75, 0x81, 0xce, 0xe1, 0xa0, 0xee, 0xe8, 0xb9, 0xbc, 0x81, 0xbe, 0xc7, 0x1f, 0x46, 0xa6,
-	0x6a, 0x4d, 0x7f, 0x87, 0xfd, 0xb3, 0x44, 0xe9, 0xa9, 0x7f, 0x0e, 0x03, 0x9b, 0x21, 0x74, 0xe6,
-	0x46, 0x2e, 0x56, 0x29, 0x88, 0xe4, 0x4b, 0x68, 0xfd, 0x94, 0xa3, 0xbc, 0xb5, 0x2d, 0xec, 0x9f,
-	0x3c, 0x1d, 0x15, 0x2f, 0xe9, 0x54, 0x44, 0xf9, 0x06, 0x33, 0x6d, 0xd5, 0xcc, 0x59, 0x99, 0xd1,
-	0x4d, 0x44, 0x9e, 0xe9, 0xf7, 0x59, 0x7a, 0xeb, 0x17, 0xba, 0x04, 0x28, 0x03, 0x12, 0x32, 0x57,
-	0x56, 0xee, 0x05, 0xec, 0x1a, 0xd4, 0x57, 0x42, 0xee, 0x66, 0x60, 0x56, 0x5f, 0x7f, 0x7d, 0x9b,
-	0xe1, 0xf5, 0x15, 0xb0, 0x37, 0x59, 0xf3, 0x6c, 0x55, 0xd4, 0x72, 0x00, 0xad, 0x39, 0x5e, 0xf9,
-	0x4a, 0x9a, 0xcc, 0x09, 0xe6, 0xca, 0x5c, 0x26, 0xa9, 0x46, 0xe9, 0x77, 0xc1, 0x4b, 0xa6, 0xf2,
-	0x65, 0xca, 0xb5, 0xc6, 0x2c, 0x9c, 0x3f, 0x2f, 0x1a, 0x0f, 0xa5, 0x25, 0xf2, 0x8d, 0xbf, 0x7e,
-	0xbd, 0x44, 0x3f, 0xc0, 0xc0, 0x25, 0xac, 0x94, 0xf0, 0x0a, 0x3a, 0x0e, 0x0b, 0x55, 0x0c, 0xdc,
-	0x3c, 0xe7, 0xb7, 0x59, 0xe4, 0xd9, 0x75, 0x22, 0x67, 0x40, 0x3e, 0x83, 0xde, 0x19, 0x57, 0xda,
-	0xd0, 0x8a, 0x7d, 0x29, 0xdd, 0x94, 0x2b, 0xfd, 0x9b, 0xc2, 0xab, 0x45, 0xdb, 0xfe, 0x3e, 0x79,
-	0xfd, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x20, 0x02, 0x42, 0x78, 0x25, 0x09, 0x00, 0x00,
+	// 1132 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdb, 0x6e, 0x1b, 0x45,
+	0x18, 0x96, 0xe3, 0x43, 0xec, 0xdf, 0xa4, 0xb5, 0x26, 0x69, 0xeb, 0x86, 0xaa, 0x0a, 0xa3, 0x50,
+	0x55, 0x15, 0x38, 0x28, 0xbd, 0x40, 0xa8, 0x57, 0xad, 0x53, 0xa0, 0x21, 0x24, 0x66, 0x9c, 0xf4,
+	0x82, 0x0a, 0xa1, 0xf1, 0xfa, 0x8f, 0xb3, 0xea, 0x7a, 0xc7, 0x99, 0x99, 0x8d, 0x12, 0xc4, 0x1b,
+	0x20, 0xf1, 0x58, 0x88, 0xc7, 0xe0, 0x51, 0xd0, 0x9c, 0xf6, 0x90, 0x98, 0x12, 0x24, 0x6e, 0x12,
+	0x7f, 0xdf, 0xfc, 0xf3, 0x1f, 0xbf, 0x99, 0x1d, 0x80, 0x29, 0xd7, 0x7c, 0xb0, 0x90, 0x42, 0x0b,
+	0xd2, 0x90, 0xa8, 0xf4, 0xe6, 0xf3, 0x59, 0xac, 0xcf, 0xb2, 0xc9, 0x20, 0x12, 0xf3, 0x9d, 0xc5,
+	0xd5, 0x34, 0x16, 0x3b, 0x11, 0x26, 0x89, 0xda, 0x89, 0xc4, 0x7c, 0x2e, 0xd2, 0x1d, 0x6b, 0xba,
+	0xa3, 0x25, 0xa2, 0xfd, 0xe3, 0xb6, 0x6e, 0xbe, 0xb8, 0xcd, 0xa6, 0xa9, 0x88, 0x94, 0x16, 0x12,
+	0xf3, 0x1f, 0x6e, 0x33, 0xfd, 0x0e, 0xd6, 0xc6, 0xc8, 0x65, 0x74, 0xc6, 0x50, 0x65, 0x89, 0x56,
+	0x64, 0x1b, 0x56, 0xfd, 0xcf, 0x7e, 0x6d, 0xab, 0xfe, 0xb4, 0xbb, 0x0b, 0x03, 0x1b, 0xeb, 0x50,
+	0x4c, 0x91, 0x85, 0x25, 0xb2, 0x01, 0xcd, 0x63, 0xa1, 0x79, 0xd2, 0x5f, 0xd9, 0xaa, 0x3d, 0x6d,
+	0x32, 0x07, 0xe8, 0x5f, 0x35, 0x80, 0x11, 0x9f, 0xc5, 0x29, 0xd7, 0xb1, 0x48, 0x8d, 0xd1, 0x41,
+	0x3c, 0x8f, 0x75, 0xbf, 0xe6, 0x8c, 0x2c, 0x20, 0xdb, 0xb0, 0x36, 0xcc, 0xa4, 0xc4, 0x54, 0x1f,
+	0x9d, 0x9e, 0x2a, 0xd4, 0xde, 0x45, 0x95, 0x2c, 0x02, 0xd4, 0x4b, 0x01, 0xc8, 0x16, 0x74, 0xbd,
+	0xd9, 0x88, 0xcf, 0xb0, 0xdf, 0xb0, 0x6b, 0x65, 0x8a, 0x3c, 0x06, 0xb0, 0xa6, 0x06, 0xa8, 0x7e,
+	0xd3, 0x1a, 0x94, 0x18, 0xb3, 0x7e, 0x88, 0x97, 0x21, 0x74, 0xcb, 0xad, 0x17, 0x8c, 0x59, 0x1f,
+	0x49, 0xbc, 0xf0, 0xeb, 0xab, 0x6e, 0xbd, 0x60, 0xe8, 0x1e, 0xb4, 0xbf, 0x47, 0xcd, 0xcd, 0xe4,
+	0xc8, 0x23, 0xe8, 0x1c, 0xf2, 0x39, 0xaa, 0x05, 0x8f, 0xd0, 0xd6, 0xd8, 0x61, 0x05, 0x41, 0x36,
+	0xa1, 0xbd, 0xaf, 0x44, 0x6a, 0xac, 0x6d, 0x89, 0x1d, 0x96, 0x63, 0xfa, 0x23, 0xdc, 0x31, 0xff,
+	0x87, 0x22, 0x49, 0x30, 0xb2, 0xbd, 0xda, 0x84, 0xb6, 0xe9, 0xf0, 0x88, 0xeb, 0x33, 0xef, 0x2a,
+	0xc7, 0xe4, 0x33, 0xe8, 0x84, 0x98, 0xaa, 0xbf, 0x62, 0x87, 0x72, 0x67, 0x60, 0xf4, 0x32, 0x08,
+	0x34, 0x2b, 0x0c, 0xe8, 0x08, 0x36, 0x0c, 0xc8, 0x13, 0x61, 0x78, 0x9e, 0xa1, 0xd2, 0x1f, 0x8c,
+	0x50, 0xa9, 0xc4, 0x44, 0x28, 0x57, 0x42, 0xff, 0xa8, 0x01, 0xf9, 0x06, 0xf5, 0xab, 0x2c, 0x79,
+	0x6f, 0x3c, 0x07, 0x87, 0x66, 0x93, 0x77, 0xe0, 0xb4, 0xd2, 0x61, 0x05, 0x11, 0x56, 0x4f, 0xb2,
+	0x78, 0xaa, 0x72, 0x97, 0x81, 0x20, 0xcf, 0xa0, 0xf7, 0x32, 0x49, 0x8c, 0xb7, 0x91, 0x14, 0x17,
+	0xf1, 0x14, 0xa5, 0xb2, 0x93, 0x6e, 0xb3, 0x1b, 0xbc, 0x49, 0xfc, 0x2d, 0x4a, 0x15, 0x8b, 0x54,
+	0xd9, 0x89, 0xb7, 0x59, 0x8e, 0xc9, 0x7d, 0x68, 0xf9, 0x51, 0xb9, 0x51, 0xb7, 0x0a, 0xf9, 0x38,
+	0xe9, 0xb5, 0x4a, 0xd2, 0xa3, 0xa7, 0xd0, 0x2b, 0x8a, 0x50, 0x0b, 0x91, 0x2a, 0x24, 0x5b, 0xd0,
+	0x34, 0x69, 0x2d, 0x53, 0xbb, 0x5b, 0x20, 0x5f, 0x94, 0x45, 0x6d, 0xe3, 0x74, 0x77, 0x7b, 0xae,
+	0xff, 0x05, 0xcf, 0x4a, 0x36, 0xf4, 0xcf, 0x1a, 0x3c, 0x34, 0x81, 0x4e, 0x16, 0x53, 0xae, 0xf1,
+	0x44, 0xa1, 0xbc, 0x7d, 0xdf, 0x3e, 0x81, 0xe6, 0x0f, 0x19, 0xca, 0x2b, 0xab, 0x99, 0xee, 0x6e,
+	0xd7, 0xe5, 0x63, 0x29, 0xe6, 0x56, 0xaa, 0xd3, 0xaa, 0x5f, 0xd7, 0xdd, 0x23, 0xe8, 0x18, 0x9d,
+	0xbd, 0xe5, 0x49, 0xe6, 0x4e, 0x48, 0x87, 0x15, 0x84, 0x69, 0xd8, 0x1e, 0x26, 0xa8, 0xd1, 0x16,
+	0xd2, 0x66, 0x1e, 0x59, 0x5e, 0x5e, 0xb1, 0x2c, 0xed, 0xb7, 0x3c, 0x6f, 0x11, 0x3d, 0x82, 0x7b,
+	0x6f, 0xe6, 0x0b, 0x21, 0xf5, 0xf5, 0x2a, 0x1e, 0x03, 0x0c, 0xd5, 0xc5, 0x50, 0xa4, 0x1a, 0x53,
+	0xed, 0x05, 0x55, 0x62, 0x4a, 0x0e, 0x57, 0x2a, 0x0e, 0x7f, 0xab, 0xc1, 0xbd, 0xd7, 0x97, 0xcb,
+	0x3c, 0x7e, 0xb8, 0x2f, 0xe6, 0xe0, 0x86, 0x1a, 0x83, 0xa0, 0x4a, 0x8c, 0xd9, 0xcd, 0x30, 0xca,
+	0xa4, 0x8a, 0x2f, 0xd0, 0x4b, 0xa9, 0x20, 0x4c, 0x36, 0x5f, 0x0b, 0x39, 0xe7, 0xda, 0x77, 0xc4,
+	0x23, 0xfa, 0x7b, 0x0d, 0x48, 0xc8, 0xc3, 0x4c, 0x6c, 0x78, 0xc6, 0xd3, 0x19, 0x12, 0x02, 0x8d,
+	0xd2, 0x39, 0x69, 0x2c, 0x3b, 0x23, 0x37, 0x4f, 0xbb, 0xb9, 0x25, 0x62, 0x91, 0x29, 0x3f, 0x92,
+	0x1c, 0x1b, 0x31, 0x96, 0xa7, 0xe1, 0x80, 0x61, 0x5f, 0x4b, 0x29, 0xa4, 0x1d, 0x44, 0x87, 0x39,
+	0x40, 0x7f, 0x81, 0x8d, 0x72, 0x3e, 0xb9, 0x4c, 0xfb, 0xb0, 0xba, 0x2f, 0x26, 0xe6, 0xf0, 0xf8,
+	0xa4, 0x02, 0x5c, 0x7e, 0x15, 0x93, 0x5d, 0x58, 0x75, 0xb5, 0x98, 0x74, 0x8c, 0xb0, 0xfb, 0x4e,
+	0xb1, 0x37, 0x8b, 0x65, 0xc1, 0x90, 0x7e, 0x0a, 0x77, 0xbf, 0x45, 0x3e, 0xb5, 0xda, 0xf7, 0x33,
+	0x21, 0xd0, 0x30, 0x30, 0x34, 0xc2, 0xfc, 0xa6, 0xbb, 0xd0, 0x2b, 0xcc, 0x7c, 0x7a, 0x8f, 0x4b,
+	0x76, 0xd5, 0x43, 0xe4, 0xf6, 0x5c, 0x02, 0x19, 0x4a, 0xe4, 0x1a, 0x0d, 0x52, 0xc1, 0xfb, 0xbf,
+	0x9f, 0xbd, 0xca, 0x54, 0x57, 0xae, 0x4f, 0x95, 0xc2, 0x47, 0xc7, 0x38, 0x5f, 0x24, 0xe6, 0x90,
+	0x9d, 0xbc, 0xd9, 0xf3, 0x8d, 0xaf, 0x70, 0xf4, 0x12, 0xee, 0xbb, 0xc8, 0x63, 0xf4, 0x77, 0xed,
+	0xed, 0xa3, 0x1b, 0xff, 0x5c, 0xce, 0x50, 0xbf, 0xb4, 0x1b, 0xfd, 0xd4, 0x2b, 0x9c, 0x19, 0xcc,
+	0xc8, 0xdc, 0x46, 0x4a, 0x7b, 0xd5, 0x05, 0x48, 0x39, 0x3c, 0xb8, 0x11, 0xd9, 0xb7, 0x6b, 0x1b,
+	0xd6, 0x72, 0xd2, 0x66, 0xee, 0xfa, 0x5b, 0x25, 0x8b, 0x04, 0x57, 0xfe, 0x21, 0x41, 0xfa, 0x13,
+	0xdc, 0xb5, 0x3f, 0x4a, 0x1f, 0x12, 0x0a, 0xad, 0x11, 0x97, 0xe1, 0x4c, 0x56, 0x77, 0xf9, 0x15,
+	0xf2, 0x04, 0xda, 0xc3, 0xb3, 0x38, 0x99, 0x4a, 0x4c, 0x97, 0xf8, 0xce, 0xd7, 0xe8, 0x31, 0x10,
+	0x77, 0x3d, 0xfc, 0x9f, 0x53, 0xa3, 0xef, 0x60, 0xfd, 0x15, 0x8f, 0xde, 0xcf, 0xa4, 0xc8, 0xd2,
+	0xe9, 0xbe, 0x98, 0xb8, 0x37, 0x85, 0x91, 0x5a, 0x49, 0xde, 0x8d, 0xa0, 0xed, 0x03, 0x3e, 0xc1,
+	0xc4, 0x77, 0xde, 0x81, 0xf0, 0x25, 0xb3, 0xd6, 0xf5, 0xe2, 0x4b, 0x66, 0x30, 0x1d, 0xc1, 0x7a,
+	0x25, 0x65, 0xdf, 0xf0, 0xaf, 0x00, 0x1c, 0xbd, 0x2f, 0x26, 0x21, 0xf1, 0x87, 0xee, 0x44, 0x2c,
+	0xc9, 0x85, 0x95, 0x8c, 0xe9, 0x97, 0xb0, 0xce, 0xd0, 0x3e, 0x99, 0xfe, 0x5b, 0x17, 0xe8, 0x18,
+	0x36, 0xaa, 0x1b, 0x7d, 0x2e, 0x2f, 0xa0, 0xeb, 0xf9, 0xdb, 0x25, 0x53, 0xb6, 0xa6, 0xbf, 0xc2,
+	0xfa, 0x41, 0xac, 0xf4, 0x9e, 0x7f, 0xc5, 0x85, 0x6c, 0xfa, 0xb0, 0x3a, 0x36, 0x38, 0x97, 0x52,
+	0x80, 0xe4, 0xf3, 0xea, 0xf7, 0xe4, 0xc1, 0x20, 0x7f, 0x00, 0xee, 0x89, 0x28, 0x9b, 0x63, 0xaa,
+	0xaf, 0x7f, 0x5b, 0x86, 0x22, 0x4b, 0xf5, 0x51, 0x9a, 0x5c, 0x85, 0x6b, 0x34, 0x27, 0x28, 0x03,
+	0x12, 0x22, 0x97, 0x24, 0xf7, 0x04, 0x1a, 0x86, 0xf5, 0x95, 0x90, 0x9b, 0x11, 0x98, 0x5d, 0xaf,
+	0xde, 0x54, 0xf5, 0xf0, 0x68, 0x14, 0xb0, 0xe6, 0x2f, 0x22, 0x5f, 0xcb, 0x06, 0x34, 0xc7, 0x78,
+	0xee, 0x2b, 0xa9, 0x33, 0x07, 0xcc, 0x0d, 0x7e, 0x1a, 0x27, 0x1a, 0xa5, 0xd7, 0x82, 0x47, 0xa6,
+	0xf2, 0xd3, 0x84, 0x6b, 0x8d, 0x69, 0x38, 0x7f, 0x1e, 0x9a, 0x1d, 0x4a, 0x4b, 0xe4, 0x73, 0xff,
+	0x6a, 0xf0, 0x88, 0xbe, 0x83, 0x9e, 0x0b, 0x58, 0x2a, 0xe1, 0x59, 0x71, 0x5d, 0xba, 0x2a, 0x7a,
+	0x6e, 0x9e, 0xe3, 0xab, 0x34, 0x0a, 0xd7, 0x64, 0xe4, 0x0c, 0xc8, 0xc7, 0xd0, 0x39, 0xe0, 0x4a,
+	0x9b, 0xb4, 0xa6, 0xbe, 0x94, 0x76, 0xc2, 0x95, 0xfe, 0x59, 0xe1, 0xf9, 0xa4, 0x65, 0x9f, 0xd5,
+	0xcf, 0xff, 0x1e, 0x00, 0x49, 0xa0, 0x55, 0xb1, 0xdc, 0x0b, 0x00, 0x00,
 }
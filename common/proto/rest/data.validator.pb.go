@@ -62,6 +62,33 @@ func (this *BulkMetaResponse) Validate() error {
 	}
 	return nil
 }
+func (this *BulkUpdateUserMetaRequest) Validate() error {
+	if this.Query != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Query); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Query", err)
+		}
+	}
+	return nil
+}
+func (this *ImportUserMetaRequest) Validate() error {
+	return nil
+}
+func (this *ExportUserMetaRequest) Validate() error {
+	return nil
+}
+func (this *UserMetaBulkChange) Validate() error {
+	return nil
+}
+func (this *UserMetaBulkResponse) Validate() error {
+	for _, item := range this.Changes {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Changes", err)
+			}
+		}
+	}
+	return nil
+}
 func (this *HeadNodeRequest) Validate() error {
 	return nil
 }
@@ -463,57 +463,57 @@ func init() {
 func init() { proto.RegisterFile("frontend.proto", fileDescriptor4) }
 
 var fileDescriptor4 = []byte{
-	// 828 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x8e, 0xdb, 0x44,
-	0x14, 0xd6, 0x6c, 0x92, 0x5d, 0xe7, 0xa4, 0xa5, 0xdb, 0xd9, 0x9f, 0x5a, 0x11, 0xa0, 0xc8, 0x37,
-	0xa4, 0x80, 0x8c, 0x68, 0x2f, 0x40, 0x54, 0x20, 0x79, 0x1d, 0x43, 0xb3, 0x38, 0xcb, 0xca, 0x31,
-	0xea, 0x65, 0xe4, 0xc6, 0xb3, 0xa9, 0x55, 0xef, 0x8c, 0xf1, 0x4c, 0xaa, 0xee, 0x0b, 0x70, 0x85,
-	0xc4, 0x03, 0xc0, 0xb3, 0x70, 0xc7, 0x35, 0xcf, 0xc2, 0x1b, 0xa0, 0x19, 0xcf, 0xc4, 0x76, 0xb2,
-	0xaa, 0xb4, 0xa8, 0x77, 0x3e, 0xdf, 0x39, 0x3e, 0xfe, 0xbe, 0x6f, 0xce, 0x9c, 0x04, 0x3e, 0xb8,
-	0x2a, 0x19, 0x15, 0x84, 0xa6, 0x6e, 0x51, 0x32, 0xc1, 0x70, 0xb7, 0x24, 0x5c, 0x38, 0x27, 0x70,
-	0x34, 0x27, 0x42, 0x64, 0x74, 0xc5, 0x67, 0x84, 0xae, 0x23, 0xf2, 0xcb, 0x5a, 0xc2, 0x7f, 0x22,
-	0x78, 0x68, 0xf0, 0x80, 0x8a, 0xf2, 0x66, 0x46, 0x44, 0x82, 0x3f, 0x82, 0xfe, 0x74, 0xc9, 0xa8,
-	0x9f, 0x27, 0x9c, 0xdb, 0x68, 0x84, 0xc6, 0xfd, 0x08, 0xb2, 0x25, 0xa3, 0x8b, 0xa5, 0x44, 0xf0,
-	0x87, 0xd0, 0xf7, 0xd9, 0x75, 0xc1, 0x28, 0xa1, 0xc2, 0xde, 0x53, 0xe9, 0xfe, 0xd2, 0x00, 0xf8,
-	0x18, 0x7a, 0x97, 0x25, 0x2b, 0xb8, 0xdd, 0x51, 0x99, 0x5e, 0x21, 0x03, 0x3c, 0x04, 0xcb, 0x4b,
-	0xdf, 0x24, 0x74, 0x49, 0x52, 0xbb, 0x3b, 0x42, 0x63, 0x2b, 0xb2, 0x12, 0x1d, 0x63, 0x1b, 0x0e,
-	0xa6, 0x34, 0x25, 0x6f, 0x49, 0x6a, 0xf7, 0x46, 0x9d, 0x71, 0x3f, 0x3a, 0xc8, 0xaa, 0xd0, 0xf9,
-	0x0b, 0xc1, 0xfd, 0x16, 0x3d, 0x7c, 0x08, 0x9d, 0x1f, 0xc9, 0x8d, 0x26, 0x25, 0x1f, 0xe5, 0xf7,
-	0xc2, 0xe4, 0x25, 0xc9, 0x35, 0x93, 0x5e, 0xe8, 0x9d, 0x05, 0x21, 0x1e, 0xc1, 0x60, 0x42, 0xf8,
-	0xb2, 0xcc, 0x0a, 0x91, 0x31, 0xaa, 0xb9, 0x0c, 0x26, 0xc1, 0xdc, 0x8f, 0xa6, 0x97, 0xf1, 0xf4,
-	0xa7, 0x0b, 0xf9, 0xd5, 0x59, 0x42, 0x93, 0x15, 0x29, 0x15, 0xa1, 0x7e, 0x74, 0x30, 0xf3, 0x2e,
-	0xbc, 0x1f, 0x82, 0x48, 0x76, 0xf4, 0xf2, 0x2c, 0xe1, 0x76, 0xaf, 0xea, 0xe8, 0x85, 0x53, 0x6f,
-	0x8e, 0x9f, 0x82, 0x25, 0xcd, 0x49, 0x13, 0x91, 0xd8, 0xfb, 0x23, 0x34, 0x1e, 0x3c, 0x79, 0xe4,
-	0x4a, 0x6b, 0xdd, 0x1d, 0xff, 0x22, 0x6b, 0x16, 0xc4, 0xde, 0xc4, 0x8b, 0x3d, 0xe7, 0x37, 0x04,
-	0x0f, 0x4c, 0x7e, 0x4e, 0x96, 0x92, 0xcb, 0x7b, 0x94, 0xf0, 0x05, 0x58, 0xfe, 0xab, 0x2c, 0x4f,
-	0x4b, 0x42, 0xed, 0xee, 0xa8, 0x33, 0x1e, 0x3c, 0x39, 0xba, 0x85, 0x52, 0x64, 0xf9, 0xcf, 0xa7,
-	0xe1, 0x24, 0x0a, 0x2e, 0x9c, 0x5f, 0x11, 0x1c, 0xb7, 0xc7, 0x80, 0x17, 0x8c, 0x72, 0x82, 0x9f,
-	0xc1, 0xbd, 0x88, 0x31, 0xb1, 0x11, 0x88, 0xde, 0x2d, 0xf0, 0xde, 0x62, 0x71, 0xad, 0x4b, 0x17,
-	0x0b, 0xfc, 0x25, 0x58, 0x5a, 0x1b, 0xb7, 0xf7, 0x14, 0x8d, 0x93, 0xf6, 0x8b, 0x3a, 0x1b, 0x6d,
-	0xca, 0x9c, 0x23, 0x78, 0xf8, 0xbd, 0x1c, 0xd3, 0xb9, 0x48, 0x04, 0x31, 0xc3, 0x78, 0x0c, 0xb8,
-	0x09, 0x56, 0xd4, 0x9c, 0xc7, 0x70, 0xa4, 0xd0, 0xcb, 0x7c, 0xbd, 0xca, 0x28, 0xd7, 0xc5, 0x18,
-	0x43, 0x37, 0x4c, 0xe8, 0x4a, 0xdb, 0xa8, 0x9e, 0x9d, 0x53, 0x38, 0x6e, 0x97, 0xea, 0x16, 0x9f,
-	0x6a, 0x7c, 0x46, 0x38, 0x4f, 0x56, 0xe4, 0x9d, 0x3d, 0xfe, 0x40, 0x70, 0xb2, 0x55, 0xac, 0x3d,
-	0x0a, 0xe4, 0x00, 0x54, 0x98, 0x8d, 0x94, 0xcc, 0xc7, 0x95, 0xcc, 0x5b, 0xcb, 0x5d, 0x03, 0xe8,
-	0x33, 0x30, 0xe1, 0xf0, 0x19, 0xdc, 0x6f, 0xa5, 0xe4, 0x3c, 0xbc, 0xae, 0xe7, 0xe1, 0x75, 0x35,
-	0x0f, 0x6f, 0x92, 0x7c, 0x4d, 0xcc, 0x3c, 0xa8, 0xe0, 0x9b, 0xbd, 0xaf, 0x91, 0xf3, 0x0f, 0xd2,
-	0x6e, 0xcc, 0x09, 0xe7, 0xd2, 0x52, 0xad, 0xe4, 0x63, 0x00, 0x3f, 0xcf, 0x08, 0x15, 0x71, 0x76,
-	0x4d, 0x54, 0xab, 0x5e, 0xd4, 0x40, 0xb0, 0x0f, 0x96, 0xb7, 0x16, 0xaf, 0xa6, 0xf4, 0x8a, 0xe9,
-	0x23, 0xfa, 0xa4, 0xc1, 0xbd, 0xdd, 0xcc, 0x35, 0x95, 0x9a, 0xb9, 0x09, 0xf1, 0x29, 0xec, 0x87,
-	0x6c, 0xc5, 0xd6, 0x42, 0xcd, 0xa2, 0x15, 0xe9, 0x48, 0x2a, 0x6a, 0xbd, 0x72, 0x27, 0x45, 0xff,
-	0x22, 0x7d, 0x38, 0x1b, 0x12, 0xda, 0xee, 0x43, 0xe8, 0x9c, 0xbf, 0x88, 0x4d, 0x93, 0xf3, 0x17,
-	0xb1, 0x14, 0x19, 0xbc, 0x2d, 0xb2, 0x92, 0x28, 0x91, 0x7b, 0x95, 0xc8, 0x1a, 0x91, 0x37, 0x3a,
-	0x2e, 0xb3, 0x95, 0xbc, 0xd1, 0xd5, 0x65, 0x31, 0x21, 0x9e, 0xc1, 0x40, 0x3f, 0x2a, 0x07, 0xaa,
-	0xbb, 0xf2, 0xd9, 0x6d, 0x0e, 0xe8, 0xc3, 0x6b, 0x54, 0x57, 0x2e, 0x34, 0xdf, 0x1f, 0x7e, 0x07,
-	0x87, 0xdb, 0x05, 0x77, 0xd2, 0xfc, 0x37, 0x82, 0x53, 0xf5, 0xd9, 0x80, 0x96, 0x2c, 0xcf, 0xa5,
-	0x79, 0x8d, 0x83, 0xac, 0xc0, 0xf8, 0xa6, 0x20, 0x66, 0xf7, 0xd6, 0x08, 0x0e, 0x4d, 0xbe, 0x71,
-	0x94, 0x9f, 0x37, 0x84, 0xec, 0x74, 0x74, 0xeb, 0xf2, 0x4a, 0x49, 0xe3, 0xfd, 0xe1, 0xb7, 0xf0,
-	0x60, 0x2b, 0x7d, 0x27, 0x1d, 0xbf, 0x23, 0x78, 0xb4, 0xf3, 0xd5, 0xcd, 0x46, 0xe9, 0x2a, 0x8a,
-	0x68, 0x67, 0xda, 0x76, 0x8b, 0xdd, 0x9a, 0x9d, 0x7a, 0x69, 0xf8, 0x15, 0xf4, 0xff, 0x1f, 0xa3,
-	0xe7, 0x7a, 0x85, 0x9c, 0x65, 0x34, 0x29, 0x6f, 0x1a, 0xa6, 0x56, 0x40, 0xd3, 0xd4, 0x1a, 0x91,
-	0x7b, 0xe0, 0xe7, 0x75, 0x96, 0xea, 0x76, 0xea, 0x59, 0xfe, 0x60, 0xb6, 0x3a, 0xe9, 0x55, 0x62,
-	0x56, 0xcc, 0x19, 0x63, 0xc2, 0x67, 0xf4, 0xca, 0xec, 0xae, 0xcd, 0xda, 0xa8, 0x13, 0xf5, 0xda,
-	0x38, 0xe7, 0x8c, 0x4e, 0xaa, 0xb5, 0xba, 0xbd, 0x36, 0xb6, 0xcb, 0x5d, 0x53, 0xab, 0x2f, 0x9f,
-	0x09, 0xe5, 0x25, 0x6b, 0xa5, 0xee, 0x62, 0xcb, 0xcb, 0x7d, 0xf5, 0x57, 0xe0, 0xe9, 0x7f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x0d, 0x56, 0xe9, 0x9a, 0x1c, 0x08, 0x00, 0x00,
+	// 823 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xd1, 0x8e, 0xdb, 0x44,
+	0x14, 0xd5, 0x6c, 0x92, 0x5d, 0xe7, 0xa6, 0xa5, 0xdb, 0xd9, 0xec, 0xd6, 0x8a, 0x00, 0x45, 0x7e,
+	0x21, 0x05, 0x64, 0x44, 0xfb, 0x00, 0xa2, 0x02, 0xc9, 0xeb, 0x18, 0x9a, 0xc5, 0x59, 0x56, 0x8e,
+	0x51, 0x1f, 0x23, 0x37, 0x9e, 0x4d, 0xad, 0x7a, 0x67, 0x8c, 0x67, 0x52, 0x75, 0x7f, 0x80, 0x27,
+	0x24, 0x3e, 0x00, 0xbe, 0x85, 0x37, 0x9e, 0xf9, 0x16, 0xfe, 0x00, 0xcd, 0x78, 0x66, 0x6d, 0x27,
+	0xab, 0x4a, 0x8b, 0xfa, 0xe6, 0x7b, 0xee, 0xf5, 0xf5, 0x39, 0x67, 0xee, 0xdc, 0x04, 0x3e, 0xb8,
+	0x2c, 0x19, 0x15, 0x84, 0xa6, 0x6e, 0x51, 0x32, 0xc1, 0x70, 0xb7, 0x24, 0x5c, 0x38, 0xc7, 0x70,
+	0xb4, 0x20, 0x42, 0x64, 0x74, 0xcd, 0xe7, 0x84, 0x6e, 0x22, 0xf2, 0xcb, 0x46, 0xc2, 0x7f, 0x22,
+	0x78, 0x68, 0xf0, 0x80, 0x8a, 0xf2, 0x7a, 0x4e, 0x44, 0x82, 0x3f, 0x82, 0xfe, 0x6c, 0xc5, 0xa8,
+	0x9f, 0x27, 0x9c, 0xdb, 0x68, 0x8c, 0x26, 0xfd, 0x08, 0xb2, 0x15, 0xa3, 0xcb, 0x95, 0x44, 0xf0,
+	0x87, 0xd0, 0xf7, 0xd9, 0x55, 0xc1, 0x28, 0xa1, 0xc2, 0xde, 0x53, 0xe9, 0xfe, 0xca, 0x00, 0x78,
+	0x08, 0xbd, 0x8b, 0x92, 0x15, 0xdc, 0xee, 0xa8, 0x4c, 0xaf, 0x90, 0x01, 0x1e, 0x81, 0xe5, 0xa5,
+	0x6f, 0x12, 0xba, 0x22, 0xa9, 0xdd, 0x1d, 0xa3, 0x89, 0x15, 0x59, 0x89, 0x8e, 0xb1, 0x0d, 0x07,
+	0x33, 0x9a, 0x92, 0xb7, 0x24, 0xb5, 0x7b, 0xe3, 0xce, 0xa4, 0x1f, 0x1d, 0x64, 0x55, 0xe8, 0xfc,
+	0x85, 0xe0, 0x7e, 0x8b, 0x1e, 0x3e, 0x84, 0xce, 0x8f, 0xe4, 0x5a, 0x93, 0x92, 0x8f, 0xf2, 0x7b,
+	0x61, 0xf2, 0x92, 0xe4, 0x9a, 0x49, 0x2f, 0xf4, 0x4e, 0x83, 0x10, 0x8f, 0x61, 0x30, 0x25, 0x7c,
+	0x55, 0x66, 0x85, 0xc8, 0x18, 0xd5, 0x5c, 0x06, 0xd3, 0x60, 0xe1, 0x47, 0xb3, 0x8b, 0x78, 0xf6,
+	0xd3, 0xb9, 0xfc, 0xea, 0x3c, 0xa1, 0xc9, 0x9a, 0x94, 0x8a, 0x50, 0x3f, 0x3a, 0x98, 0x7b, 0xe7,
+	0xde, 0x0f, 0x41, 0x24, 0x3b, 0x7a, 0x79, 0x96, 0x70, 0xbb, 0x57, 0x75, 0xf4, 0xc2, 0x99, 0xb7,
+	0xc0, 0x4f, 0xc1, 0x92, 0xe6, 0xa4, 0x89, 0x48, 0xec, 0xfd, 0x31, 0x9a, 0x0c, 0x9e, 0x3c, 0x72,
+	0xa5, 0xb5, 0xee, 0x8e, 0x7f, 0x91, 0x35, 0x0f, 0x62, 0x6f, 0xea, 0xc5, 0x9e, 0xf3, 0x1b, 0x82,
+	0x07, 0x26, 0xbf, 0x20, 0x2b, 0xc9, 0xe5, 0x3d, 0x4a, 0xf8, 0x02, 0x2c, 0xff, 0x55, 0x96, 0xa7,
+	0x25, 0xa1, 0x76, 0x77, 0xdc, 0x99, 0x0c, 0x9e, 0x1c, 0xdd, 0x42, 0x29, 0xb2, 0xfc, 0xe7, 0xb3,
+	0x70, 0x1a, 0x05, 0xe7, 0xce, 0xaf, 0x08, 0x86, 0xed, 0x31, 0xe0, 0x05, 0xa3, 0x9c, 0xe0, 0x67,
+	0x70, 0x2f, 0x62, 0x4c, 0xdc, 0x08, 0x44, 0xef, 0x16, 0x78, 0x6f, 0xb9, 0xbc, 0xd2, 0xa5, 0xcb,
+	0x25, 0xfe, 0x12, 0x2c, 0xad, 0x8d, 0xdb, 0x7b, 0x8a, 0xc6, 0x71, 0xfb, 0x45, 0x9d, 0x8d, 0x6e,
+	0xca, 0x9c, 0x23, 0x78, 0xf8, 0xbd, 0x1c, 0xd3, 0x85, 0x48, 0x04, 0x31, 0xc3, 0x38, 0x04, 0xdc,
+	0x04, 0x2b, 0x6a, 0xce, 0x63, 0x38, 0x52, 0xe8, 0x45, 0xbe, 0x59, 0x67, 0x94, 0xeb, 0x62, 0x8c,
+	0xa1, 0x1b, 0x26, 0x74, 0xad, 0x6d, 0x54, 0xcf, 0xce, 0x09, 0x0c, 0xdb, 0xa5, 0xba, 0xc5, 0xa7,
+	0x1a, 0x9f, 0x13, 0xce, 0x93, 0x35, 0x79, 0x67, 0x8f, 0x3f, 0x10, 0x1c, 0x6f, 0x15, 0x6b, 0x8f,
+	0x02, 0xb0, 0x0c, 0x66, 0x23, 0x25, 0xf3, 0x71, 0x25, 0xf3, 0xd6, 0x72, 0xd7, 0x00, 0xfa, 0x0c,
+	0x4c, 0x38, 0x7a, 0x06, 0xf7, 0x5b, 0x29, 0x39, 0x0f, 0xaf, 0xeb, 0x79, 0x78, 0x5d, 0xcd, 0xc3,
+	0x9b, 0x24, 0xdf, 0x10, 0x33, 0x0f, 0x2a, 0xf8, 0x66, 0xef, 0x6b, 0xe4, 0xfc, 0x83, 0xb4, 0x1b,
+	0x0b, 0xc2, 0xb9, 0xb4, 0x54, 0x2b, 0xf9, 0x18, 0xc0, 0xcf, 0x33, 0x42, 0x45, 0x9c, 0x5d, 0x11,
+	0xd5, 0xaa, 0x17, 0x35, 0x10, 0xec, 0x83, 0xe5, 0x6d, 0xc4, 0xab, 0x19, 0xbd, 0x64, 0xfa, 0x88,
+	0x3e, 0x69, 0x70, 0x6f, 0x37, 0x73, 0x4d, 0xa5, 0x66, 0x6e, 0x42, 0x7c, 0x02, 0xfb, 0x21, 0x5b,
+	0xb3, 0x8d, 0x50, 0xb3, 0x68, 0x45, 0x3a, 0x92, 0x8a, 0x5a, 0xaf, 0xdc, 0x49, 0xd1, 0xbf, 0x08,
+	0x86, 0x6d, 0x12, 0xda, 0xee, 0x43, 0xe8, 0x9c, 0xbd, 0x88, 0x4d, 0x93, 0xb3, 0x17, 0xb1, 0x14,
+	0x19, 0xbc, 0x2d, 0xb2, 0x92, 0x28, 0x91, 0x7b, 0x95, 0xc8, 0x1a, 0x91, 0x37, 0x3a, 0x2e, 0xb3,
+	0xb5, 0xbc, 0xd1, 0xd5, 0x65, 0x31, 0x21, 0x9e, 0xc3, 0x40, 0x3f, 0x2a, 0x07, 0xaa, 0xbb, 0xf2,
+	0xd9, 0x6d, 0x0e, 0xe8, 0xc3, 0x6b, 0x54, 0x57, 0x2e, 0x34, 0xdf, 0x1f, 0x7d, 0x07, 0x87, 0xdb,
+	0x05, 0x77, 0xd2, 0xfc, 0x37, 0x82, 0x13, 0xf5, 0xd9, 0x80, 0x96, 0x2c, 0xcf, 0xa5, 0x79, 0x8d,
+	0x83, 0xac, 0xc0, 0xf8, 0xba, 0x20, 0x66, 0xf7, 0xd6, 0x08, 0x0e, 0x4d, 0xbe, 0x71, 0x94, 0x9f,
+	0x37, 0x84, 0xec, 0x74, 0x74, 0xeb, 0xf2, 0x4a, 0x49, 0xe3, 0xfd, 0xd1, 0xb7, 0xf0, 0x60, 0x2b,
+	0x7d, 0x27, 0x1d, 0xbf, 0x23, 0x78, 0xb4, 0xf3, 0xd5, 0x9b, 0x8d, 0xd2, 0x55, 0x14, 0xd1, 0xce,
+	0xb4, 0xed, 0x16, 0xbb, 0x35, 0x3b, 0xf5, 0xd2, 0xe8, 0x2b, 0xe8, 0xff, 0x3f, 0x46, 0xcf, 0xf5,
+	0x0a, 0x39, 0xcd, 0x68, 0x52, 0x5e, 0x37, 0x4c, 0xad, 0x80, 0xa6, 0xa9, 0x35, 0x22, 0xf7, 0xc0,
+	0xcf, 0x9b, 0x2c, 0xd5, 0xed, 0xd4, 0xb3, 0xfc, 0xc1, 0x6c, 0x75, 0xd2, 0xab, 0xc4, 0xac, 0x98,
+	0x53, 0xc6, 0x84, 0xcf, 0xe8, 0xa5, 0xd9, 0x5d, 0x37, 0x6b, 0xa3, 0x4e, 0xd4, 0x6b, 0xe3, 0x8c,
+	0x33, 0x3a, 0xad, 0xd6, 0xea, 0xf6, 0xda, 0xd8, 0x2e, 0x77, 0x4d, 0xad, 0xbe, 0x7c, 0x26, 0x94,
+	0x97, 0xac, 0x95, 0xba, 0x8b, 0x2d, 0x2f, 0xf7, 0xd5, 0x5f, 0x81, 0xa7, 0xff, 0x0d, 0x00, 0x0d,
+	0x56, 0xe9, 0x9a, 0x1c, 0x08, 0x00, 0x00,
 }
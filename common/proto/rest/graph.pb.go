@@ -103,7 +103,7 @@ func init() {
 func init() { proto.RegisterFile("graph.proto", fileDescriptor5) }
 
 var fileDescriptor5 = []byte{
-	// 310 bytes of a gzipped FileDescriptorProto
+	// 307 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x41, 0x4f, 0xc2, 0x40,
 	0x10, 0x85, 0x53, 0x50, 0x0c, 0x43, 0x82, 0xb8, 0x31, 0xda, 0x70, 0x22, 0x3d, 0x61, 0x62, 0xb6,
 	0xa2, 0x17, 0xe3, 0x4d, 0x0d, 0x07, 0xaf, 0x0b, 0xc6, 0xa3, 0x59, 0xda, 0x49, 0x69, 0x68, 0x3b,
@@ -122,6 +122,6 @@ var fileDescriptor5 = []byte{
 	0x56, 0xf5, 0xb4, 0x97, 0xb8, 0xee, 0x50, 0xab, 0xe0, 0x0b, 0x7a, 0x5b, 0xb4, 0x8e, 0xe5, 0x06,
 	0x3a, 0x93, 0xb9, 0x36, 0x18, 0x3f, 0x57, 0x47, 0x38, 0x90, 0xcb, 0x2e, 0x22, 0x46, 0xd0, 0x7d,
 	0xc2, 0x8c, 0x8a, 0x84, 0xa7, 0x34, 0x45, 0x9d, 0xb3, 0xdf, 0x74, 0xa6, 0xb6, 0x33, 0x29, 0xca,
-	0x50, 0xfd, 0x01, 0x66, 0x2d, 0x77, 0xc6, 0xbb, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x49, 0xb3,
-	0x35, 0xa3, 0x0e, 0x02, 0x00, 0x00,
+	0x50, 0xfd, 0x01, 0x66, 0x2d, 0x77, 0xc6, 0xbb, 0x9f, 0x01, 0x00, 0x49, 0xb3, 0x35, 0xa3, 0x0e,
+	0x02, 0x00, 0x00,
 }
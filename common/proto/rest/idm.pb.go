@@ -7,6 +7,7 @@ import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import idm "github.com/pydio/cells/common/proto/idm"
+import auth "github.com/pydio/cells/common/proto/auth"
 import service "github.com/pydio/cells/common/service/proto"
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -43,6 +44,29 @@ func (ResourcePolicyQuery_QueryType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor6, []int{0, 0}
 }
 
+type PolicyExplainRequest_ResourceType int32
+
+const (
+	PolicyExplainRequest_REST PolicyExplainRequest_ResourceType = 0
+	PolicyExplainRequest_NODE PolicyExplainRequest_ResourceType = 1
+)
+
+var PolicyExplainRequest_ResourceType_name = map[int32]string{
+	0: "REST",
+	1: "NODE",
+}
+var PolicyExplainRequest_ResourceType_value = map[string]int32{
+	"REST": 0,
+	"NODE": 1,
+}
+
+func (x PolicyExplainRequest_ResourceType) String() string {
+	return proto.EnumName(PolicyExplainRequest_ResourceType_name, int32(x))
+}
+func (PolicyExplainRequest_ResourceType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor6, []int{54, 0}
+}
+
 // Generic Query for limiting results based on resource permissions
 type ResourcePolicyQuery struct {
 	Type   ResourcePolicyQuery_QueryType `protobuf:"varint,1,opt,name=Type,enum=rest.ResourcePolicyQuery_QueryType" json:"Type,omitempty"`
@@ -437,6 +461,252 @@ func (m *WorkspaceCollection) GetTotal() int32 {
 	return 0
 }
 
+// Model used to provision similar workspaces for many users or groups. The workspace Label,
+// Description and Slug, the root paths and the ACL roles may contain variables like {{.Department}}
+type WorkspaceTemplate struct {
+	Uuid        string `protobuf:"bytes,1,opt,name=Uuid" json:"Uuid,omitempty"`
+	Label       string `protobuf:"bytes,2,opt,name=Label" json:"Label,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=Description" json:"Description,omitempty"`
+	// Workspace created for each target
+	Workspace *idm.Workspace `protobuf:"bytes,4,opt,name=Workspace" json:"Workspace,omitempty"`
+	// Paths of the workspace roots, starting with the datasource name. Missing folders are created
+	RootPathPatterns []string                `protobuf:"bytes,5,rep,name=RootPathPatterns" json:"RootPathPatterns,omitempty"`
+	AclPresets       []*WorkspaceTemplateAcl `protobuf:"bytes,6,rep,name=AclPresets" json:"AclPresets,omitempty"`
+	UpdatedAt        int64                   `protobuf:"varint,7,opt,name=UpdatedAt" json:"UpdatedAt,omitempty"`
+}
+
+func (m *WorkspaceTemplate) Reset()                    { *m = WorkspaceTemplate{} }
+func (m *WorkspaceTemplate) String() string            { return proto.CompactTextString(m) }
+func (*WorkspaceTemplate) ProtoMessage()               {}
+func (*WorkspaceTemplate) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{10} }
+
+func (m *WorkspaceTemplate) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *WorkspaceTemplate) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *WorkspaceTemplate) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *WorkspaceTemplate) GetWorkspace() *idm.Workspace {
+	if m != nil {
+		return m.Workspace
+	}
+	return nil
+}
+
+func (m *WorkspaceTemplate) GetRootPathPatterns() []string {
+	if m != nil {
+		return m.RootPathPatterns
+	}
+	return nil
+}
+
+func (m *WorkspaceTemplate) GetAclPresets() []*WorkspaceTemplateAcl {
+	if m != nil {
+		return m.AclPresets
+	}
+	return nil
+}
+
+func (m *WorkspaceTemplate) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+// ACL actions given to a role on all the roots of a template instance
+type WorkspaceTemplateAcl struct {
+	// Role uuid, {{.Uuid}} being the role of the target user or group
+	RoleId  string           `protobuf:"bytes,1,opt,name=RoleId" json:"RoleId,omitempty"`
+	Actions []*idm.ACLAction `protobuf:"bytes,2,rep,name=Actions" json:"Actions,omitempty"`
+}
+
+func (m *WorkspaceTemplateAcl) Reset()                    { *m = WorkspaceTemplateAcl{} }
+func (m *WorkspaceTemplateAcl) String() string            { return proto.CompactTextString(m) }
+func (*WorkspaceTemplateAcl) ProtoMessage()               {}
+func (*WorkspaceTemplateAcl) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{11} }
+
+func (m *WorkspaceTemplateAcl) GetRoleId() string {
+	if m != nil {
+		return m.RoleId
+	}
+	return ""
+}
+
+func (m *WorkspaceTemplateAcl) GetActions() []*idm.ACLAction {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+// User or group for which a template is instantiated
+type WorkspaceTemplateTarget struct {
+	UserLogin string `protobuf:"bytes,1,opt,name=UserLogin" json:"UserLogin,omitempty"`
+	// Full path of a group
+	GroupPath string `protobuf:"bytes,2,opt,name=GroupPath" json:"GroupPath,omitempty"`
+	// Variables overriding the ones read from the target attributes
+	Variables map[string]string `protobuf:"bytes,3,rep,name=Variables" json:"Variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *WorkspaceTemplateTarget) Reset()                    { *m = WorkspaceTemplateTarget{} }
+func (m *WorkspaceTemplateTarget) String() string            { return proto.CompactTextString(m) }
+func (*WorkspaceTemplateTarget) ProtoMessage()               {}
+func (*WorkspaceTemplateTarget) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{12} }
+
+func (m *WorkspaceTemplateTarget) GetUserLogin() string {
+	if m != nil {
+		return m.UserLogin
+	}
+	return ""
+}
+
+func (m *WorkspaceTemplateTarget) GetGroupPath() string {
+	if m != nil {
+		return m.GroupPath
+	}
+	return ""
+}
+
+func (m *WorkspaceTemplateTarget) GetVariables() map[string]string {
+	if m != nil {
+		return m.Variables
+	}
+	return nil
+}
+
+type ListWorkspaceTemplatesRequest struct {
+}
+
+func (m *ListWorkspaceTemplatesRequest) Reset()                    { *m = ListWorkspaceTemplatesRequest{} }
+func (m *ListWorkspaceTemplatesRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListWorkspaceTemplatesRequest) ProtoMessage()               {}
+func (*ListWorkspaceTemplatesRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{13} }
+
+type WorkspaceTemplateCollection struct {
+	Templates []*WorkspaceTemplate `protobuf:"bytes,1,rep,name=Templates" json:"Templates,omitempty"`
+}
+
+func (m *WorkspaceTemplateCollection) Reset()                    { *m = WorkspaceTemplateCollection{} }
+func (m *WorkspaceTemplateCollection) String() string            { return proto.CompactTextString(m) }
+func (*WorkspaceTemplateCollection) ProtoMessage()               {}
+func (*WorkspaceTemplateCollection) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{14} }
+
+func (m *WorkspaceTemplateCollection) GetTemplates() []*WorkspaceTemplate {
+	if m != nil {
+		return m.Templates
+	}
+	return nil
+}
+
+type DeleteWorkspaceTemplateRequest struct {
+	Uuid string `protobuf:"bytes,1,opt,name=Uuid" json:"Uuid,omitempty"`
+}
+
+func (m *DeleteWorkspaceTemplateRequest) Reset()                    { *m = DeleteWorkspaceTemplateRequest{} }
+func (m *DeleteWorkspaceTemplateRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteWorkspaceTemplateRequest) ProtoMessage()               {}
+func (*DeleteWorkspaceTemplateRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{15} }
+
+func (m *DeleteWorkspaceTemplateRequest) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+type InstantiateWorkspaceTemplateRequest struct {
+	TemplateUuid string                     `protobuf:"bytes,1,opt,name=TemplateUuid" json:"TemplateUuid,omitempty"`
+	Targets      []*WorkspaceTemplateTarget `protobuf:"bytes,2,rep,name=Targets" json:"Targets,omitempty"`
+}
+
+func (m *InstantiateWorkspaceTemplateRequest) Reset()         { *m = InstantiateWorkspaceTemplateRequest{} }
+func (m *InstantiateWorkspaceTemplateRequest) String() string { return proto.CompactTextString(m) }
+func (*InstantiateWorkspaceTemplateRequest) ProtoMessage()    {}
+func (*InstantiateWorkspaceTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor6, []int{16}
+}
+
+func (m *InstantiateWorkspaceTemplateRequest) GetTemplateUuid() string {
+	if m != nil {
+		return m.TemplateUuid
+	}
+	return ""
+}
+
+func (m *InstantiateWorkspaceTemplateRequest) GetTargets() []*WorkspaceTemplateTarget {
+	if m != nil {
+		return m.Targets
+	}
+	return nil
+}
+
+type SyncWorkspaceTemplateRequest struct {
+	TemplateUuid string `protobuf:"bytes,1,opt,name=TemplateUuid" json:"TemplateUuid,omitempty"`
+}
+
+func (m *SyncWorkspaceTemplateRequest) Reset()                    { *m = SyncWorkspaceTemplateRequest{} }
+func (m *SyncWorkspaceTemplateRequest) String() string            { return proto.CompactTextString(m) }
+func (*SyncWorkspaceTemplateRequest) ProtoMessage()               {}
+func (*SyncWorkspaceTemplateRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{17} }
+
+func (m *SyncWorkspaceTemplateRequest) GetTemplateUuid() string {
+	if m != nil {
+		return m.TemplateUuid
+	}
+	return ""
+}
+
+// Result of an instantiation or a re-synchronization of a template
+type WorkspaceTemplateResponse struct {
+	Template *WorkspaceTemplate `protobuf:"bytes,1,opt,name=Template" json:"Template,omitempty"`
+	// Workspaces created or updated
+	Instances []*idm.Workspace `protobuf:"bytes,2,rep,name=Instances" json:"Instances,omitempty"`
+	// Errors for the targets that could not be processed
+	Errors []string `protobuf:"bytes,3,rep,name=Errors" json:"Errors,omitempty"`
+}
+
+func (m *WorkspaceTemplateResponse) Reset()                    { *m = WorkspaceTemplateResponse{} }
+func (m *WorkspaceTemplateResponse) String() string            { return proto.CompactTextString(m) }
+func (*WorkspaceTemplateResponse) ProtoMessage()               {}
+func (*WorkspaceTemplateResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{18} }
+
+func (m *WorkspaceTemplateResponse) GetTemplate() *WorkspaceTemplate {
+	if m != nil {
+		return m.Template
+	}
+	return nil
+}
+
+func (m *WorkspaceTemplateResponse) GetInstances() []*idm.Workspace {
+	if m != nil {
+		return m.Instances
+	}
+	return nil
+}
+
+func (m *WorkspaceTemplateResponse) GetErrors() []string {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
 // Collection of UserMeta
 type UserMetaCollection struct {
 	Metadatas []*idm.UserMeta `protobuf:"bytes,1,rep,name=Metadatas" json:"Metadatas,omitempty"`
@@ -445,7 +715,7 @@ type UserMetaCollection struct {
 func (m *UserMetaCollection) Reset()                    { *m = UserMetaCollection{} }
 func (m *UserMetaCollection) String() string            { return proto.CompactTextString(m) }
 func (*UserMetaCollection) ProtoMessage()               {}
-func (*UserMetaCollection) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{10} }
+func (*UserMetaCollection) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{19} }
 
 func (m *UserMetaCollection) GetMetadatas() []*idm.UserMeta {
 	if m != nil {
@@ -462,7 +732,7 @@ type UserMetaNamespaceCollection struct {
 func (m *UserMetaNamespaceCollection) Reset()                    { *m = UserMetaNamespaceCollection{} }
 func (m *UserMetaNamespaceCollection) String() string            { return proto.CompactTextString(m) }
 func (*UserMetaNamespaceCollection) ProtoMessage()               {}
-func (*UserMetaNamespaceCollection) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{11} }
+func (*UserMetaNamespaceCollection) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{20} }
 
 func (m *UserMetaNamespaceCollection) GetNamespaces() []*idm.UserMetaNamespace {
 	if m != nil {
@@ -478,7 +748,7 @@ type ListUserMetaTagsRequest struct {
 func (m *ListUserMetaTagsRequest) Reset()                    { *m = ListUserMetaTagsRequest{} }
 func (m *ListUserMetaTagsRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListUserMetaTagsRequest) ProtoMessage()               {}
-func (*ListUserMetaTagsRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{12} }
+func (*ListUserMetaTagsRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{21} }
 
 func (m *ListUserMetaTagsRequest) GetNamespace() string {
 	if m != nil {
@@ -494,7 +764,7 @@ type ListUserMetaTagsResponse struct {
 func (m *ListUserMetaTagsResponse) Reset()                    { *m = ListUserMetaTagsResponse{} }
 func (m *ListUserMetaTagsResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListUserMetaTagsResponse) ProtoMessage()               {}
-func (*ListUserMetaTagsResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{13} }
+func (*ListUserMetaTagsResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{22} }
 
 func (m *ListUserMetaTagsResponse) GetTags() []string {
 	if m != nil {
@@ -511,7 +781,7 @@ type PutUserMetaTagRequest struct {
 func (m *PutUserMetaTagRequest) Reset()                    { *m = PutUserMetaTagRequest{} }
 func (m *PutUserMetaTagRequest) String() string            { return proto.CompactTextString(m) }
 func (*PutUserMetaTagRequest) ProtoMessage()               {}
-func (*PutUserMetaTagRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{14} }
+func (*PutUserMetaTagRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{23} }
 
 func (m *PutUserMetaTagRequest) GetNamespace() string {
 	if m != nil {
@@ -534,7 +804,7 @@ type PutUserMetaTagResponse struct {
 func (m *PutUserMetaTagResponse) Reset()                    { *m = PutUserMetaTagResponse{} }
 func (m *PutUserMetaTagResponse) String() string            { return proto.CompactTextString(m) }
 func (*PutUserMetaTagResponse) ProtoMessage()               {}
-func (*PutUserMetaTagResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{15} }
+func (*PutUserMetaTagResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{24} }
 
 func (m *PutUserMetaTagResponse) GetSuccess() bool {
 	if m != nil {
@@ -551,7 +821,7 @@ type DeleteUserMetaTagsRequest struct {
 func (m *DeleteUserMetaTagsRequest) Reset()                    { *m = DeleteUserMetaTagsRequest{} }
 func (m *DeleteUserMetaTagsRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteUserMetaTagsRequest) ProtoMessage()               {}
-func (*DeleteUserMetaTagsRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{16} }
+func (*DeleteUserMetaTagsRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{25} }
 
 func (m *DeleteUserMetaTagsRequest) GetNamespace() string {
 	if m != nil {
@@ -574,7 +844,7 @@ type DeleteUserMetaTagsResponse struct {
 func (m *DeleteUserMetaTagsResponse) Reset()                    { *m = DeleteUserMetaTagsResponse{} }
 func (m *DeleteUserMetaTagsResponse) String() string            { return proto.CompactTextString(m) }
 func (*DeleteUserMetaTagsResponse) ProtoMessage()               {}
-func (*DeleteUserMetaTagsResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{17} }
+func (*DeleteUserMetaTagsResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{26} }
 
 func (m *DeleteUserMetaTagsResponse) GetSuccess() bool {
 	if m != nil {
@@ -589,7 +859,7 @@ type UserBookmarksRequest struct {
 func (m *UserBookmarksRequest) Reset()                    { *m = UserBookmarksRequest{} }
 func (m *UserBookmarksRequest) String() string            { return proto.CompactTextString(m) }
 func (*UserBookmarksRequest) ProtoMessage()               {}
-func (*UserBookmarksRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{18} }
+func (*UserBookmarksRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{27} }
 
 // Rest request for revocation. Token is not mandatory, if not set
 // request will use current JWT token
@@ -600,7 +870,7 @@ type RevokeRequest struct {
 func (m *RevokeRequest) Reset()                    { *m = RevokeRequest{} }
 func (m *RevokeRequest) String() string            { return proto.CompactTextString(m) }
 func (*RevokeRequest) ProtoMessage()               {}
-func (*RevokeRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{19} }
+func (*RevokeRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{28} }
 
 func (m *RevokeRequest) GetTokenId() string {
 	if m != nil {
@@ -618,7 +888,7 @@ type RevokeResponse struct {
 func (m *RevokeResponse) Reset()                    { *m = RevokeResponse{} }
 func (m *RevokeResponse) String() string            { return proto.CompactTextString(m) }
 func (*RevokeResponse) ProtoMessage()               {}
-func (*RevokeResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{20} }
+func (*RevokeResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{29} }
 
 func (m *RevokeResponse) GetSuccess() bool {
 	if m != nil {
@@ -634,6 +904,221 @@ func (m *RevokeResponse) GetMessage() string {
 	return ""
 }
 
+// Generate a personal access token for the current user
+type PersonalAccessTokenRequest struct {
+	Label string `protobuf:"bytes,1,opt,name=Label" json:"Label,omitempty"`
+	// Expiration date, as a unix timestamp
+	ExpiresAt int64 `protobuf:"varint,2,opt,name=ExpiresAt" json:"ExpiresAt,omitempty"`
+	// Restrict the token to these workspaces, given by UUID or slug
+	Workspaces []string `protobuf:"bytes,3,rep,name=Workspaces" json:"Workspaces,omitempty"`
+	// Only grant read access
+	ReadOnly bool `protobuf:"varint,4,opt,name=ReadOnly" json:"ReadOnly,omitempty"`
+	// Restrict the token to these REST resources, like /tree/stats or /meta/*
+	RestResources []string `protobuf:"bytes,5,rep,name=RestResources" json:"RestResources,omitempty"`
+}
+
+func (m *PersonalAccessTokenRequest) Reset()                    { *m = PersonalAccessTokenRequest{} }
+func (m *PersonalAccessTokenRequest) String() string            { return proto.CompactTextString(m) }
+func (*PersonalAccessTokenRequest) ProtoMessage()               {}
+func (*PersonalAccessTokenRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{30} }
+
+func (m *PersonalAccessTokenRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *PersonalAccessTokenRequest) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *PersonalAccessTokenRequest) GetWorkspaces() []string {
+	if m != nil {
+		return m.Workspaces
+	}
+	return nil
+}
+
+func (m *PersonalAccessTokenRequest) GetReadOnly() bool {
+	if m != nil {
+		return m.ReadOnly
+	}
+	return false
+}
+
+func (m *PersonalAccessTokenRequest) GetRestResources() []string {
+	if m != nil {
+		return m.RestResources
+	}
+	return nil
+}
+
+// The generated token. Its value is only returned once
+type PersonalAccessTokenResponse struct {
+	Token *auth.PersonalAccessToken `protobuf:"bytes,1,opt,name=Token" json:"Token,omitempty"`
+	Value string                    `protobuf:"bytes,2,opt,name=Value" json:"Value,omitempty"`
+}
+
+func (m *PersonalAccessTokenResponse) Reset()                    { *m = PersonalAccessTokenResponse{} }
+func (m *PersonalAccessTokenResponse) String() string            { return proto.CompactTextString(m) }
+func (*PersonalAccessTokenResponse) ProtoMessage()               {}
+func (*PersonalAccessTokenResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{31} }
+
+func (m *PersonalAccessTokenResponse) GetToken() *auth.PersonalAccessToken {
+	if m != nil {
+		return m.Token
+	}
+	return nil
+}
+
+func (m *PersonalAccessTokenResponse) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type ListPersonalAccessTokensRequest struct {
+	// Admins can list the tokens of another user
+	UserLogin string `protobuf:"bytes,1,opt,name=UserLogin" json:"UserLogin,omitempty"`
+}
+
+func (m *ListPersonalAccessTokensRequest) Reset()         { *m = ListPersonalAccessTokensRequest{} }
+func (m *ListPersonalAccessTokensRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPersonalAccessTokensRequest) ProtoMessage()    {}
+func (*ListPersonalAccessTokensRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor6, []int{32}
+}
+
+func (m *ListPersonalAccessTokensRequest) GetUserLogin() string {
+	if m != nil {
+		return m.UserLogin
+	}
+	return ""
+}
+
+type PersonalAccessTokenCollection struct {
+	Tokens []*auth.PersonalAccessToken `protobuf:"bytes,1,rep,name=Tokens" json:"Tokens,omitempty"`
+}
+
+func (m *PersonalAccessTokenCollection) Reset()                    { *m = PersonalAccessTokenCollection{} }
+func (m *PersonalAccessTokenCollection) String() string            { return proto.CompactTextString(m) }
+func (*PersonalAccessTokenCollection) ProtoMessage()               {}
+func (*PersonalAccessTokenCollection) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{33} }
+
+func (m *PersonalAccessTokenCollection) GetTokens() []*auth.PersonalAccessToken {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+type RevokePersonalAccessTokenRequest struct {
+	Uuid string `protobuf:"bytes,1,opt,name=Uuid" json:"Uuid,omitempty"`
+}
+
+func (m *RevokePersonalAccessTokenRequest) Reset()         { *m = RevokePersonalAccessTokenRequest{} }
+func (m *RevokePersonalAccessTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokePersonalAccessTokenRequest) ProtoMessage()    {}
+func (*RevokePersonalAccessTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor6, []int{34}
+}
+
+func (m *RevokePersonalAccessTokenRequest) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+type ListSessionsRequest struct {
+	// Admins can list the sessions of another user
+	UserLogin string `protobuf:"bytes,1,opt,name=UserLogin" json:"UserLogin,omitempty"`
+}
+
+func (m *ListSessionsRequest) Reset()                    { *m = ListSessionsRequest{} }
+func (m *ListSessionsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListSessionsRequest) ProtoMessage()               {}
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{35} }
+
+func (m *ListSessionsRequest) GetUserLogin() string {
+	if m != nil {
+		return m.UserLogin
+	}
+	return ""
+}
+
+type SessionCollection struct {
+	Sessions []*auth.Session `protobuf:"bytes,1,rep,name=Sessions" json:"Sessions,omitempty"`
+	// Uuid of the session used by the request
+	CurrentSession string `protobuf:"bytes,2,opt,name=CurrentSession" json:"CurrentSession,omitempty"`
+}
+
+func (m *SessionCollection) Reset()                    { *m = SessionCollection{} }
+func (m *SessionCollection) String() string            { return proto.CompactTextString(m) }
+func (*SessionCollection) ProtoMessage()               {}
+func (*SessionCollection) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{36} }
+
+func (m *SessionCollection) GetSessions() []*auth.Session {
+	if m != nil {
+		return m.Sessions
+	}
+	return nil
+}
+
+func (m *SessionCollection) GetCurrentSession() string {
+	if m != nil {
+		return m.CurrentSession
+	}
+	return ""
+}
+
+type RevokeSessionsRequest struct {
+	// Admins can revoke the sessions of another user
+	UserLogin string `protobuf:"bytes,1,opt,name=UserLogin" json:"UserLogin,omitempty"`
+	// Do not revoke the session used by the request
+	KeepCurrent bool `protobuf:"varint,2,opt,name=KeepCurrent" json:"KeepCurrent,omitempty"`
+}
+
+func (m *RevokeSessionsRequest) Reset()                    { *m = RevokeSessionsRequest{} }
+func (m *RevokeSessionsRequest) String() string            { return proto.CompactTextString(m) }
+func (*RevokeSessionsRequest) ProtoMessage()               {}
+func (*RevokeSessionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{37} }
+
+func (m *RevokeSessionsRequest) GetUserLogin() string {
+	if m != nil {
+		return m.UserLogin
+	}
+	return ""
+}
+
+func (m *RevokeSessionsRequest) GetKeepCurrent() bool {
+	if m != nil {
+		return m.KeepCurrent
+	}
+	return false
+}
+
+type RevokeSessionRequest struct {
+	Uuid string `protobuf:"bytes,1,opt,name=Uuid" json:"Uuid,omitempty"`
+}
+
+func (m *RevokeSessionRequest) Reset()                    { *m = RevokeSessionRequest{} }
+func (m *RevokeSessionRequest) String() string            { return proto.CompactTextString(m) }
+func (*RevokeSessionRequest) ProtoMessage()               {}
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{38} }
+
+func (m *RevokeSessionRequest) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
 type ResetPasswordTokenRequest struct {
 	UserLogin string `protobuf:"bytes,1,opt,name=UserLogin" json:"UserLogin,omitempty"`
 }
@@ -641,7 +1126,7 @@ type ResetPasswordTokenRequest struct {
 func (m *ResetPasswordTokenRequest) Reset()                    { *m = ResetPasswordTokenRequest{} }
 func (m *ResetPasswordTokenRequest) String() string            { return proto.CompactTextString(m) }
 func (*ResetPasswordTokenRequest) ProtoMessage()               {}
-func (*ResetPasswordTokenRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{21} }
+func (*ResetPasswordTokenRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{39} }
 
 func (m *ResetPasswordTokenRequest) GetUserLogin() string {
 	if m != nil {
@@ -658,7 +1143,7 @@ type ResetPasswordTokenResponse struct {
 func (m *ResetPasswordTokenResponse) Reset()                    { *m = ResetPasswordTokenResponse{} }
 func (m *ResetPasswordTokenResponse) String() string            { return proto.CompactTextString(m) }
 func (*ResetPasswordTokenResponse) ProtoMessage()               {}
-func (*ResetPasswordTokenResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{22} }
+func (*ResetPasswordTokenResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{40} }
 
 func (m *ResetPasswordTokenResponse) GetSuccess() bool {
 	if m != nil {
@@ -683,7 +1168,7 @@ type ResetPasswordRequest struct {
 func (m *ResetPasswordRequest) Reset()                    { *m = ResetPasswordRequest{} }
 func (m *ResetPasswordRequest) String() string            { return proto.CompactTextString(m) }
 func (*ResetPasswordRequest) ProtoMessage()               {}
-func (*ResetPasswordRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{23} }
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{41} }
 
 func (m *ResetPasswordRequest) GetResetPasswordToken() string {
 	if m != nil {
@@ -714,7 +1199,7 @@ type ResetPasswordResponse struct {
 func (m *ResetPasswordResponse) Reset()                    { *m = ResetPasswordResponse{} }
 func (m *ResetPasswordResponse) String() string            { return proto.CompactTextString(m) }
 func (*ResetPasswordResponse) ProtoMessage()               {}
-func (*ResetPasswordResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{24} }
+func (*ResetPasswordResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{42} }
 
 func (m *ResetPasswordResponse) GetSuccess() bool {
 	if m != nil {
@@ -730,6 +1215,444 @@ func (m *ResetPasswordResponse) GetMessage() string {
 	return ""
 }
 
+// Second factor status of the current user
+type MfaStatusRequest struct {
+}
+
+func (m *MfaStatusRequest) Reset()                    { *m = MfaStatusRequest{} }
+func (m *MfaStatusRequest) String() string            { return proto.CompactTextString(m) }
+func (*MfaStatusRequest) ProtoMessage()               {}
+func (*MfaStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{43} }
+
+type MfaStatusResponse struct {
+	TotpEnabled bool `protobuf:"varint,1,opt,name=TotpEnabled" json:"TotpEnabled,omitempty"`
+	// One of the user roles requires a second factor
+	Enforced          bool  `protobuf:"varint,2,opt,name=Enforced" json:"Enforced,omitempty"`
+	RecoveryCodesLeft int32 `protobuf:"varint,3,opt,name=RecoveryCodesLeft" json:"RecoveryCodesLeft,omitempty"`
+	// Registered security keys
+	Credentials []*WebauthnCredential `protobuf:"bytes,4,rep,name=Credentials" json:"Credentials,omitempty"`
+}
+
+func (m *MfaStatusResponse) Reset()                    { *m = MfaStatusResponse{} }
+func (m *MfaStatusResponse) String() string            { return proto.CompactTextString(m) }
+func (*MfaStatusResponse) ProtoMessage()               {}
+func (*MfaStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{44} }
+
+func (m *MfaStatusResponse) GetTotpEnabled() bool {
+	if m != nil {
+		return m.TotpEnabled
+	}
+	return false
+}
+
+func (m *MfaStatusResponse) GetEnforced() bool {
+	if m != nil {
+		return m.Enforced
+	}
+	return false
+}
+
+func (m *MfaStatusResponse) GetRecoveryCodesLeft() int32 {
+	if m != nil {
+		return m.RecoveryCodesLeft
+	}
+	return 0
+}
+
+func (m *MfaStatusResponse) GetCredentials() []*WebauthnCredential {
+	if m != nil {
+		return m.Credentials
+	}
+	return nil
+}
+
+// Security key registered by a user
+type WebauthnCredential struct {
+	Id       string `protobuf:"bytes,1,opt,name=Id" json:"Id,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=Name" json:"Name,omitempty"`
+	Created  int64  `protobuf:"varint,3,opt,name=Created" json:"Created,omitempty"`
+	LastUsed int64  `protobuf:"varint,4,opt,name=LastUsed" json:"LastUsed,omitempty"`
+}
+
+func (m *WebauthnCredential) Reset()                    { *m = WebauthnCredential{} }
+func (m *WebauthnCredential) String() string            { return proto.CompactTextString(m) }
+func (*WebauthnCredential) ProtoMessage()               {}
+func (*WebauthnCredential) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{45} }
+
+func (m *WebauthnCredential) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *WebauthnCredential) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *WebauthnCredential) GetCreated() int64 {
+	if m != nil {
+		return m.Created
+	}
+	return 0
+}
+
+func (m *WebauthnCredential) GetLastUsed() int64 {
+	if m != nil {
+		return m.LastUsed
+	}
+	return 0
+}
+
+// Start a TOTP enrollment
+type TotpEnrollRequest struct {
+}
+
+func (m *TotpEnrollRequest) Reset()                    { *m = TotpEnrollRequest{} }
+func (m *TotpEnrollRequest) String() string            { return proto.CompactTextString(m) }
+func (*TotpEnrollRequest) ProtoMessage()               {}
+func (*TotpEnrollRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{46} }
+
+type TotpEnrollResponse struct {
+	Secret string `protobuf:"bytes,1,opt,name=Secret" json:"Secret,omitempty"`
+	// otpauth:// uri to be displayed as a QR code
+	ProvisioningUri string `protobuf:"bytes,2,opt,name=ProvisioningUri" json:"ProvisioningUri,omitempty"`
+}
+
+func (m *TotpEnrollResponse) Reset()                    { *m = TotpEnrollResponse{} }
+func (m *TotpEnrollResponse) String() string            { return proto.CompactTextString(m) }
+func (*TotpEnrollResponse) ProtoMessage()               {}
+func (*TotpEnrollResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{47} }
+
+func (m *TotpEnrollResponse) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+func (m *TotpEnrollResponse) GetProvisioningUri() string {
+	if m != nil {
+		return m.ProvisioningUri
+	}
+	return ""
+}
+
+// Operation requiring a valid second factor code
+type MfaCodeRequest struct {
+	Code string `protobuf:"bytes,1,opt,name=Code" json:"Code,omitempty"`
+}
+
+func (m *MfaCodeRequest) Reset()                    { *m = MfaCodeRequest{} }
+func (m *MfaCodeRequest) String() string            { return proto.CompactTextString(m) }
+func (*MfaCodeRequest) ProtoMessage()               {}
+func (*MfaCodeRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{48} }
+
+func (m *MfaCodeRequest) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+// Recovery codes are displayed only once
+type MfaRecoveryCodesResponse struct {
+	RecoveryCodes []string `protobuf:"bytes,1,rep,name=RecoveryCodes" json:"RecoveryCodes,omitempty"`
+}
+
+func (m *MfaRecoveryCodesResponse) Reset()                    { *m = MfaRecoveryCodesResponse{} }
+func (m *MfaRecoveryCodesResponse) String() string            { return proto.CompactTextString(m) }
+func (*MfaRecoveryCodesResponse) ProtoMessage()               {}
+func (*MfaRecoveryCodesResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{49} }
+
+func (m *MfaRecoveryCodesResponse) GetRecoveryCodes() []string {
+	if m != nil {
+		return m.RecoveryCodes
+	}
+	return nil
+}
+
+// Start a security key registration or login
+type WebauthnBeginRequest struct {
+	// Login of the user, only used for logging in
+	Login string `protobuf:"bytes,1,opt,name=Login" json:"Login,omitempty"`
+}
+
+func (m *WebauthnBeginRequest) Reset()                    { *m = WebauthnBeginRequest{} }
+func (m *WebauthnBeginRequest) String() string            { return proto.CompactTextString(m) }
+func (*WebauthnBeginRequest) ProtoMessage()               {}
+func (*WebauthnBeginRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{50} }
+
+func (m *WebauthnBeginRequest) GetLogin() string {
+	if m != nil {
+		return m.Login
+	}
+	return ""
+}
+
+type WebauthnOptionsResponse struct {
+	// JSON-encoded options to pass to navigator.credentials.create() or navigator.credentials.get()
+	Options string `protobuf:"bytes,1,opt,name=Options" json:"Options,omitempty"`
+}
+
+func (m *WebauthnOptionsResponse) Reset()                    { *m = WebauthnOptionsResponse{} }
+func (m *WebauthnOptionsResponse) String() string            { return proto.CompactTextString(m) }
+func (*WebauthnOptionsResponse) ProtoMessage()               {}
+func (*WebauthnOptionsResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{51} }
+
+func (m *WebauthnOptionsResponse) GetOptions() string {
+	if m != nil {
+		return m.Options
+	}
+	return ""
+}
+
+// Result of navigator.credentials.create(), binary values are base64url-encoded
+type WebauthnRegisterRequest struct {
+	Name              string `protobuf:"bytes,1,opt,name=Name" json:"Name,omitempty"`
+	Id                string `protobuf:"bytes,2,opt,name=Id" json:"Id,omitempty"`
+	ClientDataJSON    string `protobuf:"bytes,3,opt,name=ClientDataJSON" json:"ClientDataJSON,omitempty"`
+	AttestationObject string `protobuf:"bytes,4,opt,name=AttestationObject" json:"AttestationObject,omitempty"`
+}
+
+func (m *WebauthnRegisterRequest) Reset()                    { *m = WebauthnRegisterRequest{} }
+func (m *WebauthnRegisterRequest) String() string            { return proto.CompactTextString(m) }
+func (*WebauthnRegisterRequest) ProtoMessage()               {}
+func (*WebauthnRegisterRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{52} }
+
+func (m *WebauthnRegisterRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *WebauthnRegisterRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *WebauthnRegisterRequest) GetClientDataJSON() string {
+	if m != nil {
+		return m.ClientDataJSON
+	}
+	return ""
+}
+
+func (m *WebauthnRegisterRequest) GetAttestationObject() string {
+	if m != nil {
+		return m.AttestationObject
+	}
+	return ""
+}
+
+type WebauthnDeleteRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=Id" json:"Id,omitempty"`
+}
+
+func (m *WebauthnDeleteRequest) Reset()                    { *m = WebauthnDeleteRequest{} }
+func (m *WebauthnDeleteRequest) String() string            { return proto.CompactTextString(m) }
+func (*WebauthnDeleteRequest) ProtoMessage()               {}
+func (*WebauthnDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{53} }
+
+func (m *WebauthnDeleteRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type PolicyExplainRequest struct {
+	UserLogin string                            `protobuf:"bytes,1,opt,name=UserLogin" json:"UserLogin,omitempty"`
+	Type      PolicyExplainRequest_ResourceType `protobuf:"varint,2,opt,name=Type,enum=rest.PolicyExplainRequest_ResourceType" json:"Type,omitempty"`
+	// REST path like /workspace, or node path as listed in the admin tree
+	Resource string `protobuf:"bytes,3,opt,name=Resource" json:"Resource,omitempty"`
+	// HTTP method for REST resources, read or write for nodes
+	Action string `protobuf:"bytes,4,opt,name=Action" json:"Action,omitempty"`
+	// Additional policy context, like RemoteAddress or HttpUserAgent
+	Context map[string]string `protobuf:"bytes,5,rep,name=Context" json:"Context,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *PolicyExplainRequest) Reset()                    { *m = PolicyExplainRequest{} }
+func (m *PolicyExplainRequest) String() string            { return proto.CompactTextString(m) }
+func (*PolicyExplainRequest) ProtoMessage()               {}
+func (*PolicyExplainRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{54} }
+
+func (m *PolicyExplainRequest) GetUserLogin() string {
+	if m != nil {
+		return m.UserLogin
+	}
+	return ""
+}
+
+func (m *PolicyExplainRequest) GetType() PolicyExplainRequest_ResourceType {
+	if m != nil {
+		return m.Type
+	}
+	return PolicyExplainRequest_REST
+}
+
+func (m *PolicyExplainRequest) GetResource() string {
+	if m != nil {
+		return m.Resource
+	}
+	return ""
+}
+
+func (m *PolicyExplainRequest) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *PolicyExplainRequest) GetContext() map[string]string {
+	if m != nil {
+		return m.Context
+	}
+	return nil
+}
+
+// ACL found on the node or one of its parents, in evaluation order
+type AclTrace struct {
+	Acl      *idm.ACL `protobuf:"bytes,1,opt,name=Acl" json:"Acl,omitempty"`
+	NodePath string   `protobuf:"bytes,2,opt,name=NodePath" json:"NodePath,omitempty"`
+	// True if the ACL role is the last one defining ACLs on this node
+	Effective bool `protobuf:"varint,3,opt,name=Effective" json:"Effective,omitempty"`
+	Decisive  bool `protobuf:"varint,4,opt,name=Decisive" json:"Decisive,omitempty"`
+	// Evaluation of the security policy of a policy ACL
+	Policies []*idm.PolicyTrace `protobuf:"bytes,5,rep,name=Policies" json:"Policies,omitempty"`
+}
+
+func (m *AclTrace) Reset()                    { *m = AclTrace{} }
+func (m *AclTrace) String() string            { return proto.CompactTextString(m) }
+func (*AclTrace) ProtoMessage()               {}
+func (*AclTrace) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{55} }
+
+func (m *AclTrace) GetAcl() *idm.ACL {
+	if m != nil {
+		return m.Acl
+	}
+	return nil
+}
+
+func (m *AclTrace) GetNodePath() string {
+	if m != nil {
+		return m.NodePath
+	}
+	return ""
+}
+
+func (m *AclTrace) GetEffective() bool {
+	if m != nil {
+		return m.Effective
+	}
+	return false
+}
+
+func (m *AclTrace) GetDecisive() bool {
+	if m != nil {
+		return m.Decisive
+	}
+	return false
+}
+
+func (m *AclTrace) GetPolicies() []*idm.PolicyTrace {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
+type PolicyExplanation struct {
+	Allowed bool `protobuf:"varint,1,opt,name=Allowed" json:"Allowed,omitempty"`
+	// Human readable summary of the decision
+	Decision string `protobuf:"bytes,2,opt,name=Decision" json:"Decision,omitempty"`
+	// Roles of the user, in order
+	Roles    []*idm.Role        `protobuf:"bytes,3,rep,name=Roles" json:"Roles,omitempty"`
+	Policies []*idm.PolicyTrace `protobuf:"bytes,4,rep,name=Policies" json:"Policies,omitempty"`
+	Acls     []*AclTrace        `protobuf:"bytes,5,rep,name=Acls" json:"Acls,omitempty"`
+}
+
+func (m *PolicyExplanation) Reset()                    { *m = PolicyExplanation{} }
+func (m *PolicyExplanation) String() string            { return proto.CompactTextString(m) }
+func (*PolicyExplanation) ProtoMessage()               {}
+func (*PolicyExplanation) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{56} }
+
+func (m *PolicyExplanation) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
+func (m *PolicyExplanation) GetDecision() string {
+	if m != nil {
+		return m.Decision
+	}
+	return ""
+}
+
+func (m *PolicyExplanation) GetRoles() []*idm.Role {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *PolicyExplanation) GetPolicies() []*idm.PolicyTrace {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
+func (m *PolicyExplanation) GetAcls() []*AclTrace {
+	if m != nil {
+		return m.Acls
+	}
+	return nil
+}
+
+// Preview the members of a dynamic group
+type GroupRulePreviewRequest struct {
+	// Rule on the users attributes, see the groupRule attribute of groups
+	Rule   string `protobuf:"bytes,1,opt,name=Rule" json:"Rule,omitempty"`
+	Limit  int64  `protobuf:"varint,2,opt,name=Limit" json:"Limit,omitempty"`
+	Offset int64  `protobuf:"varint,3,opt,name=Offset" json:"Offset,omitempty"`
+}
+
+func (m *GroupRulePreviewRequest) Reset()                    { *m = GroupRulePreviewRequest{} }
+func (m *GroupRulePreviewRequest) String() string            { return proto.CompactTextString(m) }
+func (*GroupRulePreviewRequest) ProtoMessage()               {}
+func (*GroupRulePreviewRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{57} }
+
+func (m *GroupRulePreviewRequest) GetRule() string {
+	if m != nil {
+		return m.Rule
+	}
+	return ""
+}
+
+func (m *GroupRulePreviewRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GroupRulePreviewRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ResourcePolicyQuery)(nil), "rest.ResourcePolicyQuery")
 	proto.RegisterType((*SearchRoleRequest)(nil), "rest.SearchRoleRequest")
@@ -741,6 +1664,15 @@ func init() {
 	proto.RegisterType((*ACLCollection)(nil), "rest.ACLCollection")
 	proto.RegisterType((*SearchWorkspaceRequest)(nil), "rest.SearchWorkspaceRequest")
 	proto.RegisterType((*WorkspaceCollection)(nil), "rest.WorkspaceCollection")
+	proto.RegisterType((*WorkspaceTemplate)(nil), "rest.WorkspaceTemplate")
+	proto.RegisterType((*WorkspaceTemplateAcl)(nil), "rest.WorkspaceTemplateAcl")
+	proto.RegisterType((*WorkspaceTemplateTarget)(nil), "rest.WorkspaceTemplateTarget")
+	proto.RegisterType((*ListWorkspaceTemplatesRequest)(nil), "rest.ListWorkspaceTemplatesRequest")
+	proto.RegisterType((*WorkspaceTemplateCollection)(nil), "rest.WorkspaceTemplateCollection")
+	proto.RegisterType((*DeleteWorkspaceTemplateRequest)(nil), "rest.DeleteWorkspaceTemplateRequest")
+	proto.RegisterType((*InstantiateWorkspaceTemplateRequest)(nil), "rest.InstantiateWorkspaceTemplateRequest")
+	proto.RegisterType((*SyncWorkspaceTemplateRequest)(nil), "rest.SyncWorkspaceTemplateRequest")
+	proto.RegisterType((*WorkspaceTemplateResponse)(nil), "rest.WorkspaceTemplateResponse")
 	proto.RegisterType((*UserMetaCollection)(nil), "rest.UserMetaCollection")
 	proto.RegisterType((*UserMetaNamespaceCollection)(nil), "rest.UserMetaNamespaceCollection")
 	proto.RegisterType((*ListUserMetaTagsRequest)(nil), "rest.ListUserMetaTagsRequest")
@@ -752,69 +1684,167 @@ func init() {
 	proto.RegisterType((*UserBookmarksRequest)(nil), "rest.UserBookmarksRequest")
 	proto.RegisterType((*RevokeRequest)(nil), "rest.RevokeRequest")
 	proto.RegisterType((*RevokeResponse)(nil), "rest.RevokeResponse")
+	proto.RegisterType((*PersonalAccessTokenRequest)(nil), "rest.PersonalAccessTokenRequest")
+	proto.RegisterType((*PersonalAccessTokenResponse)(nil), "rest.PersonalAccessTokenResponse")
+	proto.RegisterType((*ListPersonalAccessTokensRequest)(nil), "rest.ListPersonalAccessTokensRequest")
+	proto.RegisterType((*PersonalAccessTokenCollection)(nil), "rest.PersonalAccessTokenCollection")
+	proto.RegisterType((*RevokePersonalAccessTokenRequest)(nil), "rest.RevokePersonalAccessTokenRequest")
+	proto.RegisterType((*ListSessionsRequest)(nil), "rest.ListSessionsRequest")
+	proto.RegisterType((*SessionCollection)(nil), "rest.SessionCollection")
+	proto.RegisterType((*RevokeSessionsRequest)(nil), "rest.RevokeSessionsRequest")
+	proto.RegisterType((*RevokeSessionRequest)(nil), "rest.RevokeSessionRequest")
 	proto.RegisterType((*ResetPasswordTokenRequest)(nil), "rest.ResetPasswordTokenRequest")
 	proto.RegisterType((*ResetPasswordTokenResponse)(nil), "rest.ResetPasswordTokenResponse")
 	proto.RegisterType((*ResetPasswordRequest)(nil), "rest.ResetPasswordRequest")
 	proto.RegisterType((*ResetPasswordResponse)(nil), "rest.ResetPasswordResponse")
+	proto.RegisterType((*MfaStatusRequest)(nil), "rest.MfaStatusRequest")
+	proto.RegisterType((*MfaStatusResponse)(nil), "rest.MfaStatusResponse")
+	proto.RegisterType((*WebauthnCredential)(nil), "rest.WebauthnCredential")
+	proto.RegisterType((*TotpEnrollRequest)(nil), "rest.TotpEnrollRequest")
+	proto.RegisterType((*TotpEnrollResponse)(nil), "rest.TotpEnrollResponse")
+	proto.RegisterType((*MfaCodeRequest)(nil), "rest.MfaCodeRequest")
+	proto.RegisterType((*MfaRecoveryCodesResponse)(nil), "rest.MfaRecoveryCodesResponse")
+	proto.RegisterType((*WebauthnBeginRequest)(nil), "rest.WebauthnBeginRequest")
+	proto.RegisterType((*WebauthnOptionsResponse)(nil), "rest.WebauthnOptionsResponse")
+	proto.RegisterType((*WebauthnRegisterRequest)(nil), "rest.WebauthnRegisterRequest")
+	proto.RegisterType((*WebauthnDeleteRequest)(nil), "rest.WebauthnDeleteRequest")
+	proto.RegisterType((*PolicyExplainRequest)(nil), "rest.PolicyExplainRequest")
+	proto.RegisterType((*AclTrace)(nil), "rest.AclTrace")
+	proto.RegisterType((*PolicyExplanation)(nil), "rest.PolicyExplanation")
+	proto.RegisterType((*GroupRulePreviewRequest)(nil), "rest.GroupRulePreviewRequest")
 	proto.RegisterEnum("rest.ResourcePolicyQuery_QueryType", ResourcePolicyQuery_QueryType_name, ResourcePolicyQuery_QueryType_value)
+	proto.RegisterEnum("rest.PolicyExplainRequest_ResourceType", PolicyExplainRequest_ResourceType_name, PolicyExplainRequest_ResourceType_value)
 }
 
 func init() { proto.RegisterFile("idm.proto", fileDescriptor6) }
 
 var fileDescriptor6 = []byte{
-	// 855 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x56, 0xdd, 0x72, 0xdb, 0x44,
-	0x14, 0x46, 0xfe, 0x8d, 0x4e, 0x48, 0x6a, 0x36, 0xa9, 0x51, 0x42, 0x66, 0x30, 0xcb, 0x8d, 0x19,
-	0x06, 0x79, 0x70, 0xa1, 0x85, 0x4b, 0x47, 0xcd, 0x74, 0x3a, 0x75, 0xec, 0xb0, 0x71, 0x06, 0x18,
-	0xae, 0x54, 0xe9, 0xd4, 0xd5, 0x58, 0xd2, 0x1a, 0xad, 0xd4, 0x8e, 0x5f, 0x80, 0xb7, 0xe0, 0x0d,
-	0xb8, 0xe6, 0x6d, 0x78, 0x17, 0x66, 0x57, 0x5a, 0x59, 0x76, 0x5d, 0xcc, 0xcf, 0x0d, 0x33, 0xbd,
-	0xc9, 0xe8, 0x7c, 0xe7, 0x3b, 0x67, 0xbf, 0xf3, 0x1d, 0xaf, 0x14, 0x30, 0x03, 0x3f, 0xb2, 0x97,
-	0x09, 0x4f, 0x39, 0x69, 0x24, 0x28, 0xd2, 0xf3, 0x2f, 0xe7, 0x41, 0xfa, 0x32, 0x7b, 0x6e, 0x7b,
-	0x3c, 0x1a, 0x2c, 0x57, 0x7e, 0xc0, 0x07, 0x1e, 0x86, 0xa1, 0x18, 0x78, 0x3c, 0x8a, 0x78, 0x3c,
-	0x50, 0xd4, 0x41, 0xe0, 0x47, 0x83, 0xb2, 0xf0, 0xfc, 0x9b, 0xbf, 0x2e, 0x11, 0x98, 0xbc, 0x0a,
-	0x3c, 0x2c, 0x4a, 0x73, 0x30, 0xaf, 0xa4, 0xbf, 0x1a, 0x70, 0xc2, 0x50, 0xf0, 0x2c, 0xf1, 0xf0,
-	0x86, 0x87, 0x81, 0xb7, 0xfa, 0x2e, 0xc3, 0x64, 0x45, 0x1e, 0x41, 0x63, 0xb6, 0x5a, 0xa2, 0x65,
-	0xf4, 0x8c, 0xfe, 0xf1, 0xf0, 0x53, 0x5b, 0x2a, 0xb3, 0x77, 0x10, 0x6d, 0xf5, 0x57, 0x52, 0x99,
-	0x2a, 0x20, 0x5d, 0x68, 0xdd, 0x09, 0x4c, 0x9e, 0xfa, 0x56, 0xad, 0x67, 0xf4, 0x4d, 0x56, 0x44,
-	0xf4, 0x6b, 0x30, 0x4b, 0x2a, 0x39, 0x84, 0xb6, 0x33, 0x9d, 0xcc, 0xae, 0x7e, 0x98, 0x75, 0xde,
-	0x23, 0x6d, 0xa8, 0x8f, 0x26, 0x3f, 0x76, 0x0c, 0x72, 0x00, 0x8d, 0xc9, 0x74, 0x72, 0xd5, 0xa9,
-	0xc9, 0xa7, 0xbb, 0xdb, 0x2b, 0xd6, 0xa9, 0xd3, 0xdf, 0x6a, 0xf0, 0xc1, 0x2d, 0xba, 0x89, 0xf7,
-	0x92, 0xf1, 0x10, 0x19, 0xfe, 0x9c, 0xa1, 0x48, 0x89, 0x0d, 0x6d, 0xd9, 0x2c, 0x40, 0x61, 0x19,
-	0xbd, 0x7a, 0xff, 0x70, 0x78, 0x6a, 0x4b, 0x33, 0x24, 0xe5, 0x36, 0x88, 0xe7, 0x21, 0xaa, 0xa3,
-	0x98, 0x26, 0x91, 0x67, 0x3b, 0x87, 0xb4, 0xda, 0x3d, 0xa3, 0x7f, 0x38, 0x3c, 0x7b, 0xeb, 0x70,
-	0x6c, 0xa7, 0x35, 0x5d, 0x68, 0x4d, 0x5f, 0xbc, 0x10, 0x98, 0xaa, 0x09, 0xeb, 0xac, 0x88, 0xc8,
-	0x29, 0x34, 0xc7, 0x41, 0x14, 0xa4, 0x56, 0x5d, 0xc1, 0x79, 0x40, 0x2c, 0x68, 0x3f, 0x49, 0x78,
-	0xb6, 0xbc, 0x5c, 0x59, 0x8d, 0x9e, 0xd1, 0x6f, 0x32, 0x1d, 0x92, 0x0b, 0x30, 0x1d, 0x9e, 0xc5,
-	0xe9, 0x34, 0x0e, 0x57, 0x56, 0xb3, 0x67, 0xf4, 0x0f, 0xd8, 0x1a, 0x20, 0x5f, 0x81, 0x39, 0x5d,
-	0x62, 0xe2, 0xa6, 0x01, 0x8f, 0xad, 0x96, 0xda, 0x42, 0xd7, 0x2e, 0x16, 0x69, 0x97, 0x19, 0x65,
-	0xfc, 0x9a, 0x48, 0x87, 0x70, 0x4f, 0x9a, 0x20, 0x1c, 0x1e, 0x86, 0xe8, 0x49, 0x88, 0x7c, 0x0c,
-	0x4d, 0x05, 0x15, 0x4e, 0x99, 0xa5, 0x53, 0x2c, 0xc7, 0x2b, 0x16, 0xcb, 0x55, 0xed, 0xb1, 0x58,
-	0x52, 0xde, 0x6d, 0x8b, 0x17, 0x70, 0x4f, 0x9a, 0x50, 0xb5, 0xf8, 0x13, 0x68, 0xa9, 0x13, 0x37,
-	0x3d, 0x56, 0x6e, 0x16, 0x09, 0xb9, 0x05, 0x55, 0x65, 0xd5, 0xb6, 0x19, 0x39, 0x2e, 0x47, 0x9b,
-	0xf1, 0xd4, 0x0d, 0xd5, 0x68, 0x4d, 0x96, 0x07, 0xb4, 0x0f, 0xef, 0x5f, 0x06, 0xb1, 0xcf, 0x50,
-	0x2c, 0x79, 0x2c, 0x50, 0x8e, 0x7a, 0x9b, 0x79, 0x1e, 0x0a, 0xa1, 0x6e, 0xe6, 0x01, 0xd3, 0x21,
-	0xfd, 0xc3, 0x80, 0x4e, 0xbe, 0xc5, 0x91, 0x33, 0xd6, 0x4b, 0xfc, 0x62, 0x7b, 0x89, 0x27, 0xea,
-	0xdc, 0x91, 0x33, 0xde, 0xb9, 0xc3, 0xff, 0xb3, 0xed, 0x0e, 0x1c, 0x8d, 0x9c, 0x71, 0xc5, 0xf4,
-	0x0b, 0x68, 0x8c, 0x9c, 0xb1, 0x1e, 0xec, 0x40, 0x0f, 0xc6, 0x14, 0xba, 0xb6, 0xb3, 0x56, 0xb5,
-	0xf3, 0xf7, 0x1a, 0x74, 0x73, 0x93, 0xbe, 0xe7, 0xc9, 0x42, 0x2c, 0x5d, 0xaf, 0x7c, 0xa5, 0x3c,
-	0xd8, 0xb6, 0xea, 0x4c, 0x75, 0x2c, 0x79, 0xef, 0xf6, 0x8f, 0xfe, 0x27, 0x38, 0x29, 0x9d, 0xa8,
-	0xec, 0xc0, 0x06, 0x28, 0x61, 0xed, 0xdb, 0xf1, 0xa6, 0x6f, 0xac, 0xc2, 0x78, 0xcb, 0x56, 0x46,
-	0x40, 0xe4, 0x1d, 0xb8, 0xc6, 0xd4, 0xad, 0xf4, 0xfe, 0x1c, 0x4c, 0x89, 0xf8, 0x6e, 0xea, 0xea,
-	0xd6, 0x47, 0xe5, 0xad, 0x91, 0x19, 0xb6, 0xce, 0xd3, 0x3b, 0xf8, 0x48, 0xc3, 0x13, 0x37, 0xc2,
-	0x6d, 0x9d, 0x0f, 0x01, 0x4a, 0x58, 0x37, 0xeb, 0x6e, 0x34, 0x2b, 0xd3, 0xac, 0xc2, 0xa4, 0x8f,
-	0xe0, 0xc3, 0x71, 0x20, 0x52, 0x4d, 0x9a, 0xb9, 0x73, 0xa1, 0x7f, 0x2f, 0x17, 0x60, 0x96, 0x44,
-	0x75, 0x17, 0x4d, 0xb6, 0x06, 0xa8, 0x0d, 0xd6, 0x9b, 0x85, 0xc5, 0x1d, 0x26, 0xd0, 0x90, 0xb1,
-	0x92, 0x61, 0x32, 0xf5, 0x4c, 0x9f, 0xc0, 0xfd, 0x9b, 0xac, 0x4a, 0xff, 0x5b, 0xc7, 0x90, 0x0e,
-	0xd4, 0x67, 0xee, 0xbc, 0xf8, 0xd2, 0xca, 0x47, 0x3a, 0x84, 0xee, 0x76, 0xa3, 0xbd, 0xaf, 0x8e,
-	0x6b, 0x38, 0x7b, 0x8c, 0x21, 0xa6, 0xf8, 0x8f, 0xe7, 0x2c, 0x67, 0xc9, 0x15, 0xe4, 0xb3, 0x3c,
-	0x84, 0xf3, 0x5d, 0xed, 0xf6, 0xca, 0xe8, 0xc2, 0xa9, 0xac, 0xb8, 0xe4, 0x7c, 0x11, 0xb9, 0xc9,
-	0x42, 0x2b, 0xa0, 0x9f, 0xc1, 0x11, 0xc3, 0x57, 0x7c, 0x51, 0x5e, 0x55, 0x0b, 0xda, 0x33, 0xbe,
-	0xc0, 0xf8, 0xa9, 0x5f, 0x08, 0xd2, 0x21, 0x7d, 0x0c, 0xc7, 0x9a, 0xba, 0xef, 0x38, 0x99, 0xb9,
-	0x46, 0x21, 0xdc, 0x39, 0x16, 0xea, 0x75, 0x48, 0xbf, 0x85, 0x33, 0x86, 0x02, 0xd3, 0x1b, 0x57,
-	0x88, 0xd7, 0x3c, 0xf1, 0x55, 0xf7, 0x8a, 0x1f, 0x52, 0xe5, 0x98, 0xcf, 0x83, 0x58, 0xfb, 0x51,
-	0x02, 0xf4, 0x06, 0xce, 0x77, 0x95, 0xfe, 0x07, 0x31, 0xbf, 0x18, 0x70, 0xba, 0xd1, 0x72, 0xfd,
-	0x81, 0x26, 0x6f, 0x1e, 0x55, 0x28, 0xda, 0x91, 0xd9, 0x14, 0x5e, 0xdb, 0x12, 0x4e, 0x7a, 0x70,
-	0x38, 0xc1, 0xd7, 0xba, 0x42, 0xbd, 0x6a, 0x4c, 0x56, 0x85, 0xe8, 0x33, 0xb8, 0xbf, 0xa5, 0xe3,
-	0xdf, 0x4f, 0xf5, 0xbc, 0xa5, 0xfe, 0xfb, 0x7c, 0xf0, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x08,
-	0xfb, 0x58, 0x69, 0xfd, 0x0a, 0x00, 0x00,
+	// 2023 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x5f, 0x73, 0x1b, 0xb7,
+	0x11, 0xef, 0x91, 0x94, 0x44, 0xae, 0x6c, 0x99, 0x82, 0x64, 0x89, 0xa2, 0xed, 0x98, 0x45, 0x32,
+	0x0d, 0x93, 0xaa, 0xd4, 0x44, 0x4a, 0xec, 0xd4, 0xed, 0x4c, 0x4b, 0x51, 0x9c, 0x8c, 0x63, 0xfd,
+	0x61, 0x41, 0xca, 0x49, 0x27, 0x4f, 0xa7, 0xe3, 0x92, 0xbe, 0xea, 0x74, 0xc7, 0xde, 0x81, 0xb2,
+	0xf9, 0xda, 0x87, 0x7e, 0x81, 0xcc, 0xf4, 0xad, 0x5f, 0xa0, 0xd3, 0xa7, 0x3e, 0xf4, 0xa5, 0xfd,
+	0x00, 0xfd, 0x12, 0x9d, 0x7e, 0x95, 0x0e, 0x70, 0x00, 0x0e, 0x14, 0x29, 0xc9, 0x1e, 0xbf, 0x64,
+	0x26, 0x2f, 0x9c, 0xdb, 0xc5, 0x2e, 0xb0, 0xfb, 0xdb, 0x5d, 0x2c, 0x00, 0x42, 0xc9, 0xef, 0x5f,
+	0x34, 0x46, 0x71, 0xc4, 0x23, 0x52, 0x88, 0x31, 0xe1, 0xd5, 0xcf, 0x86, 0x3e, 0x7f, 0x35, 0x3e,
+	0x6b, 0x78, 0xd1, 0xc5, 0xce, 0x68, 0xd2, 0xf7, 0xa3, 0x1d, 0x0f, 0x83, 0x20, 0xd9, 0xf1, 0xa2,
+	0x8b, 0x8b, 0x28, 0xdc, 0x91, 0xa2, 0x3b, 0x7e, 0xff, 0x62, 0xc7, 0x28, 0x56, 0xf7, 0xde, 0x46,
+	0xc5, 0x1d, 0xf3, 0x57, 0xf2, 0x47, 0x29, 0x7d, 0x79, 0xb3, 0x52, 0x82, 0xf1, 0xa5, 0xef, 0xa1,
+	0x52, 0x4e, 0x99, 0xa9, 0x26, 0xfd, 0xab, 0x03, 0x6b, 0x0c, 0x93, 0x68, 0x1c, 0x7b, 0xd8, 0x89,
+	0x02, 0xdf, 0x9b, 0xfc, 0x6e, 0x8c, 0xf1, 0x84, 0x3c, 0x85, 0x42, 0x6f, 0x32, 0xc2, 0x8a, 0x53,
+	0x73, 0xea, 0x2b, 0xbb, 0x1f, 0x36, 0x84, 0x3b, 0x8d, 0x39, 0x82, 0x0d, 0xf9, 0x2b, 0x44, 0x99,
+	0x54, 0x20, 0x1b, 0xb0, 0x78, 0x9a, 0x60, 0xfc, 0xbc, 0x5f, 0xc9, 0xd5, 0x9c, 0x7a, 0x89, 0x29,
+	0x8a, 0x7e, 0x01, 0x25, 0x23, 0x4a, 0x96, 0x61, 0xa9, 0x75, 0x72, 0xdc, 0x6b, 0x7f, 0xdb, 0x2b,
+	0xff, 0x84, 0x2c, 0x41, 0xbe, 0x79, 0xfc, 0xfb, 0xb2, 0x43, 0x8a, 0x50, 0x38, 0x3e, 0x39, 0x6e,
+	0x97, 0x73, 0xe2, 0xeb, 0xb4, 0xdb, 0x66, 0xe5, 0x3c, 0xfd, 0x7b, 0x0e, 0x56, 0xbb, 0xe8, 0xc6,
+	0xde, 0x2b, 0x16, 0x05, 0xc8, 0xf0, 0x8f, 0x63, 0x4c, 0x38, 0x69, 0xc0, 0x92, 0x98, 0xcc, 0xc7,
+	0xa4, 0xe2, 0xd4, 0xf2, 0xf5, 0xe5, 0xdd, 0xf5, 0x86, 0x40, 0x50, 0x88, 0x74, 0xfd, 0x70, 0x18,
+	0xa0, 0x5c, 0x8a, 0x69, 0x21, 0xf2, 0x62, 0xae, 0x93, 0x95, 0xa5, 0x9a, 0x53, 0x5f, 0xde, 0xdd,
+	0xba, 0xd6, 0x39, 0x36, 0x17, 0x9a, 0x0d, 0x58, 0x3c, 0x19, 0x0c, 0x12, 0xe4, 0xd2, 0xc3, 0x3c,
+	0x53, 0x14, 0x59, 0x87, 0x85, 0x43, 0xff, 0xc2, 0xe7, 0x95, 0xbc, 0x64, 0xa7, 0x04, 0xa9, 0xc0,
+	0xd2, 0x57, 0x71, 0x34, 0x1e, 0xed, 0x4f, 0x2a, 0x85, 0x9a, 0x53, 0x5f, 0x60, 0x9a, 0x24, 0x0f,
+	0xa1, 0xd4, 0x8a, 0xc6, 0x21, 0x3f, 0x09, 0x83, 0x49, 0x65, 0xa1, 0xe6, 0xd4, 0x8b, 0x2c, 0x63,
+	0x90, 0xcf, 0xa1, 0x74, 0x32, 0xc2, 0xd8, 0xe5, 0x7e, 0x14, 0x56, 0x16, 0x65, 0x14, 0x36, 0x1a,
+	0x2a, 0x90, 0x0d, 0x33, 0x22, 0x81, 0xcf, 0x04, 0xe9, 0x2e, 0xdc, 0x13, 0x20, 0x24, 0xad, 0x28,
+	0x08, 0xd0, 0x13, 0x2c, 0xf2, 0x18, 0x16, 0x24, 0x4b, 0x21, 0x55, 0x32, 0x48, 0xb1, 0x94, 0x6f,
+	0x41, 0x2c, 0x42, 0x75, 0x0b, 0xc4, 0x42, 0xe4, 0xc7, 0x0d, 0xf1, 0x39, 0xdc, 0x13, 0x20, 0xd8,
+	0x10, 0xff, 0x14, 0x16, 0xe5, 0x8a, 0xd3, 0x18, 0x4b, 0x34, 0xd5, 0x80, 0x88, 0x82, 0xd4, 0xaa,
+	0xe4, 0xae, 0x4a, 0xa4, 0x7c, 0xe1, 0x5a, 0x2f, 0xe2, 0x6e, 0x20, 0x5d, 0x5b, 0x60, 0x29, 0x41,
+	0xeb, 0x70, 0x67, 0xdf, 0x0f, 0xfb, 0x0c, 0x93, 0x51, 0x14, 0x26, 0x28, 0x5c, 0xed, 0x8e, 0x3d,
+	0x0f, 0x93, 0x44, 0x56, 0x66, 0x91, 0x69, 0x92, 0xfe, 0xd7, 0x81, 0x72, 0x1a, 0xc5, 0x66, 0xeb,
+	0x50, 0x07, 0xf1, 0x17, 0x57, 0x83, 0xb8, 0x26, 0xd7, 0x6d, 0xb6, 0x0e, 0xe7, 0xc6, 0xf0, 0x87,
+	0x0c, 0x7b, 0x0b, 0xee, 0x36, 0x5b, 0x87, 0x16, 0xe8, 0x0f, 0xa1, 0xd0, 0x6c, 0x1d, 0x6a, 0xc7,
+	0x8a, 0xda, 0x31, 0x26, 0xb9, 0x19, 0x9c, 0x39, 0x1b, 0xce, 0x7f, 0xe6, 0x60, 0x23, 0x05, 0xe9,
+	0x9b, 0x28, 0x3e, 0x4f, 0x46, 0xae, 0x67, 0xb6, 0x94, 0xbd, 0xab, 0x50, 0x6d, 0xc9, 0x19, 0x8d,
+	0xdc, 0x8f, 0x3b, 0xe9, 0xbf, 0x83, 0x35, 0x83, 0x84, 0x15, 0x83, 0x06, 0x80, 0x61, 0x6b, 0xdc,
+	0x56, 0xa6, 0x71, 0x63, 0x96, 0xc4, 0x35, 0x51, 0xf9, 0x3e, 0x07, 0xab, 0x46, 0xa8, 0x87, 0x17,
+	0xa3, 0xc0, 0xe5, 0x48, 0x08, 0x14, 0x4e, 0xc7, 0x7e, 0x5f, 0xe6, 0x79, 0x89, 0xc9, 0x6f, 0x09,
+	0x85, 0x7b, 0x86, 0x81, 0xea, 0x2d, 0x29, 0x41, 0x6a, 0xb0, 0x7c, 0x80, 0x89, 0x17, 0xfb, 0x23,
+	0xe9, 0x54, 0x5e, 0x8e, 0xd9, 0x2c, 0xb2, 0x0d, 0x25, 0xb3, 0x80, 0x84, 0x6b, 0xd6, 0xcc, 0x4c,
+	0x80, 0x7c, 0x0a, 0x65, 0x16, 0x45, 0xbc, 0xe3, 0xf2, 0x57, 0x1d, 0x97, 0x73, 0x8c, 0xc3, 0xa4,
+	0xb2, 0x50, 0xcb, 0xd7, 0x4b, 0x6c, 0x86, 0x4f, 0x9e, 0x01, 0x34, 0xbd, 0xa0, 0x13, 0x63, 0x82,
+	0x3c, 0xa9, 0x2c, 0x4a, 0x04, 0xaa, 0x69, 0xe0, 0x67, 0x5c, 0x6a, 0x7a, 0x01, 0xb3, 0xa4, 0x45,
+	0xa0, 0x4e, 0x47, 0x7d, 0x97, 0x63, 0xbf, 0xc9, 0x65, 0xce, 0xe4, 0x59, 0xc6, 0xa0, 0xdf, 0xc2,
+	0xfa, 0xbc, 0x19, 0x44, 0x9a, 0x88, 0x7d, 0xfb, 0xb9, 0x46, 0x46, 0x51, 0xa4, 0x0e, 0x4b, 0x4d,
+	0x19, 0x15, 0xbd, 0xc7, 0xac, 0xe8, 0x92, 0x48, 0xd9, 0x4c, 0x0f, 0xd3, 0xff, 0x39, 0xb0, 0x39,
+	0x33, 0x75, 0xcf, 0x8d, 0x87, 0xc8, 0xa5, 0x4d, 0x09, 0xc6, 0x87, 0xd1, 0xd0, 0x0f, 0xd5, 0x02,
+	0x19, 0x43, 0x8c, 0xca, 0x2c, 0x13, 0x10, 0xa8, 0x18, 0x64, 0x0c, 0xf2, 0x35, 0x94, 0x5e, 0xba,
+	0xb1, 0xef, 0x9e, 0x89, 0x6e, 0x93, 0x97, 0x36, 0x6c, 0x5f, 0x03, 0x45, 0xba, 0x5a, 0xc3, 0x88,
+	0xb7, 0x43, 0x1e, 0x4f, 0x58, 0xa6, 0x5e, 0xfd, 0x35, 0xac, 0x4c, 0x0f, 0x92, 0x32, 0xe4, 0xcf,
+	0x71, 0xa2, 0x6c, 0x12, 0x9f, 0x22, 0x1b, 0x2e, 0xdd, 0x60, 0x8c, 0x3a, 0x1b, 0x24, 0xf1, 0x2c,
+	0xf7, 0xa5, 0x43, 0x1f, 0xc3, 0xa3, 0x43, 0x3f, 0xe1, 0x33, 0xcb, 0x26, 0xaa, 0xda, 0x69, 0x0f,
+	0x1e, 0xcc, 0x0c, 0x5a, 0x79, 0xfd, 0x05, 0x94, 0x8c, 0x8a, 0x4a, 0xeb, 0xcd, 0x6b, 0x3c, 0x61,
+	0x99, 0x24, 0xfd, 0x1c, 0x3e, 0x38, 0xc0, 0x00, 0x39, 0xce, 0x4a, 0xa9, 0x5d, 0x66, 0x4e, 0x52,
+	0xd3, 0x3f, 0x39, 0xf0, 0xe1, 0xf3, 0x30, 0xe1, 0x6e, 0xc8, 0x7d, 0xf7, 0x06, 0x5d, 0x0a, 0x77,
+	0x34, 0xcb, 0x9a, 0x63, 0x8a, 0x47, 0x9e, 0xc2, 0x52, 0x0a, 0xad, 0x4e, 0x82, 0x47, 0x37, 0x06,
+	0x80, 0x69, 0x69, 0xba, 0x0f, 0x0f, 0xbb, 0x93, 0xd0, 0x7b, 0x9f, 0xc5, 0xe9, 0x5f, 0x1c, 0xd8,
+	0x9a, 0x33, 0x81, 0x6a, 0x5d, 0x7b, 0x50, 0xd4, 0x3c, 0xa9, 0x7d, 0x03, 0xa4, 0x46, 0x50, 0x14,
+	0x6e, 0x0a, 0x8d, 0x87, 0xda, 0xa3, 0x99, 0xc2, 0x35, 0x02, 0xa2, 0x34, 0xda, 0x71, 0x1c, 0xc5,
+	0x69, 0xf6, 0x95, 0x98, 0xa2, 0x68, 0x13, 0x88, 0xc8, 0xe1, 0x23, 0xe4, 0xae, 0x15, 0xe4, 0x9f,
+	0x43, 0x49, 0x70, 0xfa, 0x2e, 0x77, 0x75, 0x90, 0xef, 0x9a, 0xb6, 0x2c, 0x46, 0x58, 0x36, 0x4e,
+	0x4f, 0xe1, 0x81, 0x66, 0x1f, 0xbb, 0x17, 0x78, 0x75, 0x23, 0x7c, 0x02, 0x60, 0xd8, 0x7a, 0xb2,
+	0x8d, 0xa9, 0xc9, 0xcc, 0x30, 0xb3, 0x24, 0xe9, 0x53, 0xd8, 0x14, 0x89, 0xaa, 0x85, 0x7a, 0xee,
+	0x50, 0xa7, 0xa8, 0xa8, 0x35, 0x23, 0xa8, 0x2b, 0xd1, 0x30, 0x68, 0x03, 0x2a, 0xb3, 0x8a, 0x0a,
+	0x69, 0x02, 0x05, 0x41, 0x4b, 0x33, 0x4a, 0x4c, 0x7e, 0xd3, 0xaf, 0xe0, 0x7e, 0x67, 0x6c, 0x8b,
+	0xbf, 0xd5, 0x32, 0xa2, 0xe8, 0x7a, 0xee, 0x50, 0x15, 0x98, 0xf8, 0xa4, 0xbb, 0xb0, 0x71, 0x75,
+	0xa2, 0x5b, 0xcf, 0x26, 0x47, 0xb0, 0x95, 0xd6, 0xc5, 0x3b, 0xfb, 0x69, 0x7c, 0x49, 0x2d, 0x48,
+	0x7d, 0x79, 0x02, 0xd5, 0x79, 0xd3, 0xdd, 0x6a, 0xc6, 0x06, 0xac, 0x0b, 0x8d, 0xfd, 0x28, 0x3a,
+	0xbf, 0x70, 0xe3, 0x73, 0xb3, 0x19, 0x7c, 0x02, 0x77, 0x19, 0x5e, 0x46, 0xe7, 0x26, 0xd9, 0x2b,
+	0xb0, 0xd4, 0x8b, 0xce, 0x31, 0x34, 0x7b, 0xac, 0x26, 0xe9, 0x01, 0xac, 0x68, 0xd1, 0xdb, 0x96,
+	0x13, 0x23, 0x47, 0x98, 0x24, 0xee, 0x50, 0x6f, 0x50, 0x9a, 0xa4, 0xff, 0x70, 0xa0, 0xda, 0xc1,
+	0x38, 0x89, 0x42, 0x37, 0x68, 0x4a, 0x61, 0x39, 0xbf, 0x5e, 0xde, 0x74, 0x39, 0xc7, 0xee, 0x72,
+	0x0f, 0xa1, 0xd4, 0x7e, 0x33, 0xf2, 0x63, 0x4c, 0x9a, 0xfa, 0x84, 0x90, 0x31, 0xc8, 0x07, 0x53,
+	0x9d, 0x38, 0x4d, 0x7f, 0x8b, 0x43, 0xaa, 0x50, 0x64, 0xe8, 0xf6, 0xe5, 0x99, 0xa0, 0x20, 0xed,
+	0x34, 0x34, 0xf9, 0x48, 0xf8, 0x9f, 0x70, 0x7d, 0x26, 0xd1, 0xcd, 0x6e, 0x9a, 0x49, 0xfb, 0xf0,
+	0x60, 0xae, 0xcd, 0x0a, 0x87, 0x1d, 0xd1, 0xda, 0xcf, 0x31, 0x54, 0xb5, 0xbd, 0xd5, 0x90, 0xd7,
+	0xd3, 0x79, 0x1a, 0xa9, 0x9c, 0xf0, 0xf2, 0xa5, 0xbd, 0x7b, 0x4b, 0x82, 0xfe, 0x06, 0x1e, 0x8b,
+	0xbc, 0x9e, 0xa3, 0x67, 0x27, 0xcc, 0xf5, 0x2d, 0x8a, 0x32, 0x78, 0x34, 0x47, 0xd9, 0x2a, 0xd5,
+	0xcf, 0x60, 0x31, 0x9d, 0xcf, 0x9c, 0xf3, 0xae, 0xb5, 0x54, 0x09, 0xd2, 0x27, 0x50, 0x4b, 0xa3,
+	0x7e, 0x43, 0xd0, 0xe6, 0xed, 0xec, 0x7b, 0xb0, 0x26, 0x9c, 0xe9, 0x62, 0x92, 0x88, 0xc6, 0xfb,
+	0x76, 0x0e, 0x0c, 0x60, 0x55, 0x29, 0x58, 0x46, 0x7f, 0x02, 0x45, 0x3d, 0x8b, 0xd9, 0xaa, 0xa4,
+	0xd9, 0x8a, 0xcb, 0xcc, 0x30, 0xf9, 0x19, 0xac, 0xb4, 0xc6, 0x71, 0x8c, 0xa1, 0x5e, 0x57, 0x01,
+	0x7c, 0x85, 0x4b, 0xbf, 0x81, 0xfb, 0xa9, 0x53, 0xef, 0x64, 0x9e, 0x38, 0x6c, 0xbd, 0x40, 0x1c,
+	0xa9, 0xc9, 0xe4, 0xdc, 0x45, 0x66, 0xb3, 0xe8, 0xa7, 0xb0, 0x3e, 0x35, 0xf1, 0x4d, 0x08, 0xfd,
+	0x12, 0xb6, 0x18, 0x26, 0xc8, 0x3b, 0x6e, 0x92, 0xbc, 0x8e, 0xe2, 0xfe, 0x14, 0xa4, 0x37, 0xe3,
+	0xd4, 0x81, 0xea, 0x3c, 0xd5, 0xf7, 0x28, 0xcb, 0x3f, 0x3b, 0xb0, 0x3e, 0x35, 0x65, 0x76, 0x17,
+	0x26, 0xb3, 0x4b, 0x29, 0x8b, 0xe6, 0x8c, 0x4c, 0x1b, 0x9e, 0x9b, 0x83, 0xe0, 0x31, 0xbe, 0xd6,
+	0x1a, 0xfa, 0xb8, 0x6a, 0xb1, 0xe8, 0x0b, 0xb8, 0x3f, 0x35, 0xeb, 0x7b, 0x79, 0x45, 0xa0, 0x7c,
+	0x34, 0x70, 0xbb, 0xdc, 0xe5, 0x63, 0xb3, 0xe3, 0xfd, 0xcb, 0x81, 0x55, 0x8b, 0xa9, 0x66, 0xaf,
+	0xc1, 0x72, 0x2f, 0xe2, 0xa3, 0x76, 0x28, 0x4e, 0x5d, 0x7d, 0xb5, 0x82, 0xcd, 0x12, 0xbb, 0x48,
+	0x3b, 0x1c, 0x44, 0xb1, 0x87, 0x7d, 0x15, 0x79, 0x43, 0x93, 0x6d, 0x58, 0x65, 0xe8, 0x45, 0x97,
+	0x18, 0x4f, 0x5a, 0x51, 0x1f, 0x93, 0x43, 0x1c, 0x70, 0x75, 0x99, 0x9d, 0x1d, 0x20, 0xcf, 0x60,
+	0xb9, 0x15, 0x63, 0x1f, 0xc5, 0x99, 0x27, 0x48, 0x2a, 0x05, 0x99, 0xd3, 0x15, 0x75, 0x20, 0xc0,
+	0x33, 0x91, 0xdb, 0x61, 0x26, 0xc0, 0x6c, 0x61, 0x1a, 0x02, 0x99, 0x15, 0x21, 0x2b, 0x90, 0x33,
+	0xfb, 0x75, 0xee, 0x79, 0x5f, 0xa4, 0x9b, 0x68, 0x23, 0xba, 0x73, 0x88, 0x6f, 0x81, 0x52, 0x2b,
+	0x46, 0x71, 0xc0, 0x56, 0x97, 0x29, 0x4d, 0x0a, 0xcf, 0x0e, 0x5d, 0xd9, 0x4f, 0xfb, 0x72, 0x7f,
+	0xcc, 0x33, 0x43, 0xd3, 0x35, 0x58, 0x4d, 0x41, 0x88, 0xa3, 0x20, 0xd0, 0x10, 0xbe, 0x04, 0x62,
+	0x33, 0x15, 0x84, 0x1b, 0xb0, 0xd8, 0x45, 0x2f, 0x46, 0xae, 0x0f, 0xe7, 0x29, 0x45, 0xea, 0x70,
+	0xaf, 0x13, 0x47, 0x97, 0xbe, 0xa8, 0x07, 0x3f, 0x1c, 0x9e, 0xc6, 0xbe, 0xb2, 0xeb, 0x2a, 0x9b,
+	0x7e, 0x04, 0x2b, 0x47, 0x03, 0x57, 0x00, 0x65, 0xd5, 0x8d, 0x20, 0x75, 0xdd, 0x88, 0x6f, 0xfa,
+	0x5b, 0xa8, 0x1c, 0x0d, 0xdc, 0x29, 0x58, 0x8d, 0x0d, 0x72, 0x3b, 0xb7, 0x06, 0xd4, 0x39, 0x60,
+	0x9a, 0x49, 0xb7, 0x61, 0x5d, 0x83, 0xb8, 0x8f, 0x43, 0x7f, 0xaa, 0xf9, 0x58, 0x05, 0x97, 0x12,
+	0x74, 0x0f, 0x36, 0xb5, 0xf4, 0x89, 0xbc, 0x52, 0x4d, 0xf5, 0x5b, 0xc5, 0xd2, 0xcd, 0x52, 0x91,
+	0xf4, 0x7b, 0x27, 0xd3, 0x62, 0x38, 0xf4, 0x13, 0x9e, 0x3d, 0x2f, 0xe9, 0xe8, 0x38, 0x56, 0x74,
+	0xd2, 0x08, 0xe6, 0x4c, 0x04, 0xc5, 0x4e, 0x16, 0xf8, 0x18, 0xf2, 0x03, 0x97, 0xbb, 0x5f, 0x77,
+	0x4f, 0x8e, 0x55, 0xad, 0x5c, 0xe1, 0x8a, 0xcc, 0x6b, 0x72, 0x8e, 0x09, 0x97, 0x77, 0xd5, 0x93,
+	0xb3, 0x3f, 0xa0, 0xc7, 0x65, 0x10, 0x4b, 0x6c, 0x76, 0x80, 0x7e, 0x0c, 0xf7, 0xb5, 0x51, 0xe9,
+	0x29, 0x42, 0x9b, 0x74, 0x25, 0x81, 0xe8, 0x7f, 0x72, 0xb0, 0x9e, 0xde, 0xcf, 0xdb, 0x6f, 0x46,
+	0x81, 0xeb, 0xbf, 0xdd, 0xbe, 0x44, 0x7e, 0xa5, 0x5e, 0x4e, 0x73, 0xf2, 0x6e, 0xfd, 0x71, 0x9a,
+	0xd2, 0xf3, 0xe6, 0x31, 0x2f, 0x03, 0xd6, 0xeb, 0xa9, 0x6c, 0xd3, 0x29, 0x57, 0x39, 0x6b, 0x68,
+	0x91, 0x5b, 0xe9, 0x0d, 0x4e, 0xf9, 0xa6, 0x28, 0xd2, 0x84, 0xa5, 0x56, 0x14, 0x72, 0x7c, 0xc3,
+	0x65, 0xe3, 0x5e, 0xbe, 0x71, 0x4d, 0x25, 0x99, 0xde, 0xb7, 0xb4, 0x5e, 0xf5, 0x19, 0xdc, 0xb1,
+	0x07, 0xde, 0xe9, 0xae, 0x45, 0xe1, 0x8e, 0xed, 0x88, 0x78, 0xbb, 0x65, 0xed, 0xae, 0x78, 0xd8,
+	0x95, 0xef, 0xb9, 0x07, 0xed, 0xb2, 0x43, 0xff, 0xe6, 0x40, 0xb1, 0xe9, 0x05, 0xbd, 0x58, 0x1c,
+	0xe9, 0xaa, 0x90, 0x6f, 0x7a, 0x81, 0x3a, 0x27, 0x64, 0xef, 0x36, 0x82, 0x29, 0xfc, 0x3f, 0x8e,
+	0xfa, 0x68, 0xdd, 0x2f, 0x0d, 0x2d, 0x0f, 0x40, 0x83, 0x81, 0x68, 0x88, 0x97, 0x29, 0x38, 0x45,
+	0x96, 0x31, 0x84, 0xe6, 0x01, 0x7a, 0x7e, 0x22, 0x06, 0xd5, 0x01, 0x47, 0xd3, 0x64, 0x1b, 0x8a,
+	0x12, 0x0c, 0x5f, 0x9d, 0x6d, 0x96, 0x77, 0xcb, 0x72, 0xd9, 0x14, 0x21, 0x69, 0x15, 0x33, 0x12,
+	0xf4, 0xdf, 0x0e, 0xac, 0x5a, 0xd8, 0x85, 0x32, 0x79, 0x44, 0x9a, 0x37, 0x83, 0x20, 0x7a, 0x6d,
+	0x36, 0x46, 0x4d, 0x66, 0x2b, 0x9b, 0x56, 0x6b, 0xe8, 0xec, 0xf1, 0x35, 0x3f, 0xff, 0xf1, 0x75,
+	0xca, 0xb4, 0xc2, 0x6d, 0xa6, 0x11, 0x0a, 0x85, 0xa6, 0x17, 0x68, 0x27, 0x56, 0xd2, 0x38, 0x6b,
+	0x60, 0x99, 0x1c, 0xa3, 0xdf, 0xc1, 0xa6, 0xbc, 0x92, 0xb3, 0x71, 0x80, 0x9d, 0x18, 0x2f, 0x7d,
+	0x7c, 0x6d, 0x15, 0x9d, 0xe0, 0xea, 0xa2, 0x13, 0xdf, 0xd9, 0xeb, 0x52, 0xce, 0x7e, 0x5d, 0xca,
+	0xde, 0xa2, 0xf2, 0xf6, 0x5b, 0xd4, 0xd9, 0xa2, 0xfc, 0xd7, 0x60, 0xef, 0xff, 0x03, 0x00, 0xab,
+	0x97, 0x2e, 0x52, 0xea, 0x18, 0x00, 0x00,
 }
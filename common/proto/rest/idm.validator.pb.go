@@ -7,6 +7,7 @@ import github_com_mwitkow_go_proto_validators "github.com/mwitkow/go-proto-valid
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import _ "github.com/pydio/cells/common/proto/auth"
 import _ "github.com/pydio/cells/common/proto/idm"
 import _ "github.com/pydio/cells/common/service/proto"
 
@@ -123,6 +124,79 @@ func (this *WorkspaceCollection) Validate() error {
 	}
 	return nil
 }
+func (this *WorkspaceTemplate) Validate() error {
+	if this.Workspace != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Workspace); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Workspace", err)
+		}
+	}
+	for _, item := range this.AclPresets {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("AclPresets", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *WorkspaceTemplateAcl) Validate() error {
+	for _, item := range this.Actions {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Actions", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *WorkspaceTemplateTarget) Validate() error {
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+func (this *ListWorkspaceTemplatesRequest) Validate() error {
+	return nil
+}
+func (this *WorkspaceTemplateCollection) Validate() error {
+	for _, item := range this.Templates {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Templates", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *DeleteWorkspaceTemplateRequest) Validate() error {
+	return nil
+}
+func (this *InstantiateWorkspaceTemplateRequest) Validate() error {
+	for _, item := range this.Targets {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Targets", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *SyncWorkspaceTemplateRequest) Validate() error {
+	return nil
+}
+func (this *WorkspaceTemplateResponse) Validate() error {
+	if this.Template != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Template); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Template", err)
+		}
+	}
+	for _, item := range this.Instances {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Instances", err)
+			}
+		}
+	}
+	return nil
+}
 func (this *UserMetaCollection) Validate() error {
 	for _, item := range this.Metadatas {
 		if item != nil {
@@ -170,6 +244,52 @@ func (this *RevokeRequest) Validate() error {
 func (this *RevokeResponse) Validate() error {
 	return nil
 }
+func (this *PersonalAccessTokenRequest) Validate() error {
+	return nil
+}
+func (this *PersonalAccessTokenResponse) Validate() error {
+	if this.Token != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Token); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Token", err)
+		}
+	}
+	return nil
+}
+func (this *ListPersonalAccessTokensRequest) Validate() error {
+	return nil
+}
+func (this *PersonalAccessTokenCollection) Validate() error {
+	for _, item := range this.Tokens {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Tokens", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *RevokePersonalAccessTokenRequest) Validate() error {
+	return nil
+}
+func (this *ListSessionsRequest) Validate() error {
+	return nil
+}
+func (this *SessionCollection) Validate() error {
+	for _, item := range this.Sessions {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Sessions", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *RevokeSessionsRequest) Validate() error {
+	return nil
+}
+func (this *RevokeSessionRequest) Validate() error {
+	return nil
+}
 func (this *ResetPasswordTokenRequest) Validate() error {
 	return nil
 }
@@ -182,3 +302,89 @@ func (this *ResetPasswordRequest) Validate() error {
 func (this *ResetPasswordResponse) Validate() error {
 	return nil
 }
+func (this *MfaStatusRequest) Validate() error {
+	return nil
+}
+func (this *MfaStatusResponse) Validate() error {
+	for _, item := range this.Credentials {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Credentials", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *WebauthnCredential) Validate() error {
+	return nil
+}
+func (this *TotpEnrollRequest) Validate() error {
+	return nil
+}
+func (this *TotpEnrollResponse) Validate() error {
+	return nil
+}
+func (this *MfaCodeRequest) Validate() error {
+	return nil
+}
+func (this *MfaRecoveryCodesResponse) Validate() error {
+	return nil
+}
+func (this *WebauthnBeginRequest) Validate() error {
+	return nil
+}
+func (this *WebauthnOptionsResponse) Validate() error {
+	return nil
+}
+func (this *WebauthnRegisterRequest) Validate() error {
+	return nil
+}
+func (this *WebauthnDeleteRequest) Validate() error {
+	return nil
+}
+func (this *PolicyExplainRequest) Validate() error {
+	// Validation of proto3 map<> fields is unsupported.
+	return nil
+}
+func (this *AclTrace) Validate() error {
+	if this.Acl != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Acl); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Acl", err)
+		}
+	}
+	for _, item := range this.Policies {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Policies", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *PolicyExplanation) Validate() error {
+	for _, item := range this.Roles {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Roles", err)
+			}
+		}
+	}
+	for _, item := range this.Policies {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Policies", err)
+			}
+		}
+	}
+	for _, item := range this.Acls {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Acls", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *GroupRulePreviewRequest) Validate() error {
+	return nil
+}
@@ -17,6 +17,8 @@ import _ "github.com/pydio/cells/common/proto/object"
 import _ "github.com/pydio/cells/common/proto/install"
 import _ "github.com/pydio/cells/common/proto/ctl"
 import _ "github.com/pydio/cells/common/proto/update"
+import _ "github.com/pydio/cells/common/proto/chat"
+import _ "github.com/pydio/cells/common/proto/presence"
 import _ "google.golang.org/genproto/googleapis/api/annotations"
 import _ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
 
@@ -28,214 +30,295 @@ var _ = math.Inf
 func init() { proto.RegisterFile("rest.proto", fileDescriptor7) }
 
 var fileDescriptor7 = []byte{
-	// 3334 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x5a, 0xdd, 0x6f, 0x1d, 0x47,
-	0x15, 0x97, 0x9d, 0x34, 0x89, 0xc7, 0xf7, 0xda, 0xce, 0xd8, 0x89, 0x93, 0x75, 0x92, 0x3a, 0xdb,
-	0x50, 0x90, 0xc1, 0x77, 0x5b, 0x57, 0xd0, 0x36, 0x2f, 0x70, 0xe3, 0x24, 0x6e, 0x52, 0xa7, 0xbd,
-	0xf8, 0x3a, 0x69, 0x69, 0x5a, 0x95, 0xbd, 0x7b, 0xc7, 0x7b, 0x37, 0xde, 0xbb, 0xb3, 0xdd, 0x99,
-	0x75, 0x6a, 0x59, 0xe6, 0xa1, 0x08, 0x21, 0x5e, 0x81, 0x87, 0x8a, 0x3f, 0x06, 0x89, 0x47, 0x2a,
-	0x5e, 0x10, 0x48, 0x08, 0x89, 0x17, 0x24, 0xf8, 0x3f, 0xd0, 0x9c, 0xf9, 0xd8, 0xd9, 0x0f, 0x7f,
-	0x95, 0x87, 0xc4, 0x77, 0xcf, 0x39, 0xf3, 0xfb, 0x9d, 0x39, 0xf3, 0x75, 0xe6, 0xec, 0x22, 0x94,
-	0x11, 0xc6, 0x3b, 0x69, 0x46, 0x39, 0xc5, 0xe7, 0xc5, 0x6f, 0xa7, 0x15, 0xd0, 0xf1, 0x98, 0x26,
-	0x52, 0xe6, 0xa0, 0xa1, 0xcf, 0x7d, 0xf5, 0x7b, 0x2a, 0x1a, 0x8e, 0xd5, 0xcf, 0xd6, 0x20, 0xa3,
-	0xbb, 0x24, 0xd3, 0x4f, 0x01, 0x4d, 0x76, 0xa2, 0x50, 0x3d, 0xcd, 0xb2, 0x60, 0x44, 0x86, 0x79,
-	0x6c, 0xd4, 0xd3, 0x61, 0xe6, 0xa7, 0x23, 0xfd, 0xc0, 0x46, 0x7e, 0x46, 0xd4, 0xc3, 0xcc, 0x4e,
-	0x46, 0x13, 0x4e, 0x92, 0xa1, 0x6e, 0xca, 0xc9, 0x38, 0x8d, 0x7d, 0x4e, 0x98, 0x12, 0xbc, 0x15,
-	0x46, 0x7c, 0x94, 0x0f, 0x3a, 0x01, 0x1d, 0x7b, 0xe9, 0xfe, 0x30, 0xa2, 0x5e, 0x40, 0xe2, 0x98,
-	0x79, 0xd2, 0x47, 0x0f, 0x8c, 0x3c, 0x9e, 0x11, 0x02, 0xff, 0xa9, 0x46, 0x6f, 0x9e, 0xa6, 0x51,
-	0x34, 0x1c, 0x7b, 0x45, 0x7f, 0xde, 0x3e, 0x4d, 0x93, 0xb1, 0x1f, 0xc5, 0x24, 0x53, 0x7f, 0x54,
-	0xc3, 0xee, 0x69, 0x1a, 0xfa, 0x01, 0x8f, 0xf6, 0x22, 0xbe, 0x6f, 0x7e, 0x30, 0x9e, 0x11, 0x7f,
-	0x7c, 0x96, 0x3e, 0xbe, 0xa0, 0x03, 0x06, 0xff, 0xa9, 0x46, 0x3f, 0x3e, 0x4d, 0x23, 0x92, 0x04,
-	0xd9, 0x7e, 0xca, 0x23, 0x9a, 0x58, 0x3f, 0xcf, 0x12, 0xa4, 0x98, 0x86, 0xe2, 0xdf, 0x59, 0x82,
-	0x44, 0x07, 0x2f, 0x48, 0xc0, 0xd5, 0x1f, 0xd5, 0xf0, 0xdd, 0x53, 0x0d, 0x48, 0xc2, 0xb8, 0x1f,
-	0xc7, 0xfa, 0xef, 0x59, 0xdc, 0x0c, 0x78, 0x2c, 0xfe, 0x9d, 0xc5, 0xcd, 0x3c, 0x1d, 0xfa, 0x9c,
-	0xa8, 0x3f, 0xaa, 0xe1, 0x8d, 0x90, 0xd2, 0x30, 0x26, 0x9e, 0x9f, 0x46, 0x9e, 0x9f, 0x24, 0x94,
-	0xfb, 0x22, 0x5e, 0x3a, 0xe2, 0x3f, 0x80, 0x3f, 0xc1, 0x6a, 0x48, 0x92, 0x55, 0xf6, 0xd2, 0x0f,
-	0x43, 0x92, 0x79, 0x14, 0x22, 0xca, 0xea, 0xd6, 0x6b, 0xff, 0xc2, 0xa8, 0xbd, 0x0e, 0xab, 0xa2,
-	0x4f, 0xb2, 0xbd, 0x28, 0x20, 0x78, 0x1b, 0x4d, 0xf5, 0x72, 0x2e, 0x65, 0x78, 0xbe, 0x03, 0xeb,
-	0x4e, 0x3e, 0xe5, 0x19, 0x34, 0x75, 0x9a, 0x84, 0xee, 0xcd, 0xaf, 0xfe, 0xf6, 0x9f, 0xdf, 0x4d,
-	0x2e, 0x3a, 0xd8, 0x93, 0x8b, 0xcc, 0x3b, 0x78, 0x98, 0xc7, 0x71, 0xcf, 0xe7, 0xa3, 0xc3, 0xbb,
-	0x13, 0x2b, 0xf8, 0xa7, 0x68, 0x6a, 0x83, 0x9c, 0x1d, 0xd5, 0x01, 0xd4, 0x05, 0xdc, 0x80, 0x8a,
-	0x3f, 0x43, 0xed, 0x5e, 0xce, 0xef, 0xfb, 0xdc, 0xef, 0xd3, 0x3c, 0x0b, 0x08, 0xc6, 0x1d, 0x35,
-	0x9a, 0x85, 0xcc, 0x69, 0x90, 0xb9, 0x77, 0x00, 0xf4, 0x96, 0x7b, 0x5d, 0x83, 0x8a, 0xbd, 0x83,
-	0x81, 0xce, 0x3b, 0xf8, 0xc0, 0x1f, 0x13, 0xf0, 0xf8, 0x13, 0xd4, 0xde, 0x20, 0xdf, 0x06, 0xfe,
-	0x36, 0xc0, 0x2f, 0xe1, 0xa3, 0xe1, 0x71, 0x84, 0xe6, 0xee, 0x93, 0x98, 0x70, 0x72, 0x02, 0xfc,
-	0x2d, 0x19, 0x93, 0xaa, 0xed, 0x16, 0x61, 0x29, 0x4d, 0x98, 0xa1, 0x5a, 0x39, 0x86, 0x6a, 0x07,
-	0xcd, 0x6e, 0x46, 0xcc, 0xea, 0x07, 0xc3, 0x4b, 0x12, 0xb5, 0x2c, 0xde, 0x22, 0x5f, 0xe4, 0x62,
-	0x5b, 0x75, 0x14, 0xa5, 0x51, 0xac, 0xd3, 0x38, 0x26, 0x41, 0xf3, 0x68, 0x14, 0x74, 0x78, 0x1f,
-	0x5d, 0x15, 0x80, 0xcf, 0x48, 0xc6, 0x22, 0x9a, 0x44, 0x49, 0xd8, 0xa3, 0x71, 0x14, 0x44, 0x84,
-	0xe1, 0xdb, 0x05, 0x5d, 0x45, 0xbb, 0xaf, 0x49, 0x97, 0xa5, 0x49, 0x55, 0x7d, 0x1c, 0xf5, 0x9e,
-	0xb1, 0xc5, 0x23, 0x34, 0xbf, 0x41, 0x6a, 0xd8, 0xf8, 0x6a, 0x07, 0xf6, 0xda, 0xaa, 0xdc, 0x39,
-	0x42, 0x5e, 0x1f, 0xb7, 0x82, 0xc2, 0x3b, 0x78, 0x9a, 0x47, 0x43, 0x11, 0xcc, 0x39, 0xe8, 0x46,
-	0x94, 0xf1, 0xdc, 0x8f, 0x3f, 0xa0, 0x43, 0xc2, 0xf0, 0x4d, 0xab, 0x7b, 0x96, 0x5c, 0x77, 0xed,
-	0x8a, 0x54, 0x83, 0xcc, 0xea, 0xcf, 0x0d, 0x20, 0xbb, 0x8a, 0x17, 0x0c, 0x99, 0x6c, 0x9b, 0x00,
-	0xe6, 0x33, 0xd4, 0x12, 0x78, 0x6a, 0x49, 0x32, 0x7c, 0xad, 0xe0, 0x50, 0x32, 0x0d, 0xbf, 0x28,
-	0x35, 0x4a, 0x6a, 0x11, 0xcc, 0x03, 0x41, 0x1b, 0x4f, 0x6b, 0x82, 0x80, 0xc7, 0xb8, 0x8f, 0x66,
-	0xd6, 0x69, 0xc2, 0x33, 0x1a, 0xeb, 0xd5, 0xbe, 0x64, 0x56, 0x9d, 0x25, 0xd5, 0xe0, 0xad, 0x8e,
-	0xd8, 0xad, 0x94, 0xd0, 0xbd, 0x0a, 0x88, 0x73, 0xae, 0x8d, 0x28, 0x16, 0x4a, 0x82, 0xb0, 0x70,
-	0xac, 0x47, 0x48, 0xc6, 0xba, 0xc3, 0x61, 0x46, 0x18, 0x23, 0x0c, 0xbf, 0x5a, 0xb8, 0x5c, 0xd6,
-	0x54, 0xc6, 0xbc, 0xc9, 0x40, 0xcd, 0xee, 0x2b, 0x40, 0x38, 0x8b, 0xdb, 0x9a, 0x30, 0x15, 0x76,
-	0x38, 0x91, 0x33, 0x5a, 0x34, 0x7a, 0x48, 0xe3, 0xa1, 0x10, 0xdd, 0x28, 0x63, 0x29, 0xf1, 0x09,
-	0x43, 0xf0, 0x3a, 0xc0, 0x2f, 0xbb, 0x4b, 0x25, 0x78, 0xef, 0x40, 0x20, 0x28, 0x67, 0x60, 0x23,
-	0xd8, 0x41, 0x6d, 0x00, 0xce, 0x68, 0x20, 0xbb, 0xe6, 0x58, 0x6c, 0x5a, 0xa8, 0xb9, 0x96, 0x1a,
-	0x75, 0xaa, 0x43, 0x6a, 0xd0, 0xdd, 0xcb, 0x86, 0x51, 0x9b, 0x08, 0x9e, 0x43, 0x19, 0xc7, 0x07,
-	0xe6, 0x04, 0x7c, 0x9f, 0xec, 0x33, 0xbc, 0xdc, 0xb1, 0x8e, 0xc4, 0xee, 0x70, 0x1c, 0x25, 0xc2,
-	0x48, 0xa8, 0x34, 0xe5, 0xed, 0x63, 0x2c, 0x14, 0xb1, 0x0b, 0xc4, 0x37, 0xdc, 0x45, 0x4d, 0x6c,
-	0x9d, 0xb8, 0x71, 0xc4, 0xb8, 0xa0, 0xff, 0x6a, 0x02, 0xcd, 0xaf, 0x67, 0xc4, 0xe7, 0xa4, 0xe4,
-	0x01, 0xae, 0xc3, 0x4b, 0xab, 0xf7, 0x89, 0x59, 0xbe, 0xee, 0x71, 0x26, 0xca, 0x85, 0xda, 0xa6,
-	0x6b, 0xb9, 0x10, 0x80, 0xb5, 0x76, 0x42, 0xee, 0x76, 0x27, 0x39, 0x21, 0xad, 0x8e, 0x75, 0xc2,
-	0x32, 0x39, 0x85, 0x13, 0x43, 0xb0, 0xd6, 0x4e, 0x3c, 0xf8, 0x32, 0xa5, 0x19, 0x3f, 0xc9, 0x09,
-	0x69, 0x75, 0xac, 0x13, 0x96, 0xc9, 0x29, 0x9c, 0x20, 0x60, 0xad, 0x9d, 0x78, 0x34, 0x3e, 0x8d,
-	0x13, 0xd2, 0xea, 0x58, 0x27, 0x2c, 0x93, 0xb2, 0x13, 0x4e, 0x93, 0x13, 0xd1, 0x58, 0x3b, 0xf1,
-	0x73, 0x84, 0x1f, 0x24, 0xc3, 0x94, 0x46, 0x09, 0x67, 0xf7, 0x23, 0x16, 0xd0, 0x3d, 0x92, 0x89,
-	0x8d, 0x55, 0x1e, 0x11, 0x5a, 0x50, 0xd9, 0x8b, 0x2c, 0xb9, 0x22, 0xbb, 0x0e, 0x64, 0xf3, 0xd8,
-	0xcc, 0xfb, 0xa1, 0xc1, 0x1a, 0xa2, 0xb9, 0x0f, 0x53, 0x92, 0x74, 0xd3, 0xe8, 0x64, 0x7c, 0xb5,
-	0x8e, 0x95, 0x7d, 0xf5, 0x10, 0xb4, 0xce, 0x5b, 0xdd, 0xd0, 0xa3, 0x29, 0x49, 0xfc, 0x34, 0xc2,
-	0x2f, 0xd1, 0x82, 0xcc, 0x2b, 0x1e, 0xd2, 0x6c, 0x6c, 0xf5, 0x64, 0xd1, 0xce, 0x39, 0x84, 0xee,
-	0xc4, 0xae, 0xac, 0x02, 0xd9, 0x77, 0xf1, 0x77, 0xea, 0x64, 0x3b, 0x02, 0xdb, 0x3b, 0x50, 0xdb,
-	0x25, 0x9c, 0xbe, 0x6b, 0xbf, 0x99, 0x44, 0xd3, 0x5b, 0x34, 0x26, 0x7a, 0xbb, 0x7d, 0x07, 0x5d,
-	0xec, 0x13, 0x2e, 0x24, 0x78, 0xaa, 0x23, 0xd2, 0x7a, 0xf1, 0xd3, 0x29, 0x7e, 0xba, 0x8b, 0x80,
-	0x7f, 0xd9, 0x69, 0x79, 0x19, 0x8d, 0x89, 0x3a, 0x77, 0xc4, 0x50, 0xbc, 0x83, 0x90, 0x9c, 0xcf,
-	0xc7, 0x34, 0x5e, 0x80, 0xc6, 0x33, 0x2b, 0xa5, 0xc6, 0xf8, 0x87, 0xe8, 0xe2, 0xc6, 0xb1, 0x9c,
-	0xaa, 0x19, 0x2e, 0x37, 0xfb, 0x10, 0x4d, 0xf7, 0x89, 0x9f, 0x05, 0x23, 0x61, 0xc3, 0xb0, 0x39,
-	0x68, 0xb4, 0xa8, 0x32, 0x2a, 0x60, 0x65, 0xed, 0xae, 0x73, 0x00, 0x8a, 0xdc, 0x57, 0x00, 0xf4,
-	0xee, 0xc4, 0xca, 0xda, 0x3f, 0x27, 0xd1, 0xf4, 0x53, 0x46, 0x32, 0x1d, 0x8b, 0x77, 0xd1, 0xc5,
-	0x5e, 0xce, 0x85, 0x44, 0xf9, 0x25, 0x7e, 0x3a, 0xc5, 0x4f, 0xf7, 0x1a, 0x40, 0x60, 0xa7, 0xed,
-	0xe5, 0x8c, 0x64, 0xde, 0xc1, 0x26, 0x0d, 0xa3, 0x04, 0x82, 0x71, 0x5f, 0x07, 0xa3, 0xda, 0x7a,
-	0xc1, 0x4e, 0x98, 0xaa, 0x07, 0xc9, 0x4a, 0x19, 0x08, 0xff, 0x08, 0x02, 0x73, 0x8c, 0x03, 0xc5,
-	0x01, 0x54, 0x6a, 0x67, 0x22, 0x23, 0x8c, 0x2a, 0x91, 0x11, 0xa2, 0x4a, 0x64, 0xc0, 0xaa, 0x31,
-	0x32, 0x02, 0x55, 0x74, 0xe7, 0x27, 0xe8, 0x52, 0x2f, 0xe7, 0x32, 0xce, 0xcd, 0x9e, 0xdc, 0x82,
-	0x36, 0xd7, 0x9c, 0x79, 0xe9, 0x89, 0x08, 0x29, 0xb3, 0x02, 0xb2, 0xf6, 0xd7, 0x09, 0x84, 0xba,
-	0xeb, 0x9b, 0x3a, 0xb4, 0xab, 0xe8, 0x42, 0x2f, 0xe7, 0xdd, 0x20, 0xc6, 0x97, 0x00, 0xa3, 0xbb,
-	0xbe, 0xe9, 0x98, 0x5f, 0xee, 0x2c, 0x80, 0x4d, 0x39, 0xe7, 0x3d, 0x3f, 0x80, 0x13, 0xfc, 0x3d,
-	0x34, 0x25, 0x23, 0x56, 0x6e, 0xd1, 0x1c, 0xcc, 0x25, 0x68, 0x7d, 0xc5, 0x9d, 0x13, 0xad, 0xbd,
-	0x41, 0x1e, 0xef, 0x5a, 0x5b, 0xe7, 0x63, 0x84, 0x64, 0x1c, 0xba, 0x41, 0xcc, 0xf4, 0x42, 0x56,
-	0x92, 0xf5, 0x4d, 0x1d, 0x18, 0x95, 0xea, 0x77, 0xd7, 0x37, 0xad, 0xb0, 0x28, 0xaf, 0x5c, 0xed,
-	0xd5, 0x5a, 0x8a, 0xda, 0x32, 0x33, 0xd3, 0xbd, 0xfa, 0x5c, 0x66, 0x45, 0x26, 0xb1, 0xbc, 0x01,
-	0x9e, 0x1a, 0xd1, 0xfe, 0x46, 0x46, 0xf3, 0xd4, 0x1c, 0x8b, 0x37, 0x8f, 0xd0, 0xaa, 0x6e, 0x60,
-	0xa0, 0x6b, 0xb9, 0x17, 0xbd, 0x14, 0xd4, 0x82, 0xf1, 0xeb, 0x49, 0x34, 0xf7, 0x11, 0xcd, 0x76,
-	0x59, 0xea, 0x07, 0x66, 0xc9, 0x6e, 0xa2, 0x56, 0x2f, 0xe7, 0x46, 0x8c, 0x67, 0x00, 0xd7, 0x3c,
-	0x3b, 0x95, 0x67, 0x7d, 0xc8, 0x3b, 0x97, 0xbd, 0x97, 0x5a, 0xe6, 0x1d, 0xf4, 0xe3, 0x3c, 0x84,
-	0x99, 0xbb, 0x85, 0x66, 0x65, 0x3c, 0x8f, 0x06, 0x6c, 0x0e, 0xbb, 0xda, 0x43, 0x57, 0xea, 0xb0,
-	0x78, 0x80, 0xe6, 0x64, 0x88, 0x0d, 0x86, 0xc9, 0x88, 0x2a, 0x72, 0x1d, 0x9b, 0xeb, 0x52, 0x6b,
-	0xe4, 0xd6, 0x30, 0xa8, 0x39, 0xef, 0xa2, 0x82, 0x47, 0x84, 0xe6, 0x9b, 0x49, 0x34, 0xdb, 0x55,
-	0x55, 0x01, 0x1d, 0x99, 0x4f, 0xd0, 0x85, 0x3e, 0x14, 0x08, 0xf0, 0xed, 0x8e, 0xae, 0x18, 0x74,
-	0xa4, 0x44, 0x99, 0x46, 0x45, 0x62, 0x34, 0x57, 0x98, 0x7c, 0x08, 0xf7, 0x9c, 0xd2, 0x44, 0x52,
-	0x75, 0x07, 0x59, 0x6f, 0x10, 0x71, 0x7a, 0x8e, 0xa6, 0xfa, 0xf9, 0x80, 0x05, 0x59, 0x34, 0x20,
-	0xf8, 0xaa, 0x05, 0x2f, 0x85, 0x70, 0x50, 0x39, 0x47, 0xc8, 0xf5, 0x6a, 0x71, 0xe7, 0x2d, 0x64,
-	0x0d, 0x26, 0xc0, 0x7f, 0x81, 0xe6, 0x65, 0x60, 0xec, 0x56, 0x0c, 0xdf, 0xb1, 0xe0, 0xea, 0xea,
-	0x62, 0x5e, 0xc9, 0xc8, 0xda, 0x3a, 0x2b, 0x7e, 0x45, 0xaa, 0x55, 0xe5, 0x96, 0xa6, 0x22, 0x98,
-	0x9f, 0x22, 0xb4, 0x49, 0xcd, 0x85, 0xfb, 0x03, 0x74, 0xa1, 0xbf, 0xcf, 0x62, 0x2a, 0xee, 0xc5,
-	0x31, 0x0d, 0x61, 0xca, 0x6e, 0xd2, 0xb0, 0x72, 0x21, 0xdb, 0xa4, 0xe1, 0x13, 0xc2, 0x98, 0x1f,
-	0x36, 0x24, 0xf9, 0xee, 0x25, 0xa8, 0x80, 0xb0, 0x7d, 0x40, 0xff, 0xc7, 0x24, 0x6a, 0x6d, 0xd3,
-	0x5d, 0x92, 0x68, 0x82, 0x2d, 0x74, 0x61, 0x8b, 0xec, 0xd1, 0x5d, 0xa2, 0x2f, 0xde, 0xf2, 0x49,
-	0x13, 0x2c, 0x94, 0x85, 0x6a, 0xbe, 0xa9, 0xfb, 0xbc, 0x8b, 0x3d, 0x3f, 0xe7, 0x23, 0x8f, 0x0b,
-	0x40, 0x2f, 0x03, 0x1b, 0x11, 0xc2, 0x5f, 0x4f, 0x20, 0xbc, 0x45, 0x18, 0xe1, 0x3d, 0x9f, 0xb1,
-	0x97, 0x34, 0x1b, 0x02, 0xa3, 0xce, 0xfa, 0xeb, 0x9a, 0x4a, 0xd6, 0xdf, 0x64, 0xa0, 0x88, 0x3b,
-	0x40, 0xfc, 0x3d, 0xe7, 0x75, 0x49, 0x9c, 0x09, 0xcb, 0xd5, 0x54, 0x99, 0xae, 0x4a, 0x3f, 0x0e,
-	0xc4, 0xa6, 0xa8, 0x76, 0xe3, 0x08, 0xb5, 0x4b, 0x68, 0x3a, 0x3d, 0x2f, 0x09, 0x2b, 0xe9, 0x79,
-	0x45, 0xa7, 0x98, 0x5f, 0x05, 0xe6, 0xeb, 0xee, 0x42, 0x13, 0xb3, 0x88, 0xec, 0xc7, 0xa8, 0xfd,
-	0x04, 0x8a, 0x6a, 0x3a, 0xb2, 0x1b, 0xe8, 0x7c, 0x9f, 0x24, 0x43, 0xdc, 0xea, 0xa8, 0x62, 0x9b,
-	0x50, 0x3b, 0xd7, 0xf4, 0x93, 0xd0, 0x09, 0x89, 0x61, 0x50, 0xa7, 0xbb, 0xdb, 0xd2, 0x35, 0x3a,
-	0x46, 0x92, 0xa1, 0x9c, 0x11, 0x6d, 0x35, 0xe5, 0x14, 0xf2, 0xfb, 0xe8, 0x15, 0x79, 0xbd, 0x9c,
-	0x97, 0xb7, 0x55, 0x75, 0xee, 0x96, 0x37, 0x50, 0x2d, 0x64, 0x79, 0xcc, 0x99, 0x3e, 0x2e, 0xdd,
-	0xb6, 0xc7, 0x40, 0xee, 0xc1, 0x5d, 0x52, 0xa0, 0xff, 0xe9, 0x3c, 0x9a, 0xde, 0xce, 0x88, 0xd9,
-	0xd2, 0x7e, 0x86, 0xda, 0xf7, 0xf2, 0x78, 0xb7, 0xcf, 0x7d, 0x2e, 0x49, 0xd4, 0xfd, 0x72, 0x83,
-	0x70, 0x21, 0x7f, 0x42, 0xb8, 0xaf, 0x99, 0xd4, 0x16, 0x5e, 0x88, 0x55, 0x4f, 0x8a, 0xcb, 0x20,
-	0x54, 0x35, 0x19, 0xf7, 0x39, 0x5c, 0x62, 0x3e, 0x42, 0xd3, 0x32, 0xf7, 0x2f, 0x01, 0x5b, 0xa2,
-	0x13, 0x2e, 0x65, 0x45, 0x84, 0x00, 0xb7, 0xb8, 0x19, 0x6c, 0xa3, 0x4b, 0xef, 0x11, 0x7f, 0x28,
-	0xec, 0xb1, 0x6a, 0xab, 0x9f, 0x2b, 0xbe, 0x16, 0xe2, 0x5a, 0xfa, 0x69, 0x7c, 0xf5, 0x0e, 0x84,
-	0xc5, 0x21, 0x7e, 0x8e, 0xa6, 0xe5, 0x3e, 0x5b, 0x72, 0xd7, 0x12, 0x55, 0x76, 0xcc, 0x92, 0xa6,
-	0x36, 0xa8, 0x00, 0x5f, 0x1c, 0x86, 0x9f, 0xa3, 0xd6, 0x16, 0x61, 0x9c, 0x66, 0x0a, 0xfd, 0xba,
-	0x99, 0x7c, 0x46, 0x56, 0x59, 0xe4, 0x65, 0x95, 0xc2, 0x2f, 0xc6, 0x15, 0xf0, 0x33, 0x69, 0x23,
-	0x08, 0x5e, 0xa0, 0x59, 0x19, 0xd9, 0x3e, 0x51, 0xf1, 0xd3, 0xfb, 0x7e, 0x45, 0x5c, 0xd9, 0xbb,
-	0x6a, 0x5a, 0xc5, 0xa4, 0x8a, 0x2c, 0xee, 0xac, 0x0a, 0x94, 0x36, 0x90, 0xa7, 0xf1, 0xdc, 0xb6,
-	0x2e, 0x7a, 0xeb, 0x79, 0xf4, 0xa9, 0xbc, 0x19, 0x1b, 0xb9, 0x7d, 0x33, 0x36, 0xc2, 0x86, 0x9b,
-	0xb1, 0xa5, 0x2b, 0x9f, 0xc6, 0x18, 0x79, 0xa6, 0xb2, 0xbe, 0xf6, 0xdf, 0x49, 0x34, 0x2d, 0xe6,
-	0x5c, 0xb1, 0x8d, 0x89, 0x74, 0x4d, 0x48, 0x34, 0x8f, 0xf8, 0x2d, 0xf2, 0xec, 0xd2, 0xd9, 0x86,
-	0xe4, 0x82, 0x11, 0x31, 0xb4, 0x56, 0xf4, 0x98, 0x70, 0xdf, 0x0b, 0x89, 0x1a, 0x78, 0x53, 0x96,
-	0xdc, 0x84, 0x7c, 0x1c, 0x30, 0x17, 0x0a, 0xcc, 0x62, 0x3e, 0x1e, 0x87, 0xc6, 0x6a, 0x68, 0x1f,
-	0xeb, 0xb4, 0xf4, 0x4c, 0x4e, 0x16, 0x27, 0x06, 0xc0, 0xca, 0xf9, 0x53, 0x41, 0xfe, 0x04, 0x4d,
-	0x5b, 0x8b, 0xf3, 0x5b, 0xac, 0x57, 0xb5, 0x06, 0xdc, 0x19, 0x49, 0x02, 0x69, 0x5b, 0x48, 0xc4,
-	0x25, 0x6f, 0xed, 0x8f, 0x17, 0xd1, 0xac, 0xd8, 0x4f, 0xed, 0x58, 0x87, 0x68, 0xe6, 0x29, 0x94,
-	0x9c, 0xb5, 0x02, 0x3b, 0x32, 0x19, 0x2d, 0x09, 0x8b, 0xa1, 0x6d, 0xd2, 0x95, 0x8b, 0x1e, 0xce,
-	0x65, 0x48, 0x5d, 0x57, 0x81, 0x5e, 0x96, 0xb3, 0x45, 0xc7, 0x86, 0x68, 0xa6, 0x48, 0x9c, 0x2d,
-	0xa2, 0xb2, 0x50, 0x13, 0x5d, 0x2b, 0x32, 0xea, 0xf2, 0x38, 0x59, 0xa5, 0x95, 0x82, 0x45, 0x6e,
-	0x83, 0x92, 0xa5, 0x2d, 0xda, 0xdc, 0xa3, 0x74, 0x77, 0xec, 0x67, 0xbb, 0x66, 0xa2, 0x96, 0x84,
-	0x27, 0x85, 0xb0, 0x18, 0xfe, 0x82, 0x62, 0xa0, 0x1b, 0x0b, 0x96, 0x5f, 0x4d, 0xa0, 0xc5, 0x72,
-	0x10, 0xcc, 0xb8, 0xe3, 0xd7, 0x1a, 0x42, 0x54, 0x9b, 0x15, 0x77, 0x8e, 0x37, 0x2a, 0xfb, 0xe1,
-	0xd8, 0x7e, 0x24, 0xda, 0x4a, 0xf8, 0x71, 0x80, 0xae, 0x88, 0x55, 0x56, 0x77, 0xe2, 0xb6, 0x49,
-	0x89, 0x8f, 0x74, 0xe1, 0x76, 0x39, 0xc2, 0x46, 0xdf, 0x58, 0xba, 0x6c, 0xe0, 0xc7, 0x7b, 0xb2,
-	0x44, 0xaa, 0x01, 0xb6, 0xfd, 0xb0, 0x54, 0x22, 0xb5, 0xe5, 0x9a, 0xf3, 0xd6, 0x51, 0x6a, 0xd5,
-	0xe1, 0xd7, 0x80, 0xf0, 0x26, 0x5e, 0xb2, 0x08, 0xb9, 0x1f, 0x32, 0x59, 0xe2, 0x06, 0xda, 0x43,
-	0xcc, 0xd0, 0x8c, 0xba, 0x4d, 0xaa, 0xf6, 0xba, 0xb4, 0x59, 0x96, 0x6a, 0xce, 0x1b, 0xcd, 0x4a,
-	0xc5, 0x58, 0x94, 0x06, 0x8f, 0x66, 0x14, 0x91, 0xfe, 0xe5, 0x04, 0xc2, 0xc5, 0x45, 0xd4, 0xf4,
-	0xf7, 0x55, 0xfb, 0xb0, 0x68, 0xea, 0xf1, 0xf2, 0xd1, 0x06, 0xca, 0x83, 0x15, 0xf0, 0xe0, 0xce,
-	0x8a, 0x7b, 0x8c, 0x07, 0xde, 0x81, 0x68, 0x72, 0xb8, 0xf6, 0xef, 0x73, 0x68, 0xfa, 0x31, 0x1d,
-	0x98, 0x6d, 0xf9, 0x33, 0x39, 0xdb, 0xe5, 0x2e, 0xff, 0x98, 0x0e, 0xf4, 0xd6, 0x26, 0x84, 0x8f,
-	0xe9, 0xa0, 0xe1, 0x7a, 0x0a, 0xd2, 0xda, 0xf4, 0x82, 0x77, 0x79, 0xf2, 0xe6, 0xfb, 0x98, 0x0e,
-	0xcc, 0x8b, 0x91, 0x67, 0xa8, 0x05, 0xe9, 0x57, 0xc4, 0xb8, 0x60, 0xc5, 0x57, 0x3a, 0xf0, 0xbe,
-	0x4f, 0x3f, 0x37, 0xac, 0x55, 0x21, 0x6e, 0xbc, 0x62, 0x18, 0x06, 0x81, 0xfb, 0x14, 0xcd, 0x80,
-	0xdb, 0xb2, 0x14, 0x2d, 0xfc, 0xbe, 0x2c, 0x91, 0xd7, 0x79, 0x16, 0xaf, 0xd3, 0xf1, 0xd8, 0x4f,
-	0x86, 0xce, 0xf5, 0x9a, 0xa8, 0x7a, 0xcb, 0x77, 0x2a, 0xb0, 0x44, 0xee, 0x6e, 0x32, 0xd6, 0xdb,
-	0x3e, 0xdb, 0x15, 0xc7, 0x3c, 0x80, 0x58, 0xa2, 0xe2, 0x98, 0xaf, 0x6b, 0x6a, 0x09, 0x31, 0xc0,
-	0x73, 0xa1, 0xb4, 0x0e, 0xfb, 0xcf, 0xd4, 0x59, 0x28, 0xc4, 0x9b, 0x34, 0x64, 0x67, 0x4f, 0xe6,
-	0x8b, 0xfb, 0x90, 0x45, 0x10, 0xd3, 0x10, 0x52, 0xb8, 0xbf, 0x4c, 0xa0, 0x39, 0x28, 0xe5, 0xd9,
-	0x79, 0xdc, 0x73, 0xc9, 0x69, 0xe4, 0xfa, 0x95, 0x87, 0x10, 0x9e, 0x26, 0xd9, 0x2a, 0x18, 0xe1,
-	0xbc, 0xf7, 0x05, 0x8e, 0xa9, 0x07, 0x3f, 0x47, 0x6d, 0x91, 0x20, 0x16, 0xe0, 0x57, 0x24, 0xf8,
-	0x56, 0x2d, 0xeb, 0xaa, 0x88, 0x6b, 0x75, 0x02, 0x0b, 0x5c, 0xe4, 0x5e, 0xa2, 0x3b, 0x7f, 0x9e,
-	0x40, 0xad, 0x8d, 0xcc, 0x4f, 0x47, 0x45, 0x2a, 0x31, 0x05, 0xb5, 0x21, 0xee, 0x73, 0xa2, 0xeb,
-	0x06, 0x46, 0x50, 0xa9, 0xca, 0x59, 0x72, 0xc5, 0xa5, 0x2e, 0x7c, 0xf8, 0xaa, 0x07, 0xaf, 0xf0,
-	0x81, 0x46, 0x5c, 0x8f, 0x49, 0x38, 0x26, 0x09, 0x17, 0x69, 0xde, 0xa5, 0x2d, 0x12, 0xc3, 0x2b,
-	0x45, 0x9d, 0x3c, 0xea, 0xe7, 0xca, 0xae, 0x5f, 0x88, 0x15, 0xf4, 0x32, 0x40, 0x3b, 0xf8, 0x9a,
-	0x82, 0xce, 0x94, 0x81, 0xbc, 0x83, 0x3c, 0x1a, 0x1e, 0xae, 0x85, 0xa8, 0xbd, 0x3e, 0xf2, 0x93,
-	0xd0, 0x0c, 0xcb, 0x33, 0x84, 0x36, 0x08, 0x97, 0x32, 0x66, 0x5e, 0x76, 0xc2, 0x63, 0x85, 0x4d,
-	0x0a, 0x1b, 0x47, 0x24, 0x90, 0xcd, 0x45, 0x27, 0xbe, 0x78, 0x74, 0x1f, 0x8a, 0x3c, 0x5f, 0x5d,
-	0x40, 0xad, 0xfe, 0xc8, 0xcf, 0x0c, 0xd1, 0x3a, 0x54, 0xd0, 0xd6, 0x49, 0x1c, 0xeb, 0x25, 0xae,
-	0x1e, 0x8b, 0x34, 0x43, 0xd2, 0x90, 0x38, 0xd6, 0x19, 0xbb, 0x33, 0xed, 0xc1, 0x97, 0x0d, 0xf0,
-	0xb2, 0x59, 0x8c, 0xf3, 0x06, 0xa4, 0x55, 0x36, 0x88, 0x7a, 0x6c, 0x02, 0x29, 0x5e, 0xc3, 0x15,
-	0x20, 0xba, 0x60, 0xf8, 0x5c, 0x67, 0x3f, 0x80, 0xb5, 0x68, 0x6f, 0x71, 0x36, 0xdc, 0xb5, 0xba,
-	0xa2, 0x9c, 0x7e, 0xae, 0x34, 0x81, 0x6f, 0x41, 0x15, 0x06, 0x7a, 0xbf, 0x19, 0x25, 0xbb, 0x3a,
-	0x97, 0xb6, 0x65, 0x9a, 0x60, 0x56, 0x5d, 0x8e, 0xb4, 0xbc, 0xd6, 0xf3, 0x38, 0x4a, 0x76, 0xd5,
-	0x46, 0xb6, 0x41, 0xea, 0x98, 0xb6, 0xec, 0x48, 0xcc, 0x6a, 0x20, 0x04, 0xa6, 0xf6, 0xf5, 0x85,
-	0xae, 0xf1, 0x14, 0xd0, 0x37, 0xec, 0x4e, 0xd7, 0xd0, 0x6f, 0x1e, 0xa1, 0x3d, 0x22, 0x2e, 0x36,
-	0xd7, 0x4b, 0x34, 0x0f, 0x6f, 0x05, 0x85, 0x42, 0x6c, 0x85, 0xea, 0x15, 0xaf, 0xf5, 0x72, 0xad,
-	0xa2, 0xaa, 0x1c, 0xf4, 0x8d, 0x16, 0xb5, 0x15, 0x2c, 0x79, 0x33, 0x6d, 0x21, 0x82, 0xb7, 0x87,
-	0xe6, 0x65, 0xa2, 0x02, 0xad, 0x4d, 0x4d, 0x4e, 0x11, 0x37, 0xa8, 0xaa, 0x19, 0x46, 0x93, 0x45,
-	0xb9, 0xc3, 0xce, 0xac, 0x22, 0x4e, 0x95, 0x81, 0x58, 0x04, 0x7f, 0x38, 0x87, 0x66, 0x1e, 0xc9,
-	0x4f, 0x2f, 0x8a, 0xeb, 0xac, 0x58, 0x6f, 0x4a, 0x88, 0x97, 0x3a, 0xfa, 0xcb, 0x8c, 0x0d, 0xc2,
-	0xef, 0x93, 0x1d, 0x5f, 0x5c, 0x8e, 0x8b, 0x63, 0xbf, 0x51, 0xa9, 0x78, 0x55, 0x65, 0x16, 0x5f,
-	0xd2, 0x1f, 0x77, 0xe0, 0xa7, 0x68, 0xba, 0x47, 0x99, 0xc1, 0x5e, 0x34, 0xcd, 0x95, 0xa4, 0x98,
-	0xd4, 0x35, 0x85, 0xc2, 0x2c, 0x4a, 0x34, 0xca, 0x42, 0x04, 0x6f, 0x8c, 0xe6, 0x7b, 0x24, 0xdb,
-	0xa1, 0xd9, 0x58, 0x99, 0xaf, 0x8f, 0x48, 0x20, 0x66, 0x89, 0x46, 0x51, 0x5a, 0x10, 0x5b, 0x05,
-	0xcd, 0x46, 0x6d, 0x2d, 0xc3, 0xd7, 0x5f, 0xa8, 0x04, 0x42, 0x2f, 0xe8, 0x42, 0x98, 0xe8, 0xdd,
-	0x30, 0x23, 0x44, 0xec, 0x87, 0xb8, 0x14, 0x05, 0x23, 0xae, 0xf3, 0x94, 0xb5, 0xe5, 0xc1, 0xc1,
-	0xd8, 0xf0, 0xf8, 0xda, 0x66, 0xed, 0x9b, 0x09, 0xd4, 0x56, 0x03, 0xab, 0xc6, 0xa6, 0xa7, 0x2f,
-	0x12, 0x02, 0x3d, 0xca, 0xc8, 0x10, 0x5f, 0xe9, 0xa8, 0x8f, 0x59, 0x0a, 0xb9, 0xdc, 0x11, 0x2b,
-	0x62, 0x45, 0xa7, 0xca, 0xc2, 0xf8, 0xa2, 0xba, 0x34, 0xe0, 0x10, 0x4d, 0x77, 0xd3, 0x34, 0xde,
-	0x97, 0x76, 0xd8, 0xd1, 0xed, 0x2c, 0x61, 0x71, 0x2f, 0x69, 0xd2, 0x95, 0xf3, 0x1c, 0xbc, 0xa8,
-	0xbf, 0xb1, 0x39, 0xd8, 0xf6, 0xb3, 0xd0, 0x7c, 0x47, 0x70, 0xb8, 0xf6, 0xf7, 0x8b, 0x68, 0xf6,
-	0xa1, 0xfa, 0xee, 0x4b, 0x77, 0xe7, 0x63, 0x84, 0x40, 0x24, 0xcf, 0x29, 0xb5, 0xc7, 0x15, 0x92,
-	0xca, 0x1e, 0x67, 0x2b, 0xca, 0xc5, 0x02, 0x3c, 0xeb, 0xe9, 0x4f, 0xca, 0xe4, 0x61, 0x25, 0xae,
-	0x28, 0x60, 0x7e, 0x8f, 0x52, 0xf8, 0x4c, 0x46, 0x5f, 0x51, 0x4a, 0xc2, 0xca, 0x5d, 0xba, 0xa2,
-	0xab, 0x0d, 0x90, 0xa1, 0x18, 0x50, 0xca, 0x03, 0x01, 0xba, 0xab, 0x58, 0x54, 0xf6, 0xc1, 0x4a,
-	0x2c, 0x5a, 0xd8, 0xc4, 0x52, 0xe8, 0x6a, 0x6f, 0xdd, 0x0c, 0xcb, 0x58, 0xd9, 0x78, 0x07, 0x9b,
-	0x7e, 0x12, 0x1e, 0x8a, 0x69, 0x07, 0x6d, 0x7b, 0x71, 0x1e, 0x46, 0x89, 0xa9, 0x7f, 0xd8, 0xb2,
-	0x4a, 0x5e, 0x54, 0x56, 0xd5, 0x4e, 0x60, 0xc3, 0x94, 0x4a, 0x13, 0x4d, 0x14, 0x28, 0xa2, 0x3e,
-	0x61, 0x62, 0xe8, 0x4a, 0x44, 0x4a, 0xd6, 0x44, 0x64, 0x54, 0xb5, 0xd7, 0xf3, 0xc5, 0xd8, 0x48,
-	0x13, 0xb1, 0x88, 0x76, 0xd5, 0x6c, 0x78, 0x90, 0x64, 0x34, 0x8e, 0xbb, 0x39, 0x1f, 0xe9, 0x5d,
-	0xbd, 0x22, 0xae, 0xec, 0xea, 0x35, 0x6d, 0x6d, 0x77, 0x35, 0x6c, 0x04, 0xac, 0x04, 0xd9, 0x4b,
-	0x34, 0xa7, 0x5c, 0xcc, 0xf6, 0xc8, 0xbd, 0x28, 0xf1, 0xb3, 0x7d, 0x6c, 0x4f, 0x2a, 0x29, 0xaa,
-	0x14, 0xa7, 0x4a, 0x9a, 0x72, 0x35, 0x15, 0xbf, 0x6e, 0x4d, 0x06, 0x61, 0x11, 0x89, 0x61, 0x92,
-	0xb6, 0xdb, 0xfb, 0xa9, 0xb8, 0x4b, 0xc8, 0xf3, 0xe4, 0x4b, 0x34, 0x23, 0x07, 0x21, 0xe7, 0xff,
-	0x0f, 0xed, 0x9b, 0x40, 0xfb, 0x7d, 0xf7, 0x94, 0xb4, 0xf2, 0x33, 0x8b, 0x56, 0x9f, 0x70, 0x1e,
-	0x25, 0x21, 0x7b, 0x42, 0x92, 0x5c, 0x0f, 0xa2, 0x2d, 0xab, 0x0c, 0x62, 0x59, 0x55, 0x5b, 0xd6,
-	0xd6, 0x20, 0x4a, 0xbb, 0xd5, 0x31, 0x49, 0xf2, 0x7b, 0x5f, 0x4f, 0xfc, 0xb6, 0xfb, 0xfb, 0x09,
-	0xfc, 0x36, 0x5a, 0xe8, 0xed, 0x0f, 0x23, 0xba, 0x2c, 0x52, 0x10, 0xb6, 0xbc, 0x45, 0x18, 0x5f,
-	0xee, 0xf6, 0x1e, 0xb9, 0x0e, 0x7a, 0x05, 0xe4, 0xf8, 0xf2, 0x88, 0xf3, 0x94, 0xdd, 0xf5, 0xe4,
-	0xb7, 0x79, 0x9d, 0x80, 0x8e, 0xd7, 0xce, 0xbd, 0xd9, 0x79, 0x63, 0xe5, 0xdc, 0xc4, 0xe4, 0xf9,
-	0xb5, 0x39, 0x3f, 0x4d, 0xe3, 0x28, 0x90, 0x99, 0xe0, 0x0b, 0x46, 0x93, 0xbb, 0x35, 0x49, 0xf6,
-	0x06, 0x5a, 0x7a, 0x42, 0x33, 0xb2, 0xec, 0x0f, 0x68, 0xce, 0x97, 0x6d, 0xb2, 0x6e, 0x1a, 0xb1,
-	0x06, 0xfc, 0xc1, 0x05, 0xf8, 0x26, 0xef, 0xad, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xe8, 0x98,
-	0x64, 0xf6, 0xed, 0x2a, 0x00, 0x00,
+	// 4627 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5b, 0xcd, 0x73, 0x1b, 0x47,
+	0x76, 0x2f, 0x4a, 0xb2, 0x28, 0x35, 0x08, 0x90, 0x6c, 0x92, 0x22, 0x39, 0xd4, 0x07, 0x35, 0x96,
+	0xbd, 0x36, 0x13, 0x62, 0xd6, 0x74, 0x25, 0xeb, 0x75, 0x0e, 0x09, 0x04, 0x49, 0x5c, 0xc9, 0x94,
+	0x85, 0x80, 0x94, 0xec, 0x58, 0x76, 0x79, 0x07, 0x83, 0x26, 0x38, 0xe2, 0x60, 0x7a, 0x76, 0xba,
+	0x87, 0x12, 0xc3, 0x52, 0x36, 0xb5, 0x49, 0x2a, 0xb5, 0xc7, 0x7c, 0x1c, 0x52, 0x39, 0x27, 0x7f,
+	0x48, 0x72, 0xdb, 0xad, 0x5c, 0x36, 0xc9, 0x21, 0x87, 0x5c, 0x52, 0x95, 0xe4, 0xef, 0x48, 0xf5,
+	0x77, 0x4f, 0xcf, 0x80, 0x1f, 0x76, 0x0e, 0x12, 0x81, 0xf7, 0x5e, 0xff, 0x7e, 0xaf, 0xbf, 0x5f,
+	0xbf, 0x6e, 0x00, 0x90, 0x23, 0x42, 0xdb, 0x59, 0x8e, 0x29, 0x86, 0x57, 0xd8, 0x67, 0x6f, 0x26,
+	0xc2, 0xe3, 0x31, 0x4e, 0x85, 0xcc, 0x03, 0xc3, 0x90, 0x86, 0xf2, 0xf3, 0xf5, 0x78, 0x38, 0x96,
+	0x1f, 0x67, 0x06, 0x39, 0x3e, 0x44, 0xb9, 0xfa, 0x16, 0xe1, 0x74, 0x3f, 0x1e, 0xc9, 0x6f, 0xb3,
+	0x24, 0x3a, 0x40, 0xc3, 0x22, 0xd1, 0xea, 0xc6, 0x28, 0x0f, 0xb3, 0x03, 0xf5, 0x85, 0x1c, 0x84,
+	0x39, 0x92, 0x5f, 0x5a, 0xfb, 0x39, 0x4e, 0x29, 0x4a, 0x87, 0xaa, 0x28, 0x45, 0xe3, 0x2c, 0x09,
+	0x29, 0x22, 0x52, 0xf0, 0xf1, 0x28, 0xa6, 0x07, 0xc5, 0xa0, 0x1d, 0xe1, 0x71, 0x90, 0x1d, 0x0f,
+	0x63, 0x1c, 0x44, 0x28, 0x49, 0x48, 0x20, 0x7c, 0x0c, 0xb8, 0x51, 0x40, 0x73, 0x84, 0xf8, 0x7f,
+	0xb2, 0xd0, 0x47, 0xe7, 0x29, 0x14, 0x0f, 0xc7, 0x81, 0xa9, 0xcf, 0x8f, 0xce, 0x53, 0x64, 0x1c,
+	0xc6, 0x09, 0xca, 0xe5, 0x1f, 0x59, 0xb0, 0x73, 0x9e, 0x82, 0x61, 0x44, 0xe3, 0xa3, 0x98, 0x1e,
+	0xeb, 0x0f, 0x84, 0xe6, 0x28, 0x1c, 0x5f, 0xa4, 0x8e, 0xaf, 0xf0, 0x80, 0xf0, 0xff, 0x64, 0xa1,
+	0xdf, 0x3f, 0x4f, 0x21, 0x94, 0x46, 0xf9, 0x71, 0x46, 0x63, 0x9c, 0x5a, 0x1f, 0x2f, 0xd2, 0x48,
+	0x09, 0x1e, 0xb1, 0x7f, 0x17, 0x69, 0x24, 0x3c, 0x78, 0x85, 0x22, 0x2a, 0xff, 0xc8, 0x82, 0x3f,
+	0x3e, 0x57, 0x87, 0xa4, 0x84, 0x86, 0x49, 0xa2, 0xfe, 0x5e, 0xc4, 0xcd, 0x88, 0x26, 0xec, 0xdf,
+	0x45, 0xdc, 0x2c, 0xb2, 0x61, 0x48, 0x91, 0xfc, 0x73, 0x91, 0x8e, 0x88, 0x0e, 0x42, 0xca, 0xff,
+	0x93, 0x85, 0x7e, 0xef, 0x3c, 0x85, 0xb2, 0x1c, 0x11, 0x94, 0x46, 0x48, 0x7f, 0x90, 0x85, 0x6f,
+	0x8e, 0x30, 0x1e, 0x25, 0x28, 0x08, 0xb3, 0x38, 0x08, 0xd3, 0x14, 0xd3, 0x90, 0xf5, 0x90, 0xea,
+	0xe3, 0xdf, 0xe6, 0x7f, 0xa2, 0xcd, 0x11, 0x4a, 0x37, 0xc9, 0xeb, 0x70, 0x34, 0x42, 0x79, 0x80,
+	0x79, 0x1f, 0x92, 0xaa, 0xf5, 0xd6, 0x7f, 0x42, 0xd0, 0xec, 0xf2, 0x79, 0xb8, 0x8b, 0xf2, 0xa3,
+	0x38, 0x42, 0x70, 0x0f, 0x5c, 0xef, 0x15, 0x54, 0xc8, 0xe0, 0x42, 0x9b, 0xcf, 0x74, 0xf1, 0xad,
+	0xc8, 0x79, 0x51, 0xaf, 0x4e, 0xe8, 0xdf, 0xfa, 0xc5, 0xbf, 0xfd, 0xf7, 0xdf, 0x5c, 0x5a, 0xf6,
+	0x60, 0x20, 0xa6, 0x75, 0x70, 0xf2, 0xa8, 0x48, 0x92, 0x5e, 0x48, 0x0f, 0xde, 0x7e, 0x3a, 0xb5,
+	0x01, 0xff, 0x10, 0x5c, 0xdf, 0x46, 0x17, 0x47, 0xf5, 0x38, 0xea, 0x22, 0xac, 0x41, 0x85, 0xdf,
+	0x80, 0x66, 0xaf, 0xa0, 0x0f, 0x42, 0x1a, 0xee, 0xe2, 0x22, 0x8f, 0x10, 0x84, 0x6d, 0x39, 0x7e,
+	0x8c, 0xcc, 0xab, 0x91, 0xf9, 0xf7, 0x38, 0xe8, 0x6d, 0x7f, 0x55, 0x81, 0xb2, 0xd5, 0x8a, 0x70,
+	0x5d, 0x70, 0xf2, 0x79, 0x38, 0x46, 0xdc, 0xe3, 0xaf, 0x40, 0x73, 0x1b, 0x7d, 0x17, 0xf8, 0xbb,
+	0x1c, 0x7e, 0x0d, 0x4e, 0x86, 0x87, 0x31, 0x98, 0x7b, 0x80, 0x12, 0x44, 0xd1, 0x19, 0xf0, 0xb7,
+	0x45, 0x9b, 0xb8, 0xb6, 0x7d, 0x44, 0x32, 0x9c, 0x12, 0x4d, 0xb5, 0x71, 0x0a, 0xd5, 0x3e, 0x98,
+	0xdd, 0x89, 0x89, 0x55, 0x0f, 0x02, 0xd7, 0x04, 0x6a, 0x59, 0xdc, 0x47, 0x3f, 0x2b, 0xd8, 0x42,
+	0xee, 0x49, 0x4a, 0xad, 0xe8, 0xe2, 0x24, 0x41, 0x51, 0x7d, 0x6f, 0x18, 0x3a, 0x78, 0x0c, 0x6e,
+	0x30, 0xc0, 0x17, 0x28, 0x27, 0x31, 0x4e, 0xe3, 0x74, 0xd4, 0xc3, 0x49, 0x1c, 0xc5, 0x88, 0xc0,
+	0xbb, 0x86, 0xce, 0xd1, 0x1e, 0x2b, 0xd2, 0x75, 0x61, 0xe2, 0xaa, 0x4f, 0xa3, 0x3e, 0xd2, 0xb6,
+	0xf0, 0x00, 0x2c, 0x6c, 0xa3, 0x0a, 0x36, 0xbc, 0xd1, 0xe6, 0xab, 0xbb, 0x2b, 0xf7, 0x26, 0xc8,
+	0xab, 0xfd, 0x66, 0x28, 0x82, 0x93, 0xe7, 0x45, 0x3c, 0x64, 0x8d, 0x39, 0xc7, 0xab, 0x11, 0xe7,
+	0xb4, 0x08, 0x93, 0xcf, 0xf1, 0x10, 0x11, 0x78, 0xcb, 0xaa, 0x9e, 0x25, 0x57, 0x55, 0x5b, 0x12,
+	0x6a, 0x2e, 0xb3, 0xea, 0x73, 0x93, 0x93, 0xdd, 0x80, 0x8b, 0x9a, 0x4c, 0x94, 0x4d, 0x39, 0xe6,
+	0x0b, 0x30, 0xc3, 0xf0, 0xe4, 0x94, 0x24, 0x70, 0xc5, 0x70, 0x48, 0x99, 0x82, 0x5f, 0x16, 0x1a,
+	0x29, 0xb5, 0x08, 0x16, 0x38, 0x41, 0x13, 0x36, 0x14, 0x41, 0x44, 0x13, 0xb8, 0x0b, 0x5a, 0x5d,
+	0x9c, 0xd2, 0x1c, 0x27, 0x6a, 0xb6, 0xaf, 0xe9, 0x59, 0x67, 0x49, 0x15, 0xf8, 0x4c, 0x9b, 0xad,
+	0x8f, 0x52, 0xe8, 0xdf, 0xe0, 0x88, 0x73, 0xbe, 0x8d, 0xc8, 0x26, 0x4a, 0x0a, 0x20, 0x73, 0xac,
+	0x87, 0x50, 0x4e, 0x3a, 0xc3, 0x61, 0x8e, 0x08, 0x41, 0x04, 0xde, 0x31, 0x2e, 0x97, 0x35, 0x4e,
+	0x9f, 0xd7, 0x19, 0xc8, 0xd1, 0xbd, 0xc4, 0x09, 0x67, 0x61, 0x53, 0x11, 0x66, 0xcc, 0x0e, 0xa6,
+	0x60, 0x56, 0x15, 0x7a, 0x84, 0x93, 0x21, 0x13, 0xdd, 0x2c, 0x63, 0x49, 0xf1, 0x19, 0x5d, 0xf0,
+	0x3e, 0x87, 0x5f, 0xf7, 0xd7, 0x4a, 0xf0, 0xc1, 0x09, 0x43, 0x90, 0xce, 0xf0, 0x85, 0x60, 0x1f,
+	0x34, 0x39, 0x70, 0x8e, 0x23, 0x51, 0x35, 0xcf, 0x62, 0x53, 0x42, 0xc5, 0xb5, 0x56, 0xab, 0x93,
+	0x15, 0x92, 0x9d, 0xee, 0xcf, 0x6b, 0x46, 0x65, 0xc2, 0x78, 0xde, 0x8a, 0x76, 0x7c, 0xa8, 0xf7,
+	0xdc, 0xcf, 0xd0, 0x31, 0x81, 0xeb, 0x6d, 0x6b, 0x13, 0xee, 0x0c, 0xc7, 0x71, 0xca, 0x8c, 0x98,
+	0x4a, 0x51, 0xde, 0x3d, 0xc5, 0x42, 0x12, 0xfb, 0x9c, 0xf8, 0xa6, 0xbf, 0xac, 0x88, 0x4d, 0x89,
+	0x20, 0x89, 0x09, 0x65, 0xf4, 0xbf, 0x98, 0x02, 0x0b, 0xdd, 0x1c, 0x85, 0x14, 0x95, 0x3c, 0x80,
+	0x55, 0x78, 0x61, 0xf5, 0x19, 0xd2, 0xd3, 0xd7, 0x3f, 0xcd, 0x44, 0xba, 0x50, 0x59, 0x74, 0x2d,
+	0x17, 0x22, 0x6e, 0xad, 0x9c, 0x10, 0xab, 0xdd, 0x59, 0x4e, 0x08, 0xab, 0x53, 0x9d, 0xb0, 0x4c,
+	0xce, 0xe1, 0xc4, 0x90, 0x5b, 0x2b, 0x27, 0x1e, 0xbe, 0xc9, 0x70, 0x4e, 0xcf, 0x72, 0x42, 0x58,
+	0x9d, 0xea, 0x84, 0x65, 0x72, 0x0e, 0x27, 0x10, 0xb7, 0x56, 0x4e, 0x3c, 0x1e, 0x9f, 0xc7, 0x89,
+	0xc7, 0x63, 0xcd, 0x30, 0xc9, 0x89, 0xc7, 0xe3, 0x09, 0x4e, 0x78, 0x75, 0x4e, 0xc4, 0x63, 0xe5,
+	0xc4, 0x4f, 0x01, 0x7c, 0x98, 0x0e, 0x33, 0x1c, 0xa7, 0x94, 0x3c, 0x88, 0x49, 0x84, 0x8f, 0x50,
+	0xce, 0x16, 0x56, 0xb1, 0x45, 0x28, 0x81, 0xb3, 0x16, 0x59, 0x72, 0x49, 0xb6, 0xca, 0xc9, 0x16,
+	0xa0, 0x1e, 0xf7, 0x43, 0x8d, 0x35, 0x04, 0x73, 0xcf, 0x32, 0x94, 0x76, 0xb2, 0xf8, 0x6c, 0x7c,
+	0x39, 0x8f, 0xa5, 0xbd, 0xbb, 0x09, 0x5a, 0xfb, 0xad, 0x2a, 0x18, 0xe0, 0x0c, 0xa5, 0x61, 0x16,
+	0xc3, 0xd7, 0x60, 0x51, 0xc4, 0x15, 0x8f, 0x70, 0x3e, 0xb6, 0x6a, 0xb2, 0x6c, 0xc7, 0x1c, 0x4c,
+	0x77, 0x66, 0x55, 0x36, 0x39, 0xd9, 0x0f, 0xe0, 0x7b, 0x55, 0xb2, 0x7d, 0x86, 0x1d, 0x9c, 0xc8,
+	0xe5, 0x92, 0xef, 0xbe, 0x5b, 0xbf, 0xbc, 0x04, 0x1a, 0x7d, 0x9c, 0x20, 0x29, 0x84, 0x9f, 0x80,
+	0xe9, 0x5d, 0x44, 0x99, 0x04, 0x5e, 0x6f, 0xb3, 0x83, 0x04, 0xfb, 0xe8, 0x99, 0x8f, 0xfe, 0x32,
+	0xc7, 0x9f, 0xf7, 0x66, 0x82, 0x1c, 0x27, 0x48, 0xee, 0x3b, 0xac, 0x2b, 0x3e, 0x01, 0x40, 0x8c,
+	0xe7, 0x53, 0x0a, 0x2f, 0xf2, 0xc2, 0xad, 0x8d, 0x52, 0x61, 0xf8, 0x3b, 0x60, 0x7a, 0x1b, 0xd1,
+	0xb3, 0x8b, 0xc1, 0x72, 0xb1, 0x67, 0xa0, 0xb1, 0x8b, 0xc2, 0x3c, 0x3a, 0x60, 0x36, 0x04, 0xea,
+	0x8d, 0x46, 0x89, 0x9c, 0x5e, 0xe1, 0x56, 0xd6, 0xea, 0x3a, 0xc7, 0x41, 0x81, 0xff, 0x0e, 0x07,
+	0xfd, 0x74, 0x6a, 0x63, 0xeb, 0x9f, 0x2f, 0x83, 0xc6, 0x73, 0x82, 0x72, 0xd5, 0x16, 0x3f, 0x06,
+	0xd3, 0xbd, 0x82, 0x32, 0x89, 0xf4, 0x8b, 0x7d, 0xf4, 0xcc, 0x47, 0x7f, 0x85, 0x43, 0x40, 0xaf,
+	0x19, 0x14, 0x04, 0xe5, 0xc1, 0xc9, 0x0e, 0x1e, 0xc5, 0x29, 0x6f, 0x8c, 0x07, 0xaa, 0x31, 0xdc,
+	0xd2, 0x8b, 0x76, 0xc0, 0xe4, 0x6e, 0x24, 0x1b, 0x65, 0x20, 0xf8, 0xbb, 0xbc, 0x61, 0x4e, 0x71,
+	0xc0, 0x6c, 0x40, 0xa5, 0x72, 0xba, 0x65, 0x98, 0x91, 0xd3, 0x32, 0x4c, 0xe4, 0xb4, 0x0c, 0xb7,
+	0xaa, 0x6d, 0x19, 0x86, 0xca, 0xaa, 0xf3, 0x07, 0xe0, 0x5a, 0xaf, 0xa0, 0xa2, 0x9d, 0xeb, 0x3d,
+	0xb9, 0xcd, 0xcb, 0xac, 0x78, 0x0b, 0xc2, 0x13, 0xd6, 0xa4, 0xc4, 0x6e, 0x90, 0x11, 0x98, 0xeb,
+	0xe5, 0xe8, 0x28, 0x46, 0xaf, 0xb7, 0x73, 0x5c, 0x64, 0xfd, 0x22, 0x41, 0x2a, 0x30, 0xd1, 0x02,
+	0x69, 0x70, 0x86, 0x77, 0x32, 0x8e, 0xf7, 0xa1, 0x64, 0x2a, 0x12, 0xb4, 0x99, 0x89, 0x92, 0xac,
+	0x13, 0x7f, 0x33, 0x05, 0x40, 0xa7, 0xbb, 0xa3, 0xfa, 0x70, 0x13, 0x5c, 0xed, 0x15, 0xb4, 0x13,
+	0x25, 0xf0, 0x1a, 0x77, 0xb6, 0xd3, 0xdd, 0xf1, 0xf4, 0x27, 0x7f, 0x96, 0x63, 0x5d, 0xf7, 0xae,
+	0x04, 0x61, 0xc4, 0x43, 0x85, 0x9f, 0x80, 0xeb, 0xa2, 0x6b, 0xca, 0x25, 0xea, 0x7b, 0x6d, 0x8d,
+	0x97, 0x5e, 0xf2, 0xe7, 0x58, 0xe9, 0x60, 0x50, 0x24, 0x87, 0xd6, 0x1a, 0xfd, 0x04, 0x00, 0xd1,
+	0xe0, 0x9d, 0x28, 0x21, 0x6a, 0xc5, 0x90, 0x92, 0xee, 0x8e, 0xaa, 0xa3, 0x3c, 0x53, 0x74, 0xba,
+	0x3b, 0x56, 0x0d, 0xa5, 0x57, 0xbe, 0xf2, 0x6a, 0xeb, 0x37, 0xd3, 0x00, 0x3c, 0xdd, 0x0f, 0x55,
+	0x9d, 0x9e, 0x82, 0x99, 0x6d, 0x44, 0x99, 0x80, 0x86, 0xb4, 0xd0, 0xe0, 0x5a, 0xe0, 0xac, 0x11,
+	0x96, 0x5c, 0x3a, 0x3e, 0xc3, 0x09, 0xae, 0xc2, 0x2b, 0xc1, 0x78, 0x3f, 0x84, 0x5f, 0x03, 0xf0,
+	0x30, 0xcd, 0x71, 0x92, 0xec, 0x61, 0x9a, 0xa9, 0xc1, 0xc2, 0x3e, 0x0b, 0xa9, 0x42, 0x5b, 0xa9,
+	0x2a, 0x2a, 0xed, 0x30, 0xde, 0x0f, 0x03, 0x8a, 0x69, 0x16, 0x20, 0x6e, 0xc1, 0xda, 0x21, 0x04,
+	0x0d, 0xbe, 0x7a, 0xe5, 0x63, 0x0e, 0xbf, 0xa8, 0x7d, 0xea, 0xe2, 0xa1, 0x9e, 0xa2, 0xb7, 0xb5,
+	0xb4, 0x8f, 0xc4, 0x62, 0xd5, 0x15, 0x21, 0x6a, 0x25, 0x2e, 0xd1, 0x0c, 0x91, 0x00, 0x15, 0x07,
+	0xa1, 0xc6, 0x83, 0x98, 0x84, 0x83, 0x04, 0x9d, 0x42, 0x31, 0xb1, 0x31, 0x6a, 0xb0, 0x87, 0x02,
+	0x8d, 0x61, 0xc7, 0x60, 0xb9, 0x8f, 0x46, 0x28, 0x45, 0x79, 0x48, 0x51, 0xc9, 0xb9, 0xef, 0x58,
+	0x15, 0xb9, 0x66, 0xf8, 0x4d, 0x4e, 0x97, 0x4b, 0x1b, 0x46, 0xf5, 0x73, 0xb0, 0x7a, 0x1f, 0x8d,
+	0xe2, 0xf4, 0x0b, 0x34, 0x08, 0x0b, 0x7a, 0x90, 0xf6, 0xd1, 0x28, 0x26, 0x54, 0x9c, 0x33, 0x55,
+	0x48, 0xa7, 0x74, 0xdc, 0x50, 0x51, 0xde, 0x2a, 0xeb, 0x9e, 0x89, 0xb3, 0xb4, 0x66, 0xfc, 0x01,
+	0x67, 0xbc, 0xeb, 0xdf, 0xe4, 0x8c, 0xaf, 0xa5, 0x55, 0x90, 0x73, 0x78, 0x94, 0x07, 0x03, 0x86,
+	0xc5, 0x1c, 0xf8, 0x8b, 0x29, 0xe0, 0x3d, 0x8a, 0xd3, 0x98, 0x1c, 0xd4, 0xba, 0xe0, 0xd0, 0xf4,
+	0x65, 0xf9, 0xf3, 0x56, 0xfc, 0x03, 0xee, 0x86, 0xef, 0xdf, 0x9a, 0xe0, 0xc6, 0x3e, 0x67, 0x66,
+	0x7e, 0x24, 0x60, 0x45, 0xcc, 0x34, 0x45, 0xd5, 0xcd, 0xd1, 0x10, 0xa5, 0x34, 0x0e, 0x13, 0x75,
+	0x1c, 0x50, 0x1a, 0x35, 0x23, 0xcf, 0xe8, 0x63, 0x79, 0x38, 0xdb, 0x80, 0x65, 0xee, 0x93, 0xc7,
+	0xc3, 0xb7, 0xb0, 0x00, 0xb0, 0xd4, 0xec, 0x7c, 0xcd, 0xfa, 0x3e, 0xed, 0x6d, 0xc2, 0xa7, 0x12,
+	0x59, 0xc2, 0x70, 0x75, 0x63, 0x6f, 0xfd, 0xeb, 0x14, 0x68, 0x8a, 0x73, 0x9d, 0x9a, 0xd6, 0xdf,
+	0x8a, 0x33, 0x95, 0x3e, 0x96, 0xde, 0xe4, 0xcb, 0x8f, 0x16, 0x1d, 0xf3, 0x75, 0x92, 0x18, 0x27,
+	0xea, 0xb5, 0xd2, 0x09, 0xc8, 0x9d, 0x98, 0xf1, 0xa7, 0x83, 0x8c, 0xab, 0x45, 0xb0, 0xd4, 0x7c,
+	0xf8, 0x26, 0x4b, 0xc2, 0x38, 0x15, 0x45, 0x54, 0x25, 0xc5, 0x37, 0xa9, 0x72, 0xda, 0xd2, 0xd2,
+	0xa5, 0xa5, 0x8c, 0x87, 0x3f, 0x2b, 0x91, 0x03, 0x24, 0x0a, 0xb2, 0x4a, 0xfd, 0x6a, 0x1a, 0xcc,
+	0x7d, 0x81, 0xf3, 0x43, 0x92, 0x85, 0x91, 0x0e, 0x29, 0x76, 0xc0, 0x4c, 0xaf, 0xa0, 0x5a, 0x0c,
+	0x5b, 0xdc, 0x73, 0xfd, 0xdd, 0x73, 0xbe, 0xab, 0x09, 0xe9, 0xcd, 0x07, 0xaf, 0x95, 0x2c, 0x38,
+	0xd9, 0x4d, 0x8a, 0x11, 0xdf, 0x48, 0xfa, 0x60, 0x56, 0x0e, 0x8e, 0x89, 0x80, 0xf5, 0xab, 0xb5,
+	0x8c, 0xf1, 0x36, 0xaa, 0xb0, 0x70, 0x00, 0xe6, 0xc4, 0xca, 0xac, 0x31, 0xf4, 0x89, 0xcd, 0x91,
+	0xab, 0xd6, 0x59, 0x95, 0x43, 0x40, 0xc9, 0xad, 0xd5, 0x5b, 0xee, 0xc9, 0x3e, 0x30, 0x3c, 0xcc,
+	0xef, 0x3f, 0x11, 0xe9, 0x07, 0x5d, 0x62, 0x4f, 0xa5, 0x84, 0xe1, 0xbb, 0xe6, 0x44, 0x56, 0xd5,
+	0x9a, 0x33, 0x54, 0x99, 0x50, 0x19, 0xd4, 0x9e, 0xd8, 0x4d, 0x05, 0x75, 0xe2, 0x19, 0xbe, 0x01,
+	0x8b, 0x76, 0x2f, 0xa8, 0xf2, 0x6a, 0xbd, 0xaf, 0x28, 0xbc, 0x3b, 0x13, 0x14, 0xba, 0x41, 0xe5,
+	0xf1, 0xd4, 0x5b, 0xab, 0xe3, 0xb3, 0x02, 0xc3, 0x3f, 0x06, 0xcb, 0x4e, 0x8f, 0x69, 0xf2, 0x7b,
+	0x76, 0x4f, 0xd5, 0x30, 0x89, 0xba, 0xd7, 0xf7, 0xe7, 0xbb, 0x9c, 0xfe, 0xd6, 0xc6, 0x69, 0xf4,
+	0xf0, 0x1f, 0xa6, 0xc0, 0xcd, 0xc7, 0x29, 0xa1, 0x21, 0x5b, 0x3d, 0xea, 0x3c, 0xf8, 0x50, 0x60,
+	0x9f, 0x66, 0xa3, 0xdc, 0x38, 0xb3, 0x41, 0x3e, 0xe1, 0x1e, 0x6d, 0xf9, 0x9b, 0xf5, 0x1e, 0x29,
+	0x7b, 0xee, 0x99, 0xc8, 0x07, 0xa7, 0x91, 0x38, 0x59, 0xff, 0xd5, 0x14, 0x58, 0xda, 0x3d, 0x4e,
+	0xa3, 0xaa, 0x7f, 0xbe, 0x1c, 0x86, 0x75, 0xca, 0x73, 0x3b, 0xf6, 0x31, 0x77, 0x6c, 0xd3, 0xff,
+	0xe0, 0x3c, 0x8e, 0x91, 0xe3, 0x34, 0x62, 0x73, 0xf9, 0xd7, 0x97, 0xc0, 0x6c, 0x47, 0x26, 0xf6,
+	0xd5, 0x54, 0xfe, 0x0a, 0x5c, 0xdd, 0xe5, 0x39, 0x7e, 0x78, 0xb7, 0xad, 0x92, 0xfe, 0x6d, 0x21,
+	0x91, 0xa6, 0xb1, 0x19, 0xb2, 0x73, 0xc6, 0xe4, 0x19, 0x4f, 0x1c, 0x96, 0x02, 0x26, 0xa1, 0x09,
+	0xc4, 0x95, 0x01, 0x6b, 0x83, 0x97, 0xe0, 0xfa, 0x6e, 0x31, 0x20, 0x51, 0x1e, 0x0f, 0x10, 0xbc,
+	0x61, 0xc1, 0x0b, 0x21, 0x5f, 0x64, 0xbd, 0x09, 0x72, 0x15, 0x7e, 0xfa, 0x0b, 0x16, 0xb2, 0x02,
+	0x13, 0xb3, 0x6f, 0x41, 0xcc, 0x64, 0xbb, 0x14, 0x81, 0xf7, 0x2c, 0xb8, 0xaa, 0xda, 0x59, 0xef,
+	0x4b, 0x3a, 0x6b, 0xde, 0x99, 0xdc, 0x85, 0xcb, 0x2d, 0x4c, 0x59, 0x63, 0xfe, 0xe3, 0x34, 0x68,
+	0x74, 0x0f, 0x42, 0x95, 0x1c, 0x53, 0x79, 0x3a, 0x26, 0x7a, 0x8a, 0x08, 0x09, 0x47, 0x88, 0xc0,
+	0xd5, 0x36, 0xcf, 0xbf, 0x33, 0xb9, 0x92, 0x29, 0x0f, 0xe4, 0x62, 0x64, 0x9b, 0x5b, 0x0e, 0xc8,
+	0x95, 0xcd, 0x6f, 0x89, 0x2c, 0xfe, 0x58, 0x5a, 0x88, 0xac, 0xf6, 0x6c, 0x0f, 0x97, 0x78, 0xe0,
+	0xbc, 0xa0, 0xb1, 0x44, 0x5e, 0x55, 0x64, 0x1d, 0x6d, 0x6c, 0x4c, 0x11, 0x6d, 0xcd, 0x3f, 0xe7,
+	0xd7, 0x0b, 0x17, 0x07, 0xbd, 0xc3, 0x41, 0x57, 0xfd, 0xc5, 0x12, 0xa8, 0xb5, 0x54, 0x24, 0x60,
+	0x5e, 0xcc, 0xf2, 0x33, 0xb0, 0xd7, 0x84, 0x48, 0xd8, 0x4a, 0xa1, 0x1b, 0xef, 0x6c, 0xdc, 0x71,
+	0x58, 0xfa, 0x18, 0x8f, 0xc5, 0x10, 0x97, 0x8b, 0xc3, 0x9f, 0x4e, 0x01, 0xd8, 0x47, 0x61, 0x44,
+	0xf7, 0xb0, 0xcd, 0x27, 0xc1, 0xa5, 0x46, 0xa3, 0xab, 0x9e, 0xa8, 0x55, 0x4a, 0xea, 0x80, 0x53,
+	0x7f, 0xe8, 0xdf, 0x73, 0xa8, 0xa5, 0x9d, 0x60, 0xcf, 0x59, 0xd9, 0x18, 0xf3, 0x90, 0x2b, 0x03,
+	0x73, 0x4f, 0xc3, 0xfc, 0x90, 0xd1, 0x33, 0xf7, 0xfa, 0x28, 0x1c, 0xaa, 0x71, 0xc0, 0xe4, 0x4a,
+	0x66, 0x72, 0xdf, 0x35, 0x2a, 0xc9, 0xfd, 0x1e, 0xe7, 0xbe, 0xe3, 0x7b, 0x82, 0x3b, 0xc7, 0x78,
+	0x6c, 0xd7, 0x39, 0x47, 0xe1, 0x90, 0x31, 0x7e, 0x0b, 0x66, 0xbb, 0xb8, 0x48, 0xf9, 0x90, 0x78,
+	0x9e, 0x32, 0x29, 0x5c, 0x91, 0x0d, 0xcc, 0xc4, 0x42, 0x64, 0xb6, 0xb9, 0xaa, 0x46, 0xd2, 0xc9,
+	0x3c, 0x80, 0x3f, 0x23, 0xe8, 0x8a, 0x54, 0x11, 0x1c, 0x00, 0x28, 0xe6, 0x52, 0x69, 0x70, 0xcb,
+	0x46, 0x15, 0x9a, 0xea, 0xf0, 0xae, 0x55, 0xd6, 0x33, 0x11, 0x6e, 0x25, 0x06, 0x77, 0x4b, 0x24,
+	0xaf, 0x54, 0xf3, 0xc1, 0x96, 0x19, 0x2a, 0xec, 0xbb, 0x07, 0x44, 0x26, 0x9d, 0x25, 0x56, 0xd5,
+	0x7e, 0xe1, 0xaf, 0xd8, 0xed, 0x23, 0xda, 0x46, 0x27, 0xb5, 0xb6, 0x7e, 0x0e, 0x66, 0x7b, 0xf2,
+	0x2e, 0x4b, 0x4d, 0xd5, 0x44, 0x64, 0x73, 0x19, 0xc6, 0x8b, 0x18, 0xbd, 0x16, 0xd9, 0x5c, 0x7d,
+	0xe1, 0x25, 0xb2, 0xea, 0x5c, 0x6c, 0x96, 0x8b, 0x7a, 0x6d, 0xf9, 0xbc, 0x01, 0x17, 0xcd, 0xf5,
+	0xd9, 0x09, 0x03, 0xe7, 0xae, 0x6c, 0x7d, 0x0d, 0xc0, 0x0e, 0xd6, 0x57, 0x5d, 0x9f, 0x83, 0xab,
+	0xbb, 0xc7, 0x24, 0xc1, 0xec, 0x46, 0x8a, 0x5d, 0x58, 0x32, 0xbc, 0x1d, 0x3c, 0x72, 0xae, 0x42,
+	0x76, 0xf0, 0x48, 0x36, 0x5a, 0x35, 0xbd, 0xee, 0x5f, 0xe3, 0xb7, 0x9d, 0xe4, 0x98, 0x2f, 0x43,
+	0xff, 0x3b, 0x0d, 0x66, 0xf6, 0xf0, 0x21, 0x4a, 0x15, 0x41, 0x1f, 0x5c, 0xed, 0xa3, 0x23, 0x7c,
+	0x88, 0xd4, 0x95, 0x97, 0xf8, 0xe6, 0xec, 0xbc, 0x4a, 0x28, 0x6b, 0x60, 0x4e, 0xe0, 0x2c, 0xb2,
+	0x0d, 0x28, 0x03, 0x0c, 0x72, 0x6e, 0xc3, 0xba, 0xe5, 0x18, 0xac, 0x88, 0x3c, 0x77, 0x4e, 0x70,
+	0x1a, 0x26, 0x9d, 0x28, 0x42, 0x84, 0x70, 0x56, 0x02, 0xdf, 0xb3, 0xf3, 0xe0, 0x55, 0xbd, 0xe2,
+	0x95, 0x21, 0x51, 0x8d, 0x89, 0x55, 0xc3, 0x79, 0xee, 0x46, 0x03, 0x5e, 0x17, 0x6e, 0x64, 0x21,
+	0x85, 0x6f, 0xc0, 0xda, 0xb6, 0x3c, 0xab, 0xd5, 0x94, 0x85, 0xeb, 0x13, 0x61, 0x9d, 0x30, 0xab,
+	0xd6, 0x42, 0xd6, 0x5e, 0x26, 0xa3, 0x7c, 0x43, 0xcb, 0x2a, 0x4d, 0xc0, 0xaa, 0x68, 0xa5, 0x3a,
+	0xde, 0xf7, 0xed, 0x66, 0x3c, 0x85, 0xbd, 0xbe, 0xb9, 0xe5, 0x52, 0xbc, 0x31, 0xa7, 0x09, 0xd5,
+	0x02, 0xf6, 0x95, 0xba, 0x85, 0x21, 0x84, 0x6f, 0x67, 0xab, 0xf6, 0x2d, 0x8c, 0x90, 0x55, 0xae,
+	0x61, 0xb8, 0xd8, 0x6a, 0x45, 0x79, 0x69, 0x02, 0x5b, 0x02, 0x9d, 0x28, 0x2c, 0x04, 0x5a, 0xc2,
+	0x0f, 0x8d, 0xbe, 0x66, 0x7b, 0xe7, 0xe2, 0xd7, 0xbb, 0xbe, 0xce, 0xc1, 0x3d, 0x7f, 0xa9, 0x0c,
+	0x6e, 0x0d, 0x96, 0x9f, 0x82, 0x66, 0x09, 0x50, 0x9d, 0x49, 0x4a, 0xc2, 0x73, 0x0d, 0xc7, 0x0d,
+	0x97, 0x44, 0x36, 0xd2, 0x5f, 0xf2, 0x55, 0x9e, 0x20, 0xda, 0x0b, 0x09, 0x79, 0x8d, 0xf3, 0xa1,
+	0xe8, 0x93, 0x3b, 0x0a, 0xcb, 0xd5, 0x38, 0xd7, 0x3f, 0x75, 0x06, 0x92, 0xb8, 0xcd, 0x89, 0x3f,
+	0xf0, 0xde, 0x17, 0xc4, 0x39, 0xb3, 0xdc, 0xcc, 0xa4, 0xe9, 0xa6, 0x98, 0x16, 0x27, 0xcf, 0x09,
+	0xca, 0x65, 0x5a, 0x2e, 0x06, 0xcd, 0x12, 0x9a, 0xa9, 0xab, 0x25, 0x74, 0xee, 0x69, 0x1c, 0x9d,
+	0x64, 0x36, 0x1b, 0x69, 0x0d, 0x33, 0x9b, 0xe8, 0x5f, 0x82, 0xe6, 0x53, 0xfe, 0x9e, 0x43, 0x4d,
+	0xf4, 0x6d, 0x70, 0x65, 0x17, 0xa5, 0x43, 0x38, 0xd3, 0x96, 0xef, 0x3c, 0x98, 0xda, 0x5b, 0x51,
+	0xdf, 0x98, 0x8e, 0x49, 0x6a, 0x16, 0x5d, 0x61, 0x11, 0x10, 0x94, 0x72, 0xe4, 0xaf, 0x41, 0x53,
+	0x86, 0x4a, 0x12, 0xf9, 0x33, 0xf0, 0x8e, 0xb8, 0x67, 0x5c, 0x10, 0x8b, 0xad, 0xd0, 0x3a, 0x09,
+	0x2e, 0x25, 0x24, 0x45, 0x42, 0x89, 0x95, 0x03, 0x11, 0x8b, 0x79, 0xc0, 0x2f, 0x15, 0x19, 0xfa,
+	0x3f, 0x5d, 0x01, 0x8d, 0xbd, 0x1c, 0xe9, 0xc5, 0xf7, 0x8f, 0x40, 0xf3, 0x7e, 0x91, 0x1c, 0xb2,
+	0xe3, 0xbc, 0x20, 0x91, 0x59, 0xa8, 0x6d, 0x44, 0x99, 0xfc, 0x29, 0xa2, 0xa1, 0x62, 0x92, 0x59,
+	0x30, 0x23, 0x96, 0x35, 0x31, 0xb7, 0x82, 0xcc, 0xbd, 0x80, 0xd0, 0x90, 0xf2, 0xd0, 0xe8, 0x0b,
+	0xd0, 0x10, 0x97, 0x40, 0x25, 0x60, 0x4b, 0x74, 0xc6, 0xed, 0x9c, 0x69, 0x21, 0x8e, 0x6b, 0xae,
+	0x88, 0xf6, 0xc0, 0xb5, 0x9f, 0xa0, 0x70, 0xc8, 0xec, 0xa1, 0x2c, 0xab, 0xbe, 0x3b, 0xbe, 0x1a,
+	0x71, 0xe5, 0x1e, 0x42, 0xfb, 0x2a, 0x36, 0x87, 0xb7, 0xf0, 0x25, 0x68, 0x88, 0x70, 0xa7, 0xe4,
+	0xae, 0x25, 0x72, 0x8e, 0xa6, 0x25, 0x4d, 0xa5, 0x53, 0x39, 0xbc, 0x49, 0x56, 0x7e, 0x0b, 0x66,
+	0xfa, 0x88, 0x50, 0x9c, 0x4b, 0xf4, 0x55, 0x3d, 0xf8, 0xb4, 0xcc, 0xd9, 0x73, 0xca, 0xaa, 0x4a,
+	0x6e, 0x8b, 0xe3, 0xe7, 0xc2, 0x86, 0x11, 0xbc, 0x02, 0xb3, 0xa2, 0x65, 0x77, 0x91, 0x6c, 0x3f,
+	0x75, 0xc0, 0x76, 0xc4, 0x4e, 0xcc, 0x5d, 0xd1, 0x96, 0x13, 0x3a, 0xfe, 0xac, 0x6c, 0x28, 0x65,
+	0xc0, 0xc6, 0x50, 0x06, 0xe6, 0xf4, 0xf1, 0x59, 0x8d, 0xa3, 0xaf, 0xc5, 0x15, 0xa9, 0x96, 0xdb,
+	0x57, 0xa4, 0x95, 0xb3, 0xf6, 0x5a, 0xad, 0xae, 0x9c, 0x58, 0x81, 0xc0, 0x9c, 0xa0, 0xb6, 0xfe,
+	0xe7, 0x12, 0x68, 0xb0, 0x31, 0x67, 0x76, 0x55, 0x96, 0xb7, 0x67, 0x12, 0xc5, 0xc3, 0x3e, 0xb3,
+	0x0b, 0x97, 0x52, 0x12, 0xc1, 0x8e, 0x4e, 0xcc, 0x8c, 0x1e, 0x23, 0x1a, 0x06, 0x23, 0x24, 0x3b,
+	0x5e, 0xbf, 0x4f, 0xd9, 0xe1, 0x17, 0x33, 0x1c, 0x73, 0xd1, 0x60, 0x9a, 0xf1, 0x78, 0x1a, 0x1a,
+	0xa9, 0xa0, 0x7d, 0xa9, 0xee, 0x27, 0x2e, 0xe4, 0xa4, 0x39, 0xe9, 0x70, 0x58, 0x31, 0x7e, 0x1c,
+	0xe4, 0xaf, 0x40, 0xc3, 0x9a, 0x9c, 0xdf, 0x61, 0xbe, 0x9a, 0xd3, 0x0c, 0x27, 0xe1, 0x69, 0xf5,
+	0x11, 0xe2, 0xd1, 0xd9, 0x2f, 0x01, 0x98, 0x65, 0xeb, 0xa9, 0xdd, 0xd6, 0x23, 0xd0, 0x12, 0xc7,
+	0x11, 0xa5, 0x80, 0x9e, 0xb8, 0x95, 0x28, 0x09, 0x4d, 0xd7, 0xd6, 0xe9, 0xca, 0x91, 0x99, 0x37,
+	0xcf, 0x6f, 0x16, 0x36, 0x39, 0xbd, 0x78, 0x49, 0xc5, 0x2a, 0x36, 0x04, 0x2d, 0x73, 0x83, 0x62,
+	0x11, 0x95, 0x85, 0x4e, 0xb6, 0x5c, 0x89, 0xab, 0x69, 0x1a, 0xdf, 0x66, 0x31, 0x31, 0xed, 0x10,
+	0x34, 0x59, 0x99, 0xfb, 0x18, 0x1f, 0x8e, 0xc3, 0xfc, 0x50, 0x0f, 0xd4, 0x92, 0xf0, 0xac, 0x26,
+	0x34, 0xdd, 0x6f, 0x28, 0x06, 0xaa, 0xb0, 0xcc, 0xf4, 0x2e, 0x97, 0x1b, 0x41, 0xf7, 0x3b, 0x7c,
+	0xb7, 0xa6, 0x89, 0x2a, 0xa3, 0xe2, 0xde, 0xe9, 0x46, 0x65, 0x3f, 0x3c, 0xdb, 0x8f, 0x54, 0x59,
+	0x31, 0x3f, 0x4e, 0xc0, 0x12, 0x9b, 0x65, 0x55, 0x27, 0xee, 0xea, 0xec, 0xe6, 0x44, 0x17, 0xee,
+	0x96, 0x5b, 0x58, 0xeb, 0x6b, 0x33, 0x62, 0x35, 0xfc, 0xf0, 0x48, 0x9c, 0xc1, 0x15, 0xc0, 0x5e,
+	0x38, 0x2a, 0xbd, 0x95, 0xb1, 0xe5, 0x4e, 0x8e, 0xbb, 0xaa, 0x2e, 0xe7, 0xa4, 0xe0, 0x9a, 0x45,
+	0x48, 0xc3, 0x11, 0x11, 0x6f, 0x9d, 0x38, 0xed, 0x5b, 0x48, 0x40, 0xab, 0x57, 0xd8, 0xe5, 0x55,
+	0x64, 0x55, 0x96, 0x3a, 0x67, 0x7f, 0x57, 0x59, 0x4e, 0xc2, 0xf9, 0xa7, 0x31, 0xb2, 0x96, 0xfe,
+	0xb3, 0x29, 0x00, 0xcd, 0x8d, 0xa4, 0xae, 0xef, 0x1d, 0x7b, 0xb3, 0xa8, 0xab, 0xf1, 0xfa, 0x64,
+	0x03, 0xe9, 0xc1, 0x06, 0xf7, 0xe0, 0xde, 0x86, 0x7f, 0x8a, 0x07, 0xc1, 0x09, 0x2b, 0xf2, 0x16,
+	0x8e, 0x01, 0x64, 0x83, 0xd5, 0x99, 0xb0, 0x77, 0xcc, 0x30, 0xae, 0x9f, 0xb5, 0x5e, 0xb9, 0xab,
+	0x99, 0x61, 0xcd, 0x4e, 0x60, 0x8d, 0xf5, 0x22, 0x39, 0x14, 0xe9, 0x84, 0x96, 0x78, 0x58, 0xa0,
+	0xa9, 0x64, 0x4b, 0x97, 0xa5, 0xe7, 0xa1, 0x91, 0x77, 0xf8, 0xfe, 0x0d, 0x87, 0xc6, 0x7a, 0x8b,
+	0x90, 0xa8, 0xe3, 0xa8, 0xcb, 0xf6, 0xf0, 0xcd, 0xff, 0x1b, 0x9b, 0x39, 0xa9, 0xfe, 0xd7, 0x65,
+	0xd0, 0x78, 0x82, 0x07, 0x7a, 0x87, 0xfb, 0x46, 0x2c, 0x1c, 0x62, 0xc3, 0x7c, 0x82, 0x07, 0x6a,
+	0x97, 0x60, 0xc2, 0x27, 0x78, 0x50, 0x73, 0xa9, 0xca, 0xa5, 0x95, 0x99, 0xca, 0x5f, 0xe4, 0x8a,
+	0xdb, 0xe4, 0x27, 0x78, 0xa0, 0x1f, 0x1b, 0xbe, 0x00, 0x33, 0x3c, 0x92, 0x8d, 0x09, 0x65, 0xac,
+	0x70, 0xa9, 0xcd, 0x0c, 0xdb, 0xea, 0x7b, 0xcd, 0xb2, 0xc7, 0xc4, 0xb5, 0x69, 0x71, 0xcd, 0xc0,
+	0x70, 0x9f, 0x83, 0x16, 0x77, 0x5b, 0x3c, 0xef, 0x62, 0x7e, 0xcf, 0x0b, 0xe4, 0x2e, 0xcd, 0x93,
+	0x2e, 0x1e, 0x8f, 0xc3, 0x74, 0xe8, 0xad, 0x56, 0x44, 0xee, 0xcd, 0xb9, 0xe7, 0xc0, 0x22, 0xb1,
+	0x51, 0x88, 0x61, 0xbb, 0x17, 0x92, 0x43, 0x16, 0x31, 0x71, 0x10, 0x4b, 0x64, 0x22, 0xa6, 0xaa,
+	0xa6, 0x72, 0xd4, 0xe5, 0xf0, 0x94, 0x29, 0xad, 0xb8, 0xe9, 0x1b, 0x19, 0x56, 0x30, 0xf1, 0x0e,
+	0x1e, 0x91, 0x8b, 0x1f, 0xd3, 0x4d, 0x4a, 0xd4, 0x22, 0x48, 0xf0, 0x88, 0x47, 0xc3, 0xff, 0x32,
+	0x05, 0xe6, 0xf8, 0xf3, 0x18, 0x3b, 0x24, 0x7e, 0x29, 0x38, 0xb5, 0x5c, 0x3d, 0x23, 0x54, 0x49,
+	0x8a, 0xb3, 0xe2, 0x56, 0xc3, 0xc8, 0x8a, 0x05, 0x21, 0xc3, 0xd1, 0x6f, 0xac, 0x5e, 0x82, 0x26,
+	0x8b, 0xb5, 0x0d, 0xf8, 0x92, 0x00, 0xef, 0x57, 0x02, 0x58, 0x47, 0x5c, 0xb9, 0x0a, 0xb6, 0xc0,
+	0x09, 0xe5, 0x67, 0xe4, 0xad, 0x5f, 0x4d, 0x81, 0x99, 0x6d, 0xf6, 0x66, 0xde, 0x44, 0x65, 0xd7,
+	0xf9, 0x7b, 0x0b, 0x1a, 0x52, 0xa4, 0x6e, 0xb1, 0xb5, 0xc0, 0x39, 0xb9, 0x5a, 0x72, 0xc9, 0x25,
+	0x73, 0xbe, 0xf0, 0x46, 0xc0, 0x1f, 0xe2, 0x73, 0x1a, 0x76, 0xa5, 0x83, 0x46, 0x63, 0x94, 0x52,
+	0x16, 0x31, 0x5f, 0xeb, 0xa3, 0x44, 0xdc, 0x5d, 0xaa, 0x37, 0x20, 0xf2, 0xbb, 0xb3, 0x81, 0x1a,
+	0x71, 0xf9, 0xdc, 0x0a, 0x57, 0x24, 0x74, 0x2e, 0x0d, 0xc4, 0x71, 0xee, 0xf1, 0xf0, 0xed, 0xd6,
+	0x08, 0x34, 0xbb, 0x07, 0x61, 0x3a, 0xd2, 0xdd, 0xf2, 0x02, 0x00, 0xf6, 0x7e, 0x98, 0xcb, 0x88,
+	0x7e, 0x40, 0xcc, 0xbf, 0x3a, 0x6c, 0x42, 0x58, 0xdb, 0x23, 0x91, 0x28, 0xce, 0x2a, 0xf1, 0xb3,
+	0xc7, 0x0f, 0xd8, 0xc4, 0xdb, 0xfa, 0x8f, 0x16, 0x98, 0xd9, 0x3d, 0x08, 0x73, 0x4d, 0xd4, 0xe5,
+	0xaf, 0x52, 0xba, 0x28, 0x49, 0xd4, 0x14, 0x97, 0x5f, 0x4d, 0xc4, 0x26, 0x68, 0x50, 0x92, 0xa8,
+	0xc3, 0x8f, 0xd7, 0x08, 0xf8, 0xef, 0x13, 0xf8, 0x23, 0x6e, 0xd6, 0xcf, 0xdb, 0x3c, 0x42, 0xb5,
+	0x41, 0xb6, 0xd1, 0x44, 0x10, 0xf3, 0xb4, 0xd5, 0x80, 0xa8, 0xd3, 0xf5, 0x4b, 0x15, 0x48, 0x72,
+	0xac, 0x65, 0x7b, 0xb7, 0xb0, 0xe1, 0x56, 0xaa, 0x8a, 0xca, 0xd5, 0x6c, 0x15, 0xbc, 0xcf, 0x6f,
+	0x0e, 0x79, 0xed, 0x77, 0xe2, 0xf4, 0x50, 0x1d, 0x4b, 0x6c, 0x99, 0x22, 0x98, 0x15, 0x2a, 0x2d,
+	0xaf, 0xd4, 0x3c, 0x89, 0xd3, 0x43, 0xb9, 0x90, 0x6d, 0xa3, 0x2a, 0xe6, 0x36, 0x3a, 0x07, 0xa6,
+	0xdb, 0x10, 0x0c, 0x53, 0xf9, 0xfa, 0x4a, 0xdd, 0x4b, 0x1a, 0xe8, 0x9b, 0x76, 0xa5, 0x2b, 0xe8,
+	0xb7, 0x26, 0x68, 0x27, 0xb4, 0x8b, 0xcd, 0xf5, 0xe7, 0x53, 0x60, 0x81, 0x27, 0x79, 0x54, 0x29,
+	0x91, 0x4b, 0x82, 0xd6, 0x8b, 0x55, 0x47, 0xe5, 0x04, 0x4d, 0xb5, 0x16, 0x95, 0x7b, 0x7a, 0x9b,
+	0x98, 0x19, 0x72, 0xf2, 0x20, 0xe4, 0xe6, 0xac, 0x29, 0x29, 0x80, 0x1a, 0xa8, 0x93, 0x65, 0x39,
+	0x3e, 0x0a, 0x93, 0xd2, 0xbb, 0xda, 0xb2, 0xa6, 0xe6, 0x5d, 0xad, 0x6b, 0x50, 0x59, 0x45, 0x84,
+	0x0b, 0xa1, 0xb2, 0x60, 0xac, 0xaf, 0xd9, 0x03, 0xcc, 0x28, 0x1e, 0xa2, 0x52, 0x61, 0xa8, 0x03,
+	0x95, 0x8a, 0xca, 0x4d, 0x45, 0xd8, 0x3a, 0xff, 0x43, 0x4e, 0xf5, 0xae, 0x7f, 0xdb, 0xa5, 0x52,
+	0xb9, 0xe1, 0x21, 0x07, 0x94, 0xc4, 0xda, 0x67, 0xb6, 0x01, 0xc9, 0xc7, 0xea, 0x6e, 0x75, 0x8c,
+	0x6a, 0x52, 0xa3, 0xdb, 0x16, 0x13, 0x6a, 0x9c, 0x2b, 0x0b, 0x46, 0x7c, 0x04, 0x16, 0x44, 0x5c,
+	0xc4, 0x4b, 0xeb, 0xf7, 0x01, 0x92, 0xb8, 0x46, 0xe5, 0x86, 0xc8, 0x75, 0x16, 0xe5, 0x61, 0xe6,
+	0xcd, 0x4a, 0xe2, 0x4c, 0x1a, 0x30, 0xde, 0x43, 0xb0, 0x24, 0xc2, 0x89, 0x47, 0xb1, 0x7e, 0x4d,
+	0xf7, 0x20, 0xc7, 0x99, 0xba, 0x94, 0xac, 0x55, 0x3a, 0xbb, 0x8f, 0xa3, 0xb5, 0x52, 0x10, 0x82,
+	0x6f, 0x98, 0xe3, 0x8c, 0x88, 0xd7, 0x2f, 0xcb, 0x5d, 0x3c, 0xce, 0x12, 0x54, 0xa5, 0x93, 0xb7,
+	0xc4, 0x13, 0xd4, 0x67, 0x10, 0x9a, 0xd7, 0x2f, 0x16, 0xa1, 0xea, 0xdc, 0x48, 0x42, 0x32, 0x07,
+	0x3e, 0x07, 0x8d, 0x5e, 0x41, 0x9f, 0x45, 0x63, 0xde, 0x50, 0xea, 0xe0, 0x6a, 0x89, 0x14, 0x51,
+	0x4b, 0xbe, 0xf2, 0x94, 0x62, 0x2b, 0x04, 0x11, 0x0c, 0x38, 0x1a, 0xcb, 0x93, 0x1c, 0xeb, 0x71,
+	0x65, 0x56, 0x4a, 0x39, 0x68, 0x61, 0x4d, 0xca, 0xc1, 0xd2, 0x55, 0x42, 0x5c, 0x4d, 0xa0, 0x37,
+	0xec, 0x01, 0x68, 0xb1, 0xf9, 0x9b, 0x19, 0xc7, 0x25, 0x54, 0x59, 0x3a, 0xc9, 0x77, 0x73, 0x29,
+	0x62, 0xa0, 0x4f, 0xcc, 0x3c, 0xcf, 0xa8, 0x78, 0x5f, 0xde, 0x12, 0xab, 0x94, 0xcb, 0x51, 0x96,
+	0x3a, 0x07, 0x16, 0x57, 0x59, 0x79, 0x86, 0xe1, 0x32, 0xc2, 0x14, 0xcc, 0xdb, 0x0d, 0x20, 0x52,
+	0x51, 0xb7, 0xab, 0x2d, 0x73, 0x9e, 0x28, 0xc7, 0xa4, 0x2a, 0x2a, 0x15, 0xd3, 0xc9, 0xc6, 0xbf,
+	0xbf, 0x0c, 0x5a, 0x8f, 0xc5, 0xcf, 0xb2, 0x4c, 0xbe, 0x91, 0xed, 0xe2, 0x52, 0x08, 0xd7, 0xda,
+	0xea, 0x57, 0x5b, 0xec, 0x87, 0x36, 0x68, 0x3f, 0x64, 0xd9, 0x4b, 0x53, 0xcd, 0x5a, 0xa5, 0xac,
+	0xa6, 0x7c, 0x43, 0x09, 0xaf, 0xa9, 0x1f, 0x7e, 0xc1, 0xe7, 0xa0, 0xc1, 0xae, 0x62, 0x15, 0xf6,
+	0xb2, 0x2e, 0x2e, 0x25, 0x66, 0xab, 0xac, 0x28, 0x24, 0xa6, 0xb9, 0xd2, 0x91, 0x16, 0xac, 0x73,
+	0xc6, 0x60, 0xa1, 0x87, 0x72, 0xf6, 0xb4, 0x57, 0x9a, 0x77, 0x0f, 0x50, 0xc4, 0xf6, 0x1e, 0x85,
+	0x22, 0xb5, 0x5c, 0x6c, 0x3d, 0x1e, 0xaa, 0xd5, 0x56, 0x52, 0x30, 0xd2, 0x2c, 0x88, 0x98, 0x5e,
+	0xbc, 0xe3, 0x64, 0xdb, 0x67, 0x67, 0x94, 0x23, 0xc4, 0xa2, 0x2c, 0x58, 0x6a, 0x05, 0x2d, 0xae,
+	0xf2, 0x94, 0xb5, 0xe5, 0x81, 0x0d, 0xa1, 0xe6, 0x09, 0x95, 0xcd, 0xd6, 0xaf, 0xa7, 0x40, 0x53,
+	0x2e, 0x5c, 0xb2, 0x6f, 0x7a, 0x2a, 0xd3, 0xc3, 0xd0, 0xe3, 0x1c, 0x0d, 0xe1, 0x52, 0x5b, 0xfe,
+	0xd0, 0xcd, 0xc8, 0x45, 0x9c, 0xe5, 0x88, 0x25, 0x9d, 0x7c, 0x57, 0x09, 0xa7, 0x65, 0x56, 0x07,
+	0x8e, 0x40, 0xa3, 0x93, 0x65, 0xc9, 0xb1, 0xb0, 0x83, 0x9e, 0x2a, 0x67, 0x09, 0xcd, 0x04, 0xad,
+	0xd3, 0x95, 0x4f, 0x4f, 0x70, 0x59, 0x02, 0xb3, 0xe3, 0x6e, 0x3e, 0xd2, 0xbf, 0xf8, 0x79, 0xbb,
+	0xf5, 0xef, 0xd3, 0x60, 0xf6, 0x91, 0xfc, 0x4d, 0xa8, 0xaa, 0xce, 0x97, 0x00, 0x70, 0x91, 0x88,
+	0x7e, 0x65, 0xe4, 0x64, 0x24, 0x4e, 0xe4, 0x64, 0x2b, 0xca, 0xd9, 0x5c, 0x38, 0x1b, 0xa8, 0x9f,
+	0x9b, 0x8a, 0x10, 0x98, 0xad, 0x3c, 0xdc, 0xfc, 0x3e, 0xc6, 0xfc, 0x07, 0x6d, 0x6a, 0xe5, 0x29,
+	0x09, 0x9d, 0x95, 0xc7, 0xd1, 0x55, 0x3a, 0x48, 0x53, 0x0c, 0x30, 0xa6, 0xec, 0xed, 0x25, 0x3c,
+	0x94, 0x2c, 0xfa, 0x8a, 0xd7, 0x66, 0x71, 0x6f, 0x78, 0xd7, 0x6a, 0x75, 0x95, 0xf7, 0xf1, 0x9a,
+	0x45, 0xbd, 0x61, 0x08, 0x4e, 0x76, 0xc2, 0x74, 0xf4, 0x96, 0x0d, 0x3b, 0x5e, 0xb6, 0x97, 0x14,
+	0xa3, 0xd8, 0xdc, 0x74, 0xd9, 0x32, 0xe7, 0xb4, 0x55, 0x56, 0x55, 0xe2, 0x7a, 0xcd, 0x94, 0x09,
+	0x13, 0x45, 0x14, 0x49, 0x22, 0x75, 0x1d, 0x65, 0x13, 0x39, 0xb7, 0x51, 0x5e, 0x9d, 0xaa, 0xf2,
+	0xa8, 0xd4, 0xf4, 0x8d, 0x30, 0x11, 0x1b, 0xab, 0x18, 0x0d, 0xe2, 0x1d, 0x6d, 0xa7, 0xa0, 0x07,
+	0x2a, 0x56, 0x74, 0xc4, 0x4e, 0xac, 0x58, 0xd1, 0x56, 0xa2, 0x07, 0xcd, 0x66, 0x1e, 0xe0, 0xbe,
+	0x06, 0x73, 0xd2, 0xc5, 0xfc, 0x08, 0xdd, 0x8f, 0xd3, 0x30, 0x3f, 0x86, 0xf6, 0xa0, 0x12, 0x22,
+	0xe7, 0xf6, 0xa0, 0xa4, 0x29, 0x5f, 0x77, 0xc1, 0xf7, 0xad, 0xc1, 0xc0, 0x2c, 0x62, 0xd6, 0x4d,
+	0xc2, 0x76, 0xef, 0x38, 0x43, 0xfa, 0x79, 0xc5, 0x1b, 0xd0, 0x12, 0x9d, 0x50, 0xd0, 0xef, 0x43,
+	0xfb, 0x11, 0xa7, 0xfd, 0x2d, 0xff, 0x9c, 0xb4, 0x62, 0xc3, 0x9a, 0xd9, 0x45, 0x94, 0xc6, 0xe9,
+	0x88, 0x3c, 0x45, 0x69, 0xa1, 0x3a, 0xd1, 0x96, 0x39, 0x9d, 0x58, 0x56, 0x55, 0xa6, 0xb5, 0xd5,
+	0x89, 0xc2, 0x6e, 0x73, 0x8c, 0xd2, 0xe2, 0xfe, 0xdf, 0x4d, 0xfd, 0x75, 0xe7, 0x6f, 0xa7, 0xe0,
+	0x8f, 0xc0, 0x62, 0x8f, 0xfd, 0x44, 0x76, 0x9d, 0x1d, 0x6c, 0xc8, 0x7a, 0x1f, 0x11, 0xba, 0xde,
+	0xe9, 0x3d, 0xf6, 0x3d, 0xf0, 0x0e, 0x97, 0xc3, 0xf9, 0x03, 0x4a, 0x33, 0xf2, 0x69, 0x20, 0x7e,
+	0x49, 0xcb, 0x7e, 0x53, 0xbb, 0x75, 0xf9, 0xa3, 0xf6, 0x0f, 0x37, 0x2e, 0x4f, 0x5d, 0xba, 0xb2,
+	0x35, 0x17, 0x66, 0x59, 0x12, 0x47, 0xe2, 0x7c, 0xf9, 0x8a, 0xe0, 0xf4, 0xd3, 0x8a, 0x24, 0xff,
+	0x21, 0x58, 0x7b, 0x8a, 0x73, 0xb4, 0x1e, 0x0e, 0x70, 0x41, 0xd7, 0x6d, 0xb2, 0x4e, 0x16, 0x93,
+	0x1a, 0xfc, 0xc1, 0x55, 0xfe, 0xeb, 0xd9, 0x8f, 0xff, 0x6f, 0x00, 0xff, 0xf6, 0xf6, 0x51, 0x09,
+	0x3f, 0x00, 0x00,
 }
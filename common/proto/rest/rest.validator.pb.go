@@ -8,6 +8,7 @@ import fmt "fmt"
 import math "math"
 import _ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
 import _ "github.com/pydio/cells/common/proto/activity"
+import _ "github.com/pydio/cells/common/proto/chat"
 import _ "github.com/pydio/cells/common/proto/ctl"
 import _ "github.com/pydio/cells/common/proto/encryption"
 import _ "github.com/pydio/cells/common/proto/idm"
@@ -16,6 +17,7 @@ import _ "github.com/pydio/cells/common/proto/jobs"
 import _ "github.com/pydio/cells/common/proto/log"
 import _ "github.com/pydio/cells/common/proto/mailer"
 import _ "github.com/pydio/cells/common/proto/object"
+import _ "github.com/pydio/cells/common/proto/presence"
 import _ "github.com/pydio/cells/common/proto/tree"
 import _ "github.com/pydio/cells/common/proto/update"
 import _ "google.golang.org/genproto/googleapis/api/annotations"
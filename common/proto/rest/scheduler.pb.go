@@ -78,7 +78,7 @@ func init() {
 func init() { proto.RegisterFile("scheduler.proto", fileDescriptor8) }
 
 var fileDescriptor8 = []byte{
-	// 209 bytes of a gzipped FileDescriptorProto
+	// 206 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x8e, 0xc1, 0x4a, 0xc5, 0x30,
 	0x10, 0x45, 0xa9, 0x3e, 0x94, 0x17, 0xa1, 0x85, 0xac, 0x82, 0x20, 0x94, 0x2e, 0xa4, 0x20, 0x34,
 	0x60, 0x3f, 0xc1, 0x5d, 0x17, 0x22, 0x85, 0x7e, 0x40, 0x93, 0x0e, 0x36, 0x92, 0x64, 0x6a, 0x26,
@@ -91,6 +91,5 @@ var fileDescriptor8 = []byte{
 	0x03, 0x17, 0x6d, 0xf3, 0xc4, 0xaa, 0x3f, 0x27, 0x6d, 0xe8, 0x09, 0x0e, 0xe9, 0x94, 0xcc, 0xf2,
 	0x4f, 0xba, 0xc7, 0xa6, 0x67, 0xfc, 0x80, 0xe9, 0x05, 0xad, 0x05, 0x1d, 0x0d, 0x7a, 0xfe, 0xc0,
 	0x4e, 0x7b, 0x23, 0x8a, 0xfa, 0xba, 0xbd, 0x7b, 0x3e, 0x77, 0x79, 0xf1, 0x2e, 0xcc, 0xb5, 0xba,
-	0xc9, 0xe3, 0xfb, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x59, 0xb2, 0xcf, 0x09, 0x0a, 0x01, 0x00,
-	0x00,
+	0xc9, 0xe3, 0xfb, 0xef, 0x01, 0x00, 0x59, 0xb2, 0xcf, 0x09, 0x0a, 0x01, 0x00, 0x00,
 }
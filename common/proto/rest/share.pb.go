@@ -44,6 +44,126 @@ func (x ShareLinkAccessType) String() string {
 }
 func (ShareLinkAccessType) EnumDescriptor() ([]byte, []int) { return fileDescriptor9, []int{0} }
 
+// Direction of a federated share
+// Kind of access recorded on a public link
+type ShareLinkAccessAction int32
+
+const (
+	ShareLinkAccessAction_OPEN     ShareLinkAccessAction = 0
+	ShareLinkAccessAction_PREVIEW  ShareLinkAccessAction = 1
+	ShareLinkAccessAction_DOWNLOAD ShareLinkAccessAction = 2
+)
+
+var ShareLinkAccessAction_name = map[int32]string{
+	0: "OPEN",
+	1: "PREVIEW",
+	2: "DOWNLOAD",
+}
+var ShareLinkAccessAction_value = map[string]int32{
+	"OPEN":     0,
+	"PREVIEW":  1,
+	"DOWNLOAD": 2,
+}
+
+func (x ShareLinkAccessAction) String() string {
+	return proto.EnumName(ShareLinkAccessAction_name, int32(x))
+}
+func (ShareLinkAccessAction) EnumDescriptor() ([]byte, []int) { return fileDescriptor9, []int{1} }
+
+type OcmShareDirection int32
+
+const (
+	OcmShareDirection_OUTGOING OcmShareDirection = 0
+	OcmShareDirection_INCOMING OcmShareDirection = 1
+)
+
+var OcmShareDirection_name = map[int32]string{
+	0: "OUTGOING",
+	1: "INCOMING",
+}
+var OcmShareDirection_value = map[string]int32{
+	"OUTGOING": 0,
+	"INCOMING": 1,
+}
+
+func (x OcmShareDirection) String() string {
+	return proto.EnumName(OcmShareDirection_name, int32(x))
+}
+func (OcmShareDirection) EnumDescriptor() ([]byte, []int) { return fileDescriptor9, []int{2} }
+
+type OcmShareStatus int32
+
+const (
+	OcmShareStatus_PENDING  OcmShareStatus = 0
+	OcmShareStatus_ACCEPTED OcmShareStatus = 1
+	OcmShareStatus_DECLINED OcmShareStatus = 2
+)
+
+var OcmShareStatus_name = map[int32]string{
+	0: "PENDING",
+	1: "ACCEPTED",
+	2: "DECLINED",
+}
+var OcmShareStatus_value = map[string]int32{
+	"PENDING":  0,
+	"ACCEPTED": 1,
+	"DECLINED": 2,
+}
+
+func (x OcmShareStatus) String() string {
+	return proto.EnumName(OcmShareStatus_name, int32(x))
+}
+func (OcmShareStatus) EnumDescriptor() ([]byte, []int) { return fileDescriptor9, []int{3} }
+
+type ShareApproval_ApprovalStatus int32
+
+const (
+	ShareApproval_PENDING  ShareApproval_ApprovalStatus = 0
+	ShareApproval_APPROVED ShareApproval_ApprovalStatus = 1
+	ShareApproval_REJECTED ShareApproval_ApprovalStatus = 2
+)
+
+var ShareApproval_ApprovalStatus_name = map[int32]string{
+	0: "PENDING",
+	1: "APPROVED",
+	2: "REJECTED",
+}
+var ShareApproval_ApprovalStatus_value = map[string]int32{
+	"PENDING":  0,
+	"APPROVED": 1,
+	"REJECTED": 2,
+}
+
+func (x ShareApproval_ApprovalStatus) String() string {
+	return proto.EnumName(ShareApproval_ApprovalStatus_name, int32(x))
+}
+func (ShareApproval_ApprovalStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor9, []int{12, 0}
+}
+
+type ShareApproval_ApprovalShareType int32
+
+const (
+	ShareApproval_LINK ShareApproval_ApprovalShareType = 0
+	ShareApproval_CELL ShareApproval_ApprovalShareType = 1
+)
+
+var ShareApproval_ApprovalShareType_name = map[int32]string{
+	0: "LINK",
+	1: "CELL",
+}
+var ShareApproval_ApprovalShareType_value = map[string]int32{
+	"LINK": 0,
+	"CELL": 1,
+}
+
+func (x ShareApproval_ApprovalShareType) String() string {
+	return proto.EnumName(ShareApproval_ApprovalShareType_name, int32(x))
+}
+func (ShareApproval_ApprovalShareType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor9, []int{12, 1}
+}
+
 type ListSharedResourcesRequest_ListShareType int32
 
 const (
@@ -67,7 +187,7 @@ func (x ListSharedResourcesRequest_ListShareType) String() string {
 	return proto.EnumName(ListSharedResourcesRequest_ListShareType_name, int32(x))
 }
 func (ListSharedResourcesRequest_ListShareType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor9, []int{12, 0}
+	return fileDescriptor9, []int{32, 0}
 }
 
 // Group collected acls by subjects
@@ -136,6 +256,12 @@ type Cell struct {
 	ACLs                    map[string]*CellAcl       `protobuf:"bytes,5,rep,name=ACLs" json:"ACLs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	Policies                []*service.ResourcePolicy `protobuf:"bytes,6,rep,name=Policies" json:"Policies,omitempty"`
 	PoliciesContextEditable bool                      `protobuf:"varint,7,opt,name=PoliciesContextEditable" json:"PoliciesContextEditable,omitempty"`
+	// Expiration date, members lose their access after this date
+	AccessEnd int64 `protobuf:"varint,8,opt,name=AccessEnd" json:"AccessEnd,omitempty"`
+	// Set once the Cell has expired, only its owner can still access it
+	Disabled bool `protobuf:"varint,9,opt,name=Disabled" json:"Disabled,omitempty"`
+	// External users invited in this Cell are waiting for an approver decision
+	PendingApproval bool `protobuf:"varint,10,opt,name=PendingApproval" json:"PendingApproval,omitempty"`
 }
 
 func (m *Cell) Reset()                    { *m = Cell{} }
@@ -192,6 +318,27 @@ func (m *Cell) GetPoliciesContextEditable() bool {
 	return false
 }
 
+func (m *Cell) GetAccessEnd() int64 {
+	if m != nil {
+		return m.AccessEnd
+	}
+	return 0
+}
+
+func (m *Cell) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
+func (m *Cell) GetPendingApproval() bool {
+	if m != nil {
+		return m.PendingApproval
+	}
+	return false
+}
+
 type ShareLinkTargetUser struct {
 	Display       string `protobuf:"bytes,1,opt,name=Display" json:"Display,omitempty"`
 	DownloadCount int32  `protobuf:"varint,2,opt,name=DownloadCount" json:"DownloadCount,omitempty"`
@@ -237,6 +384,12 @@ type ShareLink struct {
 	Permissions             []ShareLinkAccessType           `protobuf:"varint,17,rep,packed,name=Permissions,enum=rest.ShareLinkAccessType" json:"Permissions,omitempty"`
 	Policies                []*service.ResourcePolicy       `protobuf:"bytes,18,rep,name=Policies" json:"Policies,omitempty"`
 	PoliciesContextEditable bool                            `protobuf:"varint,19,opt,name=PoliciesContextEditable" json:"PoliciesContextEditable,omitempty"`
+	// When set, the link is an upload-only file request
+	FileRequest *ShareLinkFileRequest `protobuf:"bytes,20,opt,name=FileRequest" json:"FileRequest,omitempty"`
+	// Set once the link has expired and its user has been locked
+	Disabled bool `protobuf:"varint,21,opt,name=Disabled" json:"Disabled,omitempty"`
+	// The link cannot be opened until an approver accepts it
+	PendingApproval bool `protobuf:"varint,22,opt,name=PendingApproval" json:"PendingApproval,omitempty"`
 }
 
 func (m *ShareLink) Reset()                    { *m = ShareLink{} }
@@ -377,6 +530,959 @@ func (m *ShareLink) GetPoliciesContextEditable() bool {
 	return false
 }
 
+func (m *ShareLink) GetFileRequest() *ShareLinkFileRequest {
+	if m != nil {
+		return m.FileRequest
+	}
+	return nil
+}
+
+func (m *ShareLink) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
+func (m *ShareLink) GetPendingApproval() bool {
+	if m != nil {
+		return m.PendingApproval
+	}
+	return false
+}
+
+// Options of an upload-only link, where each uploader drops files in its own folder
+type ShareLinkFileRequest struct {
+	// Maximum size of each uploaded file, zero for no limit
+	MaxFileSize int64 `protobuf:"varint,1,opt,name=MaxFileSize" json:"MaxFileSize,omitempty"`
+	// Allowed files extensions, empty for any
+	AllowedExtensions []string `protobuf:"bytes,2,rep,name=AllowedExtensions" json:"AllowedExtensions,omitempty"`
+}
+
+func (m *ShareLinkFileRequest) Reset()                    { *m = ShareLinkFileRequest{} }
+func (m *ShareLinkFileRequest) String() string            { return proto.CompactTextString(m) }
+func (*ShareLinkFileRequest) ProtoMessage()               {}
+func (*ShareLinkFileRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{4} }
+
+func (m *ShareLinkFileRequest) GetMaxFileSize() int64 {
+	if m != nil {
+		return m.MaxFileSize
+	}
+	return 0
+}
+
+func (m *ShareLinkFileRequest) GetAllowedExtensions() []string {
+	if m != nil {
+		return m.AllowedExtensions
+	}
+	return nil
+}
+
+// Drop session of one uploader on a file request link
+type FileRequestDrop struct {
+	Uuid          string `protobuf:"bytes,1,opt,name=Uuid" json:"Uuid,omitempty"`
+	LinkUuid      string `protobuf:"bytes,2,opt,name=LinkUuid" json:"LinkUuid,omitempty"`
+	UploaderName  string `protobuf:"bytes,3,opt,name=UploaderName" json:"UploaderName,omitempty"`
+	UploaderEmail string `protobuf:"bytes,4,opt,name=UploaderEmail" json:"UploaderEmail,omitempty"`
+	// Folder created for this uploader, its name is relative to the link root
+	FolderUuid string `protobuf:"bytes,5,opt,name=FolderUuid" json:"FolderUuid,omitempty"`
+	FolderName string `protobuf:"bytes,6,opt,name=FolderName" json:"FolderName,omitempty"`
+	// Internal path of the folder, not sent to the uploader
+	FolderPath  string `protobuf:"bytes,7,opt,name=FolderPath" json:"FolderPath,omitempty"`
+	CreatedAt   int64  `protobuf:"varint,8,opt,name=CreatedAt" json:"CreatedAt,omitempty"`
+	CompletedAt int64  `protobuf:"varint,9,opt,name=CompletedAt" json:"CompletedAt,omitempty"`
+	FilesCount  int32  `protobuf:"varint,10,opt,name=FilesCount" json:"FilesCount,omitempty"`
+}
+
+func (m *FileRequestDrop) Reset()                    { *m = FileRequestDrop{} }
+func (m *FileRequestDrop) String() string            { return proto.CompactTextString(m) }
+func (*FileRequestDrop) ProtoMessage()               {}
+func (*FileRequestDrop) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{5} }
+
+func (m *FileRequestDrop) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *FileRequestDrop) GetLinkUuid() string {
+	if m != nil {
+		return m.LinkUuid
+	}
+	return ""
+}
+
+func (m *FileRequestDrop) GetUploaderName() string {
+	if m != nil {
+		return m.UploaderName
+	}
+	return ""
+}
+
+func (m *FileRequestDrop) GetUploaderEmail() string {
+	if m != nil {
+		return m.UploaderEmail
+	}
+	return ""
+}
+
+func (m *FileRequestDrop) GetFolderUuid() string {
+	if m != nil {
+		return m.FolderUuid
+	}
+	return ""
+}
+
+func (m *FileRequestDrop) GetFolderName() string {
+	if m != nil {
+		return m.FolderName
+	}
+	return ""
+}
+
+func (m *FileRequestDrop) GetFolderPath() string {
+	if m != nil {
+		return m.FolderPath
+	}
+	return ""
+}
+
+func (m *FileRequestDrop) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *FileRequestDrop) GetCompletedAt() int64 {
+	if m != nil {
+		return m.CompletedAt
+	}
+	return 0
+}
+
+func (m *FileRequestDrop) GetFilesCount() int32 {
+	if m != nil {
+		return m.FilesCount
+	}
+	return 0
+}
+
+type CreateFileRequestDropRequest struct {
+	LinkUuid      string `protobuf:"bytes,1,opt,name=LinkUuid" json:"LinkUuid,omitempty"`
+	UploaderName  string `protobuf:"bytes,2,opt,name=UploaderName" json:"UploaderName,omitempty"`
+	UploaderEmail string `protobuf:"bytes,3,opt,name=UploaderEmail" json:"UploaderEmail,omitempty"`
+}
+
+func (m *CreateFileRequestDropRequest) Reset()                    { *m = CreateFileRequestDropRequest{} }
+func (m *CreateFileRequestDropRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateFileRequestDropRequest) ProtoMessage()               {}
+func (*CreateFileRequestDropRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{6} }
+
+func (m *CreateFileRequestDropRequest) GetLinkUuid() string {
+	if m != nil {
+		return m.LinkUuid
+	}
+	return ""
+}
+
+func (m *CreateFileRequestDropRequest) GetUploaderName() string {
+	if m != nil {
+		return m.UploaderName
+	}
+	return ""
+}
+
+func (m *CreateFileRequestDropRequest) GetUploaderEmail() string {
+	if m != nil {
+		return m.UploaderEmail
+	}
+	return ""
+}
+
+type CompleteFileRequestDropRequest struct {
+	Uuid string `protobuf:"bytes,1,opt,name=Uuid" json:"Uuid,omitempty"`
+}
+
+func (m *CompleteFileRequestDropRequest) Reset()                    { *m = CompleteFileRequestDropRequest{} }
+func (m *CompleteFileRequestDropRequest) String() string            { return proto.CompactTextString(m) }
+func (*CompleteFileRequestDropRequest) ProtoMessage()               {}
+func (*CompleteFileRequestDropRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{7} }
+
+func (m *CompleteFileRequestDropRequest) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+// One access to a public link, by a target user or an anonymous visitor
+type ShareLinkAccess struct {
+	Uuid     string                `protobuf:"bytes,1,opt,name=Uuid" json:"Uuid,omitempty"`
+	LinkUuid string                `protobuf:"bytes,2,opt,name=LinkUuid" json:"LinkUuid,omitempty"`
+	Time     int64                 `protobuf:"varint,3,opt,name=Time" json:"Time,omitempty"`
+	Action   ShareLinkAccessAction `protobuf:"varint,4,opt,name=Action,enum=rest.ShareLinkAccessAction" json:"Action,omitempty"`
+	// Key of the target user, empty for anonymous visitors
+	Recipient        string `protobuf:"bytes,5,opt,name=Recipient" json:"Recipient,omitempty"`
+	RecipientDisplay string `protobuf:"bytes,6,opt,name=RecipientDisplay" json:"RecipientDisplay,omitempty"`
+	RemoteAddress    string `protobuf:"bytes,7,opt,name=RemoteAddress" json:"RemoteAddress,omitempty"`
+	UserAgent        string `protobuf:"bytes,8,opt,name=UserAgent" json:"UserAgent,omitempty"`
+	NodeUuid         string `protobuf:"bytes,9,opt,name=NodeUuid" json:"NodeUuid,omitempty"`
+	NodePath         string `protobuf:"bytes,10,opt,name=NodePath" json:"NodePath,omitempty"`
+}
+
+func (m *ShareLinkAccess) Reset()                    { *m = ShareLinkAccess{} }
+func (m *ShareLinkAccess) String() string            { return proto.CompactTextString(m) }
+func (*ShareLinkAccess) ProtoMessage()               {}
+func (*ShareLinkAccess) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{8} }
+
+func (m *ShareLinkAccess) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *ShareLinkAccess) GetLinkUuid() string {
+	if m != nil {
+		return m.LinkUuid
+	}
+	return ""
+}
+
+func (m *ShareLinkAccess) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *ShareLinkAccess) GetAction() ShareLinkAccessAction {
+	if m != nil {
+		return m.Action
+	}
+	return ShareLinkAccessAction_OPEN
+}
+
+func (m *ShareLinkAccess) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *ShareLinkAccess) GetRecipientDisplay() string {
+	if m != nil {
+		return m.RecipientDisplay
+	}
+	return ""
+}
+
+func (m *ShareLinkAccess) GetRemoteAddress() string {
+	if m != nil {
+		return m.RemoteAddress
+	}
+	return ""
+}
+
+func (m *ShareLinkAccess) GetUserAgent() string {
+	if m != nil {
+		return m.UserAgent
+	}
+	return ""
+}
+
+func (m *ShareLinkAccess) GetNodeUuid() string {
+	if m != nil {
+		return m.NodeUuid
+	}
+	return ""
+}
+
+func (m *ShareLinkAccess) GetNodePath() string {
+	if m != nil {
+		return m.NodePath
+	}
+	return ""
+}
+
+// Accesses of one recipient aggregated, anonymous visitors are grouped by address
+type ShareLinkRecipientReport struct {
+	Recipient        string `protobuf:"bytes,1,opt,name=Recipient" json:"Recipient,omitempty"`
+	RecipientDisplay string `protobuf:"bytes,2,opt,name=RecipientDisplay" json:"RecipientDisplay,omitempty"`
+	RemoteAddress    string `protobuf:"bytes,3,opt,name=RemoteAddress" json:"RemoteAddress,omitempty"`
+	Opens            int32  `protobuf:"varint,4,opt,name=Opens" json:"Opens,omitempty"`
+	Previews         int32  `protobuf:"varint,5,opt,name=Previews" json:"Previews,omitempty"`
+	Downloads        int32  `protobuf:"varint,6,opt,name=Downloads" json:"Downloads,omitempty"`
+	FirstAccess      int64  `protobuf:"varint,7,opt,name=FirstAccess" json:"FirstAccess,omitempty"`
+	LastAccess       int64  `protobuf:"varint,8,opt,name=LastAccess" json:"LastAccess,omitempty"`
+}
+
+func (m *ShareLinkRecipientReport) Reset()                    { *m = ShareLinkRecipientReport{} }
+func (m *ShareLinkRecipientReport) String() string            { return proto.CompactTextString(m) }
+func (*ShareLinkRecipientReport) ProtoMessage()               {}
+func (*ShareLinkRecipientReport) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{9} }
+
+func (m *ShareLinkRecipientReport) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *ShareLinkRecipientReport) GetRecipientDisplay() string {
+	if m != nil {
+		return m.RecipientDisplay
+	}
+	return ""
+}
+
+func (m *ShareLinkRecipientReport) GetRemoteAddress() string {
+	if m != nil {
+		return m.RemoteAddress
+	}
+	return ""
+}
+
+func (m *ShareLinkRecipientReport) GetOpens() int32 {
+	if m != nil {
+		return m.Opens
+	}
+	return 0
+}
+
+func (m *ShareLinkRecipientReport) GetPreviews() int32 {
+	if m != nil {
+		return m.Previews
+	}
+	return 0
+}
+
+func (m *ShareLinkRecipientReport) GetDownloads() int32 {
+	if m != nil {
+		return m.Downloads
+	}
+	return 0
+}
+
+func (m *ShareLinkRecipientReport) GetFirstAccess() int64 {
+	if m != nil {
+		return m.FirstAccess
+	}
+	return 0
+}
+
+func (m *ShareLinkRecipientReport) GetLastAccess() int64 {
+	if m != nil {
+		return m.LastAccess
+	}
+	return 0
+}
+
+type ListShareLinkAccessRequest struct {
+	LinkUuid string `protobuf:"bytes,1,opt,name=LinkUuid" json:"LinkUuid,omitempty"`
+	// Set to "csv" to receive the log as a CSV file
+	Format string `protobuf:"bytes,2,opt,name=Format" json:"Format,omitempty"`
+	Offset int32  `protobuf:"varint,3,opt,name=Offset" json:"Offset,omitempty"`
+	Limit  int32  `protobuf:"varint,4,opt,name=Limit" json:"Limit,omitempty"`
+}
+
+func (m *ListShareLinkAccessRequest) Reset()                    { *m = ListShareLinkAccessRequest{} }
+func (m *ListShareLinkAccessRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListShareLinkAccessRequest) ProtoMessage()               {}
+func (*ListShareLinkAccessRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{10} }
+
+func (m *ListShareLinkAccessRequest) GetLinkUuid() string {
+	if m != nil {
+		return m.LinkUuid
+	}
+	return ""
+}
+
+func (m *ListShareLinkAccessRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *ListShareLinkAccessRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ListShareLinkAccessRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListShareLinkAccessResponse struct {
+	Entries []*ShareLinkAccess          `protobuf:"bytes,1,rep,name=Entries" json:"Entries,omitempty"`
+	Total   int32                       `protobuf:"varint,2,opt,name=Total" json:"Total,omitempty"`
+	Reports []*ShareLinkRecipientReport `protobuf:"bytes,3,rep,name=Reports" json:"Reports,omitempty"`
+}
+
+func (m *ListShareLinkAccessResponse) Reset()                    { *m = ListShareLinkAccessResponse{} }
+func (m *ListShareLinkAccessResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListShareLinkAccessResponse) ProtoMessage()               {}
+func (*ListShareLinkAccessResponse) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{11} }
+
+func (m *ListShareLinkAccessResponse) GetEntries() []*ShareLinkAccess {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *ListShareLinkAccessResponse) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *ListShareLinkAccessResponse) GetReports() []*ShareLinkRecipientReport {
+	if m != nil {
+		return m.Reports
+	}
+	return nil
+}
+
+// Request to activate a link or to invite external users in a Cell, waiting for an approver decision
+type ShareApproval struct {
+	Uuid      string                          `protobuf:"bytes,1,opt,name=Uuid" json:"Uuid,omitempty"`
+	ShareType ShareApproval_ApprovalShareType `protobuf:"varint,2,opt,name=ShareType,enum=rest.ShareApproval_ApprovalShareType" json:"ShareType,omitempty"`
+	// Uuid of the link or Cell workspace
+	ShareUuid      string       `protobuf:"bytes,3,opt,name=ShareUuid" json:"ShareUuid,omitempty"`
+	ShareLabel     string       `protobuf:"bytes,4,opt,name=ShareLabel" json:"ShareLabel,omitempty"`
+	RootNodes      []*tree.Node `protobuf:"bytes,5,rep,name=RootNodes" json:"RootNodes,omitempty"`
+	RequesterLogin string       `protobuf:"bytes,6,opt,name=RequesterLogin" json:"RequesterLogin,omitempty"`
+	RequesterUuid  string       `protobuf:"bytes,7,opt,name=RequesterUuid" json:"RequesterUuid,omitempty"`
+	CreatedAt      int64        `protobuf:"varint,8,opt,name=CreatedAt" json:"CreatedAt,omitempty"`
+	// Logins of the external users invited in a Cell
+	ExternalUsers []string                     `protobuf:"bytes,9,rep,name=ExternalUsers" json:"ExternalUsers,omitempty"`
+	Status        ShareApproval_ApprovalStatus `protobuf:"varint,10,opt,name=Status,enum=rest.ShareApproval_ApprovalStatus" json:"Status,omitempty"`
+	Approver      string                       `protobuf:"bytes,11,opt,name=Approver" json:"Approver,omitempty"`
+	DecidedAt     int64                        `protobuf:"varint,12,opt,name=DecidedAt" json:"DecidedAt,omitempty"`
+	Comment       string                       `protobuf:"bytes,13,opt,name=Comment" json:"Comment,omitempty"`
+}
+
+func (m *ShareApproval) Reset()                    { *m = ShareApproval{} }
+func (m *ShareApproval) String() string            { return proto.CompactTextString(m) }
+func (*ShareApproval) ProtoMessage()               {}
+func (*ShareApproval) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{12} }
+
+func (m *ShareApproval) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *ShareApproval) GetShareType() ShareApproval_ApprovalShareType {
+	if m != nil {
+		return m.ShareType
+	}
+	return ShareApproval_LINK
+}
+
+func (m *ShareApproval) GetShareUuid() string {
+	if m != nil {
+		return m.ShareUuid
+	}
+	return ""
+}
+
+func (m *ShareApproval) GetShareLabel() string {
+	if m != nil {
+		return m.ShareLabel
+	}
+	return ""
+}
+
+func (m *ShareApproval) GetRootNodes() []*tree.Node {
+	if m != nil {
+		return m.RootNodes
+	}
+	return nil
+}
+
+func (m *ShareApproval) GetRequesterLogin() string {
+	if m != nil {
+		return m.RequesterLogin
+	}
+	return ""
+}
+
+func (m *ShareApproval) GetRequesterUuid() string {
+	if m != nil {
+		return m.RequesterUuid
+	}
+	return ""
+}
+
+func (m *ShareApproval) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *ShareApproval) GetExternalUsers() []string {
+	if m != nil {
+		return m.ExternalUsers
+	}
+	return nil
+}
+
+func (m *ShareApproval) GetStatus() ShareApproval_ApprovalStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ShareApproval_PENDING
+}
+
+func (m *ShareApproval) GetApprover() string {
+	if m != nil {
+		return m.Approver
+	}
+	return ""
+}
+
+func (m *ShareApproval) GetDecidedAt() int64 {
+	if m != nil {
+		return m.DecidedAt
+	}
+	return 0
+}
+
+func (m *ShareApproval) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
+type ListShareApprovalsRequest struct {
+	// Filter requests by status, pending ones by default
+	Status ShareApproval_ApprovalStatus `protobuf:"varint,1,opt,name=Status,enum=rest.ShareApproval_ApprovalStatus" json:"Status,omitempty"`
+	// Ignore the status filter
+	AllStatuses bool  `protobuf:"varint,2,opt,name=AllStatuses" json:"AllStatuses,omitempty"`
+	Offset      int32 `protobuf:"varint,3,opt,name=Offset" json:"Offset,omitempty"`
+	Limit       int32 `protobuf:"varint,4,opt,name=Limit" json:"Limit,omitempty"`
+}
+
+func (m *ListShareApprovalsRequest) Reset()                    { *m = ListShareApprovalsRequest{} }
+func (m *ListShareApprovalsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListShareApprovalsRequest) ProtoMessage()               {}
+func (*ListShareApprovalsRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{13} }
+
+func (m *ListShareApprovalsRequest) GetStatus() ShareApproval_ApprovalStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ShareApproval_PENDING
+}
+
+func (m *ListShareApprovalsRequest) GetAllStatuses() bool {
+	if m != nil {
+		return m.AllStatuses
+	}
+	return false
+}
+
+func (m *ListShareApprovalsRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ListShareApprovalsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListShareApprovalsResponse struct {
+	Approvals []*ShareApproval `protobuf:"bytes,1,rep,name=Approvals" json:"Approvals,omitempty"`
+	Total     int32            `protobuf:"varint,2,opt,name=Total" json:"Total,omitempty"`
+}
+
+func (m *ListShareApprovalsResponse) Reset()                    { *m = ListShareApprovalsResponse{} }
+func (m *ListShareApprovalsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListShareApprovalsResponse) ProtoMessage()               {}
+func (*ListShareApprovalsResponse) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{14} }
+
+func (m *ListShareApprovalsResponse) GetApprovals() []*ShareApproval {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+func (m *ListShareApprovalsResponse) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+type DecideShareApprovalRequest struct {
+	Uuid    string `protobuf:"bytes,1,opt,name=Uuid" json:"Uuid,omitempty"`
+	Approve bool   `protobuf:"varint,2,opt,name=Approve" json:"Approve,omitempty"`
+	Comment string `protobuf:"bytes,3,opt,name=Comment" json:"Comment,omitempty"`
+}
+
+func (m *DecideShareApprovalRequest) Reset()                    { *m = DecideShareApprovalRequest{} }
+func (m *DecideShareApprovalRequest) String() string            { return proto.CompactTextString(m) }
+func (*DecideShareApprovalRequest) ProtoMessage()               {}
+func (*DecideShareApprovalRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{15} }
+
+func (m *DecideShareApprovalRequest) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *DecideShareApprovalRequest) GetApprove() bool {
+	if m != nil {
+		return m.Approve
+	}
+	return false
+}
+
+func (m *DecideShareApprovalRequest) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
+// Folder shared with a user of another server using the Open Cloud Mesh protocol
+type OcmShare struct {
+	Uuid        string            `protobuf:"bytes,1,opt,name=Uuid" json:"Uuid,omitempty"`
+	Direction   OcmShareDirection `protobuf:"varint,2,opt,name=Direction,enum=rest.OcmShareDirection" json:"Direction,omitempty"`
+	Status      OcmShareStatus    `protobuf:"varint,3,opt,name=Status,enum=rest.OcmShareStatus" json:"Status,omitempty"`
+	Name        string            `protobuf:"bytes,4,opt,name=Name" json:"Name,omitempty"`
+	Description string            `protobuf:"bytes,5,opt,name=Description" json:"Description,omitempty"`
+	// Local user who created an outgoing share, or recipient of an incoming share
+	LocalUser string `protobuf:"bytes,6,opt,name=LocalUser" json:"LocalUser,omitempty"`
+	// Federated address (user@server) of the recipient of an outgoing share
+	ShareWith string `protobuf:"bytes,7,opt,name=ShareWith" json:"ShareWith,omitempty"`
+	// Federated address of the owner of an incoming share
+	Owner            string `protobuf:"bytes,8,opt,name=Owner" json:"Owner,omitempty"`
+	OwnerDisplayName string `protobuf:"bytes,9,opt,name=OwnerDisplayName" json:"OwnerDisplayName,omitempty"`
+	// Identifier of the share on the provider side
+	ProviderId string `protobuf:"bytes,10,opt,name=ProviderId" json:"ProviderId,omitempty"`
+	// Base URL of the remote server
+	RemoteUrl   string                `protobuf:"bytes,11,opt,name=RemoteUrl" json:"RemoteUrl,omitempty"`
+	Permissions []ShareLinkAccessType `protobuf:"varint,12,rep,packed,name=Permissions,enum=rest.ShareLinkAccessType" json:"Permissions,omitempty"`
+	// Shared node and link workspace of an outgoing share
+	NodeUuid      string `protobuf:"bytes,13,opt,name=NodeUuid" json:"NodeUuid,omitempty"`
+	WorkspaceUuid string `protobuf:"bytes,14,opt,name=WorkspaceUuid" json:"WorkspaceUuid,omitempty"`
+	// Root path of an incoming share on the remote server
+	ResourcePath string `protobuf:"bytes,15,opt,name=ResourcePath" json:"ResourcePath,omitempty"`
+	// Secret giving access to the shared resource, never sent to clients
+	SharedSecret string `protobuf:"bytes,16,opt,name=SharedSecret" json:"SharedSecret,omitempty"`
+	CreatedAt    int64  `protobuf:"varint,17,opt,name=CreatedAt" json:"CreatedAt,omitempty"`
+	// Hidden user giving access to an outgoing share, never sent to clients
+	HiddenUser string `protobuf:"bytes,18,opt,name=HiddenUser" json:"HiddenUser,omitempty"`
+}
+
+func (m *OcmShare) Reset()                    { *m = OcmShare{} }
+func (m *OcmShare) String() string            { return proto.CompactTextString(m) }
+func (*OcmShare) ProtoMessage()               {}
+func (*OcmShare) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{16} }
+
+func (m *OcmShare) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *OcmShare) GetDirection() OcmShareDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return OcmShareDirection_OUTGOING
+}
+
+func (m *OcmShare) GetStatus() OcmShareStatus {
+	if m != nil {
+		return m.Status
+	}
+	return OcmShareStatus_PENDING
+}
+
+func (m *OcmShare) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *OcmShare) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *OcmShare) GetLocalUser() string {
+	if m != nil {
+		return m.LocalUser
+	}
+	return ""
+}
+
+func (m *OcmShare) GetShareWith() string {
+	if m != nil {
+		return m.ShareWith
+	}
+	return ""
+}
+
+func (m *OcmShare) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *OcmShare) GetOwnerDisplayName() string {
+	if m != nil {
+		return m.OwnerDisplayName
+	}
+	return ""
+}
+
+func (m *OcmShare) GetProviderId() string {
+	if m != nil {
+		return m.ProviderId
+	}
+	return ""
+}
+
+func (m *OcmShare) GetRemoteUrl() string {
+	if m != nil {
+		return m.RemoteUrl
+	}
+	return ""
+}
+
+func (m *OcmShare) GetPermissions() []ShareLinkAccessType {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+func (m *OcmShare) GetNodeUuid() string {
+	if m != nil {
+		return m.NodeUuid
+	}
+	return ""
+}
+
+func (m *OcmShare) GetWorkspaceUuid() string {
+	if m != nil {
+		return m.WorkspaceUuid
+	}
+	return ""
+}
+
+func (m *OcmShare) GetResourcePath() string {
+	if m != nil {
+		return m.ResourcePath
+	}
+	return ""
+}
+
+func (m *OcmShare) GetSharedSecret() string {
+	if m != nil {
+		return m.SharedSecret
+	}
+	return ""
+}
+
+func (m *OcmShare) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *OcmShare) GetHiddenUser() string {
+	if m != nil {
+		return m.HiddenUser
+	}
+	return ""
+}
+
+type PutOcmShareRequest struct {
+	Node *tree.Node `protobuf:"bytes,1,opt,name=Node" json:"Node,omitempty"`
+	// Federated address of the recipient, as user@server
+	ShareWith   string                `protobuf:"bytes,2,opt,name=ShareWith" json:"ShareWith,omitempty"`
+	Permissions []ShareLinkAccessType `protobuf:"varint,3,rep,packed,name=Permissions,enum=rest.ShareLinkAccessType" json:"Permissions,omitempty"`
+	Description string                `protobuf:"bytes,4,opt,name=Description" json:"Description,omitempty"`
+}
+
+func (m *PutOcmShareRequest) Reset()                    { *m = PutOcmShareRequest{} }
+func (m *PutOcmShareRequest) String() string            { return proto.CompactTextString(m) }
+func (*PutOcmShareRequest) ProtoMessage()               {}
+func (*PutOcmShareRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{17} }
+
+func (m *PutOcmShareRequest) GetNode() *tree.Node {
+	if m != nil {
+		return m.Node
+	}
+	return nil
+}
+
+func (m *PutOcmShareRequest) GetShareWith() string {
+	if m != nil {
+		return m.ShareWith
+	}
+	return ""
+}
+
+func (m *PutOcmShareRequest) GetPermissions() []ShareLinkAccessType {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+func (m *PutOcmShareRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type ListOcmSharesRequest struct {
+	Direction OcmShareDirection `protobuf:"varint,1,opt,name=Direction,enum=rest.OcmShareDirection" json:"Direction,omitempty"`
+}
+
+func (m *ListOcmSharesRequest) Reset()                    { *m = ListOcmSharesRequest{} }
+func (m *ListOcmSharesRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListOcmSharesRequest) ProtoMessage()               {}
+func (*ListOcmSharesRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{18} }
+
+func (m *ListOcmSharesRequest) GetDirection() OcmShareDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return OcmShareDirection_OUTGOING
+}
+
+type ListOcmSharesResponse struct {
+	Shares []*OcmShare `protobuf:"bytes,1,rep,name=Shares" json:"Shares,omitempty"`
+}
+
+func (m *ListOcmSharesResponse) Reset()                    { *m = ListOcmSharesResponse{} }
+func (m *ListOcmSharesResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListOcmSharesResponse) ProtoMessage()               {}
+func (*ListOcmSharesResponse) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{19} }
+
+func (m *ListOcmSharesResponse) GetShares() []*OcmShare {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
+type AcceptOcmShareRequest struct {
+	Uuid string `protobuf:"bytes,1,opt,name=Uuid" json:"Uuid,omitempty"`
+	// Decline the share instead of accepting it
+	Decline bool `protobuf:"varint,2,opt,name=Decline" json:"Decline,omitempty"`
+}
+
+func (m *AcceptOcmShareRequest) Reset()                    { *m = AcceptOcmShareRequest{} }
+func (m *AcceptOcmShareRequest) String() string            { return proto.CompactTextString(m) }
+func (*AcceptOcmShareRequest) ProtoMessage()               {}
+func (*AcceptOcmShareRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{20} }
+
+func (m *AcceptOcmShareRequest) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *AcceptOcmShareRequest) GetDecline() bool {
+	if m != nil {
+		return m.Decline
+	}
+	return false
+}
+
+type DeleteOcmShareRequest struct {
+	Uuid string `protobuf:"bytes,1,opt,name=Uuid" json:"Uuid,omitempty"`
+}
+
+func (m *DeleteOcmShareRequest) Reset()                    { *m = DeleteOcmShareRequest{} }
+func (m *DeleteOcmShareRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteOcmShareRequest) ProtoMessage()               {}
+func (*DeleteOcmShareRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{21} }
+
+func (m *DeleteOcmShareRequest) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+type DeleteOcmShareResponse struct {
+	Success bool `protobuf:"varint,1,opt,name=Success" json:"Success,omitempty"`
+}
+
+func (m *DeleteOcmShareResponse) Reset()                    { *m = DeleteOcmShareResponse{} }
+func (m *DeleteOcmShareResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteOcmShareResponse) ProtoMessage()               {}
+func (*DeleteOcmShareResponse) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{22} }
+
+func (m *DeleteOcmShareResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+type ListOcmShareNodesRequest struct {
+	Uuid string `protobuf:"bytes,1,opt,name=Uuid" json:"Uuid,omitempty"`
+	// Path relative to the root of the share
+	Path string `protobuf:"bytes,2,opt,name=Path" json:"Path,omitempty"`
+}
+
+func (m *ListOcmShareNodesRequest) Reset()                    { *m = ListOcmShareNodesRequest{} }
+func (m *ListOcmShareNodesRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListOcmShareNodesRequest) ProtoMessage()               {}
+func (*ListOcmShareNodesRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{23} }
+
+func (m *ListOcmShareNodesRequest) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *ListOcmShareNodesRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
 type PutCellRequest struct {
 	Room            *Cell `protobuf:"bytes,1,opt,name=Room" json:"Room,omitempty"`
 	CreateEmptyRoot bool  `protobuf:"varint,2,opt,name=CreateEmptyRoot" json:"CreateEmptyRoot,omitempty"`
@@ -385,7 +1491,7 @@ type PutCellRequest struct {
 func (m *PutCellRequest) Reset()                    { *m = PutCellRequest{} }
 func (m *PutCellRequest) String() string            { return proto.CompactTextString(m) }
 func (*PutCellRequest) ProtoMessage()               {}
-func (*PutCellRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{4} }
+func (*PutCellRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{24} }
 
 func (m *PutCellRequest) GetRoom() *Cell {
 	if m != nil {
@@ -408,7 +1514,7 @@ type GetCellRequest struct {
 func (m *GetCellRequest) Reset()                    { *m = GetCellRequest{} }
 func (m *GetCellRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetCellRequest) ProtoMessage()               {}
-func (*GetCellRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{5} }
+func (*GetCellRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{25} }
 
 func (m *GetCellRequest) GetUuid() string {
 	if m != nil {
@@ -424,7 +1530,7 @@ type DeleteCellRequest struct {
 func (m *DeleteCellRequest) Reset()                    { *m = DeleteCellRequest{} }
 func (m *DeleteCellRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteCellRequest) ProtoMessage()               {}
-func (*DeleteCellRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{6} }
+func (*DeleteCellRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{26} }
 
 func (m *DeleteCellRequest) GetUuid() string {
 	if m != nil {
@@ -440,7 +1546,7 @@ type DeleteCellResponse struct {
 func (m *DeleteCellResponse) Reset()                    { *m = DeleteCellResponse{} }
 func (m *DeleteCellResponse) String() string            { return proto.CompactTextString(m) }
 func (*DeleteCellResponse) ProtoMessage()               {}
-func (*DeleteCellResponse) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{7} }
+func (*DeleteCellResponse) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{27} }
 
 func (m *DeleteCellResponse) GetSuccess() bool {
 	if m != nil {
@@ -456,7 +1562,7 @@ type GetShareLinkRequest struct {
 func (m *GetShareLinkRequest) Reset()                    { *m = GetShareLinkRequest{} }
 func (m *GetShareLinkRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetShareLinkRequest) ProtoMessage()               {}
-func (*GetShareLinkRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{8} }
+func (*GetShareLinkRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{28} }
 
 func (m *GetShareLinkRequest) GetUuid() string {
 	if m != nil {
@@ -476,7 +1582,7 @@ type PutShareLinkRequest struct {
 func (m *PutShareLinkRequest) Reset()                    { *m = PutShareLinkRequest{} }
 func (m *PutShareLinkRequest) String() string            { return proto.CompactTextString(m) }
 func (*PutShareLinkRequest) ProtoMessage()               {}
-func (*PutShareLinkRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{9} }
+func (*PutShareLinkRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{29} }
 
 func (m *PutShareLinkRequest) GetShareLink() *ShareLink {
 	if m != nil {
@@ -520,7 +1626,7 @@ type DeleteShareLinkRequest struct {
 func (m *DeleteShareLinkRequest) Reset()                    { *m = DeleteShareLinkRequest{} }
 func (m *DeleteShareLinkRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteShareLinkRequest) ProtoMessage()               {}
-func (*DeleteShareLinkRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{10} }
+func (*DeleteShareLinkRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{30} }
 
 func (m *DeleteShareLinkRequest) GetUuid() string {
 	if m != nil {
@@ -536,7 +1642,7 @@ type DeleteShareLinkResponse struct {
 func (m *DeleteShareLinkResponse) Reset()                    { *m = DeleteShareLinkResponse{} }
 func (m *DeleteShareLinkResponse) String() string            { return proto.CompactTextString(m) }
 func (*DeleteShareLinkResponse) ProtoMessage()               {}
-func (*DeleteShareLinkResponse) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{11} }
+func (*DeleteShareLinkResponse) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{31} }
 
 func (m *DeleteShareLinkResponse) GetSuccess() bool {
 	if m != nil {
@@ -561,7 +1667,7 @@ type ListSharedResourcesRequest struct {
 func (m *ListSharedResourcesRequest) Reset()                    { *m = ListSharedResourcesRequest{} }
 func (m *ListSharedResourcesRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListSharedResourcesRequest) ProtoMessage()               {}
-func (*ListSharedResourcesRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{12} }
+func (*ListSharedResourcesRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{32} }
 
 func (m *ListSharedResourcesRequest) GetShareType() ListSharedResourcesRequest_ListShareType {
 	if m != nil {
@@ -610,7 +1716,7 @@ type ListSharedResourcesResponse struct {
 func (m *ListSharedResourcesResponse) Reset()                    { *m = ListSharedResourcesResponse{} }
 func (m *ListSharedResourcesResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListSharedResourcesResponse) ProtoMessage()               {}
-func (*ListSharedResourcesResponse) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{13} }
+func (*ListSharedResourcesResponse) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{33} }
 
 func (m *ListSharedResourcesResponse) GetResources() []*ListSharedResourcesResponse_SharedResource {
 	if m != nil {
@@ -655,7 +1761,7 @@ func (m *ListSharedResourcesResponse_SharedResource) String() string {
 }
 func (*ListSharedResourcesResponse_SharedResource) ProtoMessage() {}
 func (*ListSharedResourcesResponse_SharedResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor9, []int{13, 0}
+	return fileDescriptor9, []int{33, 0}
 }
 
 func (m *ListSharedResourcesResponse_SharedResource) GetNode() *tree.Node {
@@ -687,7 +1793,7 @@ type UpdateSharePoliciesRequest struct {
 func (m *UpdateSharePoliciesRequest) Reset()                    { *m = UpdateSharePoliciesRequest{} }
 func (m *UpdateSharePoliciesRequest) String() string            { return proto.CompactTextString(m) }
 func (*UpdateSharePoliciesRequest) ProtoMessage()               {}
-func (*UpdateSharePoliciesRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{14} }
+func (*UpdateSharePoliciesRequest) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{34} }
 
 func (m *UpdateSharePoliciesRequest) GetUuid() string {
 	if m != nil {
@@ -712,7 +1818,7 @@ type UpdateSharePoliciesResponse struct {
 func (m *UpdateSharePoliciesResponse) Reset()                    { *m = UpdateSharePoliciesResponse{} }
 func (m *UpdateSharePoliciesResponse) String() string            { return proto.CompactTextString(m) }
 func (*UpdateSharePoliciesResponse) ProtoMessage()               {}
-func (*UpdateSharePoliciesResponse) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{15} }
+func (*UpdateSharePoliciesResponse) Descriptor() ([]byte, []int) { return fileDescriptor9, []int{35} }
 
 func (m *UpdateSharePoliciesResponse) GetSuccess() bool {
 	if m != nil {
@@ -740,6 +1846,26 @@ func init() {
 	proto.RegisterType((*Cell)(nil), "rest.Cell")
 	proto.RegisterType((*ShareLinkTargetUser)(nil), "rest.ShareLinkTargetUser")
 	proto.RegisterType((*ShareLink)(nil), "rest.ShareLink")
+	proto.RegisterType((*ShareLinkFileRequest)(nil), "rest.ShareLinkFileRequest")
+	proto.RegisterType((*FileRequestDrop)(nil), "rest.FileRequestDrop")
+	proto.RegisterType((*CreateFileRequestDropRequest)(nil), "rest.CreateFileRequestDropRequest")
+	proto.RegisterType((*CompleteFileRequestDropRequest)(nil), "rest.CompleteFileRequestDropRequest")
+	proto.RegisterType((*ShareLinkAccess)(nil), "rest.ShareLinkAccess")
+	proto.RegisterType((*ShareLinkRecipientReport)(nil), "rest.ShareLinkRecipientReport")
+	proto.RegisterType((*ListShareLinkAccessRequest)(nil), "rest.ListShareLinkAccessRequest")
+	proto.RegisterType((*ListShareLinkAccessResponse)(nil), "rest.ListShareLinkAccessResponse")
+	proto.RegisterType((*ShareApproval)(nil), "rest.ShareApproval")
+	proto.RegisterType((*ListShareApprovalsRequest)(nil), "rest.ListShareApprovalsRequest")
+	proto.RegisterType((*ListShareApprovalsResponse)(nil), "rest.ListShareApprovalsResponse")
+	proto.RegisterType((*DecideShareApprovalRequest)(nil), "rest.DecideShareApprovalRequest")
+	proto.RegisterType((*OcmShare)(nil), "rest.OcmShare")
+	proto.RegisterType((*PutOcmShareRequest)(nil), "rest.PutOcmShareRequest")
+	proto.RegisterType((*ListOcmSharesRequest)(nil), "rest.ListOcmSharesRequest")
+	proto.RegisterType((*ListOcmSharesResponse)(nil), "rest.ListOcmSharesResponse")
+	proto.RegisterType((*AcceptOcmShareRequest)(nil), "rest.AcceptOcmShareRequest")
+	proto.RegisterType((*DeleteOcmShareRequest)(nil), "rest.DeleteOcmShareRequest")
+	proto.RegisterType((*DeleteOcmShareResponse)(nil), "rest.DeleteOcmShareResponse")
+	proto.RegisterType((*ListOcmShareNodesRequest)(nil), "rest.ListOcmShareNodesRequest")
 	proto.RegisterType((*PutCellRequest)(nil), "rest.PutCellRequest")
 	proto.RegisterType((*GetCellRequest)(nil), "rest.GetCellRequest")
 	proto.RegisterType((*DeleteCellRequest)(nil), "rest.DeleteCellRequest")
@@ -754,90 +1880,169 @@ func init() {
 	proto.RegisterType((*UpdateSharePoliciesRequest)(nil), "rest.UpdateSharePoliciesRequest")
 	proto.RegisterType((*UpdateSharePoliciesResponse)(nil), "rest.UpdateSharePoliciesResponse")
 	proto.RegisterEnum("rest.ShareLinkAccessType", ShareLinkAccessType_name, ShareLinkAccessType_value)
+	proto.RegisterEnum("rest.ShareLinkAccessAction", ShareLinkAccessAction_name, ShareLinkAccessAction_value)
+	proto.RegisterEnum("rest.OcmShareDirection", OcmShareDirection_name, OcmShareDirection_value)
+	proto.RegisterEnum("rest.OcmShareStatus", OcmShareStatus_name, OcmShareStatus_value)
+	proto.RegisterEnum("rest.ShareApproval_ApprovalStatus", ShareApproval_ApprovalStatus_name, ShareApproval_ApprovalStatus_value)
+	proto.RegisterEnum("rest.ShareApproval_ApprovalShareType", ShareApproval_ApprovalShareType_name, ShareApproval_ApprovalShareType_value)
 	proto.RegisterEnum("rest.ListSharedResourcesRequest_ListShareType", ListSharedResourcesRequest_ListShareType_name, ListSharedResourcesRequest_ListShareType_value)
 }
 
 func init() { proto.RegisterFile("share.proto", fileDescriptor9) }
 
 var fileDescriptor9 = []byte{
-	// 1250 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x6e, 0xdb, 0x46,
-	0x13, 0x8d, 0x7e, 0xa8, 0x9f, 0x51, 0x2c, 0x2b, 0xeb, 0x7c, 0x09, 0x3f, 0xa5, 0x49, 0x04, 0x26,
-	0x68, 0x95, 0xa0, 0xa1, 0x5a, 0xbb, 0x28, 0x82, 0xf6, 0x4a, 0x91, 0xdd, 0x34, 0xad, 0xea, 0x18,
-	0x6b, 0xab, 0x40, 0x7a, 0x47, 0x93, 0x1b, 0x87, 0x0d, 0xc9, 0x55, 0xb9, 0x4b, 0x2b, 0x7a, 0x85,
-	0x3e, 0x48, 0x2f, 0xfa, 0x28, 0x7d, 0x89, 0x02, 0xbd, 0x2a, 0x8a, 0x02, 0xbd, 0xe9, 0x03, 0x14,
-	0x3b, 0x4b, 0x8a, 0x94, 0xac, 0x28, 0x46, 0xd1, 0x0b, 0x1b, 0x3b, 0x67, 0x66, 0x76, 0x77, 0xce,
-	0xce, 0x19, 0x11, 0x5a, 0xe2, 0x95, 0x13, 0x33, 0x7b, 0x1a, 0x73, 0xc9, 0x49, 0x35, 0x66, 0x42,
-	0x76, 0x1f, 0x9f, 0xf9, 0xf2, 0x55, 0x72, 0x6a, 0xbb, 0x3c, 0x1c, 0x4c, 0xe7, 0x9e, 0xcf, 0x07,
-	0x2e, 0x0b, 0x02, 0x31, 0x70, 0x79, 0x18, 0xf2, 0x68, 0x20, 0x58, 0x7c, 0xee, 0xbb, 0x6c, 0x80,
-	0x29, 0x29, 0xa8, 0xf3, 0xbb, 0x1f, 0x6f, 0xce, 0xd4, 0x19, 0xbe, 0x17, 0xaa, 0xbf, 0x34, 0x65,
-	0xef, 0x32, 0x29, 0x32, 0x66, 0x0c, 0xff, 0xa5, 0x49, 0x9f, 0x16, 0x92, 0xc2, 0x99, 0x2f, 0x5f,
-	0xf3, 0xd9, 0xe0, 0x8c, 0x3f, 0x42, 0xe7, 0xa3, 0x73, 0x27, 0xf0, 0x3d, 0x47, 0xf2, 0x58, 0x0c,
-	0x16, 0x4b, 0x9d, 0x67, 0xfd, 0x52, 0x82, 0xfa, 0x88, 0x05, 0xc1, 0xd0, 0x0d, 0xc8, 0x0d, 0xa8,
-	0x51, 0x1e, 0xb0, 0x67, 0x9e, 0x59, 0xea, 0x95, 0xfa, 0x4d, 0x9a, 0x5a, 0xa4, 0x0f, 0xf5, 0xa1,
-	0x2b, 0x7d, 0x1e, 0x09, 0xb3, 0xdc, 0xab, 0xf4, 0x5b, 0xbb, 0x6d, 0x5b, 0xdd, 0x76, 0x38, 0x1a,
-	0x6b, 0x98, 0x66, 0x6e, 0x72, 0x07, 0xe0, 0x99, 0x98, 0x08, 0x16, 0xab, 0x4c, 0xb3, 0xd2, 0x2b,
-	0xf5, 0x1b, 0xb4, 0x80, 0x90, 0xdb, 0x50, 0x55, 0x6b, 0xb3, 0xda, 0x2b, 0xf5, 0x5b, 0xbb, 0x4d,
-	0xdc, 0x06, 0x9d, 0x08, 0x93, 0xbb, 0x60, 0x3c, 0x8d, 0x79, 0x32, 0x35, 0x8d, 0x55, 0xbf, 0xc6,
-	0x55, 0x3e, 0xee, 0x5c, 0x2b, 0xf8, 0x15, 0x40, 0x11, 0xb6, 0xfe, 0x2a, 0x43, 0x55, 0x15, 0x43,
-	0x08, 0x54, 0x27, 0x89, 0x9f, 0xd5, 0x81, 0x6b, 0x72, 0x1b, 0x8c, 0xb1, 0x73, 0xca, 0x02, 0xb3,
-	0xac, 0xc0, 0x27, 0xf5, 0xdf, 0x7e, 0xbd, 0x5b, 0x79, 0xf3, 0x77, 0x85, 0x6a, 0x94, 0x3c, 0x80,
-	0xd6, 0x3e, 0x13, 0x6e, 0xec, 0x4f, 0x55, 0x29, 0x78, 0xf7, 0x2c, 0xe8, 0xf7, 0x3a, 0x2d, 0xfa,
-	0x48, 0x1f, 0x9a, 0x94, 0x73, 0x79, 0xc8, 0x3d, 0x26, 0xcc, 0x2a, 0x32, 0x02, 0x36, 0xbe, 0x85,
-	0x82, 0x68, 0xee, 0x24, 0x7d, 0xa8, 0x0e, 0x47, 0x63, 0x61, 0x1a, 0x18, 0x74, 0xdd, 0x56, 0xcd,
-	0x64, 0xab, 0x1b, 0x2a, 0xf2, 0xc4, 0x41, 0x24, 0xe3, 0x39, 0xc5, 0x08, 0xb2, 0x07, 0x8d, 0x23,
-	0x1e, 0xf8, 0xae, 0xcf, 0x84, 0x59, 0xc3, 0xe8, 0x9b, 0x76, 0xda, 0x56, 0x36, 0x65, 0x82, 0x27,
-	0xb1, 0xcb, 0x30, 0x60, 0x4e, 0x17, 0x81, 0xe4, 0x31, 0xdc, 0xcc, 0xd6, 0x23, 0x1e, 0x49, 0xf6,
-	0x46, 0x1e, 0x78, 0xbe, 0x74, 0x4e, 0x03, 0x66, 0xd6, 0x91, 0xfb, 0xb7, 0xb9, 0xbb, 0x5f, 0x40,
-	0x73, 0x71, 0x03, 0xd2, 0x81, 0xca, 0x6b, 0x36, 0x4f, 0xc9, 0x52, 0x4b, 0x72, 0x0f, 0x8c, 0x73,
-	0x27, 0x48, 0x18, 0x72, 0xd5, 0xda, 0xdd, 0xca, 0x2f, 0x3e, 0x74, 0x03, 0xaa, 0x7d, 0x9f, 0x95,
-	0x1f, 0x97, 0xac, 0x09, 0xec, 0x1c, 0x2b, 0xb5, 0x8c, 0xfd, 0xe8, 0xf5, 0x89, 0x13, 0x9f, 0x31,
-	0x89, 0x0f, 0x69, 0x42, 0x7d, 0xdf, 0x17, 0xd3, 0xc0, 0xc9, 0x76, 0xcd, 0x4c, 0x72, 0x1f, 0xb6,
-	0xf6, 0xf9, 0x2c, 0x0a, 0xb8, 0xe3, 0x8d, 0x78, 0x12, 0x49, 0x3c, 0xc1, 0xa0, 0xcb, 0xa0, 0xf5,
-	0x67, 0x0d, 0x9a, 0x8b, 0x7d, 0xd7, 0xbe, 0x66, 0x17, 0x1a, 0xca, 0xf7, 0xa5, 0x23, 0x5e, 0xe9,
-	0x07, 0xa5, 0x0b, 0x5b, 0x9d, 0xae, 0xd6, 0x93, 0x38, 0xd0, 0xcf, 0x48, 0x33, 0x33, 0xef, 0x81,
-	0xea, 0x65, 0x7a, 0xc0, 0xd8, 0xd0, 0x03, 0x5d, 0x68, 0xa8, 0x4a, 0xf1, 0x5e, 0x35, 0x7d, 0x7e,
-	0x66, 0x93, 0xf7, 0xa0, 0xa9, 0xd6, 0x63, 0x7e, 0xe6, 0x47, 0xf8, 0x10, 0x4d, 0x9a, 0x03, 0xe4,
-	0x21, 0x74, 0x8e, 0x1c, 0x21, 0x66, 0x3c, 0xf6, 0x28, 0xfb, 0x21, 0xf1, 0x63, 0xe6, 0x99, 0x0d,
-	0x7c, 0xad, 0x0b, 0x38, 0xe9, 0x41, 0x6b, 0xe8, 0xba, 0x4c, 0x88, 0x63, 0xe9, 0xc4, 0xd2, 0x6c,
-	0xf6, 0x4a, 0xfd, 0x0a, 0x2d, 0x42, 0xea, 0x2c, 0x6d, 0x1e, 0x44, 0x9e, 0x09, 0xe8, 0xcf, 0x01,
-	0x62, 0xc1, 0xd5, 0x6f, 0x9c, 0x37, 0x19, 0xb7, 0xc2, 0x6c, 0x61, 0xc0, 0x12, 0xa6, 0xee, 0x33,
-	0x4a, 0xe2, 0x98, 0x45, 0x32, 0x8f, 0xbb, 0x8a, 0x71, 0x17, 0x70, 0x15, 0xfb, 0xad, 0xcf, 0x66,
-	0x27, 0x2c, 0x9c, 0x06, 0x8e, 0x64, 0x87, 0x4e, 0xc8, 0xcc, 0x2d, 0x2c, 0xf0, 0x02, 0x4e, 0x9e,
-	0x40, 0x2b, 0xef, 0x08, 0x61, 0xb6, 0xb1, 0xa9, 0x7b, 0xba, 0x93, 0x16, 0x6f, 0x6b, 0x17, 0x42,
-	0xb4, 0x1c, 0x8a, 0x49, 0xe4, 0x13, 0xf8, 0x1f, 0x65, 0x42, 0xc6, 0xbe, 0x2b, 0x4f, 0x78, 0x71,
-	0xb7, 0x6d, 0x24, 0x6c, 0xbd, 0x73, 0x59, 0x9f, 0x9d, 0x4d, 0xfa, 0xfc, 0x1c, 0x5a, 0x47, 0x2c,
-	0x0e, 0x7d, 0x21, 0x70, 0xba, 0x5d, 0xeb, 0x55, 0xfa, 0xed, 0xdd, 0xff, 0xaf, 0xdc, 0x51, 0xd3,
-	0x79, 0x32, 0x9f, 0x32, 0x5a, 0x8c, 0x5e, 0x92, 0x2c, 0xf9, 0x0f, 0x24, 0xbb, 0xb3, 0x59, 0xb2,
-	0x2f, 0xa0, 0xb3, 0x4a, 0xd6, 0x1a, 0xe5, 0x0e, 0x96, 0x95, 0xbb, 0x5a, 0x4b, 0xbe, 0x43, 0x51,
-	0xc5, 0xdf, 0x41, 0xfb, 0x28, 0x91, 0x4a, 0xde, 0xaa, 0xf3, 0x98, 0x90, 0xe4, 0x8e, 0x1a, 0xb4,
-	0x3c, 0xc4, 0x9d, 0x15, 0x7b, 0x0b, 0xfd, 0x53, 0xc4, 0x49, 0x1f, 0xb6, 0x47, 0x31, 0x73, 0x24,
-	0x3b, 0x08, 0xa7, 0x72, 0xae, 0x08, 0xc5, 0x03, 0x1b, 0x74, 0x15, 0xb6, 0xee, 0x43, 0xfb, 0x29,
-	0x5b, 0xda, 0x7b, 0x8d, 0x9c, 0xad, 0x0f, 0xe0, 0xda, 0x3e, 0x0b, 0x98, 0x64, 0xef, 0x0a, 0xb4,
-	0x81, 0x14, 0x03, 0xc5, 0x94, 0x47, 0x82, 0x29, 0xc5, 0x1f, 0x27, 0xf8, 0x4c, 0x18, 0xdc, 0xa0,
-	0x99, 0x69, 0x3d, 0x80, 0x9d, 0xa7, 0x4c, 0x2e, 0xea, 0xdf, 0xb4, 0xf5, 0x1f, 0x25, 0xd8, 0x39,
-	0x4a, 0x2e, 0xc6, 0x3e, 0x2a, 0xcc, 0xa2, 0x94, 0x90, 0xed, 0x15, 0x5a, 0x69, 0x61, 0x5a, 0xf5,
-	0x61, 0x3b, 0xd3, 0xf1, 0x41, 0xa4, 0x5e, 0xce, 0xcb, 0xa8, 0x59, 0x81, 0xc9, 0xfb, 0xd0, 0xd6,
-	0x6c, 0x65, 0x8e, 0x74, 0x5c, 0xad, 0xa0, 0x2a, 0x6e, 0x32, 0xf5, 0x8a, 0x71, 0x55, 0x1d, 0xb7,
-	0x8c, 0x2a, 0x75, 0x6a, 0x64, 0x94, 0x08, 0xc9, 0x43, 0x9c, 0x8d, 0x86, 0x56, 0xe7, 0x2a, 0x6e,
-	0x7d, 0x08, 0x37, 0x34, 0x8f, 0x97, 0xa2, 0x66, 0x0f, 0x6e, 0x5e, 0x88, 0x7e, 0x27, 0xf5, 0x3f,
-	0x96, 0xa1, 0x3b, 0xf6, 0x85, 0x26, 0xd4, 0xcb, 0x14, 0x21, 0xb2, 0x73, 0xc6, 0x29, 0xad, 0x4a,
-	0x58, 0x98, 0xda, 0xde, 0xb5, 0x35, 0xad, 0x6f, 0x4f, 0xca, 0x5d, 0x28, 0xc7, 0x7c, 0x03, 0x7d,
-	0x8d, 0xd3, 0xef, 0x99, 0x2b, 0xb3, 0x99, 0x9f, 0x9a, 0x8a, 0xbd, 0xe7, 0xb3, 0x88, 0x79, 0x4f,
-	0xe6, 0x59, 0x40, 0x15, 0xef, 0xb9, 0x82, 0xaa, 0xaf, 0x9f, 0xe7, 0x2f, 0x5f, 0x0a, 0x26, 0x91,
-	0x33, 0x83, 0xa6, 0x16, 0xb9, 0x0e, 0xc6, 0xd8, 0x0f, 0x7d, 0x89, 0x63, 0xde, 0xa0, 0xda, 0xb0,
-	0x6c, 0xd8, 0x5a, 0xba, 0x0b, 0xa9, 0x43, 0x65, 0x78, 0xf8, 0xa2, 0x73, 0x85, 0x34, 0xc1, 0x18,
-	0x3f, 0x3b, 0xfc, 0xfa, 0xb8, 0x53, 0x52, 0xcb, 0xd1, 0xc1, 0x78, 0x7c, 0xdc, 0x29, 0x5b, 0x3f,
-	0x97, 0xe1, 0xd6, 0xda, 0xba, 0x52, 0x1a, 0x0f, 0xa1, 0xb9, 0x00, 0xcd, 0x12, 0x4e, 0x93, 0x8f,
-	0x36, 0xb0, 0xa1, 0xb3, 0xec, 0x65, 0x9c, 0xe6, 0x5b, 0x14, 0xaa, 0x29, 0xaf, 0xaf, 0xa6, 0x52,
-	0xa8, 0x46, 0xa1, 0x27, 0x5c, 0x3a, 0xfa, 0x77, 0xd1, 0xa0, 0xda, 0xe8, 0xce, 0xa0, 0xbd, 0x7c,
-	0x80, 0x1a, 0x0b, 0x6a, 0x70, 0x2e, 0xc6, 0x42, 0x3e, 0x54, 0x11, 0x27, 0xf7, 0xa0, 0x8a, 0x2a,
-	0x29, 0xaf, 0x57, 0x09, 0x3a, 0x49, 0x0f, 0x0c, 0x25, 0x5e, 0x61, 0x56, 0xd2, 0xd1, 0x9c, 0x0f,
-	0x17, 0xed, 0xb0, 0x18, 0x74, 0x75, 0xc3, 0x62, 0x6a, 0x36, 0x10, 0x37, 0x34, 0xe8, 0xd2, 0x2c,
-	0x2e, 0x5f, 0x72, 0x16, 0x5b, 0x3f, 0x95, 0xe0, 0xd6, 0xda, 0x73, 0xde, 0xd5, 0xda, 0xff, 0xea,
-	0xb8, 0x4d, 0xa3, 0xbf, 0xb2, 0x71, 0xf4, 0x3f, 0xfc, 0xaa, 0xf0, 0x95, 0x95, 0xff, 0x1a, 0x91,
-	0xab, 0xd0, 0x38, 0xe4, 0xda, 0xee, 0x5c, 0x21, 0x2d, 0xa8, 0x1f, 0xc5, 0xec, 0xdc, 0x67, 0xb3,
-	0x4e, 0x49, 0xb9, 0xb2, 0x5f, 0xed, 0x4e, 0x99, 0x00, 0xd4, 0x26, 0x53, 0x5c, 0x57, 0x4e, 0x6b,
-	0xf8, 0xdd, 0xbf, 0xf7, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3c, 0xa2, 0x0f, 0xc2, 0xe6, 0x0c,
-	0x00, 0x00,
+	// 2446 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0xcf, 0x6f, 0x1b, 0xc7,
+	0xf5, 0xf7, 0xf2, 0xa7, 0xf8, 0x68, 0x51, 0xd4, 0x58, 0xb2, 0x37, 0x72, 0xe2, 0x08, 0x1b, 0x27,
+	0x51, 0x9c, 0x98, 0xfa, 0x46, 0xce, 0xb7, 0x70, 0xd2, 0x00, 0x05, 0x4d, 0xd2, 0x8e, 0x52, 0x9a,
+	0x22, 0x46, 0x92, 0x8d, 0xf4, 0xb6, 0xe6, 0x8e, 0xe5, 0xad, 0x97, 0x5c, 0x76, 0x77, 0x29, 0x59,
+	0x3d, 0xf4, 0x0f, 0xe8, 0x1f, 0xd0, 0x53, 0xd1, 0x63, 0x0b, 0xf4, 0xdf, 0xe8, 0xa5, 0x68, 0x2f,
+	0x05, 0x0a, 0xf4, 0x5a, 0xa0, 0xa7, 0xa2, 0xa7, 0x1e, 0x7a, 0xea, 0xa9, 0x78, 0x6f, 0x66, 0x77,
+	0x67, 0x97, 0x14, 0x25, 0x17, 0x3d, 0x48, 0xd8, 0xf9, 0xbc, 0x37, 0x3f, 0xde, 0xef, 0x37, 0x43,
+	0xa8, 0x87, 0xaf, 0xec, 0x40, 0xb4, 0xa6, 0x81, 0x1f, 0xf9, 0xac, 0x14, 0x88, 0x30, 0xda, 0x7a,
+	0x78, 0xe2, 0x46, 0xaf, 0x66, 0x2f, 0x5a, 0x23, 0x7f, 0xbc, 0x3b, 0x3d, 0x77, 0x5c, 0x7f, 0x77,
+	0x24, 0x3c, 0x2f, 0xdc, 0x1d, 0xf9, 0xe3, 0xb1, 0x3f, 0xd9, 0x0d, 0x45, 0x70, 0xea, 0x8e, 0xc4,
+	0x2e, 0x4d, 0x51, 0xa0, 0x9c, 0xbf, 0xf5, 0xf9, 0xf2, 0x99, 0x72, 0x86, 0xeb, 0x8c, 0xf1, 0x4f,
+	0x4d, 0x79, 0x70, 0x95, 0x29, 0x51, 0x20, 0x04, 0xfd, 0x53, 0x93, 0xbe, 0xa7, 0x4d, 0x1a, 0x9f,
+	0xb9, 0xd1, 0x6b, 0xff, 0x6c, 0xf7, 0xc4, 0xbf, 0x4f, 0xc4, 0xfb, 0xa7, 0xb6, 0xe7, 0x3a, 0x76,
+	0xe4, 0x07, 0xe1, 0x6e, 0xf2, 0x29, 0xe7, 0x59, 0x7f, 0x30, 0xa0, 0xda, 0x11, 0x9e, 0xd7, 0x1e,
+	0x79, 0xec, 0x26, 0x54, 0xb8, 0xef, 0x89, 0x7d, 0xc7, 0x34, 0xb6, 0x8d, 0x9d, 0x1a, 0x57, 0x23,
+	0xb6, 0x03, 0xd5, 0xf6, 0x28, 0x72, 0xfd, 0x49, 0x68, 0x16, 0xb6, 0x8b, 0x3b, 0xf5, 0xbd, 0x46,
+	0x0b, 0x4f, 0xdb, 0xee, 0xf4, 0x25, 0xcc, 0x63, 0x32, 0xbb, 0x03, 0xb0, 0x1f, 0x1e, 0x87, 0x22,
+	0xc0, 0x99, 0x66, 0x71, 0xdb, 0xd8, 0x59, 0xe1, 0x1a, 0xc2, 0xde, 0x83, 0x12, 0x7e, 0x9b, 0xa5,
+	0x6d, 0x63, 0xa7, 0xbe, 0x57, 0xa3, 0x65, 0x88, 0x48, 0x30, 0x7b, 0x1f, 0xca, 0x4f, 0x02, 0x7f,
+	0x36, 0x35, 0xcb, 0x79, 0xba, 0xc4, 0x71, 0x3e, 0xad, 0x5c, 0xd1, 0xe8, 0x08, 0x70, 0x82, 0xad,
+	0x3f, 0x17, 0xa1, 0x84, 0xc2, 0x30, 0x06, 0xa5, 0xe3, 0x99, 0x1b, 0xcb, 0x41, 0xdf, 0xec, 0x3d,
+	0x28, 0xf7, 0xed, 0x17, 0xc2, 0x33, 0x0b, 0x08, 0x3e, 0xaa, 0xfe, 0xed, 0xaf, 0xef, 0x17, 0xdf,
+	0xfc, 0xab, 0xc8, 0x25, 0xca, 0x3e, 0x81, 0x7a, 0x57, 0x84, 0xa3, 0xc0, 0x9d, 0xa2, 0x28, 0x66,
+	0x51, 0x63, 0xfa, 0x7b, 0x95, 0xeb, 0x34, 0xb6, 0x03, 0x35, 0xee, 0xfb, 0xd1, 0xc0, 0x77, 0x44,
+	0x68, 0x96, 0x48, 0x23, 0xd0, 0x22, 0x5b, 0x20, 0xc4, 0x53, 0x22, 0xdb, 0x81, 0x52, 0xbb, 0xd3,
+	0x0f, 0xcd, 0x32, 0x31, 0x6d, 0xb4, 0xd0, 0x99, 0x5a, 0x78, 0x42, 0x54, 0x5e, 0xd8, 0x9b, 0x44,
+	0xc1, 0x39, 0x27, 0x0e, 0xf6, 0x00, 0x56, 0x86, 0xbe, 0xe7, 0x8e, 0x5c, 0x11, 0x9a, 0x15, 0xe2,
+	0xbe, 0xd5, 0x52, 0x6e, 0xd5, 0xe2, 0x22, 0xf4, 0x67, 0xc1, 0x48, 0x10, 0xc3, 0x39, 0x4f, 0x18,
+	0xd9, 0x43, 0xb8, 0x15, 0x7f, 0x77, 0xfc, 0x49, 0x24, 0xde, 0x44, 0x3d, 0xc7, 0x8d, 0xec, 0x17,
+	0x9e, 0x30, 0xab, 0xa4, 0xfb, 0x8b, 0xc8, 0xec, 0x5d, 0xa8, 0xb5, 0x47, 0x23, 0x11, 0x86, 0xbd,
+	0x89, 0x63, 0xae, 0x6c, 0x1b, 0x3b, 0x45, 0x9e, 0x02, 0x6c, 0x0b, 0x56, 0xba, 0x6e, 0x88, 0x8c,
+	0x8e, 0x59, 0xa3, 0x85, 0x92, 0x31, 0xdb, 0x81, 0xb5, 0xa1, 0x98, 0x38, 0xee, 0xe4, 0xa4, 0x3d,
+	0x9d, 0x06, 0xfe, 0xa9, 0xed, 0x99, 0x40, 0x2c, 0x79, 0x78, 0xeb, 0x31, 0xd4, 0x12, 0x29, 0x59,
+	0x13, 0x8a, 0xaf, 0xc5, 0xb9, 0x32, 0x08, 0x7e, 0xb2, 0x0f, 0xa0, 0x7c, 0x6a, 0x7b, 0x33, 0x41,
+	0xf6, 0xa8, 0xef, 0xad, 0xa6, 0xca, 0x69, 0x8f, 0x3c, 0x2e, 0x69, 0x5f, 0x15, 0x1e, 0x1a, 0xd6,
+	0x31, 0xdc, 0x38, 0xc4, 0x88, 0xec, 0xbb, 0x93, 0xd7, 0x47, 0x76, 0x70, 0x22, 0x22, 0x72, 0x16,
+	0x13, 0xaa, 0x5d, 0x37, 0x9c, 0x7a, 0x76, 0xbc, 0x6a, 0x3c, 0x64, 0x77, 0x61, 0xb5, 0xeb, 0x9f,
+	0x4d, 0x3c, 0xdf, 0x76, 0x3a, 0xfe, 0x6c, 0x12, 0xd1, 0x0e, 0x65, 0x9e, 0x05, 0xad, 0x7f, 0x56,
+	0xa1, 0x96, 0xac, 0xbb, 0xd0, 0x63, 0xb6, 0x60, 0x05, 0x69, 0xdf, 0xd8, 0xe1, 0x2b, 0xe9, 0x34,
+	0x3c, 0x19, 0xe3, 0xee, 0xf8, 0x7d, 0x1c, 0x78, 0xd2, 0x55, 0x78, 0x3c, 0x4c, 0xfd, 0xac, 0x74,
+	0x15, 0x3f, 0x2b, 0x2f, 0xf1, 0xb3, 0x2d, 0x58, 0x41, 0x49, 0xe9, 0x5c, 0x15, 0xb9, 0x7f, 0x3c,
+	0x46, 0x03, 0xe2, 0x77, 0xdf, 0x3f, 0x71, 0x27, 0x64, 0xec, 0x1a, 0x4f, 0x01, 0x76, 0x0f, 0x9a,
+	0x43, 0x3b, 0x0c, 0xcf, 0xfc, 0xc0, 0xe1, 0xe2, 0x27, 0x33, 0x37, 0x10, 0xd2, 0xca, 0x2b, 0x7c,
+	0x0e, 0x67, 0xdb, 0x50, 0x97, 0x96, 0x3f, 0x8c, 0xec, 0x20, 0x22, 0x7b, 0x17, 0xb9, 0x0e, 0x65,
+	0x9d, 0x05, 0xf2, 0xce, 0x62, 0xc1, 0xf5, 0xa7, 0xf6, 0x9b, 0x58, 0xb7, 0xa1, 0x59, 0x27, 0x86,
+	0x0c, 0x86, 0xe7, 0xe9, 0xcc, 0x82, 0x40, 0x4c, 0xa2, 0x94, 0xef, 0x3a, 0xf1, 0xcd, 0xe1, 0xc8,
+	0xfb, 0xcc, 0x15, 0x67, 0x47, 0x62, 0x3c, 0xf5, 0xec, 0x48, 0x0c, 0xec, 0xb1, 0x30, 0x57, 0x49,
+	0xc0, 0x39, 0x9c, 0x3d, 0x82, 0x7a, 0xea, 0x11, 0xa1, 0xd9, 0xa0, 0xc0, 0xd9, 0x96, 0x9e, 0x94,
+	0xd8, 0xb6, 0xa5, 0xb1, 0xc8, 0x90, 0xd3, 0x27, 0xb1, 0x2f, 0x60, 0x93, 0x8b, 0x30, 0x0a, 0xdc,
+	0x51, 0x74, 0xe4, 0xeb, 0xab, 0xad, 0x91, 0xc2, 0x16, 0x13, 0xb3, 0x39, 0xa0, 0xb9, 0x2c, 0x07,
+	0x7c, 0x1f, 0xea, 0x43, 0x11, 0x8c, 0xdd, 0x30, 0xa4, 0x0c, 0xba, 0xbe, 0x5d, 0xdc, 0x69, 0xec,
+	0xbd, 0x93, 0x3b, 0xa3, 0x54, 0xe7, 0xd1, 0xf9, 0x54, 0x70, 0x9d, 0x3b, 0x93, 0x16, 0xd8, 0xff,
+	0x20, 0x2d, 0xdc, 0x58, 0x9e, 0x16, 0xbe, 0x86, 0xfa, 0x63, 0xd7, 0x13, 0xe8, 0x1b, 0x22, 0x8c,
+	0xcc, 0x0d, 0x8a, 0xcc, 0xad, 0xdc, 0x59, 0x35, 0x0e, 0xae, 0xb3, 0x67, 0xd2, 0xc6, 0xe6, 0xe5,
+	0x69, 0xe3, 0xe6, 0xe2, 0xb4, 0xf1, 0x1d, 0x34, 0xf3, 0x06, 0x5b, 0x90, 0x3d, 0x76, 0xb3, 0xd9,
+	0x23, 0xaf, 0xcf, 0x74, 0x05, 0x3d, 0x93, 0xbc, 0x84, 0x8d, 0x45, 0x52, 0x60, 0x08, 0x3c, 0xb5,
+	0xdf, 0x20, 0x72, 0xe8, 0xfe, 0x54, 0xd0, 0x36, 0x45, 0xae, 0x43, 0xec, 0x33, 0x58, 0x6f, 0x7b,
+	0x9e, 0x7f, 0x26, 0x9c, 0xde, 0x9b, 0x48, 0x4c, 0xc2, 0xa4, 0x18, 0xd6, 0xf8, 0x3c, 0xc1, 0xfa,
+	0x63, 0x01, 0xd6, 0xb4, 0xf5, 0xbb, 0x81, 0x3f, 0x5d, 0x96, 0x60, 0x08, 0xd7, 0x12, 0x0c, 0xd1,
+	0x2c, 0xb8, 0x7e, 0x3c, 0xc5, 0x88, 0x10, 0x01, 0x85, 0x80, 0xcc, 0x32, 0x19, 0x0c, 0x13, 0x5d,
+	0x3c, 0xee, 0x8d, 0x6d, 0x57, 0xa5, 0x1c, 0x9e, 0x05, 0xb1, 0x28, 0x3f, 0xf6, 0x3d, 0x47, 0x25,
+	0x12, 0x4a, 0x38, 0x5c, 0x43, 0x52, 0x3a, 0xed, 0x53, 0xd1, 0xe9, 0xb4, 0x4b, 0x42, 0x1f, 0xda,
+	0xd1, 0x2b, 0x95, 0x6b, 0x34, 0x04, 0xd3, 0x43, 0x27, 0x10, 0x76, 0x24, 0x9c, 0x76, 0x14, 0xd7,
+	0x92, 0x04, 0x40, 0xdd, 0x76, 0xfc, 0xf1, 0xd4, 0x13, 0x92, 0xae, 0xd2, 0x8b, 0x06, 0xd1, 0xfa,
+	0xae, 0x27, 0x42, 0x99, 0xab, 0x81, 0x72, 0xb5, 0x86, 0x58, 0xbf, 0x30, 0xe0, 0x5d, 0xb9, 0x5e,
+	0x4e, 0xa7, 0x9a, 0xdf, 0x25, 0x6a, 0x34, 0x72, 0x6a, 0xfc, 0x34, 0xa7, 0x46, 0xbd, 0xf8, 0xff,
+	0xde, 0xc8, 0xe9, 0xf3, 0x7e, 0x5e, 0x9f, 0xc5, 0x2c, 0x77, 0x96, 0x6a, 0x7d, 0x01, 0x77, 0x62,
+	0x39, 0x2e, 0x38, 0xd9, 0x02, 0xa3, 0x5b, 0x7f, 0x2a, 0xc0, 0x5a, 0x2e, 0xee, 0xdf, 0xda, 0x39,
+	0x18, 0x94, 0x8e, 0x5c, 0xe5, 0x14, 0x45, 0x4e, 0xdf, 0xec, 0x01, 0x54, 0x64, 0x1b, 0x46, 0x5e,
+	0xd0, 0xd8, 0xbb, 0xbd, 0x30, 0xc5, 0x48, 0x16, 0xae, 0x58, 0xd1, 0x76, 0x5c, 0x8c, 0xdc, 0xa9,
+	0x2b, 0x26, 0x91, 0x72, 0x8d, 0x14, 0xc0, 0x54, 0x9c, 0x0c, 0xe2, 0x5a, 0x2b, 0xfd, 0x63, 0x0e,
+	0x47, 0x5f, 0xe4, 0x62, 0xec, 0x47, 0xa2, 0xed, 0x38, 0x81, 0x08, 0x43, 0xe5, 0x28, 0x59, 0x30,
+	0x2e, 0x5b, 0xed, 0x13, 0x31, 0x91, 0xbe, 0x52, 0xe3, 0x29, 0x80, 0x22, 0x63, 0xce, 0x24, 0x91,
+	0x6b, 0x52, 0xe4, 0x78, 0x1c, 0xd3, 0xc8, 0x07, 0x21, 0xa5, 0xe1, 0xd8, 0xfa, 0x65, 0x01, 0xcc,
+	0x44, 0xce, 0xe4, 0x64, 0x5c, 0x4c, 0x7d, 0x59, 0xbd, 0x52, 0x11, 0x8d, 0xab, 0x88, 0x58, 0xb8,
+	0xaa, 0x88, 0xc5, 0x45, 0x22, 0x6e, 0x40, 0xf9, 0x60, 0x2a, 0x26, 0x21, 0x99, 0xa1, 0xcc, 0xe5,
+	0x00, 0x8f, 0x3f, 0x0c, 0xc4, 0xa9, 0x2b, 0xce, 0x42, 0xd2, 0x73, 0x99, 0x27, 0x63, 0x3c, 0x61,
+	0x5a, 0x16, 0x2b, 0x44, 0x4c, 0x01, 0x0c, 0xa0, 0xc7, 0x6e, 0x10, 0x46, 0xd2, 0x7e, 0xa4, 0xd6,
+	0x22, 0xd7, 0x21, 0x0c, 0xa0, 0xbe, 0x9d, 0x30, 0xc8, 0x08, 0xd4, 0x10, 0xeb, 0x67, 0xb0, 0xd5,
+	0x77, 0xc3, 0x28, 0xe7, 0x09, 0x57, 0x89, 0x9e, 0x9b, 0x50, 0x79, 0xec, 0x07, 0x63, 0x3b, 0x52,
+	0x3a, 0x51, 0x23, 0xc4, 0x0f, 0x5e, 0xbe, 0x0c, 0x45, 0x44, 0x2a, 0x28, 0x73, 0x35, 0x42, 0xd9,
+	0xfb, 0xee, 0xd8, 0x8d, 0x62, 0xd9, 0x69, 0x60, 0xfd, 0xca, 0x80, 0xdb, 0x0b, 0x0f, 0x10, 0x4e,
+	0xfd, 0x49, 0x28, 0xd8, 0x2e, 0x54, 0x31, 0xcd, 0x63, 0x8d, 0x33, 0xa8, 0xc6, 0x6d, 0x2e, 0x74,
+	0x5d, 0x1e, 0x73, 0xe1, 0x36, 0x47, 0x7e, 0x64, 0x7b, 0xaa, 0xb1, 0x93, 0x03, 0xf6, 0x10, 0xaa,
+	0xd2, 0xe4, 0x68, 0x18, 0x5c, 0xe6, 0x4e, 0x6e, 0x99, 0x9c, 0x67, 0xf0, 0x98, 0xdd, 0xfa, 0x4b,
+	0x09, 0x56, 0x89, 0x2b, 0x2e, 0x42, 0x0b, 0x03, 0xb2, 0xa3, 0xfa, 0x45, 0xac, 0xd2, 0xb4, 0x73,
+	0x63, 0xef, 0x43, 0x6d, 0x87, 0x78, 0x6e, 0x2b, 0xfe, 0x48, 0x98, 0x79, 0x3a, 0x0f, 0x6d, 0x4d,
+	0x03, 0x5a, 0x5d, 0xfa, 0x4f, 0x0a, 0xa0, 0x25, 0xe5, 0x69, 0xd3, 0x06, 0x92, 0x6b, 0x48, 0xb6,
+	0xeb, 0x28, 0x2f, 0xeb, 0x3a, 0x3e, 0x82, 0x86, 0x32, 0x70, 0xdc, 0x24, 0xca, 0xc0, 0xcd, 0xa1,
+	0xd2, 0xa7, 0x15, 0x42, 0x67, 0x4a, 0xc2, 0x56, 0x03, 0x2f, 0x49, 0xf1, 0x77, 0x61, 0x15, 0x8b,
+	0x5f, 0x30, 0xb1, 0x3d, 0xd9, 0x39, 0xd5, 0xa8, 0x30, 0x66, 0x41, 0xf6, 0x15, 0x54, 0x0e, 0x23,
+	0x3b, 0x9a, 0x85, 0x14, 0xbe, 0x8d, 0x3d, 0x6b, 0xa9, 0xee, 0x88, 0x93, 0xab, 0x19, 0xe8, 0xa3,
+	0x92, 0x22, 0x02, 0xea, 0x2f, 0x6b, 0x3c, 0x19, 0x53, 0xf4, 0x88, 0x91, 0xeb, 0xd0, 0xd9, 0x64,
+	0x53, 0x99, 0x02, 0xd8, 0xa7, 0x77, 0xfc, 0xf1, 0x18, 0x63, 0x5f, 0x36, 0x91, 0xf1, 0xd0, 0xfa,
+	0x12, 0x1a, 0xd9, 0xdd, 0x58, 0x1d, 0xaa, 0xc3, 0xde, 0xa0, 0xbb, 0x3f, 0x78, 0xd2, 0xbc, 0xc6,
+	0xae, 0xc3, 0x4a, 0x7b, 0x38, 0xe4, 0x07, 0xcf, 0x7a, 0xdd, 0xa6, 0x81, 0x23, 0xde, 0xfb, 0xb6,
+	0xd7, 0x39, 0xea, 0x75, 0x9b, 0x05, 0xeb, 0x63, 0x58, 0x9f, 0x33, 0x32, 0x5b, 0x81, 0x52, 0x7f,
+	0x7f, 0xf0, 0xc3, 0xe6, 0x35, 0xfc, 0xea, 0xf4, 0xfa, 0xfd, 0xa6, 0x61, 0xfd, 0xc6, 0x80, 0x77,
+	0x12, 0xcf, 0x8f, 0xa7, 0x24, 0x91, 0x97, 0x6a, 0xc4, 0x78, 0x6b, 0x8d, 0x60, 0xd7, 0xee, 0x29,
+	0x50, 0x84, 0xe4, 0x8e, 0x2b, 0x5c, 0x87, 0xde, 0x32, 0x46, 0x85, 0x96, 0x23, 0xb4, 0x83, 0xaa,
+	0x08, 0xfd, 0x1c, 0x6a, 0x09, 0xa8, 0x62, 0xf4, 0xc6, 0x82, 0xc3, 0xf2, 0x94, 0x6b, 0x71, 0x8c,
+	0x5a, 0x0e, 0x6c, 0x49, 0xdb, 0x64, 0xe7, 0x5d, 0x5c, 0x2e, 0xd1, 0x80, 0xca, 0xd4, 0x4a, 0xc8,
+	0x78, 0xa8, 0x9b, 0xb6, 0x98, 0x35, 0xed, 0xbf, 0x4b, 0xb0, 0x72, 0x30, 0x1a, 0xd3, 0x1e, 0x0b,
+	0x17, 0xfd, 0x7f, 0xa8, 0x75, 0xdd, 0x40, 0xc8, 0x72, 0x29, 0x43, 0xf9, 0x96, 0x94, 0x27, 0x9e,
+	0x96, 0x90, 0x79, 0xca, 0xc9, 0x3e, 0x4b, 0x0c, 0x56, 0xa4, 0x39, 0x1b, 0xd9, 0x39, 0x39, 0x13,
+	0x31, 0x28, 0x51, 0xcb, 0x21, 0xc3, 0x98, 0xbe, 0xd1, 0x6c, 0x73, 0xb7, 0xbf, 0xec, 0xa5, 0xef,
+	0x5d, 0xa8, 0xf5, 0xfd, 0x91, 0x0c, 0x1a, 0x15, 0xb3, 0x29, 0x90, 0xa4, 0x8f, 0xe7, 0x6e, 0xd2,
+	0x8a, 0xa5, 0x00, 0x95, 0x9e, 0xb3, 0x89, 0x08, 0x54, 0x65, 0x95, 0x03, 0x2c, 0x71, 0xf4, 0xa1,
+	0xca, 0x18, 0x9d, 0x49, 0x56, 0xd7, 0x39, 0x1c, 0x13, 0xd0, 0x30, 0xf0, 0x4f, 0x5d, 0x47, 0x04,
+	0xfb, 0x8e, 0xaa, 0xb3, 0x1a, 0x22, 0x8b, 0x29, 0x56, 0x3b, 0xbc, 0xf8, 0xd6, 0xe3, 0x62, 0xaa,
+	0x80, 0xfc, 0x55, 0xe7, 0xfa, 0x5b, 0x5d, 0x75, 0xf4, 0xe2, 0xbf, 0x9a, 0x2b, 0xfe, 0x77, 0x61,
+	0xf5, 0xb9, 0x1f, 0xbc, 0x0e, 0xa7, 0xf6, 0x48, 0x32, 0x34, 0x64, 0x96, 0xca, 0x80, 0xd8, 0x32,
+	0x27, 0x77, 0x22, 0x6c, 0x13, 0xd6, 0x64, 0xcb, 0xac, 0x63, 0xc8, 0x43, 0x27, 0x71, 0x0e, 0xc5,
+	0x28, 0x10, 0x91, 0xd9, 0x94, 0x3c, 0x3a, 0x96, 0xcd, 0x76, 0xeb, 0xf9, 0x6c, 0x77, 0x07, 0xe0,
+	0x1b, 0xd7, 0x71, 0xc4, 0x84, 0x2c, 0xc4, 0xa4, 0x8a, 0x52, 0xc4, 0xfa, 0x9d, 0x01, 0x6c, 0x38,
+	0x8b, 0x62, 0xa7, 0x88, 0x7d, 0xfb, 0x0e, 0x94, 0x50, 0x1c, 0x72, 0xc3, 0x6c, 0xd6, 0x26, 0x9c,
+	0x7d, 0xa8, 0x5b, 0x36, 0xf7, 0x44, 0x95, 0x52, 0xf2, 0x2a, 0x2e, 0xbe, 0x95, 0x8a, 0x73, 0x6f,
+	0x0f, 0xa5, 0x8b, 0xdf, 0x1e, 0xac, 0xa7, 0xb0, 0x81, 0xf9, 0x20, 0x96, 0x22, 0xc9, 0x59, 0x99,
+	0xc8, 0x31, 0xae, 0x1a, 0x39, 0xd6, 0x0f, 0x60, 0x33, 0xb7, 0x9c, 0xca, 0x2c, 0x1f, 0x41, 0x45,
+	0x22, 0x2a, 0xad, 0x34, 0xb2, 0x8b, 0x71, 0x45, 0xb5, 0x7a, 0xb0, 0x89, 0x52, 0x4d, 0xe7, 0xf4,
+	0x7a, 0x41, 0xce, 0xe8, 0x8a, 0x91, 0xe7, 0x4e, 0x92, 0x9c, 0xa1, 0x86, 0xd6, 0xa7, 0xb0, 0xd9,
+	0x15, 0xd8, 0xb0, 0x5f, 0x61, 0x19, 0x6b, 0x0f, 0x6e, 0xe6, 0x99, 0xd5, 0xa9, 0x4d, 0xa8, 0x1e,
+	0xce, 0x64, 0xbb, 0x65, 0xc8, 0x0d, 0xd4, 0xd0, 0x7a, 0x04, 0xa6, 0x2e, 0x28, 0x15, 0xe3, 0x65,
+	0x47, 0x65, 0x50, 0x22, 0x5f, 0x95, 0xfd, 0x15, 0x7d, 0x5b, 0x3f, 0x82, 0xc6, 0x70, 0x16, 0xe1,
+	0x4b, 0x98, 0xe6, 0x3c, 0xdc, 0xf7, 0xc7, 0x89, 0xf3, 0x24, 0x4f, 0x65, 0x9c, 0x70, 0xbc, 0x5d,
+	0x4b, 0x07, 0xed, 0x8d, 0xa7, 0xd1, 0x39, 0x76, 0x01, 0x4a, 0xf0, 0x3c, 0x6c, 0xdd, 0x85, 0xc6,
+	0x13, 0x91, 0x59, 0x7b, 0x91, 0xe4, 0x1f, 0xc3, 0xba, 0x94, 0xfc, 0x32, 0xc6, 0x16, 0x30, 0x9d,
+	0xf1, 0x52, 0xf5, 0x7c, 0x02, 0x37, 0x9e, 0x88, 0x48, 0xeb, 0xc8, 0x2e, 0x5e, 0xfa, 0x1f, 0x06,
+	0xdc, 0x18, 0xce, 0xe6, 0x79, 0xef, 0x6b, 0xcf, 0x76, 0x4a, 0x21, 0x6b, 0xf9, 0x46, 0x2f, 0xe5,
+	0xa0, 0x87, 0x07, 0xf5, 0xe4, 0xd5, 0x9b, 0xc8, 0xb7, 0x09, 0xa5, 0x9a, 0x1c, 0x8c, 0x2d, 0x93,
+	0xd4, 0x56, 0x4c, 0x50, 0x65, 0x25, 0x87, 0x22, 0xdf, 0xf1, 0xd4, 0xd1, 0xf9, 0x64, 0x86, 0xcf,
+	0xa1, 0x98, 0x77, 0x25, 0xd2, 0x99, 0x85, 0x91, 0x3f, 0xa6, 0x67, 0x44, 0x99, 0xf0, 0xe7, 0x70,
+	0xeb, 0xb3, 0xd8, 0xd5, 0xae, 0xa4, 0x9a, 0x07, 0x70, 0x6b, 0x8e, 0xfb, 0x52, 0xd5, 0xff, 0xbc,
+	0xa0, 0x95, 0x78, 0x27, 0x4e, 0x8a, 0x89, 0x73, 0xf6, 0xf5, 0xee, 0x56, 0x06, 0x76, 0x4b, 0xaa,
+	0xf5, 0xe2, 0x49, 0x29, 0x29, 0xdf, 0xe6, 0xd2, 0x31, 0x5e, 0xfc, 0x58, 0x8c, 0x92, 0xda, 0xac,
+	0x86, 0xa8, 0x3d, 0xac, 0x3a, 0xce, 0xa3, 0xf3, 0x98, 0xa1, 0x44, 0xe7, 0xcc, 0xa1, 0x5a, 0xfb,
+	0x52, 0x5e, 0xdc, 0xbe, 0x54, 0xf4, 0xf6, 0xa5, 0x05, 0xab, 0x99, 0xb3, 0xb0, 0x2a, 0x14, 0xdb,
+	0x83, 0xef, 0x9a, 0xd7, 0x58, 0x0d, 0xca, 0xd8, 0x96, 0x1d, 0x36, 0x0d, 0xfc, 0xc4, 0xbe, 0xec,
+	0xb0, 0x59, 0xb0, 0x7e, 0x5b, 0x80, 0xdb, 0x0b, 0xe5, 0x52, 0x6a, 0x1c, 0x40, 0x2d, 0x01, 0x55,
+	0x66, 0xfa, 0xbf, 0x25, 0xda, 0x90, 0xb3, 0x5a, 0x59, 0x9c, 0xa7, 0x4b, 0x68, 0xd2, 0x14, 0x16,
+	0x4b, 0x53, 0xd4, 0xa4, 0x49, 0x7b, 0xa7, 0x92, 0xd6, 0x3b, 0x6d, 0x9d, 0x41, 0x23, 0xbb, 0xc1,
+	0xa5, 0x35, 0xe5, 0x03, 0x28, 0x51, 0x94, 0x14, 0x16, 0x47, 0x09, 0x11, 0xd9, 0x36, 0x94, 0x31,
+	0x78, 0xe3, 0x4b, 0x93, 0x9e, 0x5c, 0x24, 0x01, 0x7b, 0x43, 0xe9, 0xb0, 0x34, 0x35, 0x7e, 0x3b,
+	0x5c, 0x96, 0xd5, 0xf4, 0x67, 0xcb, 0xc2, 0x15, 0x9f, 0x2d, 0xad, 0x5f, 0x1b, 0x70, 0x7b, 0xe1,
+	0x3e, 0x97, 0xb9, 0xf6, 0x7f, 0xb5, 0xdd, 0xb2, 0x57, 0xd2, 0xe2, 0xd2, 0x57, 0xd2, 0x7b, 0xdf,
+	0x6a, 0x3f, 0x48, 0xa4, 0xa5, 0x16, 0xef, 0x08, 0x03, 0x5f, 0x8e, 0x9b, 0xd7, 0xe8, 0x32, 0x21,
+	0x2f, 0xf8, 0xf2, 0xfa, 0x10, 0x5f, 0xe8, 0x9b, 0x05, 0x06, 0x50, 0x91, 0x0f, 0x49, 0xcd, 0xe2,
+	0xbd, 0xaf, 0x61, 0x73, 0xe1, 0x0b, 0x0d, 0x5e, 0x22, 0x0e, 0x86, 0xbd, 0x81, 0x5a, 0x89, 0xf7,
+	0x9e, 0xed, 0xf7, 0x9e, 0xab, 0x95, 0x0e, 0x9e, 0x0f, 0xfa, 0x07, 0xed, 0x6e, 0xb3, 0x70, 0x6f,
+	0x17, 0xd6, 0xe7, 0xca, 0x2e, 0xb2, 0x1c, 0x1c, 0x1f, 0x3d, 0x39, 0x48, 0xee, 0x31, 0xfb, 0x83,
+	0xce, 0xc1, 0x53, 0x1c, 0x19, 0xf7, 0xbe, 0x84, 0x46, 0xb6, 0x5b, 0x9d, 0xbf, 0xf4, 0x74, 0x3a,
+	0xbd, 0xe1, 0x51, 0x7c, 0xe9, 0xe9, 0xf6, 0x3a, 0xfd, 0xfd, 0x01, 0x5e, 0x7a, 0x5e, 0x54, 0xe8,
+	0x07, 0xc3, 0x07, 0xff, 0x19, 0x00, 0x51, 0x33, 0x12, 0x9a, 0x1f, 0x1d, 0x00, 0x00,
 }
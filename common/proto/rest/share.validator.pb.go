@@ -91,6 +91,123 @@ func (this *ShareLink) Validate() error {
 			}
 		}
 	}
+	if this.FileRequest != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.FileRequest); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("FileRequest", err)
+		}
+	}
+	return nil
+}
+func (this *ShareLinkFileRequest) Validate() error {
+	return nil
+}
+func (this *FileRequestDrop) Validate() error {
+	return nil
+}
+func (this *CreateFileRequestDropRequest) Validate() error {
+	if !(len(this.UploaderName) < 200) {
+		return github_com_mwitkow_go_proto_validators.FieldError("UploaderName", fmt.Errorf(`value '%v' must length be less than '200'`, this.UploaderName))
+	}
+	if !(len(this.UploaderEmail) < 200) {
+		return github_com_mwitkow_go_proto_validators.FieldError("UploaderEmail", fmt.Errorf(`value '%v' must length be less than '200'`, this.UploaderEmail))
+	}
+	return nil
+}
+func (this *CompleteFileRequestDropRequest) Validate() error {
+	return nil
+}
+func (this *ShareLinkAccess) Validate() error {
+	return nil
+}
+func (this *ShareLinkRecipientReport) Validate() error {
+	return nil
+}
+func (this *ListShareLinkAccessRequest) Validate() error {
+	return nil
+}
+func (this *ListShareLinkAccessResponse) Validate() error {
+	for _, item := range this.Entries {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Entries", err)
+			}
+		}
+	}
+	for _, item := range this.Reports {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Reports", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *ShareApproval) Validate() error {
+	for _, item := range this.RootNodes {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("RootNodes", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *ListShareApprovalsRequest) Validate() error {
+	return nil
+}
+func (this *ListShareApprovalsResponse) Validate() error {
+	for _, item := range this.Approvals {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Approvals", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *DecideShareApprovalRequest) Validate() error {
+	return nil
+}
+func (this *OcmShare) Validate() error {
+	return nil
+}
+func (this *PutOcmShareRequest) Validate() error {
+	if this.Node != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Node); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Node", err)
+		}
+	}
+	if !(len(this.ShareWith) < 500) {
+		return github_com_mwitkow_go_proto_validators.FieldError("ShareWith", fmt.Errorf(`value '%v' must length be less than '500'`, this.ShareWith))
+	}
+	if !(len(this.Description) < 1000) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Description", fmt.Errorf(`value '%v' must length be less than '1000'`, this.Description))
+	}
+	return nil
+}
+func (this *ListOcmSharesRequest) Validate() error {
+	return nil
+}
+func (this *ListOcmSharesResponse) Validate() error {
+	for _, item := range this.Shares {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Shares", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *AcceptOcmShareRequest) Validate() error {
+	return nil
+}
+func (this *DeleteOcmShareRequest) Validate() error {
+	return nil
+}
+func (this *DeleteOcmShareResponse) Validate() error {
+	return nil
+}
+func (this *ListOcmShareNodesRequest) Validate() error {
 	return nil
 }
 func (this *PutCellRequest) Validate() error {
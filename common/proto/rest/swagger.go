@@ -207,6 +207,82 @@ var SwaggerJson = `{
         ]
       }
     },
+    "/auth/pat": {
+      "get": {
+        "summary": "List the personal access tokens of the current user",
+        "operationId": "ListPersonalAccessTokens",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restPersonalAccessTokenCollection"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "UserLogin",
+            "description": "Admins can list the tokens of another user.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "TokenService"
+        ]
+      },
+      "post": {
+        "summary": "Generate a personal access token for the current user",
+        "operationId": "GeneratePersonalAccessToken",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restPersonalAccessTokenResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restPersonalAccessTokenRequest"
+            }
+          }
+        ],
+        "tags": [
+          "TokenService"
+        ]
+      }
+    },
+    "/auth/pat/{Uuid}": {
+      "delete": {
+        "summary": "Revoke a personal access token",
+        "operationId": "RevokePersonalAccessToken",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restRevokeResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "Uuid",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "TokenService"
+        ]
+      }
+    },
     "/auth/reset-password": {
       "post": {
         "summary": "Finish up the reset password process by providing the unique token",
@@ -242,32 +318,388 @@ var SwaggerJson = `{
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/restResetPasswordTokenResponse"
+              "$ref": "#/definitions/restResetPasswordTokenResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "UserLogin",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "TokenService"
+        ]
+      }
+    },
+    "/auth/sessions": {
+      "get": {
+        "summary": "List the active sessions of the current user",
+        "operationId": "ListSessions",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restSessionCollection"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "UserLogin",
+            "description": "Admins can list the sessions of another user.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "TokenService"
+        ]
+      }
+    },
+    "/auth/sessions/revoke": {
+      "post": {
+        "summary": "Revoke all sessions of the current user, or of another user for admins",
+        "operationId": "RevokeSessions",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restRevokeResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restRevokeSessionsRequest"
+            }
+          }
+        ],
+        "tags": [
+          "TokenService"
+        ]
+      }
+    },
+    "/auth/sessions/{Uuid}": {
+      "delete": {
+        "summary": "Revoke a session",
+        "operationId": "RevokeSession",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restRevokeResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "Uuid",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "TokenService"
+        ]
+      }
+    },
+    "/auth/token/revoke": {
+      "post": {
+        "summary": "Revoke a JWT token",
+        "operationId": "Revoke",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restRevokeResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restRevokeRequest"
+            }
+          }
+        ],
+        "tags": [
+          "TokenService"
+        ]
+      }
+    },
+    "/changes/{SeqID}": {
+      "post": {
+        "summary": "Get Changes",
+        "operationId": "GetChanges",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restChangeCollection"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "SeqID",
+            "in": "path",
+            "required": true,
+            "type": "string",
+            "format": "int64"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restChangeRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ChangeService"
+        ]
+      }
+    },
+    "/chat/message": {
+      "put": {
+        "summary": "Post a new message in a room, or a reply to a thread if ParentUuid is set",
+        "operationId": "PostChatMessage",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/chatChatMessage"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/chatChatMessage"
+            }
+          }
+        ],
+        "tags": [
+          "ChatService"
+        ]
+      }
+    },
+    "/chat/message/{MessageUuid}/reaction": {
+      "post": {
+        "summary": "Add or remove a reaction of the current user on a message",
+        "operationId": "ReactToChatMessage",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/chatReactToMessageResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "MessageUuid",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/chatReactToMessageRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ChatService"
+        ]
+      }
+    },
+    "/chat/message/{RoomUuid}/{Uuid}": {
+      "delete": {
+        "summary": "Delete a message posted by the current user",
+        "operationId": "DeleteChatMessage",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/chatDeleteMessageResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "RoomUuid",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "Uuid",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ChatService"
+        ]
+      }
+    },
+    "/chat/message/{Uuid}": {
+      "post": {
+        "summary": "Edit a message posted by the current user. Previous version is kept in the message history",
+        "operationId": "UpdateChatMessage",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/chatChatMessage"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "Uuid",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/chatChatMessage"
+            }
+          }
+        ],
+        "tags": [
+          "ChatService"
+        ]
+      }
+    },
+    "/chat/messages": {
+      "post": {
+        "summary": "List messages of a room, paginated from the most recent ones, or replies of a given thread",
+        "operationId": "ListChatMessages",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restChatMessagesCollection"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/chatListMessagesRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ChatService"
+        ]
+      }
+    },
+    "/chat/room/{RoomUuid}/read": {
+      "post": {
+        "summary": "Mark messages of a room as read by the current user",
+        "operationId": "MarkChatRoomRead",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/chatMarkRoomReadResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "RoomUuid",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/chatMarkRoomReadRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ChatService"
+        ]
+      }
+    },
+    "/chat/room/{Uuid}/export": {
+      "post": {
+        "summary": "Export the history of a workspace or node room as a Markdown document stored in the room folder",
+        "operationId": "ExportChatRoom",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/treeNode"
             }
           }
         },
         "parameters": [
           {
-            "name": "UserLogin",
+            "name": "Uuid",
             "in": "path",
             "required": true,
             "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/chatChatRoom"
+            }
           }
         ],
         "tags": [
-          "TokenService"
+          "ChatService"
         ]
       }
     },
-    "/auth/token/revoke": {
+    "/chat/search": {
       "post": {
-        "summary": "Revoke a JWT token",
-        "operationId": "Revoke",
+        "summary": "Full-text search in messages of the rooms accessible to the current user",
+        "operationId": "SearchChatMessages",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/restRevokeResponse"
+              "$ref": "#/definitions/chatSearchMessagesResponse"
             }
           }
         },
@@ -277,46 +709,39 @@ var SwaggerJson = `{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/restRevokeRequest"
+              "$ref": "#/definitions/chatSearchMessagesRequest"
             }
           }
         ],
         "tags": [
-          "TokenService"
+          "ChatService"
         ]
       }
     },
-    "/changes/{SeqID}": {
+    "/chat/unread": {
       "post": {
-        "summary": "Get Changes",
-        "operationId": "GetChanges",
+        "summary": "Count unread messages of the current user for a list of rooms",
+        "operationId": "CountChatUnread",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/restChangeCollection"
+              "$ref": "#/definitions/chatCountUnreadResponse"
             }
           }
         },
         "parameters": [
-          {
-            "name": "SeqID",
-            "in": "path",
-            "required": true,
-            "type": "string",
-            "format": "int64"
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/restChangeRequest"
+              "$ref": "#/definitions/chatCountUnreadRequest"
             }
           }
         ],
         "tags": [
-          "ChangeService"
+          "ChatService"
         ]
       }
     },
@@ -1732,6 +2157,237 @@ var SwaggerJson = `{
         ]
       }
     },
+    "/mfa": {
+      "get": {
+        "summary": "Get the second factor status of the current user",
+        "operationId": "GetMfaStatus",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restMfaStatusResponse"
+            }
+          }
+        },
+        "tags": [
+          "MfaService"
+        ]
+      }
+    },
+    "/mfa/recovery": {
+      "post": {
+        "summary": "Replace the recovery codes",
+        "operationId": "RegenerateRecoveryCodes",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restMfaRecoveryCodesResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restMfaCodeRequest"
+            }
+          }
+        ],
+        "tags": [
+          "MfaService"
+        ]
+      }
+    },
+    "/mfa/totp/confirm": {
+      "post": {
+        "summary": "Validate a first code to enable TOTP, returns recovery codes",
+        "operationId": "ConfirmTotp",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restMfaRecoveryCodesResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restMfaCodeRequest"
+            }
+          }
+        ],
+        "tags": [
+          "MfaService"
+        ]
+      }
+    },
+    "/mfa/totp/disable": {
+      "post": {
+        "summary": "Disable TOTP, unless it is enforced by one of the user roles",
+        "operationId": "DisableTotp",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restMfaStatusResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restMfaCodeRequest"
+            }
+          }
+        ],
+        "tags": [
+          "MfaService"
+        ]
+      }
+    },
+    "/mfa/totp/enroll": {
+      "post": {
+        "summary": "Generate a new TOTP secret, it is enabled once confirmed with a valid code",
+        "operationId": "EnrollTotp",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restTotpEnrollResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restTotpEnrollRequest"
+            }
+          }
+        ],
+        "tags": [
+          "MfaService"
+        ]
+      }
+    },
+    "/mfa/webauthn/login/begin": {
+      "post": {
+        "summary": "Get the options for logging in with a security key, the assertion is then passed along the login request.\nThis one is publicly accessible.",
+        "operationId": "BeginWebauthnLogin",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restWebauthnOptionsResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restWebauthnBeginRequest"
+            }
+          }
+        ],
+        "tags": [
+          "MfaService"
+        ]
+      }
+    },
+    "/mfa/webauthn/register/begin": {
+      "post": {
+        "summary": "Get the options for registering a new security key",
+        "operationId": "BeginWebauthnRegistration",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restWebauthnOptionsResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restWebauthnBeginRequest"
+            }
+          }
+        ],
+        "tags": [
+          "MfaService"
+        ]
+      }
+    },
+    "/mfa/webauthn/register/finish": {
+      "post": {
+        "summary": "Register a new security key, returns recovery codes if it is the first second factor",
+        "operationId": "FinishWebauthnRegistration",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restMfaRecoveryCodesResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restWebauthnRegisterRequest"
+            }
+          }
+        ],
+        "tags": [
+          "MfaService"
+        ]
+      }
+    },
+    "/mfa/webauthn/{Id}": {
+      "delete": {
+        "summary": "Remove a security key, unless it is the last second factor and one of the user roles enforces it",
+        "operationId": "DeleteWebauthnCredential",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restMfaStatusResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "Id",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "MfaService"
+        ]
+      }
+    },
     "/policy": {
       "post": {
         "summary": "List all defined security policies",
@@ -1746,16 +2402,76 @@ var SwaggerJson = `{
         },
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/idmListPolicyGroupsRequest"
+            }
+          }
+        ],
+        "tags": [
+          "PolicyService"
+        ]
+      }
+    },
+    "/policy/explain": {
+      "post": {
+        "summary": "Explain how access to a REST resource or a node is decided for a user",
+        "operationId": "ExplainPolicy",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restPolicyExplanation"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restPolicyExplainRequest"
+            }
+          }
+        ],
+        "tags": [
+          "PolicyService"
+        ]
+      }
+    },
+    "/presence/{NodeUuid}": {
+      "get": {
+        "summary": "List sessions currently viewing or editing a node, and optionally its direct children",
+        "operationId": "ListNodeViewers",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/presenceListViewersResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "NodeUuid",
+            "in": "path",
             "required": true,
-            "schema": {
-              "$ref": "#/definitions/idmListPolicyGroupsRequest"
-            }
+            "type": "string"
+          },
+          {
+            "name": "Children",
+            "description": "Also list viewers of the direct children of this node.",
+            "in": "query",
+            "required": false,
+            "type": "boolean",
+            "format": "boolean"
           }
         ],
         "tags": [
-          "PolicyService"
+          "PresenceService"
         ]
       }
     },
@@ -1949,6 +2665,66 @@ var SwaggerJson = `{
         ]
       }
     },
+    "/share/approvals": {
+      "post": {
+        "summary": "List approval requests: all of them for approvers, their own ones for other users",
+        "operationId": "ListShareApprovals",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restListShareApprovalsResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restListShareApprovalsRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ShareService"
+        ]
+      }
+    },
+    "/share/approvals/{Uuid}/decide": {
+      "post": {
+        "summary": "Approve or reject a pending link or Cell, only allowed to approvers",
+        "operationId": "DecideShareApproval",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restShareApproval"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "Uuid",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restDecideShareApprovalRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ShareService"
+        ]
+      }
+    },
     "/share/cell": {
       "put": {
         "summary": "Put or Create a share room",
@@ -2024,6 +2800,66 @@ var SwaggerJson = `{
         ]
       }
     },
+    "/share/drops": {
+      "post": {
+        "summary": "Start a drop session on a file request link, creating the uploader folder",
+        "operationId": "CreateFileRequestDrop",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restFileRequestDrop"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restCreateFileRequestDropRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ShareService"
+        ]
+      }
+    },
+    "/share/drops/{Uuid}/complete": {
+      "post": {
+        "summary": "Close a drop session once all files are uploaded, notifying the link owner",
+        "operationId": "CompleteFileRequestDrop",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restFileRequestDrop"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "Uuid",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restCompleteFileRequestDropRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ShareService"
+        ]
+      }
+    },
     "/share/link": {
       "put": {
         "summary": "Put or Create a share room",
@@ -2032,18 +2868,178 @@ var SwaggerJson = `{
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/restShareLink"
+              "$ref": "#/definitions/restShareLink"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restPutShareLinkRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ShareService"
+        ]
+      }
+    },
+    "/share/link/{LinkUuid}/access": {
+      "post": {
+        "summary": "List accesses to a share link with per-recipient reports, or export them as CSV",
+        "operationId": "ListShareLinkAccess",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restListShareLinkAccessResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "LinkUuid",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restListShareLinkAccessRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ShareService"
+        ]
+      }
+    },
+    "/share/link/{Uuid}": {
+      "get": {
+        "summary": "Load a share link with all infos",
+        "operationId": "GetShareLink",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restShareLink"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "Uuid",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ShareService"
+        ]
+      },
+      "delete": {
+        "summary": "Delete Share Link",
+        "operationId": "DeleteShareLink",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restDeleteShareLinkResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "Uuid",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ShareService"
+        ]
+      }
+    },
+    "/share/ocm": {
+      "put": {
+        "summary": "Share a folder with a user of another server using Open Cloud Mesh",
+        "operationId": "PutOcmShare",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restOcmShare"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restPutOcmShareRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ShareService"
+        ]
+      }
+    },
+    "/share/ocm/list": {
+      "post": {
+        "summary": "List federated shares sent or received by the current user",
+        "operationId": "ListOcmShares",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restListOcmSharesResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restListOcmSharesRequest"
+            }
+          }
+        ],
+        "tags": [
+          "ShareService"
+        ]
+      }
+    },
+    "/share/ocm/{Uuid}": {
+      "delete": {
+        "summary": "Delete a federated share, notifying the remote server",
+        "operationId": "DeleteOcmShare",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restDeleteOcmShareResponse"
             }
           }
         },
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
+            "name": "Uuid",
+            "in": "path",
             "required": true,
-            "schema": {
-              "$ref": "#/definitions/restPutShareLinkRequest"
-            }
+            "type": "string"
           }
         ],
         "tags": [
@@ -2051,15 +3047,15 @@ var SwaggerJson = `{
         ]
       }
     },
-    "/share/link/{Uuid}": {
-      "get": {
-        "summary": "Load a share link with all infos",
-        "operationId": "GetShareLink",
+    "/share/ocm/{Uuid}/accept": {
+      "post": {
+        "summary": "Accept or decline a federated share received from another server",
+        "operationId": "AcceptOcmShare",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/restShareLink"
+              "$ref": "#/definitions/restOcmShare"
             }
           }
         },
@@ -2069,20 +3065,30 @@ var SwaggerJson = `{
             "in": "path",
             "required": true,
             "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restAcceptOcmShareRequest"
+            }
           }
         ],
         "tags": [
           "ShareService"
         ]
-      },
-      "delete": {
-        "summary": "Delete Share Link",
-        "operationId": "DeleteShareLink",
+      }
+    },
+    "/share/ocm/{Uuid}/nodes": {
+      "post": {
+        "summary": "Browse the content of an accepted incoming federated share",
+        "operationId": "ListOcmShareNodes",
         "responses": {
           "200": {
             "description": "",
             "schema": {
-              "$ref": "#/definitions/restDeleteShareLinkResponse"
+              "$ref": "#/definitions/restNodesCollection"
             }
           }
         },
@@ -2092,6 +3098,14 @@ var SwaggerJson = `{
             "in": "path",
             "required": true,
             "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restListOcmShareNodesRequest"
+            }
           }
         ],
         "tags": [
@@ -2524,6 +3538,87 @@ var SwaggerJson = `{
         ]
       }
     },
+    "/user-meta/bulk": {
+      "post": {
+        "summary": "Apply a metadata change to many nodes in a background task",
+        "operationId": "BulkUpdateUserMeta",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restUserMetaBulkResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restBulkUpdateUserMetaRequest"
+            }
+          }
+        ],
+        "tags": [
+          "UserMetaService"
+        ]
+      }
+    },
+    "/user-meta/bulk/export": {
+      "post": {
+        "summary": "Export metadata of a set of nodes",
+        "operationId": "ExportUserMeta",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restUserMetaBulkResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restExportUserMetaRequest"
+            }
+          }
+        ],
+        "tags": [
+          "UserMetaService"
+        ]
+      }
+    },
+    "/user-meta/bulk/import": {
+      "post": {
+        "summary": "Import metadata from a CSV file in a background task",
+        "operationId": "ImportUserMeta",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restUserMetaBulkResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restImportUserMetaRequest"
+            }
+          }
+        ],
+        "tags": [
+          "UserMetaService"
+        ]
+      }
+    },
     "/user-meta/namespace": {
       "get": {
         "summary": "List defined meta namespaces",
@@ -2740,6 +3835,33 @@ var SwaggerJson = `{
         ]
       }
     },
+    "/user/rule-preview": {
+      "post": {
+        "summary": "List the users matching a dynamic group rule",
+        "operationId": "PreviewGroupRule",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restUsersCollection"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restGroupRulePreviewRequest"
+            }
+          }
+        ],
+        "tags": [
+          "UserService"
+        ]
+      }
+    },
     "/user/{Login}": {
       "get": {
         "summary": "Get a user by login",
@@ -2891,6 +4013,145 @@ var SwaggerJson = `{
         ]
       }
     },
+    "/workspace/templates": {
+      "get": {
+        "summary": "List workspace templates",
+        "operationId": "ListWorkspaceTemplates",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restWorkspaceTemplateCollection"
+            }
+          }
+        },
+        "tags": [
+          "WorkspaceService"
+        ]
+      }
+    },
+    "/workspace/templates/{TemplateUuid}/instances": {
+      "post": {
+        "summary": "Create or update the workspaces of a template for a list of users or groups",
+        "operationId": "InstantiateWorkspaceTemplate",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restWorkspaceTemplateResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "TemplateUuid",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restInstantiateWorkspaceTemplateRequest"
+            }
+          }
+        ],
+        "tags": [
+          "WorkspaceService"
+        ]
+      }
+    },
+    "/workspace/templates/{TemplateUuid}/sync": {
+      "post": {
+        "summary": "Re-synchronize all the workspaces of a template",
+        "operationId": "SyncWorkspaceTemplate",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restWorkspaceTemplateResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "TemplateUuid",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restSyncWorkspaceTemplateRequest"
+            }
+          }
+        ],
+        "tags": [
+          "WorkspaceService"
+        ]
+      }
+    },
+    "/workspace/templates/{Uuid}": {
+      "delete": {
+        "summary": "Delete a workspace template, its instances are kept as regular workspaces",
+        "operationId": "DeleteWorkspaceTemplate",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restDeleteResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "Uuid",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "WorkspaceService"
+        ]
+      },
+      "put": {
+        "summary": "Create or update a workspace template, its existing instances are re-synchronized",
+        "operationId": "PutWorkspaceTemplate",
+        "responses": {
+          "200": {
+            "description": "",
+            "schema": {
+              "$ref": "#/definitions/restWorkspaceTemplateResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "Uuid",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restWorkspaceTemplate"
+            }
+          }
+        ],
+        "tags": [
+          "WorkspaceService"
+        ]
+      }
+    },
     "/workspace/{Slug}": {
       "delete": {
         "summary": "Delete an existing workspace",
@@ -3008,6 +4269,14 @@ var SwaggerJson = `{
       ],
       "default": "Draft"
     },
+    "PolicyExplainRequestResourceType": {
+      "type": "string",
+      "enum": [
+        "REST",
+        "NODE"
+      ],
+      "default": "REST"
+    },
     "ResourcePolicyQueryQueryType": {
       "type": "string",
       "enum": [
@@ -3016,7 +4285,24 @@ var SwaggerJson = `{
         "NONE",
         "USER"
       ],
-      "default": "CONTEXT"
+      "default": "CONTEXT"
+    },
+    "ShareApprovalApprovalShareType": {
+      "type": "string",
+      "enum": [
+        "LINK",
+        "CELL"
+      ],
+      "default": "LINK"
+    },
+    "ShareApprovalApprovalStatus": {
+      "type": "string",
+      "enum": [
+        "PENDING",
+        "APPROVED",
+        "REJECTED"
+      ],
+      "default": "PENDING"
     },
     "UpdateUserMetaNamespaceRequestUserMetaNsOp": {
       "type": "string",
@@ -3320,22 +4606,367 @@ var SwaggerJson = `{
       ],
       "default": "NODE"
     },
-    "activitySearchSubscriptionsRequest": {
+    "activitySearchSubscriptionsRequest": {
+      "type": "object",
+      "properties": {
+        "UserIds": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "ObjectTypes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/activityOwnerType"
+          }
+        },
+        "ObjectIds": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "activityStreamActivitiesRequest": {
+      "type": "object",
+      "properties": {
+        "Context": {
+          "$ref": "#/definitions/activityStreamContext"
+        },
+        "ContextData": {
+          "type": "string"
+        },
+        "StreamFilter": {
+          "type": "string"
+        },
+        "BoxName": {
+          "type": "string"
+        },
+        "UnreadCountOnly": {
+          "type": "boolean",
+          "format": "boolean"
+        },
+        "Offset": {
+          "type": "string",
+          "format": "int64"
+        },
+        "Limit": {
+          "type": "string",
+          "format": "int64"
+        },
+        "AsDigest": {
+          "type": "boolean",
+          "format": "boolean"
+        },
+        "PointOfView": {
+          "$ref": "#/definitions/activitySummaryPointOfView"
+        },
+        "Language": {
+          "type": "string"
+        }
+      }
+    },
+    "activityStreamContext": {
+      "type": "string",
+      "enum": [
+        "MYFEED",
+        "USER_ID",
+        "NODE_ID"
+      ],
+      "default": "MYFEED"
+    },
+    "activitySubscription": {
+      "type": "object",
+      "properties": {
+        "UserId": {
+          "type": "string"
+        },
+        "ObjectType": {
+          "$ref": "#/definitions/activityOwnerType"
+        },
+        "ObjectId": {
+          "type": "string"
+        },
+        "Events": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "activitySummaryPointOfView": {
+      "type": "string",
+      "enum": [
+        "GENERIC",
+        "ACTOR",
+        "SUBJECT"
+      ],
+      "default": "GENERIC"
+    },
+    "authPersonalAccessToken": {
+      "type": "object",
+      "properties": {
+        "Uuid": {
+          "type": "string"
+        },
+        "Label": {
+          "type": "string"
+        },
+        "UserUuid": {
+          "type": "string"
+        },
+        "UserLogin": {
+          "type": "string"
+        },
+        "CreatedAt": {
+          "type": "string",
+          "format": "int64"
+        },
+        "ExpiresAt": {
+          "type": "string",
+          "format": "int64"
+        },
+        "LastUsedAt": {
+          "type": "string",
+          "format": "int64"
+        },
+        "Workspaces": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "Restrict the token to these workspaces, given by UUID or slug"
+        },
+        "ReadOnly": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "Only grant read access to the workspaces and read requests on the REST API"
+        },
+        "RestResources": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "Restrict the token to these REST resources, like /tree/stats or /meta/*"
+        }
+      }
+    },
+    "authSession": {
+      "type": "object",
+      "properties": {
+        "Uuid": {
+          "type": "string"
+        },
+        "UserUuid": {
+          "type": "string"
+        },
+        "UserLogin": {
+          "type": "string"
+        },
+        "ClientId": {
+          "type": "string"
+        },
+        "Nonce": {
+          "type": "string",
+          "title": "Nonce passed at login, shared by the refreshed tokens"
+        },
+        "RemoteAddress": {
+          "type": "string"
+        },
+        "UserAgent": {
+          "type": "string"
+        },
+        "ClientType": {
+          "type": "string",
+          "title": "One of web, sync, dav or api"
+        },
+        "Device": {
+          "type": "string",
+          "title": "Human readable description of the client"
+        },
+        "CreatedAt": {
+          "type": "string",
+          "format": "int64"
+        },
+        "LastActivity": {
+          "type": "string",
+          "format": "int64"
+        },
+        "ExpiresAt": {
+          "type": "string",
+          "format": "int64",
+          "title": "Expiration of the last issued IdToken"
+        },
+        "IssuedAt": {
+          "type": "string",
+          "format": "int64",
+          "title": "Issuance of the last IdToken"
+        },
+        "HasRefreshToken": {
+          "type": "boolean",
+          "format": "boolean"
+        },
+        "RevokedAt": {
+          "type": "string",
+          "format": "int64",
+          "title": "IdTokens issued before this date are rejected"
+        }
+      },
+      "title": "Session groups the tokens issued by a login and their refreshes"
+    },
+    "chatChatAttachment": {
+      "type": "object",
+      "properties": {
+        "NodeUuid": {
+          "type": "string"
+        },
+        "Path": {
+          "type": "string"
+        },
+        "Label": {
+          "type": "string"
+        },
+        "MimeType": {
+          "type": "string"
+        },
+        "Size": {
+          "type": "string",
+          "format": "int64"
+        },
+        "IsLeaf": {
+          "type": "boolean",
+          "format": "boolean"
+        }
+      }
+    },
+    "chatChatMessage": {
+      "type": "object",
+      "properties": {
+        "Uuid": {
+          "type": "string"
+        },
+        "RoomUuid": {
+          "type": "string"
+        },
+        "Message": {
+          "type": "string"
+        },
+        "Author": {
+          "type": "string"
+        },
+        "Timestamp": {
+          "type": "string",
+          "format": "int64"
+        },
+        "Activity": {
+          "$ref": "#/definitions/activityObject"
+        },
+        "ParentUuid": {
+          "type": "string",
+          "title": "Uuid of the parent message if this message is a reply in a thread"
+        },
+        "Mentions": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "Logins of users mentioned with @login in the message"
+        },
+        "Attachments": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/chatChatAttachment"
+          },
+          "title": "References to nodes attached to this message"
+        },
+        "Reactions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/chatChatReaction"
+          }
+        },
+        "Edited": {
+          "type": "string",
+          "format": "int64",
+          "title": "Timestamp of the last edition, 0 if never edited"
+        },
+        "History": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/chatChatMessageRevision"
+          },
+          "title": "Previous versions of the message"
+        },
+        "RepliesCount": {
+          "type": "integer",
+          "format": "int32",
+          "title": "Number of replies if this message is a thread root"
+        }
+      }
+    },
+    "chatChatMessageRevision": {
+      "type": "object",
+      "properties": {
+        "Message": {
+          "type": "string"
+        },
+        "Timestamp": {
+          "type": "string",
+          "format": "int64"
+        }
+      }
+    },
+    "chatChatReaction": {
       "type": "object",
       "properties": {
-        "UserIds": {
+        "Emoji": {
+          "type": "string"
+        },
+        "Users": {
           "type": "array",
           "items": {
             "type": "string"
           }
+        }
+      }
+    },
+    "chatChatRoom": {
+      "type": "object",
+      "properties": {
+        "Uuid": {
+          "type": "string"
         },
-        "ObjectTypes": {
+        "Type": {
+          "$ref": "#/definitions/chatRoomType"
+        },
+        "RoomTypeObject": {
+          "type": "string"
+        },
+        "RoomLabel": {
+          "type": "string"
+        },
+        "Users": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/activityOwnerType"
+            "type": "string"
           }
         },
-        "ObjectIds": {
+        "LastUpdated": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "chatCountUnreadRequest": {
+      "type": "object",
+      "properties": {
+        "User": {
+          "type": "string"
+        },
+        "RoomUuids": {
           "type": "array",
           "items": {
             "type": "string"
@@ -3343,24 +4974,36 @@ var SwaggerJson = `{
         }
       }
     },
-    "activityStreamActivitiesRequest": {
+    "chatCountUnreadResponse": {
       "type": "object",
       "properties": {
-        "Context": {
-          "$ref": "#/definitions/activityStreamContext"
-        },
-        "ContextData": {
-          "type": "string"
-        },
-        "StreamFilter": {
-          "type": "string"
-        },
-        "BoxName": {
-          "type": "string"
-        },
-        "UnreadCountOnly": {
+        "Counts": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "integer",
+            "format": "int32"
+          }
+        }
+      }
+    },
+    "chatDeleteMessageResponse": {
+      "type": "object",
+      "properties": {
+        "Success": {
           "type": "boolean",
           "format": "boolean"
+        }
+      }
+    },
+    "chatListMessagesRequest": {
+      "type": "object",
+      "properties": {
+        "RoomUuid": {
+          "type": "string"
+        },
+        "LastMessage": {
+          "type": "string",
+          "title": "List starting at a given message ID"
         },
         "Offset": {
           "type": "string",
@@ -3370,56 +5013,115 @@ var SwaggerJson = `{
           "type": "string",
           "format": "int64"
         },
-        "AsDigest": {
+        "ParentUuid": {
+          "type": "string",
+          "title": "List replies of a given thread. If empty, only top-level messages are listed"
+        }
+      }
+    },
+    "chatMarkRoomReadRequest": {
+      "type": "object",
+      "properties": {
+        "RoomUuid": {
+          "type": "string"
+        },
+        "User": {
+          "type": "string"
+        },
+        "LastMessage": {
+          "type": "string",
+          "title": "Last message read by the user. If empty, the whole room is marked as read"
+        }
+      }
+    },
+    "chatMarkRoomReadResponse": {
+      "type": "object",
+      "properties": {
+        "Success": {
           "type": "boolean",
           "format": "boolean"
+        }
+      }
+    },
+    "chatReactToMessageRequest": {
+      "type": "object",
+      "properties": {
+        "RoomUuid": {
+          "type": "string"
         },
-        "PointOfView": {
-          "$ref": "#/definitions/activitySummaryPointOfView"
+        "MessageUuid": {
+          "type": "string"
         },
-        "Language": {
+        "Emoji": {
+          "type": "string"
+        },
+        "User": {
           "type": "string"
+        },
+        "Remove": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "Remove the reaction instead of adding it"
         }
       }
     },
-    "activityStreamContext": {
+    "chatReactToMessageResponse": {
+      "type": "object",
+      "properties": {
+        "Message": {
+          "$ref": "#/definitions/chatChatMessage"
+        }
+      }
+    },
+    "chatRoomType": {
       "type": "string",
       "enum": [
-        "MYFEED",
-        "USER_ID",
-        "NODE_ID"
+        "GLOBAL",
+        "WORKSPACE",
+        "USER",
+        "NODE"
       ],
-      "default": "MYFEED"
+      "default": "GLOBAL"
     },
-    "activitySubscription": {
+    "chatSearchMessagesRequest": {
       "type": "object",
       "properties": {
-        "UserId": {
-          "type": "string"
+        "Query": {
+          "type": "string",
+          "title": "Full-text query, using the bleve query string syntax"
         },
-        "ObjectType": {
-          "$ref": "#/definitions/activityOwnerType"
+        "RoomUuids": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "Restrict search to these rooms. Callers are responsible for checking access to them"
         },
-        "ObjectId": {
-          "type": "string"
+        "Offset": {
+          "type": "integer",
+          "format": "int32"
         },
-        "Events": {
+        "Limit": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "chatSearchMessagesResponse": {
+      "type": "object",
+      "properties": {
+        "Messages": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/chatChatMessage"
           }
+        },
+        "Total": {
+          "type": "integer",
+          "format": "int32"
         }
       }
     },
-    "activitySummaryPointOfView": {
-      "type": "string",
-      "enum": [
-        "GENERIC",
-        "ACTOR",
-        "SUBJECT"
-      ],
-      "default": "GENERIC"
-    },
     "ctlPeer": {
       "type": "object",
       "properties": {
@@ -3789,6 +5491,22 @@ var SwaggerJson = `{
         }
       }
     },
+    "idmPolicyConditionTrace": {
+      "type": "object",
+      "properties": {
+        "Key": {
+          "type": "string"
+        },
+        "Type": {
+          "type": "string"
+        },
+        "Fulfilled": {
+          "type": "boolean",
+          "format": "boolean"
+        }
+      },
+      "title": "Evaluation of a policy condition against the request context"
+    },
     "idmPolicyEffect": {
       "type": "string",
       "enum": [
@@ -3837,6 +5555,34 @@ var SwaggerJson = `{
       ],
       "default": "rest"
     },
+    "idmPolicyTrace": {
+      "type": "object",
+      "properties": {
+        "Subject": {
+          "type": "string"
+        },
+        "Policy": {
+          "$ref": "#/definitions/idmPolicy"
+        },
+        "Conditions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/idmPolicyConditionTrace"
+          }
+        },
+        "Matched": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "True if all conditions are fulfilled"
+        },
+        "Decisive": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "True if this policy decided of the response"
+        }
+      },
+      "title": "Policy whose actions, subjects and resources match a request, in evaluation order"
+    },
     "idmRole": {
       "type": "object",
       "properties": {
@@ -5181,6 +6927,51 @@ var SwaggerJson = `{
       "default": "LOCAL",
       "title": "Type of Gateway"
     },
+    "presenceListViewersResponse": {
+      "type": "object",
+      "properties": {
+        "Viewers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/presencePresence"
+          }
+        }
+      }
+    },
+    "presencePresence": {
+      "type": "object",
+      "properties": {
+        "SessionId": {
+          "type": "string",
+          "title": "Unique identifier of the websocket session"
+        },
+        "User": {
+          "type": "string"
+        },
+        "NodeUuid": {
+          "type": "string"
+        },
+        "ParentUuid": {
+          "type": "string",
+          "title": "Uuid of the parent folder, used to notify users browsing the same folder"
+        },
+        "Editing": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "Whether the user is editing the node or only viewing it"
+        },
+        "Cursor": {
+          "type": "string",
+          "title": "Opaque cursor or selection position, forwarded as-is to other viewers"
+        },
+        "Expires": {
+          "type": "string",
+          "format": "int64",
+          "title": "Unix timestamp after which the presence expires if it is not refreshed"
+        }
+      },
+      "title": "Presence of a user on a node, as announced by a websocket session"
+    },
     "protobufAny": {
       "type": "object",
       "properties": {
@@ -5202,15 +6993,56 @@ var SwaggerJson = `{
         "ACLs": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/idmACL"
-          }
-        },
-        "Total": {
-          "type": "integer",
-          "format": "int32"
+            "$ref": "#/definitions/idmACL"
+          }
+        },
+        "Total": {
+          "type": "integer",
+          "format": "int32"
+        }
+      },
+      "title": "Response for search request"
+    },
+    "restAcceptOcmShareRequest": {
+      "type": "object",
+      "properties": {
+        "Uuid": {
+          "type": "string"
+        },
+        "Decline": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "Decline the share instead of accepting it"
+        }
+      }
+    },
+    "restAclTrace": {
+      "type": "object",
+      "properties": {
+        "Acl": {
+          "$ref": "#/definitions/idmACL"
+        },
+        "NodePath": {
+          "type": "string"
+        },
+        "Effective": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "True if the ACL role is the last one defining ACLs on this node"
+        },
+        "Decisive": {
+          "type": "boolean",
+          "format": "boolean"
+        },
+        "Policies": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/idmPolicyTrace"
+          },
+          "title": "Evaluation of the security policy of a policy ACL"
         }
       },
-      "title": "Response for search request"
+      "title": "ACL found on the node or one of its parents, in evaluation order"
     },
     "restBackgroundJobResult": {
       "type": "object",
@@ -5240,6 +7072,38 @@ var SwaggerJson = `{
         }
       }
     },
+    "restBulkUpdateUserMetaRequest": {
+      "type": "object",
+      "properties": {
+        "NodePaths": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "Nodes paths, as seen by the current user"
+        },
+        "Query": {
+          "$ref": "#/definitions/treeQuery",
+          "title": "Or a search query, restricted by its PathPrefix"
+        },
+        "Namespace": {
+          "type": "string"
+        },
+        "JsonValue": {
+          "type": "string"
+        },
+        "Delete": {
+          "type": "boolean",
+          "format": "boolean"
+        },
+        "DryRun": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "Return the list of changes without applying them"
+        }
+      },
+      "title": "Apply the same user metadata change to a set of nodes"
+    },
     "restCell": {
       "type": "object",
       "properties": {
@@ -5273,6 +7137,21 @@ var SwaggerJson = `{
         "PoliciesContextEditable": {
           "type": "boolean",
           "format": "boolean"
+        },
+        "AccessEnd": {
+          "type": "string",
+          "format": "int64",
+          "title": "Expiration date, members lose their access after this date"
+        },
+        "Disabled": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "Set once the Cell has expired, only its owner can still access it"
+        },
+        "PendingApproval": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "External users invited in this Cell are waiting for an approver decision"
         }
       },
       "title": "Model for representing a shared room"
@@ -5340,6 +7219,26 @@ var SwaggerJson = `{
         }
       }
     },
+    "restChatMessagesCollection": {
+      "type": "object",
+      "properties": {
+        "Messages": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/chatChatMessage"
+          }
+        }
+      },
+      "title": "Collection of chat messages"
+    },
+    "restCompleteFileRequestDropRequest": {
+      "type": "object",
+      "properties": {
+        "Uuid": {
+          "type": "string"
+        }
+      }
+    },
     "restConfiguration": {
       "type": "object",
       "properties": {
@@ -5366,6 +7265,20 @@ var SwaggerJson = `{
         }
       }
     },
+    "restCreateFileRequestDropRequest": {
+      "type": "object",
+      "properties": {
+        "LinkUuid": {
+          "type": "string"
+        },
+        "UploaderName": {
+          "type": "string"
+        },
+        "UploaderEmail": {
+          "type": "string"
+        }
+      }
+    },
     "restCreateNodesRequest": {
       "type": "object",
       "properties": {
@@ -5432,6 +7345,21 @@ var SwaggerJson = `{
       },
       "title": "Collection of datasources"
     },
+    "restDecideShareApprovalRequest": {
+      "type": "object",
+      "properties": {
+        "Uuid": {
+          "type": "string"
+        },
+        "Approve": {
+          "type": "boolean",
+          "format": "boolean"
+        },
+        "Comment": {
+          "type": "string"
+        }
+      }
+    },
     "restDeleteCellResponse": {
       "type": "object",
       "properties": {
@@ -5476,6 +7404,15 @@ var SwaggerJson = `{
         }
       }
     },
+    "restDeleteOcmShareResponse": {
+      "type": "object",
+      "properties": {
+        "Success": {
+          "type": "boolean",
+          "format": "boolean"
+        }
+      }
+    },
     "restError": {
       "type": "object",
       "properties": {
@@ -5566,6 +7503,75 @@ var SwaggerJson = `{
         }
       }
     },
+    "restExportUserMetaRequest": {
+      "type": "object",
+      "properties": {
+        "NodePaths": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "Namespaces": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "Limit export to these namespaces"
+        },
+        "Recursive": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "Export children of folders as well"
+        },
+        "Format": {
+          "type": "string",
+          "title": "Use \"csv\" to download a CSV file"
+        }
+      },
+      "title": "Export user metadata of a set of nodes"
+    },
+    "restFileRequestDrop": {
+      "type": "object",
+      "properties": {
+        "Uuid": {
+          "type": "string"
+        },
+        "LinkUuid": {
+          "type": "string"
+        },
+        "UploaderName": {
+          "type": "string"
+        },
+        "UploaderEmail": {
+          "type": "string"
+        },
+        "FolderUuid": {
+          "type": "string",
+          "title": "Folder created for this uploader, its name is relative to the link root"
+        },
+        "FolderName": {
+          "type": "string"
+        },
+        "FolderPath": {
+          "type": "string",
+          "title": "Internal path of the folder, not sent to the uploader"
+        },
+        "CreatedAt": {
+          "type": "string",
+          "format": "int64"
+        },
+        "CompletedAt": {
+          "type": "string",
+          "format": "int64"
+        },
+        "FilesCount": {
+          "type": "integer",
+          "format": "int32"
+        }
+      },
+      "title": "Drop session of one uploader on a file request link"
+    },
     "restFrontBinaryRequest": {
       "type": "object",
       "properties": {
@@ -5716,6 +7722,24 @@ var SwaggerJson = `{
         }
       }
     },
+    "restGroupRulePreviewRequest": {
+      "type": "object",
+      "properties": {
+        "Rule": {
+          "type": "string",
+          "title": "Rule on the users attributes, see the groupRule attribute of groups"
+        },
+        "Limit": {
+          "type": "string",
+          "format": "int64"
+        },
+        "Offset": {
+          "type": "string",
+          "format": "int64"
+        }
+      },
+      "title": "Preview the members of a dynamic group"
+    },
     "restHeadNodeResponse": {
       "type": "object",
       "properties": {
@@ -5724,6 +7748,64 @@ var SwaggerJson = `{
         }
       }
     },
+    "restImportUserMetaRequest": {
+      "type": "object",
+      "properties": {
+        "CsvContent": {
+          "type": "string"
+        },
+        "DryRun": {
+          "type": "boolean",
+          "format": "boolean"
+        }
+      },
+      "title": "Apply user metadata changes from a CSV of path, namespace and value rows"
+    },
+    "restInstantiateWorkspaceTemplateRequest": {
+      "type": "object",
+      "properties": {
+        "TemplateUuid": {
+          "type": "string"
+        },
+        "Targets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/restWorkspaceTemplateTarget"
+          }
+        }
+      }
+    },
+    "restListOcmShareNodesRequest": {
+      "type": "object",
+      "properties": {
+        "Uuid": {
+          "type": "string"
+        },
+        "Path": {
+          "type": "string",
+          "title": "Path relative to the root of the share"
+        }
+      }
+    },
+    "restListOcmSharesRequest": {
+      "type": "object",
+      "properties": {
+        "Direction": {
+          "$ref": "#/definitions/restOcmShareDirection"
+        }
+      }
+    },
+    "restListOcmSharesResponse": {
+      "type": "object",
+      "properties": {
+        "Shares": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/restOcmShare"
+          }
+        }
+      }
+    },
     "restListPeerFoldersRequest": {
       "type": "object",
       "properties": {
@@ -5763,7 +7845,85 @@ var SwaggerJson = `{
         "Processes": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/restProcess"
+            "$ref": "#/definitions/restProcess"
+          }
+        }
+      }
+    },
+    "restListShareApprovalsRequest": {
+      "type": "object",
+      "properties": {
+        "Status": {
+          "$ref": "#/definitions/ShareApprovalApprovalStatus",
+          "title": "Filter requests by status, pending ones by default"
+        },
+        "AllStatuses": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "Ignore the status filter"
+        },
+        "Offset": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "Limit": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "restListShareApprovalsResponse": {
+      "type": "object",
+      "properties": {
+        "Approvals": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/restShareApproval"
+          }
+        },
+        "Total": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "restListShareLinkAccessRequest": {
+      "type": "object",
+      "properties": {
+        "LinkUuid": {
+          "type": "string"
+        },
+        "Format": {
+          "type": "string",
+          "title": "Set to \"csv\" to receive the log as a CSV file"
+        },
+        "Offset": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "Limit": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "restListShareLinkAccessResponse": {
+      "type": "object",
+      "properties": {
+        "Entries": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/restShareLinkAccess"
+          }
+        },
+        "Total": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "Reports": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/restShareLinkRecipientReport"
           }
         }
       }
@@ -5892,6 +8052,52 @@ var SwaggerJson = `{
         }
       }
     },
+    "restMfaCodeRequest": {
+      "type": "object",
+      "properties": {
+        "Code": {
+          "type": "string"
+        }
+      },
+      "title": "Operation requiring a valid second factor code"
+    },
+    "restMfaRecoveryCodesResponse": {
+      "type": "object",
+      "properties": {
+        "RecoveryCodes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      },
+      "title": "Recovery codes are displayed only once"
+    },
+    "restMfaStatusResponse": {
+      "type": "object",
+      "properties": {
+        "TotpEnabled": {
+          "type": "boolean",
+          "format": "boolean"
+        },
+        "Enforced": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "One of the user roles requires a second factor"
+        },
+        "RecoveryCodesLeft": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "Credentials": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/restWebauthnCredential"
+          },
+          "title": "Registered security keys"
+        }
+      }
+    },
     "restNodesCollection": {
       "type": "object",
       "properties": {
@@ -5906,6 +8112,96 @@ var SwaggerJson = `{
         }
       }
     },
+    "restOcmShare": {
+      "type": "object",
+      "properties": {
+        "Uuid": {
+          "type": "string"
+        },
+        "Direction": {
+          "$ref": "#/definitions/restOcmShareDirection"
+        },
+        "Status": {
+          "$ref": "#/definitions/restOcmShareStatus"
+        },
+        "Name": {
+          "type": "string"
+        },
+        "Description": {
+          "type": "string"
+        },
+        "LocalUser": {
+          "type": "string",
+          "title": "Local user who created an outgoing share, or recipient of an incoming share"
+        },
+        "ShareWith": {
+          "type": "string",
+          "title": "Federated address (user@server) of the recipient of an outgoing share"
+        },
+        "Owner": {
+          "type": "string",
+          "title": "Federated address of the owner of an incoming share"
+        },
+        "OwnerDisplayName": {
+          "type": "string"
+        },
+        "ProviderId": {
+          "type": "string",
+          "title": "Identifier of the share on the provider side"
+        },
+        "RemoteUrl": {
+          "type": "string",
+          "title": "Base URL of the remote server"
+        },
+        "Permissions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/restShareLinkAccessType"
+          }
+        },
+        "NodeUuid": {
+          "type": "string",
+          "title": "Shared node and link workspace of an outgoing share"
+        },
+        "WorkspaceUuid": {
+          "type": "string"
+        },
+        "ResourcePath": {
+          "type": "string",
+          "title": "Root path of an incoming share on the remote server"
+        },
+        "SharedSecret": {
+          "type": "string",
+          "title": "Secret giving access to the shared resource, never sent to clients"
+        },
+        "CreatedAt": {
+          "type": "string",
+          "format": "int64"
+        },
+        "HiddenUser": {
+          "type": "string",
+          "title": "Hidden user giving access to an outgoing share, never sent to clients"
+        }
+      },
+      "title": "Folder shared with a user of another server using the Open Cloud Mesh protocol"
+    },
+    "restOcmShareDirection": {
+      "type": "string",
+      "enum": [
+        "OUTGOING",
+        "INCOMING"
+      ],
+      "default": "OUTGOING"
+    },
+    "restOcmShareStatus": {
+      "type": "string",
+      "enum": [
+        "PENDING",
+        "ACCEPTED",
+        "DECLINED"
+      ],
+      "default": "PENDING"
+    },
     "restOpenApiResponse": {
       "type": "object"
     },
@@ -5950,6 +8246,120 @@ var SwaggerJson = `{
       },
       "title": "Generic container for responses sending pagination information"
     },
+    "restPersonalAccessTokenCollection": {
+      "type": "object",
+      "properties": {
+        "Tokens": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/authPersonalAccessToken"
+          }
+        }
+      }
+    },
+    "restPersonalAccessTokenRequest": {
+      "type": "object",
+      "properties": {
+        "Label": {
+          "type": "string"
+        },
+        "ExpiresAt": {
+          "type": "string",
+          "format": "int64",
+          "title": "Expiration date, as a unix timestamp"
+        },
+        "Workspaces": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "Restrict the token to these workspaces, given by UUID or slug"
+        },
+        "ReadOnly": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "Only grant read access"
+        },
+        "RestResources": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "Restrict the token to these REST resources, like /tree/stats or /meta/*"
+        }
+      },
+      "title": "Generate a personal access token for the current user"
+    },
+    "restPersonalAccessTokenResponse": {
+      "type": "object",
+      "properties": {
+        "Token": {
+          "$ref": "#/definitions/authPersonalAccessToken"
+        },
+        "Value": {
+          "type": "string"
+        }
+      },
+      "title": "The generated token. Its value is only returned once"
+    },
+    "restPolicyExplainRequest": {
+      "type": "object",
+      "properties": {
+        "UserLogin": {
+          "type": "string"
+        },
+        "Type": {
+          "$ref": "#/definitions/PolicyExplainRequestResourceType"
+        },
+        "Resource": {
+          "type": "string",
+          "title": "REST path like /workspace, or node path as listed in the admin tree"
+        },
+        "Action": {
+          "type": "string",
+          "title": "HTTP method for REST resources, read or write for nodes"
+        },
+        "Context": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          },
+          "title": "Additional policy context, like RemoteAddress or HttpUserAgent"
+        }
+      }
+    },
+    "restPolicyExplanation": {
+      "type": "object",
+      "properties": {
+        "Allowed": {
+          "type": "boolean",
+          "format": "boolean"
+        },
+        "Decision": {
+          "type": "string",
+          "title": "Human readable summary of the decision"
+        },
+        "Roles": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/idmRole"
+          },
+          "title": "Roles of the user, in order"
+        },
+        "Policies": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/idmPolicyTrace"
+          }
+        },
+        "Acls": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/restAclTrace"
+          }
+        }
+      }
+    },
     "restProcess": {
       "type": "object",
       "properties": {
@@ -5992,6 +8402,27 @@ var SwaggerJson = `{
         }
       }
     },
+    "restPutOcmShareRequest": {
+      "type": "object",
+      "properties": {
+        "Node": {
+          "$ref": "#/definitions/treeNode"
+        },
+        "ShareWith": {
+          "type": "string",
+          "title": "Federated address of the recipient, as user@server"
+        },
+        "Permissions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/restShareLinkAccessType"
+          }
+        },
+        "Description": {
+          "type": "string"
+        }
+      }
+    },
     "restPutShareLinkRequest": {
       "type": "object",
       "properties": {
@@ -6144,6 +8575,20 @@ var SwaggerJson = `{
       },
       "title": "Rest response"
     },
+    "restRevokeSessionsRequest": {
+      "type": "object",
+      "properties": {
+        "UserLogin": {
+          "type": "string",
+          "title": "Admins can revoke the sessions of another user"
+        },
+        "KeepCurrent": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "Do not revoke the session used by the request"
+        }
+      }
+    },
     "restRolesCollection": {
       "type": "object",
       "properties": {
@@ -6319,6 +8764,21 @@ var SwaggerJson = `{
         }
       }
     },
+    "restSessionCollection": {
+      "type": "object",
+      "properties": {
+        "Sessions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/authSession"
+          }
+        },
+        "CurrentSession": {
+          "type": "string",
+          "title": "Uuid of the session used by the request"
+        }
+      }
+    },
     "restSettingsEntry": {
       "type": "object",
       "properties": {
@@ -6398,7 +8858,62 @@ var SwaggerJson = `{
             "$ref": "#/definitions/restSettingsEntry"
           }
         }
-      }
+      }
+    },
+    "restShareApproval": {
+      "type": "object",
+      "properties": {
+        "Uuid": {
+          "type": "string"
+        },
+        "ShareType": {
+          "$ref": "#/definitions/ShareApprovalApprovalShareType"
+        },
+        "ShareUuid": {
+          "type": "string",
+          "title": "Uuid of the link or Cell workspace"
+        },
+        "ShareLabel": {
+          "type": "string"
+        },
+        "RootNodes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/treeNode"
+          }
+        },
+        "RequesterLogin": {
+          "type": "string"
+        },
+        "RequesterUuid": {
+          "type": "string"
+        },
+        "CreatedAt": {
+          "type": "string",
+          "format": "int64"
+        },
+        "ExternalUsers": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "Logins of the external users invited in a Cell"
+        },
+        "Status": {
+          "$ref": "#/definitions/ShareApprovalApprovalStatus"
+        },
+        "Approver": {
+          "type": "string"
+        },
+        "DecidedAt": {
+          "type": "string",
+          "format": "int64"
+        },
+        "Comment": {
+          "type": "string"
+        }
+      },
+      "title": "Request to activate a link or to invite external users in a Cell, waiting for an approver decision"
     },
     "restShareLink": {
       "type": "object",
@@ -6478,10 +8993,72 @@ var SwaggerJson = `{
         "PoliciesContextEditable": {
           "type": "boolean",
           "format": "boolean"
+        },
+        "FileRequest": {
+          "$ref": "#/definitions/restShareLinkFileRequest",
+          "title": "When set, the link is an upload-only file request"
+        },
+        "Disabled": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "Set once the link has expired and its user has been locked"
+        },
+        "PendingApproval": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "The link cannot be opened until an approver accepts it"
         }
       },
       "title": "Model for representing a public link"
     },
+    "restShareLinkAccess": {
+      "type": "object",
+      "properties": {
+        "Uuid": {
+          "type": "string"
+        },
+        "LinkUuid": {
+          "type": "string"
+        },
+        "Time": {
+          "type": "string",
+          "format": "int64"
+        },
+        "Action": {
+          "$ref": "#/definitions/restShareLinkAccessAction"
+        },
+        "Recipient": {
+          "type": "string",
+          "title": "Key of the target user, empty for anonymous visitors"
+        },
+        "RecipientDisplay": {
+          "type": "string"
+        },
+        "RemoteAddress": {
+          "type": "string"
+        },
+        "UserAgent": {
+          "type": "string"
+        },
+        "NodeUuid": {
+          "type": "string"
+        },
+        "NodePath": {
+          "type": "string"
+        }
+      },
+      "title": "One access to a public link, by a target user or an anonymous visitor"
+    },
+    "restShareLinkAccessAction": {
+      "type": "string",
+      "enum": [
+        "OPEN",
+        "PREVIEW",
+        "DOWNLOAD"
+      ],
+      "default": "OPEN",
+      "title": "Direction of a federated share\nKind of access recorded on a public link"
+    },
     "restShareLinkAccessType": {
       "type": "string",
       "enum": [
@@ -6493,6 +9070,59 @@ var SwaggerJson = `{
       "default": "NoAccess",
       "title": "Known values for link permissions"
     },
+    "restShareLinkFileRequest": {
+      "type": "object",
+      "properties": {
+        "MaxFileSize": {
+          "type": "string",
+          "format": "int64",
+          "title": "Maximum size of each uploaded file, zero for no limit"
+        },
+        "AllowedExtensions": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "Allowed files extensions, empty for any"
+        }
+      },
+      "title": "Options of an upload-only link, where each uploader drops files in its own folder"
+    },
+    "restShareLinkRecipientReport": {
+      "type": "object",
+      "properties": {
+        "Recipient": {
+          "type": "string"
+        },
+        "RecipientDisplay": {
+          "type": "string"
+        },
+        "RemoteAddress": {
+          "type": "string"
+        },
+        "Opens": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "Previews": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "Downloads": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "FirstAccess": {
+          "type": "string",
+          "format": "int64"
+        },
+        "LastAccess": {
+          "type": "string",
+          "format": "int64"
+        }
+      },
+      "title": "Accesses of one recipient aggregated, anonymous visitors are grouped by address"
+    },
     "restShareLinkTargetUser": {
       "type": "object",
       "properties": {
@@ -6516,6 +9146,14 @@ var SwaggerJson = `{
         }
       }
     },
+    "restSyncWorkspaceTemplateRequest": {
+      "type": "object",
+      "properties": {
+        "TemplateUuid": {
+          "type": "string"
+        }
+      }
+    },
     "restTemplate": {
       "type": "object",
       "properties": {
@@ -6567,6 +9205,22 @@ var SwaggerJson = `{
       },
       "title": "A template node is representing a file or a folder"
     },
+    "restTotpEnrollRequest": {
+      "type": "object",
+      "title": "Start a TOTP enrollment"
+    },
+    "restTotpEnrollResponse": {
+      "type": "object",
+      "properties": {
+        "Secret": {
+          "type": "string"
+        },
+        "ProvisioningUri": {
+          "type": "string",
+          "title": "otpauth:// uri to be displayed as a QR code"
+        }
+      }
+    },
     "restUpdateSharePoliciesRequest": {
       "type": "object",
       "properties": {
@@ -6633,6 +9287,46 @@ var SwaggerJson = `{
         }
       }
     },
+    "restUserMetaBulkChange": {
+      "type": "object",
+      "properties": {
+        "Path": {
+          "type": "string"
+        },
+        "Namespace": {
+          "type": "string"
+        },
+        "Previous": {
+          "type": "string"
+        },
+        "Value": {
+          "type": "string"
+        },
+        "Error": {
+          "type": "string"
+        }
+      },
+      "title": "Single user metadata change, with its previous value"
+    },
+    "restUserMetaBulkResponse": {
+      "type": "object",
+      "properties": {
+        "JobUuid": {
+          "type": "string",
+          "title": "Background job applying the changes"
+        },
+        "Total": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "Changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/restUserMetaBulkChange"
+          }
+        }
+      }
+    },
     "restUserMetaCollection": {
       "type": "object",
       "properties": {
@@ -6707,6 +9401,63 @@ var SwaggerJson = `{
         }
       }
     },
+    "restWebauthnBeginRequest": {
+      "type": "object",
+      "properties": {
+        "Login": {
+          "type": "string",
+          "title": "Login of the user, only used for logging in"
+        }
+      },
+      "title": "Start a security key registration or login"
+    },
+    "restWebauthnCredential": {
+      "type": "object",
+      "properties": {
+        "Id": {
+          "type": "string"
+        },
+        "Name": {
+          "type": "string"
+        },
+        "Created": {
+          "type": "string",
+          "format": "int64"
+        },
+        "LastUsed": {
+          "type": "string",
+          "format": "int64"
+        }
+      },
+      "title": "Security key registered by a user"
+    },
+    "restWebauthnOptionsResponse": {
+      "type": "object",
+      "properties": {
+        "Options": {
+          "type": "string",
+          "title": "JSON-encoded options to pass to navigator.credentials.create() or navigator.credentials.get()"
+        }
+      }
+    },
+    "restWebauthnRegisterRequest": {
+      "type": "object",
+      "properties": {
+        "Name": {
+          "type": "string"
+        },
+        "Id": {
+          "type": "string"
+        },
+        "ClientDataJSON": {
+          "type": "string"
+        },
+        "AttestationObject": {
+          "type": "string"
+        }
+      },
+      "title": "Result of navigator.credentials.create(), binary values are base64url-encoded"
+    },
     "restWorkspaceCollection": {
       "type": "object",
       "properties": {
@@ -6723,6 +9474,112 @@ var SwaggerJson = `{
       },
       "title": "Rest response for workspace search"
     },
+    "restWorkspaceTemplate": {
+      "type": "object",
+      "properties": {
+        "Uuid": {
+          "type": "string"
+        },
+        "Label": {
+          "type": "string"
+        },
+        "Description": {
+          "type": "string"
+        },
+        "Workspace": {
+          "$ref": "#/definitions/idmWorkspace",
+          "title": "Workspace created for each target"
+        },
+        "RootPathPatterns": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "Paths of the workspace roots, starting with the datasource name. Missing folders are created"
+        },
+        "AclPresets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/restWorkspaceTemplateAcl"
+          }
+        },
+        "UpdatedAt": {
+          "type": "string",
+          "format": "int64"
+        }
+      },
+      "title": "Model used to provision similar workspaces for many users or groups. The workspace Label,\nDescription and Slug, the root paths and the ACL roles may contain variables like {{.Department}}"
+    },
+    "restWorkspaceTemplateAcl": {
+      "type": "object",
+      "properties": {
+        "RoleId": {
+          "type": "string",
+          "title": "Role uuid, {{.Uuid}} being the role of the target user or group"
+        },
+        "Actions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/idmACLAction"
+          }
+        }
+      },
+      "title": "ACL actions given to a role on all the roots of a template instance"
+    },
+    "restWorkspaceTemplateCollection": {
+      "type": "object",
+      "properties": {
+        "Templates": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/restWorkspaceTemplate"
+          }
+        }
+      }
+    },
+    "restWorkspaceTemplateResponse": {
+      "type": "object",
+      "properties": {
+        "Template": {
+          "$ref": "#/definitions/restWorkspaceTemplate"
+        },
+        "Instances": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/idmWorkspace"
+          },
+          "title": "Workspaces created or updated"
+        },
+        "Errors": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "Errors for the targets that could not be processed"
+        }
+      },
+      "title": "Result of an instantiation or a re-synchronization of a template"
+    },
+    "restWorkspaceTemplateTarget": {
+      "type": "object",
+      "properties": {
+        "UserLogin": {
+          "type": "string"
+        },
+        "GroupPath": {
+          "type": "string",
+          "title": "Full path of a group"
+        },
+        "Variables": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          },
+          "title": "Variables overriding the ones read from the target attributes"
+        }
+      },
+      "title": "User or group for which a template is instantiated"
+    },
     "serviceOperationType": {
       "type": "string",
       "enum": [
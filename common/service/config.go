@@ -31,6 +31,12 @@ import (
 	"github.com/pydio/cells/common/service/context"
 )
 
+func init() {
+	servicecontext.TrustedProxiesLoader = func() []string {
+		return config.Get("defaults", "trustedProxies").StringSlice([]string{})
+	}
+}
+
 func newConfigProvider(service micro.Service) error {
 
 	// TODO : WATCH CONFIGS FOR RELOADING ???
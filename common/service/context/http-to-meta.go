@@ -22,6 +22,7 @@ package servicecontext
 
 import (
 	"context"
+	"net"
 	"net/http"
 	"strings"
 	"time"
@@ -40,10 +41,22 @@ const (
 	HttpMetaUserAgent      = "UserAgent"
 	HttpMetaContentType    = "ContentType"
 	HttpMetaCoookiesString = "CookiesString"
+	HttpMetaClientType     = "ClientType"
 	ClientTime             = "ClientTime"
 	ServerTime             = "ServerTime"
 )
 
+const (
+	ClientTypeWeb  = "web"
+	ClientTypeSync = "sync"
+	ClientTypeDav  = "dav"
+	ClientTypeApi  = "api"
+)
+
+// TrustedProxiesLoader returns the addresses or CIDR blocks of the reverse proxies allowed to set the
+// X-Forwarded-For header. It is set by the service package, as this package cannot read configs directly.
+var TrustedProxiesLoader func() []string
+
 // HttpRequestInfoToMetadata extracts as much HTTP metadata as possible and stores it in the context as metadata.
 func HttpRequestInfoToMetadata(ctx context.Context, req *http.Request) context.Context {
 
@@ -64,17 +77,14 @@ func HttpRequestInfoToMetadata(ctx context.Context, req *http.Request) context.C
 	// We currently use server time instead of client time. TODO: Retrieve client time and locale and set it here.
 	meta[ClientTime] = t.Format(layout)
 
-	// We might want to also support new standard "Forwarded" header.
-	if h, ok := req.Header["X-Forwarded-For"]; ok {
-		ips := strings.Split(strings.Join(h, ""), ",")
-		meta[HttpMetaRemoteAddress] = ips[0]
-	} else if req.RemoteAddr != "" {
-		meta[HttpMetaRemoteAddress] = req.RemoteAddr
+	if addr := HttpRemoteAddress(req); addr != "" {
+		meta[HttpMetaRemoteAddress] = addr
 	}
 
 	if h, ok := req.Header["User-Agent"]; ok {
 		meta[HttpMetaUserAgent] = strings.Join(h, "")
 	}
+	meta[HttpMetaClientType] = HttpClientType(req)
 	if h, ok := req.Header["Content-Type"]; ok {
 		meta[HttpMetaContentType] = strings.Join(h, "")
 	}
@@ -108,6 +118,69 @@ func HttpRequestInfoToMetadata(ctx context.Context, req *http.Request) context.C
 	return metadata.NewContext(ctx, meta)
 }
 
+// HttpRemoteAddress returns the address of the client sending the request. The X-Forwarded-For header
+// is set by clients as well, so it is only honored when the request comes from a trusted proxy: the
+// address is then the right-most entry that is not itself a trusted proxy.
+func HttpRemoteAddress(req *http.Request) string {
+	// We might want to also support new standard "Forwarded" header.
+	h, ok := req.Header["X-Forwarded-For"]
+	if !ok || TrustedProxiesLoader == nil {
+		return req.RemoteAddr
+	}
+	trusted := TrustedProxiesLoader()
+	if !isTrustedProxy(req.RemoteAddr, trusted) {
+		return req.RemoteAddr
+	}
+	ips := strings.Split(strings.Join(h, ","), ",")
+	for i := len(ips) - 1; i >= 0; i-- {
+		ip := strings.TrimSpace(ips[i])
+		if ip == "" {
+			continue
+		}
+		if i == 0 || !isTrustedProxy(ip, trusted) {
+			return ip
+		}
+	}
+	return req.RemoteAddr
+}
+
+// isTrustedProxy checks if an address, with or without port, matches one of the trusted IPs or CIDR blocks.
+func isTrustedProxy(addr string, trusted []string) bool {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, t := range trusted {
+		t = strings.TrimSpace(t)
+		if strings.Contains(t, "/") {
+			if _, network, err := net.ParseCIDR(t); err == nil && network.Contains(ip) {
+				return true
+			}
+		} else if ip.Equal(net.ParseIP(t)) {
+			return true
+		}
+	}
+	return false
+}
+
+// HttpClientType guesses the type of client sending the request, from its path and user agent:
+// "sync" for desktop sync clients, "dav" for WebDAV clients, "web" for browsers and "api" otherwise.
+func HttpClientType(req *http.Request) string {
+	ua := strings.ToLower(req.UserAgent())
+	switch {
+	case strings.Contains(ua, "cells-sync") || strings.Contains(ua, "pydiosync") || strings.Contains(ua, "pydio.sync"):
+		return ClientTypeSync
+	case strings.HasPrefix(req.URL.Path, "/dav/") || req.URL.Path == "/dav" || strings.Contains(ua, "webdav") || strings.Contains(ua, "davclnt"):
+		return ClientTypeDav
+	case strings.HasPrefix(ua, "mozilla/"):
+		return ClientTypeWeb
+	}
+	return ClientTypeApi
+}
+
 // HttpMetaExtractorWrapper extracts data from the request and puts it in a context Metadata field.
 func HttpMetaExtractorWrapper(h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
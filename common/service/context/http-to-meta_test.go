@@ -0,0 +1,53 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package servicecontext
+
+import (
+	"net/http"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestHttpRemoteAddress(t *testing.T) {
+
+	Convey("Test X-Forwarded-For is only honored from trusted proxies", t, func() {
+		defer func() { TrustedProxiesLoader = nil }()
+		req, _ := http.NewRequest("GET", "/", nil)
+		req.RemoteAddr = "10.0.0.2:4567"
+		req.Header.Set("X-Forwarded-For", "1.2.3.4, 5.6.7.8")
+
+		So(HttpRemoteAddress(req), ShouldEqual, "10.0.0.2:4567")
+
+		TrustedProxiesLoader = func() []string { return []string{"192.168.0.1"} }
+		So(HttpRemoteAddress(req), ShouldEqual, "10.0.0.2:4567")
+
+		TrustedProxiesLoader = func() []string { return []string{"10.0.0.0/8"} }
+		So(HttpRemoteAddress(req), ShouldEqual, "5.6.7.8")
+
+		TrustedProxiesLoader = func() []string { return []string{"10.0.0.0/8", "5.6.7.8"} }
+		So(HttpRemoteAddress(req), ShouldEqual, "1.2.3.4")
+
+		req.Header.Del("X-Forwarded-For")
+		So(HttpRemoteAddress(req), ShouldEqual, "10.0.0.2:4567")
+	})
+
+}
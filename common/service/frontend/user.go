@@ -264,6 +264,23 @@ func (u *User) LoadWorkspaces(ctx context.Context, accessList *permissions.Acces
 			u.Workspaces[wsId] = ws
 		}
 	}
+	// Workspaces mounted from other servers
+	if views.RemoteWorkspacesLoader != nil {
+		remotes, e := views.RemoteWorkspacesLoader(ctx)
+		if e != nil {
+			log.Logger(ctx).Error("cannot load remote workspaces", zap.Error(e))
+		}
+		for _, remote := range remotes {
+			ws := &Workspace{}
+			ws.Workspace = *remote.Workspace
+			ws.AccessRight = "rw"
+			if remote.ReadOnly {
+				ws.AccessRight = "r"
+			}
+			ws.AccessType = "gateway"
+			u.Workspaces[ws.UUID] = ws
+		}
+	}
 	return nil
 }
 
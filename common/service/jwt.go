@@ -76,10 +76,10 @@ func JWTHttpWrapper(h http.Handler) http.Handler {
 
 			whole := strings.Join(val, "")
 			rawIDToken := strings.TrimPrefix(strings.Trim(whole, ""), "Bearer ")
-			//var claims claim.Claims
+			var claims claim.Claims
 			var err error
 
-			c, _, err = jwtVerifier.Verify(c, rawIDToken)
+			c, claims, err = jwtVerifier.Verify(c, rawIDToken)
 			if err != nil {
 				// This is a wrong JWT go out with error
 				w.WriteHeader(401)
@@ -93,6 +93,13 @@ func JWTHttpWrapper(h http.Handler) http.Handler {
 				return
 			}
 
+			if !auth.RestScopeAllows(claims, r.Method, r.URL.Path) {
+				// Personal access token used outside of its scope
+				w.WriteHeader(403)
+				w.Write([]byte("Forbidden.\n"))
+				return
+			}
+
 		}
 
 		r = r.WithContext(c)
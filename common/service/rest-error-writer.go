@@ -91,6 +91,21 @@ func RestError401(req *restful.Request, resp *restful.Response, err error) {
 	resp.WriteHeaderAndEntity(401, e)
 }
 
+// RestError429 logs the error with context and writes an Error 429 on the response.
+func RestError429(req *restful.Request, resp *restful.Response, err error) {
+	log.Logger(req.Request.Context()).Error("Rest Error 429", zap.Error(err))
+	resp.AddHeader("Content-Type", "application/json")
+	e := &rest.Error{
+		Title:  err.Error(),
+		Detail: err.Error(),
+	}
+	if parsed := errors.Parse(err.Error()); parsed.Status != "" && parsed.Detail != "" {
+		e.Title = parsed.Detail
+		e.Detail = parsed.Status + ": " + parsed.Detail
+	}
+	resp.WriteHeaderAndEntity(429, e)
+}
+
 // RestErrorDetect parses the error and tries to detect the correct code.
 func RestErrorDetect(req *restful.Request, resp *restful.Response, err error, defaultCode ...int32) {
 	emitters := map[int32]restErrorEmitter{
@@ -98,6 +113,7 @@ func RestErrorDetect(req *restful.Request, resp *restful.Response, err error, de
 		404: RestError404,
 		403: RestError403,
 		401: RestError401,
+		429: RestError429,
 	}
 	erCode := errors.Parse(err.Error()).Code
 	if f, ok := emitters[erCode]; ok {
@@ -0,0 +1,292 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package meta
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/micro/go-micro/client"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/tree"
+)
+
+// bulkLoadChunk is the number of nodes loaded at once when reading current values
+const bulkLoadChunk = 100
+
+// BulkChange describes the modification of one user metadata on one node.
+// Values are JSON-encoded, an empty Value stands for a deletion.
+type BulkChange struct {
+	Path      string
+	NodeUuid  string `json:",omitempty"`
+	Namespace string
+	Previous  string `json:",omitempty"`
+	Value     string `json:",omitempty"`
+	Error     string `json:",omitempty"`
+
+	metaUuid string
+}
+
+// Unchanged checks if applying this change would have no effect.
+func (c *BulkChange) Unchanged() bool {
+	return c.Error == "" && c.Previous == c.Value
+}
+
+// BulkChangesForNodes builds the same change for a set of nodes.
+func BulkChangesForNodes(nodes []*tree.Node, namespace string, jsonValue string) (changes []*BulkChange) {
+	for _, n := range nodes {
+		changes = append(changes, &BulkChange{Path: n.Path, NodeUuid: n.Uuid, Namespace: namespace, Value: jsonValue})
+	}
+	return
+}
+
+// ReadBulkCSV parses rows of path, namespace and value. A first row starting with "path" is considered as a header.
+// Values are converted from text to JSON according to the namespaces definitions.
+func ReadBulkCSV(r io.Reader, namespaces map[string]*idm.UserMetaNamespace) ([]*BulkChange, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 3
+	reader.TrimLeadingSpace = true
+	var changes []*BulkChange
+	for line := 1; ; line++ {
+		record, e := reader.Read()
+		if e == io.EOF {
+			break
+		} else if e != nil {
+			return nil, e
+		}
+		if line == 1 && strings.ToLower(record[0]) == "path" {
+			continue
+		}
+		change := &BulkChange{Path: strings.Trim(record[0], "/"), Namespace: record[1]}
+		if ns, ok := namespaces[change.Namespace]; ok {
+			def, _ := ParseNamespaceDefinition(ns)
+			change.Value = def.FromText(csvUnescape(record[2]))
+		} else {
+			change.Error = fmt.Sprintf("unknown namespace %s", change.Namespace)
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// WriteBulkCSV writes changes values as rows of path, namespace and value.
+func WriteBulkCSV(w io.Writer, changes []*BulkChange, namespaces map[string]*idm.UserMetaNamespace) error {
+	writer := csv.NewWriter(w)
+	if e := writer.Write([]string{"path", "namespace", "value"}); e != nil {
+		return e
+	}
+	for _, c := range changes {
+		var def *NamespaceDefinition
+		if ns, ok := namespaces[c.Namespace]; ok {
+			def, _ = ParseNamespaceDefinition(ns)
+		}
+		if e := writer.Write([]string{csvEscape(c.Path), c.Namespace, csvEscape(def.ToText(c.Value))}); e != nil {
+			return e
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// csvEscape prevents spreadsheets from evaluating values as formulas, csvUnescape reverts it.
+func csvEscape(value string) string {
+	if value != "" && strings.ContainsAny(value[:1], "=+-@\t\r") {
+		return "'" + value
+	}
+	return value
+}
+
+func csvUnescape(value string) string {
+	if len(value) > 1 && value[0] == '\'' && strings.ContainsAny(value[1:2], "=+-@\t\r") {
+		return value[1:]
+	}
+	return value
+}
+
+// BulkApplier resolves, checks and applies user metadata changes on many nodes.
+type BulkApplier struct {
+	treeClient tree.NodeProviderClient
+	metaClient idm.UserMetaServiceClient
+	namespaces map[string]*idm.UserMetaNamespace
+}
+
+// NewBulkApplier creates a BulkApplier and loads the current namespaces.
+func NewBulkApplier(ctx context.Context, cl client.Client) (*BulkApplier, error) {
+	b := &BulkApplier{
+		treeClient: tree.NewNodeProviderClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_TREE, cl),
+		metaClient: idm.NewUserMetaServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_USER_META, cl),
+		namespaces: make(map[string]*idm.UserMetaNamespace),
+	}
+	stream, e := b.metaClient.ListUserMetaNamespace(ctx, &idm.ListUserMetaNamespaceRequest{})
+	if e != nil {
+		return nil, e
+	}
+	defer stream.Close()
+	for {
+		resp, er := stream.Recv()
+		if er != nil {
+			break
+		}
+		b.namespaces[resp.UserMetaNamespace.Namespace] = resp.UserMetaNamespace
+	}
+	return b, nil
+}
+
+// Namespaces returns the namespaces known by this applier.
+func (b *BulkApplier) Namespaces() map[string]*idm.UserMetaNamespace {
+	return b.namespaces
+}
+
+// Prepare resolves the nodes of the changes by their path, checks the values format
+// and loads the current values, so that changes can be displayed as a diff.
+func (b *BulkApplier) Prepare(ctx context.Context, changes []*BulkChange) {
+	var valid []*BulkChange
+	for _, c := range changes {
+		if c.Error != "" {
+			continue
+		}
+		ns, ok := b.namespaces[c.Namespace]
+		if !ok {
+			c.Error = fmt.Sprintf("unknown namespace %s", c.Namespace)
+			continue
+		}
+		if def, e := ParseNamespaceDefinition(ns); e != nil {
+			c.Error = e.Error()
+			continue
+		} else if def != nil && c.Value != "" {
+			if _, e := def.Validate(c.Value); e != nil {
+				c.Error = e.Error()
+				continue
+			}
+		} else if def != nil && def.IsRequired() {
+			c.Error = fmt.Sprintf("a value is required for %s", ns.Label)
+			continue
+		}
+		if c.NodeUuid == "" {
+			resp, e := b.treeClient.ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Path: c.Path}})
+			if e != nil {
+				c.Error = "cannot find node"
+				continue
+			}
+			c.NodeUuid = resp.Node.Uuid
+		}
+		valid = append(valid, c)
+	}
+	var uuids []string
+	for _, c := range valid {
+		uuids = append(uuids, c.NodeUuid)
+	}
+	current := b.load(ctx, uuids)
+	for _, c := range valid {
+		if m, ok := current[c.NodeUuid][c.Namespace]; ok {
+			c.Previous = m.JsonValue
+			c.metaUuid = m.Uuid
+		}
+	}
+}
+
+// Export lists the current values of the given namespaces (all if empty) on a set of nodes.
+func (b *BulkApplier) Export(ctx context.Context, nodes []*tree.Node, namespaces ...string) (changes []*BulkChange) {
+	var uuids []string
+	for _, n := range nodes {
+		uuids = append(uuids, n.Uuid)
+	}
+	current := b.load(ctx, uuids)
+	for _, n := range nodes {
+		for name, m := range current[n.Uuid] {
+			if _, ok := b.namespaces[name]; !ok || len(namespaces) > 0 && !stringInSlice(name, namespaces) {
+				continue
+			}
+			changes = append(changes, &BulkChange{Path: n.Path, NodeUuid: n.Uuid, Namespace: name, Value: m.JsonValue})
+		}
+	}
+	return
+}
+
+// Apply performs a single prepared change.
+func (b *BulkApplier) Apply(ctx context.Context, c *BulkChange) error {
+	if c.Error != "" {
+		return errors.New(c.Error)
+	}
+	if c.Unchanged() {
+		return nil
+	}
+	if c.Value == "" {
+		_, e := b.metaClient.UpdateUserMeta(ctx, &idm.UpdateUserMetaRequest{
+			Operation: idm.UpdateUserMetaRequest_DELETE,
+			MetaDatas: []*idm.UserMeta{{Uuid: c.metaUuid, NodeUuid: c.NodeUuid, Namespace: c.Namespace}},
+		})
+		return e
+	}
+	_, e := b.metaClient.UpdateUserMeta(ctx, &idm.UpdateUserMetaRequest{
+		Operation: idm.UpdateUserMetaRequest_PUT,
+		MetaDatas: []*idm.UserMeta{{
+			NodeUuid:  c.NodeUuid,
+			Namespace: c.Namespace,
+			JsonValue: c.Value,
+			Policies:  b.namespaces[c.Namespace].Policies,
+		}},
+	})
+	return e
+}
+
+// load reads the current metadata of a set of nodes, indexed by node then namespace.
+func (b *BulkApplier) load(ctx context.Context, uuids []string) map[string]map[string]*idm.UserMeta {
+	current := make(map[string]map[string]*idm.UserMeta, len(uuids))
+	for i := 0; i < len(uuids); i += bulkLoadChunk {
+		end := i + bulkLoadChunk
+		if end > len(uuids) {
+			end = len(uuids)
+		}
+		stream, e := b.metaClient.SearchUserMeta(ctx, &idm.SearchUserMetaRequest{NodeUuids: uuids[i:end]})
+		if e != nil {
+			continue
+		}
+		for {
+			resp, er := stream.Recv()
+			if er != nil {
+				break
+			}
+			m := resp.UserMeta
+			if _, ok := current[m.NodeUuid]; !ok {
+				current[m.NodeUuid] = make(map[string]*idm.UserMeta)
+			}
+			current[m.NodeUuid][m.Namespace] = m
+		}
+		stream.Close()
+	}
+	return current
+}
+
+func stringInSlice(s string, slice []string) bool {
+	for _, v := range slice {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
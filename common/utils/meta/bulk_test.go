@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package meta
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common/proto/idm"
+)
+
+func TestBulkCSV(t *testing.T) {
+
+	namespaces := map[string]*idm.UserMetaNamespace{
+		"usermeta-text":  {Namespace: "usermeta-text", JsonDefinition: `{"type":"string"}`},
+		"usermeta-count": {Namespace: "usermeta-count", JsonDefinition: `{"type":"integer"}`},
+		"usermeta-raw":   {Namespace: "usermeta-raw", JsonDefinition: `{"type":"json"}`},
+	}
+
+	Convey("Read CSV rows", t, func() {
+		changes, e := ReadBulkCSV(strings.NewReader("path,namespace,value\n/ws/a.txt,usermeta-text,12\nws/a.txt,usermeta-count,12\nws/b.txt,usermeta-unknown,x\nws/c.txt,usermeta-text,'-1\nws/d.txt,usermeta-text,\n"), namespaces)
+		So(e, ShouldBeNil)
+		So(changes, ShouldHaveLength, 5)
+		So(changes[0].Path, ShouldEqual, "ws/a.txt")
+		So(changes[0].Value, ShouldEqual, `"12"`)
+		So(changes[1].Value, ShouldEqual, `12`)
+		So(changes[2].Error, ShouldNotBeEmpty)
+		So(changes[3].Value, ShouldEqual, `"-1"`)
+		So(changes[4].Value, ShouldBeEmpty)
+
+		_, e = ReadBulkCSV(strings.NewReader("ws/a.txt,usermeta-text\n"), namespaces)
+		So(e, ShouldNotBeNil)
+	})
+
+	Convey("Write and read back", t, func() {
+		changes := []*BulkChange{
+			{Path: "ws/a.txt", Namespace: "usermeta-text", Value: `"=SUM(A1)"`},
+			{Path: "ws/a.txt", Namespace: "usermeta-count", Value: `42`},
+			{Path: "ws/b.txt", Namespace: "usermeta-raw", Value: `"12"`},
+		}
+		buffer := &bytes.Buffer{}
+		So(WriteBulkCSV(buffer, changes, namespaces), ShouldBeNil)
+		So(buffer.String(), ShouldContainSubstring, "'=SUM(A1)")
+
+		read, e := ReadBulkCSV(buffer, namespaces)
+		So(e, ShouldBeNil)
+		So(read, ShouldHaveLength, 3)
+		for i, c := range read {
+			So(c.Value, ShouldEqual, changes[i].Value)
+		}
+	})
+
+	Convey("Unchanged values", t, func() {
+		So((&BulkChange{Previous: `"a"`, Value: `"a"`}).Unchanged(), ShouldBeTrue)
+		So((&BulkChange{Previous: `"a"`}).Unchanged(), ShouldBeFalse)
+		So((&BulkChange{Value: `"a"`, Error: "failed"}).Unchanged(), ShouldBeFalse)
+	})
+}
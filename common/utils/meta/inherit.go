@@ -0,0 +1,109 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package meta
+
+import (
+	"encoding/json"
+	"path"
+	"strings"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/tree"
+)
+
+// EventChangedNamespaces is the key of the UPDATE_USER_META events metadata listing the changed namespaces,
+// separated by commas.
+const EventChangedNamespaces = "ChangedNamespaces"
+
+// InheritedOrigin describes the folder from which a metadata value is inherited.
+type InheritedOrigin struct {
+	Uuid string `json:"uuid"`
+	Name string `json:"name,omitempty"`
+}
+
+// InheritableNamespaces lists the namespaces whose definition declares that folder values apply to descendants.
+func InheritableNamespaces(namespaces map[string]*idm.UserMetaNamespace) map[string]struct{} {
+	inheritable := make(map[string]struct{})
+	for name, ns := range namespaces {
+		if def, e := ParseNamespaceDefinition(ns); e == nil && def != nil && def.Inherit {
+			inheritable[name] = struct{}{}
+		}
+	}
+	return inheritable
+}
+
+// ChangesInheritable checks if an UPDATE_USER_META event changed one of the inheritable namespaces. Events that
+// do not list their changed namespaces may have changed any of them.
+func ChangesInheritable(event *tree.NodeChangeEvent, inheritable map[string]struct{}) bool {
+	if len(inheritable) == 0 {
+		return false
+	}
+	changed, ok := event.Metadata[EventChangedNamespaces]
+	if !ok {
+		return true
+	}
+	for _, ns := range strings.Split(changed, ",") {
+		if _, ok := inheritable[ns]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// IsInheritanceSource checks if an ancestor node returned by the tree may carry user metadata.
+func IsInheritanceSource(node *tree.Node) bool {
+	return node.Uuid != "" && node.Uuid != "ROOT" && !strings.HasPrefix(node.Uuid, "DATASOURCE:")
+}
+
+// ApplyInherited sets the effective values of inheritable namespaces on a node. Ancestors must be ordered from the
+// closest parent to the root, with their Path, and carry their own values in their MetaStore. Values defined on the node itself always
+// win, otherwise the closest ancestor wins. The origins of inherited values are stored as a JSON map under the
+// META_NAMESPACE_INHERITED_ORIGINS key. It returns the number of inherited values.
+func ApplyInherited(node *tree.Node, inheritable map[string]struct{}, ancestors ...*tree.Node) int {
+	if len(inheritable) == 0 {
+		return 0
+	}
+	if node.MetaStore == nil {
+		node.MetaStore = make(map[string]string)
+	}
+	origins := make(map[string]*InheritedOrigin)
+	for _, ancestor := range ancestors {
+		if ancestor.Uuid == node.Uuid {
+			continue
+		}
+		for ns, value := range ancestor.MetaStore {
+			if _, ok := inheritable[ns]; !ok {
+				continue
+			}
+			if _, defined := node.MetaStore[ns]; defined {
+				continue
+			}
+			node.MetaStore[ns] = value
+			origins[ns] = &InheritedOrigin{Uuid: ancestor.Uuid, Name: path.Base(ancestor.Path)}
+		}
+	}
+	if len(origins) > 0 {
+		data, _ := json.Marshal(origins)
+		node.MetaStore[common.META_NAMESPACE_INHERITED_ORIGINS] = string(data)
+	}
+	return len(origins)
+}
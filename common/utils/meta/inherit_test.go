@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package meta
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/tree"
+)
+
+func TestApplyInherited(t *testing.T) {
+
+	Convey("Inheritable namespaces", t, func() {
+		inheritable := InheritableNamespaces(map[string]*idm.UserMetaNamespace{
+			"usermeta-project":   {Namespace: "usermeta-project", JsonDefinition: `{"type":"string","inherit":true}`},
+			"usermeta-retention": {Namespace: "usermeta-retention", JsonDefinition: `{"type":"choice","data":"short,long","inherit":true}`},
+			"usermeta-tags":      {Namespace: "usermeta-tags", JsonDefinition: `{"type":"tags"}`},
+			"usermeta-free":      {Namespace: "usermeta-free"},
+		})
+		So(inheritable, ShouldHaveLength, 2)
+		So(inheritable, ShouldContainKey, "usermeta-project")
+		So(inheritable, ShouldContainKey, "usermeta-retention")
+	})
+
+	Convey("Closest values win unless overridden", t, func() {
+		inheritable := map[string]struct{}{"usermeta-project": {}, "usermeta-retention": {}}
+		node := &tree.Node{Uuid: "file", Path: "ds/root/sub/file", MetaStore: map[string]string{"usermeta-project": `"P2"`}}
+		parent := &tree.Node{Uuid: "sub", Path: "ds/root/sub", MetaStore: map[string]string{"usermeta-retention": `"short"`}}
+		root := &tree.Node{Uuid: "root", Path: "ds/root", MetaStore: map[string]string{
+			"usermeta-project":   `"P1"`,
+			"usermeta-retention": `"long"`,
+			"usermeta-tags":      `"a,b"`,
+		}}
+
+		So(ApplyInherited(node, inheritable, node, parent, root), ShouldEqual, 1)
+		So(node.MetaStore["usermeta-project"], ShouldEqual, `"P2"`)
+		So(node.MetaStore["usermeta-retention"], ShouldEqual, `"short"`)
+		So(node.MetaStore, ShouldNotContainKey, "usermeta-tags")
+
+		var origins map[string]*InheritedOrigin
+		So(json.Unmarshal([]byte(node.MetaStore[common.META_NAMESPACE_INHERITED_ORIGINS]), &origins), ShouldBeNil)
+		So(origins, ShouldHaveLength, 1)
+		So(origins["usermeta-retention"], ShouldResemble, &InheritedOrigin{Uuid: "sub", Name: "sub"})
+	})
+
+	Convey("Nothing inherited", t, func() {
+		node := &tree.Node{Uuid: "file"}
+		So(ApplyInherited(node, map[string]struct{}{"usermeta-project": {}}, &tree.Node{Uuid: "root"}), ShouldEqual, 0)
+		So(node.MetaStore, ShouldNotContainKey, common.META_NAMESPACE_INHERITED_ORIGINS)
+		So(IsInheritanceSource(&tree.Node{Uuid: "DATASOURCE:pydiods1"}), ShouldBeFalse)
+		So(IsInheritanceSource(&tree.Node{Uuid: "root"}), ShouldBeTrue)
+	})
+}
+
+func TestChangesInheritable(t *testing.T) {
+
+	Convey("Only changes of inheritable namespaces are detected", t, func() {
+		inheritable := map[string]struct{}{"usermeta-project": {}}
+		event := &tree.NodeChangeEvent{Type: tree.NodeChangeEvent_UPDATE_USER_META}
+		So(ChangesInheritable(event, inheritable), ShouldBeTrue)
+		So(ChangesInheritable(event, map[string]struct{}{}), ShouldBeFalse)
+		event.Metadata = map[string]string{EventChangedNamespaces: "usermeta-tags"}
+		So(ChangesInheritable(event, inheritable), ShouldBeFalse)
+		event.Metadata = map[string]string{EventChangedNamespaces: "usermeta-tags,usermeta-project"}
+		So(ChangesInheritable(event, inheritable), ShouldBeTrue)
+	})
+}
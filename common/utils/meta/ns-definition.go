@@ -0,0 +1,281 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package meta
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pydio/cells/common/proto/idm"
+)
+
+// Types supported in the JsonDefinition of a UserMetaNamespace
+const (
+	NsTypeString   = "string"
+	NsTypeTextarea = "textarea"
+	NsTypeInteger  = "integer"
+	NsTypeDate     = "date"
+	NsTypeChoice   = "choice"
+	NsTypeTags     = "tags"
+	NsTypeStars    = "stars_rate"
+	NsTypeCss      = "css_label"
+	NsTypeUser     = "user"
+	NsTypeNode     = "node"
+	NsTypeJson     = "json"
+
+	// NsRequiredAll can be used in the required list to make a field mandatory in all workspaces
+	NsRequiredAll = "*"
+)
+
+var nsDateLayouts = []string{"2006-01-02", time.RFC3339}
+
+// NamespaceDefinition is the parsed form of a UserMetaNamespace JsonDefinition.
+// The "choice" type is an enumeration whose Data is a comma-separated list of key|label pairs,
+// "tags" is a multi-select that can be restricted to the values of the managed vocabulary.
+type NamespaceDefinition struct {
+	Type string `json:"type"`
+	Data string `json:"data,omitempty"`
+	// Vocabulary restricts tags to the values stored by administrators for this namespace
+	Vocabulary bool `json:"vocabulary,omitempty"`
+	// Min and Max bound the values of integer namespaces
+	Min *int64 `json:"min,omitempty"`
+	Max *int64 `json:"max,omitempty"`
+	// Required lists workspaces UUIDs where a value cannot be left empty
+	Required []string `json:"required,omitempty"`
+	// Inherit makes values set on a folder apply to all its descendants, unless they define their own value
+	Inherit bool `json:"inherit,omitempty"`
+}
+
+// ParseNamespaceDefinition decodes and checks the JsonDefinition of a namespace.
+// It returns a nil definition without error if the namespace is not typed.
+func ParseNamespaceDefinition(ns *idm.UserMetaNamespace) (*NamespaceDefinition, error) {
+	if ns.JsonDefinition == "" {
+		return nil, nil
+	}
+	def := &NamespaceDefinition{}
+	if e := json.Unmarshal([]byte(ns.JsonDefinition), def); e != nil {
+		return nil, fmt.Errorf("invalid json definition for namespace %s: %s", ns.Namespace, e.Error())
+	}
+	switch def.Type {
+	case "":
+		return nil, fmt.Errorf("missing type in definition of namespace %s", ns.Namespace)
+	case NsTypeChoice:
+		if len(def.Choices()) == 0 {
+			return nil, fmt.Errorf("choice namespace %s must declare at least one value", ns.Namespace)
+		}
+	case NsTypeInteger:
+		if def.Min != nil && def.Max != nil && *def.Min > *def.Max {
+			return nil, fmt.Errorf("invalid bounds for integer namespace %s", ns.Namespace)
+		}
+	case NsTypeString, NsTypeTextarea, NsTypeDate, NsTypeTags, NsTypeStars, NsTypeCss, NsTypeUser, NsTypeNode, NsTypeJson:
+	default:
+		return nil, fmt.Errorf("unsupported type %s for namespace %s", def.Type, ns.Namespace)
+	}
+	return def, nil
+}
+
+// Choices returns the keys declared in the Data of a choice namespace.
+func (d *NamespaceDefinition) Choices() []string {
+	var keys []string
+	for _, line := range strings.Split(d.Data, ",") {
+		key := strings.TrimSpace(strings.Split(line, "|")[0])
+		if key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// IsRequired checks if a value is mandatory for a node appearing in one of the given workspaces.
+func (d *NamespaceDefinition) IsRequired(workspaces ...string) bool {
+	for _, r := range d.Required {
+		if r == NsRequiredAll {
+			return true
+		}
+		for _, ws := range workspaces {
+			if r == ws {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// IsEmpty checks if a JSON value should be considered as not set.
+func (d *NamespaceDefinition) IsEmpty(jsonValue string) bool {
+	var v interface{}
+	if e := json.Unmarshal([]byte(jsonValue), &v); e != nil || v == nil {
+		return true
+	}
+	if s, ok := v.(string); ok {
+		return strings.TrimSpace(s) == ""
+	}
+	return false
+}
+
+// Validate checks that a JSON encoded value matches the definition type and returns the decoded value.
+// References (users, nodes, vocabulary) are only checked for their format, callers must check they exist.
+func (d *NamespaceDefinition) Validate(jsonValue string) (interface{}, error) {
+	var v interface{}
+	if e := json.Unmarshal([]byte(jsonValue), &v); e != nil {
+		return nil, fmt.Errorf("value is not valid json")
+	}
+	if v == nil {
+		return nil, nil
+	}
+	switch d.Type {
+	case NsTypeString, NsTypeTextarea, NsTypeCss:
+		if _, ok := v.(string); !ok {
+			return nil, fmt.Errorf("expected a string value")
+		}
+	case NsTypeUser, NsTypeNode:
+		s, ok := v.(string)
+		if !ok || strings.TrimSpace(s) == "" {
+			return nil, fmt.Errorf("expected a %s identifier", d.Type)
+		}
+	case NsTypeInteger:
+		i, e := d.toInt(v)
+		if e != nil {
+			return nil, e
+		}
+		if d.Min != nil && i < *d.Min || d.Max != nil && i > *d.Max {
+			return nil, fmt.Errorf("value %d is out of bounds", i)
+		}
+		return i, nil
+	case NsTypeStars:
+		i, e := d.toInt(v)
+		if e != nil || i < 0 || i > 5 {
+			return nil, fmt.Errorf("expected a rate between 0 and 5")
+		}
+		return i, nil
+	case NsTypeDate:
+		return d.toDate(v)
+	case NsTypeChoice:
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a string value")
+		}
+		if s == "" {
+			return s, nil
+		}
+		for _, k := range d.Choices() {
+			if k == s {
+				return s, nil
+			}
+		}
+		return nil, fmt.Errorf("value %s is not part of the allowed choices", s)
+	case NsTypeTags:
+		if _, ok := v.(string); !ok {
+			return nil, fmt.Errorf("expected a comma-separated list of tags")
+		}
+	}
+	return v, nil
+}
+
+// Tags splits the value of a tags namespace.
+func (d *NamespaceDefinition) Tags(jsonValue string) []string {
+	var s string
+	var tags []string
+	json.Unmarshal([]byte(jsonValue), &s)
+	for _, t := range strings.Split(s, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			tags = append(tags, t)
+		}
+	}
+	return tags
+}
+
+// FromText converts a value typed in a text form (e.g. a CSV cell) to its JSON encoding.
+// Empty texts are returned as is, as they stand for a deletion.
+func (d *NamespaceDefinition) FromText(text string) string {
+	if text == "" {
+		return ""
+	}
+	if d == nil || d.Type == NsTypeJson || d.Type == NsTypeInteger || d.Type == NsTypeStars {
+		if json.Valid([]byte(text)) {
+			return text
+		}
+	}
+	encoded, _ := json.Marshal(text)
+	return string(encoded)
+}
+
+// ToText converts a JSON value to a text form that FromText reverts: strings are unquoted,
+// unless the namespace accepts any JSON value.
+func (d *NamespaceDefinition) ToText(jsonValue string) string {
+	if d == nil || d.Type == NsTypeJson {
+		return jsonValue
+	}
+	var s string
+	if e := json.Unmarshal([]byte(jsonValue), &s); e == nil {
+		return s
+	}
+	return jsonValue
+}
+
+// IndexValue converts an already decoded value to the type expected by search engines,
+// so that range queries can be performed on integers and dates.
+func (d *NamespaceDefinition) IndexValue(v interface{}) interface{} {
+	switch d.Type {
+	case NsTypeInteger, NsTypeStars:
+		if i, e := d.toInt(v); e == nil {
+			return float64(i)
+		}
+	case NsTypeDate:
+		if t, e := d.toDate(v); e == nil {
+			return t
+		}
+	}
+	return v
+}
+
+func (d *NamespaceDefinition) toInt(v interface{}) (int64, error) {
+	switch n := v.(type) {
+	case float64:
+		if n != math.Trunc(n) {
+			return 0, fmt.Errorf("expected an integer value")
+		}
+		return int64(n), nil
+	case string:
+		if i, e := strconv.ParseInt(strings.TrimSpace(n), 10, 64); e == nil {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("expected an integer value")
+}
+
+func (d *NamespaceDefinition) toDate(v interface{}) (time.Time, error) {
+	switch t := v.(type) {
+	case float64:
+		return time.Unix(int64(t), 0).UTC(), nil
+	case string:
+		for _, layout := range nsDateLayouts {
+			if parsed, e := time.Parse(layout, t); e == nil {
+				return parsed.UTC(), nil
+			}
+		}
+	}
+	return time.Time{}, fmt.Errorf("expected a date (YYYY-MM-DD, RFC3339 or timestamp)")
+}
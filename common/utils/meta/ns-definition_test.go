@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package meta
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common/proto/idm"
+)
+
+func TestNamespaceDefinition(t *testing.T) {
+
+	Convey("Parse definitions", t, func() {
+		def, e := ParseNamespaceDefinition(&idm.UserMetaNamespace{Namespace: "usermeta-none"})
+		So(e, ShouldBeNil)
+		So(def, ShouldBeNil)
+
+		_, e = ParseNamespaceDefinition(&idm.UserMetaNamespace{Namespace: "usermeta-bad", JsonDefinition: `{"type":"unknown"}`})
+		So(e, ShouldNotBeNil)
+		_, e = ParseNamespaceDefinition(&idm.UserMetaNamespace{Namespace: "usermeta-choice", JsonDefinition: `{"type":"choice"}`})
+		So(e, ShouldNotBeNil)
+		_, e = ParseNamespaceDefinition(&idm.UserMetaNamespace{Namespace: "usermeta-int", JsonDefinition: `{"type":"integer","min":10,"max":1}`})
+		So(e, ShouldNotBeNil)
+
+		def, e = ParseNamespaceDefinition(&idm.UserMetaNamespace{Namespace: "usermeta-choice", JsonDefinition: `{"type":"choice","data":"low|Low,high|High","required":["ws1"]}`})
+		So(e, ShouldBeNil)
+		So(def.Choices(), ShouldResemble, []string{"low", "high"})
+		So(def.IsRequired("ws2", "ws1"), ShouldBeTrue)
+		So(def.IsRequired("ws2"), ShouldBeFalse)
+	})
+
+	Convey("Validate values", t, func() {
+		intDef := &NamespaceDefinition{Type: NsTypeInteger}
+		min, max := int64(0), int64(100)
+		intDef.Min, intDef.Max = &min, &max
+		v, e := intDef.Validate(`42`)
+		So(e, ShouldBeNil)
+		So(v, ShouldEqual, 42)
+		_, e = intDef.Validate(`"12"`)
+		So(e, ShouldBeNil)
+		_, e = intDef.Validate(`4.2`)
+		So(e, ShouldNotBeNil)
+		_, e = intDef.Validate(`420`)
+		So(e, ShouldNotBeNil)
+
+		dateDef := &NamespaceDefinition{Type: NsTypeDate}
+		v, e = dateDef.Validate(`"2019-05-12"`)
+		So(e, ShouldBeNil)
+		So(v, ShouldEqual, time.Date(2019, 5, 12, 0, 0, 0, 0, time.UTC))
+		_, e = dateDef.Validate(`"12/05/2019"`)
+		So(e, ShouldNotBeNil)
+
+		choiceDef := &NamespaceDefinition{Type: NsTypeChoice, Data: "low|Low,high|High"}
+		_, e = choiceDef.Validate(`"high"`)
+		So(e, ShouldBeNil)
+		_, e = choiceDef.Validate(`"medium"`)
+		So(e, ShouldNotBeNil)
+
+		strDef := &NamespaceDefinition{Type: NsTypeString}
+		_, e = strDef.Validate(`12`)
+		So(e, ShouldNotBeNil)
+		So(strDef.IsEmpty(`" "`), ShouldBeTrue)
+
+		tagsDef := &NamespaceDefinition{Type: NsTypeTags}
+		So(tagsDef.Tags(`"a, b,,c"`), ShouldResemble, []string{"a", "b", "c"})
+	})
+
+	Convey("Index values", t, func() {
+		So((&NamespaceDefinition{Type: NsTypeInteger}).IndexValue("12"), ShouldEqual, float64(12))
+		So((&NamespaceDefinition{Type: NsTypeDate}).IndexValue("2019-05-12"), ShouldHaveSameTypeAs, time.Time{})
+		So((&NamespaceDefinition{Type: NsTypeString}).IndexValue("12"), ShouldEqual, "12")
+	})
+}
@@ -27,6 +27,7 @@ import (
 
 	. "github.com/smartystreets/goconvey/convey"
 
+	"github.com/pydio/cells/common/auth/claim"
 	"github.com/pydio/cells/common/proto/idm"
 	"github.com/pydio/cells/common/proto/tree"
 )
@@ -152,3 +153,27 @@ func TestAccessList_Flatten(t *testing.T) {
 
 	})
 }
+
+func TestScopeACLs(t *testing.T) {
+
+	Convey("Test ACLs scoped by a personal access token", t, func() {
+
+		So(ScopeACLs(claim.Claims{ScopeReadOnly: true}, acls), ShouldHaveLength, len(acls))
+
+		scoped := ScopeACLs(claim.Claims{PersonalAccessToken: "pat", ScopeWorkspaces: []string{"ws2"}}, acls)
+		for _, acl := range scoped {
+			So(acl.WorkspaceID, ShouldBeIn, []string{"", "ws2"})
+		}
+
+		ctx := context.Background()
+		list := NewAccessList(roles)
+		list.Append(ScopeACLs(claim.Claims{PersonalAccessToken: "pat", ScopeReadOnly: true}, acls))
+		list.Flatten(ctx)
+		testReadWrite := listParents("root/folder1/subfolder2/file1")
+		So(list.CanRead(ctx, testReadWrite...), ShouldBeTrue)
+		So(list.CanWrite(ctx, testReadWrite...), ShouldBeFalse)
+
+		So(ScopeACLs(claim.Claims{MfaEnrollOnly: true}, acls), ShouldBeEmpty)
+
+	})
+}
@@ -0,0 +1,170 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package permissions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/auth/claim"
+	"github.com/pydio/cells/common/config"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/micro"
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/tree"
+)
+
+const (
+	// PolicyNodeResource is the resource of the security policies restricting the access to nodes granted by ACLs,
+	// upon their metadata and the user attributes.
+	PolicyNodeResource   = "node"
+	PolicyUserAttribute_ = "UserAttribute:"
+)
+
+var (
+	attributesPoliciesCache *cache.Cache
+	// managedAttributesConfig reads the managedAttributes option of the user service, it is replaced in tests
+	managedAttributesConfig = func() []string {
+		return config.Get("services", common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_USER, "managedAttributes").StringSlice([]string{})
+	}
+)
+
+func init() {
+	attributesPoliciesCache = cache.New(30*time.Second, time.Minute)
+}
+
+// PolicyContextFromNodeMeta adds the metadata of the nodes to the passed policyContext, under NodeMeta:namespace keys.
+// Nodes are ordered from the closest to the farthest, so that a node metadata overrides the one of its parents.
+func PolicyContextFromNodeMeta(policyContext map[string]string, nodes ...*tree.Node) {
+	for _, node := range nodes {
+		for k, v := range node.MetaStore {
+			if strings.HasPrefix(k, "pydio:") {
+				continue
+			}
+			key := PolicyNodeMeta_ + k
+			if _, already := policyContext[key]; already {
+				continue
+			}
+			var s string
+			if e := json.Unmarshal([]byte(v), &s); e == nil {
+				policyContext[key] = s
+			} else {
+				policyContext[key] = v
+			}
+		}
+	}
+}
+
+// ManagedUserAttributes lists the user attributes that only administrators can set: the profile, and the ones
+// listed in the managedAttributes option of the user service (services/pydio.grpc.user/managedAttributes), empty
+// by default. Users cannot edit them, even on their own account.
+func ManagedUserAttributes() map[string]bool {
+	managed := map[string]bool{idm.UserAttrProfile: true}
+	for _, name := range managedAttributesConfig() {
+		if name != "" && !strings.HasPrefix(name, idm.UserAttrPrivatePrefix) {
+			managed[name] = true
+		}
+	}
+	return managed
+}
+
+// PolicyContextFromUser adds the managed attributes of the user to the passed policyContext, under UserAttribute:name keys.
+// Other attributes can be edited by the user, so they are never passed to policies: an attribute like department or
+// clearance must be listed in the managedAttributes option, or the policies conditions using it never match.
+func PolicyContextFromUser(policyContext map[string]string, user *idm.User) {
+	for k := range ManagedUserAttributes() {
+		if v, ok := user.Attributes[k]; ok {
+			policyContext[PolicyUserAttribute_+k] = v
+		}
+	}
+	policyContext[PolicyUserAttribute_+"GroupPath"] = user.GroupPath
+}
+
+// HasAttributesPolicies checks if some security policies apply to the node resource. As there are usually none,
+// this avoids loading metadata and querying the policy engine on each node. The result is cached for 30 seconds.
+func HasAttributesPolicies(ctx context.Context) bool {
+	if has, ok := attributesPoliciesCache.Get(PolicyNodeResource); ok {
+		return has.(bool)
+	}
+	cli := idm.NewPolicyEngineServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_POLICY, defaults.NewClient())
+	resp, err := cli.ListPolicyGroups(ctx, &idm.ListPolicyGroupsRequest{})
+	if err != nil {
+		// Fail closed: policies are evaluated, and deny access while the service is unavailable
+		log.Logger(ctx).Error("cannot list policy groups to find attribute based policies", zap.Error(err))
+		attributesPoliciesCache.Set(PolicyNodeResource, true, 5*time.Second)
+		return true
+	}
+	var has bool
+	for _, group := range resp.PolicyGroups {
+		for _, p := range group.Policies {
+			for _, r := range p.Resources {
+				if strings.HasPrefix(r, PolicyNodeResource) {
+					has = true
+				}
+			}
+		}
+	}
+	attributesPoliciesCache.Set(PolicyNodeResource, has, cache.DefaultExpiration)
+	return has
+}
+
+// NodeAttributesDecision evaluates the security policies on the node resource for the user found in context.
+// Nodes are the node and its parents, whose metadata must already be loaded: a metadata set on a folder applies
+// to its children unless they override it. It tells if a policy explicitly allows or denies the action.
+func NodeAttributesDecision(ctx context.Context, flag BitmaskFlag, nodes ...*tree.Node) (allowed bool, denied bool) {
+	claims, ok := ctx.Value(claim.ContextKey).(claim.Claims)
+	if !ok || claims.Name == "" {
+		return false, false
+	}
+	policyContext := make(map[string]string)
+	PolicyContextFromMetadata(policyContext, ctx)
+	if len(nodes) > 0 {
+		PolicyContextFromNode(policyContext, nodes[0])
+		PolicyContextFromNodeMeta(policyContext, nodes...)
+	}
+	if user, e := SearchUniqueUser(ctx, claims.Name, ""); e == nil {
+		PolicyContextFromUser(policyContext, user)
+	} else {
+		log.Logger(ctx).Error("cannot load user attributes for policies", zap.String(common.KEY_USERNAME, claims.Name), zap.Error(e))
+	}
+	req := &idm.PolicyEngineRequest{
+		Subjects: PolicyRequestSubjectsFromClaims(claims),
+		Resource: PolicyNodeResource,
+		Action:   FlagsToNames[flag],
+		Context:  policyContext,
+	}
+	cli := idm.NewPolicyEngineServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_POLICY, defaults.NewClient())
+	resp, err := cli.IsAllowed(ctx, req)
+	if err != nil {
+		// Fail closed
+		log.Logger(ctx).Error("cannot evaluate attribute based policies", zap.Any(common.KEY_POLICY_REQUEST, req), zap.Error(err))
+		return false, true
+	}
+	log.Logger(ctx).Debug(fmt.Sprintf("Attribute based policies for %s", FlagsToNames[flag]), zap.Any(common.KEY_POLICY_REQUEST, req), zap.Any("response", resp))
+	return resp.Allowed, resp.ExplicitDeny
+}
@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package permissions
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/tree"
+)
+
+func TestPolicyContextFromNodeMeta(t *testing.T) {
+
+	Convey("Node metadata are inherited from parents", t, func() {
+
+		file := &tree.Node{Uuid: "file", Path: "folder/file.txt", MetaStore: map[string]string{
+			"classification": `"internal"`,
+			"pydio:private":  `"hidden"`,
+		}}
+		folder := &tree.Node{Uuid: "folder", Path: "folder", MetaStore: map[string]string{
+			"classification": `"confidential"`,
+			"project":        `"apollo"`,
+			"tags":           `["a","b"]`,
+		}}
+		policyContext := make(map[string]string)
+		PolicyContextFromNodeMeta(policyContext, file, folder)
+
+		So(policyContext, ShouldResemble, map[string]string{
+			"NodeMeta:classification": "internal",
+			"NodeMeta:project":        "apollo",
+			"NodeMeta:tags":           `["a","b"]`,
+		})
+	})
+}
+
+func TestPolicyContextFromUser(t *testing.T) {
+
+	Convey("Only managed user attributes are added", t, func() {
+
+		managedAttributesConfig = func() []string {
+			return []string{"clearance"}
+		}
+
+		user := &idm.User{Login: "max", GroupPath: "/legal", Attributes: map[string]string{
+			"clearance":         "secret",
+			"department":        "legal",
+			idm.UserAttrProfile: "standard",
+			idm.UserAttrMfa:     "{}",
+		}}
+		policyContext := make(map[string]string)
+		PolicyContextFromUser(policyContext, user)
+
+		So(policyContext, ShouldResemble, map[string]string{
+			"UserAttribute:clearance": "secret",
+			"UserAttribute:profile":   "standard",
+			"UserAttribute:GroupPath": "/legal",
+		})
+	})
+}
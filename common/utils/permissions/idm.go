@@ -353,7 +353,7 @@ func AccessListFromContextClaims(ctx context.Context) (accessList *AccessList, e
 
 	roles := GetRoles(ctx, strings.Split(claims.Roles, ","))
 	accessList = NewAccessList(roles)
-	accessList.Append(GetACLsForRoles(ctx, roles, AclRead, AclDeny, AclWrite, AclLock, AclPolicy))
+	accessList.Append(ScopeACLs(claims, GetACLsForRoles(ctx, roles, AclRead, AclDeny, AclWrite, AclLock, AclPolicy)))
 	ResolvePolicyRequest = func(ctx context.Context, request *idm.PolicyEngineRequest) (*idm.PolicyEngineResponse, error) {
 		cli := idm.NewPolicyEngineServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_POLICY, defaults.NewClient())
 		return cli.IsAllowed(ctx, request)
@@ -368,6 +368,32 @@ func AccessListFromContextClaims(ctx context.Context) (accessList *AccessList, e
 	return accessList, nil
 }
 
+// ScopeACLs restricts ACLs to the workspaces and actions allowed by the claims of a personal access token.
+// ACLs that are not attached to a workspace are kept. Sessions restricted to the enrollment of a second factor get no ACL.
+func ScopeACLs(claims claim.Claims, acls []*idm.ACL) []*idm.ACL {
+	if claims.MfaEnrollOnly {
+		return nil
+	}
+	if claims.PersonalAccessToken == "" || (len(claims.ScopeWorkspaces) == 0 && !claims.ScopeReadOnly) {
+		return acls
+	}
+	allowed := make(map[string]bool, len(claims.ScopeWorkspaces))
+	for _, ws := range claims.ScopeWorkspaces {
+		allowed[ws] = true
+	}
+	var scoped []*idm.ACL
+	for _, acl := range acls {
+		if claims.ScopeReadOnly && acl.Action != nil && acl.Action.Name == AclWrite.Name {
+			continue
+		}
+		if acl.WorkspaceID != "" && len(allowed) > 0 && !allowed[acl.WorkspaceID] {
+			continue
+		}
+		scoped = append(scoped, acl)
+	}
+	return scoped
+}
+
 func AccessListFromUser(ctx context.Context, userNameOrUuid string, isUuid bool) (accessList *AccessList, user *idm.User, err error) {
 
 	if isUuid {
@@ -24,6 +24,7 @@ import (
 	"context"
 	"fmt"
 	"path"
+	"strconv"
 	"strings"
 
 	"github.com/micro/go-micro/metadata"
@@ -40,7 +41,8 @@ const (
 	PolicyNodeMetaExtension = "NodeMetaExtension"
 	PolicyNodeMetaSize      = "NodeMetaSize"
 	PolicyNodeMetaMTime     = "NodeMetaMTime"
-	// Todo - Problem, usermeta are not loaded at this point
+	PolicyMfaAuthenticated  = "MfaAuthenticated"
+	// Metadata are only loaded by the views when attribute based policies are defined
 	PolicyNodeMeta_ = "NodeMeta:"
 )
 
@@ -84,6 +86,7 @@ func PolicyContextFromMetadata(policyContext map[string]string, ctx context.Cont
 			servicecontext.HttpMetaUserAgent,
 			servicecontext.HttpMetaContentType,
 			servicecontext.HttpMetaProtocol,
+			servicecontext.HttpMetaClientType,
 			servicecontext.ClientTime,
 			servicecontext.ServerTime,
 		} {
@@ -93,6 +96,9 @@ func PolicyContextFromMetadata(policyContext map[string]string, ctx context.Cont
 			}
 		}
 	}
+	if claims, ok := ctx.Value(claim.ContextKey).(claim.Claims); ok {
+		policyContext[PolicyMfaAuthenticated] = strconv.FormatBool(claims.MfaAuthenticated)
+	}
 }
 
 // PolicyContextFromNode extracts metadata from the Node and enriches the passed policyContext.
@@ -104,4 +110,5 @@ func PolicyContextFromNode(policyContext map[string]string, node *tree.Node) {
 	if node.IsLeaf() {
 		policyContext[PolicyNodeMetaExtension] = strings.TrimLeft(path.Ext(node.Path), ".")
 	}
+	PolicyContextFromNodeMeta(policyContext, node)
 }
@@ -0,0 +1,115 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package views
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/utils/meta"
+	"github.com/pydio/cells/common/utils/permissions"
+)
+
+// NodeAccessAllowed checks if the first node, passed with its parents, can be read or written. ACLs are checked first,
+// then, if some security policies apply to the node resource, the metadata of the nodes are loaded and the policies
+// are evaluated against them: policies can only narrow the access granted by ACLs, an explicit deny always wins.
+// Pass a loader to reuse the metadata streams, or nil to open new ones. A nil accessList only checks the policies.
+func NodeAccessAllowed(ctx context.Context, accessList *permissions.AccessList, flag permissions.BitmaskFlag, loader *NodesMetaLoader, nodes ...*tree.Node) bool {
+
+	if !permissions.HasAttributesPolicies(ctx) {
+		return aclAllowed(ctx, accessList, flag, nodes...)
+	}
+	if loader == nil {
+		loader = NewNodesMetaLoader(ctx)
+		defer loader.Close()
+	}
+	nodes = loader.Load(ctx, nodes...)
+
+	if !aclAllowed(ctx, accessList, flag, nodes...) {
+		return false
+	}
+	_, policyDenied := permissions.NodeAttributesDecision(ctx, flag, nodes...)
+	return !policyDenied
+}
+
+func aclAllowed(ctx context.Context, accessList *permissions.AccessList, flag permissions.BitmaskFlag, nodes ...*tree.Node) bool {
+	if accessList == nil {
+		return true
+	}
+	if flag == permissions.FlagWrite {
+		return accessList.CanWrite(ctx, nodes...)
+	}
+	return accessList.CanRead(ctx, nodes...)
+}
+
+// NodesMetaLoader loads the metadata of nodes from all meta providers, including user-defined namespaces.
+type NodesMetaLoader struct {
+	streamers []tree.NodeProviderStreamer_ReadNodeStreamClient
+	names     []string
+	closer    meta.MetaProviderCloser
+}
+
+// newNodesMetaLoaderIfRequired only opens streams to the meta providers if some policies may use the metadata.
+func newNodesMetaLoaderIfRequired(ctx context.Context) *NodesMetaLoader {
+	if !permissions.HasAttributesPolicies(ctx) {
+		return nil
+	}
+	return NewNodesMetaLoader(ctx)
+}
+
+// NewNodesMetaLoader opens streams to the meta providers, they must be closed after use.
+func NewNodesMetaLoader(ctx context.Context) *NodesMetaLoader {
+	l := &NodesMetaLoader{}
+	l.streamers, l.closer, l.names = meta.InitMetaProviderClients(ctx, true)
+	return l
+}
+
+// Load returns copies of the nodes enriched with their metadata. Nodes already loaded are not sent again.
+func (l *NodesMetaLoader) Load(ctx context.Context, nodes ...*tree.Node) []*tree.Node {
+	var out, toLoad []*tree.Node
+	for _, n := range nodes {
+		if n.HasMetaKey(metaLoadedKey) {
+			out = append(out, n)
+			continue
+		}
+		c := n.Clone()
+		c.SetMeta(metaLoadedKey, true)
+		out = append(out, c)
+		if c.Uuid != "" && c.Uuid != "ROOT" && !strings.HasPrefix(c.Uuid, "DATASOURCE:") {
+			toLoad = append(toLoad, c)
+		}
+	}
+	if len(toLoad) > 0 {
+		meta.EnrichNodesMetaFromProviders(ctx, l.streamers, l.names, toLoad...)
+	}
+	return out
+}
+
+// Close closes the streams to the meta providers.
+func (l *NodesMetaLoader) Close() {
+	if l != nil && l.closer != nil {
+		l.closer()
+	}
+}
+
+// metaLoadedKey flags nodes whose metadata have been loaded. It is reserved so it is never sent to clients.
+const metaLoadedKey = "pydio:meta-loaded"
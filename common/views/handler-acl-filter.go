@@ -60,14 +60,18 @@ func (a *AclFilterHandler) ReadNode(ctx context.Context, in *tree.ReadNodeReques
 		return nil, err
 	}
 
-	if !accessList.CanRead(ctx, parents...) && !accessList.CanWrite(ctx, parents...) {
+	loader := newNodesMetaLoaderIfRequired(ctx)
+	defer loader.Close()
+	canRead := NodeAccessAllowed(ctx, accessList, permissions.FlagRead, loader, parents...)
+	canWrite := NodeAccessAllowed(ctx, accessList, permissions.FlagWrite, loader, parents...)
+	if !canRead && !canWrite {
 		return nil, errors.Forbidden(VIEWS_LIBRARY_NAME, "Node is not readable")
 	}
 	response, err := a.next.ReadNode(ctx, in, opts...)
 	if err != nil {
 		return nil, err
 	}
-	if accessList.CanRead(ctx, parents...) && !accessList.CanWrite(ctx, parents...) {
+	if canRead && !canWrite {
 		n := response.Node.Clone()
 		n.SetMeta(common.META_FLAG_READONLY, "true")
 		response.Node = n
@@ -86,19 +90,26 @@ func (a *AclFilterHandler) ListNodes(ctx context.Context, in *tree.ListNodesRequ
 		return nil, err
 	}
 
-	if !accessList.CanRead(ctx, parents...) {
+	loader := newNodesMetaLoaderIfRequired(ctx)
+	if loader != nil {
+		parents = loader.Load(ctx, parents...)
+	}
+	if !NodeAccessAllowed(ctx, accessList, permissions.FlagRead, loader, parents...) {
+		loader.Close()
 		return nil, errors.Forbidden(VIEWS_LIBRARY_NAME, "Node is not readable")
 	}
 	log.Logger(ctx).Debug("Parent Ancestors", zap.Any("parents", parents), zap.Any("acl", accessList))
 
 	stream, err := a.next.ListNodes(ctx, in, opts...)
 	if err != nil {
+		loader.Close()
 		return nil, err
 	}
 	s := NewWrappingStreamer()
 	go func() {
 		defer stream.Close()
 		defer s.Close()
+		defer loader.Close()
 		for {
 			resp, err := stream.Recv()
 			if err != nil {
@@ -110,10 +121,10 @@ func (a *AclFilterHandler) ListNodes(ctx context.Context, in *tree.ListNodesRequ
 			// FILTER OUT NON READABLE NODES
 			newBranch := []*tree.Node{resp.Node}
 			newBranch = append(newBranch, parents...)
-			if !accessList.CanRead(ctx, newBranch...) {
+			if !NodeAccessAllowed(ctx, accessList, permissions.FlagRead, loader, newBranch...) {
 				continue
 			}
-			if accessList.CanRead(ctx, newBranch...) && !accessList.CanWrite(ctx, newBranch...) {
+			if !NodeAccessAllowed(ctx, accessList, permissions.FlagWrite, loader, newBranch...) {
 				n := resp.Node.Clone()
 				n.SetMeta(common.META_FLAG_READONLY, "true")
 				resp.Node = n
@@ -133,7 +144,7 @@ func (a *AclFilterHandler) CreateNode(ctx context.Context, in *tree.CreateNodeRe
 	if err != nil {
 		return nil, err
 	}
-	if !accessList.CanWrite(ctx, toParents...) {
+	if !NodeAccessAllowed(ctx, accessList, permissions.FlagWrite, nil, toParents...) {
 		return nil, errors.Forbidden("parent.not.writeable", "Target Location is not writeable (CreateNode)")
 	}
 	return a.next.CreateNode(ctx, in, opts...)
@@ -148,14 +159,14 @@ func (a *AclFilterHandler) UpdateNode(ctx context.Context, in *tree.UpdateNodeRe
 	if err != nil {
 		return nil, err
 	}
-	if !accessList.CanRead(ctx, fromParents...) {
+	if !NodeAccessAllowed(ctx, accessList, permissions.FlagRead, nil, fromParents...) {
 		return nil, errors.Forbidden(VIEWS_LIBRARY_NAME, "Source Node is not readable")
 	}
 	ctx, toParents, err := AncestorsListFromContext(ctx, in.To, "to", a.clientsPool, true)
 	if err != nil {
 		return nil, err
 	}
-	if !accessList.CanWrite(ctx, toParents...) {
+	if !NodeAccessAllowed(ctx, accessList, permissions.FlagWrite, nil, toParents...) {
 		return nil, errors.Forbidden(VIEWS_LIBRARY_NAME, "Target Node is not writeable")
 	}
 	return a.next.UpdateNode(ctx, in, opts...)
@@ -170,7 +181,7 @@ func (a *AclFilterHandler) DeleteNode(ctx context.Context, in *tree.DeleteNodeRe
 	if err != nil {
 		return nil, err
 	}
-	if !accessList.CanWrite(ctx, delParents...) {
+	if !NodeAccessAllowed(ctx, accessList, permissions.FlagWrite, nil, delParents...) {
 		return nil, errors.Forbidden(VIEWS_LIBRARY_NAME, "Node is not writeable, cannot delete!")
 	}
 	return a.next.DeleteNode(ctx, in, opts...)
@@ -186,7 +197,7 @@ func (a *AclFilterHandler) GetObject(ctx context.Context, node *tree.Node, reque
 	if err != nil {
 		return nil, err
 	}
-	if !accessList.CanRead(ctx, parents...) {
+	if !NodeAccessAllowed(ctx, accessList, permissions.FlagRead, nil, parents...) {
 		return nil, errors.Forbidden(VIEWS_LIBRARY_NAME, "Node is not readable")
 	}
 	return a.next.GetObject(ctx, node, requestData)
@@ -202,7 +213,7 @@ func (a *AclFilterHandler) PutObject(ctx context.Context, node *tree.Node, reade
 	if err != nil {
 		return 0, err
 	}
-	if !accessList.CanWrite(ctx, parents...) {
+	if !NodeAccessAllowed(ctx, accessList, permissions.FlagWrite, nil, parents...) {
 		return 0, errors.Forbidden(VIEWS_LIBRARY_NAME, "Node is not writeable")
 	}
 	return a.next.PutObject(ctx, node, reader, requestData)
@@ -217,14 +228,14 @@ func (a *AclFilterHandler) CopyObject(ctx context.Context, from *tree.Node, to *
 	if err != nil {
 		return 0, err
 	}
-	if !accessList.CanRead(ctx, fromParents...) {
+	if !NodeAccessAllowed(ctx, accessList, permissions.FlagRead, nil, fromParents...) {
 		return 0, errors.Forbidden(VIEWS_LIBRARY_NAME, "Source Node is not readable")
 	}
 	ctx, toParents, err := AncestorsListFromContext(ctx, to, "to", a.clientsPool, true)
 	if err != nil {
 		return 0, err
 	}
-	if !accessList.CanWrite(ctx, toParents...) {
+	if !NodeAccessAllowed(ctx, accessList, permissions.FlagWrite, nil, toParents...) {
 		return 0, errors.Forbidden(VIEWS_LIBRARY_NAME, "Target Location is not writeable (CopyObject)")
 	}
 	return a.next.CopyObject(ctx, from, to, requestData)
@@ -34,6 +34,7 @@ import (
 	"github.com/pydio/cells/common/micro"
 	"github.com/pydio/cells/common/proto/docstore"
 	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/rest"
 	"github.com/pydio/cells/common/proto/tree"
 	"github.com/pydio/cells/common/utils/permissions"
 )
@@ -85,7 +86,7 @@ func (h *HandlerEventRead) GetObject(ctx context.Context, node *tree.Node, reque
 		linkData *docstore.ShareDocument
 	)
 
-	if doc, linkData = h.sharedLinkWithDownloadLimit(ctx); doc != nil && linkData != nil {
+	if doc, linkData = h.sharedLinkData(ctx); linkData != nil && linkData.DownloadLimit > 0 {
 		// Check download limit!
 		if linkData.DownloadCount >= linkData.DownloadLimit {
 			return nil, errors.Forbidden("MaxDownloadsReached", "You are not allowed to download this document")
@@ -112,27 +113,59 @@ func (h *HandlerEventRead) GetObject(ctx context.Context, node *tree.Node, reque
 			}()
 		}
 		if doc != nil && linkData != nil {
-			go func() {
-				bgContext := context.Background()
-				linkData.DownloadCount++
-				newData, _ := json.Marshal(linkData)
-				doc.Data = string(newData)
-				store := docstore.NewDocStoreClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_DOCSTORE, defaults.NewClient())
-				_, e3 := store.PutDocument(bgContext, &docstore.PutDocumentRequest{StoreID: common.DOCSTORE_ID_SHARES, DocumentID: doc.ID, Document: doc})
-				if e3 == nil {
-					logger.Debug("Updated share download count " + doc.ID)
-				} else {
-					logger.Error("Docstore error while trying to increment link downloads count", zap.Error(e3))
-				}
-
-			}()
+			go h.updateLinkAccess(ctx, doc, linkData, eventNode)
 		}
 	}
 	return reader, e
 
 }
 
-func (h *HandlerEventRead) sharedLinkWithDownloadLimit(ctx context.Context) (doc *docstore.Document, linkData *docstore.ShareDocument) {
+// updateLinkAccess records the access of a link user in the link log, and increments the downloads
+// counters of the link and of the target user if any.
+func (h *HandlerEventRead) updateLinkAccess(ctx context.Context, doc *docstore.Document, linkData *docstore.ShareDocument, node *tree.Node) {
+	logger := log.Logger(ctx)
+	bgContext := context.Background()
+	action := linkAccessAction(ctx)
+	recipient, target := LinkAccessRecipient(ctx, linkData)
+	entry := &rest.ShareLinkAccess{
+		LinkUuid:  linkData.RepositoryId,
+		Action:    action,
+		Recipient: recipient,
+		NodeUuid:  node.Uuid,
+		NodePath:  node.Path,
+	}
+	if target != nil {
+		entry.RecipientDisplay = target.Display
+	}
+	if e := RecordLinkAccess(ctx, entry); e != nil {
+		logger.Error("Docstore error while trying to record link access", zap.Error(e))
+	}
+
+	var update bool
+	if linkData.DownloadLimit > 0 {
+		linkData.DownloadCount++
+		update = true
+	}
+	if target != nil && action == rest.ShareLinkAccessAction_DOWNLOAD {
+		target.DownloadCount++
+		update = true
+	}
+	if !update {
+		return
+	}
+	newData, _ := json.Marshal(linkData)
+	doc.Data = string(newData)
+	store := docstore.NewDocStoreClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_DOCSTORE, defaults.NewClient())
+	_, e3 := store.PutDocument(bgContext, &docstore.PutDocumentRequest{StoreID: common.DOCSTORE_ID_SHARES, DocumentID: doc.ID, Document: doc})
+	if e3 == nil {
+		logger.Debug("Updated share download count " + doc.ID)
+	} else {
+		logger.Error("Docstore error while trying to increment link downloads count", zap.Error(e3))
+	}
+}
+
+// sharedLinkData finds the link document of the current user if it is a hidden link user.
+func (h *HandlerEventRead) sharedLinkData(ctx context.Context) (doc *docstore.Document, linkData *docstore.ShareDocument) {
 
 	userLogin, claims := permissions.FindUserNameInContext(ctx)
 	// TODO - Have the 'hidden' info directly in claims => could it be a profile instead ?
@@ -185,7 +218,7 @@ func (h *HandlerEventRead) sharedLinkWithDownloadLimit(ctx context.Context) (doc
 
 	if doc != nil {
 		var data *docstore.ShareDocument
-		if e2 := json.Unmarshal([]byte(doc.Data), &data); e2 == nil && data.DownloadLimit > 0 {
+		if e2 := json.Unmarshal([]byte(doc.Data), &data); e2 == nil {
 			linkData = data
 		}
 	}
@@ -0,0 +1,255 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+package views
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/micro/go-micro/client"
+	"github.com/micro/go-micro/errors"
+	"github.com/micro/go-micro/metadata"
+	"github.com/patrickmn/go-cache"
+	"github.com/pydio/minio-go"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/micro"
+	"github.com/pydio/cells/common/proto/docstore"
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/rest"
+	"github.com/pydio/cells/common/proto/tree"
+)
+
+var (
+	fileRequestLinks = cache.New(time.Minute, 5*time.Minute)
+	fileRequestDrops = cache.New(30*time.Second, 5*time.Minute)
+)
+
+// FileRequestFilter restricts write operations on the workspace of a file request link. Each operation
+// must target the folder of the drop session passed in the X-Pydio-Drop-Session header, so that
+// uploaders cannot reach the files of each other, and uploads must respect the limits of the link.
+type FileRequestFilter struct {
+	AbstractHandler
+}
+
+// CreateNode checks that the node is created inside the drop folder.
+func (a *FileRequestFilter) CreateNode(ctx context.Context, in *tree.CreateNodeRequest, opts ...client.CallOption) (*tree.CreateNodeResponse, error) {
+	if _, e := a.checkDropTarget(ctx, "in", in.Node); e != nil {
+		return nil, e
+	}
+	return a.next.CreateNode(ctx, in, opts...)
+}
+
+// UpdateNode checks that both source and target are inside the drop folder.
+func (a *FileRequestFilter) UpdateNode(ctx context.Context, in *tree.UpdateNodeRequest, opts ...client.CallOption) (*tree.UpdateNodeResponse, error) {
+	if _, e := a.checkDropTarget(ctx, "from", in.From); e != nil {
+		return nil, e
+	}
+	if _, e := a.checkDropTarget(ctx, "to", in.To); e != nil {
+		return nil, e
+	}
+	return a.next.UpdateNode(ctx, in, opts...)
+}
+
+// DeleteNode checks that the node is inside the drop folder.
+func (a *FileRequestFilter) DeleteNode(ctx context.Context, in *tree.DeleteNodeRequest, opts ...client.CallOption) (*tree.DeleteNodeResponse, error) {
+	if _, e := a.checkDropTarget(ctx, "in", in.Node); e != nil {
+		return nil, e
+	}
+	return a.next.DeleteNode(ctx, in, opts...)
+}
+
+// PutObject checks the target folder and the size and extension limits of the link.
+func (a *FileRequestFilter) PutObject(ctx context.Context, node *tree.Node, reader io.Reader, requestData *PutRequestData) (int64, error) {
+	link, e := a.checkDropTarget(ctx, "in", node)
+	if e != nil {
+		return 0, e
+	}
+	if link != nil {
+		if e := checkFileRequestLimits(link, node.GetPath(), requestData.Size); e != nil {
+			return 0, e
+		}
+	}
+	return a.next.PutObject(ctx, node, reader, requestData)
+}
+
+// MultipartCreate checks the target folder and the extension limits of the link.
+func (a *FileRequestFilter) MultipartCreate(ctx context.Context, target *tree.Node, requestData *MultipartRequestData) (string, error) {
+	link, e := a.checkDropTarget(ctx, "in", target)
+	if e != nil {
+		return "", e
+	}
+	if link != nil {
+		if e := checkFileRequestLimits(link, target.GetPath(), 0); e != nil {
+			return "", e
+		}
+	}
+	return a.next.MultipartCreate(ctx, target, requestData)
+}
+
+// MultipartPutObjectPart checks the target folder and the size limit of the link.
+func (a *FileRequestFilter) MultipartPutObjectPart(ctx context.Context, target *tree.Node, uploadID string, partNumberMarker int, reader io.Reader, requestData *PutRequestData) (minio.ObjectPart, error) {
+	link, e := a.checkDropTarget(ctx, "in", target)
+	if e != nil {
+		return minio.ObjectPart{}, e
+	}
+	if link != nil {
+		if e := checkFileRequestLimits(link, target.GetPath(), requestData.Size); e != nil {
+			return minio.ObjectPart{}, e
+		}
+	}
+	return a.next.MultipartPutObjectPart(ctx, target, uploadID, partNumberMarker, reader, requestData)
+}
+
+// CopyObject checks that the copy target is inside the drop folder.
+func (a *FileRequestFilter) CopyObject(ctx context.Context, from *tree.Node, to *tree.Node, requestData *CopyRequestData) (int64, error) {
+	link, e := a.checkDropTarget(ctx, "to", to)
+	if e != nil {
+		return 0, e
+	}
+	if link != nil {
+		if e := checkFileRequestLimits(link, to.GetPath(), from.GetSize()); e != nil {
+			return 0, e
+		}
+	}
+	return a.next.CopyObject(ctx, from, to, requestData)
+}
+
+// checkDropTarget returns the link data if the branch is a file request link, and an error if the node
+// is not inside the folder of the current drop session.
+func (a *FileRequestFilter) checkDropTarget(ctx context.Context, identifier string, node *tree.Node) (*docstore.ShareDocument, error) {
+	branchInfo, ok := GetBranchInfo(ctx, identifier)
+	if !ok || branchInfo.Binary || branchInfo.Workspace.Scope != idm.WorkspaceScope_LINK {
+		return nil, nil
+	}
+	link, e := loadFileRequestLink(ctx, branchInfo.Workspace.UUID)
+	if e != nil {
+		log.Logger(ctx).Error("cannot load link to check file request", zap.String("workspace", branchInfo.Workspace.UUID), zap.Error(e))
+		return nil, errors.Forbidden(VIEWS_LIBRARY_NAME, "Cannot check this link, please retry later")
+	}
+	if link == nil {
+		return nil, nil
+	}
+	var dropId string
+	if meta, ok := metadata.FromContext(ctx); ok {
+		if d, ok := meta[common.XPydioDropSession]; ok {
+			dropId = d
+		} else if d, ok := meta[strings.ToLower(common.XPydioDropSession)]; ok {
+			dropId = d
+		}
+	}
+	if dropId == "" {
+		return nil, errors.Forbidden(VIEWS_LIBRARY_NAME, "Please start a drop session before uploading files on this link")
+	}
+	drop := loadFileRequestDrop(ctx, dropId)
+	if drop == nil || drop.LinkUuid != branchInfo.Workspace.UUID {
+		return nil, errors.Forbidden(VIEWS_LIBRARY_NAME, "Unknown drop session")
+	}
+	if drop.CompletedAt > 0 {
+		return nil, errors.Forbidden(VIEWS_LIBRARY_NAME, "This drop session is closed, please start a new one")
+	}
+	if !fileRequestPathAllowed(node.GetPath(), drop.FolderPath) {
+		return nil, errors.Forbidden(VIEWS_LIBRARY_NAME, "You are not allowed to write outside of your drop folder")
+	}
+	return link, nil
+}
+
+// fileRequestPathAllowed checks that a node path is strictly inside the drop folder.
+func fileRequestPathAllowed(nodePath string, folderPath string) bool {
+	folderPath = strings.Trim(folderPath, "/")
+	if folderPath == "" {
+		return false
+	}
+	return strings.HasPrefix(strings.Trim(nodePath, "/"), folderPath+"/")
+}
+
+// checkFileRequestLimits checks the size and extension of a file uploaded on a file request link.
+func checkFileRequestLimits(link *docstore.ShareDocument, nodePath string, size int64) error {
+	if link.UploadMaxSize > 0 && size > link.UploadMaxSize {
+		return errors.Forbidden(VIEWS_LIBRARY_NAME, "Upload limit is %d", link.UploadMaxSize)
+	}
+	if len(link.UploadExtensions) == 0 || path.Base(nodePath) == common.PYDIO_SYNC_HIDDEN_FILE_META {
+		return nil
+	}
+	// Beware, Ext function includes the leading dot
+	nodeExt := strings.ToLower(strings.TrimPrefix(path.Ext(nodePath), "."))
+	for _, e := range link.UploadExtensions {
+		if strings.ToLower(strings.TrimPrefix(e, ".")) == nodeExt {
+			return nil
+		}
+	}
+	return errors.Forbidden(VIEWS_LIBRARY_NAME, "Extension %s is not allowed!", path.Ext(nodePath))
+}
+
+// loadFileRequestLink loads the share document of a link workspace. It returns nil if the link is not a file request.
+// Errors are not cached, so that the link is checked again on next request.
+func loadFileRequestLink(ctx context.Context, workspaceId string) (*docstore.ShareDocument, error) {
+	if c, ok := fileRequestLinks.Get(workspaceId); ok {
+		return c.(*docstore.ShareDocument), nil
+	}
+	store := docstore.NewDocStoreClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_DOCSTORE, defaults.NewClient())
+	stream, e := store.ListDocuments(ctx, &docstore.ListDocumentsRequest{StoreID: common.DOCSTORE_ID_SHARES, Query: &docstore.DocumentQuery{
+		MetaQuery: "+REPOSITORY:\"" + workspaceId + "\" +SHARE_TYPE:minisite",
+	}})
+	if e != nil {
+		return nil, e
+	}
+	defer stream.Close()
+	var link *docstore.ShareDocument
+	r, e := stream.Recv()
+	if e != nil && e != io.EOF && e != io.ErrUnexpectedEOF {
+		return nil, e
+	}
+	if e == nil && r.Document != nil {
+		var data *docstore.ShareDocument
+		if er := json.Unmarshal([]byte(r.Document.Data), &data); er != nil {
+			return nil, er
+		}
+		if data != nil && data.FileRequest {
+			link = data
+		}
+	}
+	fileRequestLinks.Set(workspaceId, link, cache.DefaultExpiration)
+	return link, nil
+}
+
+// loadFileRequestDrop loads a drop session from the docstore.
+func loadFileRequestDrop(ctx context.Context, dropId string) *rest.FileRequestDrop {
+	if c, ok := fileRequestDrops.Get(dropId); ok {
+		return c.(*rest.FileRequestDrop)
+	}
+	store := docstore.NewDocStoreClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_DOCSTORE, defaults.NewClient())
+	resp, e := store.GetDocument(ctx, &docstore.GetDocumentRequest{StoreID: common.DOCSTORE_ID_FILE_REQUEST_DROPS, DocumentID: dropId})
+	if e != nil || resp.Document == nil {
+		return nil
+	}
+	var drop *rest.FileRequestDrop
+	if e := json.Unmarshal([]byte(resp.Document.Data), &drop); e != nil {
+		return nil
+	}
+	fileRequestDrops.Set(dropId, drop, cache.DefaultExpiration)
+	return drop
+}
@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+package views
+
+import (
+	"context"
+	"testing"
+
+	"github.com/micro/go-micro/errors"
+	"github.com/micro/go-micro/metadata"
+	"github.com/patrickmn/go-cache"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/proto/docstore"
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/rest"
+	"github.com/pydio/cells/common/proto/tree"
+)
+
+func TestFileRequestFilter(t *testing.T) {
+
+	fileRequestLinks.Set("link-ws", &docstore.ShareDocument{FileRequest: true, UploadMaxSize: 100, UploadExtensions: []string{"pdf", ".DOCX"}}, cache.NoExpiration)
+	fileRequestLinks.Set("other-link-ws", (*docstore.ShareDocument)(nil), cache.NoExpiration)
+	fileRequestDrops.Set("drop1", &rest.FileRequestDrop{Uuid: "drop1", LinkUuid: "link-ws", FolderPath: "pydiods1/requests/John Doe"}, cache.NoExpiration)
+	fileRequestDrops.Set("drop2", &rest.FileRequestDrop{Uuid: "drop2", LinkUuid: "link-ws", FolderPath: "pydiods1/requests/Jane", CompletedAt: 10}, cache.NoExpiration)
+
+	h := &FileRequestFilter{}
+	mock := NewHandlerMock()
+	h.SetNextHandler(mock)
+
+	linkCtx := func(drop string) context.Context {
+		ctx := WithBranchInfo(context.Background(), "in", BranchInfo{Workspace: idm.Workspace{UUID: "link-ws", Scope: idm.WorkspaceScope_LINK}})
+		if drop != "" {
+			ctx = metadata.NewContext(ctx, metadata.Metadata{common.XPydioDropSession: drop})
+		}
+		return ctx
+	}
+	put := func(ctx context.Context, p string, size int64) error {
+		_, e := h.PutObject(ctx, &tree.Node{Path: p}, nil, &PutRequestData{Size: size})
+		return e
+	}
+
+	Convey("Test uploads in drop folder", t, func() {
+		So(put(linkCtx("drop1"), "pydiods1/requests/John Doe/file.pdf", 10), ShouldBeNil)
+		So(put(linkCtx("drop1"), "pydiods1/requests/John Doe/sub/file.docx", 10), ShouldBeNil)
+		_, e := h.CreateNode(linkCtx("drop1"), &tree.CreateNodeRequest{Node: &tree.Node{Path: "pydiods1/requests/John Doe/sub"}})
+		So(e, ShouldBeNil)
+	})
+
+	Convey("Test uploads outside of drop folder", t, func() {
+		e := put(linkCtx("drop1"), "pydiods1/requests/Jane/file.pdf", 10)
+		So(e, ShouldNotBeNil)
+		So(errors.Parse(e.Error()).Code, ShouldEqual, 403)
+		So(put(linkCtx("drop1"), "pydiods1/requests/John Doe Jr/file.pdf", 10), ShouldNotBeNil)
+		So(put(linkCtx("drop1"), "pydiods1/requests/file.pdf", 10), ShouldNotBeNil)
+		_, e = h.DeleteNode(linkCtx("drop1"), &tree.DeleteNodeRequest{Node: &tree.Node{Path: "pydiods1/requests/Jane/file.pdf"}})
+		So(e, ShouldNotBeNil)
+	})
+
+	Convey("Test missing, unknown or closed drop session", t, func() {
+		So(put(linkCtx(""), "pydiods1/requests/John Doe/file.pdf", 10), ShouldNotBeNil)
+		So(put(linkCtx("unknown"), "pydiods1/requests/John Doe/file.pdf", 10), ShouldNotBeNil)
+		So(put(linkCtx("drop2"), "pydiods1/requests/Jane/file.pdf", 10), ShouldNotBeNil)
+	})
+
+	Convey("Test size and extensions limits", t, func() {
+		So(put(linkCtx("drop1"), "pydiods1/requests/John Doe/file.pdf", 1000), ShouldNotBeNil)
+		So(put(linkCtx("drop1"), "pydiods1/requests/John Doe/file.exe", 10), ShouldNotBeNil)
+		So(put(linkCtx("drop1"), "pydiods1/requests/John Doe/file.PDF", 10), ShouldBeNil)
+	})
+
+	Convey("Test other workspaces are not filtered", t, func() {
+		ctx := WithBranchInfo(context.Background(), "in", BranchInfo{Workspace: idm.Workspace{UUID: "other-link-ws", Scope: idm.WorkspaceScope_LINK}})
+		So(put(ctx, "pydiods1/any/file.exe", 1000), ShouldBeNil)
+		ctx = WithBranchInfo(context.Background(), "in", BranchInfo{Workspace: idm.Workspace{UUID: "ws", Scope: idm.WorkspaceScope_ADMIN}})
+		So(put(ctx, "pydiods1/any/file.exe", 1000), ShouldBeNil)
+	})
+}
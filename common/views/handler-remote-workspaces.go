@@ -0,0 +1,262 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package views
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/micro/go-micro/client"
+	"github.com/micro/go-micro/errors"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/tree"
+)
+
+// RemoteNodesProvider accesses the nodes of a RemoteWorkspace. Paths are relative to the root of the workspace.
+type RemoteNodesProvider interface {
+	ReadNode(ctx context.Context, p string) (*tree.Node, error)
+	ListNodes(ctx context.Context, p string) ([]*tree.Node, error)
+	GetObject(ctx context.Context, p string, offset int64, length int64) (io.ReadCloser, error)
+	PutObject(ctx context.Context, p string, reader io.Reader, size int64) (int64, error)
+	CreateFolder(ctx context.Context, p string) error
+	Delete(ctx context.Context, p string) error
+}
+
+// RemoteWorkspace is a workspace whose nodes are stored on another server.
+type RemoteWorkspace struct {
+	Workspace *idm.Workspace
+	Provider  RemoteNodesProvider
+	ReadOnly  bool
+}
+
+// RemoteWorkspacesLoader lists the remote workspaces mounted for the user of the context.
+// It is set by the services providing such workspaces.
+var RemoteWorkspacesLoader func(ctx context.Context) ([]*RemoteWorkspace, error)
+
+// RemoteWorkspacesHandler serves the nodes of the remote workspaces, found by their slug in the first segment of the path,
+// and appends these workspaces when listing the root.
+type RemoteWorkspacesHandler struct {
+	AbstractHandler
+}
+
+func NewRemoteWorkspacesHandler() *RemoteWorkspacesHandler {
+	return &RemoteWorkspacesHandler{}
+}
+
+// find returns the remote workspace targeted by a path, and the path relative to its root.
+func (r *RemoteWorkspacesHandler) find(ctx context.Context, nodePath string) (*RemoteWorkspace, string, bool) {
+	if RemoteWorkspacesLoader == nil {
+		return nil, "", false
+	}
+	parts := strings.SplitN(strings.Trim(nodePath, "/"), "/", 2)
+	if parts[0] == "" {
+		return nil, "", false
+	}
+	workspaces, e := RemoteWorkspacesLoader(ctx)
+	if e != nil {
+		log.Logger(ctx).Error("Cannot load remote workspaces", zap.Error(e))
+		return nil, "", false
+	}
+	for _, ws := range workspaces {
+		if ws.Workspace.Slug == parts[0] {
+			var rel string
+			if len(parts) > 1 {
+				rel = parts[1]
+			}
+			return ws, rel, true
+		}
+	}
+	return nil, "", false
+}
+
+// output prepends the slug of the workspace to the path of a remote node.
+func (r *RemoteWorkspacesHandler) output(ws *RemoteWorkspace, node *tree.Node) *tree.Node {
+	out := node.Clone()
+	out.Path = strings.TrimRight(ws.Workspace.Slug+"/"+strings.Trim(node.Path, "/"), "/")
+	if out.Uuid == "" {
+		out.Uuid = ws.Workspace.RootUUIDs[0] + ":" + strings.Trim(node.Path, "/")
+	}
+	if ws.ReadOnly {
+		out.SetMeta(common.META_FLAG_READONLY, "true")
+	}
+	return out
+}
+
+func (r *RemoteWorkspacesHandler) forbidden(ws *RemoteWorkspace) error {
+	return errors.Forbidden(VIEWS_LIBRARY_NAME, "This operation is not supported on workspace %s", ws.Workspace.Label)
+}
+
+func (r *RemoteWorkspacesHandler) ReadNode(ctx context.Context, in *tree.ReadNodeRequest, opts ...client.CallOption) (*tree.ReadNodeResponse, error) {
+	ws, rel, ok := r.find(ctx, in.Node.Path)
+	if !ok {
+		return r.next.ReadNode(ctx, in, opts...)
+	}
+	node, e := ws.Provider.ReadNode(ctx, rel)
+	if e != nil {
+		return nil, e
+	}
+	return &tree.ReadNodeResponse{Success: true, Node: r.output(ws, node)}, nil
+}
+
+// ListNodes lists the children of a remote folder, or appends the remote workspaces if the root is listed.
+func (r *RemoteWorkspacesHandler) ListNodes(ctx context.Context, in *tree.ListNodesRequest, opts ...client.CallOption) (tree.NodeProvider_ListNodesClient, error) {
+	if ws, rel, ok := r.find(ctx, in.Node.Path); ok {
+		nodes, e := ws.Provider.ListNodes(ctx, rel)
+		if e != nil {
+			return nil, e
+		}
+		s := NewWrappingStreamer()
+		go func() {
+			defer s.Close()
+			for _, n := range nodes {
+				if in.FilterType == tree.NodeType_LEAF && !n.IsLeaf() || in.FilterType == tree.NodeType_COLLECTION && n.IsLeaf() {
+					continue
+				}
+				s.Send(&tree.ListNodesResponse{Node: r.output(ws, n)})
+			}
+		}()
+		return s, nil
+	}
+
+	if strings.Trim(in.Node.Path, "/") != "" || RemoteWorkspacesLoader == nil {
+		return r.next.ListNodes(ctx, in, opts...)
+	}
+	stream, err := r.next.ListNodes(ctx, in, opts...)
+	if err != nil {
+		return nil, err
+	}
+	s := NewWrappingStreamer()
+	go func() {
+		defer stream.Close()
+		defer s.Close()
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				if err != io.EOF && err != io.ErrUnexpectedEOF {
+					s.SendError(err)
+				}
+				break
+			}
+			if resp == nil {
+				continue
+			}
+			s.Send(resp)
+		}
+		workspaces, e := RemoteWorkspacesLoader(ctx)
+		if e != nil {
+			log.Logger(ctx).Error("Cannot load remote workspaces", zap.Error(e))
+			return
+		}
+		for _, ws := range workspaces {
+			node := &tree.Node{
+				Type: tree.NodeType_COLLECTION,
+				Uuid: ws.Workspace.RootUUIDs[0],
+				Path: ws.Workspace.Slug,
+			}
+			node.SetMeta(common.META_FLAG_WORKSPACE_SCOPE, ws.Workspace.Scope.String())
+			s.Send(&tree.ListNodesResponse{Node: node})
+		}
+	}()
+	return s, nil
+}
+
+func (r *RemoteWorkspacesHandler) GetObject(ctx context.Context, node *tree.Node, requestData *GetRequestData) (io.ReadCloser, error) {
+	ws, rel, ok := r.find(ctx, node.Path)
+	if !ok {
+		return r.next.GetObject(ctx, node, requestData)
+	}
+	return ws.Provider.GetObject(ctx, rel, requestData.StartOffset, requestData.Length)
+}
+
+func (r *RemoteWorkspacesHandler) PutObject(ctx context.Context, node *tree.Node, reader io.Reader, requestData *PutRequestData) (int64, error) {
+	ws, rel, ok := r.find(ctx, node.Path)
+	if !ok {
+		return r.next.PutObject(ctx, node, reader, requestData)
+	}
+	if ws.ReadOnly || rel == "" {
+		return 0, r.forbidden(ws)
+	}
+	return ws.Provider.PutObject(ctx, rel, reader, requestData.Size)
+}
+
+func (r *RemoteWorkspacesHandler) CreateNode(ctx context.Context, in *tree.CreateNodeRequest, opts ...client.CallOption) (*tree.CreateNodeResponse, error) {
+	ws, rel, ok := r.find(ctx, in.Node.Path)
+	if !ok {
+		return r.next.CreateNode(ctx, in, opts...)
+	}
+	if ws.ReadOnly || rel == "" || in.Node.IsLeaf() {
+		return nil, r.forbidden(ws)
+	}
+	if e := ws.Provider.CreateFolder(ctx, rel); e != nil {
+		return nil, e
+	}
+	node, e := ws.Provider.ReadNode(ctx, rel)
+	if e != nil {
+		return nil, e
+	}
+	return &tree.CreateNodeResponse{Success: true, Node: r.output(ws, node)}, nil
+}
+
+func (r *RemoteWorkspacesHandler) DeleteNode(ctx context.Context, in *tree.DeleteNodeRequest, opts ...client.CallOption) (*tree.DeleteNodeResponse, error) {
+	ws, rel, ok := r.find(ctx, in.Node.Path)
+	if !ok {
+		return r.next.DeleteNode(ctx, in, opts...)
+	}
+	if ws.ReadOnly || rel == "" {
+		return nil, r.forbidden(ws)
+	}
+	if e := ws.Provider.Delete(ctx, rel); e != nil {
+		return nil, e
+	}
+	return &tree.DeleteNodeResponse{Success: true}, nil
+}
+
+func (r *RemoteWorkspacesHandler) UpdateNode(ctx context.Context, in *tree.UpdateNodeRequest, opts ...client.CallOption) (*tree.UpdateNodeResponse, error) {
+	if ws, _, ok := r.find(ctx, in.From.Path); ok {
+		return nil, r.forbidden(ws)
+	}
+	if ws, _, ok := r.find(ctx, in.To.Path); ok {
+		return nil, r.forbidden(ws)
+	}
+	return r.next.UpdateNode(ctx, in, opts...)
+}
+
+func (r *RemoteWorkspacesHandler) CopyObject(ctx context.Context, from *tree.Node, to *tree.Node, requestData *CopyRequestData) (int64, error) {
+	if ws, _, ok := r.find(ctx, from.Path); ok {
+		return 0, r.forbidden(ws)
+	}
+	if ws, _, ok := r.find(ctx, to.Path); ok {
+		return 0, r.forbidden(ws)
+	}
+	return r.next.CopyObject(ctx, from, to, requestData)
+}
+
+func (r *RemoteWorkspacesHandler) MultipartCreate(ctx context.Context, target *tree.Node, requestData *MultipartRequestData) (string, error) {
+	if ws, _, ok := r.find(ctx, target.Path); ok {
+		return "", r.forbidden(ws)
+	}
+	return r.next.MultipartCreate(ctx, target, requestData)
+}
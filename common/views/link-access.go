@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package views
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/micro/go-micro/metadata"
+	"github.com/pborman/uuid"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/micro"
+	"github.com/pydio/cells/common/proto/docstore"
+	"github.com/pydio/cells/common/proto/rest"
+	"github.com/pydio/cells/common/service/context"
+)
+
+// RecordLinkAccess stores an access to a public link in the docstore. Date, remote address and
+// user agent are read from the context metadata. Entries are owned by the link and not indexed,
+// as a busy link can log far more entries than a docstore search returns.
+func RecordLinkAccess(ctx context.Context, entry *rest.ShareLinkAccess) error {
+	if entry.Uuid == "" {
+		entry.Uuid = uuid.New()
+	}
+	if entry.Time == 0 {
+		entry.Time = time.Now().Unix()
+	}
+	if meta, ok := metadata.FromContext(ctx); ok {
+		entry.RemoteAddress = meta[servicecontext.HttpMetaRemoteAddress]
+		entry.UserAgent = meta[servicecontext.HttpMetaUserAgent]
+	}
+	store := docstore.NewDocStoreClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_DOCSTORE, defaults.NewClient())
+	data, _ := json.Marshal(entry)
+	_, e := store.PutDocument(context.Background(), &docstore.PutDocumentRequest{
+		StoreID:    common.DOCSTORE_ID_LINK_ACCESS_LOG,
+		DocumentID: entry.Uuid,
+		Document: &docstore.Document{
+			ID:    entry.Uuid,
+			Owner: entry.LinkUuid,
+			Type:  docstore.DocumentType_JSON,
+			Data:  string(data),
+		},
+	})
+	return e
+}
+
+// LinkAccessRecipient finds the target user of the link whose key is passed in the X-Pydio-Link-Recipient
+// header. It returns an empty key for anonymous visitors.
+func LinkAccessRecipient(ctx context.Context, link *docstore.ShareDocument) (string, *docstore.TargetUserEntry) {
+	meta, ok := metadata.FromContext(ctx)
+	if !ok {
+		return "", nil
+	}
+	key, ok := meta[common.XPydioLinkRecipient]
+	if !ok {
+		key = meta[strings.ToLower(common.XPydioLinkRecipient)]
+	}
+	if key == "" || link.TargetUsers == nil {
+		return "", nil
+	}
+	if t, ok := link.TargetUsers[key]; ok {
+		return key, t
+	}
+	return "", nil
+}
+
+// linkAccessAction tells a download from a preview: the web client only asks for an attachment
+// disposition in the presigned url when downloading the file.
+func linkAccessAction(ctx context.Context) rest.ShareLinkAccessAction {
+	if meta, ok := metadata.FromContext(ctx); ok {
+		if strings.Contains(strings.ToLower(meta[servicecontext.HttpMetaRequestURI]), "response-content-disposition=attachment") {
+			return rest.ShareLinkAccessAction_DOWNLOAD
+		}
+	}
+	return rest.ShareLinkAccessAction_PREVIEW
+}
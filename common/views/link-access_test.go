@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package views
+
+import (
+	"context"
+	"testing"
+
+	"github.com/micro/go-micro/metadata"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/proto/docstore"
+	"github.com/pydio/cells/common/proto/rest"
+	"github.com/pydio/cells/common/service/context"
+)
+
+func TestLinkAccess(t *testing.T) {
+
+	Convey("Test download detection", t, func() {
+		So(linkAccessAction(context.Background()), ShouldEqual, rest.ShareLinkAccessAction_PREVIEW)
+		ctx := metadata.NewContext(context.Background(), map[string]string{
+			servicecontext.HttpMetaRequestURI: "/io/link/file.pdf?X-Amz-Date=20181011&response-content-disposition=attachment%3B%20filename%3Dfile.pdf",
+		})
+		So(linkAccessAction(ctx), ShouldEqual, rest.ShareLinkAccessAction_DOWNLOAD)
+		ctx = metadata.NewContext(context.Background(), map[string]string{
+			servicecontext.HttpMetaRequestURI: "/io/link/image.png?X-Amz-Date=20181011",
+		})
+		So(linkAccessAction(ctx), ShouldEqual, rest.ShareLinkAccessAction_PREVIEW)
+	})
+
+	Convey("Test link recipient", t, func() {
+		link := &docstore.ShareDocument{TargetUsers: map[string]*docstore.TargetUserEntry{"u1": {Display: "John"}}}
+		key, target := LinkAccessRecipient(context.Background(), link)
+		So(key, ShouldBeEmpty)
+		So(target, ShouldBeNil)
+
+		ctx := metadata.NewContext(context.Background(), map[string]string{common.XPydioLinkRecipient: "u1"})
+		key, target = LinkAccessRecipient(ctx, link)
+		So(key, ShouldEqual, "u1")
+		So(target.Display, ShouldEqual, "John")
+
+		ctx = metadata.NewContext(context.Background(), map[string]string{"x-pydio-link-recipient": "unknown"})
+		key, target = LinkAccessRecipient(ctx, link)
+		So(key, ShouldBeEmpty)
+		So(target, ShouldBeNil)
+	})
+}
@@ -58,6 +58,9 @@ func NewStandardRouter(options RouterOptions) *Router {
 		},
 	}
 	handlers = append(handlers, NewArchiveHandler())
+	if !options.AdminView {
+		handlers = append(handlers, NewRemoteWorkspacesHandler())
+	}
 	handlers = append(handlers, NewPathWorkspaceHandler())
 	handlers = append(handlers, NewPathMultipleRootsHandler())
 	if !options.BrowseVirtualNodes && !options.AdminView {
@@ -84,6 +87,7 @@ func NewStandardRouter(options RouterOptions) *Router {
 	handlers = append(handlers, &PutHandler{})
 	if !options.AdminView {
 		handlers = append(handlers, &UploadLimitFilter{})
+		handlers = append(handlers, &FileRequestFilter{})
 		handlers = append(handlers, &AclLockFilter{})
 		handlers = append(handlers, &AclQuotaFilter{})
 	}
@@ -37,6 +37,9 @@ const (
 	AUDIT_LOGIN_POLICY_DENIAL = "3"
 	AUDIT_INVALID_JWT         = "4"
 	AUDIT_LOCK_USER           = "5"
+	AUDIT_PAT_CREATE          = "6"
+	AUDIT_PAT_REVOKE          = "7"
+	AUDIT_SESSION_REVOKE      = "8"
 
 	// Tree events
 	AUDIT_NODE_CREATE       = "11"
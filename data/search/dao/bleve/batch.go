@@ -116,6 +116,15 @@ func (b *Batch) LoadIndexableNode(indexNode *IndexableNode, excludes map[string]
 		}
 	}
 	indexNode.Meta = indexNode.AllMetaDeserialized(excludes)
+	delete(indexNode.Meta, common.META_NAMESPACE_INHERITED_ORIGINS)
+	// Typed namespaces are indexed as numbers or dates to support range queries
+	for name, ns := range b.NamespacesProvider().Namespaces() {
+		if v, ok := indexNode.Meta[name]; ok {
+			if def, e := meta.ParseNamespaceDefinition(ns); e == nil && def != nil {
+				indexNode.Meta[name] = def.IndexValue(v)
+			}
+		}
+	}
 	indexNode.ModifTime = time.Unix(indexNode.MTime, 0)
 	var basename string
 	indexNode.GetMeta("name", &basename)
@@ -47,6 +47,9 @@ type SearchServer struct {
 	TreeClient       tree.NodeProviderClient
 	NsProvider       *meta.NamespacesProvider
 	ReIndexThrottler chan struct{}
+
+	pendingLock    sync.Mutex
+	pendingFolders map[string]struct{}
 }
 
 // CreateNodeChangeSubscriber that will treat events for the meta server
@@ -115,6 +118,10 @@ func (s *SearchServer) processEvent(ctx context.Context, e *tree.NodeChangeEvent
 			break
 		}
 		s.Engine.IndexNode(ctx, e.Target, true, excludes)
+		// Descendants may inherit the values set on a folder
+		if meta.ChangesInheritable(e, meta.InheritableNamespaces(s.NamespacesProvider().Namespaces())) {
+			s.reindexInheritingChildren(ctx, e.Target, excludes)
+		}
 		break
 	case tree.NodeChangeEvent_UPDATE_CONTENT:
 		// We may have to store the metadata again
@@ -230,6 +237,12 @@ func (s *SearchServer) ReindexFolder(c context.Context, node *tree.Node, exclude
 	defer func() {
 		<-s.ReIndexThrottler
 	}()
+	s.reindexFolder(c, node, excludes)
+
+}
+
+func (s *SearchServer) reindexFolder(c context.Context, node *tree.Node, excludes map[string]struct{}) {
+
 	bg := context.Background()
 	dsStream, err := s.TreeClient.ListNodes(bg, &tree.ListNodesRequest{
 		Node:      node,
@@ -254,3 +267,38 @@ func (s *SearchServer) ReindexFolder(c context.Context, node *tree.Node, exclude
 	log.Logger(c).Info(fmt.Sprintf("Search Server re-indexed %d folders", count))
 
 }
+
+// reindexInheritingChildren re-indexes in background the children of a folder whose metadata changed, so that
+// the effective values of inheritable namespaces are up-to-date. Changes received while the folder is waiting
+// for its re-indexation are coalesced.
+func (s *SearchServer) reindexInheritingChildren(c context.Context, node *tree.Node, excludes map[string]struct{}) {
+
+	s.pendingLock.Lock()
+	if s.pendingFolders == nil {
+		s.pendingFolders = make(map[string]struct{})
+	}
+	if _, pending := s.pendingFolders[node.Uuid]; pending {
+		s.pendingLock.Unlock()
+		return
+	}
+	s.pendingFolders[node.Uuid] = struct{}{}
+	s.pendingLock.Unlock()
+
+	go func() {
+		s.ReIndexThrottler <- struct{}{}
+		defer func() {
+			<-s.ReIndexThrottler
+		}()
+		// Changes received from now on require a new re-indexation
+		s.pendingLock.Lock()
+		delete(s.pendingFolders, node.Uuid)
+		s.pendingLock.Unlock()
+
+		resp, e := s.TreeClient.ReadNode(c, &tree.ReadNodeRequest{Node: &tree.Node{Uuid: node.Uuid}})
+		if e != nil || resp.Node.IsLeaf() {
+			return
+		}
+		s.reindexFolder(c, resp.Node, excludes)
+	}()
+
+}
@@ -36,12 +36,14 @@ import (
 	"github.com/pydio/cells/common/proto/rest"
 	"github.com/pydio/cells/common/proto/tree"
 	"github.com/pydio/cells/common/service"
+	"github.com/pydio/cells/common/utils/permissions"
 	"github.com/pydio/cells/common/views"
 )
 
 type Handler struct {
-	router *views.Router
-	client tree.SearcherClient
+	router     *views.Router
+	client     tree.SearcherClient
+	treeClient tree.NodeProviderClient
 }
 
 // SwaggerTags list the names of the service tags declared in the swagger json implemented by this service
@@ -68,6 +70,13 @@ func (s *Handler) getClient() tree.SearcherClient {
 	return s.client
 }
 
+func (s *Handler) getTreeClient() tree.NodeProviderClient {
+	if s.treeClient == nil {
+		s.treeClient = tree.NewNodeProviderClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_TREE, defaults.NewClient())
+	}
+	return s.treeClient
+}
+
 func (s *Handler) Nodes(req *restful.Request, rsp *restful.Response) {
 
 	ctx := req.Request.Context()
@@ -145,6 +154,13 @@ func (s *Handler) Nodes(req *restful.Request, rsp *restful.Response) {
 
 		defer sClient.Close()
 
+		// Policies on node metadata apply to search results as well
+		var attributesLoader *views.NodesMetaLoader
+		if permissions.HasAttributesPolicies(ctx) {
+			attributesLoader = views.NewNodesMetaLoader(ctx)
+			defer attributesLoader.Close()
+		}
+
 		for {
 			resp, rErr := sClient.Recv()
 			if resp == nil {
@@ -153,6 +169,9 @@ func (s *Handler) Nodes(req *restful.Request, rsp *restful.Response) {
 				return err
 			}
 			respNode := resp.Node
+			if attributesLoader != nil && !s.attributesAllow(ctx, attributesLoader, respNode) {
+				continue
+			}
 			for r, p := range nodesPrefixes {
 				if strings.HasPrefix(respNode.Path, r+"/") {
 					log.Logger(ctx).Debug("Response", zap.String("node", respNode.Path))
@@ -189,3 +208,13 @@ func (s *Handler) Nodes(req *restful.Request, rsp *restful.Response) {
 	rsp.WriteEntity(result)
 
 }
+
+// attributesAllow evaluates the policies on node metadata for a search result and its parents.
+func (s *Handler) attributesAllow(ctx context.Context, loader *views.NodesMetaLoader, node *tree.Node) bool {
+	branch, err := tree.BuildAncestorsList(ctx, s.getTreeClient(), node)
+	if err != nil || len(branch) == 0 {
+		branch = []*tree.Node{node}
+	}
+	accessList, _ := ctx.Value(views.CtxUserAccessListKey{}).(*permissions.AccessList)
+	return views.NodeAccessAllowed(ctx, accessList, permissions.FlagRead, loader, branch...)
+}
@@ -18,6 +18,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/auth/mfa"
 	"github.com/pydio/cells/common/config"
 	"github.com/pydio/cells/common/log"
 	"github.com/pydio/cells/common/proto/rest"
@@ -35,7 +36,22 @@ func LoginPasswordAuth(middleware frontend.AuthMiddleware) frontend.AuthMiddlewa
 		}
 
 		nonce := uuid.New()
-		respMap, err := GrantTypeAccess(req.Request.Context(), nonce, "", in.AuthInfo["login"], in.AuthInfo["password"], false)
+		ctx := req.Request.Context()
+		if code, ok := in.AuthInfo["mfaCode"]; ok && code != "" {
+			ctx = mfa.WithCode(ctx, code)
+		}
+		password := in.AuthInfo["password"]
+		if encoded, ok := in.AuthInfo["webauthnAssertion"]; ok && encoded != "" {
+			assertion, err := mfa.ParseAssertion(encoded)
+			if err != nil {
+				return err
+			}
+			ctx = mfa.WithAssertion(ctx, assertion)
+			if password == "" {
+				password = mfa.WebauthnPasswordless
+			}
+		}
+		respMap, err := GrantTypeAccess(ctx, nonce, "", in.AuthInfo["login"], password, false)
 		if err != nil {
 			return err
 		}
@@ -146,6 +162,13 @@ func GrantTypeAccess(ctx context.Context, nonce string, refreshToken string, log
 		}
 	}
 
+	if code := mfa.CodeFromContext(ctx); code != "" {
+		httpReq.Header.Add(common.XPydioMfaCode, code)
+	}
+	if assertion := mfa.AssertionFromContext(ctx); assertion != nil {
+		httpReq.Header.Add(common.XPydioWebauthnAssertion, assertion.Encode())
+	}
+
 	httpReq.Header.Add("Content-Type", "application/x-www-form-urlencoded") // Important our dex API does not yet support json payload.
 	httpReq.Header.Add("Cache-Control", "no-cache")
 	httpReq.Header.Add("Authorization", basic)
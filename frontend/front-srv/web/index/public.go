@@ -21,9 +21,11 @@ import (
 	"github.com/pydio/cells/common/micro"
 	"github.com/pydio/cells/common/proto/docstore"
 	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/rest"
 	"github.com/pydio/cells/common/service/context"
 	"github.com/pydio/cells/common/service/frontend"
 	"github.com/pydio/cells/common/service/proto"
+	"github.com/pydio/cells/common/views"
 )
 
 type PublicHandler struct {
@@ -38,7 +40,7 @@ func NewPublicHandler() *PublicHandler {
 	return h
 }
 
-func (h *PublicHandler) computeTplConf(ctx context.Context, linkId string) (statusCode int, tplConf *TplConf) {
+func (h *PublicHandler) computeTplConf(ctx context.Context, linkId string, recipient string) (statusCode int, tplConf *TplConf) {
 
 	url := config.Get("defaults", "url").String("")
 	tplConf = &TplConf{
@@ -58,6 +60,16 @@ func (h *PublicHandler) computeTplConf(ctx context.Context, linkId string) (stat
 		return 404, tplConf
 	}
 
+	// Check approval status
+	if linkData.PendingApproval {
+		tplConf.ErrorMessage = "This link is waiting for approval. Please try again later."
+		return 404, tplConf
+	}
+	if linkData.DisabledAt > 0 {
+		tplConf.ErrorMessage = "This link has been disabled. Please contact the person who sent it to you."
+		return 404, tplConf
+	}
+
 	// Check expiration time
 	if linkData.ExpireTime > 0 && time.Now().After(time.Unix(linkData.ExpireTime, 0)) {
 		tplConf.ErrorMessage = "This link has expired. Please contact the person who sent it to you."
@@ -117,6 +129,23 @@ func (h *PublicHandler) computeTplConf(ctx context.Context, linkId string) (stat
 		"MINISITE":            linkId,
 		"START_REPOSITORY":    linkData.RepositoryId,
 	}
+	if linkData.FileRequest {
+		// Uploaders must fill a name/email form, their drop session is then sent in the X-Pydio-Drop-Session header
+		startParameters["FILE_REQUEST"] = map[string]interface{}{
+			"MAX_FILE_SIZE":      linkData.UploadMaxSize,
+			"ALLOWED_EXTENSIONS": linkData.UploadExtensions,
+		}
+	}
+	// Target users open the link with their own key, it is then sent in the X-Pydio-Link-Recipient header
+	access := &rest.ShareLinkAccess{LinkUuid: linkData.RepositoryId, Action: rest.ShareLinkAccessAction_OPEN}
+	if t, ok := linkData.TargetUsers[recipient]; ok && recipient != "" {
+		startParameters["TARGET_USER"] = recipient
+		access.Recipient = recipient
+		access.RecipientDisplay = t.Display
+	}
+	if e := views.RecordLinkAccess(ctx, access); e != nil {
+		log.Logger(ctx).Error("Cannot record public link access", zap.Error(e))
+	}
 	var uField string
 	if linkData.PreLogUser != "" {
 		startParameters["PRELOG_USER"] = linkData.PreLogUser
@@ -146,7 +175,8 @@ func (h *PublicHandler) computeTplConf(ctx context.Context, linkId string) (stat
 func (h *PublicHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	link := mux.Vars(r)["link"]
-	status, tplConf := h.computeTplConf(r.Context(), link)
+	ctx := servicecontext.HttpRequestInfoToMetadata(r.Context(), r)
+	status, tplConf := h.computeTplConf(ctx, link, r.URL.Query().Get("u"))
 	if status != 200 {
 		w.WriteHeader(status)
 		h.error.Execute(w, tplConf)
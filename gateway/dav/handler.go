@@ -39,6 +39,7 @@ import (
 	"github.com/pydio/cells/common/auth/claim"
 	"github.com/pydio/cells/common/log"
 	"github.com/pydio/cells/common/views"
+	"github.com/pydio/cells/idm/ocm"
 )
 
 type ValidUser struct {
@@ -97,5 +98,7 @@ func newHandler(ctx context.Context, router *views.Router) http.Handler {
 		},
 	}
 
-	return basicAuthenticator.Wrap(logRequest(dav))
+	// Federated shares sent to other servers are accessed with their shared secret
+	h := logRequest(dav)
+	return servicecontext.HttpMetaExtractorWrapper(ocm.WrapBearerAuth(h, basicAuthenticator.Wrap(h)))
 }
@@ -90,6 +90,26 @@ var (
 			header_upstream X-Real-IP {remote}
 			header_upstream X-Forwarded-Proto {scheme}
 		}
+		proxy /scim/ {{.Scim | urls}} {
+			header_upstream Host {host}
+			header_upstream X-Real-IP {remote}
+			header_upstream X-Forwarded-Proto {scheme}
+		}
+		proxy /ocm/ {{.Ocm | urls}} {
+			header_upstream Host {host}
+			header_upstream X-Real-IP {remote}
+			header_upstream X-Forwarded-Proto {scheme}
+		}
+		proxy /ocm-provider {{.Ocm | urls}} {
+			header_upstream Host {host}
+			header_upstream X-Real-IP {remote}
+			header_upstream X-Forwarded-Proto {scheme}
+		}
+		proxy /.well-known/ocm {{.Ocm | urls}} {
+			header_upstream Host {host}
+			header_upstream X-Real-IP {remote}
+			header_upstream X-Forwarded-Proto {scheme}
+		}
 
 		proxy /public/ {{.FrontPlugins | urls}} {
 			header_upstream Host {host}
@@ -133,6 +153,9 @@ var (
 			if {path} not_starts_with "/ws/"
 			if {path} not_starts_with "/plug/"
 			if {path} not_starts_with "/dav/"
+			if {path} not_starts_with "/scim/"
+			if {path} not_starts_with "/ocm"
+			if {path} not_starts_with "/.well-known/ocm"
 			{{range .PluginPathes}}
 			if {path} not_starts_with "{{.}}"
 			{{end}}
@@ -163,6 +186,8 @@ var (
 		WebSocket    string
 		FrontPlugins string
 		DAV          string
+		Scim         string
+		Ocm          string
 		// Dedicated log file for caddy errors to ease debugging
 		Logs string
 		// Caddy compliant TLS string, either "self_signed", a valid email for Let's encrypt managed certificate or paths to "cert key"
@@ -180,6 +205,8 @@ var (
 		WebSocket:    common.SERVICE_GATEWAY_NAMESPACE_ + common.SERVICE_WEBSOCKET,
 		FrontPlugins: common.SERVICE_WEB_NAMESPACE_ + common.SERVICE_FRONT_STATICS,
 		DAV:          common.SERVICE_GATEWAY_DAV,
+		Scim:         common.SERVICE_WEB_NAMESPACE_ + common.SERVICE_SCIM,
+		Ocm:          common.SERVICE_WEB_NAMESPACE_ + common.SERVICE_OCM,
 	}
 )
 
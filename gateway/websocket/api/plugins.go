@@ -34,9 +34,11 @@ import (
 	"github.com/pydio/cells/common/micro"
 	"github.com/pydio/cells/common/plugins"
 	"github.com/pydio/cells/common/proto/activity"
+	"github.com/pydio/cells/common/proto/auth"
 	chat2 "github.com/pydio/cells/common/proto/chat"
 	"github.com/pydio/cells/common/proto/idm"
 	"github.com/pydio/cells/common/proto/jobs"
+	"github.com/pydio/cells/common/proto/presence"
 	"github.com/pydio/cells/common/proto/tree"
 	"github.com/pydio/cells/common/service"
 	"github.com/pydio/cells/common/service/context"
@@ -133,6 +135,23 @@ func init() {
 					return nil
 				})
 
+				brok.Subscribe(common.TOPIC_PRESENCE_EVENT, func(publication broker.Publication) error {
+					var event presence.PresenceEvent
+					if e := proto.Unmarshal(publication.Message().Body, &event); e == nil {
+						return ws.HandlePresenceEvent(publicationContext(publication), &event)
+					}
+					return nil
+				})
+
+				brok.Subscribe(common.TOPIC_SESSION_EVENT, func(publication broker.Publication) error {
+					var event auth.SessionEvent
+					if e := proto.Unmarshal(publication.Message().Body, &event); e == nil {
+						ws.HandleSessionEvent(publicationContext(publication), &event)
+						return chat.HandleSessionEvent(publicationContext(publication), &event)
+					}
+					return nil
+				})
+
 				gin.SetMode(gin.ReleaseMode)
 				gin.DisableConsoleColor()
 				Server := gin.New()
@@ -145,6 +164,14 @@ func init() {
 					chat.Websocket.HandleRequest(c.Writer, c.Request)
 				})
 
+				Server.GET("/sse", func(c *gin.Context) {
+					ws.HandleSSE(c.Writer, c.Request)
+				})
+
+				Server.POST("/sse/ticket", func(c *gin.Context) {
+					ws.HandleSSETicket(c.Writer, c.Request)
+				})
+
 				hd := srv.NewHandler(Server)
 
 				err := srv.Handle(hd)
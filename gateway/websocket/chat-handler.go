@@ -29,14 +29,18 @@ import (
 
 	"context"
 
+	"github.com/micro/go-micro/metadata"
 	"github.com/micro/protobuf/jsonpb"
 	"go.uber.org/zap"
 	"gopkg.in/olahol/melody.v1"
 
+	chat2 "github.com/pydio/cells/broker/chat"
 	"github.com/pydio/cells/common"
 	"github.com/pydio/cells/common/auth"
+	"github.com/pydio/cells/common/auth/claim"
 	"github.com/pydio/cells/common/log"
 	"github.com/pydio/cells/common/micro"
+	auth2 "github.com/pydio/cells/common/proto/auth"
 	"github.com/pydio/cells/common/proto/chat"
 	"github.com/pydio/cells/common/views"
 )
@@ -164,8 +168,13 @@ func (c *ChatHandler) InitHandlers(serviceCtx context.Context) {
 					break
 				}
 				chatClient := c.getChatClient()
-				// List existing Messages
-				stream, e2 := chatClient.ListMessages(ctx, &chat.ListMessagesRequest{RoomUuid: foundRoom.Uuid})
+				// List existing Messages, optionally paginated or inside a thread
+				listRequest := &chat.ListMessagesRequest{}
+				if chatMsg.History != nil {
+					listRequest = chatMsg.History
+				}
+				listRequest.RoomUuid = foundRoom.Uuid
+				stream, e2 := chatClient.ListMessages(ctx, listRequest)
 				if e2 == nil {
 					defer stream.Close()
 					for {
@@ -185,6 +194,9 @@ func (c *ChatHandler) InitHandlers(serviceCtx context.Context) {
 				message := chatMsg.Message
 				message.Author = userName
 				message.Timestamp = time.Now().Unix()
+				if len(message.Attachments) > 0 {
+					chat2.ResolveAttachments(c.userContext(session), views.NewUuidRouter(views.RouterOptions{}), message)
+				}
 				_, e := c.getChatClient().PostMessage(ctx, &chat.PostMessageRequest{
 					Messages: []*chat.ChatMessage{message},
 				})
@@ -205,6 +217,52 @@ func (c *ChatHandler) InitHandlers(serviceCtx context.Context) {
 					}
 				}
 
+			case chat.WsMessageType_EDIT_MSG:
+
+				log.Logger(serviceCtx).Debug("Edit", zap.Any("msg", chatMsg))
+				message := chatMsg.Message
+				if message == nil || !c.roomsHaveValue(session, message.RoomUuid) {
+					break
+				}
+				message.Author = userName
+				if len(message.Attachments) > 0 {
+					chat2.ResolveAttachments(c.userContext(session), views.NewUuidRouter(views.RouterOptions{}), message)
+				}
+				_, e := c.getChatClient().UpdateMessage(ctx, &chat.UpdateMessageRequest{Message: message})
+				if e != nil {
+					log.Logger(ctx).Error("Error while editing message", zap.Any("msg", message), zap.Error(e))
+				}
+
+			case chat.WsMessageType_REACT_MSG:
+
+				log.Logger(serviceCtx).Debug("React", zap.Any("msg", chatMsg))
+				reaction := chatMsg.Reaction
+				if reaction == nil || !c.roomsHaveValue(session, reaction.RoomUuid) {
+					break
+				}
+				reaction.User = userName
+				if _, e := c.getChatClient().ReactToMessage(ctx, reaction); e != nil {
+					log.Logger(ctx).Error("Error while reacting to message", zap.Any("msg", reaction), zap.Error(e))
+				}
+
+			case chat.WsMessageType_READ_ROOM:
+
+				foundRoom, e1 := c.FindOrCreateRoom(ctx, chatMsg.Room, false)
+				if e1 != nil || foundRoom == nil {
+					break
+				}
+				var lastMessage string
+				if chatMsg.Message != nil {
+					lastMessage = chatMsg.Message.Uuid
+				}
+				if _, e := c.getChatClient().MarkRoomRead(ctx, &chat.MarkRoomReadRequest{
+					RoomUuid:    foundRoom.Uuid,
+					User:        userName,
+					LastMessage: lastMessage,
+				}); e != nil {
+					log.Logger(ctx).Error("Error while marking room as read", zap.Error(e))
+				}
+
 			}
 
 		} else {
@@ -215,6 +273,24 @@ func (c *ChatHandler) InitHandlers(serviceCtx context.Context) {
 
 }
 
+// HandleSessionEvent disconnects the chat sessions authenticated by revoked sessions.
+func (c *ChatHandler) HandleSessionEvent(ctx context.Context, event *auth2.SessionEvent) error {
+	return CloseRevokedSessions(c.Websocket, NewRevokedSessions(event))
+}
+
+// userContext builds a context carrying the session user claims, to perform ACL-checked requests on behalf of the user.
+func (c *ChatHandler) userContext(session *melody.Session) context.Context {
+	ctx := context.Background()
+	claims, ok := session.Get(SessionClaimsKey)
+	if !ok || claims == nil {
+		return ctx
+	}
+	cl := claims.(claim.Claims)
+	ctx = context.WithValue(ctx, claim.ContextKey, cl)
+	ctx = metadata.NewContext(ctx, map[string]string{common.PYDIO_CONTEXT_USER_KEY: cl.Name})
+	return auth.ToMetadata(ctx, cl)
+}
+
 func (c *ChatHandler) roomsHaveValue(session *melody.Session, roomUuid string) bool {
 	if key, ok := session.Get(SessionRoomKey); ok && key != nil {
 		rooms := key.([]string)
@@ -337,6 +413,16 @@ func (c *ChatHandler) BroadcastChatMessage(ctx context.Context, msg *chat.ChatEv
 				Message: msg.Message,
 			}
 			marshaller.Marshal(buff, wsMessage)
+		} else if msg.Details == "UPDATE" || msg.Details == "REACT" {
+			wsType := chat.WsMessageType_EDIT_MSG
+			if msg.Details == "REACT" {
+				wsType = chat.WsMessageType_REACT_MSG
+			}
+			wsMessage := &chat.WebSocketMessage{
+				Type:    wsType,
+				Message: msg.Message,
+			}
+			marshaller.Marshal(buff, wsMessage)
 		} else {
 			marshaller.Marshal(buff, msg.Message)
 		}
@@ -22,6 +22,7 @@ package websocket
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/pydio/cells/common/proto/chat"
 )
@@ -32,6 +33,13 @@ const (
 	MsgSubscribe   MessageType = "subscribe"
 	MsgUnsubscribe MessageType = "unsubscribe"
 	MsgError       MessageType = "error"
+	// MsgSubscribed acknowledges a subscription with the current epoch and sequence of the events ring
+	MsgSubscribed MessageType = "subscribed"
+	// MsgResync is sent when missed events cannot be replayed, clients must reload their state
+	MsgResync MessageType = "resync"
+	// MsgPresence announces the node currently viewed or edited, it must be refreshed before PresenceTTL
+	MsgPresence      MessageType = "presence"
+	MsgPresenceLeave MessageType = "presence-leave"
 )
 
 // Should pass JWT instead of username
@@ -39,6 +47,17 @@ type Message struct {
 	Type  MessageType `json:"@type"`
 	JWT   string      `json:"jwt"`
 	Error string      `json:"error"`
+
+	// Events stream position. Subscribe messages may pass the last received epoch and
+	// sequence in Epoch and Since to replay missed events.
+	Epoch string `json:"epoch,omitempty"`
+	Seq   uint64 `json:"seq,omitempty"`
+	Since uint64 `json:"since,omitempty"`
+
+	// Presence data, only used by MsgPresence messages
+	NodeUuid string `json:"node,omitempty"`
+	Editing  bool   `json:"editing,omitempty"`
+	Cursor   string `json:"cursor,omitempty"`
 }
 
 func NewErrorMessage(e error) []byte {
@@ -60,6 +79,18 @@ func Marshal(m Message) []byte {
 	return data
 }
 
+// WithSequence adds the "@epoch" and "@seq" keys to a JSON-serialized event.
+func WithSequence(data []byte, epoch string, seq uint64) []byte {
+	if len(data) < 2 || data[0] != '{' {
+		return data
+	}
+	prefix := fmt.Sprintf(`{"@epoch":%q,"@seq":%d`, epoch, seq)
+	if len(data) > 2 {
+		prefix += ","
+	}
+	return append([]byte(prefix), data[1:]...)
+}
+
 type ChatMessageType string
 
 const (
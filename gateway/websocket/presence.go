@@ -0,0 +1,144 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package websocket
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pydio/cells/common/proto/presence"
+)
+
+// PresenceTTL is the duration after which a presence expires if the client does not refresh it.
+var PresenceTTL = 60 * time.Second
+
+// PresenceStore keeps track of the nodes currently viewed or edited by websocket sessions. It is fed by
+// the presence events published on the broker, so that each instance knows about the sessions of the whole cluster.
+type PresenceStore struct {
+	sync.Mutex
+	presences map[string]*presence.Presence
+	onExpire  func(p *presence.Presence)
+	done      chan bool
+}
+
+// NewPresenceStore creates a PresenceStore and starts watching expirations. The onExpire callback
+// is called for each presence that was not refreshed in time.
+func NewPresenceStore(onExpire func(p *presence.Presence)) *PresenceStore {
+	s := &PresenceStore{
+		presences: make(map[string]*presence.Presence),
+		onExpire:  onExpire,
+		done:      make(chan bool),
+	}
+	go s.watchExpirations()
+	return s
+}
+
+// Handle applies a presence event to the store. It returns false if the event did not change anything
+// visible to other users (a simple refresh of the TTL).
+func (s *PresenceStore) Handle(event *presence.PresenceEvent) bool {
+	p := event.Presence
+	if p == nil || p.SessionId == "" {
+		return false
+	}
+	s.Lock()
+	defer s.Unlock()
+	previous, exists := s.presences[p.SessionId]
+	if event.Type == presence.PresenceEventType_LEAVE {
+		delete(s.presences, p.SessionId)
+		return exists
+	}
+	s.presences[p.SessionId] = p
+	return !exists || previous.NodeUuid != p.NodeUuid || previous.Editing != p.Editing || previous.Cursor != p.Cursor
+}
+
+// List returns presences on a given node, and on its direct children if children is true.
+func (s *PresenceStore) List(nodeUuid string, children bool) (viewers []*presence.Presence) {
+	s.Lock()
+	for _, p := range s.presences {
+		if p.NodeUuid == nodeUuid || (children && p.ParentUuid == nodeUuid) {
+			viewers = append(viewers, p)
+		}
+	}
+	s.Unlock()
+	sort.Slice(viewers, func(i, j int) bool {
+		if viewers[i].User == viewers[j].User {
+			return viewers[i].SessionId < viewers[j].SessionId
+		}
+		return viewers[i].User < viewers[j].User
+	})
+	return
+}
+
+// Around returns presences on the same folder as p, except p itself.
+func (s *PresenceStore) Around(p *presence.Presence) (viewers []*presence.Presence) {
+	s.Lock()
+	defer s.Unlock()
+	for id, other := range s.presences {
+		if id != p.SessionId && SameFolder(p, other) {
+			viewers = append(viewers, other)
+		}
+	}
+	return
+}
+
+// Expire removes presences whose TTL is over and returns them.
+func (s *PresenceStore) Expire(now time.Time) (expired []*presence.Presence) {
+	s.Lock()
+	defer s.Unlock()
+	for id, p := range s.presences {
+		if p.Expires < now.Unix() {
+			expired = append(expired, p)
+			delete(s.presences, id)
+		}
+	}
+	return
+}
+
+// Close stops watching expirations.
+func (s *PresenceStore) Close() {
+	close(s.done)
+}
+
+func (s *PresenceStore) watchExpirations() {
+	ticker := time.NewTicker(10 * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case now := <-ticker.C:
+			for _, p := range s.Expire(now) {
+				if s.onExpire != nil {
+					s.onExpire(p)
+				}
+			}
+		case <-s.done:
+			return
+		}
+	}
+}
+
+// SameFolder checks if two presences are on the same folder: either siblings, or one of them is on the parent of the other.
+func SameFolder(a, b *presence.Presence) bool {
+	if a.ParentUuid != "" && a.ParentUuid == b.ParentUuid {
+		return true
+	}
+	return a.NodeUuid == b.NodeUuid || a.NodeUuid == b.ParentUuid || a.ParentUuid == b.NodeUuid
+}
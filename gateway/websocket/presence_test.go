@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package websocket
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common/proto/presence"
+)
+
+func TestPresenceStore(t *testing.T) {
+
+	Convey("Test presence events and expiration", t, func() {
+		store := NewPresenceStore(nil)
+		defer store.Close()
+		expires := time.Now().Add(PresenceTTL).Unix()
+
+		folder := &presence.Presence{SessionId: "s1", User: "admin", NodeUuid: "folder", ParentUuid: "root", Expires: expires}
+		file := &presence.Presence{SessionId: "s2", User: "user", NodeUuid: "file", ParentUuid: "folder", Expires: expires}
+		other := &presence.Presence{SessionId: "s3", User: "user", NodeUuid: "other", ParentUuid: "elsewhere", Expires: expires}
+		So(store.Handle(&presence.PresenceEvent{Type: presence.PresenceEventType_JOIN, Presence: folder}), ShouldBeTrue)
+		So(store.Handle(&presence.PresenceEvent{Type: presence.PresenceEventType_JOIN, Presence: file}), ShouldBeTrue)
+		So(store.Handle(&presence.PresenceEvent{Type: presence.PresenceEventType_JOIN, Presence: other}), ShouldBeTrue)
+
+		So(store.List("folder", false), ShouldHaveLength, 1)
+		So(store.List("folder", true), ShouldHaveLength, 2)
+		So(store.Around(folder), ShouldHaveLength, 1)
+		So(store.Around(folder)[0].SessionId, ShouldEqual, "s2")
+
+		// Refreshing TTL does not need to be broadcasted, moving the cursor does
+		refresh := *file
+		refresh.Expires = expires + 10
+		So(store.Handle(&presence.PresenceEvent{Type: presence.PresenceEventType_UPDATE, Presence: &refresh}), ShouldBeFalse)
+		cursor := refresh
+		cursor.Cursor = "line:10"
+		So(store.Handle(&presence.PresenceEvent{Type: presence.PresenceEventType_UPDATE, Presence: &cursor}), ShouldBeTrue)
+
+		So(store.Handle(&presence.PresenceEvent{Type: presence.PresenceEventType_LEAVE, Presence: folder}), ShouldBeTrue)
+		So(store.List("folder", true), ShouldHaveLength, 1)
+
+		expired := store.Expire(time.Now().Add(2 * PresenceTTL))
+		So(expired, ShouldHaveLength, 2)
+		So(store.List("file", false), ShouldBeEmpty)
+	})
+
+}
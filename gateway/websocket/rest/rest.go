@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package rest
+
+import (
+	"github.com/emicklei/go-restful"
+	"github.com/golang/protobuf/proto"
+	"github.com/micro/go-micro/broker"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/micro"
+	"github.com/pydio/cells/common/proto/presence"
+	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/service"
+	"github.com/pydio/cells/common/views"
+	"github.com/pydio/cells/gateway/websocket"
+)
+
+// PresenceHandler lists current viewers of nodes. It maintains its own presence store,
+// fed by the events published by the websocket instances.
+type PresenceHandler struct {
+	router views.Handler
+	store  *websocket.PresenceStore
+}
+
+func NewPresenceHandler() *PresenceHandler {
+	h := &PresenceHandler{
+		router: views.NewUuidRouter(views.RouterOptions{}),
+		store:  websocket.NewPresenceStore(nil),
+	}
+	defaults.Broker().Subscribe(common.TOPIC_PRESENCE_EVENT, func(publication broker.Publication) error {
+		var event presence.PresenceEvent
+		if e := proto.Unmarshal(publication.Message().Body, &event); e == nil {
+			h.store.Handle(&event)
+		}
+		return nil
+	})
+	return h
+}
+
+// SwaggerTags list the names of the service tags declared in the swagger json implemented by this service
+func (h *PresenceHandler) SwaggerTags() []string {
+	return []string{"PresenceService"}
+}
+
+// Filter returns a function to filter the swagger path
+func (h *PresenceHandler) Filter() func(string) string {
+	return nil
+}
+
+// ListNodeViewers lists sessions currently viewing a node the current user can read
+func (h *PresenceHandler) ListNodeViewers(req *restful.Request, rsp *restful.Response) {
+
+	ctx := req.Request.Context()
+	nodeUuid := req.PathParameter("NodeUuid")
+	if _, err := h.router.ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Uuid: nodeUuid}}); err != nil {
+		service.RestErrorDetect(req, rsp, err)
+		return
+	}
+	children := req.QueryParameter("Children") == "true"
+	rsp.WriteEntity(&presence.ListViewersResponse{
+		Viewers: h.store.List(nodeUuid, children),
+	})
+
+}
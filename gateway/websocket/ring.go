@@ -0,0 +1,136 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package websocket
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// EventsRingSize is the number of events kept in memory for resuming streams.
+const EventsRingSize = 1000
+
+// RingEvent is an event stored in the ring, along with its identifier.
+type RingEvent struct {
+	Id    string
+	Seq   uint64
+	Event interface{}
+}
+
+// EventsRing keeps the last broadcasted events in memory, so that clients can
+// resume their stream after a disconnection. Identifiers are built from an epoch
+// that changes at each restart and a monotonic sequence number.
+type EventsRing struct {
+	sync.Mutex
+	epoch  string
+	seq    uint64
+	events []*RingEvent
+	head   int
+	count  int
+}
+
+// NewEventsRing creates a ring holding up to size events.
+func NewEventsRing(size int) *EventsRing {
+	return &EventsRing{
+		epoch:  strconv.FormatInt(time.Now().UnixNano(), 36),
+		events: make([]*RingEvent, size),
+	}
+}
+
+// Epoch returns the identifier of this ring instance.
+func (r *EventsRing) Epoch() string {
+	return r.epoch
+}
+
+// Seq returns the sequence number of the last appended event.
+func (r *EventsRing) Seq() uint64 {
+	r.Lock()
+	defer r.Unlock()
+	return r.seq
+}
+
+// Append stores an event and returns it wrapped with its identifier.
+// Oldest events are dropped when the ring is full.
+func (r *EventsRing) Append(event interface{}) *RingEvent {
+	r.Lock()
+	defer r.Unlock()
+	r.seq++
+	e := &RingEvent{Id: fmt.Sprintf("%s-%d", r.epoch, r.seq), Seq: r.seq, Event: event}
+	r.events[(r.head+r.count)%len(r.events)] = e
+	if r.count < len(r.events) {
+		r.count++
+	} else {
+		r.head = (r.head + 1) % len(r.events)
+	}
+	return e
+}
+
+// Since returns the events that were appended after the given identifier. The boolean is false if
+// the identifier is unknown (previous epoch) or too old, in which case the client must resync.
+func (r *EventsRing) Since(id string) ([]*RingEvent, bool) {
+	epoch, seq, e := ParseEventId(id)
+	if e != nil {
+		return nil, false
+	}
+	return r.Resume(epoch, seq)
+}
+
+// Resume returns the events of the given epoch whose sequence number is greater than seq.
+func (r *EventsRing) Resume(epoch string, seq uint64) ([]*RingEvent, bool) {
+	if epoch != r.epoch {
+		return nil, false
+	}
+	r.Lock()
+	defer r.Unlock()
+	if seq > r.seq {
+		return nil, false
+	}
+	if seq == r.seq {
+		return nil, true
+	}
+	if r.count == 0 || seq+1 < r.events[r.head].Seq {
+		return nil, false
+	}
+	var events []*RingEvent
+	for i := 0; i < r.count; i++ {
+		e := r.events[(r.head+i)%len(r.events)]
+		if e.Seq > seq {
+			events = append(events, e)
+		}
+	}
+	return events, true
+}
+
+// ParseEventId splits an event identifier into its epoch and sequence number.
+func ParseEventId(id string) (string, uint64, error) {
+	i := strings.LastIndex(id, "-")
+	if i == -1 {
+		return "", 0, fmt.Errorf("invalid event id %s", id)
+	}
+	seq, e := strconv.ParseUint(id[i+1:], 10, 64)
+	if e != nil {
+		return "", 0, fmt.Errorf("invalid event id %s", id)
+	}
+	return id[:i], seq, nil
+}
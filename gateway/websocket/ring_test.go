@@ -0,0 +1,156 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package websocket
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common/proto/tree"
+)
+
+func TestEventsRing(t *testing.T) {
+
+	Convey("Test events replay", t, func() {
+		ring := NewEventsRing(3)
+		first := ring.Append("a")
+		second := ring.Append("b")
+		ring.Append("c")
+
+		events, complete := ring.Since(first.Id)
+		So(complete, ShouldBeTrue)
+		So(events, ShouldHaveLength, 2)
+		So(events[0].Event, ShouldEqual, "b")
+
+		last := ring.Append("d")
+		events, complete = ring.Since(last.Id)
+		So(complete, ShouldBeTrue)
+		So(events, ShouldBeEmpty)
+
+		// "a" was dropped, but replaying since "a" is still possible
+		events, complete = ring.Since(first.Id)
+		So(complete, ShouldBeTrue)
+		So(events, ShouldHaveLength, 3)
+
+		ring.Append("e")
+		_, complete = ring.Since(first.Id)
+		So(complete, ShouldBeFalse)
+		events, complete = ring.Since(second.Id)
+		So(complete, ShouldBeTrue)
+		So(events, ShouldHaveLength, 3)
+
+		_, complete = ring.Since("previous-1")
+		So(complete, ShouldBeFalse)
+		_, complete = ring.Since("invalid")
+		So(complete, ShouldBeFalse)
+	})
+
+}
+
+func TestWithSequence(t *testing.T) {
+
+	Convey("Test sequence injection and resume", t, func() {
+		ring := NewEventsRing(10)
+		e := ring.Append("a")
+		So(string(WithSequence([]byte(`{"Type":"CREATE"}`), ring.Epoch(), e.Seq)), ShouldEqual, `{"@epoch":"`+ring.Epoch()+`","@seq":1,"Type":"CREATE"}`)
+		So(string(WithSequence([]byte(`{}`), "e", 2)), ShouldEqual, `{"@epoch":"e","@seq":2}`)
+		So(string(WithSequence([]byte(`"dump"`), "e", 2)), ShouldEqual, `"dump"`)
+
+		ring.Append("b")
+		So(ring.Seq(), ShouldEqual, 2)
+		events, complete := ring.Resume(ring.Epoch(), 1)
+		So(complete, ShouldBeTrue)
+		So(events, ShouldHaveLength, 1)
+		_, complete = ring.Resume("other", 1)
+		So(complete, ShouldBeFalse)
+		_, complete = ring.Resume(ring.Epoch(), 5)
+		So(complete, ShouldBeFalse)
+	})
+
+}
+
+func TestCloneEvent(t *testing.T) {
+
+	Convey("Test events stored in the ring are isolated from broadcasted ones", t, func() {
+		event := &NodeChangeEventWithInfo{refreshTarget: true}
+		event.Type = tree.NodeChangeEvent_UPDATE_PATH
+		event.Target = &tree.Node{Uuid: "uuid", Path: "path"}
+
+		ring := NewEventsRing(10)
+		re := ring.Append(cloneEvent(event))
+		event.Target = &tree.Node{Uuid: "uuid", Path: "resolved"}
+		event.Source = &tree.Node{Uuid: "uuid", Path: "source"}
+
+		stored := re.Event.(*NodeChangeEventWithInfo)
+		So(stored.refreshTarget, ShouldBeTrue)
+		So(stored.Type, ShouldEqual, tree.NodeChangeEvent_UPDATE_PATH)
+		So(stored.Target.Path, ShouldEqual, "path")
+		So(stored.Source, ShouldBeNil)
+
+		So(cloneEvent("other"), ShouldEqual, "other")
+	})
+
+}
+
+func TestSSETickets(t *testing.T) {
+
+	Convey("Test tickets are single-use", t, func() {
+		tickets := NewSSETickets()
+		id, e := tickets.Issue("jwt")
+		So(e, ShouldBeNil)
+		So(id, ShouldNotBeEmpty)
+		So(id, ShouldNotContainSubstring, "jwt")
+
+		jwt, ok := tickets.Consume(id)
+		So(ok, ShouldBeTrue)
+		So(jwt, ShouldEqual, "jwt")
+		_, ok = tickets.Consume(id)
+		So(ok, ShouldBeFalse)
+		_, ok = tickets.Consume("unknown")
+		So(ok, ShouldBeFalse)
+	})
+
+	Convey("Test expired tickets", t, func() {
+		tickets := NewSSETickets()
+		id, _ := tickets.Issue("jwt")
+		tickets.tickets[id].expiry = time.Now().Add(-time.Second)
+		_, ok := tickets.Consume(id)
+		So(ok, ShouldBeFalse)
+
+		expired, _ := tickets.Issue("jwt")
+		tickets.tickets[expired].expiry = time.Now().Add(-time.Second)
+		tickets.Issue("other")
+		So(tickets.tickets, ShouldHaveLength, 1)
+	})
+
+	Convey("Test events without id", t, func() {
+		buffer := &bytes.Buffer{}
+		So(writeSSEEvent(buffer, "", [][]byte{[]byte("{}")}), ShouldBeNil)
+		So(buffer.String(), ShouldEqual, "data: {}\n\n")
+		buffer.Reset()
+		So(writeSSEEvent(buffer, "e-1", [][]byte{[]byte("{}")}), ShouldBeNil)
+		So(buffer.String(), ShouldEqual, "id: e-1\ndata: {}\n\n")
+	})
+
+}
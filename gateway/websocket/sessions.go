@@ -28,8 +28,10 @@ import (
 	"golang.org/x/time/rate"
 	"gopkg.in/olahol/melody.v1"
 
+	"github.com/pydio/cells/common/auth"
 	"github.com/pydio/cells/common/auth/claim"
 	"github.com/pydio/cells/common/log"
+	proto "github.com/pydio/cells/common/proto/auth"
 	"github.com/pydio/cells/common/utils/permissions"
 	"github.com/pydio/cells/common/views"
 )
@@ -40,11 +42,19 @@ const SessionUsernameKey = "user"
 const SessionProfileKey = "profile"
 const SessionClaimsKey = "profile"
 const SessionLimiterKey = "limiter"
+const SessionPresenceKey = "presence"
 
 const LimiterRate = 30
 const LimiterBurst = 20
 
-func UpdateSessionFromClaims(session *melody.Session, claims claim.Claims, pool *views.ClientsPool) {
+// SessionData stores the keys used to filter events for a given client. It is implemented
+// by websocket sessions as well as SSE clients.
+type SessionData interface {
+	Get(key string) (interface{}, bool)
+	Set(key string, value interface{})
+}
+
+func UpdateSessionFromClaims(session SessionData, claims claim.Claims, pool *views.ClientsPool) {
 
 	ctx := context.WithValue(context.Background(), claim.ContextKey, claims)
 	vNodeManager := views.GetVirtualNodesManager()
@@ -79,7 +89,7 @@ func UpdateSessionFromClaims(session *melody.Session, claims claim.Claims, pool
 
 }
 
-func ClearSession(session *melody.Session) {
+func ClearSession(session SessionData) {
 
 	session.Set(SessionRolesKey, nil)
 	session.Set(SessionWorkspacesKey, nil)
@@ -87,5 +97,54 @@ func ClearSession(session *melody.Session) {
 	session.Set(SessionProfileKey, nil)
 	session.Set(SessionClaimsKey, nil)
 	session.Set(SessionLimiterKey, nil)
+	session.Set(SessionPresenceKey, nil)
+
+}
+
+// RevokedSessions indexes the Uuids of revoked authentication sessions and personal access tokens.
+type RevokedSessions map[string]struct{}
+
+// NewRevokedSessions reads the sessions and tokens revoked by a SessionEvent.
+func NewRevokedSessions(event *proto.SessionEvent) RevokedSessions {
+	revoked := make(RevokedSessions, len(event.Revoked)+len(event.RevokedTokens))
+	for _, s := range event.Revoked {
+		revoked[s.Uuid] = struct{}{}
+	}
+	for _, t := range event.RevokedTokens {
+		revoked[t] = struct{}{}
+	}
+	return revoked
+}
+
+// Match checks if a client was authenticated by one of the revoked sessions or tokens.
+func (r RevokedSessions) Match(session SessionData) bool {
+	value, ok := session.Get(SessionClaimsKey)
+	if !ok || value == nil {
+		return false
+	}
+	claims, ok := value.(claim.Claims)
+	if !ok {
+		return false
+	}
+	id := claims.PersonalAccessToken
+	if id == "" {
+		id = auth.SessionUuid(claims)
+	}
+	_, revoked := r[id]
+	return revoked
+}
 
+// CloseRevokedSessions clears and closes the websocket sessions authenticated by a revoked session.
+func CloseRevokedSessions(m *melody.Melody, revoked RevokedSessions) error {
+	if m == nil || len(revoked) == 0 {
+		return nil
+	}
+	// The filter is used to walk through the sessions, the empty message itself is never sent
+	return m.BroadcastFilter([]byte{}, func(session *melody.Session) bool {
+		if revoked.Match(session) {
+			ClearSession(session)
+			session.CloseWithMsg(NewErrorMessageString("session revoked"))
+		}
+		return false
+	})
 }
@@ -0,0 +1,345 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package websocket
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/common/auth"
+	"github.com/pydio/cells/common/log"
+)
+
+const (
+	// SSEKeepAlive is the interval between two comments sent to keep the connection open
+	SSEKeepAlive = 30 * time.Second
+	// SSEBufferSize is the number of pending events for a client before it gets disconnected
+	SSEBufferSize = 256
+	// SSETicketTTL is the validity of the tickets used to open an SSE stream
+	SSETicketTTL = 30 * time.Second
+)
+
+type sseEvent struct {
+	id       string
+	seq      uint64
+	messages [][]byte
+}
+
+// SSEClient is a client connected to the Server-Sent Events endpoint. It implements SessionData
+// so that events are filtered exactly like for websocket sessions.
+type SSEClient struct {
+	sync.RWMutex
+	keys   map[string]interface{}
+	events chan *sseEvent
+	done   chan struct{}
+	once   sync.Once
+}
+
+// NewSSEClient creates an empty client.
+func NewSSEClient() *SSEClient {
+	return &SSEClient{
+		keys:   make(map[string]interface{}),
+		events: make(chan *sseEvent, SSEBufferSize),
+		done:   make(chan struct{}),
+	}
+}
+
+// Get returns a value stored for this client.
+func (c *SSEClient) Get(key string) (interface{}, bool) {
+	c.RLock()
+	defer c.RUnlock()
+	v, ok := c.keys[key]
+	return v, ok
+}
+
+// Set stores a value for this client.
+func (c *SSEClient) Set(key string, value interface{}) {
+	c.Lock()
+	defer c.Unlock()
+	c.keys[key] = value
+}
+
+// push queues an event for this client. If the client is too slow to consume its events, the
+// stream is closed: the client will reconnect and resume from its last received event.
+func (c *SSEClient) push(e *sseEvent) {
+	select {
+	case c.events <- e:
+	default:
+		c.close()
+	}
+}
+
+func (c *SSEClient) close() {
+	c.once.Do(func() {
+		close(c.done)
+	})
+}
+
+// SSETickets stores short-lived, single-use tickets standing for a JWT, so that the JWT itself never
+// appears in the URL of an EventSource request.
+type SSETickets struct {
+	sync.Mutex
+	tickets map[string]*sseTicket
+}
+
+type sseTicket struct {
+	jwt    string
+	expiry time.Time
+}
+
+// NewSSETickets creates an empty tickets store.
+func NewSSETickets() *SSETickets {
+	return &SSETickets{tickets: make(map[string]*sseTicket)}
+}
+
+// Issue creates a ticket for a JWT, valid for SSETicketTTL.
+func (t *SSETickets) Issue(jwt string) (string, error) {
+	b := make([]byte, 32)
+	if _, e := rand.Read(b); e != nil {
+		return "", e
+	}
+	id := base64.RawURLEncoding.EncodeToString(b)
+	t.Lock()
+	defer t.Unlock()
+	now := time.Now()
+	for k, v := range t.tickets {
+		if now.After(v.expiry) {
+			delete(t.tickets, k)
+		}
+	}
+	t.tickets[id] = &sseTicket{jwt: jwt, expiry: now.Add(SSETicketTTL)}
+	return id, nil
+}
+
+// Consume returns the JWT of a ticket and removes the ticket.
+func (t *SSETickets) Consume(id string) (string, bool) {
+	t.Lock()
+	defer t.Unlock()
+	ticket, ok := t.tickets[id]
+	if !ok {
+		return "", false
+	}
+	delete(t.tickets, id)
+	if time.Now().After(ticket.expiry) {
+		return "", false
+	}
+	return ticket.jwt, true
+}
+
+// HandleSSETicket exchanges the JWT passed as a Bearer Authorization header against a ticket for opening an SSE stream.
+func (w *WebsocketHandler) HandleSSETicket(rw http.ResponseWriter, req *http.Request) {
+
+	jwt := bearerToken(req)
+	if jwt == "" {
+		http.Error(rw, "empty jwt", http.StatusUnauthorized)
+		return
+	}
+	if _, _, e := auth.DefaultJWTVerifier().Verify(req.Context(), jwt); e != nil {
+		http.Error(rw, e.Error(), http.StatusUnauthorized)
+		return
+	}
+	ticket, e := w.tickets.Issue(jwt)
+	if e != nil {
+		http.Error(rw, e.Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	rw.Header().Set("Cache-Control", "no-store")
+	json.NewEncoder(rw).Encode(map[string]interface{}{
+		"ticket":    ticket,
+		"expiresIn": int(SSETicketTTL.Seconds()),
+	})
+
+}
+
+// HandleSSE serves a Server-Sent Events stream delivering the same events as the websocket. The JWT is passed either
+// as a Bearer Authorization header or, as EventSource cannot set headers, through a "ticket" query parameter obtained
+// from HandleSSETicket. The JWT is verified again at each keep-alive and the stream is closed when it expires.
+// Clients resuming a stream send the Last-Event-ID header (or the "lastEventId" parameter): missed events are
+// replayed from the ring, or a "resync" event is sent if they are not available anymore.
+func (w *WebsocketHandler) HandleSSE(rw http.ResponseWriter, req *http.Request) {
+
+	ctx := req.Context()
+	flusher, ok := rw.(http.Flusher)
+	if !ok {
+		http.Error(rw, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	jwt := bearerToken(req)
+	if ticket := req.URL.Query().Get("ticket"); jwt == "" && ticket != "" {
+		if jwt, ok = w.tickets.Consume(ticket); !ok {
+			http.Error(rw, "invalid or expired ticket", http.StatusUnauthorized)
+			return
+		}
+	}
+	if jwt == "" {
+		http.Error(rw, "empty jwt", http.StatusUnauthorized)
+		return
+	}
+	verifier := auth.DefaultJWTVerifier()
+	_, claims, e := verifier.Verify(ctx, jwt)
+	if e != nil {
+		log.Logger(ctx).Error("invalid jwt received from sse connection")
+		http.Error(rw, e.Error(), http.StatusUnauthorized)
+		return
+	}
+	client := NewSSEClient()
+	UpdateSessionFromClaims(client, claims, w.EventRouter.GetClientsPool())
+	if u, ok := client.Get(SessionUsernameKey); !ok || u == nil {
+		http.Error(rw, "cannot load user access list", http.StatusUnauthorized)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "text/event-stream")
+	rw.Header().Set("Cache-Control", "no-cache")
+	rw.Header().Set("Connection", "keep-alive")
+	rw.Header().Set("X-Accel-Buffering", "no")
+	rw.WriteHeader(http.StatusOK)
+
+	// Register before replaying, events received in between are skipped using their sequence
+	w.addSSEClient(client)
+	defer w.removeSSEClient(client)
+
+	var replayed uint64
+	lastEventId := req.Header.Get("Last-Event-ID")
+	if lastEventId == "" {
+		lastEventId = req.URL.Query().Get("lastEventId")
+	}
+	if lastEventId != "" {
+		events, complete := w.Ring.Since(lastEventId)
+		if !complete {
+			fmt.Fprint(rw, "event: resync\ndata: {}\n\n")
+		}
+		for _, re := range events {
+			writeSSEEvent(rw, re.Id, w.filterEvent(ctx, re.Event, client))
+			replayed = re.Seq
+		}
+	}
+	flusher.Flush()
+
+	keepAlive := time.NewTicker(SSEKeepAlive)
+	defer keepAlive.Stop()
+	var expired <-chan time.Time
+	if !claims.Expiry.IsZero() {
+		timer := time.NewTimer(time.Until(claims.Expiry))
+		defer timer.Stop()
+		expired = timer.C
+	}
+
+	for {
+		select {
+		case e := <-client.events:
+			// Task events are not stored in the ring and have no sequence
+			if e.seq > 0 && e.seq <= replayed {
+				continue
+			}
+			if err := writeSSEEvent(rw, e.id, e.messages); err != nil {
+				return
+			}
+			flusher.Flush()
+		case <-keepAlive.C:
+			if _, _, err := verifier.Verify(ctx, jwt); err != nil {
+				log.Logger(ctx).Debug("Closing SSE client with an invalid jwt", zap.Error(err))
+				return
+			}
+			if _, err := fmt.Fprint(rw, ": ping\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		case <-expired:
+			log.Logger(ctx).Debug("Closing SSE client with an expired jwt")
+			return
+		case <-client.done:
+			log.Logger(ctx).Debug("Closing slow SSE client")
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+
+}
+
+// broadcastSSE filters the event for each SSE client and queues the resulting messages.
+func (w *WebsocketHandler) broadcastSSE(ctx context.Context, re *RingEvent) {
+
+	w.sseLock.RLock()
+	clients := make([]*SSEClient, 0, len(w.sseClients))
+	for c := range w.sseClients {
+		clients = append(clients, c)
+	}
+	w.sseLock.RUnlock()
+
+	for _, c := range clients {
+		if messages := w.filterEvent(ctx, re.Event, c); len(messages) > 0 {
+			c.push(&sseEvent{id: re.Id, seq: re.Seq, messages: messages})
+		}
+	}
+
+}
+
+func (w *WebsocketHandler) addSSEClient(c *SSEClient) {
+	w.sseLock.Lock()
+	defer w.sseLock.Unlock()
+	w.sseClients[c] = struct{}{}
+}
+
+func (w *WebsocketHandler) removeSSEClient(c *SSEClient) {
+	w.sseLock.Lock()
+	defer w.sseLock.Unlock()
+	delete(w.sseClients, c)
+	c.close()
+	log.Logger(context.Background()).Debug("SSE client disconnected", zap.Int("remaining", len(w.sseClients)))
+}
+
+// writeSSEEvent writes one SSE event per message, all carrying the same id. Events without id (task events)
+// do not change the last event id of the client.
+func writeSSEEvent(writer io.Writer, id string, messages [][]byte) error {
+	for _, m := range messages {
+		var e error
+		if id == "" {
+			_, e = fmt.Fprintf(writer, "data: %s\n\n", m)
+		} else {
+			_, e = fmt.Fprintf(writer, "id: %s\ndata: %s\n\n", id, m)
+		}
+		if e != nil {
+			return e
+		}
+	}
+	return nil
+}
+
+func bearerToken(req *http.Request) string {
+	if h := req.Header.Get("Authorization"); strings.HasPrefix(h, "Bearer ") {
+		return strings.TrimPrefix(h, "Bearer ")
+	}
+	return ""
+}
@@ -23,12 +23,16 @@ package websocket
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"path"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/micro/go-micro/metadata"
 	"github.com/micro/protobuf/jsonpb"
+	"github.com/pborman/uuid"
 	"go.uber.org/zap"
 	"golang.org/x/time/rate"
 	"gopkg.in/olahol/melody.v1"
@@ -37,9 +41,12 @@ import (
 	"github.com/pydio/cells/common/auth"
 	"github.com/pydio/cells/common/auth/claim"
 	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/micro"
 	"github.com/pydio/cells/common/proto/activity"
+	auth2 "github.com/pydio/cells/common/proto/auth"
 	"github.com/pydio/cells/common/proto/idm"
 	"github.com/pydio/cells/common/proto/jobs"
+	"github.com/pydio/cells/common/proto/presence"
 	"github.com/pydio/cells/common/proto/tree"
 	"github.com/pydio/cells/common/utils/meta"
 	"github.com/pydio/cells/common/views"
@@ -48,7 +55,12 @@ import (
 type WebsocketHandler struct {
 	Websocket   *melody.Melody
 	EventRouter *views.RouterEventFilter
+	Presences   *PresenceStore
+	Ring        *EventsRing
 
+	sseClients    map[*SSEClient]struct{}
+	sseLock       *sync.RWMutex
+	tickets       *SSETickets
 	batcherLock   *sync.Mutex
 	batchers      map[string]*NodeEventsBatcher
 	dispatcher    chan *NodeChangeEventWithInfo
@@ -63,7 +75,14 @@ func NewWebSocketHandler(serviceCtx context.Context) *WebsocketHandler {
 		done:          make(chan string),
 		batcherLock:   &sync.Mutex{},
 		silentDropper: rate.NewLimiter(20, 10),
+		sseClients:    make(map[*SSEClient]struct{}),
+		sseLock:       &sync.RWMutex{},
+		tickets:       NewSSETickets(),
+		Ring:          NewEventsRing(EventsRingSize),
 	}
+	w.Presences = NewPresenceStore(func(p *presence.Presence) {
+		w.BroadcastPresenceEvent(serviceCtx, &presence.PresenceEvent{Type: presence.PresenceEventType_LEAVE, Presence: p})
+	})
 	w.InitHandlers(serviceCtx)
 	go func() {
 		for {
@@ -89,10 +108,12 @@ func (w *WebsocketHandler) InitHandlers(serviceCtx context.Context) {
 		if !strings.Contains(i.Error(), "close 1000 (normal)") {
 			log.Logger(serviceCtx).Debug("HandleError", zap.Error(i))
 		}
+		w.leavePresence(serviceCtx, session)
 		ClearSession(session)
 	})
 
 	w.Websocket.HandleClose(func(session *melody.Session, i int, i2 string) error {
+		w.leavePresence(serviceCtx, session)
 		ClearSession(session)
 		return nil
 	})
@@ -122,11 +143,27 @@ func (w *WebsocketHandler) InitHandlers(serviceCtx context.Context) {
 				return
 			}
 			UpdateSessionFromClaims(session, claims, w.EventRouter.GetClientsPool())
+			if msg.Epoch != "" {
+				w.replayEvents(ctx, session, msg.Epoch, msg.Since)
+			}
+			session.Write(Marshal(Message{Type: MsgSubscribed, Epoch: w.Ring.Epoch(), Seq: w.Ring.Seq()}))
 
 		case MsgUnsubscribe:
 
+			w.leavePresence(serviceCtx, session)
 			ClearSession(session)
 
+		case MsgPresence:
+
+			if e := w.announcePresence(serviceCtx, session, msg); e != nil {
+				log.Logger(serviceCtx).Debug("Cannot announce presence", zap.Error(e))
+				session.Write(NewErrorMessage(e))
+			}
+
+		case MsgPresenceLeave:
+
+			w.leavePresence(serviceCtx, session)
+
 		default:
 			return
 		}
@@ -186,200 +223,443 @@ func (w *WebsocketHandler) BroadcastNodeChangeEvent(ctx context.Context, event *
 		return nil
 	}
 
-	return w.Websocket.BroadcastFilter([]byte(`"dump"`), func(session *melody.Session) bool {
+	return w.dispatch(ctx, event)
 
-		value, ok := session.Get(SessionWorkspacesKey)
-		if !ok || value == nil {
-			return false
-		}
-		workspaces := value.(map[string]*idm.Workspace)
-
-		// Rate-limit events (let Optimistic events always go through)
-		if lim, ok := session.Get(SessionLimiterKey); ok && !event.Optimistic {
-			limiter := lim.(*rate.Limiter)
-			if err := limiter.Wait(ctx); err != nil {
-				log.Logger(ctx).Warn("WebSocket: some events were dropped (session rate limiter)")
-				return false
-			}
-		}
+}
 
-		var (
-			hasData             bool
-			metaCtx             context.Context
-			metaProviderClients []tree.NodeProviderStreamer_ReadNodeStreamClient
-			metaProviderNames   []string
-			metaProvidersCloser meta.MetaProviderCloser
-		)
-
-		if event.refreshTarget && event.Target != nil {
-			claims, _ := session.Get(SessionClaimsKey)
-			uName, _ := session.Get(SessionUsernameKey)
-			metaCtx = metadata.NewContext(context.Background(), map[string]string{
-				common.PYDIO_CONTEXT_USER_KEY: uName.(string),
-			})
-			metaCtx = auth.ToMetadata(metaCtx, claims.(claim.Claims))
-			metaProviderClients, metaProvidersCloser, metaProviderNames = meta.InitMetaProviderClients(metaCtx, false)
-			defer metaProvidersCloser()
-			if respNode, err := w.EventRouter.GetClientsPool().GetTreeClient().ReadNode(ctx, &tree.ReadNodeRequest{Node: event.Target}); err == nil {
-				event.Target = respNode.Node
-			}
-		}
+// BroadcastTaskChangeEvent listens to tasks events and broadcast them to sessions with the adequate user.
+func (w *WebsocketHandler) BroadcastTaskChangeEvent(ctx context.Context, event *jobs.TaskChangeEvent) error {
 
-		enrichedNodes := make(map[string]*tree.Node)
-		for wsId, workspace := range workspaces {
-			nTarget, t1 := w.EventRouter.WorkspaceCanSeeNode(ctx, workspace, event.Target)
-			nSource, t2 := w.EventRouter.WorkspaceCanSeeNode(ctx, workspace, event.Source)
-			// Depending on node, broadcast now
-			if t1 || t2 {
-				eType := event.Type
-				if nTarget != nil {
-					if event.refreshTarget {
-						if metaNode, ok := enrichedNodes[nTarget.Uuid]; ok {
-							for k, v := range metaNode.MetaStore {
-								nTarget.MetaStore[k] = v
-							}
-						} else {
-							metaNode = nTarget.Clone()
-							meta.EnrichNodesMetaFromProviders(metaCtx, metaProviderClients, metaProviderNames, metaNode)
-							for k, v := range metaNode.MetaStore {
-								nTarget.MetaStore[k] = v
-							}
-							enrichedNodes[nTarget.Uuid] = metaNode
-						}
-					}
-					nTarget.SetMeta("EventWorkspaceId", workspace.UUID)
-					nTarget = nTarget.WithoutReservedMetas()
-					log.Logger(ctx).Debug("Broadcasting event to this session for workspace", zap.Any("type", event.Type), zap.String("wsId", wsId), zap.Any("path", event.Target.Path))
-				}
-				if nSource != nil {
-					nSource.SetMeta("EventWorkspaceId", workspace.UUID)
-					nSource = nSource.WithoutReservedMetas()
-				}
-				// Eventually update event type if one node is out of scope
-				if eType == tree.NodeChangeEvent_UPDATE_PATH {
-					if nSource == nil {
-						eType = tree.NodeChangeEvent_CREATE
-					} else if nTarget == nil {
-						eType = tree.NodeChangeEvent_DELETE
-					}
-				}
-				// We have to filter the event for this context
-				marshaler := &jsonpb.Marshaler{}
-				s, _ := marshaler.MarshalToString(&tree.NodeChangeEvent{
-					Type:   eType,
-					Target: nTarget,
-					Source: nSource,
-				})
-				data := []byte(s)
-
-				session.Write(data)
-				hasData = true
+	if w.Websocket == nil {
+		return nil
+	}
+
+	return w.dispatch(ctx, event)
+
+}
+
+// BroadcastIDMChangeEvent listens to ACL events and broadcast them to sessions if the Role, User, or Workspace is concerned
+// This triggers a registry reload in the UX (and eventually a change of permissions)
+func (w *WebsocketHandler) BroadcastIDMChangeEvent(ctx context.Context, event *idm.ChangeEvent) error {
+
+	event.JsonType = "idm"
+	return w.dispatch(ctx, event)
+
+}
+
+// BroadcastActivityEvent listens to activities and broadcast them to sessions with the adequate user.
+func (w *WebsocketHandler) BroadcastActivityEvent(ctx context.Context, event *activity.PostActivityEvent) error {
+
+	// Only handle "users inbox" events for now
+	if event.BoxName != "inbox" && event.OwnerType != activity.OwnerType_USER {
+		return nil
+	}
+	event.JsonType = "activity"
+	return w.dispatch(ctx, event)
+
+}
+
+// dispatch stores a copy of the event in the ring for later replays, then sends it to all websocket sessions and SSE
+// clients. Websocket messages carry the event sequence, so that clients can resume the stream after a reconnection.
+// Task events are frequent and only reflect a transient progress: they are not kept in the ring, so that they do not
+// evict the other events, and are sent without sequence.
+func (w *WebsocketHandler) dispatch(ctx context.Context, event interface{}) error {
+
+	var ringEvent *RingEvent
+	if _, isTaskEvent := event.(*jobs.TaskChangeEvent); isTaskEvent {
+		ringEvent = &RingEvent{Event: event}
+	} else {
+		ringEvent = w.Ring.Append(cloneEvent(event))
+	}
+	w.broadcastSSE(ctx, ringEvent)
+
+	_, isNodeEvent := event.(*NodeChangeEventWithInfo)
+	return w.Websocket.BroadcastFilter([]byte(`"dump"`), func(session *melody.Session) bool {
+		messages := w.filterEvent(ctx, event, session)
+		for _, m := range messages {
+			if ringEvent.Seq > 0 {
+				m = WithSequence(m, w.Ring.Epoch(), ringEvent.Seq)
 			}
+			session.Write(m)
 		}
-
-		return hasData
+		return isNodeEvent && len(messages) > 0
 	})
 
 }
 
-// BroadcastTaskChangeEvent listens to tasks events and broadcast them to sessions with the adequate user.
-func (w *WebsocketHandler) BroadcastTaskChangeEvent(ctx context.Context, event *jobs.TaskChangeEvent) error {
+// cloneEvent deep-copies an event before it is stored in the ring.
+func cloneEvent(event interface{}) interface{} {
+	switch e := event.(type) {
+	case *NodeChangeEventWithInfo:
+		c := &NodeChangeEventWithInfo{refreshTarget: e.refreshTarget}
+		c.NodeChangeEvent = *proto.Clone(&e.NodeChangeEvent).(*tree.NodeChangeEvent)
+		return c
+	case proto.Message:
+		return proto.Clone(e)
+	}
+	return event
+}
 
-	if w.Websocket == nil {
-		return nil
+// replayEvents sends to the session the events it missed since the given sequence, or a resync
+// message if they are not available anymore.
+func (w *WebsocketHandler) replayEvents(ctx context.Context, session *melody.Session, epoch string, since uint64) {
+
+	events, complete := w.Ring.Resume(epoch, since)
+	if !complete {
+		session.Write(Marshal(Message{Type: MsgResync}))
+		return
+	}
+	for _, re := range events {
+		for _, m := range w.filterEvent(ctx, re.Event, session) {
+			session.Write(WithSequence(m, w.Ring.Epoch(), re.Seq))
+		}
 	}
 
-	taskOwner := event.TaskUpdated.TriggerOwner
+}
+
+// filterEvent computes the messages that a given session is allowed to receive for an event.
+func (w *WebsocketHandler) filterEvent(ctx context.Context, event interface{}, session SessionData) [][]byte {
+
 	marshaller := jsonpb.Marshaler{}
-	message, _ := marshaller.MarshalToString(event)
-	return w.Websocket.BroadcastFilter([]byte(message), func(session *melody.Session) bool {
-		var isAdmin, o bool
-		var v interface{}
-		if v, o = session.Get(SessionProfileKey); o && v == common.PYDIO_PROFILE_ADMIN {
-			isAdmin = true
+	switch e := event.(type) {
+	case *NodeChangeEventWithInfo:
+		return w.filterNodeChangeEvent(ctx, e, session)
+	case *jobs.TaskChangeEvent:
+		if filterTaskChangeEvent(ctx, e, session) {
+			message, _ := marshaller.MarshalToString(e)
+			return [][]byte{[]byte(message)}
 		}
-		value, ok := session.Get(SessionUsernameKey)
-		if !ok || value == nil {
-			return false
+	case *idm.ChangeEvent:
+		if filterIDMChangeEvent(e, session) {
+			message, _ := marshaller.MarshalToString(e)
+			return [][]byte{[]byte(message)}
 		}
-		isOwner := value.(string) == taskOwner || (taskOwner == common.PYDIO_SYSTEM_USERNAME && isAdmin)
-		if isOwner {
-			log.Logger(ctx).Debug("Should Broadcast Task Event : ", zap.Any("task", event.TaskUpdated), zap.Any("job", event.Job))
-		} else {
-			log.Logger(ctx).Debug("Owner was " + taskOwner + " while session user was " + value.(string))
+	case *activity.PostActivityEvent:
+		if filterActivityEvent(e, session) {
+			message, _ := marshaller.MarshalToString(e)
+			return [][]byte{[]byte(message)}
 		}
-		return isOwner
-	})
+	}
+	return nil
 
 }
 
-// BroadcastIDMChangeEvent listens to ACL events and broadcast them to sessions if the Role, User, or Workspace is concerned
-// This triggers a registry reload in the UX (and eventually a change of permissions)
-func (w *WebsocketHandler) BroadcastIDMChangeEvent(ctx context.Context, event *idm.ChangeEvent) error {
+// filterNodeChangeEvent rewrites the event for each workspace of the session that can see its nodes.
+func (w *WebsocketHandler) filterNodeChangeEvent(ctx context.Context, event *NodeChangeEventWithInfo, session SessionData) (messages [][]byte) {
 
-	marshaller := jsonpb.Marshaler{}
-	event.JsonType = "idm"
-	message, _ := marshaller.MarshalToString(event)
-	return w.Websocket.BroadcastFilter([]byte(message), func(session *melody.Session) bool {
+	value, ok := session.Get(SessionWorkspacesKey)
+	if !ok || value == nil {
+		return
+	}
+	workspaces := value.(map[string]*idm.Workspace)
 
-		var checkRoleId string
-		var checkUserId string
-		var checkWorkspaceId string
-		if event.Acl != nil && event.Acl.RoleID != "" && !strings.HasPrefix(event.Acl.Action.Name, "parameter:") && !strings.HasPrefix(event.Acl.Action.Name, "action:") {
-			checkRoleId = event.Acl.RoleID
-		} else if event.Role != nil {
-			checkRoleId = event.Role.Uuid
-		} else if event.User != nil {
-			checkUserId = event.User.Uuid
-		} else if event.Workspace != nil {
-			checkWorkspaceId = event.Workspace.UUID
+	// Rate-limit events (let Optimistic events always go through)
+	if lim, ok := session.Get(SessionLimiterKey); ok && lim != nil && !event.Optimistic {
+		limiter := lim.(*rate.Limiter)
+		if err := limiter.Wait(ctx); err != nil {
+			log.Logger(ctx).Warn("WebSocket: some events were dropped (session rate limiter)")
+			return
 		}
+	}
 
-		if checkUserId != "" {
-			if val, ok := session.Get(SessionUsernameKey); ok && val != nil {
-				return checkUserId == val.(string)
-			}
+	var (
+		metaCtx             context.Context
+		metaProviderClients []tree.NodeProviderStreamer_ReadNodeStreamClient
+		metaProviderNames   []string
+		metaProvidersCloser meta.MetaProviderCloser
+	)
+
+	// Never modify the event itself, it is shared by all sessions
+	target := event.Target
+	if event.refreshTarget && target != nil {
+		claims, _ := session.Get(SessionClaimsKey)
+		uName, _ := session.Get(SessionUsernameKey)
+		metaCtx = metadata.NewContext(context.Background(), map[string]string{
+			common.PYDIO_CONTEXT_USER_KEY: uName.(string),
+		})
+		metaCtx = auth.ToMetadata(metaCtx, claims.(claim.Claims))
+		metaProviderClients, metaProvidersCloser, metaProviderNames = meta.InitMetaProviderClients(metaCtx, false)
+		defer metaProvidersCloser()
+		if respNode, err := w.EventRouter.GetClientsPool().GetTreeClient().ReadNode(ctx, &tree.ReadNodeRequest{Node: target}); err == nil {
+			target = respNode.Node
 		}
+	}
 
-		if checkRoleId != "" {
-			if value, ok := session.Get(SessionRolesKey); ok && value != nil {
-				roles := value.([]*idm.Role)
-				for _, r := range roles {
-					if r.Uuid == checkRoleId {
-						return true
+	enrichedNodes := make(map[string]*tree.Node)
+	for wsId, workspace := range workspaces {
+		nTarget, t1 := w.EventRouter.WorkspaceCanSeeNode(ctx, workspace, target)
+		nSource, t2 := w.EventRouter.WorkspaceCanSeeNode(ctx, workspace, event.Source)
+		// Depending on node, broadcast now
+		if t1 || t2 {
+			eType := event.Type
+			if nTarget != nil {
+				if event.refreshTarget {
+					if metaNode, ok := enrichedNodes[nTarget.Uuid]; ok {
+						for k, v := range metaNode.MetaStore {
+							nTarget.MetaStore[k] = v
+						}
+					} else {
+						metaNode = nTarget.Clone()
+						meta.EnrichNodesMetaFromProviders(metaCtx, metaProviderClients, metaProviderNames, metaNode)
+						for k, v := range metaNode.MetaStore {
+							nTarget.MetaStore[k] = v
+						}
+						enrichedNodes[nTarget.Uuid] = metaNode
 					}
 				}
+				nTarget.SetMeta("EventWorkspaceId", workspace.UUID)
+				nTarget = nTarget.WithoutReservedMetas()
+				log.Logger(ctx).Debug("Broadcasting event to this session for workspace", zap.Any("type", event.Type), zap.String("wsId", wsId), zap.Any("path", target.Path))
 			}
+			if nSource != nil {
+				nSource.SetMeta("EventWorkspaceId", workspace.UUID)
+				nSource = nSource.WithoutReservedMetas()
+			}
+			// Eventually update event type if one node is out of scope
+			if eType == tree.NodeChangeEvent_UPDATE_PATH {
+				if nSource == nil {
+					eType = tree.NodeChangeEvent_CREATE
+				} else if nTarget == nil {
+					eType = tree.NodeChangeEvent_DELETE
+				}
+			}
+			// We have to filter the event for this context
+			marshaler := &jsonpb.Marshaler{}
+			s, _ := marshaler.MarshalToString(&tree.NodeChangeEvent{
+				Type:   eType,
+				Target: nTarget,
+				Source: nSource,
+			})
+			messages = append(messages, []byte(s))
 		}
+	}
+
+	return
 
-		if checkWorkspaceId != "" {
-			if value, ok := session.Get(SessionWorkspacesKey); ok && value != nil {
-				if _, has := value.(map[string]*idm.Workspace)[checkWorkspaceId]; has {
+}
+
+// filterTaskChangeEvent checks that the session user is the owner of the task.
+func filterTaskChangeEvent(ctx context.Context, event *jobs.TaskChangeEvent, session SessionData) bool {
+
+	taskOwner := event.TaskUpdated.TriggerOwner
+	var isAdmin, o bool
+	var v interface{}
+	if v, o = session.Get(SessionProfileKey); o && v == common.PYDIO_PROFILE_ADMIN {
+		isAdmin = true
+	}
+	value, ok := session.Get(SessionUsernameKey)
+	if !ok || value == nil {
+		return false
+	}
+	isOwner := value.(string) == taskOwner || (taskOwner == common.PYDIO_SYSTEM_USERNAME && isAdmin)
+	if isOwner {
+		log.Logger(ctx).Debug("Should Broadcast Task Event : ", zap.Any("task", event.TaskUpdated), zap.Any("job", event.Job))
+	} else {
+		log.Logger(ctx).Debug("Owner was " + taskOwner + " while session user was " + value.(string))
+	}
+	return isOwner
+
+}
+
+// filterIDMChangeEvent checks that the session user, one of its roles or one of its workspaces is concerned by the event.
+func filterIDMChangeEvent(event *idm.ChangeEvent, session SessionData) bool {
+
+	var checkRoleId string
+	var checkUserId string
+	var checkWorkspaceId string
+	if event.Acl != nil && event.Acl.RoleID != "" && !strings.HasPrefix(event.Acl.Action.Name, "parameter:") && !strings.HasPrefix(event.Acl.Action.Name, "action:") {
+		checkRoleId = event.Acl.RoleID
+	} else if event.Role != nil {
+		checkRoleId = event.Role.Uuid
+	} else if event.User != nil {
+		checkUserId = event.User.Uuid
+	} else if event.Workspace != nil {
+		checkWorkspaceId = event.Workspace.UUID
+	}
+
+	if checkUserId != "" {
+		if val, ok := session.Get(SessionUsernameKey); ok && val != nil {
+			return checkUserId == val.(string)
+		}
+	}
+
+	if checkRoleId != "" {
+		if value, ok := session.Get(SessionRolesKey); ok && value != nil {
+			roles := value.([]*idm.Role)
+			for _, r := range roles {
+				if r.Uuid == checkRoleId {
 					return true
 				}
 			}
 		}
+	}
 
-		return false
-	})
+	if checkWorkspaceId != "" {
+		if value, ok := session.Get(SessionWorkspacesKey); ok && value != nil {
+			if _, has := value.(map[string]*idm.Workspace)[checkWorkspaceId]; has {
+				return true
+			}
+		}
+	}
+
+	return false
 
 }
 
-// BroadcastActivityEvent listens to activities and broadcast them to sessions with the adequate user.
-func (w *WebsocketHandler) BroadcastActivityEvent(ctx context.Context, event *activity.PostActivityEvent) error {
+// filterActivityEvent checks that the session user is the owner of the inbox, but not the author of the activity.
+func filterActivityEvent(event *activity.PostActivityEvent, session SessionData) bool {
 
-	// Only handle "users inbox" events for now
-	if event.BoxName != "inbox" && event.OwnerType != activity.OwnerType_USER {
+	if val, ok := session.Get(SessionUsernameKey); ok && val != nil {
+		return event.OwnerId == val.(string) && event.Activity.Actor.Id != val.(string)
+	}
+	return false
+
+}
+
+// HandleSessionEvent disconnects the websocket sessions and SSE clients authenticated by revoked sessions.
+func (w *WebsocketHandler) HandleSessionEvent(ctx context.Context, event *auth2.SessionEvent) error {
+
+	revoked := NewRevokedSessions(event)
+	w.sseLock.RLock()
+	for c := range w.sseClients {
+		if revoked.Match(c) {
+			c.close()
+		}
+	}
+	w.sseLock.RUnlock()
+	return CloseRevokedSessions(w.Websocket, revoked)
+
+}
+
+// HandlePresenceEvent updates the presence store with events coming from any websocket instance,
+// and broadcasts them to local sessions if they changed something.
+func (w *WebsocketHandler) HandlePresenceEvent(ctx context.Context, event *presence.PresenceEvent) error {
+
+	if w.Presences.Handle(event) {
+		return w.BroadcastPresenceEvent(ctx, event)
+	}
+	return nil
+
+}
+
+// BroadcastPresenceEvent sends presence events to the sessions viewing the same folder.
+func (w *WebsocketHandler) BroadcastPresenceEvent(ctx context.Context, event *presence.PresenceEvent) error {
+
+	if w.Websocket == nil || event.Presence == nil {
 		return nil
 	}
 	marshaller := jsonpb.Marshaler{}
-	event.JsonType = "activity"
+	event.JsonType = "presence"
 	message, _ := marshaller.MarshalToString(event)
 	return w.Websocket.BroadcastFilter([]byte(message), func(session *melody.Session) bool {
-		if val, ok := session.Get(SessionUsernameKey); ok && val != nil {
-			return event.OwnerId == val.(string) && event.Activity.Actor.Id != val.(string)
+		value, ok := session.Get(SessionPresenceKey)
+		if !ok || value == nil {
+			return false
 		}
-		return false
+		own := value.(*presence.Presence)
+		return own.SessionId != event.Presence.SessionId && SameFolder(own, event.Presence)
 	})
 
 }
+
+// announcePresence registers the node currently viewed by the session user, and publishes the presence
+// to all websocket instances. The session receives the presences already registered on the same folder.
+func (w *WebsocketHandler) announcePresence(ctx context.Context, session *melody.Session, msg *Message) error {
+
+	userName, ok := session.Get(SessionUsernameKey)
+	if !ok || userName == nil {
+		return fmt.Errorf("presence requires ws subscription first")
+	}
+	if msg.NodeUuid == "" {
+		return fmt.Errorf("presence requires a node uuid")
+	}
+
+	eventType := presence.PresenceEventType_UPDATE
+	var p *presence.Presence
+	if value, ok := session.Get(SessionPresenceKey); ok && value != nil {
+		p = proto.Clone(value.(*presence.Presence)).(*presence.Presence)
+	}
+	if p == nil || p.NodeUuid != msg.NodeUuid {
+		parentUuid, err := w.resolvePresenceNode(ctx, session, msg.NodeUuid)
+		if err != nil {
+			return err
+		}
+		if p != nil {
+			w.leavePresence(ctx, session)
+		}
+		p = &presence.Presence{
+			SessionId:  uuid.New(),
+			User:       userName.(string),
+			NodeUuid:   msg.NodeUuid,
+			ParentUuid: parentUuid,
+		}
+		eventType = presence.PresenceEventType_JOIN
+	}
+	p.Editing = msg.Editing
+	p.Cursor = msg.Cursor
+	p.Expires = time.Now().Add(PresenceTTL).Unix()
+	session.Set(SessionPresenceKey, p)
+
+	if eventType == presence.PresenceEventType_JOIN {
+		marshaller := jsonpb.Marshaler{}
+		for _, other := range w.Presences.Around(p) {
+			message, _ := marshaller.MarshalToString(&presence.PresenceEvent{
+				JsonType: "presence",
+				Type:     presence.PresenceEventType_JOIN,
+				Presence: other,
+			})
+			session.Write([]byte(message))
+		}
+	}
+
+	return w.publishPresence(ctx, &presence.PresenceEvent{Type: eventType, Presence: p})
+
+}
+
+// leavePresence publishes a LEAVE event if the session had announced a presence.
+func (w *WebsocketHandler) leavePresence(ctx context.Context, session *melody.Session) {
+
+	value, ok := session.Get(SessionPresenceKey)
+	if !ok || value == nil {
+		return
+	}
+	session.Set(SessionPresenceKey, nil)
+	if e := w.publishPresence(ctx, &presence.PresenceEvent{Type: presence.PresenceEventType_LEAVE, Presence: value.(*presence.Presence)}); e != nil {
+		log.Logger(ctx).Error("Cannot publish presence event", zap.Error(e))
+	}
+
+}
+
+// resolvePresenceNode checks that the node is visible in one of the session workspaces, and returns the uuid of its parent.
+func (w *WebsocketHandler) resolvePresenceNode(ctx context.Context, session *melody.Session, nodeUuid string) (string, error) {
+
+	value, ok := session.Get(SessionWorkspacesKey)
+	if !ok || value == nil {
+		return "", fmt.Errorf("presence requires ws subscription first")
+	}
+	treeClient := w.EventRouter.GetClientsPool().GetTreeClient()
+	resp, err := treeClient.ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Uuid: nodeUuid}})
+	if err != nil {
+		return "", err
+	}
+	var visible bool
+	for _, workspace := range value.(map[string]*idm.Workspace) {
+		if _, visible = w.EventRouter.WorkspaceCanSeeNode(ctx, workspace, resp.Node); visible {
+			break
+		}
+	}
+	if !visible {
+		return "", fmt.Errorf("node %s is not accessible", nodeUuid)
+	}
+	parentPath := path.Dir(strings.TrimRight(resp.Node.Path, "/"))
+	if parentResp, e := treeClient.ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Path: parentPath}}); e == nil {
+		return parentResp.Node.Uuid, nil
+	}
+	return "", nil
+
+}
+
+func (w *WebsocketHandler) publishPresence(ctx context.Context, event *presence.PresenceEvent) error {
+	cl := defaults.NewClient()
+	return cl.Publish(ctx, cl.NewPublication(common.TOPIC_PRESENCE_EVENT, event))
+}
@@ -25,6 +25,8 @@ import (
 	"net/http"
 
 	"github.com/gorilla/mux"
+
+	"github.com/pydio/cells/common/service/context"
 )
 
 type route struct {
@@ -44,6 +46,7 @@ func NewRouter() *mux.Router {
 		handler = route.handlerFunc
 		handler = logger(handler, route.name)
 		handler = auth(handler)
+		handler = servicecontext.HttpMetaExtractorWrapper(handler)
 
 		router.
 			Methods(route.method).
@@ -34,6 +34,22 @@ type DAO interface {
 	ListTokens(offset int, count int) (chan *auth.Token, error)
 }
 
+// PatDAO stores personal access tokens, indexed by the hash of their value
+type PatDAO interface {
+	PutPat(t *auth.PersonalAccessToken, hash string) error
+	LoadPat(hash string) (*auth.PersonalAccessToken, error)
+	ListPats(userUuid string) ([]*auth.PersonalAccessToken, error)
+	DeletePat(uuid string) error
+}
+
+// SessionDAO stores the sessions opened by the issued tokens, indexed by their Uuid
+type SessionDAO interface {
+	PutSession(s *auth.Session) error
+	GetSession(uuid string) (*auth.Session, error)
+	ListSessions(userUuid string) ([]*auth.Session, error)
+	DeleteSession(uuid string) error
+}
+
 type DexDAO interface {
 	DexPruneOfflineSessions(c Config) (pruned int64, e error)
 	DexDeleteOfflineSessions(c Config, userUuid string, sessionUuid string) error
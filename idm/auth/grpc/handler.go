@@ -40,9 +40,10 @@ import (
 	"github.com/pydio/cells/idm/auth"
 )
 
-func NewAuthTokenRevokerHandler(dexConfig auth.Config) (proto.AuthTokenRevokerHandler, error) {
+func NewAuthTokenRevokerHandler(dexConfig auth.Config, sessions *SessionHandler) (proto.AuthTokenRevokerHandler, error) {
 	h := &TokenRevokerHandler{
 		dexConfig: dexConfig,
+		sessions:  sessions,
 	}
 	dataDir, e := config.ServiceDataDir(common.SERVICE_GRPC_NAMESPACE_ + common.SERVICE_AUTH)
 	if e != nil {
@@ -59,9 +60,10 @@ func NewAuthTokenRevokerHandler(dexConfig auth.Config) (proto.AuthTokenRevokerHa
 type TokenRevokerHandler struct {
 	dao       auth.DAO
 	dexConfig auth.Config
+	sessions  *SessionHandler
 }
 
-// MatchInvalid checks if token is part of revocation list
+// MatchInvalid checks if token is part of revocation list, or if its session was revoked
 func (h *TokenRevokerHandler) MatchInvalid(ctx context.Context, in *proto.MatchInvalidTokenRequest, out *proto.MatchInvalidTokenResponse) error {
 	info, err := h.dao.GetInfo(in.Token)
 	if err != nil || len(info) == 0 {
@@ -69,6 +71,10 @@ func (h *TokenRevokerHandler) MatchInvalid(ctx context.Context, in *proto.MatchI
 	} else {
 		out.State = proto.State_REVOKED
 	}
+	if out.State == proto.State_NO_MATCH && h.sessions != nil && h.sessions.matchRevoked(ctx, in.Token) {
+		out.State = proto.State_REVOKED
+		info = "session revoked"
+	}
 	out.RevocationInfo = info
 	return nil
 }
@@ -86,6 +92,9 @@ func (h *TokenRevokerHandler) Revoke(ctx context.Context, in *proto.RevokeTokenR
 			if dexDao, ok := servicecontext.GetDAO(ctx).(auth.DexDAO); ok {
 				dexDao.DexDeleteOfflineSessions(h.dexConfig, claimsUuid, claimsNonce)
 			}
+			if h.sessions != nil {
+				h.sessions.endSession(ctx, claims)
+			}
 		} else {
 			log.Logger(ctx).Error("Cannot unmarshall token", zap.Error(err), zap.Any("token", in.Token))
 		}
@@ -136,6 +145,12 @@ func (h *TokenRevokerHandler) PruneTokens(ctx context.Context, in *proto.PruneTo
 		log.Logger(ctx).Info("Cannot get dexDAO")
 	}
 
+	if h.sessions != nil {
+		if pruned := h.sessions.prune(ctx); pruned > 0 {
+			log.Logger(ctx).Info(fmt.Sprintf("Pruned %d expired sessions", pruned))
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package grpc
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"github.com/micro/go-micro/client"
+	"github.com/micro/go-micro/errors"
+	"github.com/pborman/uuid"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/common"
+	auth2 "github.com/pydio/cells/common/auth"
+	"github.com/pydio/cells/common/config"
+	"github.com/pydio/cells/common/log"
+	proto "github.com/pydio/cells/common/proto/auth"
+	"github.com/pydio/cells/idm/auth"
+)
+
+// lastUsedPrecision avoids writing the store on each request made with the same token
+const lastUsedPrecision = 60
+
+func NewPatHandler() (proto.PersonalAccessTokenServiceHandler, error) {
+	dataDir, e := config.ServiceDataDir(common.SERVICE_GRPC_NAMESPACE_ + common.SERVICE_AUTH)
+	if e != nil {
+		return nil, e
+	}
+	dao, err := auth.NewPatBoltStore(path.Join(dataDir, "auth-pat.db"))
+	if err != nil {
+		return nil, err
+	}
+	return &PatHandler{dao: dao}, nil
+}
+
+// PatHandler manages personal access tokens. Only the hash of their value is stored.
+type PatHandler struct {
+	dao auth.PatDAO
+}
+
+// Generate creates a new token and returns its value, that cannot be retrieved later on
+func (h *PatHandler) Generate(ctx context.Context, in *proto.PatGenerateRequest, out *proto.PatGenerateResponse) error {
+	t := in.Token
+	if t == nil || t.UserUuid == "" || t.UserLogin == "" {
+		return errors.BadRequest(common.SERVICE_AUTH, "please provide the user owning the token")
+	}
+	now := time.Now().Unix()
+	if t.ExpiresAt <= now {
+		return errors.BadRequest(common.SERVICE_AUTH, "please provide an expiration date in the future")
+	}
+	value, err := auth2.NewPersonalAccessTokenValue()
+	if err != nil {
+		return err
+	}
+	t.Uuid = uuid.New()
+	t.CreatedAt = now
+	t.LastUsedAt = 0
+	if err := h.dao.PutPat(t, auth2.HashPersonalAccessToken(value)); err != nil {
+		return err
+	}
+	out.Token = t
+	out.Value = value
+	return nil
+}
+
+// Verify finds a valid token by its value
+func (h *PatHandler) Verify(ctx context.Context, in *proto.PatVerifyRequest, out *proto.PatVerifyResponse) error {
+	hash := auth2.HashPersonalAccessToken(in.Value)
+	t, err := h.dao.LoadPat(hash)
+	if err != nil {
+		return err
+	}
+	if t == nil {
+		return errors.Unauthorized(common.SERVICE_AUTH, "invalid personal access token")
+	}
+	now := time.Now().Unix()
+	if t.ExpiresAt <= now {
+		if e := h.dao.DeletePat(t.Uuid); e != nil {
+			log.Logger(ctx).Error("cannot delete expired personal access token", zap.Error(e))
+		}
+		return errors.Unauthorized(common.SERVICE_AUTH, "personal access token has expired")
+	}
+	if now-t.LastUsedAt >= lastUsedPrecision {
+		t.LastUsedAt = now
+		if e := h.dao.PutPat(t, hash); e != nil {
+			log.Logger(ctx).Error("cannot update personal access token usage", zap.Error(e))
+		}
+	}
+	out.Token = t
+	return nil
+}
+
+// List lists the valid tokens of a user, or of all users if no UserUuid is passed
+func (h *PatHandler) List(ctx context.Context, in *proto.PatListRequest, out *proto.PatListResponse) error {
+	tokens, err := h.dao.ListPats(in.UserUuid)
+	if err != nil {
+		return err
+	}
+	now := time.Now().Unix()
+	for _, t := range tokens {
+		if t.ExpiresAt <= now {
+			if e := h.dao.DeletePat(t.Uuid); e != nil {
+				log.Logger(ctx).Error("cannot delete expired personal access token", zap.Error(e))
+			}
+			continue
+		}
+		out.Tokens = append(out.Tokens, t)
+	}
+	return nil
+}
+
+// Revoke deletes a token and tells the gateways to forget it
+func (h *PatHandler) Revoke(ctx context.Context, in *proto.PatRevokeRequest, out *proto.PatRevokeResponse) error {
+	if err := h.dao.DeletePat(in.Uuid); err != nil {
+		return err
+	}
+	out.Success = true
+	return client.Publish(ctx, client.NewPublication(common.TOPIC_SESSION_EVENT, &proto.SessionEvent{RevokedTokens: []string{in.Uuid}}))
+}
@@ -46,7 +46,7 @@ func init() {
 			service.Name(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_AUTH),
 			service.Tag(common.SERVICE_TAG_IDM),
 			service.WithStorage(auth.NewDAO, "dex_"),
-			service.Description("Authentication Service : JWT provider and token revocation"),
+			service.Description("Authentication Service : JWT provider, token revocation, sessions and personal access tokens"),
 			service.Dependency(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_USER, []string{}),
 			service.Dependency(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_POLICY, []string{}),
 			service.Dependency(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_ROLE, []string{}),
@@ -96,13 +96,27 @@ func init() {
 					}
 				}
 
-				tokenRevokerHandler, err := NewAuthTokenRevokerHandler(c)
+				sessionHandler, err := NewSessionHandler(c)
+				if err != nil {
+					return err
+				}
+
+				proto.RegisterSessionServiceHandler(m.Options().Server, sessionHandler)
+
+				tokenRevokerHandler, err := NewAuthTokenRevokerHandler(c, sessionHandler)
 				if err != nil {
 					return err
 				}
 
 				proto.RegisterAuthTokenRevokerHandler(m.Options().Server, tokenRevokerHandler)
 
+				patHandler, err := NewPatHandler()
+				if err != nil {
+					return err
+				}
+
+				proto.RegisterPersonalAccessTokenServiceHandler(m.Options().Server, patHandler)
+
 				return nil
 			}),
 		)
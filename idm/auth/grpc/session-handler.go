@@ -0,0 +1,307 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+	"time"
+
+	"github.com/micro/go-micro/client"
+	"github.com/micro/go-micro/errors"
+	"github.com/micro/go-micro/metadata"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/common"
+	auth2 "github.com/pydio/cells/common/auth"
+	"github.com/pydio/cells/common/auth/claim"
+	"github.com/pydio/cells/common/config"
+	"github.com/pydio/cells/common/log"
+	proto "github.com/pydio/cells/common/proto/auth"
+	"github.com/pydio/cells/common/service/context"
+	"github.com/pydio/cells/idm/auth"
+)
+
+// tokenTimes reads the standard dates of an IdToken, that are not part of claim.Claims
+type tokenTimes struct {
+	IssuedAt int64 `json:"iat"`
+	Expiry   int64 `json:"exp"`
+}
+
+func NewSessionHandler(dexConfig auth.Config) (*SessionHandler, error) {
+	dataDir, e := config.ServiceDataDir(common.SERVICE_GRPC_NAMESPACE_ + common.SERVICE_AUTH)
+	if e != nil {
+		return nil, e
+	}
+	dao, err := auth.NewSessionBoltStore(path.Join(dataDir, "auth-sessions.db"))
+	if err != nil {
+		return nil, err
+	}
+	return &SessionHandler{dao: dao, dexConfig: dexConfig}, nil
+}
+
+// SessionHandler records a session for each login, along with the client that opened it. Revoking a session
+// deletes its refresh tokens and rejects the IdTokens it already issued.
+type SessionHandler struct {
+	dao       auth.SessionDAO
+	dexConfig auth.Config
+}
+
+// Register creates the session of an IdToken, or updates it when the token was obtained by a refresh
+func (h *SessionHandler) Register(ctx context.Context, in *proto.SessionRegisterRequest, out *proto.SessionRegisterResponse) error {
+	claims, times, err := parseIdToken(in.IdToken)
+	if err != nil {
+		return errors.BadRequest(common.SERVICE_AUTH, "cannot parse token: %s", err.Error())
+	}
+	sessionUuid := auth2.SessionUuid(claims)
+	if sessionUuid == "" {
+		return errors.BadRequest(common.SERVICE_AUTH, "token does not belong to a session")
+	}
+	session, err := h.dao.GetSession(sessionUuid)
+	if err != nil {
+		return err
+	}
+	now := time.Now().Unix()
+	if times.IssuedAt == 0 {
+		times.IssuedAt = now
+	}
+	if session == nil {
+		userUuid, _ := claims.DecodeUserUuid()
+		session = &proto.Session{
+			Uuid:      sessionUuid,
+			UserUuid:  userUuid,
+			UserLogin: claims.Name,
+			ClientId:  claims.ClientApp,
+			Nonce:     claims.Nonce,
+			CreatedAt: now,
+		}
+	} else if sessionRevoked(session) && times.IssuedAt > session.RevokedAt {
+		// Logins without nonce share the same session, that is opened again
+		session.CreatedAt = now
+	}
+	sessionClientFromContext(ctx, session)
+	session.LastActivity = now
+	session.IssuedAt = times.IssuedAt
+	session.ExpiresAt = times.Expiry
+	if in.HasRefreshToken {
+		session.HasRefreshToken = true
+	}
+	if err := h.dao.PutSession(session); err != nil {
+		return err
+	}
+	out.Session = session
+	return nil
+}
+
+// List lists the active sessions of a user, or of all users if no UserUuid is passed
+func (h *SessionHandler) List(ctx context.Context, in *proto.SessionListRequest, out *proto.SessionListResponse) error {
+	sessions, err := h.dao.ListSessions(in.UserUuid)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, s := range sessions {
+		if h.sessionExpired(s, now) {
+			if e := h.dao.DeleteSession(s.Uuid); e != nil {
+				log.Logger(ctx).Error("cannot delete expired session", zap.Error(e))
+			}
+			continue
+		}
+		if !sessionRevoked(s) {
+			out.Sessions = append(out.Sessions, s)
+		}
+	}
+	return nil
+}
+
+// Revoke invalidates a session, or all the active sessions of a user
+func (h *SessionHandler) Revoke(ctx context.Context, in *proto.SessionRevokeRequest, out *proto.SessionRevokeResponse) error {
+	var sessions []*proto.Session
+	if in.Uuid != "" {
+		session, err := h.dao.GetSession(in.Uuid)
+		if err != nil {
+			return err
+		}
+		if session == nil || sessionRevoked(session) || (in.UserUuid != "" && session.UserUuid != in.UserUuid) {
+			return errors.NotFound(common.SERVICE_AUTH, "cannot find session %s", in.Uuid)
+		}
+		sessions = append(sessions, session)
+	} else if in.UserUuid != "" {
+		listResp := &proto.SessionListResponse{}
+		if err := h.List(ctx, &proto.SessionListRequest{UserUuid: in.UserUuid}, listResp); err != nil {
+			return err
+		}
+		sessions = listResp.Sessions
+	} else {
+		return errors.BadRequest(common.SERVICE_AUTH, "please provide a session or a user")
+	}
+	if err := h.revoke(ctx, sessions); err != nil {
+		return err
+	}
+	out.Sessions = sessions
+	return nil
+}
+
+// revoke marks the sessions as revoked, deletes their refresh tokens and tells the gateways to forget them
+func (h *SessionHandler) revoke(ctx context.Context, sessions []*proto.Session) error {
+	if len(sessions) == 0 {
+		return nil
+	}
+	dexDao, _ := servicecontext.GetDAO(ctx).(auth.DexDAO)
+	now := time.Now().Unix()
+	for _, s := range sessions {
+		s.RevokedAt = now
+		s.HasRefreshToken = false
+		if err := h.dao.PutSession(s); err != nil {
+			return err
+		}
+		if dexDao != nil {
+			if e := dexDao.DexDeleteOfflineSessions(h.dexConfig, s.UserUuid, s.Nonce); e != nil {
+				log.Logger(ctx).Error("cannot delete refresh tokens of session", zap.String("session", s.Uuid), zap.Error(e))
+			}
+		}
+	}
+	return client.Publish(ctx, client.NewPublication(common.TOPIC_SESSION_EVENT, &proto.SessionEvent{Revoked: sessions}))
+}
+
+// matchRevoked checks if an IdToken was issued by a revoked session. Otherwise it updates the session activity.
+func (h *SessionHandler) matchRevoked(ctx context.Context, idToken string) bool {
+	claims, times, err := parseIdToken(idToken)
+	if err != nil {
+		return false
+	}
+	sessionUuid := auth2.SessionUuid(claims)
+	if sessionUuid == "" {
+		return false
+	}
+	session, err := h.dao.GetSession(sessionUuid)
+	if err != nil || session == nil {
+		return false
+	}
+	if session.RevokedAt > 0 && times.IssuedAt <= session.RevokedAt {
+		return true
+	}
+	if now := time.Now().Unix(); now-session.LastActivity >= lastUsedPrecision {
+		session.LastActivity = now
+		sessionClientFromContext(ctx, session)
+		if e := h.dao.PutSession(session); e != nil {
+			log.Logger(ctx).Error("cannot update session activity", zap.Error(e))
+		}
+	}
+	return false
+}
+
+// endSession revokes the session of a token that is being revoked, on logout
+func (h *SessionHandler) endSession(ctx context.Context, claims claim.Claims) {
+	sessionUuid := auth2.SessionUuid(claims)
+	if sessionUuid == "" {
+		return
+	}
+	session, err := h.dao.GetSession(sessionUuid)
+	if err != nil || session == nil || sessionRevoked(session) {
+		return
+	}
+	if e := h.revoke(ctx, []*proto.Session{session}); e != nil {
+		log.Logger(ctx).Error("cannot revoke session", zap.Error(e))
+	}
+}
+
+// prune deletes the sessions that cannot issue valid tokens anymore
+func (h *SessionHandler) prune(ctx context.Context) (pruned int) {
+	sessions, err := h.dao.ListSessions("")
+	if err != nil {
+		log.Logger(ctx).Error("cannot list sessions", zap.Error(err))
+		return
+	}
+	now := time.Now()
+	for _, s := range sessions {
+		if h.sessionExpired(s, now) {
+			if e := h.dao.DeleteSession(s.Uuid); e == nil {
+				pruned++
+			}
+		}
+	}
+	return
+}
+
+// sessionExpired checks if the last IdToken of a session is expired, and if its refresh token is expired as well
+func (h *SessionHandler) sessionExpired(s *proto.Session, now time.Time) bool {
+	if s.ExpiresAt == 0 || time.Unix(s.ExpiresAt, 0).After(now) {
+		return false
+	}
+	if !s.HasRefreshToken {
+		return true
+	}
+	for _, c := range h.dexConfig.StaticClients {
+		if c.ID != s.ClientId || c.RefreshTokensExpiry == "" {
+			continue
+		}
+		expiry, e := time.ParseDuration(c.RefreshTokensExpiry)
+		if e != nil {
+			return false
+		}
+		ref := s.CreatedAt
+		if c.OfflineSessionsSliding {
+			ref = s.LastActivity
+		}
+		return now.After(time.Unix(ref, 0).Add(expiry))
+	}
+	return false
+}
+
+// sessionRevoked tells if a session was revoked after issuing its last token
+func sessionRevoked(s *proto.Session) bool {
+	return s.RevokedAt > 0 && s.IssuedAt <= s.RevokedAt
+}
+
+// sessionClientFromContext reads the client information forwarded in the request metadata
+func sessionClientFromContext(ctx context.Context, s *proto.Session) {
+	meta, ok := metadata.FromContext(ctx)
+	if !ok {
+		return
+	}
+	if addr := meta[servicecontext.HttpMetaRemoteAddress]; addr != "" {
+		s.RemoteAddress = addr
+	}
+	if ua := meta[servicecontext.HttpMetaUserAgent]; ua != "" {
+		s.UserAgent = ua
+		s.Device = auth2.DeviceFromUserAgent(ua)
+	}
+	if clientType := meta[servicecontext.HttpMetaClientType]; clientType != "" {
+		s.ClientType = clientType
+	}
+}
+
+// parseIdToken reads the claims and dates of an IdToken, without verifying it
+func parseIdToken(idToken string) (claim.Claims, tokenTimes, error) {
+	var claims claim.Claims
+	var times tokenTimes
+	payload, err := parseJWT(idToken)
+	if err != nil {
+		return claims, times, err
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return claims, times, err
+	}
+	err = json.Unmarshal(payload, &times)
+	return claims, times, err
+}
@@ -0,0 +1,145 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package auth
+
+import (
+	"encoding/json"
+	"time"
+
+	bolt "github.com/etcd-io/bbolt"
+
+	"github.com/pydio/cells/common/proto/auth"
+)
+
+var (
+	patBucket     = []byte("pats")
+	patHashBucket = []byte("pats-hashes")
+)
+
+// storedPat is the stored form of a token, along with the hash of its value
+type storedPat struct {
+	Token *auth.PersonalAccessToken
+	Hash  string
+}
+
+// PatBoltStore implements PatDAO with a bolt database
+type PatBoltStore struct {
+	db *bolt.DB
+}
+
+func NewPatBoltStore(filename string) (*PatBoltStore, error) {
+
+	options := bolt.DefaultOptions
+	options.Timeout = 5 * time.Second
+	db, err := bolt.Open(filename, 0644, options)
+	if err != nil {
+		return nil, err
+	}
+
+	er := db.Update(func(tx *bolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(patBucket); err != nil {
+			return err
+		}
+		_, err := tx.CreateBucketIfNotExists(patHashBucket)
+		return err
+	})
+	if er != nil {
+		db.Close()
+		return nil, er
+	}
+
+	return &PatBoltStore{db: db}, nil
+}
+
+func (b *PatBoltStore) Close() error {
+	return b.db.Close()
+}
+
+// PutPat creates or updates a token
+func (b *PatBoltStore) PutPat(t *auth.PersonalAccessToken, hash string) error {
+	data, err := json.Marshal(&storedPat{Token: t, Hash: hash})
+	if err != nil {
+		return err
+	}
+	return b.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(patBucket).Put([]byte(t.Uuid), data); err != nil {
+			return err
+		}
+		return tx.Bucket(patHashBucket).Put([]byte(hash), []byte(t.Uuid))
+	})
+}
+
+// LoadPat finds a token by the hash of its value, it returns nil if there is none
+func (b *PatBoltStore) LoadPat(hash string) (*auth.PersonalAccessToken, error) {
+	var stored *storedPat
+	e := b.db.View(func(tx *bolt.Tx) error {
+		id := tx.Bucket(patHashBucket).Get([]byte(hash))
+		if id == nil {
+			return nil
+		}
+		data := tx.Bucket(patBucket).Get(id)
+		if data == nil {
+			return nil
+		}
+		stored = &storedPat{}
+		return json.Unmarshal(data, stored)
+	})
+	if e != nil || stored == nil {
+		return nil, e
+	}
+	return stored.Token, nil
+}
+
+// ListPats lists the tokens of a user, or all tokens if userUuid is empty
+func (b *PatBoltStore) ListPats(userUuid string) ([]*auth.PersonalAccessToken, error) {
+	var tokens []*auth.PersonalAccessToken
+	e := b.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(patBucket).ForEach(func(k, v []byte) error {
+			stored := &storedPat{}
+			if err := json.Unmarshal(v, stored); err != nil {
+				return err
+			}
+			if userUuid == "" || stored.Token.UserUuid == userUuid {
+				tokens = append(tokens, stored.Token)
+			}
+			return nil
+		})
+	})
+	return tokens, e
+}
+
+// DeletePat deletes a token and its hash
+func (b *PatBoltStore) DeletePat(uuid string) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(patBucket)
+		data := bucket.Get([]byte(uuid))
+		if data == nil {
+			return nil
+		}
+		stored := &storedPat{}
+		if err := json.Unmarshal(data, stored); err == nil {
+			if err := tx.Bucket(patHashBucket).Delete([]byte(stored.Hash)); err != nil {
+				return err
+			}
+		}
+		return bucket.Delete([]byte(uuid))
+	})
+}
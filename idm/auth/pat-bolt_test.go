@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package auth
+
+import (
+	"os"
+	"testing"
+
+	"github.com/pydio/cells/common/proto/auth"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestPatBoltStore(t *testing.T) {
+
+	patFile := os.TempDir() + "/bolt-pat-test.db"
+	defer os.Remove(patFile)
+
+	var patStore *PatBoltStore
+
+	convey.Convey("Test Create and Open bolt db", t, func() {
+		var e error
+		patStore, e = NewPatBoltStore(patFile)
+		convey.So(e, convey.ShouldBeNil)
+		convey.So(patStore, convey.ShouldNotBeNil)
+	})
+
+	convey.Convey("Test Put and Load tokens", t, func() {
+		convey.So(patStore.PutPat(&auth.PersonalAccessToken{Uuid: "p1", UserUuid: "u1", Label: "script"}, "h1"), convey.ShouldBeNil)
+		convey.So(patStore.PutPat(&auth.PersonalAccessToken{Uuid: "p2", UserUuid: "u1"}, "h2"), convey.ShouldBeNil)
+		convey.So(patStore.PutPat(&auth.PersonalAccessToken{Uuid: "p3", UserUuid: "u2"}, "h3"), convey.ShouldBeNil)
+
+		p, e := patStore.LoadPat("h1")
+		convey.So(e, convey.ShouldBeNil)
+		convey.So(p, convey.ShouldNotBeNil)
+		convey.So(p.Label, convey.ShouldEqual, "script")
+
+		p, e = patStore.LoadPat("unknown")
+		convey.So(e, convey.ShouldBeNil)
+		convey.So(p, convey.ShouldBeNil)
+	})
+
+	convey.Convey("Test List tokens", t, func() {
+		tokens, e := patStore.ListPats("u1")
+		convey.So(e, convey.ShouldBeNil)
+		convey.So(tokens, convey.ShouldHaveLength, 2)
+
+		tokens, e = patStore.ListPats("")
+		convey.So(e, convey.ShouldBeNil)
+		convey.So(tokens, convey.ShouldHaveLength, 3)
+	})
+
+	convey.Convey("Test Delete token", t, func() {
+		convey.So(patStore.DeletePat("p1"), convey.ShouldBeNil)
+		p, e := patStore.LoadPat("h1")
+		convey.So(e, convey.ShouldBeNil)
+		convey.So(p, convey.ShouldBeNil)
+		tokens, _ := patStore.ListPats("u1")
+		convey.So(tokens, convey.ShouldHaveLength, 1)
+		convey.So(patStore.Close(), convey.ShouldBeNil)
+	})
+
+}
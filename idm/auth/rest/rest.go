@@ -30,7 +30,9 @@ import (
 	"github.com/pborman/uuid"
 
 	"github.com/pydio/cells/common"
+	auth2 "github.com/pydio/cells/common/auth"
 	"github.com/pydio/cells/common/auth/claim"
+	"github.com/pydio/cells/common/log"
 	"github.com/pydio/cells/common/micro"
 	"github.com/pydio/cells/common/proto/auth"
 	"github.com/pydio/cells/common/proto/docstore"
@@ -40,6 +42,7 @@ import (
 	"github.com/pydio/cells/common/registry"
 	"github.com/pydio/cells/common/service"
 	"github.com/pydio/cells/common/utils/permissions"
+	"github.com/pydio/cells/idm/user"
 )
 
 type TokenHandler struct{}
@@ -190,9 +193,6 @@ func (a *TokenHandler) ResetPassword(req *restful.Request, resp *restful.Respons
 		service.RestError500(req, resp, e)
 		return
 	}
-	// Delete in store token now
-	cli.DeleteDocuments(ctx, &docstore.DeleteDocumentsRequest{StoreID: common.DOCSTORE_ID_RESET_PASS_KEYS, DocumentID: token})
-
 	jsonData := docResp.Document.Data
 	var storedToken ResetToken
 	if e := json.Unmarshal([]byte(jsonData), &storedToken); e != nil {
@@ -200,7 +200,12 @@ func (a *TokenHandler) ResetPassword(req *restful.Request, resp *restful.Respons
 		return
 	}
 	response := &rest.ResetPasswordResponse{}
-	if time.Unix(int64(storedToken.Expiration), 0).Before(time.Now()) {
+	expired := time.Unix(int64(storedToken.Expiration), 0).Before(time.Now())
+	if expired || storedToken.UserLogin != input.UserLogin {
+		// Delete in store token now
+		cli.DeleteDocuments(ctx, &docstore.DeleteDocumentsRequest{StoreID: common.DOCSTORE_ID_RESET_PASS_KEYS, DocumentID: token})
+	}
+	if expired {
 		response.Success = false
 		response.Message = "Token is expired, please follow again the reset password process!"
 		return
@@ -210,6 +215,12 @@ func (a *TokenHandler) ResetPassword(req *restful.Request, resp *restful.Respons
 		response.Message = "Token is does not correspond to this user identifier!"
 		return
 	}
+	// The token is valid: tell about the password policy, and let the user try again with the same token
+	if e := auth2.DefaultPasswordPolicy().Validate(input.NewPassword); e != nil {
+		service.RestError403(req, resp, e)
+		return
+	}
+	cli.DeleteDocuments(ctx, &docstore.DeleteDocumentsRequest{StoreID: common.DOCSTORE_ID_RESET_PASS_KEYS, DocumentID: token})
 	u, e := permissions.SearchUniqueUser(ctx, storedToken.UserLogin, "")
 	if e != nil {
 		response.Success = false
@@ -217,9 +228,17 @@ func (a *TokenHandler) ResetPassword(req *restful.Request, resp *restful.Respons
 		return
 	}
 	u.Password = input.NewPassword
+	// The password has just been changed, release a forced change
+	if user.HasLock(u, user.LockPassChange) {
+		user.SetLock(u, user.LockPassChange, false)
+	}
 	userClient := idm.NewUserServiceClient(registry.GetClient(common.SERVICE_USER))
 	if _, e := userClient.CreateUser(ctx, &idm.CreateUserRequest{User: u}); e != nil {
-		service.RestError500(req, resp, fmt.Errorf("Error while trying to set new password!"))
+		if errors.Parse(e.Error()).Code == 403 {
+			service.RestError403(req, resp, e)
+		} else {
+			service.RestError500(req, resp, fmt.Errorf("Error while trying to set new password!"))
+		}
 		return
 	}
 
@@ -246,3 +265,316 @@ func (a *TokenHandler) ResetPassword(req *restful.Request, resp *restful.Respons
 	resp.WriteEntity(response)
 
 }
+
+// GeneratePersonalAccessToken creates a token for the current user. The token value is only returned once.
+func (a *TokenHandler) GeneratePersonalAccessToken(req *restful.Request, resp *restful.Response) {
+
+	ctx := req.Request.Context()
+	claims, ok := ctx.Value(claim.ContextKey).(claim.Claims)
+	if !ok {
+		service.RestError403(req, resp, errors.Forbidden(common.SERVICE_AUTH, "please log in to create a personal access token"))
+		return
+	}
+	if claims.PersonalAccessToken != "" {
+		service.RestError403(req, resp, errors.Forbidden(common.SERVICE_AUTH, "personal access tokens cannot be used to create other tokens"))
+		return
+	}
+
+	var input rest.PersonalAccessTokenRequest
+	if e := req.ReadEntity(&input); e != nil {
+		service.RestError500(req, resp, errors.BadRequest(common.SERVICE_AUTH, "Cannot decode input request"))
+		return
+	}
+	if input.Label == "" {
+		service.RestError500(req, resp, errors.BadRequest(common.SERVICE_AUTH, "please provide a label for the token"))
+		return
+	}
+
+	user, e := permissions.SearchUniqueUser(ctx, claims.Name, "")
+	if e != nil {
+		service.RestError500(req, resp, e)
+		return
+	}
+
+	// Workspaces can be passed by slug, they are stored by UUID
+	var workspaces []string
+	if len(input.Workspaces) > 0 {
+		accessList, e := permissions.AccessListFromContextClaims(ctx)
+		if e != nil {
+			service.RestError500(req, resp, e)
+			return
+		}
+		for _, id := range input.Workspaces {
+			var found string
+			for _, ws := range accessList.Workspaces {
+				if ws.UUID == id || ws.Slug == id {
+					found = ws.UUID
+					break
+				}
+			}
+			if found == "" {
+				service.RestError403(req, resp, errors.Forbidden(common.SERVICE_AUTH, "cannot find workspace %s", id))
+				return
+			}
+			workspaces = append(workspaces, found)
+		}
+	}
+
+	cli := auth.NewPersonalAccessTokenServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_AUTH, defaults.NewClient())
+	rsp, e := cli.Generate(ctx, &auth.PatGenerateRequest{Token: &auth.PersonalAccessToken{
+		Label:         input.Label,
+		UserUuid:      user.Uuid,
+		UserLogin:     user.Login,
+		ExpiresAt:     input.ExpiresAt,
+		Workspaces:    workspaces,
+		ReadOnly:      input.ReadOnly,
+		RestResources: input.RestResources,
+	}})
+	if e != nil {
+		service.RestError500(req, resp, e)
+		return
+	}
+
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("Created personal access token [%s]", input.Label),
+		log.GetAuditId(common.AUDIT_PAT_CREATE),
+	)
+
+	resp.WriteEntity(&rest.PersonalAccessTokenResponse{Token: rsp.Token, Value: rsp.Value})
+}
+
+// ListPersonalAccessTokens lists the tokens of the current user. Admins can list the tokens of another user.
+func (a *TokenHandler) ListPersonalAccessTokens(req *restful.Request, resp *restful.Response) {
+
+	ctx := req.Request.Context()
+	claims, ok := ctx.Value(claim.ContextKey).(claim.Claims)
+	if !ok {
+		service.RestError403(req, resp, errors.Forbidden(common.SERVICE_AUTH, "please log in to list personal access tokens"))
+		return
+	}
+	login := claims.Name
+	if l := req.QueryParameter("UserLogin"); l != "" && l != login {
+		if claims.Profile != common.PYDIO_PROFILE_ADMIN {
+			service.RestError403(req, resp, errors.Forbidden(common.SERVICE_AUTH, "only admins can list the tokens of other users"))
+			return
+		}
+		login = l
+	}
+	user, e := permissions.SearchUniqueUser(ctx, login, "")
+	if e != nil {
+		service.RestError404(req, resp, e)
+		return
+	}
+
+	cli := auth.NewPersonalAccessTokenServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_AUTH, defaults.NewClient())
+	rsp, e := cli.List(ctx, &auth.PatListRequest{UserUuid: user.Uuid})
+	if e != nil {
+		service.RestError500(req, resp, e)
+		return
+	}
+
+	resp.WriteEntity(&rest.PersonalAccessTokenCollection{Tokens: rsp.Tokens})
+}
+
+// RevokePersonalAccessToken deletes a token owned by the current user, or any token for admins.
+func (a *TokenHandler) RevokePersonalAccessToken(req *restful.Request, resp *restful.Response) {
+
+	ctx := req.Request.Context()
+	claims, ok := ctx.Value(claim.ContextKey).(claim.Claims)
+	if !ok {
+		service.RestError403(req, resp, errors.Forbidden(common.SERVICE_AUTH, "please log in to revoke personal access tokens"))
+		return
+	}
+	tokenUuid := req.PathParameter("Uuid")
+
+	cli := auth.NewPersonalAccessTokenServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_AUTH, defaults.NewClient())
+	var listRequest *auth.PatListRequest
+	if claims.Profile == common.PYDIO_PROFILE_ADMIN {
+		listRequest = &auth.PatListRequest{}
+	} else {
+		user, e := permissions.SearchUniqueUser(ctx, claims.Name, "")
+		if e != nil {
+			service.RestError500(req, resp, e)
+			return
+		}
+		listRequest = &auth.PatListRequest{UserUuid: user.Uuid}
+	}
+	listResp, e := cli.List(ctx, listRequest)
+	if e != nil {
+		service.RestError500(req, resp, e)
+		return
+	}
+	var token *auth.PersonalAccessToken
+	for _, t := range listResp.Tokens {
+		if t.Uuid == tokenUuid {
+			token = t
+			break
+		}
+	}
+	if token == nil {
+		service.RestError404(req, resp, errors.NotFound(common.SERVICE_AUTH, "cannot find personal access token %s", tokenUuid))
+		return
+	}
+
+	if _, e := cli.Revoke(ctx, &auth.PatRevokeRequest{Uuid: token.Uuid}); e != nil {
+		service.RestError500(req, resp, e)
+		return
+	}
+
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("Revoked personal access token [%s] of user [%s]", token.Label, token.UserLogin),
+		log.GetAuditId(common.AUDIT_PAT_REVOKE),
+	)
+
+	resp.WriteEntity(&rest.RevokeResponse{Success: true, Message: "Personal access token successfully revoked"})
+}
+
+// sessionsOwner finds the user whose sessions are managed: the current user, or any user for admins.
+func (a *TokenHandler) sessionsOwner(req *restful.Request, resp *restful.Response, claims claim.Claims, userLogin string) (*idm.User, bool) {
+	login := claims.Name
+	if userLogin != "" && userLogin != login {
+		if claims.Profile != common.PYDIO_PROFILE_ADMIN {
+			service.RestError403(req, resp, errors.Forbidden(common.SERVICE_AUTH, "only admins can manage the sessions of other users"))
+			return nil, false
+		}
+		login = userLogin
+	}
+	user, e := permissions.SearchUniqueUser(req.Request.Context(), login, "")
+	if e != nil {
+		service.RestError404(req, resp, e)
+		return nil, false
+	}
+	return user, true
+}
+
+// ListSessions lists the active sessions of the current user. Admins can list the sessions of another user.
+func (a *TokenHandler) ListSessions(req *restful.Request, resp *restful.Response) {
+
+	ctx := req.Request.Context()
+	claims, ok := ctx.Value(claim.ContextKey).(claim.Claims)
+	if !ok {
+		service.RestError403(req, resp, errors.Forbidden(common.SERVICE_AUTH, "please log in to list sessions"))
+		return
+	}
+	user, ok := a.sessionsOwner(req, resp, claims, req.QueryParameter("UserLogin"))
+	if !ok {
+		return
+	}
+
+	cli := auth.NewSessionServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_AUTH, defaults.NewClient())
+	rsp, e := cli.List(ctx, &auth.SessionListRequest{UserUuid: user.Uuid})
+	if e != nil {
+		service.RestError500(req, resp, e)
+		return
+	}
+
+	resp.WriteEntity(&rest.SessionCollection{Sessions: rsp.Sessions, CurrentSession: auth2.SessionUuid(claims)})
+}
+
+// RevokeSessions revokes all the sessions of the current user, or of another user for admins.
+func (a *TokenHandler) RevokeSessions(req *restful.Request, resp *restful.Response) {
+
+	ctx := req.Request.Context()
+	claims, ok := ctx.Value(claim.ContextKey).(claim.Claims)
+	if !ok {
+		service.RestError403(req, resp, errors.Forbidden(common.SERVICE_AUTH, "please log in to revoke sessions"))
+		return
+	}
+	var input rest.RevokeSessionsRequest
+	if e := req.ReadEntity(&input); e != nil {
+		service.RestError500(req, resp, errors.BadRequest(common.SERVICE_AUTH, "Cannot decode input request"))
+		return
+	}
+	user, ok := a.sessionsOwner(req, resp, claims, input.UserLogin)
+	if !ok {
+		return
+	}
+
+	cli := auth.NewSessionServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_AUTH, defaults.NewClient())
+	var revoked int
+	if input.KeepCurrent {
+		listResp, e := cli.List(ctx, &auth.SessionListRequest{UserUuid: user.Uuid})
+		if e != nil {
+			service.RestError500(req, resp, e)
+			return
+		}
+		current := auth2.SessionUuid(claims)
+		for _, s := range listResp.Sessions {
+			if s.Uuid == current {
+				continue
+			}
+			if _, e := cli.Revoke(ctx, &auth.SessionRevokeRequest{Uuid: s.Uuid, UserUuid: user.Uuid}); e != nil {
+				service.RestError500(req, resp, e)
+				return
+			}
+			revoked++
+		}
+	} else {
+		rsp, e := cli.Revoke(ctx, &auth.SessionRevokeRequest{UserUuid: user.Uuid})
+		if e != nil {
+			service.RestError500(req, resp, e)
+			return
+		}
+		revoked = len(rsp.Sessions)
+	}
+
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("Revoked %d sessions of user [%s]", revoked, user.Login),
+		log.GetAuditId(common.AUDIT_SESSION_REVOKE),
+	)
+
+	resp.WriteEntity(&rest.RevokeResponse{Success: true, Message: fmt.Sprintf("%d sessions successfully revoked", revoked)})
+}
+
+// RevokeSession revokes a session of the current user, or any session for admins.
+func (a *TokenHandler) RevokeSession(req *restful.Request, resp *restful.Response) {
+
+	ctx := req.Request.Context()
+	claims, ok := ctx.Value(claim.ContextKey).(claim.Claims)
+	if !ok {
+		service.RestError403(req, resp, errors.Forbidden(common.SERVICE_AUTH, "please log in to revoke sessions"))
+		return
+	}
+	sessionUuid := req.PathParameter("Uuid")
+
+	cli := auth.NewSessionServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_AUTH, defaults.NewClient())
+	var listRequest *auth.SessionListRequest
+	if claims.Profile == common.PYDIO_PROFILE_ADMIN {
+		listRequest = &auth.SessionListRequest{}
+	} else {
+		user, e := permissions.SearchUniqueUser(ctx, claims.Name, "")
+		if e != nil {
+			service.RestError500(req, resp, e)
+			return
+		}
+		listRequest = &auth.SessionListRequest{UserUuid: user.Uuid}
+	}
+	listResp, e := cli.List(ctx, listRequest)
+	if e != nil {
+		service.RestError500(req, resp, e)
+		return
+	}
+	var session *auth.Session
+	for _, s := range listResp.Sessions {
+		if s.Uuid == sessionUuid {
+			session = s
+			break
+		}
+	}
+	if session == nil {
+		service.RestError404(req, resp, errors.NotFound(common.SERVICE_AUTH, "cannot find session %s", sessionUuid))
+		return
+	}
+
+	if _, e := cli.Revoke(ctx, &auth.SessionRevokeRequest{Uuid: session.Uuid}); e != nil {
+		service.RestError500(req, resp, e)
+		return
+	}
+
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("Revoked session [%s] of user [%s] on [%s]", session.Uuid, session.UserLogin, session.Device),
+		log.GetAuditId(common.AUDIT_SESSION_REVOKE),
+	)
+
+	resp.WriteEntity(&rest.RevokeResponse{Success: true, Message: "Session successfully revoked"})
+}
@@ -0,0 +1,117 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package auth
+
+import (
+	"encoding/json"
+	"time"
+
+	bolt "github.com/etcd-io/bbolt"
+
+	"github.com/pydio/cells/common/proto/auth"
+)
+
+var (
+	sessionBucket = []byte("sessions")
+)
+
+// SessionBoltStore implements SessionDAO with a bolt database
+type SessionBoltStore struct {
+	db *bolt.DB
+}
+
+func NewSessionBoltStore(filename string) (*SessionBoltStore, error) {
+
+	options := bolt.DefaultOptions
+	options.Timeout = 5 * time.Second
+	db, err := bolt.Open(filename, 0644, options)
+	if err != nil {
+		return nil, err
+	}
+
+	er := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(sessionBucket)
+		return err
+	})
+	if er != nil {
+		db.Close()
+		return nil, er
+	}
+
+	return &SessionBoltStore{db: db}, nil
+}
+
+func (b *SessionBoltStore) Close() error {
+	return b.db.Close()
+}
+
+// PutSession creates or updates a session
+func (b *SessionBoltStore) PutSession(s *auth.Session) error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(sessionBucket).Put([]byte(s.Uuid), data)
+	})
+}
+
+// GetSession finds a session by its Uuid, it returns nil if there is none
+func (b *SessionBoltStore) GetSession(uuid string) (*auth.Session, error) {
+	var session *auth.Session
+	e := b.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(sessionBucket).Get([]byte(uuid))
+		if data == nil {
+			return nil
+		}
+		session = &auth.Session{}
+		return json.Unmarshal(data, session)
+	})
+	if e != nil {
+		return nil, e
+	}
+	return session, nil
+}
+
+// ListSessions lists the sessions of a user, or all sessions if userUuid is empty
+func (b *SessionBoltStore) ListSessions(userUuid string) ([]*auth.Session, error) {
+	var sessions []*auth.Session
+	e := b.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(sessionBucket).ForEach(func(k, v []byte) error {
+			session := &auth.Session{}
+			if err := json.Unmarshal(v, session); err != nil {
+				return err
+			}
+			if userUuid == "" || session.UserUuid == userUuid {
+				sessions = append(sessions, session)
+			}
+			return nil
+		})
+	})
+	return sessions, e
+}
+
+// DeleteSession deletes a session
+func (b *SessionBoltStore) DeleteSession(uuid string) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(sessionBucket).Delete([]byte(uuid))
+	})
+}
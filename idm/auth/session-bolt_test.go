@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package auth
+
+import (
+	"os"
+	"testing"
+
+	"github.com/pydio/cells/common/proto/auth"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestSessionBoltStore(t *testing.T) {
+
+	sessionFile := os.TempDir() + "/bolt-session-test.db"
+	defer os.Remove(sessionFile)
+
+	var sessionStore *SessionBoltStore
+
+	convey.Convey("Test Create and Open bolt db", t, func() {
+		var e error
+		sessionStore, e = NewSessionBoltStore(sessionFile)
+		convey.So(e, convey.ShouldBeNil)
+		convey.So(sessionStore, convey.ShouldNotBeNil)
+	})
+
+	convey.Convey("Test Put and Get sessions", t, func() {
+		convey.So(sessionStore.PutSession(&auth.Session{Uuid: "s1", UserUuid: "u1", Device: "Firefox on Linux"}), convey.ShouldBeNil)
+		convey.So(sessionStore.PutSession(&auth.Session{Uuid: "s2", UserUuid: "u1"}), convey.ShouldBeNil)
+		convey.So(sessionStore.PutSession(&auth.Session{Uuid: "s3", UserUuid: "u2"}), convey.ShouldBeNil)
+
+		s, e := sessionStore.GetSession("s1")
+		convey.So(e, convey.ShouldBeNil)
+		convey.So(s, convey.ShouldNotBeNil)
+		convey.So(s.Device, convey.ShouldEqual, "Firefox on Linux")
+
+		s.LastActivity = 10
+		convey.So(sessionStore.PutSession(s), convey.ShouldBeNil)
+		s, _ = sessionStore.GetSession("s1")
+		convey.So(s.LastActivity, convey.ShouldEqual, 10)
+
+		s, e = sessionStore.GetSession("unknown")
+		convey.So(e, convey.ShouldBeNil)
+		convey.So(s, convey.ShouldBeNil)
+	})
+
+	convey.Convey("Test List sessions", t, func() {
+		sessions, e := sessionStore.ListSessions("u1")
+		convey.So(e, convey.ShouldBeNil)
+		convey.So(sessions, convey.ShouldHaveLength, 2)
+
+		sessions, e = sessionStore.ListSessions("")
+		convey.So(e, convey.ShouldBeNil)
+		convey.So(sessions, convey.ShouldHaveLength, 3)
+	})
+
+	convey.Convey("Test Delete session", t, func() {
+		convey.So(sessionStore.DeleteSession("s1"), convey.ShouldBeNil)
+		s, e := sessionStore.GetSession("s1")
+		convey.So(e, convey.ShouldBeNil)
+		convey.So(s, convey.ShouldBeNil)
+		sessions, _ := sessionStore.ListSessions("u1")
+		convey.So(sessions, convey.ShouldHaveLength, 1)
+		convey.So(sessionStore.Close(), convey.ShouldBeNil)
+	})
+
+}
@@ -33,6 +33,8 @@ import (
 	"github.com/sirupsen/logrus"
 	"go.uber.org/zap"
 
+	"github.com/pydio/cells/common/auth/dex"
+	"github.com/pydio/cells/common/auth/mfa"
 	"github.com/pydio/cells/common/service"
 	servicecontext "github.com/pydio/cells/common/service/context"
 	"github.com/pydio/cells/idm/auth"
@@ -148,6 +150,22 @@ func serve(c auth.Config, pydioSrvContext context.Context, pydioLogger *zap.Logg
 		}
 		storageConnectors[i] = conn
 
+		// Sub-connectors using a browser flow (SAML) are exposed as separate connectors
+		if wrapper, ok := c.Config.(*dex.WrapperConfig); ok {
+			for _, ext := range wrapper.ExternalConnectors(logger) {
+				extConn, err := auth.ToStorageConnector(auth.Connector{
+					Type:   c.Type,
+					ID:     ext.Name,
+					Name:   ext.Name,
+					Config: &dex.WrapperConfig{Connectors: []dex.ConnectorConfig{ext}},
+				})
+				if err != nil {
+					return nil, fmt.Errorf("failed to initialize storage connectors: %v", err)
+				}
+				logger.Infof("config connector: %s", ext.Name)
+				storageConnectors = append(storageConnectors, extConn)
+			}
+		}
 	}
 
 	if c.EnablePasswordDB {
@@ -211,7 +229,8 @@ func serve(c auth.Config, pydioSrvContext context.Context, pydioLogger *zap.Logg
 		dexServer = serv
 	}
 
-	wrapped := servicecontext.HttpMetaExtractorWrapper(dexServer)
+	wrapped := servicecontext.HttpMetaExtractorWrapper(sessionsRecorderWrapper(dexServer))
+	wrapped = mfa.HttpCodeWrapper(wrapped)
 	wrapped = servicecontext.HttpSpanHandlerWrapper(wrapped)
 	wrapped = service.NewLogHttpHandlerWrapper(wrapped, servicecontext.GetServiceName(pydioSrvContext), servicecontext.GetServiceColor(pydioSrvContext))
 
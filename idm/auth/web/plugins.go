@@ -44,6 +44,7 @@ func init() {
 
 	plugins.Register(func() {
 		dex.RegisterWrapperConnectorMiddleware("Login", dex.WrapWithIdmUser)
+		dex.RegisterWrapperConnectorMiddleware("Login", dex.WrapWithMfa)
 		dex.RegisterWrapperConnectorMiddleware("Login", dex.WrapWithUserLocks)
 		dex.RegisterWrapperConnectorMiddleware("Login", dex.WrapWithPolicyCheck)
 		dex.RegisterWrapperConnectorMiddleware("Login", dex.WrapWithIdentity)
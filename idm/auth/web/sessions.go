@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package grpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/micro"
+	"github.com/pydio/cells/common/proto/auth"
+)
+
+// tokenResponseRecorder copies the response of the token endpoint while it is written to the client
+type tokenResponseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (r *tokenResponseRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *tokenResponseRecorder) Write(data []byte) (int, error) {
+	r.body.Write(data)
+	return r.ResponseWriter.Write(data)
+}
+
+// sessionsRecorderWrapper registers a session for each IdToken issued by the token endpoint, be it by
+// a login or by a refresh. It expects the HTTP metadata to be already extracted in the request context.
+func sessionsRecorderWrapper(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || !strings.HasSuffix(r.URL.Path, "/token") {
+			h.ServeHTTP(w, r)
+			return
+		}
+		recorder := &tokenResponseRecorder{ResponseWriter: w, status: http.StatusOK}
+		h.ServeHTTP(recorder, r)
+		if recorder.status != http.StatusOK {
+			return
+		}
+		var tokens struct {
+			IdToken      string `json:"id_token"`
+			RefreshToken string `json:"refresh_token"`
+		}
+		if e := json.Unmarshal(recorder.body.Bytes(), &tokens); e != nil || tokens.IdToken == "" {
+			return
+		}
+		ctx := r.Context()
+		cli := auth.NewSessionServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_AUTH, defaults.NewClient())
+		if _, e := cli.Register(ctx, &auth.SessionRegisterRequest{
+			IdToken:         tokens.IdToken,
+			HasRefreshToken: tokens.RefreshToken != "",
+		}); e != nil {
+			log.Logger(ctx).Error("cannot register session for issued token", zap.Error(e))
+		}
+	})
+}
@@ -24,19 +24,23 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"path"
 	"strings"
 
 	"github.com/micro/go-micro/client"
+	"github.com/micro/go-micro/errors"
 	"go.uber.org/zap"
 
 	"github.com/pydio/cells/common"
 	"github.com/pydio/cells/common/auth"
 	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/micro"
 	"github.com/pydio/cells/common/proto/idm"
 	"github.com/pydio/cells/common/proto/tree"
 	"github.com/pydio/cells/common/service/context"
 	"github.com/pydio/cells/common/service/proto"
 	"github.com/pydio/cells/common/utils/cache"
+	meta2 "github.com/pydio/cells/common/utils/meta"
 	"github.com/pydio/cells/idm/meta"
 )
 
@@ -61,6 +65,31 @@ func (h *Handler) UpdateUserMeta(ctx context.Context, request *idm.UpdateUserMet
 	dao := servicecontext.GetDAO(ctx).(meta.DAO)
 	namespaces, _ := dao.GetNamespaceDao().List()
 	var nodeUuids []string
+	changedNamespaces := make(map[string][]string)
+	for _, metadata := range request.MetaDatas {
+		target := metadata
+		if request.Operation == idm.UpdateUserMetaRequest_DELETE && (target.Namespace == "" || target.NodeUuid == "") && target.Uuid != "" {
+			// Deletion by uuid only: load stored meta to know its namespace and node
+			if stored, e := dao.Search([]string{target.Uuid}, []string{}, "", "", nil); e == nil && len(stored) > 0 {
+				target = stored[0]
+			}
+		}
+		ns, ok := namespaces[target.Namespace]
+		if !ok {
+			continue
+		}
+		changedNamespaces[target.NodeUuid] = append(changedNamespaces[target.NodeUuid], target.Namespace)
+		if request.Operation == idm.UpdateUserMetaRequest_PUT {
+			if e := h.validateUserMeta(ctx, ns, target); e != nil {
+				return e
+			}
+		}
+		if def, _ := meta2.ParseNamespaceDefinition(ns); def != nil && (request.Operation == idm.UpdateUserMetaRequest_DELETE || def.IsEmpty(target.JsonValue)) {
+			if e := h.checkRequired(ctx, ns, target.NodeUuid); e != nil {
+				return e
+			}
+		}
+	}
 	for _, metadata := range request.MetaDatas {
 		h.clearCacheForNode(metadata.NodeUuid)
 		if request.Operation == idm.UpdateUserMetaRequest_PUT {
@@ -100,8 +129,9 @@ func (h *Handler) UpdateUserMeta(ctx context.Context, request *idm.UpdateUserMet
 				}
 			}
 			client.Publish(bgCtx, client.NewPublication(common.TOPIC_META_CHANGES, &tree.NodeChangeEvent{
-				Type:   tree.NodeChangeEvent_UPDATE_USER_META,
-				Target: node,
+				Type:     tree.NodeChangeEvent_UPDATE_USER_META,
+				Target:   node,
+				Metadata: map[string]string{meta2.EventChangedNamespaces: strings.Join(changedNamespaces[nodeId], ",")},
 			}))
 		}
 	}()
@@ -136,6 +166,13 @@ func (h *Handler) ReadNodeStream(ctx context.Context, stream tree.NodeProviderSt
 		return e
 	}
 
+	var inheritable map[string]struct{}
+	if namespaces, e := dao.GetNamespaceDao().List(); e == nil {
+		inheritable = meta2.InheritableNamespaces(namespaces)
+	}
+	// Nodes of a stream are often siblings: resolve their ancestors once per parent path
+	ancestorsByParent := make(map[string][]*tree.Node)
+
 	for {
 		req, er := stream.Recv()
 		if req == nil {
@@ -145,34 +182,100 @@ func (h *Handler) ReadNodeStream(ctx context.Context, stream tree.NodeProviderSt
 			return er
 		}
 		node := req.Node
-		var results []*idm.UserMeta
-		var err error
-		if r, ok := h.resultsFromCache(node.Uuid, subjects); ok {
-			results = r
-		} else {
-			results, err = dao.Search([]string{}, []string{node.Uuid}, "", "", &service.ResourcePolicyQuery{
-				Subjects: subjects,
-			})
-			log.Logger(ctx).Debug("Got Results For Node", node.ZapUuid(), zap.Any("results", results))
-			if err == nil {
-				h.resultsToCache(node.Uuid, subjects, results)
-			}
+		if node.MetaStore == nil {
+			node.MetaStore = make(map[string]string)
 		}
+		results, err := h.searchNodeMetas(ctx, dao, node.Uuid, subjects)
 		if err == nil && len(results) > 0 {
 			for _, result := range results {
 				node.MetaStore[result.Namespace] = result.JsonValue
 			}
 		}
+		if len(inheritable) > 0 {
+			h.applyInherited(ctx, dao, node, subjects, inheritable, ancestorsByParent)
+		}
 		stream.Send(&tree.ReadNodeResponse{Node: node})
 	}
 
 	return nil
 }
 
+// searchNodeMetas loads the metadata directly attached to a node, using the cache if possible.
+func (h *Handler) searchNodeMetas(ctx context.Context, dao meta.DAO, nodeUuid string, subjects []string) ([]*idm.UserMeta, error) {
+	if r, ok := h.resultsFromCache(nodeUuid, subjects); ok {
+		return r, nil
+	}
+	results, err := dao.Search([]string{}, []string{nodeUuid}, "", "", &service.ResourcePolicyQuery{
+		Subjects: subjects,
+	})
+	log.Logger(ctx).Debug("Got Results For Node", zap.String("uuid", nodeUuid), zap.Any("results", results))
+	if err == nil {
+		h.resultsToCache(nodeUuid, subjects, results)
+	}
+	return results, err
+}
+
+// applyInherited loads the metadata of the node ancestors and sets the effective values of inheritable namespaces.
+// Ancestors are stored in the passed map under the parent path of the node, to be reused for its siblings.
+func (h *Handler) applyInherited(ctx context.Context, dao meta.DAO, node *tree.Node, subjects []string, inheritable map[string]struct{}, ancestorsByParent map[string][]*tree.Node) {
+	if !meta2.IsInheritanceSource(node) {
+		return
+	}
+	var parentPath string
+	if node.Path != "" {
+		parentPath = path.Dir(strings.TrimRight(node.Path, "/"))
+	}
+	ancestors, ok := ancestorsByParent[parentPath]
+	if !ok || parentPath == "" {
+		var e error
+		if ancestors, e = h.loadAncestors(ctx, dao, node, subjects, inheritable); e != nil {
+			log.Logger(ctx).Debug("Cannot load ancestors for inherited metadata", node.ZapUuid(), zap.Error(e))
+			return
+		}
+		if parentPath != "" {
+			ancestorsByParent[parentPath] = ancestors
+		}
+	}
+	meta2.ApplyInherited(node, inheritable, ancestors...)
+}
+
+// loadAncestors lists the ancestors of a node that can be the source of inherited metadata, from the closest
+// to the root, along with the values of their inheritable namespaces.
+func (h *Handler) loadAncestors(ctx context.Context, dao meta.DAO, node *tree.Node, subjects []string, inheritable map[string]struct{}) ([]*tree.Node, error) {
+	treeClient := tree.NewNodeProviderClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_TREE, defaults.NewClient())
+	parents, e := tree.BuildAncestorsList(ctx, treeClient, &tree.Node{Uuid: node.Uuid})
+	if e != nil {
+		return nil, e
+	}
+	var ancestors []*tree.Node
+	for _, p := range parents {
+		if p.Uuid == node.Uuid || !meta2.IsInheritanceSource(p) {
+			continue
+		}
+		ancestor := &tree.Node{Uuid: p.Uuid, Path: p.Path, MetaStore: make(map[string]string)}
+		if results, er := h.searchNodeMetas(ctx, dao, p.Uuid, subjects); er == nil {
+			for _, result := range results {
+				if _, ok := inheritable[result.Namespace]; ok {
+					ancestor.MetaStore[result.Namespace] = result.JsonValue
+				}
+			}
+		}
+		ancestors = append(ancestors, ancestor)
+	}
+	return ancestors, nil
+}
+
 // Update/Delete a namespace.
 func (h *Handler) UpdateUserMetaNamespace(ctx context.Context, request *idm.UpdateUserMetaNamespaceRequest, response *idm.UpdateUserMetaNamespaceResponse) error {
 
 	dao := servicecontext.GetDAO(ctx).(meta.DAO).GetNamespaceDao()
+	if request.Operation == idm.UpdateUserMetaNamespaceRequest_PUT {
+		for _, metaNameSpace := range request.Namespaces {
+			if _, e := meta2.ParseNamespaceDefinition(metaNameSpace); e != nil {
+				return errors.BadRequest(common.SERVICE_USER_META, "%s", e.Error())
+			}
+		}
+	}
 	for _, metaNameSpace := range request.Namespaces {
 		if err := dao.Del(metaNameSpace); err != nil {
 			return err
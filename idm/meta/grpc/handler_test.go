@@ -26,6 +26,7 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/micro/go-micro/errors"
 	"github.com/micro/go-micro/metadata"
 
 	"github.com/pydio/cells/common/config"
@@ -59,7 +60,7 @@ func TestMain(m *testing.M) {
 		return
 	}
 
-	ctx = servicecontext.WithDAO(ctx, mockDAO)
+	ctx = servicecontext.WithDAO(context.Background(), mockDAO)
 	ctx = metadata.NewContext(ctx, map[string]string{})
 
 	m.Run()
@@ -88,4 +89,38 @@ func TestRole(t *testing.T) {
 
 	})
 
+	Convey("Test Required Meta", t, func() {
+
+		namespaces := []*idm.UserMetaNamespace{{
+			Namespace:      "usermeta-required",
+			Label:          "Required",
+			JsonDefinition: `{"type":"string","required":["*"]}`,
+		}}
+		err := h.UpdateUserMetaNamespace(ctx, &idm.UpdateUserMetaNamespaceRequest{Namespaces: namespaces, Operation: idm.UpdateUserMetaNamespaceRequest_PUT}, &idm.UpdateUserMetaNamespaceResponse{})
+		So(err, ShouldBeNil)
+
+		resp := &idm.UpdateUserMetaResponse{}
+		err = h.UpdateUserMeta(ctx, &idm.UpdateUserMetaRequest{
+			Operation: idm.UpdateUserMetaRequest_PUT,
+			MetaDatas: []*idm.UserMeta{{NodeUuid: "required-node", Namespace: "usermeta-required", JsonValue: `"value"`}},
+		}, resp)
+		So(err, ShouldBeNil)
+		So(resp.MetaDatas, ShouldHaveLength, 1)
+
+		err = h.UpdateUserMeta(ctx, &idm.UpdateUserMetaRequest{
+			Operation: idm.UpdateUserMetaRequest_PUT,
+			MetaDatas: []*idm.UserMeta{{NodeUuid: "required-node", Namespace: "usermeta-required", JsonValue: `""`}},
+		}, &idm.UpdateUserMetaResponse{})
+		So(err, ShouldNotBeNil)
+		So(errors.Parse(err.Error()).Code, ShouldEqual, 403)
+
+		err = h.UpdateUserMeta(ctx, &idm.UpdateUserMetaRequest{
+			Operation: idm.UpdateUserMetaRequest_DELETE,
+			MetaDatas: []*idm.UserMeta{{Uuid: resp.MetaDatas[0].Uuid}},
+		}, &idm.UpdateUserMetaResponse{})
+		So(err, ShouldNotBeNil)
+		So(errors.Parse(err.Error()).Code, ShouldEqual, 403)
+
+	})
+
 }
@@ -0,0 +1,146 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/micro/go-micro/errors"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/micro"
+	"github.com/pydio/cells/common/proto/docstore"
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/tree"
+	meta2 "github.com/pydio/cells/common/utils/meta"
+	"github.com/pydio/cells/common/utils/permissions"
+)
+
+// validateUserMeta checks a value against the typed definition of its namespace, including references
+// to users, nodes and to the managed vocabulary of tags.
+func (h *Handler) validateUserMeta(ctx context.Context, ns *idm.UserMetaNamespace, metadata *idm.UserMeta) error {
+
+	def, e := meta2.ParseNamespaceDefinition(ns)
+	if e != nil {
+		return errors.InternalServerError(common.SERVICE_USER_META, "%s", e.Error())
+	}
+	if def == nil {
+		return nil
+	}
+	value, e := def.Validate(metadata.JsonValue)
+	if e != nil {
+		return errors.BadRequest(common.SERVICE_USER_META, "Invalid value for %s: %s", ns.Label, e.Error())
+	}
+	if value == nil || def.IsEmpty(metadata.JsonValue) {
+		return nil
+	}
+	switch def.Type {
+	case meta2.NsTypeUser:
+		if _, er := permissions.SearchUniqueUser(ctx, value.(string), ""); er != nil {
+			return errors.BadRequest(common.SERVICE_USER_META, "Invalid value for %s: cannot find user %s", ns.Label, value)
+		}
+	case meta2.NsTypeNode:
+		treeClient := tree.NewNodeProviderClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_TREE, defaults.NewClient())
+		if _, er := treeClient.ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Uuid: value.(string)}}); er != nil {
+			return errors.BadRequest(common.SERVICE_USER_META, "Invalid value for %s: cannot find node %s", ns.Label, value)
+		}
+	case meta2.NsTypeTags:
+		if !def.Vocabulary {
+			return nil
+		}
+		vocabulary := h.vocabulary(ctx, ns.Namespace)
+		for _, tag := range def.Tags(metadata.JsonValue) {
+			if _, ok := vocabulary[tag]; !ok {
+				return errors.BadRequest(common.SERVICE_USER_META, "Invalid value for %s: %s is not part of the vocabulary", ns.Label, tag)
+			}
+		}
+	}
+	return nil
+}
+
+// vocabulary loads the tags stored for a namespace.
+func (h *Handler) vocabulary(ctx context.Context, namespace string) map[string]struct{} {
+	tags := make(map[string]struct{})
+	docClient := docstore.NewDocStoreClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_DOCSTORE, defaults.NewClient())
+	r, e := docClient.GetDocument(ctx, &docstore.GetDocumentRequest{StoreID: common.DOCSTORE_ID_META_TAGS, DocumentID: namespace})
+	if e != nil || r.Document == nil {
+		return tags
+	}
+	var stored []string
+	if e := json.Unmarshal([]byte(r.Document.Data), &stored); e == nil {
+		for _, t := range stored {
+			tags[t] = struct{}{}
+		}
+	}
+	return tags
+}
+
+// checkRequired refuses to empty a value that is required in one of the workspaces the node belongs to.
+func (h *Handler) checkRequired(ctx context.Context, ns *idm.UserMetaNamespace, nodeUuid string) error {
+
+	def, e := meta2.ParseNamespaceDefinition(ns)
+	if e != nil {
+		return errors.InternalServerError(common.SERVICE_USER_META, "%s", e.Error())
+	}
+	if def == nil || len(def.Required) == 0 {
+		return nil
+	}
+	if def.IsRequired() {
+		return errors.Forbidden(common.SERVICE_USER_META, "A value is required for %s in this workspace", ns.Label)
+	}
+	workspaces, e := h.nodeWorkspaces(ctx, nodeUuid, def.Required)
+	if e != nil {
+		return errors.Forbidden(common.SERVICE_USER_META, "Cannot check if a value is required for %s: %s", ns.Label, e.Error())
+	}
+	if def.IsRequired(workspaces...) {
+		return errors.Forbidden(common.SERVICE_USER_META, "A value is required for %s in this workspace", ns.Label)
+	}
+	return nil
+}
+
+// nodeWorkspaces finds which of the given workspaces have their root on the node or on one of its ancestors.
+func (h *Handler) nodeWorkspaces(ctx context.Context, nodeUuid string, workspaces []string) (found []string, e error) {
+
+	acls, e := permissions.GetACLsForWorkspace(ctx, workspaces, &idm.ACLAction{Name: permissions.AclWsrootActionName})
+	if e != nil {
+		return nil, e
+	}
+	roots := make(map[string][]string, len(acls))
+	for _, acl := range acls {
+		roots[acl.NodeID] = append(roots[acl.NodeID], acl.WorkspaceID)
+	}
+	if len(roots) == 0 {
+		return nil, nil
+	}
+	treeClient := tree.NewNodeProviderClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_TREE, defaults.NewClient())
+	ancestors, e := tree.BuildAncestorsList(ctx, treeClient, &tree.Node{Uuid: nodeUuid})
+	if e != nil {
+		return nil, e
+	}
+	found = append(found, roots[nodeUuid]...)
+	for _, a := range ancestors {
+		if a.Uuid != nodeUuid {
+			found = append(found, roots[a.Uuid]...)
+		}
+	}
+	return found, nil
+}
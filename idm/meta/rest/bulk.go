@@ -0,0 +1,394 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/emicklei/go-restful"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/micro/go-micro/errors"
+	"github.com/pborman/uuid"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/auth/claim"
+	"github.com/pydio/cells/common/config"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/micro"
+	"github.com/pydio/cells/common/proto/jobs"
+	"github.com/pydio/cells/common/proto/rest"
+	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/registry"
+	"github.com/pydio/cells/common/service"
+	serviceproto "github.com/pydio/cells/common/service/proto"
+	"github.com/pydio/cells/common/utils/i18n"
+	meta2 "github.com/pydio/cells/common/utils/meta"
+	"github.com/pydio/cells/common/views"
+	"github.com/pydio/cells/idm/meta/namespace"
+	"github.com/pydio/cells/scheduler/lang"
+)
+
+var (
+	router *views.Router
+)
+
+func getRouter() *views.Router {
+	if router == nil {
+		router = views.NewStandardRouter(views.RouterOptions{WatchRegistry: true})
+	}
+	return router
+}
+
+// BulkUpdateUserMeta applies the same metadata change to a list of nodes or to the results of a search query.
+func (s *UserMetaHandler) BulkUpdateUserMeta(req *restful.Request, rsp *restful.Response) {
+
+	var input rest.BulkUpdateUserMetaRequest
+	if e := req.ReadEntity(&input); e != nil {
+		service.RestError500(req, rsp, e)
+		return
+	}
+	ctx := req.Request.Context()
+	applier, e := meta2.NewBulkApplier(ctx, defaults.NewClient())
+	if e != nil {
+		service.RestError500(req, rsp, e)
+		return
+	}
+	ns, ok := applier.Namespaces()[input.Namespace]
+	if !ok || ns.Namespace == namespace.ReservedNamespaceBookmark {
+		service.RestError404(req, rsp, errors.NotFound(common.SERVICE_USER_META, "Namespace %s is not defined!", input.Namespace))
+		return
+	}
+	if !s.MatchPolicies(ctx, ns.Namespace, ns.Policies, serviceproto.ResourcePolicyAction_WRITE) {
+		service.RestError403(req, rsp, errors.Forbidden(common.SERVICE_USER_META, "You are not authorized to write on namespace %s", ns.Namespace))
+		return
+	}
+	def, _ := meta2.ParseNamespaceDefinition(ns)
+	value := input.JsonValue
+	if input.Delete {
+		value = ""
+		if def != nil && len(def.Required) > 0 {
+			service.RestError403(req, rsp, errors.Forbidden(common.SERVICE_USER_META, "Values of %s are required and cannot be deleted in bulk", ns.Label))
+			return
+		}
+	} else if value == "" {
+		service.RestError500(req, rsp, fmt.Errorf("please provide a value"))
+		return
+	} else if def != nil {
+		if _, e := def.Validate(value); e != nil {
+			service.RestError500(req, rsp, e)
+			return
+		}
+	}
+	if len(input.NodePaths) == 0 && input.Query == nil {
+		service.RestError500(req, rsp, fmt.Errorf("please provide nodes or a search query"))
+		return
+	}
+
+	// Convert user paths to internal paths, this checks that the user can access the nodes
+	selector := &jobs.NodesSelector{Collect: true}
+	prefixes := make(map[string]string)
+	e = getRouter().WrapCallback(func(inputFilter views.NodeFilter, outputFilter views.NodeFilter) error {
+		for _, p := range input.NodePaths {
+			_, n, er := inputFilter(ctx, &tree.Node{Path: strings.Trim(p, "/")}, "bulk")
+			if er != nil {
+				return er
+			}
+			prefixes[n.Path] = p
+			selector.Pathes = append(selector.Pathes, n.Path)
+		}
+		if input.Query != nil {
+			if len(input.Query.PathPrefix) == 0 {
+				return errors.Forbidden(common.SERVICE_USER_META, "Search queries must be restricted to a folder")
+			}
+			for i, p := range input.Query.PathPrefix {
+				_, n, er := inputFilter(ctx, &tree.Node{Path: strings.Trim(p, "/")}, "bulk")
+				if er != nil {
+					return er
+				}
+				prefixes[n.Path] = p
+				input.Query.PathPrefix[i] = n.Path
+			}
+			q, _ := ptypes.MarshalAny(input.Query)
+			selector.Query = &serviceproto.Query{SubQueries: []*any.Any{q}}
+		}
+		return nil
+	})
+	if e != nil {
+		service.RestErrorDetect(req, rsp, e)
+		return
+	}
+
+	if input.DryRun {
+		changes := meta2.BulkChangesForNodes(selectNodes(ctx, selector), ns.Namespace, value)
+		applier.Prepare(ctx, changes)
+		rsp.WriteEntity(bulkResponse("", changes, prefixes))
+		return
+	}
+
+	jobUuid, e := s.bulkJob(req, map[string]string{
+		"metaName":  ns.Namespace,
+		"metaValue": value,
+		"delete":    strconv.FormatBool(input.Delete),
+	}, selector)
+	if e != nil {
+		service.RestErrorDetect(req, rsp, e)
+		return
+	}
+	rsp.WriteEntity(&rest.UserMetaBulkResponse{JobUuid: jobUuid})
+
+}
+
+// ImportUserMeta applies the rows of a CSV file (path, namespace, value), an empty value deleting the metadata.
+func (s *UserMetaHandler) ImportUserMeta(req *restful.Request, rsp *restful.Response) {
+
+	var input rest.ImportUserMetaRequest
+	if e := req.ReadEntity(&input); e != nil {
+		service.RestError500(req, rsp, e)
+		return
+	}
+	ctx := req.Request.Context()
+	applier, e := meta2.NewBulkApplier(ctx, defaults.NewClient())
+	if e != nil {
+		service.RestError500(req, rsp, e)
+		return
+	}
+	nsList := applier.Namespaces()
+	changes, e := meta2.ReadBulkCSV(strings.NewReader(input.CsvContent), nsList)
+	if e != nil {
+		service.RestError500(req, rsp, e)
+		return
+	}
+
+	writable := make(map[string]bool)
+	prefixes := make(map[string]string)
+	getRouter().WrapCallback(func(inputFilter views.NodeFilter, outputFilter views.NodeFilter) error {
+		for _, c := range changes {
+			if c.Error != "" {
+				continue
+			}
+			ns := nsList[c.Namespace]
+			if _, ok := writable[ns.Namespace]; !ok {
+				writable[ns.Namespace] = ns.Namespace != namespace.ReservedNamespaceBookmark && s.MatchPolicies(ctx, ns.Namespace, ns.Policies, serviceproto.ResourcePolicyAction_WRITE)
+			}
+			if !writable[ns.Namespace] {
+				c.Error = "you are not authorized to write on namespace " + ns.Namespace
+				continue
+			}
+			if def, _ := meta2.ParseNamespaceDefinition(ns); c.Value == "" && def != nil && len(def.Required) > 0 {
+				c.Error = "values of " + ns.Label + " are required and cannot be deleted in bulk"
+				continue
+			}
+			_, n, er := inputFilter(ctx, &tree.Node{Path: c.Path}, "bulk")
+			if er != nil {
+				c.Error = "cannot find node"
+				continue
+			}
+			prefixes[n.Path] = c.Path
+			c.Path = n.Path
+		}
+		return nil
+	})
+
+	if input.DryRun {
+		applier.Prepare(ctx, changes)
+		rsp.WriteEntity(bulkResponse("", changes, prefixes))
+		return
+	}
+
+	var valid, invalid []*meta2.BulkChange
+	for _, c := range changes {
+		if c.Error == "" {
+			valid = append(valid, c)
+		} else {
+			invalid = append(invalid, c)
+		}
+	}
+	var jobUuid string
+	if len(valid) > 0 {
+		buffer := &bytes.Buffer{}
+		if e := meta2.WriteBulkCSV(buffer, valid, nsList); e != nil {
+			service.RestError500(req, rsp, e)
+			return
+		}
+		if jobUuid, e = s.bulkJob(req, map[string]string{"csv": buffer.String()}, nil); e != nil {
+			service.RestErrorDetect(req, rsp, e)
+			return
+		}
+	}
+	// Rows with errors are not sent to the job, report them directly
+	response := bulkResponse(jobUuid, invalid, prefixes)
+	response.Total = int32(len(changes))
+	rsp.WriteEntity(response)
+
+}
+
+// ExportUserMeta lists the metadata of a set of nodes, as JSON or as a CSV file that can be imported back.
+func (s *UserMetaHandler) ExportUserMeta(req *restful.Request, rsp *restful.Response) {
+
+	var input rest.ExportUserMetaRequest
+	if e := req.ReadEntity(&input); e != nil {
+		service.RestError500(req, rsp, e)
+		return
+	}
+	ctx := req.Request.Context()
+	applier, e := meta2.NewBulkApplier(ctx, defaults.NewClient())
+	if e != nil {
+		service.RestError500(req, rsp, e)
+		return
+	}
+	var readable []string
+	for name, ns := range applier.Namespaces() {
+		if name == namespace.ReservedNamespaceBookmark || len(input.Namespaces) > 0 && !stringInSlice(name, input.Namespaces) {
+			continue
+		}
+		if s.MatchPolicies(ctx, name, ns.Policies, serviceproto.ResourcePolicyAction_READ) {
+			readable = append(readable, name)
+		}
+	}
+
+	var nodes []*tree.Node
+	r := getRouter()
+	for _, p := range input.NodePaths {
+		resp, er := r.ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Path: strings.Trim(p, "/")}})
+		if er != nil {
+			service.RestErrorDetect(req, rsp, er, 404)
+			return
+		}
+		nodes = append(nodes, resp.Node)
+		if !input.Recursive || resp.Node.IsLeaf() {
+			continue
+		}
+		stream, er := r.ListNodes(ctx, &tree.ListNodesRequest{Node: resp.Node, Recursive: true})
+		if er != nil {
+			service.RestErrorDetect(req, rsp, er)
+			return
+		}
+		for {
+			lr, le := stream.Recv()
+			if le != nil {
+				break
+			}
+			nodes = append(nodes, lr.Node)
+		}
+		stream.Close()
+	}
+
+	var changes []*meta2.BulkChange
+	if len(readable) > 0 {
+		changes = applier.Export(ctx, nodes, readable...)
+	}
+	if input.Format == "csv" {
+		rsp.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		rsp.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"metadata-%d.csv\"", time.Now().Unix()))
+		rsp.WriteHeader(200)
+		if e := meta2.WriteBulkCSV(rsp.ResponseWriter, changes, applier.Namespaces()); e != nil {
+			log.Logger(ctx).Error("Cannot write metadata export", zap.Error(e))
+		}
+		return
+	}
+	rsp.WriteEntity(bulkResponse("", changes, nil))
+
+}
+
+// bulkJob starts a background task applying metadata changes on behalf of the current user.
+func (s *UserMetaHandler) bulkJob(req *restful.Request, parameters map[string]string, selector *jobs.NodesSelector) (string, error) {
+	ctx := req.Request.Context()
+	claims, ok := ctx.Value(claim.ContextKey).(claim.Claims)
+	if !ok {
+		return "", errors.Forbidden(common.SERVICE_USER_META, "Cannot find user in context")
+	}
+	languages := i18n.UserLanguagesFromRestRequest(req, config.Default())
+	T := lang.Bundle().GetTranslationFunc(languages...)
+	job := &jobs.Job{
+		ID:             "bulk-meta-" + uuid.New(),
+		Owner:          claims.Name,
+		Label:          T("Jobs.User.BulkMeta"),
+		Languages:      languages,
+		MaxConcurrency: 1,
+		AutoStart:      true,
+		AutoClean:      true,
+		Actions: []*jobs.Action{{
+			ID:            "actions.tree.bulk-meta",
+			Parameters:    parameters,
+			NodesSelector: selector,
+		}},
+	}
+	cli := jobs.NewJobServiceClient(registry.GetClient(common.SERVICE_JOBS))
+	if _, e := cli.PutJob(ctx, &jobs.PutJobRequest{Job: job}); e != nil {
+		return "", e
+	}
+	return job.ID, nil
+}
+
+// selectNodes synchronously lists the nodes matched by a selector.
+func selectNodes(ctx context.Context, selector *jobs.NodesSelector) (nodes []*tree.Node) {
+	objects := make(chan interface{})
+	done := make(chan bool, 2)
+	go selector.Select(defaults.NewClient(), ctx, objects, done)
+	for {
+		select {
+		case o := <-objects:
+			if n, ok := o.(*tree.Node); ok {
+				nodes = append(nodes, n)
+			}
+		case <-done:
+			return
+		}
+	}
+}
+
+// bulkResponse converts changes to their REST form, replacing internal paths by the paths seen by the user.
+func bulkResponse(jobUuid string, changes []*meta2.BulkChange, prefixes map[string]string) *rest.UserMetaBulkResponse {
+	response := &rest.UserMetaBulkResponse{JobUuid: jobUuid, Total: int32(len(changes))}
+	for _, c := range changes {
+		p := c.Path
+		for internal, user := range prefixes {
+			if p == internal || strings.HasPrefix(p, internal+"/") {
+				p = strings.Trim(user, "/") + strings.TrimPrefix(p, internal)
+				break
+			}
+		}
+		response.Changes = append(response.Changes, &rest.UserMetaBulkChange{
+			Path:      p,
+			Namespace: c.Namespace,
+			Previous:  c.Previous,
+			Value:     c.Value,
+			Error:     c.Error,
+		})
+	}
+	return response
+}
+
+func stringInSlice(s string, slice []string) bool {
+	for _, v := range slice {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -44,12 +44,13 @@ import (
 	"github.com/pydio/cells/common/service"
 	serviceproto "github.com/pydio/cells/common/service/proto"
 	"github.com/pydio/cells/common/service/resources"
+	meta2 "github.com/pydio/cells/common/utils/meta"
 	"github.com/pydio/cells/common/utils/permissions"
 	"github.com/pydio/cells/common/views"
 	"github.com/pydio/cells/idm/meta/namespace"
 )
 
-const MetaTagsDocStoreId = "user_meta_tags"
+const MetaTagsDocStoreId = common.DOCSTORE_ID_META_TAGS
 
 func NewUserMetaHandler() *UserMetaHandler {
 	handler := new(UserMetaHandler)
@@ -170,24 +171,18 @@ func (s *UserMetaHandler) UpdateUserMeta(req *restful.Request, rsp *restful.Resp
 		if meta.Uuid != "" {
 			loadUuids = append(loadUuids, meta.Uuid)
 		}
-		if ns.JsonDefinition != "" {
-			// Special case for tags: automatically update stored list
-			var nsDef map[string]interface{}
-			if jE := json.Unmarshal([]byte(ns.JsonDefinition), &nsDef); jE == nil {
-				if _, ok := nsDef["type"]; ok {
-					nsType := nsDef["type"].(string)
-					if nsType == "tags" {
-						var currentValue string
-						json.Unmarshal([]byte(meta.JsonValue), &currentValue)
-						log.Logger(ctx).Debug("jsonDef for namespace "+ns.Namespace, zap.Any("d", nsDef), zap.Any("v", currentValue))
-						e := s.putTagsIfNecessary(ctx, ns.Namespace, strings.Split(currentValue, ","))
-						if e != nil {
-							log.Logger(ctx).Error("Could not store meta tags for namespace "+ns.Namespace, zap.Error(e))
-						}
-					}
+		nsDef, jE := meta2.ParseNamespaceDefinition(ns)
+		if jE != nil {
+			log.Logger(ctx).Error("Cannot decode jsonDef "+ns.Namespace+": "+ns.JsonDefinition, zap.Error(jE))
+		}
+		if nsDef != nil {
+			// Special case for tags: automatically update stored list, unless it is a managed vocabulary
+			if nsDef.Type == meta2.NsTypeTags && !nsDef.Vocabulary && input.Operation == idm.UpdateUserMetaRequest_PUT {
+				tags := nsDef.Tags(meta.JsonValue)
+				log.Logger(ctx).Debug("jsonDef for namespace "+ns.Namespace, zap.Any("d", nsDef), zap.Any("v", tags))
+				if e := s.putTagsIfNecessary(ctx, ns.Namespace, tags); e != nil {
+					log.Logger(ctx).Error("Could not store meta tags for namespace "+ns.Namespace, zap.Error(e))
 				}
-			} else {
-				log.Logger(ctx).Error("Cannot decode jsonDef "+ns.Namespace+": "+ns.JsonDefinition, zap.Error(jE))
 			}
 		}
 		// Now update policies for input Meta
@@ -227,7 +222,7 @@ func (s *UserMetaHandler) UpdateUserMeta(req *restful.Request, rsp *restful.Resp
 		}
 	}
 	if response, err := userMetaClient.UpdateUserMeta(ctx, &input); err != nil {
-		service.RestError500(req, rsp, err)
+		service.RestErrorDetect(req, rsp, err)
 	} else {
 		rsp.WriteEntity(response)
 	}
@@ -296,18 +291,13 @@ func (s *UserMetaHandler) UpdateUserMetaNamespace(req *restful.Request, rsp *res
 		}
 	}
 	// Validate input
-	type jsonCheck struct {
-		Type string
-		Data interface{} `json:"data,omitempty"`
-	}
 	for _, ns := range input.Namespaces {
 		if !strings.HasPrefix(ns.Namespace, "usermeta-") {
 			service.RestError500(req, rsp, fmt.Errorf("user defined meta must start with usermeta- prefix"))
 			return
 		}
-		var check jsonCheck
-		if e := json.Unmarshal([]byte(ns.JsonDefinition), &check); e != nil {
-			service.RestError500(req, rsp, fmt.Errorf("invalid json definition for namespace: "+e.Error()))
+		if _, e := meta2.ParseNamespaceDefinition(ns); e != nil {
+			service.RestError500(req, rsp, e)
 			return
 		}
 	}
@@ -352,6 +342,11 @@ func (s *UserMetaHandler) PutUserMetaTag(req *restful.Request, rsp *restful.Resp
 	var r rest.PutUserMetaTagRequest
 	if e := req.ReadEntity(&r); e != nil {
 		service.RestError500(req, rsp, e)
+		return
+	}
+	if e := s.checkVocabularyEdit(req.Request.Context(), r.Namespace); e != nil {
+		service.RestError403(req, rsp, e)
+		return
 	}
 	e := s.putTagsIfNecessary(req.Request.Context(), r.Namespace, []string{r.Tag})
 	if e != nil {
@@ -420,11 +415,32 @@ func (s *UserMetaHandler) putTagsIfNecessary(ctx context.Context, namespace stri
 	return nil
 }
 
+// checkVocabularyEdit restricts the edition of managed vocabularies to administrators.
+func (s *UserMetaHandler) checkVocabularyEdit(ctx context.Context, namespace string) error {
+	if value := ctx.Value(claim.ContextKey); value != nil && value.(claim.Claims).Profile == common.PYDIO_PROFILE_ADMIN {
+		return nil
+	}
+	nsList, e := s.ListAllNamespaces(ctx, idm.NewUserMetaServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_USER_META, defaults.NewClient()))
+	if e != nil {
+		return e
+	}
+	if ns, ok := nsList[namespace]; ok {
+		if def, _ := meta2.ParseNamespaceDefinition(ns); def != nil && def.Vocabulary {
+			return errors.Forbidden(common.SERVICE_USER_META, "Only administrators can edit the vocabulary of %s", ns.Label)
+		}
+	}
+	return nil
+}
+
 func (s *UserMetaHandler) DeleteUserMetaTags(req *restful.Request, rsp *restful.Response) {
 	ns := req.PathParameter("Namespace")
 	tag := req.PathParameter("Tags")
 	ctx := req.Request.Context()
 	log.Logger(ctx).Info("Delete tags for namespace "+ns, zap.String("tag", tag))
+	if e := s.checkVocabularyEdit(ctx, ns); e != nil {
+		service.RestError403(req, rsp, e)
+		return
+	}
 	if tag == "*" {
 		docClient := docstore.NewDocStoreClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_DOCSTORE, defaults.NewClient())
 		if _, e := docClient.DeleteDocuments(ctx, &docstore.DeleteDocumentsRequest{
@@ -0,0 +1,449 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/emicklei/go-restful"
+	"github.com/micro/go-micro/errors"
+	"github.com/micro/go-micro/metadata"
+	"github.com/patrickmn/go-cache"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/auth/claim"
+	"github.com/pydio/cells/common/auth/mfa"
+	"github.com/pydio/cells/common/config"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/micro"
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/rest"
+	"github.com/pydio/cells/common/service"
+	"github.com/pydio/cells/common/service/context"
+	"github.com/pydio/cells/common/utils/permissions"
+)
+
+const (
+	loginLimiterRate  = rate.Limit(0.2)
+	loginLimiterBurst = 5
+)
+
+// loginLimiters holds the rate limiters of the anonymous security key login, by client address.
+var loginLimiters = cache.New(10*time.Minute, 10*time.Minute)
+
+// MfaHandler manages the second factor of the current user.
+type MfaHandler struct{}
+
+// SwaggerTags list the names of the service tags declared in the swagger json implemented by this service
+func (h *MfaHandler) SwaggerTags() []string {
+	return []string{"MfaService"}
+}
+
+// Filter returns a function to filter the swagger path
+func (h *MfaHandler) Filter() func(string) string {
+	return nil
+}
+
+// GetMfaStatus tells whether the current user has enrolled a second factor.
+func (h *MfaHandler) GetMfaStatus(req *restful.Request, rsp *restful.Response) {
+
+	ctx := req.Request.Context()
+	user, userMfa, err := h.loadUser(ctx)
+	if err != nil {
+		service.RestErrorDetect(req, rsp, err)
+		return
+	}
+	rsp.WriteEntity(h.status(ctx, user, userMfa))
+
+}
+
+// EnrollTotp generates a new secret for the current user. It is only enabled after a call to ConfirmTotp.
+func (h *MfaHandler) EnrollTotp(req *restful.Request, rsp *restful.Response) {
+
+	ctx := req.Request.Context()
+	user, userMfa, err := h.loadEnrollingUser(ctx)
+	if err != nil {
+		service.RestErrorDetect(req, rsp, err)
+		return
+	}
+	if userMfa.TotpEnabled {
+		service.RestError403(req, rsp, fmt.Errorf("a second factor is already enabled, disable it first"))
+		return
+	}
+	secret, err := mfa.GenerateTotpSecret()
+	if err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	if err := userMfa.SetTotpSecret(secret); err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	if err := h.storeUser(ctx, user, userMfa); err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	issuer := config.Get("frontend", "plugin", "core.pydio", "APPLICATION_TITLE").String("Pydio Cells")
+	rsp.WriteEntity(&rest.TotpEnrollResponse{
+		Secret:          secret,
+		ProvisioningUri: mfa.TotpProvisioningUri(issuer, user.Login, secret),
+	})
+
+}
+
+// ConfirmTotp enables TOTP if the code is valid for the pending secret, and returns a set of recovery codes.
+func (h *MfaHandler) ConfirmTotp(req *restful.Request, rsp *restful.Response) {
+
+	var input rest.MfaCodeRequest
+	if err := req.ReadEntity(&input); err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	ctx := req.Request.Context()
+	user, userMfa, err := h.loadEnrollingUser(ctx)
+	if err != nil {
+		service.RestErrorDetect(req, rsp, err)
+		return
+	}
+	if userMfa.TotpEnabled || userMfa.TotpSecret == "" {
+		service.RestError403(req, rsp, fmt.Errorf("no pending enrollment"))
+		return
+	}
+	if !userMfa.ValidateTotp(input.Code, time.Now()) {
+		service.RestError403(req, rsp, fmt.Errorf("invalid code"))
+		return
+	}
+	userMfa.TotpEnabled = true
+	codes, err := userMfa.GenerateRecoveryCodes()
+	if err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	if err := h.storeUser(ctx, user, userMfa); err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("User [%s] enabled TOTP second factor", user.Login),
+		log.GetAuditId(common.AUDIT_USER_UPDATE),
+		user.ZapUuid(),
+	)
+	rsp.WriteEntity(&rest.MfaRecoveryCodesResponse{RecoveryCodes: codes})
+
+}
+
+// DisableTotp removes the TOTP second factor of the current user, if it is not enforced by one of their roles.
+func (h *MfaHandler) DisableTotp(req *restful.Request, rsp *restful.Response) {
+
+	var input rest.MfaCodeRequest
+	if err := req.ReadEntity(&input); err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	ctx := req.Request.Context()
+	user, userMfa, err := h.loadEnrollingUser(ctx)
+	if err != nil {
+		service.RestErrorDetect(req, rsp, err)
+		return
+	}
+	if userMfa.TotpEnabled {
+		if mfa.Enforced(ctx, user) && len(userMfa.WebauthnCredentials) == 0 {
+			service.RestError403(req, rsp, fmt.Errorf("second factor is enforced for your account"))
+			return
+		}
+		if !userMfa.Verify(input.Code, time.Now()) {
+			service.RestError403(req, rsp, fmt.Errorf("invalid code"))
+			return
+		}
+	}
+	// Security keys are kept, along with recovery codes if any key remains
+	userMfa.TotpSecret = ""
+	userMfa.TotpEnabled = false
+	userMfa.LastCounter = 0
+	if !userMfa.Enabled() {
+		userMfa.RecoveryCodes = nil
+	}
+	if err := h.storeUser(ctx, user, userMfa); err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("User [%s] disabled TOTP second factor", user.Login),
+		log.GetAuditId(common.AUDIT_USER_UPDATE),
+		user.ZapUuid(),
+	)
+	rsp.WriteEntity(h.status(ctx, user, userMfa))
+
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes of the current user.
+func (h *MfaHandler) RegenerateRecoveryCodes(req *restful.Request, rsp *restful.Response) {
+
+	var input rest.MfaCodeRequest
+	if err := req.ReadEntity(&input); err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	ctx := req.Request.Context()
+	user, userMfa, err := h.loadEnrollingUser(ctx)
+	if err != nil {
+		service.RestErrorDetect(req, rsp, err)
+		return
+	}
+	if !userMfa.Verify(input.Code, time.Now()) {
+		service.RestError403(req, rsp, fmt.Errorf("invalid code"))
+		return
+	}
+	codes, err := userMfa.GenerateRecoveryCodes()
+	if err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	if err := h.storeUser(ctx, user, userMfa); err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	rsp.WriteEntity(&rest.MfaRecoveryCodesResponse{RecoveryCodes: codes})
+
+}
+
+// BeginWebauthnRegistration returns the options for registering a new security key.
+func (h *MfaHandler) BeginWebauthnRegistration(req *restful.Request, rsp *restful.Response) {
+
+	ctx := req.Request.Context()
+	user, userMfa, err := h.loadEnrollingUser(ctx)
+	if err != nil {
+		service.RestErrorDetect(req, rsp, err)
+		return
+	}
+	options, err := userMfa.BeginRegistration(mfa.DefaultWebauthnConfig(), user, time.Now())
+	if err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	if err := h.storeUser(ctx, user, userMfa); err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	h.writeOptions(req, rsp, options)
+
+}
+
+// FinishWebauthnRegistration verifies and stores a new security key. Recovery codes are generated
+// if it is the first second factor of the user.
+func (h *MfaHandler) FinishWebauthnRegistration(req *restful.Request, rsp *restful.Response) {
+
+	var input rest.WebauthnRegisterRequest
+	if err := req.ReadEntity(&input); err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	ctx := req.Request.Context()
+	user, userMfa, err := h.loadEnrollingUser(ctx)
+	if err != nil {
+		service.RestErrorDetect(req, rsp, err)
+		return
+	}
+	firstFactor := !userMfa.Enabled()
+	cred, verifyErr := userMfa.FinishRegistration(mfa.DefaultWebauthnConfig(), input.Name, &mfa.AttestationResponse{
+		Id:                input.Id,
+		ClientDataJSON:    input.ClientDataJSON,
+		AttestationObject: input.AttestationObject,
+	}, time.Now())
+	var codes []string
+	if verifyErr == nil && firstFactor {
+		if codes, err = userMfa.GenerateRecoveryCodes(); err != nil {
+			service.RestError500(req, rsp, err)
+			return
+		}
+	}
+	// Store anyway, as the challenge is consumed
+	if err := h.storeUser(ctx, user, userMfa); err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	if verifyErr != nil {
+		service.RestError403(req, rsp, verifyErr)
+		return
+	}
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("User [%s] registered security key [%s]", user.Login, cred.Name),
+		log.GetAuditId(common.AUDIT_USER_UPDATE),
+		user.ZapUuid(),
+	)
+	rsp.WriteEntity(&rest.MfaRecoveryCodesResponse{RecoveryCodes: codes})
+
+}
+
+// DeleteWebauthnCredential removes a security key of the current user. The last second factor cannot
+// be removed if it is enforced by one of their roles.
+func (h *MfaHandler) DeleteWebauthnCredential(req *restful.Request, rsp *restful.Response) {
+
+	id := req.PathParameter("Id")
+	ctx := req.Request.Context()
+	user, userMfa, err := h.loadEnrollingUser(ctx)
+	if err != nil {
+		service.RestErrorDetect(req, rsp, err)
+		return
+	}
+	if !userMfa.RemoveCredential(id) {
+		service.RestError404(req, rsp, fmt.Errorf("cannot find security key %s", id))
+		return
+	}
+	if !userMfa.Enabled() {
+		if mfa.Enforced(ctx, user) {
+			service.RestError403(req, rsp, fmt.Errorf("second factor is enforced for your account"))
+			return
+		}
+		userMfa.RecoveryCodes = nil
+	}
+	if err := h.storeUser(ctx, user, userMfa); err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("User [%s] removed a security key", user.Login),
+		log.GetAuditId(common.AUDIT_USER_UPDATE),
+		user.ZapUuid(),
+	)
+	rsp.WriteEntity(h.status(ctx, user, userMfa))
+
+}
+
+// BeginWebauthnLogin returns the options for logging in with a security key. It is publicly accessible:
+// the challenge is not stored on the user and unknown users get the same kind of response, so that it
+// cannot be used to find existing accounts. Calls are rate-limited per client address.
+func (h *MfaHandler) BeginWebauthnLogin(req *restful.Request, rsp *restful.Response) {
+
+	var input rest.WebauthnBeginRequest
+	if err := req.ReadEntity(&input); err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	ctx := req.Request.Context()
+	if !loginLimiter(ctx).Allow() {
+		service.RestError429(req, rsp, fmt.Errorf("too many requests, please retry later"))
+		return
+	}
+	userMfa := &mfa.UserMfa{}
+	if input.Login != "" {
+		if user, err := permissions.SearchUniqueUser(ctx, "", "", &idm.UserSingleQuery{Login: input.Login}); err == nil && user != nil {
+			if loaded, err := mfa.Load(user); err == nil {
+				userMfa = loaded
+			}
+		}
+	}
+	options, err := userMfa.BeginLogin(mfa.DefaultWebauthnConfig(), input.Login, time.Now())
+	if err != nil {
+		service.RestError500(req, rsp, fmt.Errorf("cannot start security key login"))
+		return
+	}
+	h.writeOptions(req, rsp, options)
+
+}
+
+// loginLimiter returns the rate limiter of the client address found in the context metadata.
+func loginLimiter(ctx context.Context) *rate.Limiter {
+	var addr string
+	if meta, ok := metadata.FromContext(ctx); ok {
+		addr = meta[servicecontext.HttpMetaRemoteAddress]
+	}
+	if l, ok := loginLimiters.Get(addr); ok {
+		return l.(*rate.Limiter)
+	}
+	l := rate.NewLimiter(loginLimiterRate, loginLimiterBurst)
+	loginLimiters.SetDefault(addr, l)
+	return l
+}
+
+func (h *MfaHandler) writeOptions(req *restful.Request, rsp *restful.Response, options interface{}) {
+	data, err := json.Marshal(options)
+	if err != nil {
+		service.RestError500(req, rsp, err)
+		return
+	}
+	rsp.WriteEntity(&rest.WebauthnOptionsResponse{Options: string(data)})
+}
+
+func (h *MfaHandler) status(ctx context.Context, user *idm.User, userMfa *mfa.UserMfa) *rest.MfaStatusResponse {
+	status := &rest.MfaStatusResponse{
+		TotpEnabled:       userMfa.TotpEnabled,
+		Enforced:          mfa.Enforced(ctx, user),
+		RecoveryCodesLeft: int32(len(userMfa.RecoveryCodes)),
+	}
+	for _, c := range userMfa.WebauthnCredentials {
+		status.Credentials = append(status.Credentials, &rest.WebauthnCredential{
+			Id:       c.Id,
+			Name:     c.Name,
+			Created:  c.Created,
+			LastUsed: c.LastUsed,
+		})
+	}
+	return status
+}
+
+// loadEnrollingUser loads the current user for modifying second factors. Sessions restricted to the enrollment
+// of a second factor were opened with a password only: they cannot modify second factors once one is enabled.
+func (h *MfaHandler) loadEnrollingUser(ctx context.Context) (*idm.User, *mfa.UserMfa, error) {
+	user, userMfa, err := h.loadUser(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if claims, ok := ctx.Value(claim.ContextKey).(claim.Claims); ok && claims.MfaEnrollOnly && userMfa.Enabled() {
+		return nil, nil, errors.Forbidden(common.SERVICE_MFA, "a second factor is already enrolled, please log in again with it")
+	}
+	return user, userMfa, nil
+}
+
+// loadUser finds the current user, bypassing the users cache.
+func (h *MfaHandler) loadUser(ctx context.Context) (*idm.User, *mfa.UserMfa, error) {
+	login, _ := permissions.FindUserNameInContext(ctx)
+	if login == "" || login == common.PYDIO_S3ANON_USERNAME {
+		return nil, nil, fmt.Errorf("cannot find user in context")
+	}
+	user, err := permissions.SearchUniqueUser(ctx, "", "", &idm.UserSingleQuery{Login: login})
+	if err != nil {
+		return nil, nil, err
+	}
+	if user == nil {
+		return nil, nil, fmt.Errorf("cannot find user %s", login)
+	}
+	userMfa, err := mfa.Load(user)
+	if err != nil {
+		return nil, nil, err
+	}
+	return user, userMfa, nil
+}
+
+func (h *MfaHandler) storeUser(ctx context.Context, user *idm.User, userMfa *mfa.UserMfa) error {
+	userMfa.Store(user)
+	cli := idm.NewUserServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_USER, defaults.NewClient())
+	if _, err := cli.CreateUser(ctx, &idm.CreateUserRequest{User: user}); err != nil {
+		log.Logger(ctx).Error("Cannot store second factor for user", user.ZapLogin(), zap.Error(err))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package ocm
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/auth"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/utils/permissions"
+)
+
+// WrapBearerAuth authenticates the requests sending the shared secret of an outgoing share as a bearer token:
+// they are served by handler as the hidden user giving access to the share. Other requests, including those
+// sending bearer tokens that are not shared secrets, are passed to fallback.
+func WrapBearerAuth(handler http.Handler, fallback http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header := r.Header.Get("Authorization")
+		token := strings.TrimSpace(strings.TrimPrefix(header, "Bearer "))
+		if !strings.HasPrefix(header, "Bearer ") || !IsSecret(token) || !Enabled() {
+			fallback.ServeHTTP(w, r)
+			return
+		}
+		sysCtx := context.WithValue(r.Context(), common.PYDIO_CONTEXT_USER_KEY, common.PYDIO_SYSTEM_USERNAME)
+		s, e := FindShareBySecret(sysCtx, token)
+		if e != nil {
+			log.Logger(r.Context()).Debug("Bearer token is not the secret of a federated share", zap.Error(e))
+			fallback.ServeHTTP(w, r)
+			return
+		}
+		var user *idm.User
+		if s.HiddenUser != "" {
+			user, e = permissions.SearchUniqueUser(sysCtx, s.HiddenUser, "")
+		}
+		if e != nil || user == nil {
+			log.Logger(r.Context()).Debug("Refusing federated share access", zap.Error(e))
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte("Unauthorized.\n"))
+			return
+		}
+		handler.ServeHTTP(w, r.WithContext(auth.WithUserClaims(r.Context(), user)))
+	})
+}
@@ -0,0 +1,125 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package ocm
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Client sends discovery requests, shares and notifications to remote servers.
+type Client struct {
+	http *http.Client
+}
+
+// NewClient creates a Client. Set skipVerify to accept self-signed certificates of remote servers.
+func NewClient(skipVerify bool) *Client {
+	tr := http.DefaultTransport
+	if skipVerify {
+		tr = &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		}
+	}
+	return &Client{http: &http.Client{Transport: tr, Timeout: 30 * time.Second}}
+}
+
+// Discover loads the discovery document of a server, trying the well-known path first.
+func (c *Client) Discover(ctx context.Context, serverURL string) (*Discovery, error) {
+	serverURL = strings.TrimRight(serverURL, "/")
+	var lastErr error
+	for _, p := range []string{WellKnownPath, DiscoveryPath} {
+		d := &Discovery{}
+		if e := c.do(ctx, http.MethodGet, serverURL+p, nil, d); e != nil {
+			lastErr = e
+			continue
+		}
+		if !d.Enabled || d.EndPoint == "" {
+			return nil, fmt.Errorf("federated sharing is not enabled on %s", serverURL)
+		}
+		d.EndPoint = strings.TrimRight(d.EndPoint, "/")
+		return d, nil
+	}
+	return nil, fmt.Errorf("cannot discover federated sharing on %s: %s", serverURL, lastErr.Error())
+}
+
+// SendShare creates a share on the server described by d.
+func (c *Client) SendShare(ctx context.Context, d *Discovery, share *Share) (*ShareResponse, error) {
+	resp := &ShareResponse{}
+	if e := c.do(ctx, http.MethodPost, d.EndPoint+"/shares", share, resp); e != nil {
+		return nil, e
+	}
+	return resp, nil
+}
+
+// SendNotification sends a notification to the server described by d.
+func (c *Client) SendNotification(ctx context.Context, d *Discovery, n *Notification) error {
+	return c.do(ctx, http.MethodPost, d.EndPoint+"/notifications", n, nil)
+}
+
+func (c *Client) do(ctx context.Context, method string, url string, body interface{}, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, e := json.Marshal(body)
+		if e != nil {
+			return e
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, e := http.NewRequest(method, url, reader)
+	if e != nil {
+		return e
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, e := c.http.Do(req)
+	if e != nil {
+		return e
+	}
+	defer resp.Body.Close()
+	data, e := ioutil.ReadAll(io.LimitReader(resp.Body, 1024*1024))
+	if e != nil {
+		return e
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		remote := &Error{}
+		if json.Unmarshal(data, remote) == nil && remote.Message != "" {
+			remote.code = resp.StatusCode
+			return remote
+		}
+		return &Error{Message: fmt.Sprintf("%s %s returned status %d", method, url, resp.StatusCode), code: resp.StatusCode}
+	}
+	if out != nil && len(data) > 0 {
+		return json.Unmarshal(data, out)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package ocm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestClient(t *testing.T) {
+
+	var received *Share
+	var notified *Notification
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	mux.HandleFunc(DiscoveryPath, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, &Discovery{Enabled: true, APIVersion: APIVersion, EndPoint: srv.URL + BasePath + "/"})
+	})
+	mux.HandleFunc(BasePath+"/shares", func(w http.ResponseWriter, r *http.Request) {
+		received = &Share{}
+		json.NewDecoder(r.Body).Decode(received)
+		if errs := received.Validate(); len(errs) > 0 {
+			e := newError(http.StatusBadRequest, "Invalid share")
+			e.ValidationErrors = errs
+			writeError(w, e)
+			return
+		}
+		writeJSON(w, http.StatusCreated, &ShareResponse{RecipientDisplayName: "Alice"})
+	})
+	mux.HandleFunc(BasePath+"/notifications", func(w http.ResponseWriter, r *http.Request) {
+		notified = &Notification{}
+		json.NewDecoder(r.Body).Decode(notified)
+		w.WriteHeader(http.StatusCreated)
+	})
+	ctx := context.Background()
+	client := NewClient(false)
+
+	Convey("Test discovery falls back to the legacy path", t, func() {
+		d, e := client.Discover(ctx, srv.URL+"/")
+		So(e, ShouldBeNil)
+		So(d.EndPoint, ShouldEqual, srv.URL+BasePath)
+
+		_, e = client.Discover(ctx, "http://127.0.0.1:1")
+		So(e, ShouldNotBeNil)
+	})
+
+	Convey("Test sending shares and notifications", t, func() {
+		d, _ := client.Discover(ctx, srv.URL)
+		s := &Share{
+			ShareWith:    "alice@" + srv.URL,
+			Name:         "Reports",
+			ProviderID:   "abc",
+			Owner:        FormatAddress("bob", "http://localhost:8080"),
+			ShareType:    ShareTypeUser,
+			ResourceType: ResourceTypeFile,
+			Protocol:     Protocol{Name: ProtocolWebDAV, Options: ProtocolOptions{SharedSecret: "s", Permissions: []string{PermissionRead}}},
+		}
+		resp, e := client.SendShare(ctx, d, s)
+		So(e, ShouldBeNil)
+		So(resp.RecipientDisplayName, ShouldEqual, "Alice")
+		So(received.Protocol.Options.Permissions, ShouldResemble, []string{"read"})
+
+		s.ResourceType = "calendar"
+		_, e = client.SendShare(ctx, d, s)
+		So(e, ShouldNotBeNil)
+		remote, ok := e.(*Error)
+		So(ok, ShouldBeTrue)
+		So(remote.code, ShouldEqual, http.StatusBadRequest)
+		So(remote.ValidationErrors[0].Name, ShouldEqual, "resourceType")
+
+		e = client.SendNotification(ctx, d, &Notification{NotificationType: NotificationShareAccepted, ProviderID: "abc"})
+		So(e, ShouldBeNil)
+		So(notified.NotificationType, ShouldEqual, NotificationShareAccepted)
+	})
+}
@@ -0,0 +1,307 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package ocm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/gorilla/mux"
+	"github.com/micro/go-micro/errors"
+	"github.com/pborman/uuid"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/config"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/micro"
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/rest"
+	service "github.com/pydio/cells/common/service/proto"
+	"github.com/pydio/cells/idm/share"
+)
+
+// ServiceName is the name of the service serving the OCM endpoints, under which its configuration is stored.
+const ServiceName = common.SERVICE_WEB_NAMESPACE_ + common.SERVICE_OCM
+
+// Enabled tells whether federated sharing is enabled in the configuration.
+func Enabled() bool {
+	return config.Get("services", ServiceName, "enabled").Bool(true)
+}
+
+// SkipVerify tells whether self-signed certificates of remote servers are accepted.
+func SkipVerify() bool {
+	return config.Get("services", ServiceName, "skipVerify").Bool(false)
+}
+
+// IsTrusted tells whether shares can be received from a server, by matching its host (or scheme and host)
+// against the trustedServers configuration key. No server is trusted by default.
+func IsTrusted(serverURL string) bool {
+	u, e := url.Parse(serverURL)
+	if e != nil || u.Host == "" {
+		return false
+	}
+	for _, t := range config.Get("services", ServiceName, "trustedServers").StringSlice([]string{}) {
+		t = strings.ToLower(strings.TrimRight(strings.TrimSpace(t), "/"))
+		if t == strings.ToLower(u.Host) || t == strings.ToLower(u.Scheme+"://"+u.Host) {
+			return true
+		}
+	}
+	return false
+}
+
+// LocalURL returns the external URL of this server.
+func LocalURL() string {
+	return strings.TrimRight(config.Get("defaults", "url").String(""), "/")
+}
+
+// LocalDiscovery builds the discovery document of this server.
+func LocalDiscovery() *Discovery {
+	return &Discovery{
+		Enabled:    Enabled(),
+		APIVersion: APIVersion,
+		EndPoint:   LocalURL() + BasePath,
+		Provider:   ProviderName,
+		ResourceTypes: []ResourceType{{
+			Name:       ResourceTypeFile,
+			ShareTypes: []string{ShareTypeUser},
+			Protocols:  map[string]string{ProtocolWebDAV: "/dav/"},
+		}},
+	}
+}
+
+type handler struct {
+	userCli idm.UserServiceClient
+}
+
+// NewRouter creates the router serving the discovery document and receiving shares and notifications.
+func NewRouter() *mux.Router {
+	h := &handler{
+		userCli: idm.NewUserServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_USER, defaults.NewClient()),
+	}
+	router := mux.NewRouter().StrictSlash(true)
+	router.Methods("GET").Path(WellKnownPath).HandlerFunc(h.discovery)
+	router.Methods("GET").Path(DiscoveryPath).HandlerFunc(h.discovery)
+	router.Methods("POST").Path(BasePath + "/shares").Handler(h.serve(h.receiveShare))
+	router.Methods("POST").Path(BasePath + "/notifications").Handler(h.serve(h.receiveNotification))
+	return router
+}
+
+func (h *handler) discovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, LocalDiscovery())
+}
+
+// serve runs the handler functions as system and writes the errors they return.
+func (h *handler) serve(f func(ctx context.Context, w http.ResponseWriter, r *http.Request) error) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !Enabled() {
+			writeError(w, newError(http.StatusNotImplemented, "Federated sharing is disabled"))
+			return
+		}
+		ctx := context.WithValue(r.Context(), common.PYDIO_CONTEXT_USER_KEY, common.PYDIO_SYSTEM_USERNAME)
+		if err := f(ctx, w, r); err != nil {
+			if _, ok := err.(*Error); !ok {
+				log.Logger(ctx).Error("OCM request failed", zap.String("path", r.URL.Path), zap.Error(err))
+			}
+			writeError(w, err)
+		}
+	})
+}
+
+// receiveShare stores a share sent by a trusted server. As the owner address is set by the sender, the share
+// is confirmed by accessing its resource on the owner server with the shared secret. It stays pending until
+// the recipient accepts it. The response does not tell whether the recipient exists.
+func (h *handler) receiveShare(ctx context.Context, w http.ResponseWriter, r *http.Request) error {
+	s := &Share{}
+	if e := json.NewDecoder(io.LimitReader(r.Body, 1024*1024)).Decode(s); e != nil {
+		return newError(http.StatusBadRequest, "Cannot decode share: "+e.Error())
+	}
+	if errs := s.Validate(); len(errs) > 0 {
+		e := newError(http.StatusBadRequest, "Invalid share")
+		e.ValidationErrors = errs
+		return e
+	}
+	_, remoteURL, _ := ParseAddress(s.Owner)
+	if !IsTrusted(remoteURL) {
+		log.Logger(ctx).Warn("Refusing federated share from untrusted server", zap.String("owner", s.Owner))
+		return newError(http.StatusForbidden, "Shares from this server are not accepted")
+	}
+	// The sender is trusted: check that it serves federated shares and find its webdav endpoint
+	discovery, e := NewClient(SkipVerify()).Discover(ctx, remoteURL)
+	if e != nil {
+		log.Logger(ctx).Warn("Cannot validate sender of federated share", zap.String("owner", s.Owner), zap.Error(e))
+		return newError(http.StatusBadRequest, "Cannot validate sender server")
+	}
+	davPath := discovery.WebDAVPath()
+	if davPath == "" {
+		return newError(http.StatusBadRequest, "Sender server does not provide webdav access")
+	}
+	login := s.ShareWith
+	if pos := strings.LastIndex(login, "@"); pos > 0 {
+		login = login[:pos]
+	}
+	recipient, e := h.findUser(ctx, login)
+	if e != nil {
+		return e
+	}
+	if recipient == nil {
+		log.Logger(ctx).Debug("Ignoring federated share for unknown user", zap.String("shareWith", s.ShareWith))
+		return writeJSON(w, http.StatusCreated, &ShareResponse{})
+	}
+	ocmShare := &rest.OcmShare{
+		Uuid:             uuid.New(),
+		Direction:        rest.OcmShareDirection_INCOMING,
+		Status:           rest.OcmShareStatus_PENDING,
+		Name:             s.Name,
+		Description:      s.Description,
+		LocalUser:        recipient.Login,
+		ShareWith:        s.ShareWith,
+		Owner:            s.Owner,
+		OwnerDisplayName: s.OwnerDisplayName,
+		ProviderId:       s.ProviderID,
+		RemoteUrl:        remoteURL,
+		Permissions:      PermissionsFromProtocol(s.Protocol.Options.Permissions),
+		ResourcePath:     path.Join(davPath, s.Protocol.Options.URI),
+		SharedSecret:     s.Protocol.Options.SharedSecret,
+		CreatedAt:        time.Now().Unix(),
+	}
+	if e := confirmShare(ctx, ocmShare); e != nil {
+		log.Logger(ctx).Warn("Cannot confirm federated share with sender server", zap.String("owner", s.Owner), zap.Error(e))
+		return newError(http.StatusForbidden, "Cannot confirm share with sender server")
+	}
+	if e := StoreShare(ctx, ocmShare); e != nil {
+		return e
+	}
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("Received federated share [%s] from %s", s.Name, s.Owner),
+		zap.String(common.KEY_USERNAME, recipient.Login),
+	)
+	return writeJSON(w, http.StatusCreated, &ShareResponse{})
+}
+
+// receiveNotification updates the status of a share, after checking the shared secret.
+func (h *handler) receiveNotification(ctx context.Context, w http.ResponseWriter, r *http.Request) error {
+	n := &Notification{}
+	if e := json.NewDecoder(io.LimitReader(r.Body, 1024*1024)).Decode(n); e != nil {
+		return newError(http.StatusBadRequest, "Cannot decode notification: "+e.Error())
+	}
+	direction := rest.OcmShareDirection_OUTGOING
+	if n.NotificationType == NotificationShareUnshared {
+		direction = rest.OcmShareDirection_INCOMING
+	}
+	s, e := FindShare(ctx, direction, n.ProviderID, n.Notification.SharedSecret)
+	if e != nil {
+		return newError(http.StatusForbidden, "Unknown share or invalid shared secret")
+	}
+	switch n.NotificationType {
+	case NotificationShareAccepted:
+		s.Status = rest.OcmShareStatus_ACCEPTED
+		if e := StoreShare(ctx, s); e != nil {
+			return e
+		}
+	case NotificationShareDeclined:
+		if e := RevokeOutgoingShare(ctx, s); e != nil {
+			return e
+		}
+	case NotificationShareUnshared:
+		if e := DeleteShare(ctx, s.Uuid); e != nil {
+			return e
+		}
+		ForgetMounts(s.LocalUser)
+	default:
+		return newError(http.StatusNotImplemented, "Unsupported notification type "+n.NotificationType)
+	}
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("Received notification %s for federated share [%s]", n.NotificationType, s.Name),
+		zap.String(common.KEY_USERNAME, s.LocalUser),
+	)
+	w.WriteHeader(http.StatusCreated)
+	return nil
+}
+
+// confirmShare checks that the server of the share owner gives access to the shared resource with the shared
+// secret, so that a share cannot be sent on behalf of another server.
+func confirmShare(ctx context.Context, s *rest.OcmShare) error {
+	remote, e := NewRemote(s)
+	if e != nil {
+		return e
+	}
+	_, e = remote.ReadNode(ctx, "")
+	return e
+}
+
+// RevokeOutgoingShare deletes the link workspace giving access to an outgoing share, and the share itself.
+func RevokeOutgoingShare(ctx context.Context, s *rest.OcmShare) error {
+	if s.WorkspaceUuid != "" {
+		e := share.DeleteWorkspace(ctx, &idm.User{Login: s.LocalUser}, idm.WorkspaceScope_LINK, s.WorkspaceUuid, nil)
+		if e != nil && errors.Parse(e.Error()).Code != http.StatusNotFound {
+			return e
+		}
+	}
+	return DeleteShare(ctx, s.Uuid)
+}
+
+// findUser loads the recipient of a share, ignoring hidden users. It returns a nil user if none is found.
+func (h *handler) findUser(ctx context.Context, login string) (*idm.User, error) {
+	q, _ := ptypes.MarshalAny(&idm.UserSingleQuery{Login: login, NodeType: idm.NodeType_USER})
+	stream, e := h.userCli.SearchUser(ctx, &idm.SearchUserRequest{Query: &service.Query{SubQueries: []*any.Any{q}}})
+	if e != nil {
+		return nil, e
+	}
+	defer stream.Close()
+	for {
+		resp, e := stream.Recv()
+		if e != nil {
+			break
+		}
+		if resp.User != nil && resp.User.Login == login && resp.User.Attributes["hidden"] != "true" {
+			return resp.User, nil
+		}
+	}
+	return nil, nil
+}
+
+func newError(code int, message string) *Error {
+	return &Error{Message: message, code: code}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	return json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	e, ok := err.(*Error)
+	if !ok {
+		e = newError(http.StatusInternalServerError, err.Error())
+	}
+	writeJSON(w, e.code, e)
+}
@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package ocm
+
+import (
+	"context"
+	"time"
+
+	"github.com/gosimple/slug"
+	"github.com/patrickmn/go-cache"
+
+	"github.com/pydio/cells/common/auth/claim"
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/rest"
+	"github.com/pydio/cells/common/views"
+)
+
+var mountsCache = cache.New(20*time.Second, time.Minute)
+
+func init() {
+	views.RemoteWorkspacesLoader = LoadMounts
+}
+
+// MountWorkspace describes the virtual workspace mounting an accepted incoming share.
+func MountWorkspace(s *rest.OcmShare) *idm.Workspace {
+	return &idm.Workspace{
+		UUID:        "ocm-" + s.Uuid,
+		Label:       s.Name,
+		Description: s.Description,
+		Slug:        slug.Make(s.Name) + "-" + s.Uuid[:8],
+		Scope:       idm.WorkspaceScope_ROOM,
+		RootUUIDs:   []string{"ocm-" + s.Uuid},
+	}
+}
+
+// LoadMounts lists the accepted incoming shares of the user of the context, as remote workspaces.
+func LoadMounts(ctx context.Context) ([]*views.RemoteWorkspace, error) {
+	claims, ok := ctx.Value(claim.ContextKey).(claim.Claims)
+	if !ok || claims.Name == "" || !Enabled() {
+		return nil, nil
+	}
+	if m, ok := mountsCache.Get(claims.Name); ok {
+		return m.([]*views.RemoteWorkspace), nil
+	}
+	shares, e := ListShares(ctx, claims.Name, rest.OcmShareDirection_INCOMING)
+	if e != nil {
+		return nil, e
+	}
+	var mounts []*views.RemoteWorkspace
+	for _, s := range shares {
+		if s.Status != rest.OcmShareStatus_ACCEPTED {
+			continue
+		}
+		remote, e := NewRemote(s)
+		if e != nil {
+			continue
+		}
+		mounts = append(mounts, &views.RemoteWorkspace{
+			Workspace: MountWorkspace(s),
+			Provider:  remote,
+			ReadOnly:  !canWrite(s.Permissions),
+		})
+	}
+	mountsCache.Set(claims.Name, mounts, cache.DefaultExpiration)
+	return mounts, nil
+}
+
+// ForgetMounts clears the cached mounts of a user after its incoming shares have changed.
+func ForgetMounts(localUser string) {
+	mountsCache.Delete(localUser)
+}
+
+func canWrite(perms []rest.ShareLinkAccessType) bool {
+	for _, p := range perms {
+		if p == rest.ShareLinkAccessType_Upload {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,246 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+// Package ocm implements the Open Cloud Mesh protocol, used to share folders with users of other servers.
+//
+// See https://github.com/cs3org/OCM-API for the specification.
+package ocm
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/pydio/cells/common/proto/rest"
+)
+
+const (
+	// APIVersion is the version of the protocol advertised by the discovery endpoint.
+	APIVersion = "1.0-proposal1"
+	// ProviderName identifies this server software in the discovery document.
+	ProviderName = "Pydio Cells"
+
+	// BasePath is the path under which shares and notifications are received.
+	BasePath = "/ocm"
+	// DiscoveryPath is the legacy discovery path, still used by most implementations.
+	DiscoveryPath = "/ocm-provider"
+	// WellKnownPath is the discovery path defined by the latest versions of the specification.
+	WellKnownPath = "/.well-known/ocm"
+
+	ShareTypeUser    = "user"
+	ResourceTypeFile = "file"
+	ProtocolWebDAV   = "webdav"
+
+	NotificationShareAccepted = "SHARE_ACCEPTED"
+	NotificationShareDeclined = "SHARE_DECLINED"
+	NotificationShareUnshared = "SHARE_UNSHARED"
+
+	PermissionRead  = "read"
+	PermissionWrite = "write"
+)
+
+// Discovery is the document describing the OCM capabilities of a server.
+type Discovery struct {
+	Enabled       bool           `json:"enabled"`
+	APIVersion    string         `json:"apiVersion"`
+	EndPoint      string         `json:"endPoint"`
+	Provider      string         `json:"provider,omitempty"`
+	ResourceTypes []ResourceType `json:"resourceTypes"`
+}
+
+// WebDAVPath returns the path of the webdav endpoint advertised for files, or an empty string.
+func (d *Discovery) WebDAVPath() string {
+	for _, rt := range d.ResourceTypes {
+		if p, ok := rt.Protocols[ProtocolWebDAV]; ok && rt.Name == ResourceTypeFile {
+			if u, e := url.Parse(p); e == nil && u.Path != "" {
+				return u.Path
+			}
+		}
+	}
+	return ""
+}
+
+// ResourceType lists the share types and access protocols supported for a kind of resource.
+type ResourceType struct {
+	Name       string            `json:"name"`
+	ShareTypes []string          `json:"shareTypes"`
+	Protocols  map[string]string `json:"protocols"`
+}
+
+// Protocol describes how the receiver can access a shared resource.
+type Protocol struct {
+	Name    string          `json:"name"`
+	Options ProtocolOptions `json:"options"`
+}
+
+// ProtocolOptions are the options of the webdav protocol.
+type ProtocolOptions struct {
+	SharedSecret string   `json:"sharedSecret"`
+	Permissions  []string `json:"permissions,omitempty"`
+	// URI is the path of the resource on the provider, as seen by the user of the shared secret.
+	URI string `json:"uri,omitempty"`
+}
+
+// Share is the payload sent by a provider to create a share on a receiving server.
+type Share struct {
+	ShareWith         string   `json:"shareWith"`
+	Name              string   `json:"name"`
+	Description       string   `json:"description,omitempty"`
+	ProviderID        string   `json:"providerId"`
+	Owner             string   `json:"owner"`
+	Sender            string   `json:"sender"`
+	OwnerDisplayName  string   `json:"ownerDisplayName,omitempty"`
+	SenderDisplayName string   `json:"senderDisplayName,omitempty"`
+	ShareType         string   `json:"shareType"`
+	ResourceType      string   `json:"resourceType"`
+	Protocol          Protocol `json:"protocol"`
+}
+
+// ShareResponse is returned by the receiving server once a share is created.
+type ShareResponse struct {
+	RecipientDisplayName string `json:"recipientDisplayName,omitempty"`
+}
+
+// Notification informs the other party of a change on a share.
+type Notification struct {
+	NotificationType string              `json:"notificationType"`
+	ResourceType     string              `json:"resourceType"`
+	ProviderID       string              `json:"providerId"`
+	Notification     NotificationDetails `json:"notification"`
+}
+
+// NotificationDetails carries the shared secret authenticating a notification.
+type NotificationDetails struct {
+	SharedSecret string `json:"sharedSecret"`
+	Message      string `json:"message,omitempty"`
+}
+
+// ValidationError reports an invalid field of a received share.
+type ValidationError struct {
+	Name    string `json:"name"`
+	Message string `json:"message"`
+}
+
+// Error is the error format of the OCM endpoints.
+type Error struct {
+	Message          string            `json:"message"`
+	ValidationErrors []ValidationError `json:"validationErrors,omitempty"`
+	code             int
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Validate checks the required fields of a received share.
+func (s *Share) Validate() []ValidationError {
+	var errs []ValidationError
+	required := map[string]string{
+		"shareWith":  s.ShareWith,
+		"name":       s.Name,
+		"providerId": s.ProviderID,
+		"owner":      s.Owner,
+	}
+	for _, k := range []string{"shareWith", "name", "providerId", "owner"} {
+		if strings.TrimSpace(required[k]) == "" {
+			errs = append(errs, ValidationError{Name: k, Message: "REQUIRED"})
+		}
+	}
+	if s.Owner != "" {
+		if _, _, e := ParseAddress(s.Owner); e != nil {
+			errs = append(errs, ValidationError{Name: "owner", Message: "INVALID_FORMAT"})
+		}
+	}
+	if s.ShareType != ShareTypeUser {
+		errs = append(errs, ValidationError{Name: "shareType", Message: "NOT_SUPPORTED"})
+	}
+	if s.ResourceType != ResourceTypeFile {
+		errs = append(errs, ValidationError{Name: "resourceType", Message: "NOT_SUPPORTED"})
+	}
+	if s.Protocol.Name != ProtocolWebDAV {
+		errs = append(errs, ValidationError{Name: "protocol.name", Message: "NOT_SUPPORTED"})
+	} else if s.Protocol.Options.SharedSecret == "" {
+		errs = append(errs, ValidationError{Name: "protocol.options.sharedSecret", Message: "REQUIRED"})
+	}
+	return errs
+}
+
+// ParseAddress splits a federated address user@server into the user and the base URL of its server.
+// The server may carry an explicit scheme, https is assumed otherwise.
+func ParseAddress(address string) (user string, serverURL string, err error) {
+	address = strings.TrimSpace(address)
+	pos := strings.LastIndex(address, "@")
+	if pos <= 0 || pos == len(address)-1 {
+		return "", "", fmt.Errorf("invalid federated address %s, expected user@server", address)
+	}
+	user, server := address[:pos], address[pos+1:]
+	if !strings.HasPrefix(server, "http://") && !strings.HasPrefix(server, "https://") {
+		server = "https://" + server
+	}
+	server = strings.TrimRight(server, "/")
+	if u, e := url.Parse(server); e != nil || u.Host == "" || u.Path != "" || u.RawQuery != "" || u.Fragment != "" || u.User != nil {
+		return "", "", fmt.Errorf("invalid server in federated address %s", address)
+	}
+	return user, server, nil
+}
+
+// FormatAddress builds the federated address of a local user. The scheme is kept only when it is not https.
+func FormatAddress(user string, serverURL string) string {
+	return user + "@" + strings.TrimPrefix(strings.TrimRight(serverURL, "/"), "https://")
+}
+
+// PermissionsToProtocol converts the permissions of a share to the webdav protocol permissions.
+func PermissionsToProtocol(perms []rest.ShareLinkAccessType) []string {
+	var read, write bool
+	for _, p := range perms {
+		switch p {
+		case rest.ShareLinkAccessType_Preview, rest.ShareLinkAccessType_Download:
+			read = true
+		case rest.ShareLinkAccessType_Upload:
+			write = true
+		}
+	}
+	var out []string
+	if read {
+		out = append(out, PermissionRead)
+	}
+	if write {
+		out = append(out, PermissionWrite)
+	}
+	return out
+}
+
+// PermissionsFromProtocol converts webdav protocol permissions to share permissions. Read access is
+// assumed when no permissions are sent.
+func PermissionsFromProtocol(perms []string) []rest.ShareLinkAccessType {
+	if len(perms) == 0 {
+		perms = []string{PermissionRead}
+	}
+	var out []rest.ShareLinkAccessType
+	for _, p := range perms {
+		switch strings.ToLower(p) {
+		case PermissionRead:
+			out = append(out, rest.ShareLinkAccessType_Preview, rest.ShareLinkAccessType_Download)
+		case PermissionWrite:
+			out = append(out, rest.ShareLinkAccessType_Upload)
+		}
+	}
+	return out
+}
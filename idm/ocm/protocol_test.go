@@ -0,0 +1,110 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package ocm
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common/proto/rest"
+)
+
+func TestParseAddress(t *testing.T) {
+
+	Convey("Test valid addresses", t, func() {
+		user, server, e := ParseAddress("alice@cells.example.com")
+		So(e, ShouldBeNil)
+		So(user, ShouldEqual, "alice")
+		So(server, ShouldEqual, "https://cells.example.com")
+
+		user, server, e = ParseAddress("alice@example.com@http://localhost:8080/")
+		So(e, ShouldBeNil)
+		So(user, ShouldEqual, "alice@example.com")
+		So(server, ShouldEqual, "http://localhost:8080")
+	})
+
+	Convey("Test invalid addresses", t, func() {
+		for _, a := range []string{"", "alice", "@server", "alice@", "alice@https://", "alice@host/path"} {
+			_, _, e := ParseAddress(a)
+			So(e, ShouldNotBeNil)
+		}
+	})
+
+	Convey("Test formatted addresses can be parsed back", t, func() {
+		So(FormatAddress("bob", "https://cells.example.com/"), ShouldEqual, "bob@cells.example.com")
+		So(FormatAddress("bob", "http://localhost:8080"), ShouldEqual, "bob@http://localhost:8080")
+		user, server, e := ParseAddress(FormatAddress("bob", "http://localhost:8080"))
+		So(e, ShouldBeNil)
+		So(user, ShouldEqual, "bob")
+		So(server, ShouldEqual, "http://localhost:8080")
+	})
+}
+
+func TestPermissions(t *testing.T) {
+
+	Convey("Test permissions conversion", t, func() {
+		So(PermissionsToProtocol([]rest.ShareLinkAccessType{rest.ShareLinkAccessType_Preview, rest.ShareLinkAccessType_Download}), ShouldResemble, []string{"read"})
+		So(PermissionsToProtocol([]rest.ShareLinkAccessType{rest.ShareLinkAccessType_Download, rest.ShareLinkAccessType_Upload}), ShouldResemble, []string{"read", "write"})
+		So(PermissionsFromProtocol(nil), ShouldResemble, []rest.ShareLinkAccessType{rest.ShareLinkAccessType_Preview, rest.ShareLinkAccessType_Download})
+		So(PermissionsFromProtocol([]string{"WRITE", "share"}), ShouldResemble, []rest.ShareLinkAccessType{rest.ShareLinkAccessType_Upload})
+	})
+}
+
+func TestShareValidate(t *testing.T) {
+
+	Convey("Test share validation", t, func() {
+		s := &Share{
+			ShareWith:    "alice@cells.example.com",
+			Name:         "Reports",
+			ProviderID:   "abc",
+			Owner:        "bob@http://localhost:8080",
+			ShareType:    ShareTypeUser,
+			ResourceType: ResourceTypeFile,
+			Protocol:     Protocol{Name: ProtocolWebDAV, Options: ProtocolOptions{SharedSecret: NewSecret()}},
+		}
+		So(s.Validate(), ShouldBeEmpty)
+
+		s.ShareType = "group"
+		s.Protocol.Options.SharedSecret = ""
+		s.Owner = "bob"
+		errs := s.Validate()
+		So(errs, ShouldHaveLength, 3)
+		So(errs[0].Name, ShouldEqual, "owner")
+		So(errs[1].Name, ShouldEqual, "shareType")
+		So(errs[2].Name, ShouldEqual, "protocol.options.sharedSecret")
+	})
+
+	Convey("Test shared secret", t, func() {
+		So(NewSecret(), ShouldHaveLength, 48)
+		So(NewSecret(), ShouldNotEqual, NewSecret())
+		So(hashSecret("secret"), ShouldNotContainSubstring, "secret")
+	})
+}
+
+func TestDiscoveryWebDAVPath(t *testing.T) {
+
+	Convey("Test webdav path of discovery documents", t, func() {
+		d := &Discovery{ResourceTypes: []ResourceType{{Name: ResourceTypeFile, Protocols: map[string]string{ProtocolWebDAV: "https://remote.example.com/remote.php/dav/ocm/"}}}}
+		So(d.WebDAVPath(), ShouldEqual, "/remote.php/dav/ocm/")
+		So((&Discovery{}).WebDAVPath(), ShouldBeEmpty)
+	})
+}
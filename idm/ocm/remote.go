@@ -0,0 +1,303 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package ocm
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/micro/go-micro/errors"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/proto/rest"
+	"github.com/pydio/cells/common/proto/tree"
+)
+
+const propfindBody = `<?xml version="1.0" encoding="utf-8"?>
+<d:propfind xmlns:d="DAV:"><d:prop><d:resourcetype/><d:getcontentlength/><d:getlastmodified/><d:getetag/></d:prop></d:propfind>`
+
+// Remote accesses the resource of an incoming share with the WebDAV protocol, sending the shared secret as a bearer token.
+// Paths are relative to the root of the share.
+type Remote struct {
+	root   *url.URL
+	secret string
+	http   *http.Client
+}
+
+// NewRemote creates a Remote for an incoming share.
+func NewRemote(s *rest.OcmShare) (*Remote, error) {
+	root, e := url.Parse(strings.TrimRight(s.RemoteUrl, "/") + "/" + strings.Trim(s.ResourcePath, "/") + "/")
+	if e != nil {
+		return nil, e
+	}
+	tr := http.DefaultTransport
+	if SkipVerify() {
+		tr = &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		}
+	}
+	return &Remote{root: root, secret: s.SharedSecret, http: &http.Client{Transport: tr, Timeout: 5 * time.Minute}}, nil
+}
+
+type davMultistatus struct {
+	Responses []davResponse `xml:"DAV: response"`
+}
+
+type davResponse struct {
+	Href      string        `xml:"DAV: href"`
+	Propstats []davPropstat `xml:"DAV: propstat"`
+}
+
+type davPropstat struct {
+	Status string  `xml:"DAV: status"`
+	Prop   davProp `xml:"DAV: prop"`
+}
+
+type davProp struct {
+	Collection    *struct{} `xml:"DAV: resourcetype>collection"`
+	ContentLength string    `xml:"DAV: getcontentlength"`
+	LastModified  string    `xml:"DAV: getlastmodified"`
+	Etag          string    `xml:"DAV: getetag"`
+}
+
+// ReadNode stats a file or folder.
+func (r *Remote) ReadNode(ctx context.Context, p string) (*tree.Node, error) {
+	nodes, e := r.propfind(ctx, p, "0")
+	if e != nil {
+		return nil, e
+	}
+	for _, n := range nodes {
+		if n.Path == strings.Trim(p, "/") {
+			return n, nil
+		}
+	}
+	return nil, errors.NotFound(common.SERVICE_SHARE, "Cannot find %s on remote server", p)
+}
+
+// ListNodes lists the children of a folder.
+func (r *Remote) ListNodes(ctx context.Context, p string) ([]*tree.Node, error) {
+	nodes, e := r.propfind(ctx, p, "1")
+	if e != nil {
+		return nil, e
+	}
+	var children []*tree.Node
+	for _, n := range nodes {
+		if n.Path != strings.Trim(p, "/") {
+			children = append(children, n)
+		}
+	}
+	return children, nil
+}
+
+// GetObject reads the content of a file, length is ignored if negative or zero.
+func (r *Remote) GetObject(ctx context.Context, p string, offset int64, length int64) (io.ReadCloser, error) {
+	req, e := r.request(ctx, http.MethodGet, p, nil)
+	if e != nil {
+		return nil, e
+	}
+	if length > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", offset, offset+length-1))
+	} else if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+	resp, e := r.do(req)
+	if e != nil {
+		return nil, e
+	}
+	return resp.Body, nil
+}
+
+// PutObject uploads the content of a file.
+func (r *Remote) PutObject(ctx context.Context, p string, reader io.Reader, size int64) (int64, error) {
+	counter := &countingReader{Reader: reader}
+	req, e := r.request(ctx, http.MethodPut, p, counter)
+	if e != nil {
+		return 0, e
+	}
+	if size >= 0 {
+		req.ContentLength = size
+	}
+	resp, e := r.do(req)
+	if e != nil {
+		return 0, e
+	}
+	resp.Body.Close()
+	return counter.n, nil
+}
+
+// CreateFolder creates a folder.
+func (r *Remote) CreateFolder(ctx context.Context, p string) error {
+	req, e := r.request(ctx, "MKCOL", strings.TrimRight(p, "/")+"/", nil)
+	if e != nil {
+		return e
+	}
+	resp, e := r.do(req)
+	if e != nil {
+		return e
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// Delete removes a file or folder.
+func (r *Remote) Delete(ctx context.Context, p string) error {
+	req, e := r.request(ctx, http.MethodDelete, p, nil)
+	if e != nil {
+		return e
+	}
+	resp, e := r.do(req)
+	if e != nil {
+		return e
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (r *Remote) propfind(ctx context.Context, p string, depth string) ([]*tree.Node, error) {
+	req, e := r.request(ctx, "PROPFIND", p, bytes.NewBufferString(propfindBody))
+	if e != nil {
+		return nil, e
+	}
+	req.Header.Set("Depth", depth)
+	req.Header.Set("Content-Type", "application/xml; charset=utf-8")
+	resp, e := r.do(req)
+	if e != nil {
+		return nil, e
+	}
+	defer resp.Body.Close()
+	ms := &davMultistatus{}
+	if e := xml.NewDecoder(io.LimitReader(resp.Body, 32*1024*1024)).Decode(ms); e != nil {
+		return nil, fmt.Errorf("cannot decode webdav response: %s", e.Error())
+	}
+	var nodes []*tree.Node
+	for _, res := range ms.Responses {
+		if n, ok := r.toNode(res); ok {
+			nodes = append(nodes, n)
+		}
+	}
+	return nodes, nil
+}
+
+// toNode converts a propfind response to a node whose path is relative to the root of the share.
+func (r *Remote) toNode(res davResponse) (*tree.Node, bool) {
+	href, e := url.Parse(res.Href)
+	if e != nil {
+		return nil, false
+	}
+	if !strings.HasPrefix(href.Path+"/", r.root.Path) {
+		return nil, false
+	}
+	for _, ps := range res.Propstats {
+		if !strings.Contains(ps.Status, " 200") {
+			continue
+		}
+		n := &tree.Node{
+			Path: strings.Trim(strings.TrimPrefix(href.Path+"/", r.root.Path), "/"),
+			Etag: strings.Trim(ps.Prop.Etag, `"`),
+			Type: tree.NodeType_LEAF,
+		}
+		if ps.Prop.Collection != nil {
+			n.Type = tree.NodeType_COLLECTION
+		}
+		n.Size, _ = strconv.ParseInt(ps.Prop.ContentLength, 10, 64)
+		if t, e := http.ParseTime(ps.Prop.LastModified); e == nil {
+			n.MTime = t.Unix()
+		}
+		n.SetMeta("name", path.Base("/"+n.Path))
+		return n, true
+	}
+	return nil, false
+}
+
+func (r *Remote) request(ctx context.Context, method string, p string, body io.Reader) (*http.Request, error) {
+	rel := strings.TrimLeft(path.Clean("/"+p), "/")
+	if strings.HasSuffix(p, "/") && rel != "" {
+		rel += "/"
+	}
+	target := r.root.ResolveReference(&url.URL{Path: rel})
+	req, e := http.NewRequest(method, target.String(), body)
+	if e != nil {
+		return nil, e
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Authorization", "Bearer "+r.secret)
+	return req, nil
+}
+
+func (r *Remote) do(req *http.Request) (*http.Response, error) {
+	resp, e := r.http.Do(req)
+	if e != nil {
+		return nil, e
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+		return resp, nil
+	}
+	ioutil.ReadAll(io.LimitReader(resp.Body, 4096))
+	resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusNotFound:
+		return nil, errors.NotFound(common.SERVICE_SHARE, "%s %s: not found on remote server", req.Method, req.URL.Path)
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return nil, errors.Forbidden(common.SERVICE_SHARE, "%s %s: access refused by remote server", req.Method, req.URL.Path)
+	}
+	return nil, errors.InternalServerError(common.SERVICE_SHARE, "%s %s returned status %d", req.Method, req.URL.Path, resp.StatusCode)
+}
+
+type countingReader struct {
+	io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, e := c.Reader.Read(p)
+	c.n += int64(n)
+	return n, e
+}
+
+// ListRemoteNodes lists the children of a folder of an incoming share. Paths are relative to the root of the share.
+func ListRemoteNodes(ctx context.Context, s *rest.OcmShare, folder string) (*rest.NodesCollection, error) {
+	remote, e := NewRemote(s)
+	if e != nil {
+		return nil, e
+	}
+	folder = strings.TrimLeft(path.Clean("/"+folder), "/")
+	parent, e := remote.ReadNode(ctx, folder)
+	if e != nil {
+		return nil, e
+	}
+	children, e := remote.ListNodes(ctx, folder)
+	if e != nil {
+		return nil, e
+	}
+	return &rest.NodesCollection{Parent: parent, Children: children}, nil
+}
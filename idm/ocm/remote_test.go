@@ -0,0 +1,123 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package ocm
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common/proto/rest"
+	"github.com/pydio/cells/common/proto/tree"
+)
+
+const testMultistatus = `<?xml version="1.0" encoding="UTF-8"?>
+<D:multistatus xmlns:D="DAV:">
+<D:response><D:href>/dav/reports-ws/</D:href><D:propstat><D:prop><D:resourcetype><D:collection/></D:resourcetype><D:getlastmodified>Mon, 02 Jan 2006 15:04:05 GMT</D:getlastmodified></D:prop><D:status>HTTP/1.1 200 OK</D:status></D:propstat></D:response>
+<D:response><D:href>/dav/reports-ws/Q1%20report.pdf</D:href><D:propstat><D:prop><D:resourcetype/><D:getcontentlength>1024</D:getcontentlength><D:getetag>"abc"</D:getetag></D:prop><D:status>HTTP/1.1 200 OK</D:status></D:propstat></D:response>
+<D:response><D:href>/dav/reports-ws/archives/</D:href><D:propstat><D:prop><D:resourcetype><D:collection/></D:resourcetype></D:prop><D:status>HTTP/1.1 200 OK</D:status></D:propstat></D:response>
+</D:multistatus>`
+
+func TestRemote(t *testing.T) {
+
+	var auth, depth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		if r.URL.Path != "/dav/reports-ws/" && r.URL.Path != "/dav/reports-ws/Q1 report.pdf" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch r.Method {
+		case "PROPFIND":
+			depth = r.Header.Get("Depth")
+			w.WriteHeader(207)
+			w.Write([]byte(testMultistatus))
+		case http.MethodGet:
+			w.Write([]byte("content"))
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	remote, e := NewRemote(&rest.OcmShare{RemoteUrl: srv.URL, ResourcePath: "/dav/reports-ws", SharedSecret: "secret"})
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	Convey("Test listing a remote folder", t, func() {
+		nodes, e := remote.ListNodes(ctx, "")
+		So(e, ShouldBeNil)
+		So(auth, ShouldEqual, "Bearer secret")
+		So(depth, ShouldEqual, "1")
+		So(nodes, ShouldHaveLength, 2)
+		So(nodes[0].Path, ShouldEqual, "Q1 report.pdf")
+		So(nodes[0].Type, ShouldEqual, tree.NodeType_LEAF)
+		So(nodes[0].Size, ShouldEqual, 1024)
+		So(nodes[0].Etag, ShouldEqual, "abc")
+		So(nodes[1].Path, ShouldEqual, "archives")
+		So(nodes[1].Type, ShouldEqual, tree.NodeType_COLLECTION)
+
+		root, e := remote.ReadNode(ctx, "/")
+		So(e, ShouldBeNil)
+		So(depth, ShouldEqual, "0")
+		So(root.Path, ShouldEqual, "")
+		So(root.MTime, ShouldEqual, 1136214245)
+	})
+
+	Convey("Test reading remote files", t, func() {
+		reader, e := remote.GetObject(ctx, "Q1 report.pdf", 0, 0)
+		So(e, ShouldBeNil)
+		data, _ := ioutil.ReadAll(reader)
+		reader.Close()
+		So(string(data), ShouldEqual, "content")
+
+		_, e = remote.GetObject(ctx, "../../other/file", 0, 0)
+		So(e, ShouldNotBeNil)
+		So(e.Error(), ShouldContainSubstring, "/dav/reports-ws/other/file")
+
+		So(remote.Delete(ctx, "Q1 report.pdf"), ShouldNotBeNil)
+	})
+}
+
+func TestConfirmShare(t *testing.T) {
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(207)
+		w.Write([]byte(testMultistatus))
+	}))
+	defer srv.Close()
+
+	Convey("Test confirming a share with the owner server", t, func() {
+		ctx := context.Background()
+		So(confirmShare(ctx, &rest.OcmShare{RemoteUrl: srv.URL, ResourcePath: "/dav/reports-ws", SharedSecret: "secret"}), ShouldBeNil)
+		So(confirmShare(ctx, &rest.OcmShare{RemoteUrl: srv.URL, ResourcePath: "/dav/reports-ws", SharedSecret: "forged"}), ShouldNotBeNil)
+	})
+}
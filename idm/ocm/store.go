@@ -0,0 +1,248 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package ocm
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/micro/go-micro/errors"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/config"
+	"github.com/pydio/cells/common/crypto"
+	"github.com/pydio/cells/common/micro"
+	"github.com/pydio/cells/common/proto/docstore"
+	"github.com/pydio/cells/common/proto/rest"
+)
+
+var (
+	keyConfigPath = []string{"services", ServiceName, "secretsKey"}
+	keyLock       = &sync.Mutex{}
+)
+
+func init() {
+	config.RegisterVaultKey(keyConfigPath...)
+}
+
+// NewSecret generates a random secret, used as the password of the hidden users of outgoing shares.
+func NewSecret() string {
+	b := make([]byte, 24)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// OutgoingSecret derives the shared secret of an outgoing share from its uuid and a key stored in the vault,
+// so that the secret itself is never stored: only its hash is kept to find the share.
+func OutgoingSecret(shareUuid string) (string, error) {
+	key, e := secretsKey()
+	if e != nil {
+		return "", e
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(shareUuid))
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// ShareSecret returns the shared secret of a share: incoming shares store the secret sent by the remote
+// server, the secret of outgoing shares is derived from their uuid.
+func ShareSecret(share *rest.OcmShare) (string, error) {
+	if share.Direction == rest.OcmShareDirection_OUTGOING {
+		return OutgoingSecret(share.Uuid)
+	}
+	return share.SharedSecret, nil
+}
+
+// IsSecret tells whether a token has the form of the shared secret of an outgoing share.
+func IsSecret(token string) bool {
+	if len(token) != 2*sha256.Size {
+		return false
+	}
+	_, e := hex.DecodeString(token)
+	return e == nil
+}
+
+// hashSecret is used to index and check outgoing shares by their shared secret without storing it.
+func hashSecret(secret string) string {
+	h := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(h[:])
+}
+
+// secretMatches compares the hashes of the shared secret of a share and of a secret sent by a remote server.
+func secretMatches(share *rest.OcmShare, secret string) bool {
+	expected, e := ShareSecret(share)
+	if e != nil || expected == "" || secret == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(hashSecret(expected)), []byte(hashSecret(secret))) == 1
+}
+
+// secretsKey loads the key used to derive the secrets of outgoing shares from the vault, generating it if required.
+func secretsKey() ([]byte, error) {
+	keyLock.Lock()
+	defer keyLock.Unlock()
+	if ref := config.Get(keyConfigPath...).String(""); ref != "" {
+		if encoded := config.GetSecret(ref).String(""); encoded != "" {
+			return base64.StdEncoding.DecodeString(encoded)
+		}
+	}
+	key, e := crypto.RandomBytes(32)
+	if e != nil {
+		return nil, e
+	}
+	config.Set(base64.StdEncoding.EncodeToString(key), keyConfigPath...)
+	if e := config.Save(common.PYDIO_SYSTEM_USERNAME, "Generate federated shares secrets key"); e != nil {
+		return nil, fmt.Errorf("cannot save federated shares secrets key: %s", e.Error())
+	}
+	return key, nil
+}
+
+// StoreShare saves a federated share in the docstore. Documents are owned by the local user. The shared secret
+// of outgoing shares is not stored.
+func StoreShare(ctx context.Context, share *rest.OcmShare) error {
+	store := docstore.NewDocStoreClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_DOCSTORE, defaults.NewClient())
+	meta := map[string]string{
+		"Direction":  share.Direction.String(),
+		"ProviderId": share.ProviderId,
+	}
+	if share.Direction == rest.OcmShareDirection_OUTGOING {
+		secret, e := OutgoingSecret(share.Uuid)
+		if e != nil {
+			return e
+		}
+		share.SharedSecret = ""
+		meta["SecretHash"] = hashSecret(secret)
+	}
+	data, _ := json.Marshal(share)
+	indexable, _ := json.Marshal(meta)
+	_, e := store.PutDocument(ctx, &docstore.PutDocumentRequest{
+		StoreID:    common.DOCSTORE_ID_OCM_SHARES,
+		DocumentID: share.Uuid,
+		Document: &docstore.Document{
+			ID:            share.Uuid,
+			Owner:         share.LocalUser,
+			Type:          docstore.DocumentType_JSON,
+			Data:          string(data),
+			IndexableMeta: string(indexable),
+		},
+	})
+	return e
+}
+
+// LoadShare loads a federated share from the docstore.
+func LoadShare(ctx context.Context, shareUuid string) (*rest.OcmShare, error) {
+	store := docstore.NewDocStoreClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_DOCSTORE, defaults.NewClient())
+	resp, e := store.GetDocument(ctx, &docstore.GetDocumentRequest{StoreID: common.DOCSTORE_ID_OCM_SHARES, DocumentID: shareUuid})
+	if e != nil || resp.Document == nil || resp.Document.Data == "" {
+		return nil, errors.NotFound(common.SERVICE_SHARE, "Cannot find federated share %s", shareUuid)
+	}
+	var share *rest.OcmShare
+	if e := json.Unmarshal([]byte(resp.Document.Data), &share); e != nil {
+		return nil, e
+	}
+	return share, nil
+}
+
+// ListShares lists the federated shares of a local user in one direction.
+func ListShares(ctx context.Context, localUser string, direction rest.OcmShareDirection) ([]*rest.OcmShare, error) {
+	return searchShares(ctx, &docstore.DocumentQuery{
+		Owner:     localUser,
+		MetaQuery: "+Direction:" + direction.String(),
+	})
+}
+
+// FindShare finds a share by the identifier used by the provider, and checks its shared secret.
+func FindShare(ctx context.Context, direction rest.OcmShareDirection, providerId string, secret string) (*rest.OcmShare, error) {
+	if providerId == "" || strings.ContainsAny(providerId, "\"\\") {
+		return nil, errors.NotFound(common.SERVICE_SHARE, "Cannot find federated share %s", providerId)
+	}
+	shares, e := searchShares(ctx, &docstore.DocumentQuery{
+		MetaQuery: "+Direction:" + direction.String() + " +ProviderId:\"" + providerId + "\"",
+	})
+	if e != nil {
+		return nil, e
+	}
+	for _, s := range shares {
+		if s.ProviderId == providerId && secretMatches(s, secret) {
+			return s, nil
+		}
+	}
+	return nil, errors.NotFound(common.SERVICE_SHARE, "Cannot find federated share %s", providerId)
+}
+
+// FindShareBySecret finds the outgoing share giving access to a resource with the given shared secret.
+func FindShareBySecret(ctx context.Context, secret string) (*rest.OcmShare, error) {
+	if secret == "" {
+		return nil, errors.NotFound(common.SERVICE_SHARE, "Cannot find federated share")
+	}
+	shares, e := searchShares(ctx, &docstore.DocumentQuery{
+		MetaQuery: "+Direction:" + rest.OcmShareDirection_OUTGOING.String() + " +SecretHash:\"" + hashSecret(secret) + "\"",
+	})
+	if e != nil {
+		return nil, e
+	}
+	for _, s := range shares {
+		if s.Direction == rest.OcmShareDirection_OUTGOING && secretMatches(s, secret) {
+			return s, nil
+		}
+	}
+	return nil, errors.NotFound(common.SERVICE_SHARE, "Cannot find federated share")
+}
+
+// DeleteShare removes a federated share from the docstore.
+func DeleteShare(ctx context.Context, shareUuid string) error {
+	store := docstore.NewDocStoreClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_DOCSTORE, defaults.NewClient())
+	_, e := store.DeleteDocuments(ctx, &docstore.DeleteDocumentsRequest{StoreID: common.DOCSTORE_ID_OCM_SHARES, DocumentID: shareUuid})
+	return e
+}
+
+func searchShares(ctx context.Context, query *docstore.DocumentQuery) ([]*rest.OcmShare, error) {
+	store := docstore.NewDocStoreClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_DOCSTORE, defaults.NewClient())
+	streamer, e := store.ListDocuments(ctx, &docstore.ListDocumentsRequest{StoreID: common.DOCSTORE_ID_OCM_SHARES, Query: query})
+	if e != nil {
+		return nil, e
+	}
+	defer streamer.Close()
+	var shares []*rest.OcmShare
+	for {
+		resp, e := streamer.Recv()
+		if e != nil {
+			break
+		}
+		if resp.Document == nil {
+			continue
+		}
+		var share *rest.OcmShare
+		if json.Unmarshal([]byte(resp.Document.Data), &share) == nil {
+			shares = append(shares, share)
+		}
+	}
+	return shares, nil
+}
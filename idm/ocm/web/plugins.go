@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+// Package web exposes the public Open Cloud Mesh endpoints, used by other servers to discover this one and send shares.
+package web
+
+import (
+	"context"
+
+	micro "github.com/micro/go-micro"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/micro"
+	"github.com/pydio/cells/common/plugins"
+	"github.com/pydio/cells/common/service"
+	"github.com/pydio/cells/idm/ocm"
+)
+
+func init() {
+	plugins.Register(func() {
+		service.NewService(
+			service.Name(ocm.ServiceName),
+			service.Tag(common.SERVICE_TAG_IDM),
+			service.Description("Open Cloud Mesh endpoints for federated sharing with other servers"),
+			service.Dependency(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_USER, []string{}),
+			service.Dependency(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_DOCSTORE, []string{}),
+			service.Dependency(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_WORKSPACE, []string{}),
+			service.WithGeneric(func(ctx context.Context, cancel context.CancelFunc) (service.Runner, service.Checker, service.Stopper, error) {
+				return service.RunnerFunc(func() error {
+						return nil
+					}), service.CheckerFunc(func() error {
+						return nil
+					}), service.StopperFunc(func() error {
+						return nil
+					}), nil
+			}, func(s service.Service) (micro.Option, error) {
+				srv := defaults.NewHTTPServer()
+
+				hd := srv.NewHandler(ocm.NewRouter())
+				if err := srv.Handle(hd); err != nil {
+					return nil, err
+				}
+
+				return micro.Server(srv), nil
+			}),
+		)
+	})
+}
@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package conditions
+
+import (
+	"strings"
+
+	"github.com/ory/ladon"
+)
+
+// ClientTypeCondition is a condition which is fulfilled if the type of client sending the request is one of
+// the Types: "web", "sync", "dav" or "api". Set Exclude to deny these clients instead.
+type ClientTypeCondition struct {
+	Types   []string `json:"types"`
+	Exclude bool     `json:"exclude"`
+}
+
+// Fulfills returns true if the given value is a string matching one of the types.
+func (c *ClientTypeCondition) Fulfills(value interface{}, _ *ladon.Request) bool {
+
+	s, _ := value.(string)
+	in := false
+	if s != "" {
+		for _, t := range c.Types {
+			if strings.EqualFold(strings.TrimSpace(t), s) {
+				in = true
+				break
+			}
+		}
+	}
+
+	return in != c.Exclude
+}
+
+// GetName returns the condition's name.
+func (c *ClientTypeCondition) GetName() string {
+	return "ClientTypeCondition"
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package conditions
+
+import (
+	"testing"
+
+	"github.com/ory/ladon"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestClientTypeCondition(t *testing.T) {
+
+	Convey("Canonical client type tests", t, func() {
+
+		for _, c := range []struct {
+			types   []string
+			exclude bool
+			value   interface{}
+			pass    bool
+		}{
+			{types: []string{"web", "sync"}, value: "sync", pass: true},
+			{types: []string{"WEB"}, value: "web", pass: true},
+			{types: []string{"web"}, value: "dav", pass: false},
+			{types: []string{"dav"}, exclude: true, value: "web", pass: true},
+			{types: []string{"dav"}, exclude: true, value: "dav", pass: false},
+			{types: []string{"web"}, value: nil, pass: false},
+		} {
+			condition := &ClientTypeCondition{
+				Types:   c.types,
+				Exclude: c.exclude,
+			}
+			So(condition.Fulfills(c.value, new(ladon.Request)), ShouldEqual, c.pass)
+		}
+	})
+}
@@ -22,6 +22,7 @@
 package conditions
 
 import (
+	"net"
 	"strconv"
 	"strings"
 	"time"
@@ -103,3 +104,16 @@ func timeStringToMinutes(str string) int {
 	minutes, _ := strconv.Atoi(tokens[1])
 	return hours*60 + minutes
 }
+
+// parseRemoteIP reads the IP of a remote address, that may contain a port.
+func parseRemoteIP(value interface{}) net.IP {
+	s, ok := value.(string)
+	if !ok {
+		return nil
+	}
+	s = strings.TrimSpace(s)
+	if host, _, err := net.SplitHostPort(s); err == nil {
+		s = host
+	}
+	return net.ParseIP(strings.Trim(s, "[]"))
+}
@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package conditions
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ory/ladon"
+)
+
+// ContextValueCondition is a condition which compares its value to the value of another Key of the request context,
+// typically a node metadata to a user attribute. Without Levels, it is fulfilled if the values are equal, the other
+// value may be a comma-separated list. With Levels, ordered from the lowest, it is fulfilled if the value level is
+// lower or equal to the other one, unknown values being the highest. Set Exclude to negate the comparison.
+// An empty value never fulfills the condition, so that unlabelled nodes are not concerned.
+// Only the profile and the user attributes listed in the managedAttributes option of the user service are
+// available under UserAttribute: keys, any other key is empty.
+type ContextValueCondition struct {
+	Key     string   `json:"key"`
+	Levels  []string `json:"levels"`
+	Exclude bool     `json:"exclude"`
+}
+
+// Fulfills returns true if the given value is a non-empty string matching the value of the other key.
+func (c *ContextValueCondition) Fulfills(value interface{}, r *ladon.Request) bool {
+
+	s := strings.TrimSpace(fmt.Sprintf("%v", value))
+	if value == nil || s == "" {
+		return false
+	}
+	var other string
+	if r != nil && r.Context != nil {
+		if o, ok := r.Context[c.Key]; ok && o != nil {
+			other = strings.TrimSpace(fmt.Sprintf("%v", o))
+		}
+	}
+
+	var match bool
+	if len(c.Levels) > 0 {
+		match = c.level(s, len(c.Levels)) <= c.level(other, -1)
+	} else {
+		for _, o := range strings.Split(other, ",") {
+			if strings.EqualFold(strings.TrimSpace(o), s) {
+				match = true
+				break
+			}
+		}
+	}
+
+	return match != c.Exclude
+}
+
+// level finds the index of a value in Levels, or returns def.
+func (c *ContextValueCondition) level(value string, def int) int {
+	for i, l := range c.Levels {
+		if strings.EqualFold(l, value) {
+			return i
+		}
+	}
+	return def
+}
+
+// GetName returns the condition's name.
+func (c *ContextValueCondition) GetName() string {
+	return "ContextValueCondition"
+}
@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package conditions
+
+import (
+	"testing"
+
+	"github.com/ory/ladon"
+	"github.com/ory/ladon/manager/memory"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContextValueCondition(t *testing.T) {
+
+	Convey("Canonical context value tests", t, func() {
+
+		levels := []string{"public", "internal", "confidential", "secret"}
+		for _, c := range []struct {
+			levels  []string
+			exclude bool
+			value   interface{}
+			other   interface{}
+			pass    bool
+		}{
+			{value: "legal", other: "legal", pass: true},
+			{value: "Legal", other: "sales, legal", pass: true},
+			{value: "legal", other: "sales", pass: false},
+			{value: "legal", other: nil, pass: false},
+			{value: "legal", other: "sales", exclude: true, pass: true},
+			{value: "", other: "sales", exclude: true, pass: false},
+			{value: nil, other: "sales", exclude: true, pass: false},
+			{levels: levels, value: "internal", other: "confidential", pass: true},
+			{levels: levels, value: "confidential", other: "confidential", pass: true},
+			{levels: levels, value: "secret", other: "confidential", pass: false},
+			{levels: levels, value: "public", other: nil, pass: false},
+			{levels: levels, value: "unknown", other: "secret", pass: false},
+			{levels: levels, value: "secret", other: "internal", exclude: true, pass: true},
+			{levels: levels, value: "internal", other: "internal", exclude: true, pass: false},
+		} {
+			condition := &ContextValueCondition{
+				Key:     "UserAttribute:clearance",
+				Levels:  c.levels,
+				Exclude: c.exclude,
+			}
+			request := &ladon.Request{Context: ladon.Context{}}
+			if c.other != nil {
+				request.Context["UserAttribute:clearance"] = c.other
+			}
+			So(condition.Fulfills(c.value, request), ShouldEqual, c.pass)
+		}
+	})
+}
+
+func TestContextValuePolicy(t *testing.T) {
+
+	Convey("Test a classification policy on nodes", t, func() {
+
+		ladonPolicy := &ladon.DefaultPolicy{
+			ID:          "classification-rule",
+			Description: "Deny reading nodes classified above the user clearance",
+			Subjects:    []string{"<.+>"},
+			Resources:   []string{"node"},
+			Actions:     []string{"read", "write"},
+			Effect:      ladon.DenyAccess,
+			Conditions: ladon.Conditions{
+				"NodeMeta:classification": &ContextValueCondition{
+					Key:     "UserAttribute:clearance",
+					Levels:  []string{"public", "internal", "confidential"},
+					Exclude: true,
+				},
+			},
+		}
+
+		warden := &ladon.Ladon{Manager: memory.NewMemoryManager()}
+		require.Nil(t, warden.Manager.Create(ladonPolicy))
+
+		err := warden.IsAllowed(&ladon.Request{
+			Subject:  "user:max",
+			Resource: "node",
+			Action:   "read",
+			Context:  ladon.Context{"NodeMeta:classification": "confidential", "UserAttribute:clearance": "internal"},
+		})
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldContainSubstring, "forcefully denied")
+
+		err = warden.IsAllowed(&ladon.Request{
+			Subject:  "user:max",
+			Resource: "node",
+			Action:   "read",
+			Context:  ladon.Context{"NodeMeta:classification": "confidential", "UserAttribute:clearance": "confidential"},
+		})
+		So(err.Error(), ShouldContainSubstring, "denied by default")
+
+		err = warden.IsAllowed(&ladon.Request{
+			Subject:  "user:max",
+			Resource: "node",
+			Action:   "read",
+			Context:  ladon.Context{"UserAttribute:clearance": "public"},
+		})
+		So(err.Error(), ShouldContainSubstring, "denied by default")
+	})
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package conditions
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ory/ladon"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/common/log"
+)
+
+// CountryCondition is a condition which is fulfilled if the remote address is located in one of the
+// Countries, given as ISO 3166 alpha-2 codes. Set Exclude to deny these countries instead.
+// Countries are resolved with the database registered by SetGeoIPDatabase.
+type CountryCondition struct {
+	Countries []string `json:"countries"`
+	Exclude   bool     `json:"exclude"`
+}
+
+// Fulfills returns true if the given value is a valid IP address, with or without port, located in the countries.
+// Addresses that cannot be resolved are considered out of all countries.
+func (c *CountryCondition) Fulfills(value interface{}, _ *ladon.Request) bool {
+
+	ip := parseRemoteIP(value)
+	if ip == nil {
+		log.Logger(context.Background()).Error("passed value must be an IP address", zap.Any("input param", value))
+		return false
+	}
+
+	in := false
+	if country := lookupCountry(ip); country != "" {
+		for _, cc := range c.Countries {
+			if strings.EqualFold(strings.TrimSpace(cc), country) {
+				in = true
+				break
+			}
+		}
+	}
+
+	return in != c.Exclude
+}
+
+// GetName returns the condition's name.
+func (c *CountryCondition) GetName() string {
+	return "CountryCondition"
+}
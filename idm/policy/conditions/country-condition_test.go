@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package conditions
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/ory/ladon"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const testGeoIPDatabase = `ip_from,ip_to,country_code,country_name
+# IP2Location format
+16777216,16777471,AU,Australia
+"3232235520","3232301055","FR","France"
+0,16777215,-,-
+# DB-IP format
+8.8.8.0,8.8.8.255,US
+2001:db8::,2001:db8:ffff:ffff:ffff:ffff:ffff:ffff,DE
+# CIDR format
+10.0.0.0/8,ch
+`
+
+func TestGeoIPDatabase(t *testing.T) {
+
+	Convey("Parse and query a GeoIP database", t, func() {
+
+		db, err := ParseGeoIPDatabase(strings.NewReader(testGeoIPDatabase))
+		So(err, ShouldBeNil)
+		So(db.ranges, ShouldHaveLength, 5)
+
+		So(db.Country(net.ParseIP("1.0.0.1")), ShouldEqual, "AU")
+		So(db.Country(net.ParseIP("192.168.12.4")), ShouldEqual, "FR")
+		So(db.Country(net.ParseIP("8.8.8.8")), ShouldEqual, "US")
+		So(db.Country(net.ParseIP("2001:db8::12")), ShouldEqual, "DE")
+		So(db.Country(net.ParseIP("10.255.255.255")), ShouldEqual, "CH")
+		So(db.Country(net.ParseIP("0.0.0.1")), ShouldEqual, "")
+		So(db.Country(net.ParseIP("8.8.9.1")), ShouldEqual, "")
+		So(db.Country(net.ParseIP("::1")), ShouldEqual, "")
+
+		_, err = ParseGeoIPDatabase(strings.NewReader("8.8.8.0,8.8.8.255,US\nwrong,line,FR\n"))
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestCountryCondition(t *testing.T) {
+
+	Convey("Canonical country tests", t, func() {
+
+		db, err := ParseGeoIPDatabase(strings.NewReader(testGeoIPDatabase))
+		So(err, ShouldBeNil)
+		SetGeoIPDatabase(db)
+		defer SetGeoIPDatabase(nil)
+
+		for _, c := range []struct {
+			countries []string
+			exclude   bool
+			value     interface{}
+			pass      bool
+		}{
+			{countries: []string{"FR", "CH"}, value: "192.168.1.1:8080", pass: true},
+			{countries: []string{"fr"}, value: "192.168.1.1", pass: true},
+			{countries: []string{"FR"}, value: "8.8.8.8", pass: false},
+			{countries: []string{"FR"}, value: "4.4.4.4", pass: false},
+			{countries: []string{"US"}, exclude: true, value: "8.8.8.8", pass: false},
+			{countries: []string{"US"}, exclude: true, value: "4.4.4.4", pass: true},
+			{countries: []string{"US"}, exclude: true, value: "", pass: false},
+		} {
+			condition := &CountryCondition{
+				Countries: c.countries,
+				Exclude:   c.exclude,
+			}
+			So(condition.Fulfills(c.value, new(ladon.Request)), ShouldEqual, c.pass)
+		}
+	})
+}
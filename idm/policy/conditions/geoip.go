@@ -0,0 +1,190 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package conditions
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var (
+	geoIPLock     sync.RWMutex
+	geoIPDatabase *GeoIPDatabase
+)
+
+type geoIPRange struct {
+	start   net.IP
+	end     net.IP
+	country string
+}
+
+// GeoIPDatabase resolves the country of an IP address from an offline list of IP ranges.
+type GeoIPDatabase struct {
+	ranges []geoIPRange
+}
+
+// SetGeoIPDatabase registers the database used by the CountryCondition.
+func SetGeoIPDatabase(db *GeoIPDatabase) {
+	geoIPLock.Lock()
+	defer geoIPLock.Unlock()
+	geoIPDatabase = db
+}
+
+func lookupCountry(ip net.IP) string {
+	geoIPLock.RLock()
+	defer geoIPLock.RUnlock()
+	if geoIPDatabase == nil {
+		return ""
+	}
+	return geoIPDatabase.Country(ip)
+}
+
+// LoadGeoIPDatabase reads a CSV database from a file, see ParseGeoIPDatabase for the supported formats.
+func LoadGeoIPDatabase(filename string) (*GeoIPDatabase, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseGeoIPDatabase(f)
+}
+
+// ParseGeoIPDatabase reads a CSV database where each line is either "cidr,country" or "start,end,country[,...]".
+// Range bounds are IP addresses or their decimal value, as found in the IP2Location LITE and DB-IP lite databases.
+// Country is an ISO 3166 alpha-2 code. A header line is ignored.
+func ParseGeoIPDatabase(r io.Reader) (*GeoIPDatabase, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.Comment = '#'
+	db := &GeoIPDatabase{}
+	line := 0
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		line++
+		rg, err := parseGeoIPRecord(record)
+		if err != nil {
+			if line == 1 {
+				continue
+			}
+			return nil, fmt.Errorf("geoip database line %d: %s", line, err.Error())
+		}
+		if rg.country == "" || rg.country == "-" {
+			continue
+		}
+		db.ranges = append(db.ranges, rg)
+	}
+	sort.Slice(db.ranges, func(i, j int) bool {
+		return bytes.Compare(db.ranges[i].start, db.ranges[j].start) < 0
+	})
+	return db, nil
+}
+
+// Country finds the country code of an IP address, or an empty string if it is not in the database.
+func (db *GeoIPDatabase) Country(ip net.IP) string {
+	ip = ip.To16()
+	if ip == nil {
+		return ""
+	}
+	// Find the last range starting before ip
+	i := sort.Search(len(db.ranges), func(i int) bool {
+		return bytes.Compare(db.ranges[i].start, ip) > 0
+	})
+	if i == 0 {
+		return ""
+	}
+	rg := db.ranges[i-1]
+	if bytes.Compare(ip, rg.end) > 0 {
+		return ""
+	}
+	return rg.country
+}
+
+func parseGeoIPRecord(record []string) (rg geoIPRange, err error) {
+	for i := range record {
+		record[i] = strings.TrimSpace(record[i])
+	}
+	if len(record) >= 2 && strings.Contains(record[0], "/") {
+		_, network, e := net.ParseCIDR(record[0])
+		if e != nil {
+			return rg, e
+		}
+		rg.start = network.IP.To16()
+		rg.end = make(net.IP, len(rg.start))
+		mask := network.Mask
+		if len(mask) == net.IPv4len {
+			mask = append(net.CIDRMask(96, 128)[:12], mask...)
+		}
+		for i := range rg.start {
+			rg.end[i] = rg.start[i] | ^mask[i]
+		}
+		rg.country = strings.ToUpper(record[1])
+		return rg, nil
+	}
+	if len(record) < 3 {
+		return rg, fmt.Errorf("expected cidr,country or start,end,country")
+	}
+	if rg.start, err = parseGeoIPAddress(record[0]); err != nil {
+		return
+	}
+	if rg.end, err = parseGeoIPAddress(record[1]); err != nil {
+		return
+	}
+	if bytes.Compare(rg.start, rg.end) > 0 {
+		return rg, fmt.Errorf("range start %s is after its end %s", record[0], record[1])
+	}
+	rg.country = strings.ToUpper(record[2])
+	return rg, nil
+}
+
+// parseGeoIPAddress reads an IP address, either as a string or as its decimal value.
+func parseGeoIPAddress(s string) (net.IP, error) {
+	if strings.ContainsAny(s, ".:") {
+		if ip := net.ParseIP(s); ip != nil {
+			return ip.To16(), nil
+		}
+		return nil, fmt.Errorf("invalid IP address %s", s)
+	}
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok || n.Sign() < 0 || n.BitLen() > 128 {
+		return nil, fmt.Errorf("invalid IP address %s", s)
+	}
+	if n.BitLen() <= 32 {
+		v := n.Uint64()
+		return net.IPv4(byte(v>>24), byte(v>>16), byte(v>>8), byte(v)).To16(), nil
+	}
+	b := n.Bytes()
+	ip := make(net.IP, net.IPv6len)
+	copy(ip[net.IPv6len-len(b):], b)
+	return ip, nil
+}
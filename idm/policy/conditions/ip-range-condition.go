@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package conditions
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/ory/ladon"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/common/log"
+)
+
+// IPRangeCondition is a condition which is fulfilled if the remote address is within one of the Ranges,
+// given as CIDR blocks or single IP addresses, e.g. "192.168.0.0/16". Set Exclude to deny these ranges instead.
+type IPRangeCondition struct {
+	Ranges  []string `json:"ranges"`
+	Exclude bool     `json:"exclude"`
+}
+
+// Fulfills returns true if the given value is a valid IP address, with or without port, and matches the ranges.
+func (c *IPRangeCondition) Fulfills(value interface{}, _ *ladon.Request) bool {
+
+	ip := parseRemoteIP(value)
+	if ip == nil {
+		log.Logger(context.Background()).Error("passed value must be an IP address", zap.Any("input param", value))
+		return false
+	}
+
+	in := false
+	for _, r := range c.Ranges {
+		r = strings.TrimSpace(r)
+		if strings.Contains(r, "/") {
+			_, network, err := net.ParseCIDR(r)
+			if err != nil {
+				log.Logger(context.Background()).Error("cannot parse IP range", zap.String("range", r), zap.Error(err))
+				continue
+			}
+			in = network.Contains(ip)
+		} else {
+			in = ip.Equal(net.ParseIP(r))
+		}
+		if in {
+			break
+		}
+	}
+
+	return in != c.Exclude
+}
+
+// GetName returns the condition's name.
+func (c *IPRangeCondition) GetName() string {
+	return "IPRangeCondition"
+}
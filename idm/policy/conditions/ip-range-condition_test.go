@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package conditions
+
+import (
+	"testing"
+
+	"github.com/ory/ladon"
+	"github.com/ory/ladon/manager/memory"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pydio/cells/common/service/context"
+)
+
+func TestIPRangeCondition(t *testing.T) {
+
+	Convey("Canonical IP range tests", t, func() {
+
+		for _, c := range []struct {
+			ranges  []string
+			exclude bool
+			value   interface{}
+			pass    bool
+		}{
+			{ranges: []string{"192.168.0.0/16"}, value: "192.168.1.12", pass: true},
+			{ranges: []string{"192.168.0.0/16"}, value: "192.168.1.12:54321", pass: true},
+			{ranges: []string{"192.168.0.0/16"}, value: "10.0.0.1", pass: false},
+			{ranges: []string{"10.0.0.0/8", "192.168.1.12"}, value: "192.168.1.12", pass: true},
+			{ranges: []string{"2001:db8::/32"}, value: "[2001:db8::1]:443", pass: true},
+			{ranges: []string{"2001:db8::/32"}, value: "::1", pass: false},
+			{ranges: []string{"192.168.0.0/16"}, exclude: true, value: "10.0.0.1", pass: true},
+			{ranges: []string{"192.168.0.0/16"}, exclude: true, value: "192.168.1.12", pass: false},
+			{ranges: []string{"192.168.0.0/16"}, value: "not-an-ip", pass: false},
+			{ranges: []string{"192.168.0.0/16"}, exclude: true, value: nil, pass: false},
+		} {
+			condition := &IPRangeCondition{
+				Ranges:  c.ranges,
+				Exclude: c.exclude,
+			}
+			So(condition.Fulfills(c.value, new(ladon.Request)), ShouldEqual, c.pass)
+		}
+	})
+}
+
+func TestIPRangePolicy(t *testing.T) {
+
+	Convey("Test IPRangePolicy", t, func() {
+
+		ladonPolicy := &ladon.DefaultPolicy{
+			ID:          "lan-only-rule",
+			Description: "ACL Rule example, allowing write only from the local network",
+			Subjects:    []string{"max"},
+			Resources:   []string{"resource1"},
+			Actions:     []string{"write"},
+			Effect:      ladon.AllowAccess,
+			Conditions: ladon.Conditions{
+				servicecontext.HttpMetaRemoteAddress: &IPRangeCondition{
+					Ranges: []string{"192.168.0.0/16"},
+				},
+			},
+		}
+
+		warden := &ladon.Ladon{Manager: memory.NewMemoryManager()}
+		require.Nil(t, warden.Manager.Create(ladonPolicy))
+
+		So(warden.IsAllowed(&ladon.Request{
+			Subject:  "max",
+			Resource: "resource1",
+			Action:   "write",
+			Context:  ladon.Context{"RemoteAddress": "192.168.0.8"},
+		}), ShouldBeNil)
+		So(warden.IsAllowed(&ladon.Request{
+			Subject:  "max",
+			Resource: "resource1",
+			Action:   "write",
+			Context:  ladon.Context{"RemoteAddress": "8.8.8.8"},
+		}), ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package conditions
+
+import (
+	"strconv"
+
+	"github.com/ory/ladon"
+)
+
+// MfaCondition is a condition which is fulfilled if the user authenticated with a second factor,
+// or did not if Authenticated is false.
+type MfaCondition struct {
+	Authenticated bool `json:"authenticated"`
+}
+
+// Fulfills returns true if the given value is a boolean string equal to Authenticated. A missing value counts as false.
+func (c *MfaCondition) Fulfills(value interface{}, _ *ladon.Request) bool {
+
+	s, _ := value.(string)
+	b, _ := strconv.ParseBool(s)
+
+	return b == c.Authenticated
+}
+
+// GetName returns the condition's name.
+func (c *MfaCondition) GetName() string {
+	return "MfaCondition"
+}
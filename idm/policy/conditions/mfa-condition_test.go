@@ -0,0 +1,40 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package conditions
+
+import (
+	"testing"
+
+	"github.com/ory/ladon"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMfaCondition(t *testing.T) {
+
+	Convey("Canonical MFA tests", t, func() {
+
+		So((&MfaCondition{Authenticated: true}).Fulfills("true", new(ladon.Request)), ShouldBeTrue)
+		So((&MfaCondition{Authenticated: true}).Fulfills("false", new(ladon.Request)), ShouldBeFalse)
+		So((&MfaCondition{Authenticated: true}).Fulfills(nil, new(ladon.Request)), ShouldBeFalse)
+		So((&MfaCondition{Authenticated: false}).Fulfills(nil, new(ladon.Request)), ShouldBeTrue)
+		So((&MfaCondition{Authenticated: false}).Fulfills("true", new(ladon.Request)), ShouldBeFalse)
+	})
+}
@@ -72,6 +72,14 @@ var (
 					Actions:     []string{"POST"},
 					Effect:      ladon.AllowAccess,
 				}),
+				LadonToProtoPolicy(&ladon.DefaultPolicy{
+					ID:          "webauthn-login",
+					Description: "PolicyGroup.PublicAccess.Rule5",
+					Subjects:    []string{"profile:anon"},
+					Resources:   []string{"rest:/mfa/webauthn/login/begin"},
+					Actions:     []string{"POST"},
+					Effect:      ladon.AllowAccess,
+				}),
 			},
 		},
 
@@ -132,6 +140,12 @@ var (
 						"rest:/frontend/<.*>",
 						"rest:/tree/<.*>",
 						"rest:/templates",
+						"rest:/mfa",
+						"rest:/mfa/<.+>",
+						"rest:/auth/pat",
+						"rest:/auth/pat/<.+>",
+						"rest:/auth/sessions",
+						"rest:/auth/sessions/<.+>",
 					},
 					Actions: []string{"GET", "POST", "DELETE", "PUT", "PATCH"},
 					Effect:  ladon.AllowAccess,
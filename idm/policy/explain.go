@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package policy
+
+import (
+	"sort"
+
+	"github.com/ory/ladon"
+
+	"github.com/pydio/cells/common/proto/idm"
+)
+
+// ExplainPolicies evaluates policies against a request the same way ladon does, but records a trace of each
+// policy that matches the request action, subject and resource. It stops on the first explicit deny.
+func ExplainPolicies(r *ladon.Request, policies ladon.Policies) (traces []*idm.PolicyTrace, allowed bool, denied bool, err error) {
+
+	matcher := ladon.DefaultMatcher
+	for _, p := range policies {
+		if m, e := matcher.Matches(p, p.GetActions(), r.Action); e != nil {
+			return nil, false, false, e
+		} else if !m {
+			continue
+		}
+		if m, e := matcher.Matches(p, p.GetSubjects(), r.Subject); e != nil {
+			return nil, false, false, e
+		} else if !m {
+			continue
+		}
+		if m, e := matcher.Matches(p, p.GetResources(), r.Resource); e != nil {
+			return nil, false, false, e
+		} else if !m {
+			continue
+		}
+
+		trace := &idm.PolicyTrace{
+			Subject: r.Subject,
+			Policy:  LadonToProtoPolicy(p),
+			Matched: true,
+		}
+		// Sort conditions to get a stable trace
+		conditions := p.GetConditions()
+		var keys []string
+		for k := range conditions {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			c := conditions[k]
+			fulfilled := c.Fulfills(r.Context[k], r)
+			trace.Conditions = append(trace.Conditions, &idm.PolicyConditionTrace{
+				Key:       k,
+				Type:      c.GetName(),
+				Fulfilled: fulfilled,
+			})
+			trace.Matched = trace.Matched && fulfilled
+		}
+		traces = append(traces, trace)
+		if !trace.Matched {
+			continue
+		}
+
+		if !p.AllowAccess() {
+			trace.Decisive = true
+			return traces, false, true, nil
+		}
+		allowed = true
+	}
+	return traces, allowed, false, nil
+}
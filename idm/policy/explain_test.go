@@ -0,0 +1,103 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package policy
+
+import (
+	"testing"
+
+	"github.com/ory/ladon"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestExplainPolicies(t *testing.T) {
+
+	policies := ladon.Policies{
+		&ladon.DefaultPolicy{
+			ID:        "other-resource",
+			Subjects:  []string{"profile:standard"},
+			Resources: []string{"rest:/user"},
+			Actions:   []string{"GET"},
+			Effect:    ladon.AllowAccess,
+		},
+		&ladon.DefaultPolicy{
+			ID:        "allow-workspaces",
+			Subjects:  []string{"profile:standard"},
+			Resources: []string{"rest:/workspace<.*>"},
+			Actions:   []string{"GET", "POST"},
+			Effect:    ladon.AllowAccess,
+		},
+		&ladon.DefaultPolicy{
+			ID:        "deny-from-agent",
+			Subjects:  []string{"profile:standard"},
+			Resources: []string{"rest:/workspace<.*>"},
+			Actions:   []string{"POST"},
+			Effect:    ladon.DenyAccess,
+			Conditions: ladon.Conditions{
+				"HttpUserAgent": &ladon.StringEqualCondition{Equals: "curl"},
+			},
+		},
+	}
+
+	Convey("Test allowed request", t, func() {
+		traces, allowed, denied, err := ExplainPolicies(&ladon.Request{
+			Subject:  "profile:standard",
+			Resource: "rest:/workspace",
+			Action:   "POST",
+			Context:  ladon.Context{"HttpUserAgent": "browser"},
+		}, policies)
+		So(err, ShouldBeNil)
+		So(allowed, ShouldBeTrue)
+		So(denied, ShouldBeFalse)
+		So(traces, ShouldHaveLength, 2)
+		So(traces[0].Policy.Id, ShouldEqual, "allow-workspaces")
+		So(traces[0].Matched, ShouldBeTrue)
+		So(traces[1].Matched, ShouldBeFalse)
+		So(traces[1].Conditions, ShouldHaveLength, 1)
+		So(traces[1].Conditions[0].Key, ShouldEqual, "HttpUserAgent")
+		So(traces[1].Conditions[0].Fulfilled, ShouldBeFalse)
+	})
+
+	Convey("Test explicitly denied request", t, func() {
+		traces, allowed, denied, err := ExplainPolicies(&ladon.Request{
+			Subject:  "profile:standard",
+			Resource: "rest:/workspace",
+			Action:   "POST",
+			Context:  ladon.Context{"HttpUserAgent": "curl"},
+		}, policies)
+		So(err, ShouldBeNil)
+		So(allowed, ShouldBeFalse)
+		So(denied, ShouldBeTrue)
+		So(traces, ShouldHaveLength, 2)
+		So(traces[1].Decisive, ShouldBeTrue)
+	})
+
+	Convey("Test request matching no policy", t, func() {
+		traces, allowed, denied, err := ExplainPolicies(&ladon.Request{
+			Subject:  "profile:shared",
+			Resource: "rest:/workspace",
+			Action:   "GET",
+		}, policies)
+		So(err, ShouldBeNil)
+		So(allowed, ShouldBeFalse)
+		So(denied, ShouldBeFalse)
+		So(traces, ShouldBeEmpty)
+	})
+}
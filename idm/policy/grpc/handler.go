@@ -82,6 +82,61 @@ func (h *Handler) IsAllowed(ctx context.Context, request *idm.PolicyEngineReques
 	return nil
 }
 
+// ExplainIsAllowed evaluates a request like IsAllowed, returning the trace of all matching policies
+func (h *Handler) ExplainIsAllowed(ctx context.Context, request *idm.PolicyEngineRequest, response *idm.PolicyEngineExplanation) error {
+
+	dao := servicecontext.GetDAO(ctx).(policy.DAO)
+
+	reqContext := make(map[string]interface{})
+	for k, v := range request.Context {
+		reqContext[k] = v
+	}
+	response.Response = &idm.PolicyEngineResponse{}
+	var firstAllow *idm.PolicyTrace
+
+	for _, subject := range request.Subjects {
+
+		ladonRequest := &ladon.Request{
+			Subject:  subject,
+			Resource: request.Resource,
+			Action:   request.Action,
+			Context:  reqContext,
+		}
+
+		candidates, err := dao.FindRequestCandidates(ladonRequest)
+		if err != nil {
+			return err
+		}
+		traces, allowed, denied, err := policy.ExplainPolicies(ladonRequest, candidates)
+		if err != nil {
+			return err
+		}
+		response.Traces = append(response.Traces, traces...)
+		if denied {
+			// Explicitly Deny : ignore following subjects
+			response.Response.ExplicitDeny = true
+			return nil
+		}
+		if allowed && firstAllow == nil {
+			for _, t := range traces {
+				if t.Matched {
+					firstAllow = t
+					break
+				}
+			}
+		}
+	}
+
+	if firstAllow != nil {
+		firstAllow.Decisive = true
+		response.Response.Allowed = true
+	} else {
+		response.Response.DefaultDeny = true
+	}
+
+	return nil
+}
+
 func (h *Handler) ListPolicyGroups(ctx context.Context, request *idm.ListPolicyGroupsRequest, response *idm.ListPolicyGroupsResponse) error {
 
 	dao := servicecontext.GetDAO(ctx).(policy.DAO)
@@ -24,6 +24,7 @@ package grpc
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 
 	"github.com/micro/go-micro"
 	"github.com/pydio/cells/common/plugins"
@@ -33,11 +34,13 @@ import (
 
 	"github.com/ory/ladon"
 	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/config"
 	"github.com/pydio/cells/common/log"
 	"github.com/pydio/cells/common/proto/idm"
 	"github.com/pydio/cells/common/service"
 	"github.com/pydio/cells/common/service/context"
 	"github.com/pydio/cells/idm/policy"
+	"github.com/pydio/cells/idm/policy/conditions"
 )
 
 func init() {
@@ -72,8 +75,27 @@ func init() {
 					TargetVersion: service.ValidVersion("1.4.2"),
 					Up:            Upgrade142,
 				},
+				{
+					TargetVersion: service.ValidVersion("1.6.2"),
+					Up:            Upgrade162Mfa,
+				},
+				{
+					TargetVersion: service.ValidVersion("1.6.2"),
+					Up:            Upgrade162WebauthnLogin,
+				},
+				{
+					TargetVersion: service.ValidVersion("1.6.2"),
+					Up:            Upgrade162PersonalAccessTokens,
+				},
+				{
+					TargetVersion: service.ValidVersion("1.6.2"),
+					Up:            Upgrade162Sessions,
+				},
 			}),
 			service.WithMicro(func(m micro.Service) error {
+				if geoip := servicecontext.GetConfig(m.Options().Context).String("geoipDatabase"); geoip != "" {
+					loadGeoIPDatabase(m.Options().Context, geoip)
+				}
 				handler := new(Handler)
 				idm.RegisterPolicyEngineServiceHandler(m.Options().Server, handler)
 				return nil
@@ -82,6 +104,26 @@ func init() {
 	})
 }
 
+// loadGeoIPDatabase registers the database resolving countries for the CountryCondition.
+// A relative path is resolved inside the service data directory.
+func loadGeoIPDatabase(ctx context.Context, filename string) {
+	if !filepath.IsAbs(filename) {
+		dir, e := config.ServiceDataDir(common.SERVICE_GRPC_NAMESPACE_ + common.SERVICE_POLICY)
+		if e != nil {
+			log.Logger(ctx).Error("cannot find service data directory for the GeoIP database", zap.Error(e))
+			return
+		}
+		filename = filepath.Join(dir, filename)
+	}
+	db, e := conditions.LoadGeoIPDatabase(filename)
+	if e != nil {
+		log.Logger(ctx).Error("cannot load GeoIP database, country conditions will not be fulfilled", zap.String("file", filename), zap.Error(e))
+		return
+	}
+	conditions.SetGeoIPDatabase(db)
+	log.Logger(ctx).Info("Loaded GeoIP database", zap.String("file", filename))
+}
+
 // InitDefaults is called once at first launch to create default policy groups.
 func InitDefaults(ctx context.Context) error {
 
@@ -308,3 +350,127 @@ func Upgrade142(ctx context.Context) error {
 	}
 	return nil
 }
+
+// Upgrade162Mfa grants logged users access to their second factor settings.
+// It is called once at service launch when Cells version become >= 1.6.2.
+func Upgrade162Mfa(ctx context.Context) error {
+	dao := servicecontext.GetDAO(ctx).(policy.DAO)
+	if dao == nil {
+		return fmt.Errorf("cannot find DAO for policies initialization")
+	}
+	if e := appendUserDefaultResources(ctx, dao, "rest:/mfa", "rest:/mfa/<.+>"); e != nil {
+		return e
+	}
+	log.Logger(ctx).Info("Upgraded policy model for second factor authentication")
+	return nil
+}
+
+// Upgrade162WebauthnLogin lets anonymous users start a passwordless login with a security key.
+// It is called once at service launch when Cells version become >= 1.6.2.
+func Upgrade162WebauthnLogin(ctx context.Context) error {
+	dao := servicecontext.GetDAO(ctx).(policy.DAO)
+	if dao == nil {
+		return fmt.Errorf("cannot find DAO for policies initialization")
+	}
+	groups, e := dao.ListPolicyGroups(ctx)
+	if e != nil {
+		return e
+	}
+	for _, group := range groups {
+		if group.Uuid != "public-access" {
+			continue
+		}
+		for _, p := range group.Policies {
+			if p.Id == "webauthn-login" {
+				return nil
+			}
+		}
+		group.Policies = append(group.Policies, policy.LadonToProtoPolicy(&ladon.DefaultPolicy{
+			ID:          "webauthn-login",
+			Description: "PolicyGroup.PublicAccess.Rule5",
+			Subjects:    []string{"profile:anon"},
+			Resources:   []string{"rest:/mfa/webauthn/login/begin"},
+			Actions:     []string{"POST"},
+			Effect:      ladon.AllowAccess,
+		}))
+		if _, er := dao.StorePolicyGroup(ctx, group); er != nil {
+			log.Logger(ctx).Error("could not update policy group "+group.Uuid, zap.Error(er))
+		} else {
+			log.Logger(ctx).Info("Updated policy group " + group.Uuid)
+		}
+	}
+	log.Logger(ctx).Info("Upgraded policy model for passwordless logins")
+	return nil
+}
+
+// Upgrade162PersonalAccessTokens grants logged users access to their personal access tokens.
+// It is called once at service launch when Cells version become >= 1.6.2.
+func Upgrade162PersonalAccessTokens(ctx context.Context) error {
+	dao := servicecontext.GetDAO(ctx).(policy.DAO)
+	if dao == nil {
+		return fmt.Errorf("cannot find DAO for policies initialization")
+	}
+	if e := appendUserDefaultResources(ctx, dao, "rest:/auth/pat", "rest:/auth/pat/<.+>"); e != nil {
+		return e
+	}
+	log.Logger(ctx).Info("Upgraded policy model for personal access tokens")
+	return nil
+}
+
+// Upgrade162Sessions grants logged users access to their authentication sessions.
+// It is called once at service launch when Cells version become >= 1.6.2.
+func Upgrade162Sessions(ctx context.Context) error {
+	dao := servicecontext.GetDAO(ctx).(policy.DAO)
+	if dao == nil {
+		return fmt.Errorf("cannot find DAO for policies initialization")
+	}
+	if e := appendUserDefaultResources(ctx, dao, "rest:/auth/sessions", "rest:/auth/sessions/<.+>"); e != nil {
+		return e
+	}
+	log.Logger(ctx).Info("Upgraded policy model for sessions management")
+	return nil
+}
+
+// appendUserDefaultResources adds the resources to the user-default-policy rule, skipping
+// the ones that are already there so that migrations can safely be replayed.
+func appendUserDefaultResources(ctx context.Context, dao policy.DAO, resources ...string) error {
+	groups, e := dao.ListPolicyGroups(ctx)
+	if e != nil {
+		return e
+	}
+	for _, group := range groups {
+		if group.Uuid != "rest-apis-default-accesses" {
+			continue
+		}
+		var changed bool
+		for _, p := range group.Policies {
+			if p.Id != "user-default-policy" {
+				continue
+			}
+			for _, r := range resources {
+				if !containsString(p.Resources, r) {
+					p.Resources = append(p.Resources, r)
+					changed = true
+				}
+			}
+		}
+		if !changed {
+			continue
+		}
+		if _, er := dao.StorePolicyGroup(ctx, group); er != nil {
+			log.Logger(ctx).Error("could not update policy group "+group.Uuid, zap.Error(er))
+		} else {
+			log.Logger(ctx).Info("Updated policy group " + group.Uuid)
+		}
+	}
+	return nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+	return false
+}
@@ -50,4 +50,24 @@ func init() {
 		return new(conditions.DateAfterCondition)
 	}
 
+	ladon.ConditionFactories[new(conditions.IPRangeCondition).GetName()] = func() ladon.Condition {
+		return new(conditions.IPRangeCondition)
+	}
+
+	ladon.ConditionFactories[new(conditions.CountryCondition).GetName()] = func() ladon.Condition {
+		return new(conditions.CountryCondition)
+	}
+
+	ladon.ConditionFactories[new(conditions.ClientTypeCondition).GetName()] = func() ladon.Condition {
+		return new(conditions.ClientTypeCondition)
+	}
+
+	ladon.ConditionFactories[new(conditions.MfaCondition).GetName()] = func() ladon.Condition {
+		return new(conditions.MfaCondition)
+	}
+
+	ladon.ConditionFactories[new(conditions.ContextValueCondition).GetName()] = func() ladon.Condition {
+		return new(conditions.ContextValueCondition)
+	}
+
 }
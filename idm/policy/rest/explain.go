@@ -0,0 +1,275 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/emicklei/go-restful"
+	"github.com/micro/go-micro/errors"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/auth/claim"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/micro"
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/rest"
+	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/service"
+	"github.com/pydio/cells/common/utils/permissions"
+)
+
+// aclPolicyExplainer evaluates the security policies attached to a node by policy ACLs.
+type aclPolicyExplainer func(ctx context.Context, policyIds []string, flag permissions.BitmaskFlag, node *tree.Node) ([]*idm.PolicyTrace, bool, error)
+
+// ExplainPolicy lists the roles, policies and ACLs that are evaluated when a user accesses a resource,
+// telling which one decided of the access.
+func (h *PolicyHandler) ExplainPolicy(req *restful.Request, rsp *restful.Response) {
+
+	ctx := req.Request.Context()
+	if claims, ok := ctx.Value(claim.ContextKey).(claim.Claims); !ok || claims.Profile != common.PYDIO_PROFILE_ADMIN {
+		service.RestError403(req, rsp, errors.Forbidden(common.SERVICE_POLICY, "only admins can explain policies"))
+		return
+	}
+
+	var input rest.PolicyExplainRequest
+	if e := req.ReadEntity(&input); e != nil {
+		service.RestError500(req, rsp, e)
+		return
+	}
+	log.Logger(ctx).Info("Received Policy.Explain API request")
+
+	user, e := permissions.SearchUniqueUser(ctx, input.UserLogin, "")
+	if e != nil {
+		service.RestError404(req, rsp, e)
+		return
+	}
+	var roleIds []string
+	for _, r := range user.Roles {
+		roleIds = append(roleIds, r.Uuid)
+	}
+	roles := permissions.GetRoles(ctx, roleIds)
+	response := &rest.PolicyExplanation{Roles: roles}
+
+	switch input.Type {
+	case rest.PolicyExplainRequest_REST:
+
+		action := strings.ToUpper(input.Action)
+		if action == "" {
+			action = "GET"
+		}
+		explanation, e := h.getClient().ExplainIsAllowed(ctx, &idm.PolicyEngineRequest{
+			Subjects: permissions.PolicyRequestSubjectsFromUser(user),
+			Resource: "rest:" + restResource(input.Resource),
+			Action:   action,
+			Context:  input.Context,
+		})
+		if e != nil {
+			service.RestError500(req, rsp, e)
+			return
+		}
+		response.Policies = explanation.Traces
+		response.Allowed, response.Decision = policiesDecision(explanation)
+
+	case rest.PolicyExplainRequest_NODE:
+
+		flag, ok := permissions.NamesToFlags[strings.ToLower(input.Action)]
+		if !ok || (flag != permissions.FlagRead && flag != permissions.FlagWrite) {
+			service.RestError500(req, rsp, errors.BadRequest(common.SERVICE_POLICY, "action must be read or write for nodes"))
+			return
+		}
+		treeClient := tree.NewNodeProviderClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_TREE, defaults.NewClient())
+		nodes, e := tree.BuildAncestorsListOrParent(ctx, treeClient, &tree.Node{Path: strings.Trim(input.Resource, "/")})
+		if e != nil || len(nodes) == 0 {
+			service.RestError404(req, rsp, errors.NotFound(common.SERVICE_POLICY, "cannot find node %s", input.Resource))
+			return
+		}
+		acls := permissions.GetACLsForRoles(ctx, roles, permissions.AclRead, permissions.AclDeny, permissions.AclWrite, permissions.AclLock, permissions.AclPolicy)
+		traces, allowed, decision, e := explainAcls(ctx, roles, acls, nodes, flag, h.aclPolicyExplainer(input.Context))
+		if e != nil {
+			service.RestError500(req, rsp, e)
+			return
+		}
+		response.Acls = traces
+		response.Allowed = allowed
+		response.Decision = decision
+	}
+
+	rsp.WriteEntity(response)
+}
+
+func (h *PolicyHandler) aclPolicyExplainer(reqContext map[string]string) aclPolicyExplainer {
+	return func(ctx context.Context, policyIds []string, flag permissions.BitmaskFlag, node *tree.Node) ([]*idm.PolicyTrace, bool, error) {
+		policyContext := make(map[string]string, len(reqContext))
+		for k, v := range reqContext {
+			policyContext[k] = v
+		}
+		permissions.PolicyContextFromNode(policyContext, node)
+		var subjects []string
+		for _, id := range policyIds {
+			subjects = append(subjects, fmt.Sprintf("policy:%s", id))
+		}
+		explanation, e := h.getClient().ExplainIsAllowed(ctx, &idm.PolicyEngineRequest{
+			Subjects: subjects,
+			Resource: "acl",
+			Action:   permissions.FlagsToNames[flag],
+			Context:  policyContext,
+		})
+		if e != nil {
+			return nil, false, e
+		}
+		return explanation.Traces, explanation.Response.Allowed, nil
+	}
+}
+
+// restResource normalizes a REST path as seen by the policy wrapper, without the REST endpoint prefix.
+func restResource(p string) string {
+	p = strings.TrimPrefix(p, "/")
+	if p == "a" || strings.HasPrefix(p, "a/") {
+		p = strings.TrimPrefix(p, "a")
+	}
+	return "/" + strings.TrimPrefix(p, "/")
+}
+
+func policiesDecision(explanation *idm.PolicyEngineExplanation) (bool, string) {
+	for _, t := range explanation.Traces {
+		if !t.Decisive {
+			continue
+		}
+		if explanation.Response.ExplicitDeny {
+			return false, fmt.Sprintf("Denied by policy %s for subject %s", t.Policy.Id, t.Subject)
+		}
+		return true, fmt.Sprintf("Allowed by policy %s for subject %s", t.Policy.Id, t.Subject)
+	}
+	return false, "Denied by default: no policy allows this request"
+}
+
+// explainAcls replays the ACL resolution of AccessList.CanRead and AccessList.CanWrite on a node and its parents.
+// For each node, the ACLs of the last role defining ACLs on this node are effective.
+func explainAcls(ctx context.Context, roles []*idm.Role, acls []*idm.ACL, nodes []*tree.Node, flag permissions.BitmaskFlag, policies aclPolicyExplainer) (traces []*rest.AclTrace, allowed bool, decision string, e error) {
+
+	accessList := permissions.NewAccessList(roles, acls)
+	accessList.Flatten(ctx)
+	masks := accessList.GetNodesBitmasks()
+
+	roleLabels := make(map[string]string, len(roles))
+	for _, r := range roles {
+		roleLabels[r.Uuid] = r.Label
+	}
+
+	// Collect the ACLs of each node, in roles order
+	nodeTraces := make(map[string][]*rest.AclTrace, len(nodes))
+	effective := make(map[string]string, len(nodes))
+	for _, node := range nodes {
+		if _, ok := masks[node.Uuid]; !ok {
+			continue
+		}
+		effective[node.Uuid] = effectiveRole(roles, acls, node.Uuid)
+		for _, r := range roles {
+			for _, acl := range acls {
+				if acl.NodeID == node.Uuid && acl.RoleID == r.Uuid {
+					t := &rest.AclTrace{
+						Acl:       acl,
+						NodePath:  node.Path,
+						Effective: r.Uuid == effective[node.Uuid],
+					}
+					nodeTraces[node.Uuid] = append(nodeTraces[node.Uuid], t)
+					traces = append(traces, t)
+				}
+			}
+		}
+	}
+
+	// A deny anywhere up the path wins
+	for _, node := range nodes {
+		if mask, ok := masks[node.Uuid]; ok && mask.HasFlag(ctx, permissions.FlagDeny) {
+			markDecisive(nodeTraces[node.Uuid], permissions.AclDeny.Name)
+			decision = fmt.Sprintf("Denied by a deny ACL of role %s on %s", roleLabels[effective[node.Uuid]], node.Path)
+			return traces, false, decision, nil
+		}
+	}
+
+	// Otherwise the closest node having ACLs decides
+	for _, node := range nodes {
+		mask, ok := masks[node.Uuid]
+		if !ok || mask.BitmaskFlag == 0 {
+			continue
+		}
+		role := roleLabels[effective[node.Uuid]]
+
+		if mask.BitmaskFlag&permissions.FlagPolicy != 0 {
+			var ids []string
+			for id := range mask.PolicyIds {
+				ids = append(ids, id)
+			}
+			policyTraces, ok, er := policies(ctx, ids, flag, nodes[0])
+			if er != nil {
+				return nil, false, "", er
+			}
+			for _, t := range markDecisive(nodeTraces[node.Uuid], permissions.AclPolicy.Name) {
+				t.Policies = policyTraces
+			}
+			if ok {
+				return traces, true, fmt.Sprintf("Allowed by the security policy of role %s on %s", role, node.Path), nil
+			}
+			return traces, false, fmt.Sprintf("Denied by the security policy of role %s on %s", role, node.Path), nil
+		}
+
+		name := permissions.FlagsToNames[flag]
+		if mask.BitmaskFlag&flag != 0 {
+			markDecisive(nodeTraces[node.Uuid], name)
+			return traces, true, fmt.Sprintf("Allowed by a %s ACL of role %s on %s", name, role, node.Path), nil
+		}
+		for _, t := range nodeTraces[node.Uuid] {
+			t.Decisive = t.Effective
+		}
+		return traces, false, fmt.Sprintf("Denied: ACLs of role %s on %s do not grant %s", role, node.Path, name), nil
+	}
+
+	return traces, false, "Denied: no ACL found on the node or its parents", nil
+}
+
+// effectiveRole finds the last role that defines ACLs on a node.
+func effectiveRole(roles []*idm.Role, acls []*idm.ACL, nodeId string) string {
+	var effective string
+	for _, r := range roles {
+		for _, acl := range acls {
+			if acl.NodeID == nodeId && acl.RoleID == r.Uuid {
+				effective = r.Uuid
+				break
+			}
+		}
+	}
+	return effective
+}
+
+// markDecisive flags the effective traces with the given action, and returns them.
+func markDecisive(traces []*rest.AclTrace, action string) (marked []*rest.AclTrace) {
+	for _, t := range traces {
+		if t.Effective && t.Acl.Action != nil && t.Acl.Action.Name == action {
+			t.Decisive = true
+			marked = append(marked, t)
+		}
+	}
+	return
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package rest
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/utils/permissions"
+)
+
+func TestExplainAcls(t *testing.T) {
+
+	ctx := context.Background()
+	roles := []*idm.Role{
+		{Uuid: "root", Label: "Root Group"},
+		{Uuid: "team", Label: "Team"},
+		{Uuid: "user", Label: "User"},
+	}
+	acls := []*idm.ACL{
+		{WorkspaceID: "ws", NodeID: "folder", RoleID: "root", Action: permissions.AclRead},
+		{WorkspaceID: "ws", NodeID: "folder", RoleID: "team", Action: permissions.AclRead},
+		{WorkspaceID: "ws", NodeID: "folder", RoleID: "team", Action: permissions.AclWrite},
+		{NodeID: "private", RoleID: "user", Action: permissions.AclDeny},
+		{NodeID: "guarded", RoleID: "user", Action: &idm.ACLAction{Name: "policy", Value: "office-hours"}},
+	}
+	noPolicy := func(ctx context.Context, ids []string, flag permissions.BitmaskFlag, node *tree.Node) ([]*idm.PolicyTrace, bool, error) {
+		return []*idm.PolicyTrace{{Subject: "policy:" + ids[0], Matched: true, Decisive: true}}, false, nil
+	}
+	folder := &tree.Node{Uuid: "folder", Path: "ds/folder"}
+
+	Convey("Test ACL of the last role wins", t, func() {
+		traces, allowed, decision, err := explainAcls(ctx, roles, acls, []*tree.Node{{Uuid: "file", Path: "ds/folder/file"}, folder}, permissions.FlagWrite, noPolicy)
+		So(err, ShouldBeNil)
+		So(allowed, ShouldBeTrue)
+		So(decision, ShouldContainSubstring, "Team")
+		So(traces, ShouldHaveLength, 3)
+		So(traces[0].Effective, ShouldBeFalse)
+		So(traces[2].Acl.Action.Name, ShouldEqual, "write")
+		So(traces[2].Decisive, ShouldBeTrue)
+	})
+
+	Convey("Test deny on a parent", t, func() {
+		traces, allowed, decision, err := explainAcls(ctx, roles, acls, []*tree.Node{folder, {Uuid: "private", Path: "ds/private"}}, permissions.FlagRead, noPolicy)
+		So(err, ShouldBeNil)
+		So(allowed, ShouldBeFalse)
+		So(decision, ShouldContainSubstring, "deny")
+		So(traces, ShouldHaveLength, 4)
+		So(traces[1].Decisive, ShouldBeFalse)
+		So(traces[3].Decisive, ShouldBeTrue)
+	})
+
+	Convey("Test policy ACL", t, func() {
+		traces, allowed, _, err := explainAcls(ctx, roles, acls, []*tree.Node{{Uuid: "guarded", Path: "ds/guarded"}}, permissions.FlagRead, noPolicy)
+		So(err, ShouldBeNil)
+		So(allowed, ShouldBeFalse)
+		So(traces, ShouldHaveLength, 1)
+		So(traces[0].Decisive, ShouldBeTrue)
+		So(traces[0].Policies, ShouldHaveLength, 1)
+	})
+
+	Convey("Test no ACL", t, func() {
+		traces, allowed, decision, err := explainAcls(ctx, roles, acls, []*tree.Node{{Uuid: "other", Path: "ds/other"}}, permissions.FlagRead, noPolicy)
+		So(err, ShouldBeNil)
+		So(allowed, ShouldBeFalse)
+		So(traces, ShouldBeEmpty)
+		So(decision, ShouldContainSubstring, "no ACL")
+	})
+}
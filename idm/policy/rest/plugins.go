@@ -34,6 +34,10 @@ func init() {
 			service.Tag(common.SERVICE_TAG_IDM),
 			service.Description("RESTful service for managing policies"),
 			service.Dependency(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_POLICY, []string{}),
+			service.Dependency(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_USER, []string{}),
+			service.Dependency(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_ROLE, []string{}),
+			service.Dependency(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_ACL, []string{}),
+			service.Dependency(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_TREE, []string{}),
 			service.WithWeb(func() service.WebHandler {
 				return new(PolicyHandler)
 			}),
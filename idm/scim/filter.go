@@ -0,0 +1,416 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Filter is a parsed SCIM filter expression, see https://tools.ietf.org/html/rfc7644#section-3.4.2.2.
+// Filters are evaluated on the JSON representation of resources.
+type Filter struct {
+	// Operator is one of and, or, not, a comparison operator, pr, or "[]" for value paths.
+	Operator string
+	Path     *AttrPath
+	Value    interface{}
+	Children []*Filter
+}
+
+// AttrPath is an attribute path, optionally prefixed by a schema URN and followed by a sub-attribute.
+type AttrPath struct {
+	Schema string
+	Name   string
+	Sub    string
+}
+
+var (
+	comparisonOperators = map[string]bool{"eq": true, "ne": true, "co": true, "sw": true, "ew": true, "gt": true, "ge": true, "lt": true, "le": true}
+)
+
+// ParseAttrPath parses an attribute path like emails.value or urn:...:enterprise:2.0:User:department.
+func ParseAttrPath(s string) (*AttrPath, error) {
+	p := &AttrPath{}
+	if strings.HasPrefix(strings.ToLower(s), "urn:") {
+		i := strings.LastIndex(s, ":")
+		p.Schema, s = s[:i], s[i+1:]
+	}
+	parts := strings.Split(s, ".")
+	if len(parts) > 2 || parts[0] == "" {
+		return nil, fmt.Errorf("invalid attribute path %q", s)
+	}
+	p.Name = parts[0]
+	if len(parts) == 2 {
+		p.Sub = parts[1]
+	}
+	return p, nil
+}
+
+// String returns the path in its canonical form.
+func (p *AttrPath) String() string {
+	s := p.Name
+	if p.Schema != "" {
+		s = p.Schema + ":" + s
+	}
+	if p.Sub != "" {
+		s += "." + p.Sub
+	}
+	return s
+}
+
+// ParseFilter parses a filter expression.
+func ParseFilter(s string) (*Filter, error) {
+	tokens, err := tokenizeFilter(s)
+	if err != nil {
+		return nil, err
+	}
+	p := &filterParser{tokens: tokens}
+	f, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected %q in filter", p.tokens[p.pos])
+	}
+	return f, nil
+}
+
+// tokenizeFilter splits a filter in words, JSON strings, parentheses and brackets.
+func tokenizeFilter(s string) ([]string, error) {
+	var tokens []string
+	for i := 0; i < len(s); {
+		switch c := s[i]; {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(' || c == ')' || c == '[' || c == ']':
+			tokens = append(tokens, string(c))
+			i++
+		case c == '"':
+			j := i + 1
+			for ; j < len(s) && s[j] != '"'; j++ {
+				if s[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(s) {
+				return nil, fmt.Errorf("unterminated string in filter")
+			}
+			tokens = append(tokens, s[i:j+1])
+			i = j + 1
+		default:
+			j := i
+			for ; j < len(s) && !strings.ContainsRune(" \t\n\r()[]\"", rune(s[j])); j++ {
+			}
+			tokens = append(tokens, s[i:j])
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+type filterParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *filterParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *filterParser) next() string {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+func (p *filterParser) expect(t string) error {
+	if got := p.next(); got != t {
+		return fmt.Errorf("expected %q in filter, got %q", t, got)
+	}
+	return nil
+}
+
+func (p *filterParser) parseOr() (*Filter, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for strings.ToLower(p.peek()) == "or" {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &Filter{Operator: "or", Children: []*Filter{left, right}}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (*Filter, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for strings.ToLower(p.peek()) == "and" {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &Filter{Operator: "and", Children: []*Filter{left, right}}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseUnary() (*Filter, error) {
+	switch t := p.peek(); {
+	case t == "(":
+		p.next()
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		return f, p.expect(")")
+	case strings.ToLower(t) == "not":
+		p.next()
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		return &Filter{Operator: "not", Children: []*Filter{f}}, p.expect(")")
+	case t == "":
+		return nil, fmt.Errorf("unexpected end of filter")
+	}
+
+	path, err := ParseAttrPath(p.next())
+	if err != nil {
+		return nil, err
+	}
+	if p.peek() == "[" {
+		p.next()
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		return &Filter{Operator: "[]", Path: path, Children: []*Filter{inner}}, p.expect("]")
+	}
+	op := strings.ToLower(p.next())
+	if op == "pr" {
+		return &Filter{Operator: op, Path: path}, nil
+	}
+	if !comparisonOperators[op] {
+		return nil, fmt.Errorf("unknown operator %q in filter", op)
+	}
+	raw := p.next()
+	var value interface{}
+	if err := json.Unmarshal([]byte(raw), &value); err != nil {
+		return nil, fmt.Errorf("invalid value %q in filter", raw)
+	}
+	return &Filter{Operator: op, Path: path, Value: value}, nil
+}
+
+// Equality returns the value compared with eq to the given attribute, if the filter requires it.
+// It is used to narrow searches before evaluating the whole filter.
+func (f *Filter) Equality(attribute string) (string, bool) {
+	switch f.Operator {
+	case "eq":
+		if f.Path.Schema == "" && f.Path.Sub == "" && strings.EqualFold(f.Path.Name, attribute) {
+			v, ok := f.Value.(string)
+			return v, ok
+		}
+	case "and":
+		for _, c := range f.Children {
+			if v, ok := c.Equality(attribute); ok {
+				return v, ok
+			}
+		}
+	}
+	return "", false
+}
+
+// Matches evaluates the filter on a resource in its JSON form.
+func (f *Filter) Matches(resource map[string]interface{}) bool {
+	switch f.Operator {
+	case "and":
+		return f.Children[0].Matches(resource) && f.Children[1].Matches(resource)
+	case "or":
+		return f.Children[0].Matches(resource) || f.Children[1].Matches(resource)
+	case "not":
+		return !f.Children[0].Matches(resource)
+	case "[]":
+		for _, item := range asList(lookup(resource, f.Path.Schema, f.Path.Name)) {
+			if m, ok := item.(map[string]interface{}); ok && f.Children[0].Matches(m) {
+				return true
+			}
+		}
+		return false
+	}
+	values := f.Path.Values(resource)
+	if f.Operator == "pr" {
+		for _, v := range values {
+			if v != nil && v != "" {
+				return true
+			}
+		}
+		return false
+	}
+	if f.Operator == "ne" {
+		for _, v := range values {
+			if compare("eq", v, f.Value) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, v := range values {
+		if compare(f.Operator, v, f.Value) {
+			return true
+		}
+	}
+	return false
+}
+
+// Values returns the values of the attribute in a resource. Multi-valued complex attributes without
+// sub-attribute are compared upon their "value" sub-attribute.
+func (p *AttrPath) Values(resource map[string]interface{}) []interface{} {
+	var values []interface{}
+	for _, item := range asList(lookup(resource, p.Schema, p.Name)) {
+		if m, ok := item.(map[string]interface{}); ok {
+			sub := p.Sub
+			if sub == "" {
+				sub = "value"
+			}
+			if v, ok := m[findKey(m, sub)]; ok {
+				values = append(values, v)
+			}
+		} else if p.Sub == "" {
+			values = append(values, item)
+		}
+	}
+	return values
+}
+
+// Strings returns the values of the attribute as strings.
+func (p *AttrPath) Strings(resource map[string]interface{}) []string {
+	var strs []string
+	for _, v := range p.Values(resource) {
+		switch t := v.(type) {
+		case string:
+			strs = append(strs, t)
+		case nil:
+		default:
+			strs = append(strs, fmt.Sprintf("%v", t))
+		}
+	}
+	return strs
+}
+
+// lookup returns an attribute of a resource, in the extension object if schema is set.
+func lookup(resource map[string]interface{}, schema, name string) interface{} {
+	if schema != "" {
+		ext, ok := resource[findKey(resource, schema)].(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		resource = ext
+	}
+	return resource[findKey(resource, name)]
+}
+
+// findKey returns the key of m matching name case-insensitively, as attribute names are case-insensitive.
+func findKey(m map[string]interface{}, name string) string {
+	if _, ok := m[name]; ok {
+		return name
+	}
+	for k := range m {
+		if strings.EqualFold(k, name) {
+			return k
+		}
+	}
+	return name
+}
+
+func asList(v interface{}) []interface{} {
+	switch t := v.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		return t
+	}
+	return []interface{}{v}
+}
+
+// compare applies a comparison operator, strings being compared case-insensitively.
+func compare(op string, v, ref interface{}) bool {
+	switch r := ref.(type) {
+	case string:
+		s, ok := v.(string)
+		if !ok {
+			return false
+		}
+		s, r = strings.ToLower(s), strings.ToLower(r)
+		switch op {
+		case "eq":
+			return s == r
+		case "co":
+			return strings.Contains(s, r)
+		case "sw":
+			return strings.HasPrefix(s, r)
+		case "ew":
+			return strings.HasSuffix(s, r)
+		case "gt":
+			return s > r
+		case "ge":
+			return s >= r
+		case "lt":
+			return s < r
+		case "le":
+			return s <= r
+		}
+	case float64:
+		n, ok := v.(float64)
+		if !ok {
+			return false
+		}
+		switch op {
+		case "eq":
+			return n == r
+		case "gt":
+			return n > r
+		case "ge":
+			return n >= r
+		case "lt":
+			return n < r
+		case "le":
+			return n <= r
+		}
+	case bool, nil:
+		return op == "eq" && v == ref
+	}
+	return false
+}
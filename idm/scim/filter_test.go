@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package scim
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func testResource() map[string]interface{} {
+	res := make(map[string]interface{})
+	json.Unmarshal([]byte(`{
+		"userName": "jdoe",
+		"active": true,
+		"name": {"givenName": "John", "familyName": "Doe"},
+		"emails": [{"value": "john@work.com", "type": "work", "primary": true}, {"value": "john@home.com", "type": "home"}],
+		"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User": {"department": "Sales", "employeeNumber": 42}
+	}`), &res)
+	return res
+}
+
+func TestParseFilter(t *testing.T) {
+
+	Convey("Test valid filters", t, func() {
+		for _, f := range []string{
+			`userName eq "jdoe"`,
+			`userName Eq "jdoe" and active eq true`,
+			`not (name.givenName sw "A") or emails[type eq "work" and value co "@"]`,
+			`urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:department pr`,
+			`((displayName eq "a b"))`,
+		} {
+			_, err := ParseFilter(f)
+			So(err, ShouldBeNil)
+		}
+	})
+
+	Convey("Test invalid filters", t, func() {
+		for _, f := range []string{
+			``,
+			`userName`,
+			`userName eq`,
+			`userName eq jdoe`,
+			`userName like "jdoe"`,
+			`userName eq "jdoe`,
+			`(userName eq "jdoe"`,
+			`userName eq "jdoe" extra`,
+		} {
+			_, err := ParseFilter(f)
+			So(err, ShouldNotBeNil)
+		}
+	})
+
+	Convey("Test equality extraction", t, func() {
+		f, _ := ParseFilter(`UserName eq "jdoe" and active eq true`)
+		v, ok := f.Equality("userName")
+		So(ok, ShouldBeTrue)
+		So(v, ShouldEqual, "jdoe")
+		f, _ = ParseFilter(`userName eq "jdoe" or active eq true`)
+		_, ok = f.Equality("userName")
+		So(ok, ShouldBeFalse)
+	})
+}
+
+func TestFilterMatches(t *testing.T) {
+
+	Convey("Test filter evaluation", t, func() {
+		res := testResource()
+		for f, expected := range map[string]bool{
+			`userName eq "JDOE"`:                         true,
+			`userName ne "jdoe"`:                         false,
+			`userName sw "jd" and active eq true`:        true,
+			`active eq false or name.familyName ew "oe"`: true,
+			`not (name.givenName co "oh")`:               false,
+			`emails eq "john@home.com"`:                  true,
+			`emails.type eq "home"`:                      true,
+			`emails[type eq "home" and primary eq true]`: false,
+			`emails[type eq "work" and primary eq true]`: true,
+			`title pr`: false,
+			`urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:employeeNumber gt 40`:  true,
+			`urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:department eq "sales"`: true,
+		} {
+			filter, err := ParseFilter(f)
+			So(err, ShouldBeNil)
+			So(filter.Matches(res), ShouldEqual, expected)
+		}
+	})
+
+	Convey("Test attribute values", t, func() {
+		res := testResource()
+		p, _ := ParseAttrPath("emails")
+		So(p.Strings(res), ShouldResemble, []string{"john@work.com", "john@home.com"})
+		p, _ = ParseAttrPath("urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:employeeNumber")
+		So(p.Strings(res), ShouldResemble, []string{"42"})
+		So(p.String(), ShouldEqual, "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:employeeNumber")
+	})
+}
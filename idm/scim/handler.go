@@ -0,0 +1,749 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package scim
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/gorilla/mux"
+	"github.com/gosimple/slug"
+	"github.com/micro/go-micro/errors"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/auth"
+	"github.com/pydio/cells/common/config"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/micro"
+	proto "github.com/pydio/cells/common/proto/auth"
+	"github.com/pydio/cells/common/proto/idm"
+	service "github.com/pydio/cells/common/service/proto"
+)
+
+const (
+	// BasePath is the path under which the SCIM endpoints are served.
+	BasePath = "/scim/v2"
+
+	contentType  = "application/scim+json"
+	maxPageCount = 200
+)
+
+// HashToken returns the hash of a bearer token, as stored in the tokenHash configuration key.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// settings are read from the service configuration on each request, so that they apply without restart.
+type settings struct {
+	// groupPath is the group under which users and groups are created.
+	groupPath string
+	// authSource marks the users and groups managed by SCIM. It can be set to the name of a SAML connector
+	// so that the users it provisions can log in with it.
+	authSource string
+	rules      []*proto.LdapMapping
+	location   string
+}
+
+func loadSettings() *settings {
+	name := common.SERVICE_WEB_NAMESPACE_ + common.SERVICE_SCIM
+	s := &settings{
+		groupPath:  "/" + strings.Trim(config.Get("services", name, "groupPath").String("/"), "/"),
+		authSource: config.Get("services", name, "authSource").String("scim"),
+		location:   strings.TrimRight(config.Get("defaults", "url").String(""), "/") + BasePath,
+	}
+	config.Get("services", name, "mappingRules").Scan(&s.rules)
+	return s
+}
+
+// childPath returns the path of a group created by SCIM.
+func (s *settings) childPath(label string) string {
+	if s.groupPath == "/" {
+		return "/" + label
+	}
+	return s.groupPath + "/" + label
+}
+
+type handler struct {
+	userCli idm.UserServiceClient
+	roleCli idm.RoleServiceClient
+}
+
+type route struct {
+	method      string
+	pattern     string
+	handlerFunc func(h *handler, ctx context.Context, s *settings, w http.ResponseWriter, r *http.Request) error
+}
+
+var routes = []route{
+	{"GET", "/ServiceProviderConfig", (*handler).serviceProviderConfig},
+	{"GET", "/ResourceTypes", (*handler).resourceTypes},
+	{"GET", "/Users", (*handler).listUsers},
+	{"POST", "/Users", (*handler).createUser},
+	{"GET", "/Users/{id}", (*handler).getUser},
+	{"PUT", "/Users/{id}", (*handler).replaceUser},
+	{"PATCH", "/Users/{id}", (*handler).patchUser},
+	{"DELETE", "/Users/{id}", (*handler).deleteUser},
+	{"GET", "/Groups", (*handler).listGroups},
+	{"POST", "/Groups", (*handler).createGroup},
+	{"GET", "/Groups/{id}", (*handler).getGroup},
+	{"PUT", "/Groups/{id}", (*handler).replaceGroup},
+	{"PATCH", "/Groups/{id}", (*handler).patchGroup},
+	{"DELETE", "/Groups/{id}", (*handler).deleteGroup},
+}
+
+// NewRouter creates the router serving the SCIM 2.0 endpoints, see https://tools.ietf.org/html/rfc7644.
+func NewRouter() *mux.Router {
+	h := &handler{
+		userCli: idm.NewUserServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_USER, defaults.NewClient()),
+		roleCli: idm.NewRoleServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_ROLE, defaults.NewClient()),
+	}
+	router := mux.NewRouter().StrictSlash(true)
+	for _, rt := range routes {
+		router.Methods(rt.method).Path(BasePath + rt.pattern).Handler(h.serve(rt.handlerFunc))
+	}
+	return router
+}
+
+// serve checks the bearer token and writes the errors returned by the handler functions.
+func (h *handler) serve(f func(h *handler, ctx context.Context, s *settings, w http.ResponseWriter, r *http.Request) error) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(r) {
+			writeError(w, newError(http.StatusUnauthorized, "", "invalid or missing bearer token"))
+			return
+		}
+		ctx := context.WithValue(r.Context(), common.PYDIO_CONTEXT_USER_KEY, common.PYDIO_SYSTEM_USERNAME)
+		if err := f(h, ctx, loadSettings(), w, r); err != nil {
+			if _, ok := err.(*Error); !ok {
+				log.Logger(ctx).Error("SCIM request failed", zap.String("method", r.Method), zap.String("path", r.URL.Path), zap.Error(err))
+			}
+			writeError(w, err)
+		}
+	})
+}
+
+// authorized compares the bearer token with the configured hash. The service is disabled until a token is set.
+func authorized(r *http.Request) bool {
+	hash := config.Get("services", common.SERVICE_WEB_NAMESPACE_+common.SERVICE_SCIM, "tokenHash").String("")
+	header := r.Header.Get("Authorization")
+	if hash == "" || len(header) < 7 || !strings.EqualFold(header[:7], "Bearer ") {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(HashToken(strings.TrimSpace(header[7:]))), []byte(hash)) == 1
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) error {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	return json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	e, ok := err.(*Error)
+	if !ok {
+		if parsed := errors.Parse(err.Error()); parsed.Code == http.StatusNotFound {
+			e = newError(http.StatusNotFound, "", parsed.Detail)
+		} else {
+			e = newError(http.StatusInternalServerError, "", err.Error())
+		}
+	}
+	writeJSON(w, e.code, e)
+}
+
+func readResource(r *http.Request) (map[string]interface{}, error) {
+	res := make(map[string]interface{})
+	if err := json.NewDecoder(r.Body).Decode(&res); err != nil {
+		return nil, newError(http.StatusBadRequest, "invalidSyntax", err.Error())
+	}
+	return res, nil
+}
+
+func readPatch(r *http.Request) (*PatchRequest, error) {
+	patch := &PatchRequest{}
+	if err := json.NewDecoder(r.Body).Decode(patch); err != nil {
+		return nil, newError(http.StatusBadRequest, "invalidSyntax", err.Error())
+	}
+	return patch, nil
+}
+
+// listResponse applies the filter and pagination parameters of a request to resources.
+func listResponse(r *http.Request, resources []map[string]interface{}) (map[string]interface{}, error) {
+	var filter *Filter
+	if f := r.URL.Query().Get("filter"); f != "" {
+		var err error
+		if filter, err = ParseFilter(f); err != nil {
+			return nil, newError(http.StatusBadRequest, "invalidFilter", err.Error())
+		}
+	}
+	matching := []map[string]interface{}{}
+	for _, res := range resources {
+		if filter == nil || filter.Matches(res) {
+			matching = append(matching, res)
+		}
+	}
+	startIndex, count := 1, maxPageCount
+	if v, err := strconv.Atoi(r.URL.Query().Get("startIndex")); err == nil && v > 1 {
+		startIndex = v
+	}
+	if v, err := strconv.Atoi(r.URL.Query().Get("count")); err == nil && v >= 0 && v < maxPageCount {
+		count = v
+	}
+	page := []map[string]interface{}{}
+	for i := startIndex - 1; i < len(matching) && len(page) < count; i++ {
+		page = append(page, matching[i])
+	}
+	return map[string]interface{}{
+		"schemas":      []string{SchemaListResponse},
+		"totalResults": len(matching),
+		"startIndex":   startIndex,
+		"itemsPerPage": len(page),
+		"Resources":    page,
+	}, nil
+}
+
+// equalityFilter returns the value an attribute must be equal to for the filter of a request to match.
+func equalityFilter(r *http.Request, attribute string) string {
+	f, err := ParseFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		return ""
+	}
+	v, _ := f.Equality(attribute)
+	return v
+}
+
+// search lists the users or groups matching all queries.
+func (h *handler) search(ctx context.Context, queries ...*idm.UserSingleQuery) ([]*idm.User, error) {
+	var subQueries []*any.Any
+	for _, q := range queries {
+		sub, _ := ptypes.MarshalAny(q)
+		subQueries = append(subQueries, sub)
+	}
+	stream, err := h.userCli.SearchUser(ctx, &idm.SearchUserRequest{
+		Query: &service.Query{SubQueries: subQueries, Operation: service.OperationType_AND},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+	var users []*idm.User
+	for {
+		resp, e := stream.Recv()
+		if e != nil {
+			break
+		}
+		if resp == nil {
+			continue
+		}
+		users = append(users, resp.User)
+	}
+	return users, nil
+}
+
+// managed restricts a query to the users or groups provisioned by SCIM.
+func (s *settings) managed(nodeType idm.NodeType) *idm.UserSingleQuery {
+	return &idm.UserSingleQuery{NodeType: nodeType, AttributeName: idm.UserAttrAuthSource, AttributeValue: s.authSource}
+}
+
+func (h *handler) load(ctx context.Context, s *settings, nodeType idm.NodeType, id string) (*idm.User, error) {
+	found, err := h.search(ctx, s.managed(nodeType), &idm.UserSingleQuery{Uuid: id})
+	if err != nil {
+		return nil, err
+	}
+	if len(found) == 0 {
+		return nil, newError(http.StatusNotFound, "", fmt.Sprintf("resource %s not found", id))
+	}
+	return found[0], nil
+}
+
+func (h *handler) save(ctx context.Context, u *idm.User) (*idm.User, error) {
+	resp, err := h.userCli.CreateUser(ctx, &idm.CreateUserRequest{User: u})
+	if err != nil {
+		return nil, err
+	}
+	return resp.User, nil
+}
+
+// applyMapping applies the configured mapping rules to the SCIM representation of a user.
+func (h *handler) applyMapping(ctx context.Context, s *settings, res map[string]interface{}, u *idm.User) error {
+	if len(s.rules) == 0 {
+		return nil
+	}
+	mapped, err := auth.ApplyMappingRules(s.rules, func(attribute string) []string {
+		path, err := ParseAttrPath(attribute)
+		if err != nil {
+			return nil
+		}
+		return path.Strings(res)
+	})
+	if err != nil {
+		return err
+	}
+	return mapped.ApplyTo(ctx, u)
+}
+
+func (h *handler) serviceProviderConfig(ctx context.Context, s *settings, w http.ResponseWriter, r *http.Request) error {
+	return writeJSON(w, http.StatusOK, map[string]interface{}{
+		"schemas":        []string{SchemaServiceProviderConfig},
+		"patch":          map[string]interface{}{"supported": true},
+		"bulk":           map[string]interface{}{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]interface{}{"supported": true, "maxResults": maxPageCount},
+		"changePassword": map[string]interface{}{"supported": true},
+		"sort":           map[string]interface{}{"supported": false},
+		"etag":           map[string]interface{}{"supported": false},
+		"authenticationSchemes": []interface{}{map[string]interface{}{
+			"type":        "oauthbearertoken",
+			"name":        "Bearer Token",
+			"description": "Token generated with the admin scim-token command",
+		}},
+		"meta": map[string]interface{}{"resourceType": "ServiceProviderConfig", "location": s.location + "/ServiceProviderConfig"},
+	})
+}
+
+func (h *handler) resourceTypes(ctx context.Context, s *settings, w http.ResponseWriter, r *http.Request) error {
+	types := []map[string]interface{}{
+		{
+			"schemas":          []string{SchemaResourceType},
+			"id":               "User",
+			"name":             "User",
+			"endpoint":         "/Users",
+			"schema":           SchemaUser,
+			"schemaExtensions": []interface{}{map[string]interface{}{"schema": SchemaEnterpriseUser, "required": false}},
+			"meta":             map[string]interface{}{"resourceType": "ResourceType", "location": s.location + "/ResourceTypes/User"},
+		},
+		{
+			"schemas":  []string{SchemaResourceType},
+			"id":       "Group",
+			"name":     "Group",
+			"endpoint": "/Groups",
+			"schema":   SchemaGroup,
+			"meta":     map[string]interface{}{"resourceType": "ResourceType", "location": s.location + "/ResourceTypes/Group"},
+		},
+	}
+	return writeJSON(w, http.StatusOK, map[string]interface{}{
+		"schemas":      []string{SchemaListResponse},
+		"totalResults": len(types),
+		"startIndex":   1,
+		"itemsPerPage": len(types),
+		"Resources":    types,
+	})
+}
+
+// groupsByPath indexes the managed groups by their path.
+func (h *handler) groupsByPath(ctx context.Context, s *settings) (map[string]*idm.User, error) {
+	groups, err := h.search(ctx, s.managed(idm.NodeType_GROUP))
+	if err != nil {
+		return nil, err
+	}
+	byPath := make(map[string]*idm.User, len(groups))
+	for _, g := range groups {
+		byPath[g.GroupPath] = g
+	}
+	return byPath, nil
+}
+
+// userResource converts a user, its groups being the managed group it belongs to.
+func userResource(s *settings, u *idm.User, groups map[string]*idm.User) map[string]interface{} {
+	var userGroups []*idm.User
+	if g, ok := groups[u.GroupPath]; ok {
+		userGroups = append(userGroups, g)
+	}
+	return UserToResource(u, userGroups, s.location)
+}
+
+func (h *handler) listUsers(ctx context.Context, s *settings, w http.ResponseWriter, r *http.Request) error {
+	query := s.managed(idm.NodeType_USER)
+	if login := equalityFilter(r, "userName"); login != "" {
+		query.Login = login
+	}
+	users, err := h.search(ctx, query)
+	if err != nil {
+		return err
+	}
+	groups, err := h.groupsByPath(ctx, s)
+	if err != nil {
+		return err
+	}
+	var resources []map[string]interface{}
+	for _, u := range users {
+		resources = append(resources, userResource(s, u, groups))
+	}
+	list, err := listResponse(r, resources)
+	if err != nil {
+		return err
+	}
+	return writeJSON(w, http.StatusOK, list)
+}
+
+func (h *handler) getUser(ctx context.Context, s *settings, w http.ResponseWriter, r *http.Request) error {
+	u, err := h.load(ctx, s, idm.NodeType_USER, mux.Vars(r)["id"])
+	if err != nil {
+		return err
+	}
+	groups, err := h.groupsByPath(ctx, s)
+	if err != nil {
+		return err
+	}
+	return writeJSON(w, http.StatusOK, userResource(s, u, groups))
+}
+
+func (h *handler) createUser(ctx context.Context, s *settings, w http.ResponseWriter, r *http.Request) error {
+	res, err := readResource(r)
+	if err != nil {
+		return err
+	}
+	u := &idm.User{
+		GroupPath:  s.groupPath,
+		Attributes: map[string]string{idm.UserAttrProfile: common.PYDIO_PROFILE_STANDARD},
+	}
+	if err := ResourceToUser(res, u); err != nil {
+		return err
+	}
+	if existing, _ := h.search(ctx, &idm.UserSingleQuery{Login: u.Login}); len(existing) > 0 {
+		return newError(http.StatusConflict, "uniqueness", fmt.Sprintf("user %s already exists", u.Login))
+	}
+	if err := h.applyMapping(ctx, s, res, u); err != nil {
+		return err
+	}
+	u.Attributes[idm.UserAttrAuthSource] = s.authSource
+	u.Policies = service.NewResourcePoliciesBuilder().WithStandardUserPolicies(u.Login).Policies()
+	created, err := h.save(ctx, u)
+	if err != nil {
+		return err
+	}
+	if _, err := h.roleCli.CreateRole(ctx, &idm.CreateRoleRequest{Role: &idm.Role{
+		Uuid:     created.Uuid,
+		Label:    "User " + created.Login + " role",
+		UserRole: true,
+		Policies: created.Policies,
+	}}); err != nil {
+		return err
+	}
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("Provisioned user [%s] via SCIM", created.Login),
+		log.GetAuditId(common.AUDIT_USER_CREATE),
+		created.ZapUuid(),
+	)
+	groups, err := h.groupsByPath(ctx, s)
+	if err != nil {
+		return err
+	}
+	out := userResource(s, created, groups)
+	w.Header().Set("Location", s.location+"/Users/"+created.Uuid)
+	return writeJSON(w, http.StatusCreated, out)
+}
+
+// updateUser saves a user from its new SCIM representation.
+func (h *handler) updateUser(ctx context.Context, s *settings, w http.ResponseWriter, u *idm.User, res map[string]interface{}) error {
+	if err := ResourceToUser(res, u); err != nil {
+		return err
+	}
+	if err := h.applyMapping(ctx, s, res, u); err != nil {
+		return err
+	}
+	updated, err := h.save(ctx, u)
+	if err != nil {
+		return err
+	}
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("Updated user [%s] via SCIM", updated.Login),
+		log.GetAuditId(common.AUDIT_USER_UPDATE),
+		updated.ZapUuid(),
+	)
+	groups, err := h.groupsByPath(ctx, s)
+	if err != nil {
+		return err
+	}
+	return writeJSON(w, http.StatusOK, userResource(s, updated, groups))
+}
+
+func (h *handler) replaceUser(ctx context.Context, s *settings, w http.ResponseWriter, r *http.Request) error {
+	u, err := h.load(ctx, s, idm.NodeType_USER, mux.Vars(r)["id"])
+	if err != nil {
+		return err
+	}
+	res, err := readResource(r)
+	if err != nil {
+		return err
+	}
+	return h.updateUser(ctx, s, w, u, res)
+}
+
+func (h *handler) patchUser(ctx context.Context, s *settings, w http.ResponseWriter, r *http.Request) error {
+	u, err := h.load(ctx, s, idm.NodeType_USER, mux.Vars(r)["id"])
+	if err != nil {
+		return err
+	}
+	patch, err := readPatch(r)
+	if err != nil {
+		return err
+	}
+	res := UserToResource(u, nil, s.location)
+	if err := ApplyPatch(res, patch.Operations); err != nil {
+		return newError(http.StatusBadRequest, "invalidValue", err.Error())
+	}
+	return h.updateUser(ctx, s, w, u, res)
+}
+
+func (h *handler) deleteUser(ctx context.Context, s *settings, w http.ResponseWriter, r *http.Request) error {
+	u, err := h.load(ctx, s, idm.NodeType_USER, mux.Vars(r)["id"])
+	if err != nil {
+		return err
+	}
+	q, _ := ptypes.MarshalAny(&idm.UserSingleQuery{Uuid: u.Uuid})
+	if _, err := h.userCli.DeleteUser(ctx, &idm.DeleteUserRequest{Query: &service.Query{SubQueries: []*any.Any{q}}}); err != nil {
+		return err
+	}
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("Deleted user [%s] via SCIM", u.Login),
+		log.GetAuditId(common.AUDIT_USER_DELETE),
+		u.ZapUuid(),
+	)
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// members lists the managed users of a group.
+func (h *handler) members(ctx context.Context, s *settings, g *idm.User) ([]*idm.User, error) {
+	query := s.managed(idm.NodeType_USER)
+	query.GroupPath = g.GroupPath
+	members, err := h.search(ctx, query)
+	if members == nil {
+		members = []*idm.User{}
+	}
+	return members, err
+}
+
+// setMembers moves the users with the given ids into the group, and its other members back to the base group.
+func (h *handler) setMembers(ctx context.Context, s *settings, g *idm.User, ids []string) error {
+	current, err := h.members(ctx, s, g)
+	if err != nil {
+		return err
+	}
+	wanted := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		wanted[id] = true
+	}
+	for _, m := range current {
+		if wanted[m.Uuid] {
+			delete(wanted, m.Uuid)
+			continue
+		}
+		m.GroupPath = s.groupPath
+		if _, err := h.save(ctx, m); err != nil {
+			return err
+		}
+	}
+	for id := range wanted {
+		m, err := h.load(ctx, s, idm.NodeType_USER, id)
+		if err != nil {
+			if e, ok := err.(*Error); ok && e.code == http.StatusNotFound {
+				return newError(http.StatusBadRequest, "invalidValue", fmt.Sprintf("unknown member %s", id))
+			}
+			return err
+		}
+		m.GroupPath = g.GroupPath
+		if _, err := h.save(ctx, m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// groupResource converts a group, listing its members unless they are excluded by the request.
+func (h *handler) groupResource(ctx context.Context, s *settings, r *http.Request, g *idm.User) (map[string]interface{}, error) {
+	for _, excluded := range strings.Split(r.URL.Query().Get("excludedAttributes"), ",") {
+		if strings.EqualFold(strings.TrimSpace(excluded), "members") {
+			return GroupToResource(g, nil, s.location), nil
+		}
+	}
+	members, err := h.members(ctx, s, g)
+	if err != nil {
+		return nil, err
+	}
+	return GroupToResource(g, members, s.location), nil
+}
+
+func (h *handler) listGroups(ctx context.Context, s *settings, w http.ResponseWriter, r *http.Request) error {
+	groups, err := h.search(ctx, s.managed(idm.NodeType_GROUP))
+	if err != nil {
+		return err
+	}
+	var resources []map[string]interface{}
+	for _, g := range groups {
+		res, err := h.groupResource(ctx, s, r, g)
+		if err != nil {
+			return err
+		}
+		resources = append(resources, res)
+	}
+	list, err := listResponse(r, resources)
+	if err != nil {
+		return err
+	}
+	return writeJSON(w, http.StatusOK, list)
+}
+
+func (h *handler) getGroup(ctx context.Context, s *settings, w http.ResponseWriter, r *http.Request) error {
+	g, err := h.load(ctx, s, idm.NodeType_GROUP, mux.Vars(r)["id"])
+	if err != nil {
+		return err
+	}
+	res, err := h.groupResource(ctx, s, r, g)
+	if err != nil {
+		return err
+	}
+	return writeJSON(w, http.StatusOK, res)
+}
+
+func (h *handler) createGroup(ctx context.Context, s *settings, w http.ResponseWriter, r *http.Request) error {
+	res, err := readResource(r)
+	if err != nil {
+		return err
+	}
+	g := &idm.User{}
+	if err := ResourceToGroup(res, g); err != nil {
+		return err
+	}
+	// Groups are identified by their path: it is computed once, renaming a group only changes its display name
+	g.GroupLabel = slug.Make(g.Attributes[idm.UserAttrDisplayName])
+	if g.GroupLabel == "" {
+		return newError(http.StatusBadRequest, "invalidValue", "displayName must contain letters or digits")
+	}
+	g.GroupPath = s.childPath(g.GroupLabel)
+	if existing, _ := h.search(ctx, &idm.UserSingleQuery{FullPath: g.GroupPath, NodeType: idm.NodeType_GROUP}); len(existing) > 0 {
+		return newError(http.StatusConflict, "uniqueness", fmt.Sprintf("group %s already exists", g.GroupPath))
+	}
+	g.Attributes[idm.UserAttrAuthSource] = s.authSource
+	created, err := h.save(ctx, g)
+	if err != nil {
+		return err
+	}
+	if _, err := h.roleCli.CreateRole(ctx, &idm.CreateRoleRequest{Role: &idm.Role{
+		Uuid:      created.Uuid,
+		Label:     "Group " + created.GroupLabel,
+		GroupRole: true,
+		Policies:  service.NewResourcePoliciesBuilder().WithProfileRead(common.PYDIO_PROFILE_STANDARD).WithProfileWrite(common.PYDIO_PROFILE_ADMIN).Policies(),
+	}}); err != nil {
+		return err
+	}
+	if err := h.setMembers(ctx, s, created, MemberIds(res)); err != nil {
+		return err
+	}
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("Provisioned group [%s] via SCIM", created.GroupPath),
+		log.GetAuditId(common.AUDIT_GROUP_CREATE),
+		created.ZapUuid(),
+	)
+	out, err := h.groupResource(ctx, s, r, created)
+	if err != nil {
+		return err
+	}
+	w.Header().Set("Location", s.location+"/Groups/"+created.Uuid)
+	return writeJSON(w, http.StatusCreated, out)
+}
+
+// updateGroup saves a group and its members from its new SCIM representation.
+func (h *handler) updateGroup(ctx context.Context, s *settings, w http.ResponseWriter, r *http.Request, g *idm.User, res map[string]interface{}) error {
+	if err := ResourceToGroup(res, g); err != nil {
+		return err
+	}
+	updated, err := h.save(ctx, g)
+	if err != nil {
+		return err
+	}
+	if err := h.setMembers(ctx, s, updated, MemberIds(res)); err != nil {
+		return err
+	}
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("Updated group [%s] via SCIM", updated.GroupPath),
+		log.GetAuditId(common.AUDIT_GROUP_UPDATE),
+		updated.ZapUuid(),
+	)
+	out, err := h.groupResource(ctx, s, r, updated)
+	if err != nil {
+		return err
+	}
+	return writeJSON(w, http.StatusOK, out)
+}
+
+func (h *handler) replaceGroup(ctx context.Context, s *settings, w http.ResponseWriter, r *http.Request) error {
+	g, err := h.load(ctx, s, idm.NodeType_GROUP, mux.Vars(r)["id"])
+	if err != nil {
+		return err
+	}
+	res, err := readResource(r)
+	if err != nil {
+		return err
+	}
+	return h.updateGroup(ctx, s, w, r, g, res)
+}
+
+func (h *handler) patchGroup(ctx context.Context, s *settings, w http.ResponseWriter, r *http.Request) error {
+	g, err := h.load(ctx, s, idm.NodeType_GROUP, mux.Vars(r)["id"])
+	if err != nil {
+		return err
+	}
+	patch, err := readPatch(r)
+	if err != nil {
+		return err
+	}
+	members, err := h.members(ctx, s, g)
+	if err != nil {
+		return err
+	}
+	res := GroupToResource(g, members, s.location)
+	if err := ApplyPatch(res, patch.Operations); err != nil {
+		return newError(http.StatusBadRequest, "invalidValue", err.Error())
+	}
+	return h.updateGroup(ctx, s, w, r, g, res)
+}
+
+func (h *handler) deleteGroup(ctx context.Context, s *settings, w http.ResponseWriter, r *http.Request) error {
+	g, err := h.load(ctx, s, idm.NodeType_GROUP, mux.Vars(r)["id"])
+	if err != nil {
+		return err
+	}
+	// Deleting a group deletes its content: members are moved out first, as their lifecycle is managed separately
+	if err := h.setMembers(ctx, s, g, nil); err != nil {
+		return err
+	}
+	q, _ := ptypes.MarshalAny(&idm.UserSingleQuery{GroupPath: g.GroupPath, Recursive: true})
+	if _, err := h.userCli.DeleteUser(ctx, &idm.DeleteUserRequest{Query: &service.Query{SubQueries: []*any.Any{q}}}); err != nil {
+		return err
+	}
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("Deleted group [%s] via SCIM", g.GroupPath),
+		log.GetAuditId(common.AUDIT_GROUP_DELETE),
+		g.ZapUuid(),
+	)
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
@@ -0,0 +1,270 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package scim
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// PatchOperation is an operation of a PATCH request, see https://tools.ietf.org/html/rfc7644#section-3.5.2
+type PatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// PatchRequest is the body of a PATCH request.
+type PatchRequest struct {
+	Schemas    []string          `json:"schemas"`
+	Operations []*PatchOperation `json:"Operations"`
+}
+
+// patchPath is a parsed PATCH path: an attribute, optionally filtered for multi-valued attributes.
+type patchPath struct {
+	attr   *AttrPath
+	filter *Filter
+	// sub is the sub-attribute following a value filter, as in emails[type eq "work"].value
+	sub string
+}
+
+func parsePatchPath(s string) (*patchPath, error) {
+	p := &patchPath{}
+	if i := strings.Index(s, "["); i >= 0 {
+		j := strings.LastIndex(s, "]")
+		if j < i {
+			return nil, fmt.Errorf("invalid path %q", s)
+		}
+		f, err := ParseFilter(s[i+1 : j])
+		if err != nil {
+			return nil, err
+		}
+		p.filter = f
+		if rest := s[j+1:]; rest != "" {
+			if !strings.HasPrefix(rest, ".") {
+				return nil, fmt.Errorf("invalid path %q", s)
+			}
+			p.sub = rest[1:]
+		}
+		s = s[:i]
+	}
+	attr, err := ParseAttrPath(s)
+	if err != nil {
+		return nil, err
+	}
+	if p.filter != nil && attr.Sub != "" {
+		return nil, fmt.Errorf("invalid path %q", s)
+	}
+	p.attr = attr
+	return p, nil
+}
+
+func (p *patchPath) matchesAny(items []interface{}) bool {
+	for _, item := range items {
+		if m, ok := item.(map[string]interface{}); ok && p.filter.Matches(m) {
+			return true
+		}
+	}
+	return false
+}
+
+// ApplyPatch applies PATCH operations to a resource in its JSON form. Operation names are case-insensitive.
+func ApplyPatch(resource map[string]interface{}, operations []*PatchOperation) error {
+	for _, op := range operations {
+		if err := applyOperation(resource, strings.ToLower(op.Op), op.Path, op.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyOperation(resource map[string]interface{}, op, path string, value interface{}) error {
+	if op != "add" && op != "replace" && op != "remove" {
+		return fmt.Errorf("unsupported patch operation %q", op)
+	}
+	if path == "" {
+		if op == "remove" {
+			return fmt.Errorf("remove operation requires a path")
+		}
+		values, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s operation without path requires an object value", op)
+		}
+		for k, v := range values {
+			if strings.HasPrefix(strings.ToLower(k), "urn:") {
+				// Extension attributes are merged one by one
+				if ext, ok := v.(map[string]interface{}); ok {
+					for extKey, extValue := range ext {
+						if err := applyOperation(resource, op, k+":"+extKey, extValue); err != nil {
+							return err
+						}
+					}
+					continue
+				}
+			}
+			if err := applyOperation(resource, op, k, v); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	p, err := parsePatchPath(path)
+	if err != nil {
+		return err
+	}
+	container := resource
+	if p.attr.Schema != "" {
+		key := findKey(resource, p.attr.Schema)
+		ext, ok := resource[key].(map[string]interface{})
+		if !ok {
+			if op == "remove" {
+				return nil
+			}
+			ext = make(map[string]interface{})
+			resource[key] = ext
+		}
+		container = ext
+	}
+	key := findKey(container, p.attr.Name)
+	current, exists := container[key]
+
+	if p.filter != nil {
+		items := asList(current)
+		if op != "remove" && p.filter.Operator == "eq" && p.filter.Path.Sub == "" && !p.matchesAny(items) {
+			// Some IdPs set values with a filter on an item that does not exist yet, like emails[type eq "work"].value
+			items = append(items, map[string]interface{}{p.filter.Path.Name: p.filter.Value})
+		}
+		var kept []interface{}
+		for _, item := range items {
+			m, ok := item.(map[string]interface{})
+			if !ok || !p.filter.Matches(m) {
+				kept = append(kept, item)
+				continue
+			}
+			switch {
+			case op == "remove" && p.sub == "":
+				continue
+			case op == "remove":
+				delete(m, findKey(m, p.sub))
+			case p.sub != "":
+				m[findKey(m, p.sub)] = value
+			default:
+				replacement, ok := value.(map[string]interface{})
+				if !ok {
+					return fmt.Errorf("invalid value for %s", path)
+				}
+				for k, v := range replacement {
+					m[findKey(m, k)] = v
+				}
+			}
+			kept = append(kept, m)
+		}
+		setOrDelete(container, key, kept)
+		return nil
+	}
+
+	if p.attr.Sub != "" {
+		// Sub-attribute of a complex attribute
+		parent, ok := current.(map[string]interface{})
+		if !ok {
+			if op == "remove" {
+				return nil
+			}
+			parent = make(map[string]interface{})
+			container[key] = parent
+		}
+		if op == "remove" {
+			delete(parent, findKey(parent, p.attr.Sub))
+		} else {
+			parent[findKey(parent, p.attr.Sub)] = value
+		}
+		return nil
+	}
+
+	switch op {
+	case "remove":
+		if values, ok := value.([]interface{}); ok && exists {
+			// Remove the listed values only
+			var kept []interface{}
+			for _, item := range asList(current) {
+				if !containsValue(values, item) {
+					kept = append(kept, item)
+				}
+			}
+			setOrDelete(container, key, kept)
+		} else {
+			delete(container, key)
+		}
+	case "add":
+		if values, ok := value.([]interface{}); ok {
+			merged := asList(current)
+			for _, v := range values {
+				if !containsValue(merged, v) {
+					merged = append(merged, v)
+				}
+			}
+			container[key] = merged
+		} else if m, ok := value.(map[string]interface{}); ok && exists {
+			if parent, ok := current.(map[string]interface{}); ok {
+				for k, v := range m {
+					parent[findKey(parent, k)] = v
+				}
+				return nil
+			}
+			container[key] = value
+		} else {
+			container[key] = value
+		}
+	case "replace":
+		container[key] = value
+	}
+	return nil
+}
+
+// containsValue compares multi-valued attribute items upon their "value" sub-attribute if they have one.
+func containsValue(list []interface{}, item interface{}) bool {
+	for _, l := range list {
+		lm, lok := l.(map[string]interface{})
+		im, iok := item.(map[string]interface{})
+		if lok && iok {
+			if lv, ok := lm[findKey(lm, "value")]; ok {
+				if iv, ok := im[findKey(im, "value")]; ok && reflect.DeepEqual(lv, iv) {
+					return true
+				}
+				continue
+			}
+		}
+		if reflect.DeepEqual(l, item) {
+			return true
+		}
+	}
+	return false
+}
+
+func setOrDelete(container map[string]interface{}, key string, values []interface{}) {
+	if len(values) == 0 {
+		delete(container, key)
+	} else {
+		container[key] = values
+	}
+}
@@ -0,0 +1,145 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package scim
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common/proto/idm"
+)
+
+func patchOperations(s string) []*PatchOperation {
+	req := &PatchRequest{}
+	json.Unmarshal([]byte(s), req)
+	return req.Operations
+}
+
+func TestApplyPatch(t *testing.T) {
+
+	Convey("Test simple attributes", t, func() {
+		res := testResource()
+		err := ApplyPatch(res, patchOperations(`{"Operations": [
+			{"op": "Replace", "path": "active", "value": false},
+			{"op": "add", "path": "title", "value": "Manager"},
+			{"op": "replace", "path": "name.givenName", "value": "Johnny"},
+			{"op": "remove", "path": "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:department"}
+		]}`))
+		So(err, ShouldBeNil)
+		So(res["active"], ShouldEqual, false)
+		So(res["title"], ShouldEqual, "Manager")
+		So(res["name"].(map[string]interface{})["givenName"], ShouldEqual, "Johnny")
+		So(res["urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"], ShouldNotContainKey, "department")
+	})
+
+	Convey("Test operations without path", t, func() {
+		res := testResource()
+		err := ApplyPatch(res, patchOperations(`{"Operations": [
+			{"op": "replace", "value": {"displayName": "John D.", "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User": {"manager": {"value": "boss"}}}}
+		]}`))
+		So(err, ShouldBeNil)
+		So(res["displayName"], ShouldEqual, "John D.")
+		ext := res["urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"].(map[string]interface{})
+		So(ext["department"], ShouldEqual, "Sales")
+		So(ext, ShouldContainKey, "manager")
+
+		So(ApplyPatch(res, patchOperations(`{"Operations": [{"op": "remove"}]}`)), ShouldNotBeNil)
+		So(ApplyPatch(res, patchOperations(`{"Operations": [{"op": "move", "path": "title"}]}`)), ShouldNotBeNil)
+	})
+
+	Convey("Test multi-valued attributes", t, func() {
+		res := testResource()
+		err := ApplyPatch(res, patchOperations(`{"Operations": [
+			{"op": "replace", "path": "emails[type eq \"work\"].value", "value": "jdoe@work.com"},
+			{"op": "remove", "path": "emails[type eq \"home\"]"},
+			{"op": "replace", "path": "phoneNumbers[type eq \"mobile\"].value", "value": "+33600000000"}
+		]}`))
+		So(err, ShouldBeNil)
+		emails := (&AttrPath{Name: "emails"}).Strings(res)
+		So(emails, ShouldResemble, []string{"jdoe@work.com"})
+		phones := (&AttrPath{Name: "phoneNumbers", Sub: "type"}).Strings(res)
+		So(phones, ShouldResemble, []string{"mobile"})
+	})
+
+	Convey("Test group members", t, func() {
+		res := map[string]interface{}{"displayName": "Sales"}
+		So(ApplyPatch(res, patchOperations(`{"Operations": [
+			{"op": "add", "path": "members", "value": [{"value": "u1"}, {"value": "u2"}]},
+			{"op": "add", "path": "members", "value": [{"value": "u2"}, {"value": "u3"}]}
+		]}`)), ShouldBeNil)
+		So(MemberIds(res), ShouldResemble, []string{"u1", "u2", "u3"})
+
+		So(ApplyPatch(res, patchOperations(`{"Operations": [
+			{"op": "remove", "path": "members[value eq \"u1\"]"},
+			{"op": "remove", "path": "members", "value": [{"value": "u3"}]}
+		]}`)), ShouldBeNil)
+		So(MemberIds(res), ShouldResemble, []string{"u2"})
+
+		So(ApplyPatch(res, patchOperations(`{"Operations": [{"op": "remove", "path": "members"}]}`)), ShouldBeNil)
+		So(MemberIds(res), ShouldBeEmpty)
+	})
+}
+
+func TestUserResource(t *testing.T) {
+
+	Convey("Test user round-trip", t, func() {
+		u := &idm.User{Uuid: "uuid", Attributes: map[string]string{"locks": `["pass_change"]`}}
+		res := testResource()
+		res["active"] = "False"
+		res["password"] = "secret"
+		So(ResourceToUser(res, u), ShouldBeNil)
+		So(u.Login, ShouldEqual, "jdoe")
+		So(u.Password, ShouldEqual, "secret")
+		So(u.Attributes[idm.UserAttrDisplayName], ShouldEqual, "John Doe")
+		So(u.Attributes[idm.UserAttrEmail], ShouldEqual, "john@work.com")
+		So(u.Attributes["locks"], ShouldEqual, `["pass_change","logout"]`)
+		So(u.Attributes[UserAttrScim], ShouldNotContainSubstring, "secret")
+
+		out := UserToResource(u, []*idm.User{{Uuid: "g1", GroupLabel: "sales"}}, "https://cells/scim/v2")
+		So(out["id"], ShouldEqual, "uuid")
+		So(out["active"], ShouldEqual, false)
+		So(out, ShouldNotContainKey, "password")
+		So(out["schemas"], ShouldHaveLength, 2)
+		So(out["groups"], ShouldHaveLength, 1)
+		So(out["meta"].(map[string]interface{})["location"], ShouldEqual, "https://cells/scim/v2/Users/uuid")
+
+		res["userName"] = "other"
+		So(ResourceToUser(res, u), ShouldNotBeNil)
+		res["userName"] = "jdoe"
+		res["active"] = 1
+		So(ResourceToUser(res, u), ShouldNotBeNil)
+	})
+
+	Convey("Test group conversion", t, func() {
+		g := &idm.User{Uuid: "g1"}
+		So(ResourceToGroup(map[string]interface{}{"displayName": " "}, g), ShouldNotBeNil)
+		So(ResourceToGroup(map[string]interface{}{"displayName": "Sales Team", "externalId": "ext", "members": []interface{}{}}, g), ShouldBeNil)
+		So(g.IsGroup, ShouldBeTrue)
+		So(g.Attributes[UserAttrExternalId], ShouldEqual, "ext")
+
+		out := GroupToResource(g, []*idm.User{{Uuid: "u1", Login: "jdoe"}}, "")
+		So(out["displayName"], ShouldEqual, "Sales Team")
+		So(MemberIds(out), ShouldResemble, []string{"u1"})
+		So(GroupToResource(g, nil, ""), ShouldNotContainKey, "members")
+	})
+}
@@ -0,0 +1,335 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pydio/cells/common/proto/idm"
+)
+
+const (
+	SchemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SchemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	SchemaEnterpriseUser        = "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"
+	SchemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SchemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	SchemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	SchemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	SchemaResourceType          = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+
+	// UserAttrScim stores the SCIM representation of a user or group, so that attributes that have no
+	// Cells equivalent are returned as provisioned.
+	UserAttrScim = idm.UserAttrPrivatePrefix + "scim"
+	// UserAttrExternalId stores the identifier of a resource in the provisioning client.
+	UserAttrExternalId = idm.UserAttrPrivatePrefix + "externalId"
+
+	lockLogout = "logout"
+)
+
+// computedAttributes are never stored, they are computed from the Cells user on each read.
+var computedAttributes = []string{"schemas", "id", "meta", "password", "groups", "members"}
+
+// UserToResource converts a Cells user to a SCIM User. Groups are the managed groups the user belongs to.
+func UserToResource(u *idm.User, groups []*idm.User, location string) map[string]interface{} {
+	res := storedResource(u)
+	res["schemas"] = resourceSchemas(res, SchemaUser)
+	res["id"] = u.Uuid
+	res["userName"] = u.Login
+	if d, ok := u.Attributes[idm.UserAttrDisplayName]; ok {
+		res["displayName"] = d
+	}
+	if email, ok := u.Attributes[idm.UserAttrEmail]; ok && len(asList(res[findKey(res, "emails")])) == 0 {
+		res["emails"] = []interface{}{map[string]interface{}{"value": email, "primary": true}}
+	}
+	res["active"] = !isLocked(u)
+	var refs []interface{}
+	for _, g := range groups {
+		refs = append(refs, map[string]interface{}{
+			"value":   g.Uuid,
+			"display": groupDisplayName(g),
+			"$ref":    location + "/Groups/" + g.Uuid,
+		})
+	}
+	if len(refs) > 0 {
+		res["groups"] = refs
+	}
+	res["meta"] = map[string]interface{}{
+		"resourceType": "User",
+		"location":     location + "/Users/" + u.Uuid,
+	}
+	return res
+}
+
+// ResourceToUser updates a Cells user from a SCIM User. The login of an existing user cannot be changed.
+func ResourceToUser(res map[string]interface{}, u *idm.User) error {
+	login, _ := res[findKey(res, "userName")].(string)
+	login = strings.TrimSpace(login)
+	if login == "" {
+		return newError(400, "invalidValue", "userName is required")
+	}
+	if u.Login != "" && u.Login != login {
+		return newError(400, "mutability", "userName cannot be changed")
+	}
+	u.Login = login
+	if u.Attributes == nil {
+		u.Attributes = make(map[string]string)
+	}
+	if d := resourceDisplayName(res); d != "" {
+		u.Attributes[idm.UserAttrDisplayName] = d
+	}
+	if email := primaryEmail(res); email != "" {
+		u.Attributes[idm.UserAttrEmail] = email
+	} else {
+		delete(u.Attributes, idm.UserAttrEmail)
+	}
+	if p, ok := res[findKey(res, "password")].(string); ok && p != "" {
+		u.Password = p
+	}
+	if active, ok := res[findKey(res, "active")]; ok {
+		b, ok := asBool(active)
+		if !ok {
+			return newError(400, "invalidValue", "active must be a boolean")
+		}
+		setLocked(u, !b)
+	}
+	if ext, ok := res[findKey(res, "externalId")].(string); ok && ext != "" {
+		u.Attributes[UserAttrExternalId] = ext
+	}
+	return storeResource(res, u)
+}
+
+// GroupToResource converts a Cells group to a SCIM Group. Members are not listed if members is nil.
+func GroupToResource(g *idm.User, members []*idm.User, location string) map[string]interface{} {
+	res := storedResource(g)
+	res["schemas"] = resourceSchemas(res, SchemaGroup)
+	res["id"] = g.Uuid
+	res["displayName"] = groupDisplayName(g)
+	if members != nil {
+		refs := []interface{}{}
+		for _, m := range members {
+			refs = append(refs, map[string]interface{}{
+				"value":   m.Uuid,
+				"display": m.Login,
+				"type":    "User",
+				"$ref":    location + "/Users/" + m.Uuid,
+			})
+		}
+		res["members"] = refs
+	}
+	res["meta"] = map[string]interface{}{
+		"resourceType": "Group",
+		"location":     location + "/Groups/" + g.Uuid,
+	}
+	return res
+}
+
+// ResourceToGroup updates a Cells group from a SCIM Group. Its path is left to the caller.
+func ResourceToGroup(res map[string]interface{}, g *idm.User) error {
+	d, _ := res[findKey(res, "displayName")].(string)
+	if strings.TrimSpace(d) == "" {
+		return newError(400, "invalidValue", "displayName is required")
+	}
+	g.IsGroup = true
+	if g.Attributes == nil {
+		g.Attributes = make(map[string]string)
+	}
+	g.Attributes[idm.UserAttrDisplayName] = strings.TrimSpace(d)
+	if ext, ok := res[findKey(res, "externalId")].(string); ok && ext != "" {
+		g.Attributes[UserAttrExternalId] = ext
+	}
+	return storeResource(res, g)
+}
+
+// MemberIds lists the ids of the members of a SCIM Group.
+func MemberIds(res map[string]interface{}) []string {
+	var ids []string
+	for _, m := range asList(res[findKey(res, "members")]) {
+		if item, ok := m.(map[string]interface{}); ok {
+			if id, ok := item[findKey(item, "value")].(string); ok && id != "" {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+func groupDisplayName(g *idm.User) string {
+	if d, ok := g.Attributes[idm.UserAttrDisplayName]; ok && d != "" {
+		return d
+	}
+	return g.GroupLabel
+}
+
+// storedResource returns the stored SCIM representation of a user, or an empty one.
+func storedResource(u *idm.User) map[string]interface{} {
+	res := make(map[string]interface{})
+	if data, ok := u.Attributes[UserAttrScim]; ok {
+		json.Unmarshal([]byte(data), &res)
+	}
+	return res
+}
+
+func storeResource(res map[string]interface{}, u *idm.User) error {
+	stored := make(map[string]interface{}, len(res))
+	for k, v := range res {
+		stored[k] = v
+	}
+	for _, k := range computedAttributes {
+		delete(stored, findKey(stored, k))
+	}
+	data, err := json.Marshal(stored)
+	if err != nil {
+		return err
+	}
+	u.Attributes[UserAttrScim] = string(data)
+	return nil
+}
+
+// resourceSchemas returns the core schema followed by the extension schemas used by the resource.
+func resourceSchemas(res map[string]interface{}, core string) []interface{} {
+	schemas := []interface{}{core}
+	for k := range res {
+		if strings.HasPrefix(strings.ToLower(k), "urn:") {
+			schemas = append(schemas, k)
+		}
+	}
+	return schemas
+}
+
+func resourceDisplayName(res map[string]interface{}) string {
+	if d, ok := res[findKey(res, "displayName")].(string); ok && strings.TrimSpace(d) != "" {
+		return strings.TrimSpace(d)
+	}
+	name, ok := res[findKey(res, "name")].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	if f, ok := name[findKey(name, "formatted")].(string); ok && strings.TrimSpace(f) != "" {
+		return strings.TrimSpace(f)
+	}
+	var parts []string
+	for _, k := range []string{"givenName", "familyName"} {
+		if v, ok := name[findKey(name, k)].(string); ok && strings.TrimSpace(v) != "" {
+			parts = append(parts, strings.TrimSpace(v))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// primaryEmail returns the primary email of a resource, or its first one.
+func primaryEmail(res map[string]interface{}) string {
+	var first string
+	for _, e := range asList(res[findKey(res, "emails")]) {
+		item, ok := e.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		value, _ := item[findKey(item, "value")].(string)
+		if value == "" {
+			continue
+		}
+		if primary, _ := asBool(item[findKey(item, "primary")]); primary {
+			return value
+		}
+		if first == "" {
+			first = value
+		}
+	}
+	return first
+}
+
+// asBool reads a boolean, some clients sending them as "True" or "False" strings.
+func asBool(v interface{}) (bool, bool) {
+	switch t := v.(type) {
+	case bool:
+		return t, true
+	case string:
+		switch strings.ToLower(t) {
+		case "true":
+			return true, true
+		case "false":
+			return false, true
+		}
+	}
+	return false, false
+}
+
+func userLocks(u *idm.User) []string {
+	var locks []string
+	if l, ok := u.Attributes["locks"]; ok {
+		json.Unmarshal([]byte(l), &locks)
+	}
+	return locks
+}
+
+func isLocked(u *idm.User) bool {
+	for _, l := range userLocks(u) {
+		if l == lockLogout {
+			return true
+		}
+	}
+	return false
+}
+
+// setLocked sets or removes the logout lock that prevents a user from logging in.
+func setLocked(u *idm.User, locked bool) {
+	var locks []string
+	for _, l := range userLocks(u) {
+		if l != lockLogout {
+			locks = append(locks, l)
+		}
+	}
+	if locked {
+		locks = append(locks, lockLogout)
+	}
+	if len(locks) == 0 {
+		delete(u.Attributes, "locks")
+		return
+	}
+	data, _ := json.Marshal(locks)
+	u.Attributes["locks"] = string(data)
+}
+
+// Error is a SCIM error response, see https://tools.ietf.org/html/rfc7644#section-3.12
+type Error struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+	code     int
+}
+
+func newError(code int, scimType, detail string) *Error {
+	return &Error{
+		Schemas:  []string{SchemaError},
+		Status:   fmt.Sprintf("%d", code),
+		ScimType: scimType,
+		Detail:   detail,
+		code:     code,
+	}
+}
+
+func (e *Error) Error() string {
+	return e.Detail
+}
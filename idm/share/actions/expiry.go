@@ -0,0 +1,433 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package actions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/micro/go-micro/client"
+	"github.com/micro/go-micro/errors"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/proto/docstore"
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/jobs"
+	"github.com/pydio/cells/common/proto/mailer"
+	"github.com/pydio/cells/common/service/proto"
+	"github.com/pydio/cells/common/utils/permissions"
+	"github.com/pydio/cells/idm/share"
+	"github.com/pydio/cells/scheduler/actions"
+)
+
+const (
+	expiryActionName = "actions.share.expiry"
+)
+
+// ExpiryAction notifies owners of links and Cells that are about to expire, disables them once
+// expired and deletes them after a grace period. Parameters are NotifyDays (days before expiration,
+// 0 for no reminder), DeleteAfterDays (days between disabling and deletion, 0 to never delete) and
+// ReportEmail (comma-separated addresses receiving a report of the run).
+type ExpiryAction struct {
+	notifyBefore time.Duration
+	deleteAfter  time.Duration
+	reportTo     []string
+
+	docClient    docstore.DocStoreClient
+	wsClient     idm.WorkspaceServiceClient
+	aclClient    idm.ACLServiceClient
+	mailerClient mailer.MailerServiceClient
+}
+
+// ExpiryReport lists the shares processed by a run of the action.
+type ExpiryReport struct {
+	Notified []string
+	Disabled []string
+	Deleted  []string
+	Errors   []string
+}
+
+// GetName returns the Unique Identifier of the ExpiryAction.
+func (a *ExpiryAction) GetName() string {
+	return expiryActionName
+}
+
+// Init passes parameters to a newly created instance.
+func (a *ExpiryAction) Init(job *jobs.Job, cl client.Client, action *jobs.Action) error {
+	for name, target := range map[string]*time.Duration{"NotifyDays": &a.notifyBefore, "DeleteAfterDays": &a.deleteAfter} {
+		param, ok := action.Parameters[name]
+		if !ok || param == "" {
+			continue
+		}
+		days, e := strconv.ParseInt(param, 10, 64)
+		if e != nil || days < 0 {
+			return errors.BadRequest(expiryActionName, "invalid value for %s: %s", name, param)
+		}
+		*target = time.Duration(days) * 24 * time.Hour
+	}
+	for _, address := range strings.Split(action.Parameters["ReportEmail"], ",") {
+		if address = strings.TrimSpace(address); address != "" {
+			a.reportTo = append(a.reportTo, address)
+		}
+	}
+	a.docClient = docstore.NewDocStoreClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_DOCSTORE, cl)
+	a.wsClient = idm.NewWorkspaceServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_WORKSPACE, cl)
+	a.aclClient = idm.NewACLServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_ACL, cl)
+	a.mailerClient = mailer.NewMailerServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_MAILER, cl)
+	return nil
+}
+
+// Run goes through all links and Cells and applies the next expiration step to each of them.
+func (a *ExpiryAction) Run(ctx context.Context, channels *actions.RunnableChannels, input jobs.ActionMessage) (jobs.ActionMessage, error) {
+
+	report := &ExpiryReport{}
+	now := time.Now()
+	if e := a.processLinks(ctx, now, report); e != nil {
+		return input.WithError(e), e
+	}
+	if e := a.processCells(ctx, now, report); e != nil {
+		return input.WithError(e), e
+	}
+
+	summary := fmt.Sprintf("Shares expiration: %d notified, %d disabled, %d deleted, %d errors", len(report.Notified), len(report.Disabled), len(report.Deleted), len(report.Errors))
+	log.TasksLogger(ctx).Info(summary)
+	if len(a.reportTo) > 0 && len(report.Disabled)+len(report.Deleted)+len(report.Errors) > 0 {
+		a.sendReport(ctx, report)
+	}
+	body, _ := json.Marshal(report)
+	input.AppendOutput(&jobs.ActionOutput{Success: true, StringBody: summary, JsonBody: body})
+	return input, nil
+}
+
+// processLinks walks the links hash documents.
+func (a *ExpiryAction) processLinks(ctx context.Context, now time.Time, report *ExpiryReport) error {
+
+	stream, e := a.docClient.ListDocuments(ctx, &docstore.ListDocumentsRequest{StoreID: common.DOCSTORE_ID_SHARES})
+	if e != nil {
+		return e
+	}
+	var docs []*docstore.Document
+	for {
+		resp, e := stream.Recv()
+		if e != nil {
+			break
+		}
+		if resp != nil && resp.Document != nil {
+			docs = append(docs, resp.Document)
+		}
+	}
+	stream.Close()
+
+	for _, doc := range docs {
+		var linkData *docstore.ShareDocument
+		if e := json.Unmarshal([]byte(doc.Data), &linkData); e != nil || linkData.ShareType != "minisite" || linkData.RepositoryId == "" {
+			continue
+		}
+		expiry := share.LinkExpiry(linkData)
+		step := expiry.NextStep(now, a.notifyBefore, a.deleteAfter)
+		if step == share.ExpiryNone {
+			continue
+		}
+		label := linkData.RepositoryId
+		if ws := a.loadWorkspace(ctx, linkData.RepositoryId); ws != nil {
+			label = ws.Label
+		}
+		desc := fmt.Sprintf("Link [%s] of %s", label, linkData.OwnerId)
+		login := linkData.PreLogUser
+		if login == "" {
+			login = linkData.PresetLogin
+		}
+
+		switch step {
+		case share.ExpiryNotify:
+			if owner, e := permissions.SearchUniqueUser(ctx, linkData.OwnerId, ""); e == nil {
+				a.notifyOwner(ctx, owner, "LinkExpiry", label, expiry.AccessEnd)
+			}
+			linkData.ExpiryNotified = true
+			if e := a.storeLink(ctx, doc, linkData); e != nil {
+				report.Errors = append(report.Errors, desc+": "+e.Error())
+				continue
+			}
+			report.Notified = append(report.Notified, desc)
+
+		case share.ExpiryDisable:
+			if login != "" {
+				if e := share.SetHiddenUserLock(ctx, login, true); e != nil {
+					report.Errors = append(report.Errors, desc+": "+e.Error())
+					continue
+				}
+			}
+			linkData.DisabledAt = now.Unix()
+			if e := a.storeLink(ctx, doc, linkData); e != nil {
+				report.Errors = append(report.Errors, desc+": "+e.Error())
+				continue
+			}
+			log.TasksLogger(ctx).Info("Disabled expired " + desc)
+			report.Disabled = append(report.Disabled, desc)
+
+		case share.ExpiryDelete:
+			if e := a.deleteLink(ctx, linkData.RepositoryId, login); e != nil {
+				report.Errors = append(report.Errors, desc+": "+e.Error())
+				continue
+			}
+			log.TasksLogger(ctx).Info("Deleted expired " + desc)
+			log.Auditer(ctx).Info(
+				fmt.Sprintf("Removed expired share link [%s]", label),
+				log.GetAuditId(common.AUDIT_LINK_DELETE),
+				zap.String(common.KEY_LINK_UUID, linkData.RepositoryId),
+				zap.String(common.KEY_WORKSPACE_UUID, linkData.RepositoryId),
+			)
+			report.Deleted = append(report.Deleted, desc)
+		}
+	}
+	return nil
+}
+
+// processCells walks the Cells workspaces that have an expiration date.
+func (a *ExpiryAction) processCells(ctx context.Context, now time.Time, report *ExpiryReport) error {
+
+	q, _ := ptypes.MarshalAny(&idm.WorkspaceSingleQuery{Scope: idm.WorkspaceScope_ROOM})
+	stream, e := a.wsClient.SearchWorkspace(ctx, &idm.SearchWorkspaceRequest{Query: &service.Query{SubQueries: []*any.Any{q}}})
+	if e != nil {
+		return e
+	}
+	var workspaces []*idm.Workspace
+	for {
+		resp, e := stream.Recv()
+		if e != nil {
+			break
+		}
+		if resp != nil && resp.Workspace != nil {
+			workspaces = append(workspaces, resp.Workspace)
+		}
+	}
+	stream.Close()
+
+	for _, ws := range workspaces {
+		expiry := share.CellExpiry(ws)
+		step := expiry.NextStep(now, a.notifyBefore, a.deleteAfter)
+		if step == share.ExpiryNone {
+			continue
+		}
+		owner := a.cellOwner(ctx, ws)
+		if owner == nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("Cell [%s]: cannot find owner", ws.Label))
+			continue
+		}
+		desc := fmt.Sprintf("Cell [%s] of %s", ws.Label, owner.Login)
+
+		switch step {
+		case share.ExpiryNotify:
+			a.notifyOwner(ctx, owner, "CellExpiry", ws.Label, expiry.AccessEnd)
+			share.SetCellAttributes(ws, map[string]interface{}{share.CellAttributeExpiryNotified: true})
+			if _, e := a.wsClient.CreateWorkspace(ctx, &idm.CreateWorkspaceRequest{Workspace: ws}); e != nil {
+				report.Errors = append(report.Errors, desc+": "+e.Error())
+				continue
+			}
+			report.Notified = append(report.Notified, desc)
+
+		case share.ExpiryDisable:
+			if e := a.disableCell(ctx, ws, owner, now); e != nil {
+				report.Errors = append(report.Errors, desc+": "+e.Error())
+				continue
+			}
+			log.TasksLogger(ctx).Info("Disabled expired " + desc)
+			report.Disabled = append(report.Disabled, desc)
+
+		case share.ExpiryDelete:
+			// Cell folder is resolved in the owner context
+			ownerCtx := context.WithValue(ctx, common.PYDIO_CONTEXT_USER_KEY, owner.Login)
+			if e := share.DeleteWorkspace(ownerCtx, owner, idm.WorkspaceScope_ROOM, ws.UUID, &systemChecker{}); e != nil {
+				report.Errors = append(report.Errors, desc+": "+e.Error())
+				continue
+			}
+			log.TasksLogger(ctx).Info("Deleted expired " + desc)
+			log.Auditer(ctx).Info(
+				fmt.Sprintf("Removed expired cell [%s]", ws.Label),
+				log.GetAuditId(common.AUDIT_CELL_DELETE),
+				zap.String(common.KEY_CELL_UUID, ws.UUID),
+				zap.String(common.KEY_WORKSPACE_UUID, ws.UUID),
+			)
+			report.Deleted = append(report.Deleted, desc)
+		}
+	}
+	return nil
+}
+
+// disableCell removes the ACLs of all members but the owner, who keeps access to the data until the Cell is deleted.
+func (a *ExpiryAction) disableCell(ctx context.Context, ws *idm.Workspace, owner *idm.User, now time.Time) error {
+	current, _, e := share.CommonAclsForWorkspace(ctx, ws.UUID)
+	if e != nil {
+		return e
+	}
+	var target []*idm.ACL
+	for _, acl := range current {
+		if acl.RoleID == "" || acl.RoleID == owner.Uuid {
+			target = append(target, acl)
+		}
+	}
+	_, remove := share.DiffAcls(ctx, current, target)
+	for _, acl := range remove {
+		q, _ := ptypes.MarshalAny(&idm.ACLSingleQuery{
+			NodeIDs:      []string{acl.NodeID},
+			RoleIDs:      []string{acl.RoleID},
+			WorkspaceIDs: []string{acl.WorkspaceID},
+			Actions:      []*idm.ACLAction{acl.Action},
+		})
+		if _, e := a.aclClient.DeleteACL(ctx, &idm.DeleteACLRequest{Query: &service.Query{SubQueries: []*any.Any{q}}}); e != nil {
+			return e
+		}
+	}
+	share.UpdatePoliciesFromAcls(ctx, ws, current, target)
+	share.SetCellAttributes(ws, map[string]interface{}{share.CellAttributeDisabledAt: now.Unix()})
+	_, e = a.wsClient.CreateWorkspace(ctx, &idm.CreateWorkspaceRequest{Workspace: ws})
+	return e
+}
+
+// deleteLink removes the workspace, hash document, hidden user and logs of a link.
+func (a *ExpiryAction) deleteLink(ctx context.Context, linkUuid string, login string) error {
+	if e := share.DeleteWorkspace(ctx, nil, idm.WorkspaceScope_LINK, linkUuid, &systemChecker{}); e != nil && errors.Parse(e.Error()).Code != 404 {
+		return e
+	}
+	if e := share.DeleteHashDocument(ctx, linkUuid); e != nil {
+		return e
+	}
+	if login != "" {
+		if e := share.DeleteHiddenUser(ctx, login); e != nil {
+			return e
+		}
+	}
+	if e := share.DeleteFileRequestDrops(ctx, linkUuid); e != nil {
+		log.Logger(ctx).Error("Cannot delete drop sessions for link", zap.String(common.KEY_LINK_UUID, linkUuid), zap.Error(e))
+	}
+	if e := share.DeleteLinkAccess(ctx, linkUuid); e != nil {
+		log.Logger(ctx).Error("Cannot delete access log for link", zap.String(common.KEY_LINK_UUID, linkUuid), zap.Error(e))
+	}
+	return nil
+}
+
+func (a *ExpiryAction) storeLink(ctx context.Context, doc *docstore.Document, linkData *docstore.ShareDocument) error {
+	data, _ := json.Marshal(linkData)
+	doc.Data = string(data)
+	doc.IndexableMeta = string(data)
+	_, e := a.docClient.PutDocument(ctx, &docstore.PutDocumentRequest{StoreID: common.DOCSTORE_ID_SHARES, DocumentID: doc.ID, Document: doc})
+	return e
+}
+
+func (a *ExpiryAction) loadWorkspace(ctx context.Context, uuid string) *idm.Workspace {
+	q, _ := ptypes.MarshalAny(&idm.WorkspaceSingleQuery{Uuid: uuid})
+	stream, e := a.wsClient.SearchWorkspace(ctx, &idm.SearchWorkspaceRequest{Query: &service.Query{SubQueries: []*any.Any{q}}})
+	if e != nil {
+		return nil
+	}
+	defer stream.Close()
+	for {
+		resp, e := stream.Recv()
+		if e != nil {
+			break
+		}
+		if resp != nil && resp.Workspace != nil {
+			return resp.Workspace
+		}
+	}
+	return nil
+}
+
+// cellOwner finds the user owning the Cell from the workspace policies.
+func (a *ExpiryAction) cellOwner(ctx context.Context, ws *idm.Workspace) *idm.User {
+	for _, p := range ws.Policies {
+		if p.Action == service.ResourcePolicyAction_OWNER {
+			if u, e := permissions.SearchUniqueUser(ctx, "", p.Subject); e == nil {
+				return u
+			}
+		}
+	}
+	return nil
+}
+
+func (a *ExpiryAction) notifyOwner(ctx context.Context, owner *idm.User, templateId string, label string, accessEnd int64) {
+	address, ok := owner.Attributes["email"]
+	if !ok || address == "" {
+		log.TasksLogger(ctx).Info("Cannot notify " + owner.Login + " of share expiration, no email address")
+		return
+	}
+	_, e := a.mailerClient.SendMail(ctx, &mailer.SendMailRequest{
+		InQueue: true,
+		Mail: &mailer.Mail{
+			To: []*mailer.User{{
+				Uuid:    owner.Uuid,
+				Name:    owner.Attributes["displayName"],
+				Address: address,
+			}},
+			TemplateId: templateId,
+			TemplateData: map[string]string{
+				"Label": label,
+				"Date":  time.Unix(accessEnd, 0).Format("2006-01-02 15:04"),
+			},
+		},
+	})
+	if e != nil {
+		log.TasksLogger(ctx).Error("Cannot send share expiration email", zap.Error(e))
+	}
+}
+
+func (a *ExpiryAction) sendReport(ctx context.Context, report *ExpiryReport) {
+	var lines []string
+	for _, item := range report.Disabled {
+		lines = append(lines, "Disabled: "+item)
+	}
+	for _, item := range report.Deleted {
+		lines = append(lines, "Deleted: "+item)
+	}
+	for _, item := range report.Errors {
+		lines = append(lines, "Error: "+item)
+	}
+	var to []*mailer.User
+	for _, address := range a.reportTo {
+		to = append(to, &mailer.User{Address: address})
+	}
+	_, e := a.mailerClient.SendMail(ctx, &mailer.SendMailRequest{
+		InQueue: true,
+		Mail: &mailer.Mail{
+			To:           to,
+			TemplateId:   "ShareCleanupReport",
+			TemplateData: map[string]string{"Report": strings.Join(lines, "\n")},
+		},
+	})
+	if e != nil {
+		log.TasksLogger(ctx).Error("Cannot send shares cleanup report", zap.Error(e))
+	}
+}
+
+// systemChecker lets the action manage shares of any user.
+type systemChecker struct{}
+
+func (s *systemChecker) IsContextEditable(ctx context.Context, resourceId string, policies []*service.ResourcePolicy) bool {
+	return true
+}
@@ -0,0 +1,353 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/emicklei/go-restful"
+	"github.com/micro/go-micro/errors"
+	"github.com/pborman/uuid"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/auth/claim"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/micro"
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/rest"
+	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/service"
+	service2 "github.com/pydio/cells/common/service/proto"
+	"github.com/pydio/cells/idm/ocm"
+	"github.com/pydio/cells/idm/share"
+)
+
+// PutOcmShare shares a folder with a user of another server. Access is given through a link workspace and
+// a hidden user, impersonated by the remote server when it sends the shared secret as a bearer token.
+func (h *SharesHandler) PutOcmShare(req *restful.Request, rsp *restful.Response) {
+
+	var input rest.PutOcmShareRequest
+	if e := req.ReadEntity(&input); e != nil {
+		service.RestError500(req, rsp, e)
+		return
+	}
+	ctx := req.Request.Context()
+	if !ocm.Enabled() {
+		service.RestError403(req, rsp, errors.Forbidden(common.SERVICE_SHARE, "federated sharing is disabled"))
+		return
+	}
+	if input.Node == nil {
+		service.RestError500(req, rsp, errors.BadRequest(common.SERVICE_SHARE, "please provide a node to share"))
+		return
+	}
+	_, remoteURL, e := ocm.ParseAddress(input.ShareWith)
+	if e != nil {
+		service.RestError500(req, rsp, errors.BadRequest(common.SERVICE_SHARE, "%s", e.Error()))
+		return
+	}
+	link := &rest.ShareLink{RootNodes: []*tree.Node{input.Node}, Permissions: input.Permissions}
+	if e := share.CheckLinkRootNodes(ctx, link); e != nil {
+		service.RestErrorDetect(req, rsp, e)
+		return
+	}
+	rootNode := link.RootNodes[0]
+	if rootNode.IsLeaf() {
+		service.RestError500(req, rsp, errors.BadRequest(common.SERVICE_SHARE, "only folders can be shared with other servers"))
+		return
+	}
+	client := ocm.NewClient(ocm.SkipVerify())
+	discovery, e := client.Discover(ctx, remoteURL)
+	if e != nil {
+		service.RestError500(req, rsp, errors.BadRequest(common.SERVICE_SHARE, "%s", e.Error()))
+		return
+	}
+
+	ownerUser := h.IdmUserFromClaims(ctx)
+	label := path.Base(rootNode.Path)
+	workspace, _, e := share.GetOrCreateWorkspace(ctx, ownerUser, "", idm.WorkspaceScope_LINK, label, input.Description, false)
+	if e != nil {
+		service.RestErrorDetect(req, rsp, e)
+		return
+	}
+	revokeWorkspace := func() {
+		share.DeleteWorkspace(ctx, ownerUser, idm.WorkspaceScope_LINK, workspace.UUID, nil)
+	}
+	aclClient := idm.NewACLServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_ACL, defaults.NewClient())
+	if _, e := aclClient.CreateACL(ctx, &idm.CreateACLRequest{
+		ACL: &idm.ACL{
+			NodeID:      rootNode.Uuid,
+			WorkspaceID: workspace.UUID,
+			Action:      &idm.ACLAction{Name: "workspace-path", Value: "uuid:" + rootNode.Uuid},
+		},
+	}); e != nil {
+		revokeWorkspace()
+		service.RestError500(req, rsp, e)
+		return
+	}
+	user, e := share.GetOrCreateHiddenUser(ctx, ownerUser, link, true, ocm.NewSecret(), false)
+	if e != nil {
+		revokeWorkspace()
+		service.RestError500(req, rsp, e)
+		return
+	}
+	workspace.Policies = append(workspace.Policies, &service2.ResourcePolicy{
+		Resource: workspace.UUID,
+		Subject:  fmt.Sprintf("user:%s", user.Login),
+		Action:   service2.ResourcePolicyAction_READ,
+		Effect:   service2.ResourcePolicy_allow,
+	})
+	wsClient := idm.NewWorkspaceServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_WORKSPACE, defaults.NewClient())
+	if _, e := wsClient.CreateWorkspace(ctx, &idm.CreateWorkspaceRequest{Workspace: workspace}); e != nil {
+		revokeWorkspace()
+		service.RestError500(req, rsp, e)
+		return
+	}
+	if e := share.UpdateACLsForHiddenUser(ctx, user.Uuid, workspace.UUID, link.RootNodes, link.Permissions, false); e != nil {
+		revokeWorkspace()
+		service.RestError500(req, rsp, e)
+		return
+	}
+
+	shareUuid := uuid.New()
+	secret, e := ocm.OutgoingSecret(shareUuid)
+	if e != nil {
+		revokeWorkspace()
+		service.RestError500(req, rsp, e)
+		return
+	}
+	ocmShare := &rest.OcmShare{
+		Uuid:          shareUuid,
+		Direction:     rest.OcmShareDirection_OUTGOING,
+		Status:        rest.OcmShareStatus_PENDING,
+		Name:          label,
+		Description:   input.Description,
+		LocalUser:     ownerUser.Login,
+		ShareWith:     input.ShareWith,
+		Owner:         ocm.FormatAddress(ownerUser.Login, ocm.LocalURL()),
+		ProviderId:    shareUuid,
+		RemoteUrl:     remoteURL,
+		Permissions:   link.Permissions,
+		NodeUuid:      rootNode.Uuid,
+		WorkspaceUuid: workspace.UUID,
+		ResourcePath:  workspace.Slug,
+		HiddenUser:    user.Login,
+		CreatedAt:     time.Now().Unix(),
+	}
+	if e := ocm.StoreShare(ctx, ocmShare); e != nil {
+		ocm.RevokeOutgoingShare(ctx, ocmShare)
+		service.RestError500(req, rsp, e)
+		return
+	}
+	displayName := ownerUser.Login
+	if claims, ok := ctx.Value(claim.ContextKey).(claim.Claims); ok && claims.DisplayName != "" {
+		displayName = claims.DisplayName
+	}
+	if _, e := client.SendShare(ctx, discovery, &ocm.Share{
+		ShareWith:         input.ShareWith,
+		Name:              label,
+		Description:       input.Description,
+		ProviderID:        shareUuid,
+		Owner:             ocmShare.Owner,
+		Sender:            ocmShare.Owner,
+		OwnerDisplayName:  displayName,
+		SenderDisplayName: displayName,
+		ShareType:         ocm.ShareTypeUser,
+		ResourceType:      ocm.ResourceTypeFile,
+		Protocol: ocm.Protocol{
+			Name: ocm.ProtocolWebDAV,
+			Options: ocm.ProtocolOptions{
+				SharedSecret: secret,
+				Permissions:  ocm.PermissionsToProtocol(link.Permissions),
+				URI:          workspace.Slug,
+			},
+		},
+	}); e != nil {
+		ocm.RevokeOutgoingShare(ctx, ocmShare)
+		service.RestError500(req, rsp, errors.BadRequest(common.SERVICE_SHARE, "remote server refused the share: %s", e.Error()))
+		return
+	}
+
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("Created federated share [%s] with %s", label, input.ShareWith),
+		log.GetAuditId(common.AUDIT_LINK_CREATE),
+		zap.String(common.KEY_WORKSPACE_UUID, workspace.UUID),
+	)
+	rsp.WriteEntity(ocmShareForClient(ocmShare))
+}
+
+// ListOcmShares lists the federated shares sent or received by the current user.
+func (h *SharesHandler) ListOcmShares(req *restful.Request, rsp *restful.Response) {
+
+	var input rest.ListOcmSharesRequest
+	if e := req.ReadEntity(&input); e != nil {
+		service.RestError500(req, rsp, e)
+		return
+	}
+	ctx := req.Request.Context()
+	shares, e := ocm.ListShares(ctx, h.IdmUserFromClaims(ctx).Login, input.Direction)
+	if e != nil {
+		service.RestError500(req, rsp, e)
+		return
+	}
+	for _, s := range shares {
+		ocmShareForClient(s)
+	}
+	rsp.WriteEntity(&rest.ListOcmSharesResponse{Shares: shares})
+}
+
+// AcceptOcmShare accepts or declines a pending incoming share, and notifies the remote server.
+func (h *SharesHandler) AcceptOcmShare(req *restful.Request, rsp *restful.Response) {
+
+	var input rest.AcceptOcmShareRequest
+	if e := req.ReadEntity(&input); e != nil {
+		service.RestError500(req, rsp, e)
+		return
+	}
+	ctx := req.Request.Context()
+	s, e := h.loadOcmShare(ctx, req.PathParameter("Uuid"))
+	if e != nil {
+		service.RestErrorDetect(req, rsp, e)
+		return
+	}
+	if s.Direction != rest.OcmShareDirection_INCOMING {
+		service.RestError403(req, rsp, errors.Forbidden(common.SERVICE_SHARE, "only incoming shares can be accepted"))
+		return
+	}
+	if input.Decline {
+		h.notifyOcm(ctx, s, ocm.NotificationShareDeclined)
+		if e := ocm.DeleteShare(ctx, s.Uuid); e != nil {
+			service.RestError500(req, rsp, e)
+			return
+		}
+		s.Status = rest.OcmShareStatus_DECLINED
+	} else if s.Status != rest.OcmShareStatus_ACCEPTED {
+		h.notifyOcm(ctx, s, ocm.NotificationShareAccepted)
+		s.Status = rest.OcmShareStatus_ACCEPTED
+		if e := ocm.StoreShare(ctx, s); e != nil {
+			service.RestError500(req, rsp, e)
+			return
+		}
+	}
+	// Accepted shares are mounted as workspaces
+	ocm.ForgetMounts(s.LocalUser)
+	rsp.WriteEntity(ocmShareForClient(s))
+}
+
+// DeleteOcmShare revokes an outgoing share or removes an incoming one, and notifies the remote server.
+func (h *SharesHandler) DeleteOcmShare(req *restful.Request, rsp *restful.Response) {
+
+	ctx := req.Request.Context()
+	s, e := h.loadOcmShare(ctx, req.PathParameter("Uuid"))
+	if e != nil {
+		service.RestErrorDetect(req, rsp, e)
+		return
+	}
+	if s.Direction == rest.OcmShareDirection_OUTGOING {
+		h.notifyOcm(ctx, s, ocm.NotificationShareUnshared)
+		e = ocm.RevokeOutgoingShare(ctx, s)
+	} else {
+		h.notifyOcm(ctx, s, ocm.NotificationShareDeclined)
+		e = ocm.DeleteShare(ctx, s.Uuid)
+		ocm.ForgetMounts(s.LocalUser)
+	}
+	if e != nil {
+		service.RestError500(req, rsp, e)
+		return
+	}
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("Removed federated share [%s]", s.Name),
+		log.GetAuditId(common.AUDIT_LINK_UPDATE),
+		zap.String(common.KEY_WORKSPACE_UUID, s.WorkspaceUuid),
+	)
+	rsp.WriteEntity(&rest.DeleteOcmShareResponse{Success: true})
+}
+
+// ListOcmShareNodes browses an accepted incoming share, reading the nodes on the remote server.
+func (h *SharesHandler) ListOcmShareNodes(req *restful.Request, rsp *restful.Response) {
+
+	var input rest.ListOcmShareNodesRequest
+	if e := req.ReadEntity(&input); e != nil {
+		service.RestError500(req, rsp, e)
+		return
+	}
+	ctx := req.Request.Context()
+	s, e := h.loadOcmShare(ctx, req.PathParameter("Uuid"))
+	if e != nil {
+		service.RestErrorDetect(req, rsp, e)
+		return
+	}
+	if s.Direction != rest.OcmShareDirection_INCOMING || s.Status != rest.OcmShareStatus_ACCEPTED {
+		service.RestError403(req, rsp, errors.Forbidden(common.SERVICE_SHARE, "please accept this share first"))
+		return
+	}
+	coll, e := ocm.ListRemoteNodes(ctx, s, input.Path)
+	if e != nil {
+		log.Logger(ctx).Error("Cannot browse federated share", zap.String("share", s.Uuid), zap.String("remote", s.RemoteUrl), zap.Error(e))
+		service.RestError500(req, rsp, e)
+		return
+	}
+	rsp.WriteEntity(coll)
+}
+
+// loadOcmShare loads a federated share and checks that it belongs to the current user.
+func (h *SharesHandler) loadOcmShare(ctx context.Context, shareUuid string) (*rest.OcmShare, error) {
+	s, e := ocm.LoadShare(ctx, shareUuid)
+	if e != nil {
+		return nil, e
+	}
+	if s.LocalUser != h.IdmUserFromClaims(ctx).Login {
+		return nil, errors.NotFound(common.SERVICE_SHARE, "Cannot find federated share %s", shareUuid)
+	}
+	return s, nil
+}
+
+// ocmShareForClient removes the secrets of a share before sending it to clients.
+func ocmShareForClient(s *rest.OcmShare) *rest.OcmShare {
+	s.SharedSecret = ""
+	s.HiddenUser = ""
+	return s
+}
+
+// notifyOcm sends a notification to the remote server of a share. Failures are only logged, as the remote
+// server may be unreachable.
+func (h *SharesHandler) notifyOcm(ctx context.Context, s *rest.OcmShare, notificationType string) {
+	client := ocm.NewClient(ocm.SkipVerify())
+	secret, e := ocm.ShareSecret(s)
+	var discovery *ocm.Discovery
+	if e == nil {
+		discovery, e = client.Discover(ctx, s.RemoteUrl)
+	}
+	if e == nil {
+		e = client.SendNotification(ctx, discovery, &ocm.Notification{
+			NotificationType: notificationType,
+			ResourceType:     ocm.ResourceTypeFile,
+			ProviderID:       s.ProviderId,
+			Notification:     ocm.NotificationDetails{SharedSecret: secret},
+		})
+	}
+	if e != nil {
+		log.Logger(ctx).Warn("Cannot notify remote server of federated share", zap.String("share", s.Uuid), zap.String("type", notificationType), zap.Error(e))
+	}
+}
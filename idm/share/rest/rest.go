@@ -99,6 +99,11 @@ func (h *SharesHandler) PutCell(req *restful.Request, rsp *restful.Response) {
 	}
 	log.Logger(ctx).Debug("Received Share.Cell API request", zap.Any("input", &shareRequest))
 	ownerUser := h.IdmUserFromClaims(ctx)
+	requester, err := share.ContextUser(ctx)
+	if err != nil {
+		service.RestErrorDetect(req, rsp, err)
+		return
+	}
 
 	// Init Root Nodes and check permissions
 	err, createdCellNode, readonly := share.ParseRootNodes(ctx, &shareRequest)
@@ -156,6 +161,25 @@ func (h *SharesHandler) PutCell(req *restful.Request, rsp *restful.Response) {
 	}
 	log.Logger(ctx).Debug("Current Roots", zap.Any("crt", currentRoots))
 	targetAcls := share.ComputeTargetAcls(ctx, ownerUser, shareRequest.Room, workspace.UUID, readonly)
+	// New external users only get their ACLs once an approver accepts them
+	var pendingAcls []*idm.ACL
+	var externalLogins []string
+	if share.CellsExternalRequireApproval(ctx, requester) {
+		externals := share.CellExternalUsers(ctx, shareRequest.Room)
+		approvedAcls := currentAcls
+		if !wsCreated && share.RootNodesChanged(currentRoots, shareRequest.Room.RootNodes) {
+			// Changing the shared nodes requires a new approval for all external users
+			approvedAcls = nil
+		}
+		targetAcls, pendingAcls = share.SplitPendingAcls(approvedAcls, targetAcls, externals)
+		seen := make(map[string]bool)
+		for _, acl := range pendingAcls {
+			if login := externals[acl.RoleID].Login; !seen[login] {
+				seen[login] = true
+				externalLogins = append(externalLogins, login)
+			}
+		}
+	}
 	log.Logger(ctx).Debug("Share ACLS", zap.Any("current", currentAcls), zap.Any("target", targetAcls))
 	add, remove := share.DiffAcls(ctx, currentAcls, targetAcls)
 	log.Logger(ctx).Debug("Diff ACLS", zap.Any("add", add), zap.Any("remove", remove))
@@ -181,6 +205,13 @@ func (h *SharesHandler) PutCell(req *restful.Request, rsp *restful.Response) {
 
 	log.Logger(ctx).Debug("Share Policies", zap.Any("before", workspace.Policies))
 	share.UpdatePoliciesFromAcls(ctx, workspace, currentAcls, targetAcls)
+	// Changing the expiration date re-enables an expired Cell
+	share.SetCellAccessEnd(workspace, shareRequest.Room.AccessEnd)
+	if len(pendingAcls) > 0 {
+		share.SetCellAttributes(workspace, map[string]interface{}{share.CellAttributePendingApproval: true})
+	} else {
+		share.SetCellAttributes(workspace, map[string]interface{}{share.CellAttributePendingApproval: nil})
+	}
 
 	// Now update workspace
 	log.Logger(ctx).Debug("Updating workspace", zap.Any("workspace", workspace))
@@ -189,6 +220,23 @@ func (h *SharesHandler) PutCell(req *restful.Request, rsp *restful.Response) {
 		service.RestError500(req, rsp, err)
 		return
 	}
+	if len(pendingAcls) > 0 {
+		approval := &rest.ShareApproval{
+			ShareType:     rest.ShareApproval_CELL,
+			ShareUuid:     workspace.UUID,
+			ShareLabel:    workspace.Label,
+			RootNodes:     shareRequest.Room.RootNodes,
+			ExternalUsers: externalLogins,
+		}
+		if err := share.RequestShareApproval(ctx, requester, approval, pendingAcls); err != nil {
+			service.RestError500(req, rsp, err)
+			return
+		}
+	} else if !wsCreated {
+		if err := share.CancelShareApproval(ctx, workspace.UUID); err != nil {
+			log.Logger(ctx).Error("Cannot cancel approval request for cell", zap.String(common.KEY_CELL_UUID, workspace.UUID), zap.Error(err))
+		}
+	}
 
 	// Put an Audit log if this cell has been newly created
 	if wsCreated {
@@ -264,6 +312,10 @@ func (h *SharesHandler) DeleteCell(req *restful.Request, rsp *restful.Response)
 		return
 	}
 
+	if err := share.CancelShareApproval(ctx, id); err != nil {
+		log.Logger(ctx).Error("Cannot cancel approval request for cell", zap.String(common.KEY_CELL_UUID, id), zap.Error(err))
+	}
+
 	// Put an Audit log if this cell has been removed without error
 	log.Auditer(ctx).Info(
 		fmt.Sprintf("Removed cell [%s]", currWsLabel),
@@ -295,6 +347,20 @@ func (h *SharesHandler) PutShareLink(req *restful.Request, rsp *restful.Response
 		service.RestErrorDetect(req, rsp, e)
 		return
 	}
+	if e := share.CheckFileRequestLink(link); e != nil {
+		service.RestErrorDetect(req, rsp, e)
+		return
+	}
+	requester, e := share.ContextUser(ctx)
+	if e != nil {
+		service.RestErrorDetect(req, rsp, e)
+		return
+	}
+	if e := share.CheckLinkForbiddenFolders(ctx, requester, link); e != nil {
+		service.RestErrorDetect(req, rsp, e)
+		return
+	}
+	requireApproval := share.LinksRequireApproval(ctx, requester)
 	ownerUser := h.IdmUserFromClaims(ctx)
 
 	var workspace *idm.Workspace
@@ -322,6 +388,7 @@ func (h *SharesHandler) PutShareLink(req *restful.Request, rsp *restful.Response
 		track("CreateACL")
 		link.Uuid = workspace.UUID
 		link.LinkHash = strings.Replace(uuid.NewUUID().String(), "-", "", -1)[0:12]
+		link.PendingApproval = requireApproval
 	} else {
 		workspace, create, err = share.GetOrCreateWorkspace(ctx, ownerUser, link.Uuid, idm.WorkspaceScope_LINK, link.Label, link.Description, true)
 	}
@@ -358,8 +425,12 @@ func (h *SharesHandler) PutShareLink(req *restful.Request, rsp *restful.Response
 		track("CreateWorkspace")
 	} else {
 		// Manage password if status was updated
-		storedLink := &rest.ShareLink{Uuid: link.Uuid}
-		share.LoadHashDocumentData(ctx, storedLink, []*idm.ACL{})
+		storedLink, e := share.WorkspaceToShareLinkObject(ctx, workspace, h)
+		if e != nil {
+			// Without a stored state, consider that the whole link changed
+			storedLink = &rest.ShareLink{Uuid: link.Uuid}
+			share.LoadHashDocumentData(ctx, storedLink, []*idm.ACL{})
+		}
 
 		link.PasswordRequired = storedLink.PasswordRequired
 		var saveUser bool
@@ -384,6 +455,22 @@ func (h *SharesHandler) PutShareLink(req *restful.Request, rsp *restful.Response
 				service.RestError500(req, rsp, err)
 			}
 		}
+		// Saving a disabled or rejected link again, or changing what it shares, requires a new approval
+		link.PendingApproval = requireApproval && (storedLink.PendingApproval || storedLink.Disabled || share.LinkScopeChanged(storedLink, link))
+		if (storedLink.Disabled || storedLink.PendingApproval) && !link.PendingApproval {
+			// Link was disabled by the expiration job or was waiting for approval, saving it again unlocks its user
+			if err := share.SetHiddenUserLock(ctx, user.Login, false); err != nil {
+				service.RestError500(req, rsp, err)
+				return
+			}
+		}
+	}
+	if link.PendingApproval {
+		// Link cannot be opened until an approver accepts it
+		if err := share.SetHiddenUserLock(ctx, user.Login, true); err != nil {
+			service.RestError500(req, rsp, err)
+			return
+		}
 	}
 
 	err = share.UpdateACLsForHiddenUser(ctx, user.Uuid, workspace.UUID, link.RootNodes, link.Permissions, !create)
@@ -415,6 +502,22 @@ func (h *SharesHandler) PutShareLink(req *restful.Request, rsp *restful.Response
 		return
 	}
 	track("StoreHashDocument")
+	if link.PendingApproval {
+		approval := &rest.ShareApproval{
+			ShareType:  rest.ShareApproval_LINK,
+			ShareUuid:  link.Uuid,
+			ShareLabel: link.Label,
+			RootNodes:  link.RootNodes,
+		}
+		if err := share.RequestShareApproval(ctx, requester, approval, nil); err != nil {
+			service.RestError500(req, rsp, err)
+			return
+		}
+	} else if !create {
+		if err := share.CancelShareApproval(ctx, link.Uuid); err != nil {
+			log.Logger(ctx).Error("Cannot cancel approval request for link", zap.String(common.KEY_LINK_UUID, link.Uuid), zap.Error(err))
+		}
+	}
 
 	// Reload
 	if output, e := share.WorkspaceToShareLinkObject(ctx, workspace, h); e != nil {
@@ -476,6 +579,15 @@ func (h *SharesHandler) DeleteShareLink(req *restful.Request, rsp *restful.Respo
 		service.RestError500(req, rsp, err)
 		return
 	}
+	if err := share.DeleteFileRequestDrops(ctx, id); err != nil {
+		log.Logger(ctx).Error("Cannot delete drop sessions for link", zap.String(common.KEY_LINK_UUID, id), zap.Error(err))
+	}
+	if err := share.DeleteLinkAccess(ctx, id); err != nil {
+		log.Logger(ctx).Error("Cannot delete access log for link", zap.String(common.KEY_LINK_UUID, id), zap.Error(err))
+	}
+	if err := share.CancelShareApproval(ctx, id); err != nil {
+		log.Logger(ctx).Error("Cannot cancel approval request for link", zap.String(common.KEY_LINK_UUID, id), zap.Error(err))
+	}
 
 	log.Auditer(ctx).Info(
 		fmt.Sprintf("Removed share link [%s]", id),
@@ -490,6 +602,171 @@ func (h *SharesHandler) DeleteShareLink(req *restful.Request, rsp *restful.Respo
 
 }
 
+// ListShareLinkAccess lists the accesses to a link with reports aggregated by recipient, or sends them as a CSV file.
+func (h *SharesHandler) ListShareLinkAccess(req *restful.Request, rsp *restful.Response) {
+
+	var input rest.ListShareLinkAccessRequest
+	if e := req.ReadEntity(&input); e != nil {
+		service.RestError500(req, rsp, e)
+		return
+	}
+	ctx := req.Request.Context()
+	id := req.PathParameter("LinkUuid")
+	ownerUser := h.IdmUserFromClaims(ctx)
+
+	if ws, _, e := share.GetOrCreateWorkspace(ctx, ownerUser, id, idm.WorkspaceScope_LINK, "", "", false); e != nil || ws == nil {
+		service.RestError404(req, rsp, e)
+		return
+	} else if !h.IsContextEditable(ctx, id, ws.Policies) {
+		service.RestError403(req, rsp, fmt.Errorf("you are not allowed to access this link log"))
+		return
+	}
+
+	entries, e := share.ListLinkAccess(ctx, id)
+	if e != nil {
+		service.RestError500(req, rsp, e)
+		return
+	}
+
+	if input.Format == "csv" {
+		rsp.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		rsp.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", share.LinkAccessCSVName(id)))
+		rsp.WriteHeader(200)
+		if e := share.WriteLinkAccessCSV(rsp.ResponseWriter, entries); e != nil {
+			log.Logger(ctx).Error("Cannot write link access log", zap.String(common.KEY_LINK_UUID, id), zap.Error(e))
+		}
+		return
+	}
+
+	response := &rest.ListShareLinkAccessResponse{
+		Total:   int32(len(entries)),
+		Reports: share.LinkAccessReports(entries),
+	}
+	offset, limit := int(input.Offset), int(input.Limit)
+	if offset > len(entries) {
+		offset = len(entries)
+	}
+	end := len(entries)
+	if limit > 0 && offset+limit < end {
+		end = offset + limit
+	}
+	response.Entries = entries[offset:end]
+	rsp.WriteEntity(response)
+
+}
+
+// ListShareApprovals lists approval requests. Approvers see all of them, other users only their own ones.
+func (h *SharesHandler) ListShareApprovals(req *restful.Request, rsp *restful.Response) {
+
+	var input rest.ListShareApprovalsRequest
+	if e := req.ReadEntity(&input); e != nil {
+		service.RestError500(req, rsp, e)
+		return
+	}
+	ctx := req.Request.Context()
+	user, e := share.ContextUser(ctx)
+	if e != nil {
+		service.RestErrorDetect(req, rsp, e)
+		return
+	}
+	var requester string
+	if !share.IsShareApprover(ctx, user) {
+		requester = user.Login
+	}
+
+	approvals, e := share.ListShareApprovals(ctx, "")
+	if e != nil {
+		service.RestError500(req, rsp, e)
+		return
+	}
+	approvals = share.FilterShareApprovals(approvals, input.Status, input.AllStatuses, requester)
+
+	response := &rest.ListShareApprovalsResponse{Total: int32(len(approvals))}
+	offset, limit := int(input.Offset), int(input.Limit)
+	if offset > len(approvals) {
+		offset = len(approvals)
+	}
+	end := len(approvals)
+	if limit > 0 && offset+limit < end {
+		end = offset + limit
+	}
+	response.Approvals = approvals[offset:end]
+	rsp.WriteEntity(response)
+
+}
+
+// DecideShareApproval approves or rejects a pending link or Cell and notifies its requester.
+func (h *SharesHandler) DecideShareApproval(req *restful.Request, rsp *restful.Response) {
+
+	var input rest.DecideShareApprovalRequest
+	if e := req.ReadEntity(&input); e != nil {
+		service.RestError500(req, rsp, e)
+		return
+	}
+	ctx := req.Request.Context()
+	user, e := share.ContextUser(ctx)
+	if e != nil {
+		service.RestErrorDetect(req, rsp, e)
+		return
+	}
+	if !share.IsShareApprover(ctx, user) {
+		service.RestError403(req, rsp, errors.Forbidden(common.SERVICE_SHARE, "You are not allowed to approve shares"))
+		return
+	}
+
+	approval, pendingAcls, e := share.LoadShareApproval(ctx, req.PathParameter("Uuid"))
+	if e != nil {
+		service.RestErrorDetect(req, rsp, e)
+		return
+	}
+	if approval.Status != rest.ShareApproval_PENDING {
+		service.RestErrorDetect(req, rsp, errors.BadRequest(common.SERVICE_SHARE, "This request was already decided"))
+		return
+	}
+	if approval.RequesterLogin == user.Login {
+		service.RestError403(req, rsp, errors.Forbidden(common.SERVICE_SHARE, "You cannot decide on your own request"))
+		return
+	}
+
+	approval.Status = rest.ShareApproval_REJECTED
+	if input.Approve {
+		approval.Status = rest.ShareApproval_APPROVED
+	}
+	approval.Approver = user.Login
+	approval.DecidedAt = time.Now().Unix()
+	approval.Comment = input.Comment
+	if e := share.ApplyShareApproval(ctx, approval, pendingAcls); e != nil {
+		service.RestErrorDetect(req, rsp, e)
+		return
+	}
+	if e := share.StoreShareApproval(ctx, approval, nil); e != nil {
+		service.RestError500(req, rsp, e)
+		return
+	}
+	share.NotifyShareApprovalDecision(ctx, approval)
+
+	msg := fmt.Sprintf("Share [%s] approved by %s", approval.ShareLabel, user.Login)
+	if !input.Approve {
+		msg = fmt.Sprintf("Share [%s] rejected by %s", approval.ShareLabel, user.Login)
+	}
+	if approval.ShareType == rest.ShareApproval_LINK {
+		log.Auditer(ctx).Info(msg,
+			log.GetAuditId(common.AUDIT_LINK_UPDATE),
+			zap.String(common.KEY_LINK_UUID, approval.ShareUuid),
+			zap.String(common.KEY_WORKSPACE_UUID, approval.ShareUuid),
+		)
+	} else {
+		log.Auditer(ctx).Info(msg,
+			log.GetAuditId(common.AUDIT_CELL_UPDATE),
+			zap.String(common.KEY_CELL_UUID, approval.ShareUuid),
+			zap.String(common.KEY_WORKSPACE_UUID, approval.ShareUuid),
+		)
+	}
+
+	rsp.WriteEntity(approval)
+
+}
+
 // UpdateSharePolicies updates policies associated to the underlying workspace
 func (h *SharesHandler) UpdateSharePolicies(req *restful.Request, rsp *restful.Response) {
 	var input rest.UpdateSharePoliciesRequest
@@ -549,3 +826,101 @@ func (h *SharesHandler) UpdateSharePolicies(req *restful.Request, rsp *restful.R
 	}
 	rsp.WriteEntity(response)
 }
+
+// CreateFileRequestDrop starts a drop session on a file request link. It must be called by the link
+// user, and creates a dedicated folder for the uploader.
+func (h *SharesHandler) CreateFileRequestDrop(req *restful.Request, rsp *restful.Response) {
+
+	var input rest.CreateFileRequestDropRequest
+	if e := req.ReadEntity(&input); e != nil {
+		service.RestError500(req, rsp, e)
+		return
+	}
+	ctx := req.Request.Context()
+	link, e := h.fileRequestLink(ctx, input.LinkUuid)
+	if e != nil {
+		service.RestErrorDetect(req, rsp, e)
+		return
+	}
+	ws, e := h.loadShareWorkspace(ctx, link.Uuid)
+	if e != nil {
+		service.RestErrorDetect(req, rsp, e)
+		return
+	}
+	if len(ws.RootUUIDs) == 0 {
+		service.RestError500(req, rsp, errors.InternalServerError(common.SERVICE_SHARE, "cannot find link root"))
+		return
+	}
+	drop, e := share.CreateFileRequestDrop(ctx, link.Uuid, ws.RootUUIDs[0], input.UploaderName, input.UploaderEmail)
+	if e != nil {
+		service.RestErrorDetect(req, rsp, e)
+		return
+	}
+	log.Logger(ctx).Info("Started drop session on file request", zap.String(common.KEY_LINK_UUID, link.Uuid), zap.String("drop", drop.Uuid), zap.String("folder", drop.FolderName))
+	drop.FolderPath = ""
+	rsp.WriteEntity(drop)
+}
+
+// CompleteFileRequestDrop closes a drop session once all files are uploaded. The owner of the link
+// is notified once per session.
+func (h *SharesHandler) CompleteFileRequestDrop(req *restful.Request, rsp *restful.Response) {
+
+	ctx := req.Request.Context()
+	drop, e := share.LoadFileRequestDrop(ctx, req.PathParameter("Uuid"))
+	if e != nil {
+		service.RestErrorDetect(req, rsp, e)
+		return
+	}
+	if _, e := h.fileRequestLink(ctx, drop.LinkUuid); e != nil {
+		service.RestErrorDetect(req, rsp, e)
+		return
+	}
+	if drop.CompletedAt == 0 {
+		if e := share.CompleteFileRequestDrop(ctx, drop); e != nil {
+			service.RestErrorDetect(req, rsp, e)
+			return
+		}
+		log.Logger(ctx).Info("Completed drop session on file request", zap.String(common.KEY_LINK_UUID, drop.LinkUuid), zap.String("drop", drop.Uuid), zap.Int32("files", drop.FilesCount))
+	}
+	drop.FolderPath = ""
+	rsp.WriteEntity(drop)
+}
+
+// fileRequestLink loads a file request link and checks that the current user is the link user.
+func (h *SharesHandler) fileRequestLink(ctx context.Context, linkUuid string) (*rest.ShareLink, error) {
+	link := &rest.ShareLink{Uuid: linkUuid}
+	if e := share.LoadHashDocumentData(ctx, link, []*idm.ACL{}); e != nil {
+		return nil, errors.NotFound(common.SERVICE_SHARE, "cannot find link %s", linkUuid)
+	}
+	if link.FileRequest == nil {
+		return nil, errors.Forbidden(common.SERVICE_SHARE, "this link does not accept file requests")
+	}
+	if link.UserLogin != h.IdmUserFromClaims(ctx).Login {
+		return nil, errors.Forbidden(common.SERVICE_SHARE, "you are not allowed to drop files on this link")
+	}
+	if link.AccessEnd > 0 && time.Now().After(time.Unix(link.AccessEnd, 0)) {
+		return nil, errors.Forbidden(common.SERVICE_SHARE, "this link has expired")
+	}
+	return link, nil
+}
+
+// loadShareWorkspace loads the workspace underlying a share.
+func (h *SharesHandler) loadShareWorkspace(ctx context.Context, workspaceId string) (*idm.Workspace, error) {
+	cli := idm.NewWorkspaceServiceClient(registry.GetClient(common.SERVICE_WORKSPACE))
+	q, _ := ptypes.MarshalAny(&idm.WorkspaceSingleQuery{Uuid: workspaceId})
+	stream, e := cli.SearchWorkspace(ctx, &idm.SearchWorkspaceRequest{Query: &service2.Query{SubQueries: []*any.Any{q}}})
+	if e != nil {
+		return nil, e
+	}
+	defer stream.Close()
+	for {
+		resp, e := stream.Recv()
+		if e != nil {
+			break
+		}
+		if resp != nil && resp.Workspace != nil {
+			return resp.Workspace, nil
+		}
+	}
+	return nil, errors.NotFound("share.not.found", "cannot find associated workspace")
+}
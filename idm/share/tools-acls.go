@@ -48,6 +48,7 @@ func WorkspaceToCellObject(ctx context.Context, workspace *idm.Workspace, checke
 		nodesSlices = append(nodesSlices, node)
 	}
 
+	expiry := CellExpiry(workspace)
 	return &rest.Cell{
 		Uuid:                    workspace.UUID,
 		Label:                   workspace.Label,
@@ -56,6 +57,9 @@ func WorkspaceToCellObject(ctx context.Context, workspace *idm.Workspace, checke
 		ACLs:                    roomAcls,
 		Policies:                workspace.Policies,
 		PoliciesContextEditable: checker.IsContextEditable(ctx, workspace.UUID, workspace.Policies),
+		AccessEnd:               expiry.AccessEnd,
+		Disabled:                expiry.DisabledAt > 0,
+		PendingApproval:         workspaceAttributes(workspace)[CellAttributePendingApproval] == true,
 	}, nil
 }
 
@@ -0,0 +1,558 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package share
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/micro/go-micro/errors"
+	"github.com/pborman/uuid"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/micro"
+	"github.com/pydio/cells/common/proto/docstore"
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/mailer"
+	"github.com/pydio/cells/common/proto/rest"
+	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/service/proto"
+	"github.com/pydio/cells/common/utils/permissions"
+)
+
+// Role parameters of the action.share plugin used to enforce the approval of external shares.
+const (
+	ParamLinksRequireApproval         = "parameter:action.share:LINKS_REQUIRE_APPROVAL"
+	ParamCellsExternalRequireApproval = "parameter:action.share:CELLS_EXTERNAL_REQUIRE_APPROVAL"
+	ParamLinksForbiddenFolders        = "parameter:action.share:LINKS_FORBIDDEN_FOLDERS"
+	ParamShareApprover                = "parameter:action.share:SHARE_APPROVER"
+)
+
+// CellAttributePendingApproval is set on the workspace of a Cell while external users wait for approval.
+const CellAttributePendingApproval = "PENDING_APPROVAL"
+
+type approvalDocument struct {
+	Approval *rest.ShareApproval
+	// ACLs given to the external users of a Cell once approved
+	PendingAcls []*idm.ACL
+}
+
+// ContextUser loads the current user with its roles.
+func ContextUser(ctx context.Context) (*idm.User, error) {
+	login, _ := permissions.FindUserNameInContext(ctx)
+	if login == "" {
+		return nil, errors.Unauthorized(common.SERVICE_SHARE, "Cannot find user in context")
+	}
+	return permissions.SearchUniqueUser(ctx, login, "")
+}
+
+// RoleParameter finds the value of a parameter for a user, the last role defining it wins.
+func RoleParameter(ctx context.Context, user *idm.User, name string) (string, bool) {
+	if len(user.Roles) == 0 {
+		return "", false
+	}
+	acls := permissions.GetACLsForRoles(ctx, user.Roles, &idm.ACLAction{Name: name})
+	return roleParameterValue(user.Roles, acls)
+}
+
+func roleParameterValue(roles []*idm.Role, acls []*idm.ACL) (string, bool) {
+	for i := len(roles) - 1; i >= 0; i-- {
+		for _, a := range acls {
+			if a.RoleID == roles[i].Uuid && a.Action.Value != "-1" {
+				return a.Action.Value, true
+			}
+		}
+	}
+	return "", false
+}
+
+func boolRoleParameter(ctx context.Context, user *idm.User, name string) bool {
+	var value bool
+	if v, ok := RoleParameter(ctx, user, name); ok {
+		json.Unmarshal([]byte(v), &value)
+	}
+	return value
+}
+
+// IsShareApprover checks if a user can decide on approval requests. Admins are always approvers.
+func IsShareApprover(ctx context.Context, user *idm.User) bool {
+	if user.Attributes != nil && user.Attributes[idm.UserAttrProfile] == common.PYDIO_PROFILE_ADMIN {
+		return true
+	}
+	return boolRoleParameter(ctx, user, ParamShareApprover)
+}
+
+// LinksRequireApproval checks if the public links of this user must be approved. Approvers do not
+// need an approval for their own links.
+func LinksRequireApproval(ctx context.Context, user *idm.User) bool {
+	return boolRoleParameter(ctx, user, ParamLinksRequireApproval) && !IsShareApprover(ctx, user)
+}
+
+// CellsExternalRequireApproval checks if inviting external users in a Cell must be approved.
+func CellsExternalRequireApproval(ctx context.Context, user *idm.User) bool {
+	return boolRoleParameter(ctx, user, ParamCellsExternalRequireApproval) && !IsShareApprover(ctx, user)
+}
+
+// ParseForbiddenFolders reads a comma or line separated list of folder paths.
+func ParseForbiddenFolders(value string) (folders []string) {
+	for _, f := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == '\n' }) {
+		if f = strings.Trim(strings.TrimSpace(f), "/"); f != "" {
+			folders = append(folders, f)
+		}
+	}
+	return
+}
+
+// ForbiddenRootNode finds the first node located inside one of the forbidden folders.
+func ForbiddenRootNode(folders []string, nodes []*tree.Node) *tree.Node {
+	for _, n := range nodes {
+		p := strings.Trim(n.Path, "/")
+		for _, f := range folders {
+			if p == f || strings.HasPrefix(p, f+"/") {
+				return n
+			}
+		}
+	}
+	return nil
+}
+
+// CheckLinkForbiddenFolders refuses links on the folders forbidden by the user roles. Root nodes
+// must already be loaded, see CheckLinkRootNodes.
+func CheckLinkForbiddenFolders(ctx context.Context, user *idm.User, link *rest.ShareLink) error {
+	value, ok := RoleParameter(ctx, user, ParamLinksForbiddenFolders)
+	if !ok {
+		return nil
+	}
+	if n := ForbiddenRootNode(ParseForbiddenFolders(value), link.RootNodes); n != nil {
+		return errors.Forbidden(common.SERVICE_SHARE, "Public links are not allowed on %s", path.Base(n.Path))
+	}
+	return nil
+}
+
+// CellExternalUsers finds the users with a shared profile, created by other users, that are invited in the Cell.
+func CellExternalUsers(ctx context.Context, cell *rest.Cell) map[string]*idm.User {
+	externals := make(map[string]*idm.User)
+	for _, acl := range cell.ACLs {
+		if !acl.IsUserRole {
+			continue
+		}
+		u, e := permissions.SearchUniqueUser(ctx, "", acl.RoleId)
+		if e != nil {
+			continue
+		}
+		if u.Attributes != nil && u.Attributes[idm.UserAttrProfile] == common.PYDIO_PROFILE_SHARED {
+			externals[acl.RoleId] = u
+		}
+	}
+	return externals
+}
+
+// SplitPendingAcls separates the target ACLs that can be applied right away from the ones given to
+// external users that do not already access the Cell.
+func SplitPendingAcls(current []*idm.ACL, target []*idm.ACL, externals map[string]*idm.User) (apply []*idm.ACL, pending []*idm.ACL) {
+	existing := make(map[string]bool)
+	for _, acl := range current {
+		existing[acl.RoleID] = true
+	}
+	for _, acl := range target {
+		if _, ext := externals[acl.RoleID]; ext && !existing[acl.RoleID] {
+			pending = append(pending, acl)
+		} else {
+			apply = append(apply, acl)
+		}
+	}
+	return
+}
+
+// RootNodesChanged tells whether the nodes differ from the current root nodes, given by their Uuids.
+func RootNodesChanged(current []string, nodes []*tree.Node) bool {
+	ids := make(map[string]bool, len(nodes))
+	for _, n := range nodes {
+		ids[n.Uuid] = true
+	}
+	if len(ids) != len(current) {
+		return true
+	}
+	for _, id := range current {
+		if !ids[id] {
+			return true
+		}
+	}
+	return false
+}
+
+// LinkScopeChanged tells whether the root nodes or the permissions of a link differ from the stored ones,
+// in which case an approved link must be approved again.
+func LinkScopeChanged(stored *rest.ShareLink, link *rest.ShareLink) bool {
+	var current []string
+	for _, n := range stored.RootNodes {
+		current = append(current, n.Uuid)
+	}
+	if RootNodesChanged(current, link.RootNodes) {
+		return true
+	}
+	perms := make(map[rest.ShareLinkAccessType]bool, len(stored.Permissions))
+	for _, p := range stored.Permissions {
+		perms[p] = true
+	}
+	requested := make(map[rest.ShareLinkAccessType]bool, len(link.Permissions))
+	for _, p := range link.Permissions {
+		if !perms[p] {
+			return true
+		}
+		requested[p] = true
+	}
+	return len(requested) != len(perms)
+}
+
+// RequestShareApproval stores an approval request for a link or a Cell, replacing the pending one
+// of the same share. Approvers are notified of new requests only.
+func RequestShareApproval(ctx context.Context, requester *idm.User, approval *rest.ShareApproval, pendingAcls []*idm.ACL) error {
+	var update bool
+	if pending, _, e := PendingShareApproval(ctx, approval.ShareUuid); e == nil && pending != nil {
+		approval.Uuid = pending.Uuid
+		update = true
+	} else {
+		approval.Uuid = uuid.New()
+	}
+	approval.RequesterLogin = requester.Login
+	approval.RequesterUuid = requester.Uuid
+	approval.CreatedAt = time.Now().Unix()
+	approval.Status = rest.ShareApproval_PENDING
+	if e := StoreShareApproval(ctx, approval, pendingAcls); e != nil || update {
+		return e
+	}
+	approvers := ShareApprovers(ctx)
+	var to []*mailer.User
+	for _, a := range approvers {
+		if a.Login == requester.Login || a.Attributes["email"] == "" {
+			continue
+		}
+		to = append(to, &mailer.User{Uuid: a.Uuid, Name: a.Attributes["displayName"], Address: a.Attributes["email"]})
+	}
+	if len(to) == 0 {
+		log.Logger(ctx).Warn("No approver with an email address to notify of share approval request", zap.String("share", approval.ShareUuid))
+		return nil
+	}
+	requesterName := requester.Login
+	if d, ok := requester.Attributes["displayName"]; ok && d != "" {
+		requesterName = d
+	}
+	sendApprovalMail(ctx, to, "ShareApprovalRequest", approval, requesterName)
+	return nil
+}
+
+// NotifyShareApprovalDecision tells the requester whether the share was approved or rejected.
+func NotifyShareApprovalDecision(ctx context.Context, approval *rest.ShareApproval) {
+	requester, e := permissions.SearchUniqueUser(ctx, approval.RequesterLogin, "")
+	if e != nil || requester.Attributes["email"] == "" {
+		return
+	}
+	templateId := "ShareApproved"
+	if approval.Status == rest.ShareApproval_REJECTED {
+		templateId = "ShareRejected"
+	}
+	to := []*mailer.User{{Uuid: requester.Uuid, Name: requester.Attributes["displayName"], Address: requester.Attributes["email"]}}
+	sendApprovalMail(ctx, to, templateId, approval, approval.Approver)
+}
+
+func sendApprovalMail(ctx context.Context, to []*mailer.User, templateId string, approval *rest.ShareApproval, userName string) {
+	mailCli := mailer.NewMailerServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_MAILER, defaults.NewClient())
+	_, e := mailCli.SendMail(ctx, &mailer.SendMailRequest{
+		InQueue: true,
+		Mail: &mailer.Mail{
+			To:         to,
+			TemplateId: templateId,
+			TemplateData: map[string]string{
+				"Label":   approval.ShareLabel,
+				"User":    userName,
+				"Comment": approval.Comment,
+			},
+		},
+	})
+	if e != nil {
+		log.Logger(ctx).Error("Cannot send share approval email", zap.String("template", templateId), zap.Error(e))
+	}
+}
+
+// ShareApprovers lists the users having the approver parameter. Admins are used when no approver is defined.
+func ShareApprovers(ctx context.Context) (approvers []*idm.User) {
+	aclClient := idm.NewACLServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_ACL, defaults.NewClient())
+	q, _ := ptypes.MarshalAny(&idm.ACLSingleQuery{Actions: []*idm.ACLAction{{Name: ParamShareApprover}}})
+	stream, e := aclClient.SearchACL(ctx, &idm.SearchACLRequest{Query: &service.Query{SubQueries: []*any.Any{q}}})
+	if e != nil {
+		return
+	}
+	var userQueries []*any.Any
+	for {
+		resp, e := stream.Recv()
+		if e != nil {
+			break
+		}
+		if resp == nil || resp.ACL == nil || resp.ACL.Action.Value == "-1" {
+			continue
+		}
+		hasRole, _ := ptypes.MarshalAny(&idm.UserSingleQuery{HasRole: resp.ACL.RoleID})
+		byUuid, _ := ptypes.MarshalAny(&idm.UserSingleQuery{Uuid: resp.ACL.RoleID})
+		userQueries = append(userQueries, hasRole, byUuid)
+	}
+	stream.Close()
+	for _, u := range searchUsers(ctx, userQueries) {
+		// Parameter may be overridden by another role of the user
+		if boolRoleParameter(ctx, u, ParamShareApprover) {
+			approvers = append(approvers, u)
+		}
+	}
+	if len(approvers) == 0 {
+		admins, _ := ptypes.MarshalAny(&idm.UserSingleQuery{AttributeName: idm.UserAttrProfile, AttributeValue: common.PYDIO_PROFILE_ADMIN})
+		approvers = searchUsers(ctx, []*any.Any{admins})
+	}
+	return
+}
+
+func searchUsers(ctx context.Context, queries []*any.Any) (users []*idm.User) {
+	if len(queries) == 0 {
+		return
+	}
+	uClient := idm.NewUserServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_USER, defaults.NewClient())
+	stream, e := uClient.SearchUser(ctx, &idm.SearchUserRequest{Query: &service.Query{SubQueries: queries, Operation: service.OperationType_OR}})
+	if e != nil {
+		return
+	}
+	defer stream.Close()
+	seen := make(map[string]bool)
+	for {
+		resp, e := stream.Recv()
+		if e != nil {
+			break
+		}
+		if resp == nil || resp.User == nil || resp.User.IsGroup || seen[resp.User.Uuid] {
+			continue
+		}
+		seen[resp.User.Uuid] = true
+		users = append(users, resp.User)
+	}
+	return
+}
+
+// StoreShareApproval saves an approval request in the docstore.
+func StoreShareApproval(ctx context.Context, approval *rest.ShareApproval, pendingAcls []*idm.ACL) error {
+	store := docstore.NewDocStoreClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_DOCSTORE, defaults.NewClient())
+	data, _ := json.Marshal(&approvalDocument{Approval: approval, PendingAcls: pendingAcls})
+	_, e := store.PutDocument(ctx, &docstore.PutDocumentRequest{
+		StoreID:    common.DOCSTORE_ID_SHARE_APPROVALS,
+		DocumentID: approval.Uuid,
+		Document: &docstore.Document{
+			ID:    approval.Uuid,
+			Owner: approval.ShareUuid,
+			Type:  docstore.DocumentType_JSON,
+			Data:  string(data),
+		},
+	})
+	return e
+}
+
+// LoadShareApproval loads an approval request and the ACLs waiting for it.
+func LoadShareApproval(ctx context.Context, approvalUuid string) (*rest.ShareApproval, []*idm.ACL, error) {
+	store := docstore.NewDocStoreClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_DOCSTORE, defaults.NewClient())
+	resp, e := store.GetDocument(ctx, &docstore.GetDocumentRequest{StoreID: common.DOCSTORE_ID_SHARE_APPROVALS, DocumentID: approvalUuid})
+	if e != nil || resp.Document == nil || resp.Document.Data == "" {
+		return nil, nil, errors.NotFound(common.SERVICE_SHARE, "Cannot find approval request %s", approvalUuid)
+	}
+	var doc approvalDocument
+	if e := json.Unmarshal([]byte(resp.Document.Data), &doc); e != nil || doc.Approval == nil {
+		return nil, nil, errors.NotFound(common.SERVICE_SHARE, "Cannot read approval request %s", approvalUuid)
+	}
+	return doc.Approval, doc.PendingAcls, nil
+}
+
+// ListShareApprovals lists approval requests, of one share if shareUuid is set, most recent first.
+func ListShareApprovals(ctx context.Context, shareUuid string) ([]*rest.ShareApproval, error) {
+	store := docstore.NewDocStoreClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_DOCSTORE, defaults.NewClient())
+	stream, e := store.ListDocuments(ctx, &docstore.ListDocumentsRequest{StoreID: common.DOCSTORE_ID_SHARE_APPROVALS, Query: &docstore.DocumentQuery{
+		Owner: shareUuid,
+	}})
+	if e != nil {
+		return nil, e
+	}
+	defer stream.Close()
+	var approvals []*rest.ShareApproval
+	for {
+		resp, e := stream.Recv()
+		if e != nil {
+			break
+		}
+		if resp == nil || resp.Document == nil {
+			continue
+		}
+		var doc approvalDocument
+		if e := json.Unmarshal([]byte(resp.Document.Data), &doc); e == nil && doc.Approval != nil {
+			approvals = append(approvals, doc.Approval)
+		}
+	}
+	sort.SliceStable(approvals, func(i, j int) bool {
+		return approvals[i].CreatedAt > approvals[j].CreatedAt
+	})
+	return approvals, nil
+}
+
+// PendingShareApproval finds the pending approval request of a share, if any.
+func PendingShareApproval(ctx context.Context, shareUuid string) (*rest.ShareApproval, []*idm.ACL, error) {
+	approvals, e := ListShareApprovals(ctx, shareUuid)
+	if e != nil {
+		return nil, nil, e
+	}
+	for _, a := range approvals {
+		if a.ShareUuid == shareUuid && a.Status == rest.ShareApproval_PENDING {
+			return LoadShareApproval(ctx, a.Uuid)
+		}
+	}
+	return nil, nil, nil
+}
+
+// FilterShareApprovals keeps the requests with the given status, or all of them if allStatuses is set.
+// When requester is not empty, only its own requests are kept.
+func FilterShareApprovals(approvals []*rest.ShareApproval, status rest.ShareApproval_ApprovalStatus, allStatuses bool, requester string) (filtered []*rest.ShareApproval) {
+	for _, a := range approvals {
+		if !allStatuses && a.Status != status {
+			continue
+		}
+		if requester != "" && a.RequesterLogin != requester {
+			continue
+		}
+		filtered = append(filtered, a)
+	}
+	return
+}
+
+// CancelShareApproval removes the pending approval request of a share, decided ones are kept.
+func CancelShareApproval(ctx context.Context, shareUuid string) error {
+	pending, _, e := PendingShareApproval(ctx, shareUuid)
+	if e != nil || pending == nil {
+		return e
+	}
+	store := docstore.NewDocStoreClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_DOCSTORE, defaults.NewClient())
+	_, e = store.DeleteDocuments(ctx, &docstore.DeleteDocumentsRequest{StoreID: common.DOCSTORE_ID_SHARE_APPROVALS, DocumentID: pending.Uuid})
+	return e
+}
+
+// ApplyShareApproval activates a link or gives access to the external users of a Cell once approved.
+// A rejected link is disabled, and the external users of a rejected Cell never get access.
+func ApplyShareApproval(ctx context.Context, approval *rest.ShareApproval, pendingAcls []*idm.ACL) error {
+	approved := approval.Status == rest.ShareApproval_APPROVED
+	if approval.ShareType == rest.ShareApproval_LINK {
+		doc, linkData, e := loadLinkDocument(ctx, approval.ShareUuid)
+		if e != nil {
+			return e
+		}
+		linkData.PendingApproval = false
+		if approved {
+			if e := SetHiddenUserLock(ctx, linkLogin(linkData), false); e != nil {
+				return e
+			}
+		} else {
+			linkData.DisabledAt = time.Now().Unix()
+		}
+		data, _ := json.Marshal(linkData)
+		doc.Data = string(data)
+		doc.IndexableMeta = string(data)
+		store := docstore.NewDocStoreClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_DOCSTORE, defaults.NewClient())
+		_, e = store.PutDocument(ctx, &docstore.PutDocumentRequest{StoreID: common.DOCSTORE_ID_SHARES, DocumentID: doc.ID, Document: doc})
+		return e
+	}
+
+	wsClient := idm.NewWorkspaceServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_WORKSPACE, defaults.NewClient())
+	q, _ := ptypes.MarshalAny(&idm.WorkspaceSingleQuery{Uuid: approval.ShareUuid})
+	stream, e := wsClient.SearchWorkspace(ctx, &idm.SearchWorkspaceRequest{Query: &service.Query{SubQueries: []*any.Any{q}}})
+	if e != nil {
+		return e
+	}
+	var workspace *idm.Workspace
+	for {
+		resp, e := stream.Recv()
+		if e != nil {
+			break
+		}
+		workspace = resp.Workspace
+		break
+	}
+	stream.Close()
+	if workspace == nil {
+		return errors.NotFound(common.SERVICE_SHARE, "Cannot find cell %s", approval.ShareUuid)
+	}
+	if approved && len(pendingAcls) > 0 {
+		current, _, e := CommonAclsForWorkspace(ctx, workspace.UUID)
+		if e != nil {
+			return e
+		}
+		aclClient := idm.NewACLServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_ACL, defaults.NewClient())
+		for _, acl := range pendingAcls {
+			if _, e := aclClient.CreateACL(ctx, &idm.CreateACLRequest{ACL: acl}); e != nil {
+				return e
+			}
+		}
+		UpdatePoliciesFromAcls(ctx, workspace, current, append(current, pendingAcls...))
+	}
+	SetCellAttributes(workspace, map[string]interface{}{CellAttributePendingApproval: nil})
+	_, e = wsClient.CreateWorkspace(ctx, &idm.CreateWorkspaceRequest{Workspace: workspace})
+	return e
+}
+
+func loadLinkDocument(ctx context.Context, linkUuid string) (*docstore.Document, *docstore.ShareDocument, error) {
+	store := docstore.NewDocStoreClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_DOCSTORE, defaults.NewClient())
+	stream, e := store.ListDocuments(ctx, &docstore.ListDocumentsRequest{StoreID: common.DOCSTORE_ID_SHARES, Query: &docstore.DocumentQuery{
+		MetaQuery: "+REPOSITORY:\"" + linkUuid + "\" +SHARE_TYPE:minisite",
+	}})
+	if e != nil {
+		return nil, nil, e
+	}
+	defer stream.Close()
+	for {
+		resp, e := stream.Recv()
+		if e != nil {
+			break
+		}
+		if resp.Document == nil {
+			continue
+		}
+		var linkData *docstore.ShareDocument
+		if e := json.Unmarshal([]byte(resp.Document.Data), &linkData); e != nil {
+			return nil, nil, e
+		}
+		return resp.Document, linkData, nil
+	}
+	return nil, nil, errors.NotFound(common.SERVICE_SHARE, "Cannot find link %s", linkUuid)
+}
+
+func linkLogin(linkData *docstore.ShareDocument) string {
+	if linkData.PresetLogin != "" {
+		return linkData.PresetLogin
+	}
+	return linkData.PreLogUser
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package share
+
+import (
+	"testing"
+
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/rest"
+	"github.com/pydio/cells/common/proto/tree"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRoleParameterValue(t *testing.T) {
+
+	Convey("Test parameter resolution order", t, func() {
+		roles := []*idm.Role{{Uuid: "ROOT_GROUP"}, {Uuid: "group"}, {Uuid: "user"}}
+		acls := []*idm.ACL{
+			{RoleID: "ROOT_GROUP", Action: &idm.ACLAction{Name: ParamShareApprover, Value: "false"}},
+			{RoleID: "group", Action: &idm.ACLAction{Name: ParamShareApprover, Value: "true"}},
+			{RoleID: "user", Action: &idm.ACLAction{Name: ParamShareApprover, Value: "-1"}},
+		}
+		v, ok := roleParameterValue(roles, acls)
+		So(ok, ShouldBeTrue)
+		So(v, ShouldEqual, "true")
+
+		_, ok = roleParameterValue(roles, nil)
+		So(ok, ShouldBeFalse)
+	})
+}
+
+func TestForbiddenRootNode(t *testing.T) {
+
+	Convey("Test forbidden folders", t, func() {
+		folders := ParseForbiddenFolders(" pydiods1/hr/ ,\npydiods1/legal,, ")
+		So(folders, ShouldResemble, []string{"pydiods1/hr", "pydiods1/legal"})
+
+		So(ForbiddenRootNode(folders, []*tree.Node{{Path: "pydiods1/hrm/file.txt"}}), ShouldBeNil)
+		So(ForbiddenRootNode(folders, []*tree.Node{{Path: "pydiods1/hr"}}), ShouldNotBeNil)
+		n := ForbiddenRootNode(folders, []*tree.Node{{Path: "pydiods1/public"}, {Path: "/pydiods1/legal/contracts/a.pdf"}})
+		So(n, ShouldNotBeNil)
+		So(n.Path, ShouldEqual, "/pydiods1/legal/contracts/a.pdf")
+		So(ForbiddenRootNode(nil, []*tree.Node{{Path: "pydiods1/hr"}}), ShouldBeNil)
+	})
+}
+
+func TestSplitPendingAcls(t *testing.T) {
+
+	Convey("Test external users ACLs are kept pending", t, func() {
+		externals := map[string]*idm.User{"ext-new": {Login: "new"}, "ext-old": {Login: "old"}}
+		current := []*idm.ACL{{RoleID: "owner", NodeID: "n"}, {RoleID: "ext-old", NodeID: "n"}}
+		target := []*idm.ACL{{RoleID: "owner", NodeID: "n"}, {RoleID: "ext-old", NodeID: "n"}, {RoleID: "ext-new", NodeID: "n"}, {RoleID: "internal", NodeID: "n"}}
+		apply, pending := SplitPendingAcls(current, target, externals)
+		So(apply, ShouldHaveLength, 3)
+		So(pending, ShouldHaveLength, 1)
+		So(pending[0].RoleID, ShouldEqual, "ext-new")
+	})
+}
+
+func TestLinkScopeChanged(t *testing.T) {
+
+	Convey("Test changes of root nodes and permissions are detected", t, func() {
+		So(RootNodesChanged([]string{"a", "b"}, []*tree.Node{{Uuid: "b"}, {Uuid: "a"}}), ShouldBeFalse)
+		So(RootNodesChanged([]string{"a"}, []*tree.Node{{Uuid: "a"}, {Uuid: "b"}}), ShouldBeTrue)
+		So(RootNodesChanged([]string{"a", "b"}, []*tree.Node{{Uuid: "a"}}), ShouldBeTrue)
+
+		stored := &rest.ShareLink{
+			RootNodes:   []*tree.Node{{Uuid: "a"}},
+			Permissions: []rest.ShareLinkAccessType{rest.ShareLinkAccessType_Preview, rest.ShareLinkAccessType_Download},
+		}
+		link := &rest.ShareLink{
+			RootNodes:   []*tree.Node{{Uuid: "a"}},
+			Permissions: []rest.ShareLinkAccessType{rest.ShareLinkAccessType_Download, rest.ShareLinkAccessType_Preview},
+		}
+		So(LinkScopeChanged(stored, link), ShouldBeFalse)
+		link.Permissions = append(link.Permissions, rest.ShareLinkAccessType_Upload)
+		So(LinkScopeChanged(stored, link), ShouldBeTrue)
+		link.Permissions = []rest.ShareLinkAccessType{rest.ShareLinkAccessType_Preview}
+		So(LinkScopeChanged(stored, link), ShouldBeTrue)
+		link.Permissions = stored.Permissions
+		link.RootNodes = []*tree.Node{{Uuid: "b"}}
+		So(LinkScopeChanged(stored, link), ShouldBeTrue)
+	})
+}
+
+func TestFilterShareApprovals(t *testing.T) {
+
+	Convey("Test approvals filtering", t, func() {
+		approvals := []*rest.ShareApproval{
+			{Uuid: "1", RequesterLogin: "alice", Status: rest.ShareApproval_PENDING},
+			{Uuid: "2", RequesterLogin: "bob", Status: rest.ShareApproval_PENDING},
+			{Uuid: "3", RequesterLogin: "alice", Status: rest.ShareApproval_REJECTED},
+		}
+		So(FilterShareApprovals(approvals, rest.ShareApproval_PENDING, false, ""), ShouldHaveLength, 2)
+		So(FilterShareApprovals(approvals, rest.ShareApproval_PENDING, true, "alice"), ShouldHaveLength, 2)
+		filtered := FilterShareApprovals(approvals, rest.ShareApproval_REJECTED, false, "alice")
+		So(filtered, ShouldHaveLength, 1)
+		So(filtered[0].Uuid, ShouldEqual, "3")
+	})
+}
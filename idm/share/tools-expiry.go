@@ -0,0 +1,208 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package share
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/micro"
+	"github.com/pydio/cells/common/proto/docstore"
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/service/proto"
+)
+
+// Workspace attributes used to follow the expiration of a Cell.
+const (
+	CellAttributeAccessEnd      = "ACCESS_END"
+	CellAttributeExpiryNotified = "EXPIRY_NOTIFIED"
+	CellAttributeDisabledAt     = "DISABLED_AT"
+)
+
+// ExpiryStep is the next operation to apply on an expiring share.
+type ExpiryStep int
+
+const (
+	ExpiryNone ExpiryStep = iota
+	ExpiryNotify
+	ExpiryDisable
+	ExpiryDelete
+)
+
+// ShareExpiry gathers the expiration status of a link or a Cell.
+type ShareExpiry struct {
+	// AccessEnd is the expiration date, zero if the share never expires
+	AccessEnd int64
+	// LimitReached is set for links whose maximum downloads number is reached
+	LimitReached bool
+	Notified     bool
+	DisabledAt   int64
+}
+
+// Expired checks whether the share is no longer accessible.
+func (s *ShareExpiry) Expired(now time.Time) bool {
+	return s.LimitReached || (s.AccessEnd > 0 && now.Unix() >= s.AccessEnd)
+}
+
+// NextStep finds the operation to apply: owners are notified notifyBefore the expiration date,
+// the share is disabled once expired, then deleted deleteAfter it has been disabled. A zero
+// notifyBefore disables reminders and a zero deleteAfter keeps disabled shares forever.
+func (s *ShareExpiry) NextStep(now time.Time, notifyBefore time.Duration, deleteAfter time.Duration) ExpiryStep {
+	if s.DisabledAt > 0 {
+		if deleteAfter > 0 && now.After(time.Unix(s.DisabledAt, 0).Add(deleteAfter)) {
+			return ExpiryDelete
+		}
+		return ExpiryNone
+	}
+	if s.Expired(now) {
+		return ExpiryDisable
+	}
+	if s.AccessEnd > 0 && notifyBefore > 0 && !s.Notified && now.Add(notifyBefore).Unix() >= s.AccessEnd {
+		return ExpiryNotify
+	}
+	return ExpiryNone
+}
+
+// LinkExpiry reads the expiration status of a link from its hash document.
+func LinkExpiry(linkData *docstore.ShareDocument) *ShareExpiry {
+	return &ShareExpiry{
+		AccessEnd:    linkData.ExpireTime,
+		LimitReached: linkData.DownloadLimit > 0 && linkData.DownloadCount >= linkData.DownloadLimit,
+		Notified:     linkData.ExpiryNotified,
+		DisabledAt:   linkData.DisabledAt,
+	}
+}
+
+// CellExpiry reads the expiration status of a Cell from its workspace attributes.
+func CellExpiry(workspace *idm.Workspace) *ShareExpiry {
+	atts := workspaceAttributes(workspace)
+	expiry := &ShareExpiry{}
+	if v, ok := atts[CellAttributeAccessEnd].(float64); ok {
+		expiry.AccessEnd = int64(v)
+	}
+	if v, ok := atts[CellAttributeExpiryNotified].(bool); ok {
+		expiry.Notified = v
+	}
+	if v, ok := atts[CellAttributeDisabledAt].(float64); ok {
+		expiry.DisabledAt = int64(v)
+	}
+	return expiry
+}
+
+// SetCellAccessEnd stores the expiration date of a Cell in its workspace attributes. When the date
+// changes, the reminder and the disabled status are reset.
+func SetCellAccessEnd(workspace *idm.Workspace, accessEnd int64) {
+	if CellExpiry(workspace).AccessEnd == accessEnd {
+		return
+	}
+	SetCellAttributes(workspace, map[string]interface{}{
+		CellAttributeAccessEnd:      accessEnd,
+		CellAttributeExpiryNotified: nil,
+		CellAttributeDisabledAt:     nil,
+	})
+}
+
+// SetCellAttributes updates the workspace attributes, nil values are removed.
+func SetCellAttributes(workspace *idm.Workspace, values map[string]interface{}) {
+	atts := workspaceAttributes(workspace)
+	for k, v := range values {
+		if v == nil || v == int64(0) {
+			delete(atts, k)
+		} else {
+			atts[k] = v
+		}
+	}
+	data, _ := json.Marshal(atts)
+	workspace.Attributes = string(data)
+}
+
+func workspaceAttributes(workspace *idm.Workspace) map[string]interface{} {
+	atts := make(map[string]interface{})
+	if workspace.Attributes != "" {
+		json.Unmarshal([]byte(workspace.Attributes), &atts)
+	}
+	return atts
+}
+
+// SetHiddenUserLock locks or unlocks the user of a link. Locked users cannot log in anymore.
+func SetHiddenUserLock(ctx context.Context, login string, locked bool) error {
+	uClient := idm.NewUserServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_USER, defaults.NewClient())
+	q, _ := ptypes.MarshalAny(&idm.UserSingleQuery{Login: login})
+	stream, e := uClient.SearchUser(ctx, &idm.SearchUserRequest{Query: &service.Query{SubQueries: []*any.Any{q}}})
+	if e != nil {
+		return e
+	}
+	var user *idm.User
+	for {
+		resp, e := stream.Recv()
+		if e != nil {
+			break
+		}
+		user = resp.User
+		break
+	}
+	stream.Close()
+	if user == nil {
+		return nil
+	}
+	if user.Attributes == nil {
+		user.Attributes = make(map[string]string)
+	}
+	var locks, newLocks []string
+	if l, ok := user.Attributes["locks"]; ok {
+		json.Unmarshal([]byte(l), &locks)
+	}
+	for _, lock := range locks {
+		if lock != "logout" {
+			newLocks = append(newLocks, lock)
+		}
+	}
+	if locked {
+		newLocks = append(newLocks, "logout")
+	}
+	if len(newLocks) == len(locks) {
+		// Nothing changed
+		return nil
+	}
+	if len(newLocks) > 0 {
+		data, _ := json.Marshal(newLocks)
+		user.Attributes["locks"] = string(data)
+	} else {
+		delete(user.Attributes, "locks")
+	}
+	// Do not update password
+	user.Password = ""
+	_, e = uClient.CreateUser(ctx, &idm.CreateUserRequest{User: user})
+	return e
+}
+
+// DeleteHiddenUser removes the user of a link, its role is cleaned by the roles service.
+func DeleteHiddenUser(ctx context.Context, login string) error {
+	uClient := idm.NewUserServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_USER, defaults.NewClient())
+	q, _ := ptypes.MarshalAny(&idm.UserSingleQuery{Login: login, AttributeName: "hidden", AttributeValue: "true"})
+	_, e := uClient.DeleteUser(ctx, &idm.DeleteUserRequest{Query: &service.Query{SubQueries: []*any.Any{q}}})
+	return e
+}
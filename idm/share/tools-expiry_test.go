@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package share
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pydio/cells/common/proto/docstore"
+	"github.com/pydio/cells/common/proto/idm"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestShareExpiryNextStep(t *testing.T) {
+
+	now := time.Now()
+	day := 24 * time.Hour
+
+	Convey("Test expiration steps", t, func() {
+		So((&ShareExpiry{}).NextStep(now, 3*day, 7*day), ShouldEqual, ExpiryNone)
+
+		expiry := &ShareExpiry{AccessEnd: now.Add(10 * day).Unix()}
+		So(expiry.NextStep(now, 3*day, 7*day), ShouldEqual, ExpiryNone)
+		expiry.AccessEnd = now.Add(2 * day).Unix()
+		So(expiry.NextStep(now, 3*day, 7*day), ShouldEqual, ExpiryNotify)
+		So(expiry.NextStep(now, 0, 7*day), ShouldEqual, ExpiryNone)
+		expiry.Notified = true
+		So(expiry.NextStep(now, 3*day, 7*day), ShouldEqual, ExpiryNone)
+
+		expiry.AccessEnd = now.Add(-time.Hour).Unix()
+		So(expiry.NextStep(now, 3*day, 7*day), ShouldEqual, ExpiryDisable)
+		expiry.DisabledAt = now.Add(-time.Hour).Unix()
+		So(expiry.NextStep(now, 3*day, 7*day), ShouldEqual, ExpiryNone)
+		expiry.DisabledAt = now.Add(-8 * day).Unix()
+		So(expiry.NextStep(now, 3*day, 7*day), ShouldEqual, ExpiryDelete)
+		So(expiry.NextStep(now, 3*day, 0), ShouldEqual, ExpiryNone)
+	})
+
+	Convey("Test links with downloads limit", t, func() {
+		expiry := LinkExpiry(&docstore.ShareDocument{DownloadLimit: 2, DownloadCount: 1})
+		So(expiry.NextStep(now, 3*day, 7*day), ShouldEqual, ExpiryNone)
+		expiry = LinkExpiry(&docstore.ShareDocument{DownloadLimit: 2, DownloadCount: 2})
+		So(expiry.NextStep(now, 3*day, 7*day), ShouldEqual, ExpiryDisable)
+	})
+}
+
+func TestCellExpiryAttributes(t *testing.T) {
+
+	Convey("Test cell attributes", t, func() {
+		ws := &idm.Workspace{Attributes: `{"DEFAULT_RIGHTS":"r"}`}
+		So(CellExpiry(ws).AccessEnd, ShouldEqual, 0)
+
+		SetCellAccessEnd(ws, 1000)
+		SetCellAttributes(ws, map[string]interface{}{CellAttributeExpiryNotified: true, CellAttributeDisabledAt: int64(2000)})
+		expiry := CellExpiry(ws)
+		So(expiry.AccessEnd, ShouldEqual, 1000)
+		So(expiry.Notified, ShouldBeTrue)
+		So(expiry.DisabledAt, ShouldEqual, 2000)
+
+		// Same date keeps the status
+		SetCellAccessEnd(ws, 1000)
+		So(CellExpiry(ws).DisabledAt, ShouldEqual, 2000)
+
+		// New date resets it
+		SetCellAccessEnd(ws, 5000)
+		expiry = CellExpiry(ws)
+		So(expiry.AccessEnd, ShouldEqual, 5000)
+		So(expiry.Notified, ShouldBeFalse)
+		So(expiry.DisabledAt, ShouldEqual, 0)
+
+		SetCellAccessEnd(ws, 0)
+		So(ws.Attributes, ShouldEqual, `{"DEFAULT_RIGHTS":"r"}`)
+	})
+}
@@ -0,0 +1,209 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+package share
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/micro/go-micro/client"
+	"github.com/micro/go-micro/errors"
+	"github.com/pborman/uuid"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/micro"
+	"github.com/pydio/cells/common/proto/docstore"
+	"github.com/pydio/cells/common/proto/rest"
+	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/views"
+)
+
+// fileRequestFolderMaxLength is the maximum number of characters of a drop folder name.
+const fileRequestFolderMaxLength = 120
+
+// FileRequestFolderName computes the name of the folder receiving the files of one uploader,
+// from the name and email entered in the drop form.
+func FileRequestFolderName(name string, email string) string {
+	clean := func(s string) string {
+		s = strings.Map(func(r rune) rune {
+			if r == '/' || r == '\\' || unicode.IsControl(r) {
+				return ' '
+			}
+			return r
+		}, s)
+		return strings.Trim(strings.Join(strings.Fields(s), " "), ". ")
+	}
+	name, email = clean(name), clean(email)
+	folder := name
+	if email != "" {
+		if folder == "" {
+			folder = email
+		} else {
+			folder += " (" + email + ")"
+		}
+	}
+	if runes := []rune(folder); len(runes) > fileRequestFolderMaxLength {
+		folder = strings.TrimSpace(string(runes[:fileRequestFolderMaxLength]))
+	}
+	return folder
+}
+
+// CheckFileRequestLink makes sure a file request link is upload-only and targets a single folder.
+func CheckFileRequestLink(link *rest.ShareLink) error {
+	if link.FileRequest == nil {
+		return nil
+	}
+	if len(link.RootNodes) != 1 || link.RootNodes[0].IsLeaf() {
+		return errors.BadRequest(common.SERVICE_SHARE, "A file request must be created on one folder")
+	}
+	if link.FileRequest.MaxFileSize < 0 {
+		return errors.BadRequest(common.SERVICE_SHARE, "Maximum file size cannot be negative")
+	}
+	var exts []string
+	for _, e := range link.FileRequest.AllowedExtensions {
+		if e = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(e), ".")); e != "" {
+			exts = append(exts, e)
+		}
+	}
+	link.FileRequest.AllowedExtensions = exts
+	link.Permissions = []rest.ShareLinkAccessType{rest.ShareLinkAccessType_Upload}
+	return nil
+}
+
+// CreateFileRequestDrop creates the folder of a new uploader under the root of a file request link,
+// and stores the corresponding drop session.
+func CreateFileRequestDrop(ctx context.Context, linkUuid string, rootNodeId string, name string, email string) (*rest.FileRequestDrop, error) {
+
+	folderName := FileRequestFolderName(name, email)
+	if folderName == "" {
+		return nil, errors.BadRequest(common.SERVICE_SHARE, "Please provide your name or email")
+	}
+	treeClient := tree.NewNodeProviderClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_TREE, defaults.NewClient())
+	rootResp, e := treeClient.ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Uuid: rootNodeId}})
+	if e != nil {
+		return nil, e
+	}
+	// Uploaders have no read access on the link, use an admin router to create their folder
+	router := views.NewStandardRouter(views.RouterOptions{WatchRegistry: false, AdminView: true})
+	parentPath := strings.TrimRight(rootResp.Node.Path, "/")
+	folderPath := parentPath + "/" + folderName
+	for index := 2; ; index++ {
+		if existing, err := router.ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Path: folderPath}}); err == nil && existing.Node != nil {
+			folderPath = fmt.Sprintf("%s/%s (%d)", parentPath, folderName, index)
+		} else {
+			break
+		}
+	}
+	createResp, e := router.CreateNode(ctx, &tree.CreateNodeRequest{Node: &tree.Node{Path: folderPath, Type: tree.NodeType_COLLECTION}})
+	if e != nil {
+		return nil, e
+	}
+
+	drop := &rest.FileRequestDrop{
+		Uuid:          uuid.New(),
+		LinkUuid:      linkUuid,
+		UploaderName:  strings.TrimSpace(name),
+		UploaderEmail: strings.TrimSpace(email),
+		FolderUuid:    createResp.Node.Uuid,
+		FolderName:    path.Base(folderPath),
+		FolderPath:    folderPath,
+		CreatedAt:     time.Now().Unix(),
+	}
+	if e := StoreFileRequestDrop(ctx, drop); e != nil {
+		return nil, e
+	}
+	return drop, nil
+}
+
+// CompleteFileRequestDrop closes a drop session by counting the uploaded files, and publishes an event
+// used to notify the owner of the link.
+func CompleteFileRequestDrop(ctx context.Context, drop *rest.FileRequestDrop) error {
+
+	router := views.NewStandardRouter(views.RouterOptions{WatchRegistry: false, AdminView: true})
+	stream, e := router.ListNodes(ctx, &tree.ListNodesRequest{Node: &tree.Node{Path: drop.FolderPath}, Recursive: true, FilterType: tree.NodeType_LEAF})
+	if e != nil {
+		return e
+	}
+	defer stream.Close()
+	var count int32
+	for {
+		resp, e := stream.Recv()
+		if e != nil {
+			break
+		}
+		if resp == nil || tree.IgnoreNodeForOutput(ctx, resp.Node) {
+			continue
+		}
+		count++
+	}
+	drop.FilesCount = count
+	drop.CompletedAt = time.Now().Unix()
+	if e := StoreFileRequestDrop(ctx, drop); e != nil {
+		return e
+	}
+	client.Publish(ctx, client.NewPublication(common.TOPIC_FILE_REQUEST, drop))
+	return nil
+}
+
+// StoreFileRequestDrop saves a drop session in the docstore.
+func StoreFileRequestDrop(ctx context.Context, drop *rest.FileRequestDrop) error {
+	store := docstore.NewDocStoreClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_DOCSTORE, defaults.NewClient())
+	data, _ := json.Marshal(drop)
+	_, e := store.PutDocument(ctx, &docstore.PutDocumentRequest{
+		StoreID:    common.DOCSTORE_ID_FILE_REQUEST_DROPS,
+		DocumentID: drop.Uuid,
+		Document: &docstore.Document{
+			ID:            drop.Uuid,
+			Owner:         drop.LinkUuid,
+			Type:          docstore.DocumentType_JSON,
+			Data:          string(data),
+			IndexableMeta: string(data),
+		},
+	})
+	return e
+}
+
+// LoadFileRequestDrop loads a drop session from the docstore.
+func LoadFileRequestDrop(ctx context.Context, dropUuid string) (*rest.FileRequestDrop, error) {
+	store := docstore.NewDocStoreClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_DOCSTORE, defaults.NewClient())
+	resp, e := store.GetDocument(ctx, &docstore.GetDocumentRequest{StoreID: common.DOCSTORE_ID_FILE_REQUEST_DROPS, DocumentID: dropUuid})
+	if e != nil || resp.Document == nil || resp.Document.Data == "" {
+		return nil, errors.NotFound(common.SERVICE_SHARE, "Cannot find drop session %s", dropUuid)
+	}
+	var drop *rest.FileRequestDrop
+	if e := json.Unmarshal([]byte(resp.Document.Data), &drop); e != nil {
+		return nil, e
+	}
+	return drop, nil
+}
+
+// DeleteFileRequestDrops removes all drop sessions of a link. Uploaded files are left untouched.
+func DeleteFileRequestDrops(ctx context.Context, linkUuid string) error {
+	store := docstore.NewDocStoreClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_DOCSTORE, defaults.NewClient())
+	_, e := store.DeleteDocuments(ctx, &docstore.DeleteDocumentsRequest{StoreID: common.DOCSTORE_ID_FILE_REQUEST_DROPS, Query: &docstore.DocumentQuery{
+		MetaQuery: "+LinkUuid:\"" + linkUuid + "\"",
+	}})
+	return e
+}
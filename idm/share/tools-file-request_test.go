@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+package share
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pydio/cells/common/proto/rest"
+	"github.com/pydio/cells/common/proto/tree"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestFileRequestFolderName(t *testing.T) {
+
+	Convey("Test folder names", t, func() {
+		So(FileRequestFolderName("John Doe", "john@example.com"), ShouldEqual, "John Doe (john@example.com)")
+		So(FileRequestFolderName("  John   Doe ", ""), ShouldEqual, "John Doe")
+		So(FileRequestFolderName("", "john@example.com"), ShouldEqual, "john@example.com")
+		So(FileRequestFolderName("../../etc/passwd", ""), ShouldEqual, "etc passwd")
+		So(FileRequestFolderName(".hidden", ""), ShouldEqual, "hidden")
+		So(FileRequestFolderName("a\\b\nc", ""), ShouldEqual, "a b c")
+		So(FileRequestFolderName(" . ", "  "), ShouldEqual, "")
+		So([]rune(FileRequestFolderName(strings.Repeat("é", 300), "")), ShouldHaveLength, fileRequestFolderMaxLength)
+	})
+}
+
+func TestCheckFileRequestLink(t *testing.T) {
+
+	Convey("Test file request links", t, func() {
+		link := &rest.ShareLink{
+			RootNodes:   []*tree.Node{{Uuid: "folder", Type: tree.NodeType_COLLECTION}},
+			Permissions: []rest.ShareLinkAccessType{rest.ShareLinkAccessType_Preview, rest.ShareLinkAccessType_Download},
+		}
+		So(CheckFileRequestLink(link), ShouldBeNil)
+		So(link.Permissions, ShouldHaveLength, 2)
+
+		link.FileRequest = &rest.ShareLinkFileRequest{AllowedExtensions: []string{".PDF", " docx", ""}}
+		So(CheckFileRequestLink(link), ShouldBeNil)
+		So(link.Permissions, ShouldResemble, []rest.ShareLinkAccessType{rest.ShareLinkAccessType_Upload})
+		So(link.FileRequest.AllowedExtensions, ShouldResemble, []string{"pdf", "docx"})
+
+		link.FileRequest.MaxFileSize = -1
+		So(CheckFileRequestLink(link), ShouldNotBeNil)
+
+		link.FileRequest.MaxFileSize = 0
+		link.RootNodes = []*tree.Node{{Uuid: "file", Type: tree.NodeType_LEAF}}
+		So(CheckFileRequestLink(link), ShouldNotBeNil)
+	})
+}
@@ -56,6 +56,7 @@ func StoreHashDocument(ctx context.Context, ownerUser *idm.User, link *rest.Shar
 		hashDoc.PreLogUser = link.UserLogin
 	}
 	hashDoc.PreUserUuid = link.Uuid
+	hashDoc.PendingApproval = link.PendingApproval
 
 	if link.TargetUsers != nil && len(link.TargetUsers) > 0 {
 		hashDoc.TargetUsers = make(map[string]*docstore.TargetUserEntry)
@@ -74,6 +75,12 @@ func StoreHashDocument(ctx context.Context, ownerUser *idm.User, link *rest.Shar
 	}
 	hashDoc.DownloadDisabled = !DownloadEnabled
 
+	if link.FileRequest != nil {
+		hashDoc.FileRequest = true
+		hashDoc.UploadMaxSize = link.FileRequest.MaxFileSize
+		hashDoc.UploadExtensions = link.FileRequest.AllowedExtensions
+	}
+
 	hashDocMarshaled, _ := json.Marshal(hashDoc)
 	var removeHash string
 	if len(updateHash) > 0 && len(updateHash[0]) > 0 {
@@ -134,6 +141,8 @@ func LoadHashDocumentData(ctx context.Context, shareLink *rest.ShareLink, acls [
 			shareLink.UserLogin = linkData.PreLogUser
 		}
 		shareLink.UserUuid = linkData.PreUserUuid
+		shareLink.Disabled = linkData.DisabledAt > 0
+		shareLink.PendingApproval = linkData.PendingApproval
 		if linkData.TargetUsers != nil && len(linkData.TargetUsers) > 0 {
 			shareLink.TargetUsers = make(map[string]*rest.ShareLinkTargetUser)
 			for id, t := range linkData.TargetUsers {
@@ -141,6 +150,12 @@ func LoadHashDocumentData(ctx context.Context, shareLink *rest.ShareLink, acls [
 			}
 			shareLink.RestrictToTargetUsers = linkData.RestrictToTargetUsers
 		}
+		if linkData.FileRequest {
+			shareLink.FileRequest = &rest.ShareLinkFileRequest{
+				MaxFileSize:       linkData.UploadMaxSize,
+				AllowedExtensions: linkData.UploadExtensions,
+			}
+		}
 
 	} else {
 		return err
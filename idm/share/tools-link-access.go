@@ -0,0 +1,165 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package share
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/micro"
+	"github.com/pydio/cells/common/proto/docstore"
+	"github.com/pydio/cells/common/proto/rest"
+)
+
+// ListLinkAccess loads the access log of a link, most recent entries first.
+func ListLinkAccess(ctx context.Context, linkUuid string) ([]*rest.ShareLinkAccess, error) {
+	store := docstore.NewDocStoreClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_DOCSTORE, defaults.NewClient())
+	stream, e := store.ListDocuments(ctx, &docstore.ListDocumentsRequest{StoreID: common.DOCSTORE_ID_LINK_ACCESS_LOG, Query: &docstore.DocumentQuery{
+		Owner: linkUuid,
+	}})
+	if e != nil {
+		return nil, e
+	}
+	defer stream.Close()
+	var entries []*rest.ShareLinkAccess
+	for {
+		resp, e := stream.Recv()
+		if e != nil {
+			break
+		}
+		if resp == nil || resp.Document == nil {
+			continue
+		}
+		var entry *rest.ShareLinkAccess
+		if e := json.Unmarshal([]byte(resp.Document.Data), &entry); e == nil && entry.LinkUuid == linkUuid {
+			entries = append(entries, entry)
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Time > entries[j].Time
+	})
+	return entries, nil
+}
+
+// DeleteLinkAccess removes the access log of a link.
+func DeleteLinkAccess(ctx context.Context, linkUuid string) error {
+	entries, e := ListLinkAccess(ctx, linkUuid)
+	if e != nil {
+		return e
+	}
+	store := docstore.NewDocStoreClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_DOCSTORE, defaults.NewClient())
+	for _, entry := range entries {
+		if _, e := store.DeleteDocuments(ctx, &docstore.DeleteDocumentsRequest{StoreID: common.DOCSTORE_ID_LINK_ACCESS_LOG, DocumentID: entry.Uuid}); e != nil {
+			return e
+		}
+	}
+	return nil
+}
+
+// LinkAccessReports aggregates the access log by recipient. Anonymous visitors are grouped by remote address.
+func LinkAccessReports(entries []*rest.ShareLinkAccess) []*rest.ShareLinkRecipientReport {
+	var reports []*rest.ShareLinkRecipientReport
+	byKey := make(map[string]*rest.ShareLinkRecipientReport)
+	for _, entry := range entries {
+		key := "recipient:" + entry.Recipient
+		if entry.Recipient == "" {
+			key = "address:" + entry.RemoteAddress
+		}
+		report, ok := byKey[key]
+		if !ok {
+			report = &rest.ShareLinkRecipientReport{
+				Recipient:        entry.Recipient,
+				RecipientDisplay: entry.RecipientDisplay,
+				RemoteAddress:    entry.RemoteAddress,
+				FirstAccess:      entry.Time,
+				LastAccess:       entry.Time,
+			}
+			byKey[key] = report
+			reports = append(reports, report)
+		}
+		switch entry.Action {
+		case rest.ShareLinkAccessAction_OPEN:
+			report.Opens++
+		case rest.ShareLinkAccessAction_PREVIEW:
+			report.Previews++
+		case rest.ShareLinkAccessAction_DOWNLOAD:
+			report.Downloads++
+		}
+		if entry.Time < report.FirstAccess {
+			report.FirstAccess = entry.Time
+		}
+		if entry.Time > report.LastAccess {
+			report.LastAccess = entry.Time
+			// Keep the last known address of a recipient
+			report.RemoteAddress = entry.RemoteAddress
+		}
+	}
+	sort.SliceStable(reports, func(i, j int) bool {
+		return reports[i].LastAccess > reports[j].LastAccess
+	})
+	return reports
+}
+
+// WriteLinkAccessCSV writes the access log as CSV, with one line per entry.
+func WriteLinkAccessCSV(w io.Writer, entries []*rest.ShareLinkAccess) error {
+	writer := csv.NewWriter(w)
+	if e := writer.Write([]string{"Date", "Action", "Recipient", "Remote Address", "User Agent", "File"}); e != nil {
+		return e
+	}
+	for _, entry := range entries {
+		recipient := entry.RecipientDisplay
+		if recipient == "" {
+			recipient = entry.Recipient
+		}
+		if e := writer.Write([]string{
+			time.Unix(entry.Time, 0).UTC().Format(time.RFC3339),
+			entry.Action.String(),
+			csvCell(recipient),
+			csvCell(entry.RemoteAddress),
+			csvCell(entry.UserAgent),
+			csvCell(entry.NodePath),
+		}); e != nil {
+			return e
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// csvCell escapes values that a spreadsheet would evaluate as a formula, as user agents are sent by visitors.
+func csvCell(value string) string {
+	if value != "" && strings.ContainsAny(value[:1], "=+-@\t\r") {
+		return "'" + value
+	}
+	return value
+}
+
+// LinkAccessCSVName builds the file name of a CSV export.
+func LinkAccessCSVName(linkUuid string) string {
+	return "link-access-" + linkUuid + "-" + strconv.FormatInt(time.Now().Unix(), 10) + ".csv"
+}
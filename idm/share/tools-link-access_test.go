@@ -0,0 +1,86 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package share
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	"github.com/pydio/cells/common/proto/rest"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func testLinkAccessEntries() []*rest.ShareLinkAccess {
+	return []*rest.ShareLinkAccess{
+		{Time: 400, Action: rest.ShareLinkAccessAction_DOWNLOAD, Recipient: "u1", RecipientDisplay: "John", RemoteAddress: "10.0.0.2", NodePath: "link/report.pdf"},
+		{Time: 300, Action: rest.ShareLinkAccessAction_PREVIEW, RemoteAddress: "192.168.1.1", UserAgent: "Mozilla, \"quoted\"", NodePath: "link/image.png"},
+		{Time: 200, Action: rest.ShareLinkAccessAction_OPEN, RemoteAddress: "192.168.1.1"},
+		{Time: 150, Action: rest.ShareLinkAccessAction_OPEN, RemoteAddress: "192.168.1.2"},
+		{Time: 100, Action: rest.ShareLinkAccessAction_OPEN, Recipient: "u1", RecipientDisplay: "John", RemoteAddress: "10.0.0.1"},
+	}
+}
+
+func TestLinkAccessReports(t *testing.T) {
+
+	Convey("Test reports aggregation", t, func() {
+		reports := LinkAccessReports(testLinkAccessEntries())
+		So(reports, ShouldHaveLength, 3)
+
+		So(reports[0].Recipient, ShouldEqual, "u1")
+		So(reports[0].Opens, ShouldEqual, 1)
+		So(reports[0].Downloads, ShouldEqual, 1)
+		So(reports[0].FirstAccess, ShouldEqual, 100)
+		So(reports[0].LastAccess, ShouldEqual, 400)
+		So(reports[0].RemoteAddress, ShouldEqual, "10.0.0.2")
+
+		So(reports[1].Recipient, ShouldBeEmpty)
+		So(reports[1].RemoteAddress, ShouldEqual, "192.168.1.1")
+		So(reports[1].Opens, ShouldEqual, 1)
+		So(reports[1].Previews, ShouldEqual, 1)
+
+		So(reports[2].RemoteAddress, ShouldEqual, "192.168.1.2")
+		So(LinkAccessReports(nil), ShouldBeEmpty)
+	})
+}
+
+func TestWriteLinkAccessCSV(t *testing.T) {
+
+	Convey("Test CSV export", t, func() {
+		buf := &bytes.Buffer{}
+		So(WriteLinkAccessCSV(buf, testLinkAccessEntries()), ShouldBeNil)
+		records, e := csv.NewReader(buf).ReadAll()
+		So(e, ShouldBeNil)
+		So(records, ShouldHaveLength, 6)
+		So(records[0][0], ShouldEqual, "Date")
+		So(records[1], ShouldResemble, []string{"1970-01-01T00:06:40Z", "DOWNLOAD", "John", "10.0.0.2", "", "link/report.pdf"})
+		So(records[2][4], ShouldEqual, "Mozilla, \"quoted\"")
+		So(records[3][1], ShouldEqual, "OPEN")
+
+		buf.Reset()
+		So(WriteLinkAccessCSV(buf, []*rest.ShareLinkAccess{{UserAgent: "=HYPERLINK(\"x\")", NodePath: "-1"}}), ShouldBeNil)
+		records, e = csv.NewReader(buf).ReadAll()
+		So(e, ShouldBeNil)
+		So(records[1][4], ShouldEqual, "'=HYPERLINK(\"x\")")
+		So(records[1][5], ShouldEqual, "'-1")
+	})
+}
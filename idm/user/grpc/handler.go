@@ -30,6 +30,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/micro/go-micro/client"
@@ -38,6 +39,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/auth"
 	"github.com/pydio/cells/common/log"
 	"github.com/pydio/cells/common/proto/idm"
 	"github.com/pydio/cells/common/proto/jobs"
@@ -57,6 +59,8 @@ var (
 		{Subject: "profile:admin", Action: service.ResourcePolicyAction_WRITE, Effect: service.ResourcePolicy_allow},
 	}
 	autoAppliesCache *cache.Cache
+	// passwordPolicy loads the current password policy
+	passwordPolicy = auth.DefaultPasswordPolicy
 )
 
 // ByAge implements sort.Interface for []Person based on
@@ -81,6 +85,7 @@ func (h *Handler) BindUser(ctx context.Context, req *idm.BindUserRequest, resp *
 	if err != nil {
 		return err
 	}
+	h.checkPasswordAge(ctx, dao, user)
 	resp.User = user
 	resp.User.Password = ""
 
@@ -100,7 +105,21 @@ func (h *Handler) CreateUser(ctx context.Context, req *idm.CreateUserRequest, re
 	}
 	dao := servicecontext.GetDAO(ctx).(user.DAO)
 
+	if rule, ok := req.User.Attributes[idm.UserAttrGroupRule]; ok && rule != "" {
+		if !req.User.IsGroup {
+			return errors.BadRequest(common.SERVICE_USER, "rules can only be set on groups")
+		}
+		if _, e := user.ParseGroupRule(rule); e != nil {
+			return errors.BadRequest(common.SERVICE_USER, "invalid group rule: %s", e.Error())
+		}
+	}
+
 	passChange := req.User.Password
+	if passChange != "" && !req.User.IsGroup {
+		if err := h.recordPasswordChange(ctx, dao, req.User); err != nil {
+			return err
+		}
+	}
 	// Create or update user
 	newUser, createdNodes, err := dao.Add(req.User)
 	if err != nil {
@@ -109,6 +128,9 @@ func (h *Handler) CreateUser(ctx context.Context, req *idm.CreateUserRequest, re
 	}
 
 	out := newUser.(*idm.User)
+	if out.IsGroup {
+		h.clearRuleGroups()
+	}
 	if passChange != "" {
 		// Check if it is a "force pass change operation".
 		ctxLogin, _ := permissions.FindUserNameInContext(ctx)
@@ -206,6 +228,75 @@ func (h *Handler) CreateUser(ctx context.Context, req *idm.CreateUserRequest, re
 	return nil
 }
 
+// recordPasswordChange rejects a password that does not comply with the policy or that was used recently
+// by the user, and records the hashes of the previous passwords along with the date of the change. Hidden
+// users passwords protect shared links, not accounts, so they are not checked.
+func (h *Handler) recordPasswordChange(ctx context.Context, dao user.DAO, u *idm.User) error {
+	policy := passwordPolicy()
+	if u.Attributes[idm.UserAttrPassHashed] != "true" && u.Attributes["hidden"] != "true" {
+		if e := policy.Validate(u.Password); e != nil {
+			return e
+		}
+	}
+	var history []string
+	if existing := storedUser(dao, u); existing != nil {
+		history = user.PasswordHistory(existing)
+	}
+	if policy.History > 0 && u.Attributes[idm.UserAttrPassHashed] != "true" {
+		recent := history
+		if len(recent) > policy.History {
+			recent = recent[:policy.History]
+		}
+		if user.PasswordReused(u.Password, recent) {
+			return errors.Forbidden(common.SERVICE_USER, "this password was used recently, please choose another one")
+		}
+	}
+	user.SetPasswordHistory(u, history, policy.History)
+	user.SetPasswordChanged(u, time.Now())
+	return nil
+}
+
+// checkPasswordAge sets the pass_change lock on a user whose password is older than the maximum age of the
+// policy. For passwords changed before their date was recorded, the age is counted from the next login.
+func (h *Handler) checkPasswordAge(ctx context.Context, dao user.DAO, u *idm.User) {
+	policy := passwordPolicy()
+	if policy.MaxAge == 0 || u.IsGroup {
+		return
+	}
+	update := proto.Clone(u).(*idm.User)
+	// Keep the stored hash
+	update.Password = ""
+	if changed, ok := user.PasswordChanged(u); !ok {
+		user.SetPasswordChanged(update, time.Now())
+	} else if policy.Expired(changed) && !user.HasLock(u, user.LockPassChange) {
+		user.SetLock(update, user.LockPassChange, true)
+		user.SetLock(u, user.LockPassChange, true)
+		log.Logger(ctx).Info("password of user "+u.Login+" has expired, it must be changed", u.ZapUuid())
+	} else {
+		return
+	}
+	if _, _, e := dao.Add(update); e != nil {
+		log.Logger(ctx).Error("cannot update password date of user "+u.Login, u.ZapUuid(), zap.Error(e))
+	}
+}
+
+// storedUser loads the current version of a user, if it exists.
+func storedUser(dao user.DAO, u *idm.User) *idm.User {
+	q := &idm.UserSingleQuery{Login: u.Login}
+	if u.Uuid != "" {
+		q = &idm.UserSingleQuery{Uuid: u.Uuid}
+	}
+	qA, _ := ptypes.MarshalAny(q)
+	var results []interface{}
+	if e := dao.Search(&service.Query{SubQueries: []*any.Any{qA}}, &results); e != nil || len(results) == 0 {
+		return nil
+	}
+	if stored, ok := results[0].(*idm.User); ok && !stored.IsGroup {
+		return stored
+	}
+	return nil
+}
+
 // DeleteUser from database
 func (h *Handler) DeleteUser(ctx context.Context, req *idm.DeleteUserRequest, response *idm.DeleteUserResponse) error {
 	if servicecontext.GetDAO(ctx) == nil {
@@ -251,6 +342,7 @@ func (h *Handler) DeleteUser(ctx context.Context, req *idm.DeleteUserRequest, re
 				if deleted != nil {
 					dao.DeletePoliciesForResource(deleted.Uuid)
 					if deleted.IsGroup {
+						h.clearRuleGroups()
 						dao.DeletePoliciesBySubject(fmt.Sprintf("role:%s", deleted.Uuid))
 					} else {
 						dao.DeletePoliciesBySubject(fmt.Sprintf("user:%s", deleted.Uuid))
@@ -320,6 +412,8 @@ func (h *Handler) SearchUser(ctx context.Context, request *idm.SearchUserRequest
 		return er
 	}
 
+	ruleGroups := h.loadRuleGroups(ctx, dao)
+
 	usersGroups := new([]interface{})
 	if err := dao.Search(request.Query, usersGroups); err != nil {
 		return err
@@ -333,6 +427,7 @@ func (h *Handler) SearchUser(ctx context.Context, request *idm.SearchUserRequest
 				log.Logger(ctx).Error("cannot load policies for user "+usr.Uuid, zap.Error(e))
 				continue
 			}
+			h.applyRuleGroups(usr, ruleGroups)
 			h.applyAutoApplies(usr, autoApplies)
 			response.Send(&idm.SearchUserResponse{User: usr})
 		} else {
@@ -384,10 +479,12 @@ func (h *Handler) StreamUser(ctx context.Context, streamer idm.UserService_Strea
 		if err := dao.Search(incoming.Query, users); err != nil {
 			return err
 		}
+		ruleGroups := h.loadRuleGroups(ctx, dao)
 
 		for _, in := range *users {
 			if usr, ok := in.(*idm.User); ok {
 				usr.Password = ""
+				h.applyRuleGroups(usr, ruleGroups)
 				h.applyAutoApplies(usr, autoApplies)
 				streamer.Send(&idm.SearchUserResponse{User: usr})
 			}
@@ -29,8 +29,10 @@ import (
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
+	"github.com/micro/go-micro/errors"
 	cache "github.com/patrickmn/go-cache"
 
+	"github.com/pydio/cells/common/auth"
 	"github.com/pydio/cells/common/config"
 	"github.com/pydio/cells/common/proto/idm"
 	"github.com/pydio/cells/common/service/context"
@@ -74,6 +76,9 @@ func TestMain(m *testing.M) {
 	}
 
 	ctx = servicecontext.WithDAO(context.Background(), mockDAO)
+	passwordPolicy = func() *auth.PasswordPolicy {
+		return &auth.PasswordPolicy{}
+	}
 
 	m.Run()
 	wg.Wait()
@@ -271,6 +276,85 @@ func TestUser(t *testing.T) {
 
 	})
 
+	Convey("Test password complexity", t, func() {
+
+		passwordPolicy = func() *auth.PasswordPolicy {
+			return &auth.PasswordPolicy{MinLength: 8}
+		}
+		defer func() {
+			passwordPolicy = func() *auth.PasswordPolicy {
+				return &auth.PasswordPolicy{}
+			}
+		}()
+
+		resp := new(idm.CreateUserResponse)
+		err := h.CreateUser(ctx, &idm.CreateUserRequest{User: &idm.User{Login: "lucy", Password: "short"}}, resp)
+		So(err, ShouldNotBeNil)
+		So(errors.Parse(err.Error()).Code, ShouldEqual, 403)
+
+		err = h.CreateUser(ctx, &idm.CreateUserRequest{User: &idm.User{Login: "lucy", Password: "long enough"}}, resp)
+		So(err, ShouldBeNil)
+
+		err = h.CreateUser(ctx, &idm.CreateUserRequest{User: &idm.User{
+			Login:      "lucy-link",
+			Password:   "short",
+			Attributes: map[string]string{"hidden": "true", "profile": "shared"},
+		}}, resp)
+		So(err, ShouldBeNil)
+
+	})
+
+}
+
+func TestRuleGroups(t *testing.T) {
+
+	h := new(Handler)
+
+	Convey("Test dynamic groups", t, func() {
+
+		err := h.CreateUser(ctx, &idm.CreateUserRequest{User: &idm.User{
+			IsGroup:    true,
+			GroupLabel: "rd-europe",
+			GroupPath:  "/rd/rd-europe",
+			Attributes: map[string]string{idm.UserAttrGroupRule: `department == "R&D" && country in [FR, DE]`},
+		}}, new(idm.CreateUserResponse))
+		So(err, ShouldBeNil)
+
+		err = h.CreateUser(ctx, &idm.CreateUserRequest{User: &idm.User{
+			IsGroup:    true,
+			GroupLabel: "invalid",
+			GroupPath:  "/invalid",
+			Attributes: map[string]string{idm.UserAttrGroupRule: `department ==`},
+		}}, new(idm.CreateUserResponse))
+		So(err, ShouldNotBeNil)
+
+		for login, country := range map[string]string{"frank": "FR", "ursula": "US"} {
+			err = h.CreateUser(ctx, &idm.CreateUserRequest{User: &idm.User{
+				Login:      login,
+				GroupPath:  "/",
+				Attributes: map[string]string{"department": "R&D", "country": country},
+			}}, new(idm.CreateUserResponse))
+			So(err, ShouldBeNil)
+		}
+
+		search := func(login string) *idm.User {
+			mock := &userStreamMock{}
+			q, _ := ptypes.MarshalAny(&idm.UserSingleQuery{Login: login})
+			h.SearchUser(ctx, &idm.SearchUserRequest{Query: &service.Query{SubQueries: []*any.Any{q}}}, mock)
+			So(mock.InternalBuffer, ShouldHaveLength, 1)
+			return mock.InternalBuffer[0]
+		}
+		frank := search("frank")
+		So(frank.Roles, ShouldHaveLength, 4)
+		So(frank.Roles[1].Label, ShouldEqual, "rd")
+		So(frank.Roles[1].GroupRole, ShouldBeTrue)
+		So(frank.Roles[2].Label, ShouldEqual, "rd-europe")
+		So(frank.Roles[2].GroupRole, ShouldBeTrue)
+		So(frank.Roles[3].UserRole, ShouldBeTrue)
+
+		So(search("ursula").Roles, ShouldHaveLength, 2)
+	})
+
 }
 
 // =================================================
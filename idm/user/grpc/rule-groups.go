@@ -0,0 +1,156 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package grpc
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/patrickmn/go-cache"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/proto/idm"
+	service "github.com/pydio/cells/common/service/proto"
+	"github.com/pydio/cells/idm/user"
+)
+
+var ruleGroupsCache *cache.Cache
+
+// ruleGroup is a dynamic group along with the roles granted to its members.
+type ruleGroup struct {
+	rule  *user.GroupRule
+	roles []*idm.Role
+}
+
+// applyRuleGroups adds the roles of the dynamic groups matching the user attributes. As for structural
+// groups, the members get the roles of the parent groups first, then the role of the group itself.
+// Membership is computed each time users are loaded, so that it always reflects their current attributes.
+func (h *Handler) applyRuleGroups(usr *idm.User, ruleGroups []*ruleGroup) {
+	if usr.IsGroup || usr.Login == common.PYDIO_S3ANON_USERNAME || len(ruleGroups) == 0 {
+		return
+	}
+	existing := make(map[string]struct{}, len(usr.Roles))
+	var head, tail []*idm.Role
+	for _, r := range usr.Roles {
+		existing[r.Uuid] = struct{}{}
+		if r.GroupRole {
+			head = append(head, r)
+		} else {
+			tail = append(tail, r)
+		}
+	}
+	for _, rg := range ruleGroups {
+		if !rg.rule.Match(usr) {
+			continue
+		}
+		for _, r := range rg.roles {
+			if _, ok := existing[r.Uuid]; !ok {
+				head = append(head, r)
+				existing[r.Uuid] = struct{}{}
+			}
+		}
+	}
+	usr.Roles = append(head, tail...)
+}
+
+// loadRuleGroups finds the groups defining a rule on their members attributes.
+func (h *Handler) loadRuleGroups(ctx context.Context, dao user.DAO) []*ruleGroup {
+
+	if ruleGroupsCache != nil {
+		if values, ok := ruleGroupsCache.Get("ruleGroups"); ok {
+			if ruleGroups, conv := values.([]*ruleGroup); conv {
+				return ruleGroups
+			}
+		}
+	}
+
+	var ruleGroups []*ruleGroup
+	q, _ := ptypes.MarshalAny(&idm.UserSingleQuery{
+		NodeType:          idm.NodeType_GROUP,
+		AttributeName:     idm.UserAttrGroupRule,
+		AttributeAnyValue: true,
+	})
+	var results []interface{}
+	if e := dao.Search(&service.Query{SubQueries: []*any.Any{q}}, &results); e != nil {
+		log.Logger(ctx).Error("cannot load dynamic groups", zap.Error(e))
+		return nil
+	}
+	for _, res := range results {
+		group, ok := res.(*idm.User)
+		if !ok || !group.IsGroup {
+			continue
+		}
+		// Groups are loaded with the path of their parent
+		groupPath := path.Join(group.GroupPath, group.GroupLabel)
+		rule, e := user.ParseGroupRule(group.Attributes[idm.UserAttrGroupRule])
+		if e != nil {
+			log.Logger(ctx).Warn("ignoring invalid rule of group "+groupPath, zap.Error(e))
+			continue
+		}
+		rg := &ruleGroup{rule: rule}
+		for _, parent := range parentGroups(dao, groupPath) {
+			rg.roles = append(rg.roles, &idm.Role{Uuid: parent.Uuid, Label: parent.GroupLabel, GroupRole: true})
+		}
+		rg.roles = append(rg.roles, &idm.Role{Uuid: group.Uuid, Label: group.GroupLabel, GroupRole: true})
+		ruleGroups = append(ruleGroups, rg)
+	}
+
+	if ruleGroupsCache == nil {
+		ruleGroupsCache = cache.New(10*time.Second, 20*time.Second)
+	}
+	ruleGroupsCache.Set("ruleGroups", ruleGroups, 0)
+
+	return ruleGroups
+}
+
+// clearRuleGroups forces reloading the dynamic groups after a group was modified.
+func (h *Handler) clearRuleGroups() {
+	if ruleGroupsCache != nil {
+		ruleGroupsCache.Delete("ruleGroups")
+	}
+}
+
+// parentGroups loads the groups above a group path, from the top-most one. The root group is ignored
+// as it applies to all users anyway.
+func parentGroups(dao user.DAO, groupPath string) (parents []*idm.User) {
+	var paths []string
+	for p := path.Dir(groupPath); p != "/" && p != "."; p = path.Dir(p) {
+		paths = append([]string{p}, paths...)
+	}
+	for _, p := range paths {
+		q, _ := ptypes.MarshalAny(&idm.UserSingleQuery{FullPath: p})
+		var results []interface{}
+		if e := dao.Search(&service.Query{SubQueries: []*any.Any{q}}, &results); e != nil {
+			continue
+		}
+		for _, res := range results {
+			if g, ok := res.(*idm.User); ok && g.IsGroup && path.Join(g.GroupPath, g.GroupLabel) == p {
+				parents = append(parents, g)
+			}
+		}
+	}
+	return
+}
@@ -0,0 +1,134 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package user
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/pydio/cells/common/proto/idm"
+)
+
+const (
+	// LockPassChange forces the user to change his password at next login
+	LockPassChange = "pass_change"
+)
+
+// PasswordReused checks if a clear password matches one of the hashes of the history.
+func PasswordReused(password string, hashes []string) bool {
+	for _, h := range hashes {
+		if h == "" {
+			continue
+		}
+		if valid, _ := hasher.CheckDBKDF2PydioPwd(password, h); valid {
+			return true
+		}
+		if valid, _ := hasher.CheckDBKDF2PydioPwd(password, h, true); valid {
+			return true
+		}
+	}
+	return false
+}
+
+// PasswordHistory lists the hashes of the current and previous passwords of a stored user, most recent first.
+func PasswordHistory(u *idm.User) []string {
+	var hashes []string
+	if u.Password != "" {
+		hashes = append(hashes, u.Password)
+	}
+	if data, ok := u.Attributes[idm.UserAttrPassHistory]; ok && data != "" {
+		var previous []string
+		if e := json.Unmarshal([]byte(data), &previous); e == nil {
+			hashes = append(hashes, previous...)
+		}
+	}
+	return hashes
+}
+
+// SetPasswordHistory records the hashes of the previous passwords, keeping at most size entries.
+func SetPasswordHistory(u *idm.User, history []string, size int) {
+	if u.Attributes == nil {
+		u.Attributes = make(map[string]string)
+	}
+	if len(history) > size {
+		history = history[:size]
+	}
+	if len(history) > 0 {
+		data, _ := json.Marshal(history)
+		u.Attributes[idm.UserAttrPassHistory] = string(data)
+	} else {
+		delete(u.Attributes, idm.UserAttrPassHistory)
+	}
+}
+
+// SetPasswordChanged records the date of the last password change.
+func SetPasswordChanged(u *idm.User, changed time.Time) {
+	if u.Attributes == nil {
+		u.Attributes = make(map[string]string)
+	}
+	u.Attributes[idm.UserAttrPassChanged] = strconv.FormatInt(changed.Unix(), 10)
+}
+
+// PasswordChanged reads the date of the last password change, if it was recorded.
+func PasswordChanged(u *idm.User) (time.Time, bool) {
+	if v, ok := u.Attributes[idm.UserAttrPassChanged]; ok {
+		if ts, e := strconv.ParseInt(v, 10, 64); e == nil {
+			return time.Unix(ts, 0), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// HasLock checks if the "locks" attribute of a user contains a given lock.
+func HasLock(u *idm.User, lock string) bool {
+	for _, l := range userLocks(u) {
+		if l == lock {
+			return true
+		}
+	}
+	return false
+}
+
+// SetLock adds or removes a lock in the "locks" attribute of a user.
+func SetLock(u *idm.User, lock string, set bool) {
+	var locks []string
+	for _, l := range userLocks(u) {
+		if l != lock {
+			locks = append(locks, l)
+		}
+	}
+	if set {
+		locks = append(locks, lock)
+	}
+	if u.Attributes == nil {
+		u.Attributes = make(map[string]string)
+	}
+	data, _ := json.Marshal(locks)
+	u.Attributes["locks"] = string(data)
+}
+
+func userLocks(u *idm.User) (locks []string) {
+	if l, ok := u.Attributes["locks"]; ok && l != "" {
+		json.Unmarshal([]byte(l), &locks)
+	}
+	return
+}
@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package user
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common/proto/idm"
+)
+
+func TestPasswordHistory(t *testing.T) {
+
+	Convey("Test history of hashes", t, func() {
+		u := &idm.User{Login: "john", Password: hasher.CreateHash("current")}
+		So(PasswordHistory(u), ShouldHaveLength, 1)
+
+		SetPasswordHistory(u, []string{hasher.CreateHash("previous1"), hasher.CreateHash("previous2"), hasher.CreateHash("previous3")}, 2)
+		SetPasswordChanged(u, time.Now())
+		history := PasswordHistory(u)
+		So(history, ShouldHaveLength, 3)
+		So(PasswordReused("current", history), ShouldBeTrue)
+		So(PasswordReused("previous2", history), ShouldBeTrue)
+		So(PasswordReused("previous3", history), ShouldBeFalse)
+		So(PasswordReused("other", history), ShouldBeFalse)
+
+		changed, ok := PasswordChanged(u)
+		So(ok, ShouldBeTrue)
+		So(time.Since(changed), ShouldBeLessThan, time.Minute)
+
+		SetPasswordHistory(u, PasswordHistory(u), 0)
+		_, has := u.Attributes[idm.UserAttrPassHistory]
+		So(has, ShouldBeFalse)
+	})
+
+	Convey("Test locks", t, func() {
+		u := &idm.User{Attributes: map[string]string{"locks": `["logout"]`}}
+		So(HasLock(u, LockPassChange), ShouldBeFalse)
+		SetLock(u, LockPassChange, true)
+		So(HasLock(u, LockPassChange), ShouldBeTrue)
+		So(HasLock(u, "logout"), ShouldBeTrue)
+		SetLock(u, LockPassChange, false)
+		So(u.Attributes["locks"], ShouldEqual, `["logout"]`)
+	})
+}
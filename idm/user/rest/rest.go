@@ -40,6 +40,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/auth"
 	"github.com/pydio/cells/common/log"
 	"github.com/pydio/cells/common/micro"
 	"github.com/pydio/cells/common/proto/idm"
@@ -408,6 +409,13 @@ func (s *UserHandler) PutUser(req *restful.Request, rsp *restful.Response) {
 			}
 		}
 	}
+	// Attributes passed to security policies are managed by administrators
+	if !inputUser.IsGroup && ctxClaims.Profile != common.PYDIO_PROFILE_ADMIN {
+		if e := checkManagedAttributes(update, &inputUser); e != nil {
+			service.RestError403(req, rsp, e)
+			return
+		}
+	}
 
 	// Check specific frontend USER_CREATE_USERS permission
 	var isHidden bool
@@ -426,6 +434,12 @@ func (s *UserHandler) PutUser(req *restful.Request, rsp *restful.Response) {
 		}
 		inputUser.GroupPath = strings.TrimSuffix(inputUser.GroupPath, "/") + "/" + inputUser.GroupLabel
 	} else {
+		if ctxClaims.Profile != common.PYDIO_PROFILE_ADMIN {
+			if e := s.checkRuleAttributes(ctx, update, &inputUser, cli); e != nil {
+				service.RestErrorDetect(req, rsp, e)
+				return
+			}
+		}
 		// Add a default profile
 		if _, ok := inputUser.Attributes[idm.UserAttrProfile]; !ok {
 			inputUser.Attributes[idm.UserAttrProfile] = common.PYDIO_PROFILE_SHARED
@@ -439,6 +453,12 @@ func (s *UserHandler) PutUser(req *restful.Request, rsp *restful.Response) {
 			service.RestError403(req, rsp, fmt.Errorf("you are not allowed to use this attribute"))
 			return
 		}
+		if inputUser.Password != "" {
+			if e := auth.DefaultPasswordPolicy().Validate(inputUser.Password); e != nil {
+				service.RestError403(req, rsp, e)
+				return
+			}
+		}
 	}
 
 	var acls []*idm.ACL
@@ -474,7 +494,7 @@ func (s *UserHandler) PutUser(req *restful.Request, rsp *restful.Response) {
 		User: &inputUser,
 	})
 	if er != nil {
-		service.RestError500(req, rsp, er)
+		service.RestErrorDetect(req, rsp, er)
 		return
 	}
 
@@ -765,6 +785,35 @@ func (s *UserHandler) diffRoles(as []*idm.Role, bs []*idm.Role) (diff []*idm.Rol
 	return
 }
 
+// checkManagedAttributes prevents non-admin users from editing the managed attributes, that are passed to security
+// policies, on their own account or on the users they manage. Missing values are restored from the existing user.
+// The profile is not checked, as it cannot be raised above the editor profile.
+func checkManagedAttributes(existing *idm.User, input *idm.User) error {
+	for name := range permissions.ManagedUserAttributes() {
+		if name == idm.UserAttrProfile {
+			continue
+		}
+		var current string
+		if existing != nil {
+			current = existing.Attributes[name]
+		}
+		value, ok := input.Attributes[name]
+		if !ok {
+			if current != "" {
+				if input.Attributes == nil {
+					input.Attributes = map[string]string{}
+				}
+				input.Attributes[name] = current
+			}
+			continue
+		}
+		if value != current {
+			return fmt.Errorf("you are not allowed to change the %s attribute, it is managed by an administrator", name)
+		}
+	}
+	return nil
+}
+
 // Loads an existing user by his Uuid.
 func (s *UserHandler) userById(ctx context.Context, userId string, cli idm.UserServiceClient) (user *idm.User, exists bool) {
 
@@ -0,0 +1,160 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package rest
+
+import (
+	"context"
+
+	"github.com/emicklei/go-restful"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/micro/go-micro/errors"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/micro"
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/rest"
+	"github.com/pydio/cells/common/service"
+	service2 "github.com/pydio/cells/common/service/proto"
+	"github.com/pydio/cells/common/utils/permissions"
+	"github.com/pydio/cells/idm/user"
+)
+
+// PreviewGroupRule lists the users that are members of a dynamic group using the given rule.
+func (s *UserHandler) PreviewGroupRule(req *restful.Request, rsp *restful.Response) {
+
+	ctx := req.Request.Context()
+	if _, claims := permissions.FindUserNameInContext(ctx); claims.Profile != common.PYDIO_PROFILE_ADMIN {
+		service.RestError403(req, rsp, errors.Forbidden(common.SERVICE_USER, "you are not allowed to manage groups"))
+		return
+	}
+	var previewReq rest.GroupRulePreviewRequest
+	if e := req.ReadEntity(&previewReq); e != nil {
+		service.RestError500(req, rsp, e)
+		return
+	}
+	rule, e := user.ParseGroupRule(previewReq.Rule)
+	if e != nil {
+		service.RestErrorDetect(req, rsp, errors.BadRequest(common.SERVICE_USER, "invalid group rule: %s", e.Error()))
+		return
+	}
+	limit := previewReq.Limit
+	if limit <= 0 {
+		limit = 100
+	}
+
+	q, _ := ptypes.MarshalAny(&idm.UserSingleQuery{NodeType: idm.NodeType_USER})
+	query := &service2.Query{SubQueries: []*any.Any{q}}
+	if query.ResourcePolicyQuery, e = s.RestToServiceResourcePolicy(ctx, nil); e != nil {
+		service.RestError403(req, rsp, e)
+		return
+	}
+	cli := idm.NewUserServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_USER, defaults.NewClient())
+	streamer, e := cli.SearchUser(ctx, &idm.SearchUserRequest{Query: query})
+	if e != nil {
+		service.RestError500(req, rsp, e)
+		return
+	}
+	defer streamer.Close()
+
+	response := &rest.UsersCollection{}
+	var matches int64
+	for {
+		resp, er := streamer.Recv()
+		if er != nil {
+			break
+		}
+		if resp == nil || !rule.Match(resp.User) {
+			continue
+		}
+		matches++
+		if matches > previewReq.Offset && int64(len(response.Users)) < limit {
+			u := resp.User
+			response.Users = append(response.Users, u.WithPublicData(ctx, s.IsContextEditable(ctx, u.Uuid, u.Policies)))
+		}
+	}
+	response.Total = int32(matches)
+
+	rsp.WriteEntity(response)
+}
+
+// ruleAttributes lists the attributes used by the rules of dynamic groups.
+func (s *UserHandler) ruleAttributes(ctx context.Context, cli idm.UserServiceClient) (map[string]bool, error) {
+	q, _ := ptypes.MarshalAny(&idm.UserSingleQuery{
+		NodeType:          idm.NodeType_GROUP,
+		AttributeName:     idm.UserAttrGroupRule,
+		AttributeAnyValue: true,
+	})
+	streamer, e := cli.SearchUser(ctx, &idm.SearchUserRequest{Query: &service2.Query{SubQueries: []*any.Any{q}}})
+	if e != nil {
+		return nil, e
+	}
+	defer streamer.Close()
+	attributes := make(map[string]bool)
+	for {
+		resp, er := streamer.Recv()
+		if er != nil {
+			break
+		}
+		if resp == nil || !resp.User.IsGroup {
+			continue
+		}
+		if rule, e := user.ParseGroupRule(resp.User.Attributes[idm.UserAttrGroupRule]); e == nil {
+			for _, name := range rule.Attributes() {
+				attributes[name] = true
+			}
+		}
+	}
+	return attributes, nil
+}
+
+// checkRuleAttributes prevents non-admin users from joining dynamic groups by editing the attributes
+// used by their rules, on their own account or on the users they manage. Missing values are restored
+// from the existing user. The profile is not checked, as it cannot be raised above the editor profile.
+func (s *UserHandler) checkRuleAttributes(ctx context.Context, existing *idm.User, input *idm.User, cli idm.UserServiceClient) error {
+	names, e := s.ruleAttributes(ctx, cli)
+	if e != nil {
+		return e
+	}
+	for name := range names {
+		if name == idm.UserAttrProfile {
+			continue
+		}
+		var current string
+		if existing != nil {
+			current = existing.Attributes[name]
+		}
+		value, ok := input.Attributes[name]
+		if !ok {
+			if current != "" {
+				if input.Attributes == nil {
+					input.Attributes = map[string]string{}
+				}
+				input.Attributes[name] = current
+			}
+			continue
+		}
+		if value != current {
+			return errors.Forbidden(common.SERVICE_USER, "you are not allowed to change the %s attribute, it is managed by an administrator", name)
+		}
+	}
+	return nil
+}
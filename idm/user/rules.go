@@ -0,0 +1,367 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package user
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/pydio/cells/common/proto/idm"
+)
+
+const (
+	// RuleFieldLogin and RuleFieldGroupPath can be used in rules in addition to the user attributes
+	RuleFieldLogin     = "login"
+	RuleFieldGroupPath = "groupPath"
+)
+
+// GroupRule computes the membership of a dynamic group from the users attributes. Rules combine comparisons
+// with &&, || and ! operators and parentheses, for example:
+//
+//	department == "R&D" && country in [FR, DE]
+//	groupPath under /partners && !(profile == shared)
+//
+// Comparisons support ==, !=, in [list] and under, which matches a path and all the paths below it.
+// Values are compared as strings, an attribute that is not set is an empty string.
+type GroupRule struct {
+	expression string
+	root       ruleNode
+}
+
+// ParseGroupRule compiles a rule expression.
+func ParseGroupRule(expression string) (*GroupRule, error) {
+	tokens, e := tokenizeRule(expression)
+	if e != nil {
+		return nil, e
+	}
+	p := &ruleParser{tokens: tokens}
+	root, e := p.parseOr()
+	if e != nil {
+		return nil, e
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected %s in rule", p.tokens[p.pos].text)
+	}
+	return &GroupRule{expression: expression, root: root}, nil
+}
+
+// Match checks if a user is a member of the group. Groups never match.
+func (r *GroupRule) Match(u *idm.User) bool {
+	if u.IsGroup {
+		return false
+	}
+	return r.root.match(u)
+}
+
+// String returns the original expression.
+func (r *GroupRule) String() string {
+	return r.expression
+}
+
+// Attributes lists the user attributes used by the rule, login and groupPath excepted.
+func (r *GroupRule) Attributes() []string {
+	var names []string
+	seen := make(map[string]bool)
+	var walk func(n ruleNode)
+	walk = func(n ruleNode) {
+		switch t := n.(type) {
+		case *ruleAnd:
+			walk(t.left)
+			walk(t.right)
+		case *ruleOr:
+			walk(t.left)
+			walk(t.right)
+		case *ruleNot:
+			walk(t.node)
+		case *ruleCompare:
+			if t.field != RuleFieldLogin && t.field != RuleFieldGroupPath && !seen[t.field] {
+				seen[t.field] = true
+				names = append(names, t.field)
+			}
+		}
+	}
+	walk(r.root)
+	return names
+}
+
+func ruleFieldValue(u *idm.User, field string) string {
+	switch field {
+	case RuleFieldLogin:
+		return u.Login
+	case RuleFieldGroupPath:
+		return u.GroupPath
+	}
+	return u.Attributes[field]
+}
+
+type ruleNode interface {
+	match(u *idm.User) bool
+}
+
+type ruleAnd struct{ left, right ruleNode }
+
+func (n *ruleAnd) match(u *idm.User) bool { return n.left.match(u) && n.right.match(u) }
+
+type ruleOr struct{ left, right ruleNode }
+
+func (n *ruleOr) match(u *idm.User) bool { return n.left.match(u) || n.right.match(u) }
+
+type ruleNot struct{ node ruleNode }
+
+func (n *ruleNot) match(u *idm.User) bool { return !n.node.match(u) }
+
+type ruleCompare struct {
+	field  string
+	op     string
+	values []string
+}
+
+func (n *ruleCompare) match(u *idm.User) bool {
+	v := ruleFieldValue(u, n.field)
+	switch n.op {
+	case "==":
+		return v == n.values[0]
+	case "!=":
+		return v != n.values[0]
+	case "in":
+		for _, candidate := range n.values {
+			if v == candidate {
+				return true
+			}
+		}
+	case "under":
+		parent := strings.TrimRight(n.values[0], "/")
+		return v == parent || strings.HasPrefix(v, parent+"/")
+	}
+	return false
+}
+
+type ruleTokenKind int
+
+const (
+	ruleTokenWord ruleTokenKind = iota
+	ruleTokenString
+	ruleTokenSymbol
+)
+
+type ruleToken struct {
+	kind ruleTokenKind
+	text string
+}
+
+func (t ruleToken) is(symbol string) bool {
+	return t.kind == ruleTokenSymbol && t.text == symbol
+}
+
+func isRuleWordChar(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("_-.:@/", r)
+}
+
+func tokenizeRule(expression string) ([]ruleToken, error) {
+	var tokens []ruleToken
+	runes := []rune(expression)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '"':
+			var value []rune
+			closed := false
+			for i++; i < len(runes); i++ {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+					value = append(value, runes[i])
+				} else if runes[i] == '"' {
+					closed = true
+					i++
+					break
+				} else {
+					value = append(value, runes[i])
+				}
+			}
+			if !closed {
+				return nil, fmt.Errorf("unterminated string in rule")
+			}
+			tokens = append(tokens, ruleToken{kind: ruleTokenString, text: string(value)})
+		case isRuleWordChar(r):
+			start := i
+			for i < len(runes) && isRuleWordChar(runes[i]) {
+				i++
+			}
+			tokens = append(tokens, ruleToken{kind: ruleTokenWord, text: string(runes[start:i])})
+		default:
+			if i+1 < len(runes) {
+				if two := string(runes[i : i+2]); two == "==" || two == "!=" || two == "&&" || two == "||" {
+					tokens = append(tokens, ruleToken{kind: ruleTokenSymbol, text: two})
+					i += 2
+					continue
+				}
+			}
+			if !strings.ContainsRune("!()[],", r) {
+				return nil, fmt.Errorf("unexpected character %q in rule", r)
+			}
+			tokens = append(tokens, ruleToken{kind: ruleTokenSymbol, text: string(r)})
+			i++
+		}
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("empty rule")
+	}
+	return tokens, nil
+}
+
+type ruleParser struct {
+	tokens []ruleToken
+	pos    int
+}
+
+func (p *ruleParser) peek() (ruleToken, bool) {
+	if p.pos >= len(p.tokens) {
+		return ruleToken{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+func (p *ruleParser) next() (ruleToken, error) {
+	t, ok := p.peek()
+	if !ok {
+		return t, fmt.Errorf("unexpected end of rule")
+	}
+	p.pos++
+	return t, nil
+}
+
+func (p *ruleParser) parseOr() (ruleNode, error) {
+	left, e := p.parseAnd()
+	if e != nil {
+		return nil, e
+	}
+	for t, ok := p.peek(); ok && t.is("||"); t, ok = p.peek() {
+		p.pos++
+		right, e := p.parseAnd()
+		if e != nil {
+			return nil, e
+		}
+		left = &ruleOr{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *ruleParser) parseAnd() (ruleNode, error) {
+	left, e := p.parseUnary()
+	if e != nil {
+		return nil, e
+	}
+	for t, ok := p.peek(); ok && t.is("&&"); t, ok = p.peek() {
+		p.pos++
+		right, e := p.parseUnary()
+		if e != nil {
+			return nil, e
+		}
+		left = &ruleAnd{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *ruleParser) parseUnary() (ruleNode, error) {
+	t, e := p.next()
+	if e != nil {
+		return nil, e
+	}
+	if t.is("!") {
+		node, e := p.parseUnary()
+		if e != nil {
+			return nil, e
+		}
+		return &ruleNot{node: node}, nil
+	}
+	if t.is("(") {
+		node, e := p.parseOr()
+		if e != nil {
+			return nil, e
+		}
+		if closing, e := p.next(); e != nil || !closing.is(")") {
+			return nil, fmt.Errorf("missing closing parenthesis in rule")
+		}
+		return node, nil
+	}
+	if t.kind != ruleTokenWord {
+		return nil, fmt.Errorf("expected an attribute name, found %s", t.text)
+	}
+	return p.parseComparison(t.text)
+}
+
+func (p *ruleParser) parseComparison(field string) (ruleNode, error) {
+	op, e := p.next()
+	if e != nil {
+		return nil, e
+	}
+	switch {
+	case op.is("==") || op.is("!="):
+		value, e := p.parseValue()
+		if e != nil {
+			return nil, e
+		}
+		return &ruleCompare{field: field, op: op.text, values: []string{value}}, nil
+	case op.kind == ruleTokenWord && op.text == "under":
+		value, e := p.parseValue()
+		if e != nil {
+			return nil, e
+		}
+		return &ruleCompare{field: field, op: op.text, values: []string{value}}, nil
+	case op.kind == ruleTokenWord && op.text == "in":
+		if open, e := p.next(); e != nil || !open.is("[") {
+			return nil, fmt.Errorf("expected a [list] after in")
+		}
+		c := &ruleCompare{field: field, op: op.text}
+		for {
+			value, e := p.parseValue()
+			if e != nil {
+				return nil, e
+			}
+			c.values = append(c.values, value)
+			sep, e := p.next()
+			if e != nil {
+				return nil, e
+			}
+			if sep.is("]") {
+				return c, nil
+			}
+			if !sep.is(",") {
+				return nil, fmt.Errorf("unexpected %s in list", sep.text)
+			}
+		}
+	}
+	return nil, fmt.Errorf("unsupported operator %s after %s", op.text, field)
+}
+
+func (p *ruleParser) parseValue() (string, error) {
+	t, e := p.next()
+	if e != nil {
+		return "", e
+	}
+	if t.kind == ruleTokenSymbol {
+		return "", fmt.Errorf("expected a value, found %s", t.text)
+	}
+	return t.text, nil
+}
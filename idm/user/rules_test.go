@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package user
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common/proto/idm"
+)
+
+func TestGroupRule(t *testing.T) {
+
+	alice := &idm.User{Login: "alice", GroupPath: "/rd/paris", Attributes: map[string]string{"department": "R&D", "country": "FR", "profile": "standard"}}
+	bob := &idm.User{Login: "bob", GroupPath: "/sales", Attributes: map[string]string{"department": "Sales", "country": "DE", "profile": "standard"}}
+	carol := &idm.User{Login: "carol", GroupPath: "/rd", Attributes: map[string]string{"department": "R&D", "country": "US", "profile": "shared"}}
+
+	Convey("Parse rules", t, func() {
+		for _, invalid := range []string{"", "department", "department ==", "department == R&D", "country in [FR,", "(a == b", "a == b c == d", "a ~ b", `a == "b`} {
+			_, e := ParseGroupRule(invalid)
+			So(e, ShouldNotBeNil)
+		}
+		r, e := ParseGroupRule(`department == "R&D" && country in [FR, DE]`)
+		So(e, ShouldBeNil)
+		So(r.String(), ShouldEqual, `department == "R&D" && country in [FR, DE]`)
+		So(r.Attributes(), ShouldResemble, []string{"department", "country"})
+		r, _ = ParseGroupRule(`groupPath under /rd && !(profile == shared) || login == bob || profile == admin`)
+		So(r.Attributes(), ShouldResemble, []string{"profile"})
+	})
+
+	Convey("Match users", t, func() {
+		r, _ := ParseGroupRule(`department == "R&D" && country in [FR, DE]`)
+		So(r.Match(alice), ShouldBeTrue)
+		So(r.Match(bob), ShouldBeFalse)
+		So(r.Match(carol), ShouldBeFalse)
+		So(r.Match(&idm.User{IsGroup: true, Attributes: map[string]string{"department": "R&D", "country": "FR"}}), ShouldBeFalse)
+
+		r, _ = ParseGroupRule(`groupPath under /rd && !(profile == shared) || login == bob`)
+		So(r.Match(alice), ShouldBeTrue)
+		So(r.Match(bob), ShouldBeTrue)
+		So(r.Match(carol), ShouldBeFalse)
+
+		r, _ = ParseGroupRule(`groupPath under "/rd/" && (country != FR)`)
+		So(r.Match(alice), ShouldBeFalse)
+		So(r.Match(carol), ShouldBeTrue)
+		So(r.Match(&idm.User{GroupPath: "/rdx", Attributes: map[string]string{"country": "US"}}), ShouldBeFalse)
+
+		r, _ = ParseGroupRule(`title == ""`)
+		So(r.Match(alice), ShouldBeTrue)
+	})
+}
@@ -0,0 +1,175 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/emicklei/go-restful"
+	"github.com/micro/go-micro/errors"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/auth/claim"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/proto/rest"
+	service2 "github.com/pydio/cells/common/service"
+	"github.com/pydio/cells/idm/workspace/templates"
+)
+
+// ListWorkspaceTemplates lists all workspace templates.
+func (h *WorkspaceHandler) ListWorkspaceTemplates(req *restful.Request, rsp *restful.Response) {
+
+	ctx := req.Request.Context()
+	if e := h.checkAdmin(ctx); e != nil {
+		service2.RestError403(req, rsp, e)
+		return
+	}
+	tpls, e := templates.ListTemplates(ctx)
+	if e != nil {
+		service2.RestError500(req, rsp, e)
+		return
+	}
+	rsp.WriteEntity(&rest.WorkspaceTemplateCollection{Templates: tpls})
+
+}
+
+// PutWorkspaceTemplate creates or updates a template, then re-synchronizes the workspaces already created from it.
+func (h *WorkspaceHandler) PutWorkspaceTemplate(req *restful.Request, rsp *restful.Response) {
+
+	ctx := req.Request.Context()
+	if e := h.checkAdmin(ctx); e != nil {
+		service2.RestError403(req, rsp, e)
+		return
+	}
+	var tpl rest.WorkspaceTemplate
+	if e := req.ReadEntity(&tpl); e != nil {
+		service2.RestError500(req, rsp, e)
+		return
+	}
+	tpl.Uuid = req.PathParameter("Uuid")
+	if tpl.Workspace == nil || tpl.Workspace.Label == "" || len(tpl.RootPathPatterns) == 0 {
+		service2.RestErrorDetect(req, rsp, errors.BadRequest(common.SERVICE_WORKSPACE, "Template must define a workspace label and at least one root path"))
+		return
+	}
+	tpl.UpdatedAt = time.Now().Unix()
+	if e := templates.StoreTemplate(ctx, &tpl); e != nil {
+		service2.RestError500(req, rsp, e)
+		return
+	}
+	response, e := templates.Sync(ctx, &tpl)
+	if e != nil {
+		service2.RestError500(req, rsp, e)
+		return
+	}
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("Updated workspace template [%s], %d workspaces synchronized", tpl.Label, len(response.Instances)),
+		log.GetAuditId(common.AUDIT_WS_UPDATE),
+	)
+	rsp.WriteEntity(response)
+
+}
+
+// DeleteWorkspaceTemplate removes a template. Its instances are kept as regular workspaces.
+func (h *WorkspaceHandler) DeleteWorkspaceTemplate(req *restful.Request, rsp *restful.Response) {
+
+	ctx := req.Request.Context()
+	if e := h.checkAdmin(ctx); e != nil {
+		service2.RestError403(req, rsp, e)
+		return
+	}
+	id := req.PathParameter("Uuid")
+	if _, e := templates.LoadTemplate(ctx, id); e != nil {
+		service2.RestErrorDetect(req, rsp, e)
+		return
+	}
+	if e := templates.Detach(ctx, id); e != nil {
+		service2.RestError500(req, rsp, e)
+		return
+	}
+	if e := templates.DeleteTemplate(ctx, id); e != nil {
+		service2.RestError500(req, rsp, e)
+		return
+	}
+	rsp.WriteEntity(&rest.DeleteResponse{Success: true, NumRows: 1})
+
+}
+
+// InstantiateWorkspaceTemplate creates or updates the workspaces of a template for a list of users or groups.
+func (h *WorkspaceHandler) InstantiateWorkspaceTemplate(req *restful.Request, rsp *restful.Response) {
+
+	ctx := req.Request.Context()
+	if e := h.checkAdmin(ctx); e != nil {
+		service2.RestError403(req, rsp, e)
+		return
+	}
+	var input rest.InstantiateWorkspaceTemplateRequest
+	if e := req.ReadEntity(&input); e != nil {
+		service2.RestError500(req, rsp, e)
+		return
+	}
+	tpl, e := templates.LoadTemplate(ctx, req.PathParameter("TemplateUuid"))
+	if e != nil {
+		service2.RestErrorDetect(req, rsp, e)
+		return
+	}
+	response, e := templates.Instantiate(ctx, tpl, input.Targets)
+	if e != nil {
+		service2.RestError500(req, rsp, e)
+		return
+	}
+	if len(response.Errors) > 0 {
+		log.Logger(ctx).Error("Some workspaces could not be provisioned", zap.String("template", tpl.Uuid), zap.Strings("errors", response.Errors))
+	}
+	rsp.WriteEntity(response)
+
+}
+
+// SyncWorkspaceTemplate re-applies a template to all its instances, e.g. after the attributes of their targets changed.
+func (h *WorkspaceHandler) SyncWorkspaceTemplate(req *restful.Request, rsp *restful.Response) {
+
+	ctx := req.Request.Context()
+	if e := h.checkAdmin(ctx); e != nil {
+		service2.RestError403(req, rsp, e)
+		return
+	}
+	tpl, e := templates.LoadTemplate(ctx, req.PathParameter("TemplateUuid"))
+	if e != nil {
+		service2.RestErrorDetect(req, rsp, e)
+		return
+	}
+	response, e := templates.Sync(ctx, tpl)
+	if e != nil {
+		service2.RestError500(req, rsp, e)
+		return
+	}
+	rsp.WriteEntity(response)
+
+}
+
+func (h *WorkspaceHandler) checkAdmin(ctx context.Context) error {
+	if claims, ok := ctx.Value(claim.ContextKey).(claim.Claims); !ok || claims.Profile != common.PYDIO_PROFILE_ADMIN {
+		return errors.Forbidden(common.SERVICE_WORKSPACE, "Only admins can manage workspace templates")
+	}
+	return nil
+}
@@ -0,0 +1,268 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package templates
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/micro/go-micro/errors"
+	"github.com/pborman/uuid"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/micro"
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/rest"
+	"github.com/pydio/cells/common/proto/tree"
+	"github.com/pydio/cells/common/service/proto"
+	"github.com/pydio/cells/common/utils/permissions"
+	"github.com/pydio/cells/common/views"
+)
+
+// defaultPolicies are the policies of the workspaces created by the REST service.
+func defaultPolicies() []*service.ResourcePolicy {
+	return []*service.ResourcePolicy{
+		{Subject: "profile:standard", Action: service.ResourcePolicyAction_READ, Effect: service.ResourcePolicy_allow},
+		{Subject: "profile:" + common.PYDIO_PROFILE_ADMIN, Action: service.ResourcePolicyAction_WRITE, Effect: service.ResourcePolicy_allow},
+	}
+}
+
+// Instantiate creates the workspaces of a template for each target, or updates them if they already exist.
+// Targets that cannot be processed are reported in the response errors.
+func Instantiate(ctx context.Context, tpl *rest.WorkspaceTemplate, targets []*rest.WorkspaceTemplateTarget) (*rest.WorkspaceTemplateResponse, error) {
+	instances, e := ListInstances(ctx, tpl.Uuid)
+	if e != nil {
+		return nil, e
+	}
+	response := &rest.WorkspaceTemplateResponse{Template: tpl}
+	for _, target := range targets {
+		if target.UserLogin == "" && target.GroupPath == "" {
+			response.Errors = append(response.Errors, "target must have a user login or a group path")
+			continue
+		}
+		existing := instances[TargetKey(target)]
+		if ws, e := Provision(ctx, tpl, target, existing); e != nil {
+			response.Errors = append(response.Errors, fmt.Sprintf("%s: %s", TargetKey(target), errors.Parse(e.Error()).Detail))
+		} else {
+			response.Instances = append(response.Instances, ws)
+		}
+	}
+	return response, nil
+}
+
+// Sync re-applies a template to all its existing instances, reloading the attributes of their targets.
+func Sync(ctx context.Context, tpl *rest.WorkspaceTemplate) (*rest.WorkspaceTemplateResponse, error) {
+	instances, e := ListInstances(ctx, tpl.Uuid)
+	if e != nil {
+		return nil, e
+	}
+	response := &rest.WorkspaceTemplateResponse{Template: tpl}
+	for key, ws := range instances {
+		_, target := InstanceOf(ws)
+		if updated, e := Provision(ctx, tpl, target, ws); e != nil {
+			response.Errors = append(response.Errors, fmt.Sprintf("%s: %s", key, errors.Parse(e.Error()).Detail))
+		} else {
+			response.Instances = append(response.Instances, updated)
+		}
+	}
+	return response, nil
+}
+
+// Detach removes the link between a template and its instances, that are kept as regular workspaces.
+func Detach(ctx context.Context, templateUuid string) error {
+	instances, e := ListInstances(ctx, templateUuid)
+	if e != nil {
+		return e
+	}
+	wsClient := idm.NewWorkspaceServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_WORKSPACE, defaults.NewClient())
+	for _, ws := range instances {
+		SetAttributes(ws, map[string]interface{}{AttributeTemplateUuid: nil, AttributeTemplateTarget: nil})
+		if _, e := wsClient.CreateWorkspace(ctx, &idm.CreateWorkspaceRequest{Workspace: ws}); e != nil {
+			return e
+		}
+	}
+	return nil
+}
+
+// ListInstances finds the workspaces created from a template, indexed by target key.
+func ListInstances(ctx context.Context, templateUuid string) (map[string]*idm.Workspace, error) {
+	wsClient := idm.NewWorkspaceServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_WORKSPACE, defaults.NewClient())
+	q, _ := ptypes.MarshalAny(&idm.WorkspaceSingleQuery{Scope: idm.WorkspaceScope_ADMIN})
+	stream, e := wsClient.SearchWorkspace(ctx, &idm.SearchWorkspaceRequest{Query: &service.Query{SubQueries: []*any.Any{q}}})
+	if e != nil {
+		return nil, e
+	}
+	defer stream.Close()
+	instances := make(map[string]*idm.Workspace)
+	for {
+		resp, e := stream.Recv()
+		if e != nil {
+			break
+		}
+		if resp == nil || resp.Workspace == nil {
+			continue
+		}
+		if tplUuid, target := InstanceOf(resp.Workspace); tplUuid == templateUuid && target != nil {
+			instances[TargetKey(target)] = resp.Workspace
+		}
+	}
+	return instances, nil
+}
+
+// Provision renders the template for a target and creates or updates the corresponding workspace,
+// its root folders and its ACLs.
+func Provision(ctx context.Context, tpl *rest.WorkspaceTemplate, target *rest.WorkspaceTemplateTarget, existing *idm.Workspace) (*idm.Workspace, error) {
+	user, e := LoadTarget(ctx, target)
+	if e != nil {
+		return nil, e
+	}
+	instance, e := RenderInstance(tpl, target, TargetVariables(user, target.Variables))
+	if e != nil {
+		return nil, e
+	}
+	ws := instance.Workspace
+	if existing != nil {
+		ws.UUID = existing.UUID
+		if ws.Slug == "" {
+			ws.Slug = existing.Slug
+		}
+		if len(ws.Policies) == 0 {
+			ws.Policies = existing.Policies
+		}
+	} else {
+		ws.UUID = uuid.New()
+	}
+	if len(ws.Policies) == 0 {
+		ws.Policies = defaultPolicies()
+	}
+
+	var roots []*tree.Node
+	for _, p := range instance.RootPaths {
+		node, e := ensureFolder(ctx, p)
+		if e != nil {
+			return nil, e
+		}
+		roots = append(roots, node)
+	}
+
+	wsClient := idm.NewWorkspaceServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_WORKSPACE, defaults.NewClient())
+	resp, e := wsClient.CreateWorkspace(ctx, &idm.CreateWorkspaceRequest{Workspace: ws})
+	if e != nil {
+		return nil, e
+	}
+	if e := applyAcls(ctx, ws.UUID, roots, instance.Acls, existing != nil); e != nil {
+		return nil, e
+	}
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("Provisioned workspace [%s] from template [%s]", resp.Workspace.Slug, tpl.Label),
+		log.GetAuditId(common.AUDIT_WS_UPDATE),
+		resp.Workspace.ZapUuid(),
+	)
+	return resp.Workspace, nil
+}
+
+// LoadTarget loads the user or the group of a target.
+func LoadTarget(ctx context.Context, target *rest.WorkspaceTemplateTarget) (*idm.User, error) {
+	var q *idm.UserSingleQuery
+	if target.UserLogin != "" {
+		q = &idm.UserSingleQuery{Login: target.UserLogin}
+	} else {
+		q = &idm.UserSingleQuery{FullPath: "/" + strings.Trim(target.GroupPath, "/"), NodeType: idm.NodeType_GROUP}
+	}
+	user, e := permissions.SearchUniqueUser(ctx, "", "", q)
+	if e != nil {
+		return nil, errors.NotFound(common.SERVICE_WORKSPACE, "Cannot find %s", TargetKey(target))
+	}
+	return user, nil
+}
+
+// ensureFolder loads a root folder, creating it and its missing parents.
+func ensureFolder(ctx context.Context, folderPath string) (*tree.Node, error) {
+	router := views.NewStandardRouter(views.RouterOptions{WatchRegistry: false, AdminView: true})
+	if resp, e := router.ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Path: folderPath}}); e == nil && resp.Node != nil {
+		return resp.Node, nil
+	}
+	parts := strings.Split(folderPath, "/")
+	var node *tree.Node
+	for i := 2; i <= len(parts); i++ {
+		p := path.Join(parts[:i]...)
+		if resp, e := router.ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Path: p}}); e == nil && resp.Node != nil {
+			node = resp.Node
+			continue
+		}
+		resp, e := router.CreateNode(ctx, &tree.CreateNodeRequest{Node: &tree.Node{Path: p, Type: tree.NodeType_COLLECTION}})
+		if e != nil {
+			return nil, e
+		}
+		node = resp.Node
+	}
+	if node == nil {
+		return nil, errors.NotFound(common.SERVICE_WORKSPACE, "Cannot find root %s", folderPath)
+	}
+	return node, nil
+}
+
+// applyAcls replaces the roots and the nodes ACLs of a workspace.
+func applyAcls(ctx context.Context, workspaceId string, roots []*tree.Node, presets []*rest.WorkspaceTemplateAcl, update bool) error {
+	aclClient := idm.NewACLServiceClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_ACL, defaults.NewClient())
+	if update {
+		// Same queries as the workspaces REST service, role parameters are kept
+		rootsQuery, _ := ptypes.MarshalAny(&idm.ACLSingleQuery{
+			WorkspaceIDs: []string{workspaceId},
+			Actions:      []*idm.ACLAction{{Name: permissions.AclWsrootActionName}, {Name: permissions.AclRecycleRoot.Name}},
+		})
+		q1, _ := ptypes.MarshalAny(&idm.ACLSingleQuery{WorkspaceIDs: []string{workspaceId}})
+		q2, _ := ptypes.MarshalAny(&idm.ACLSingleQuery{NodeIDs: []string{"-1"}, Not: true})
+		q3, _ := ptypes.MarshalAny(&idm.ACLSingleQuery{RoleIDs: []string{"-1"}, Not: true})
+		for _, q := range []*service.Query{
+			{SubQueries: []*any.Any{rootsQuery}},
+			{SubQueries: []*any.Any{q1, q2, q3}, Operation: service.OperationType_AND},
+		} {
+			if _, e := aclClient.DeleteACL(ctx, &idm.DeleteACLRequest{Query: q}); e != nil {
+				return e
+			}
+		}
+	}
+	for _, node := range roots {
+		acls := []*idm.ACL{
+			{WorkspaceID: workspaceId, NodeID: node.Uuid, Action: &idm.ACLAction{Name: permissions.AclWsrootActionName, Value: node.GetPath()}},
+			{WorkspaceID: workspaceId, NodeID: node.Uuid, Action: permissions.AclRecycleRoot},
+		}
+		for _, preset := range presets {
+			for _, action := range preset.Actions {
+				acls = append(acls, &idm.ACL{WorkspaceID: workspaceId, NodeID: node.Uuid, RoleID: preset.RoleId, Action: action})
+			}
+		}
+		for _, acl := range acls {
+			if _, e := aclClient.CreateACL(ctx, &idm.CreateACLRequest{ACL: acl}); e != nil {
+				log.Logger(ctx).Error("Cannot create ACL for template instance", zap.String("workspace", workspaceId), zap.Error(e))
+				return e
+			}
+		}
+	}
+	return nil
+}
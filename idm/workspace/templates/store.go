@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package templates
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/micro/go-micro/errors"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/micro"
+	"github.com/pydio/cells/common/proto/docstore"
+	"github.com/pydio/cells/common/proto/rest"
+)
+
+// StoreTemplate saves a template in the docstore.
+func StoreTemplate(ctx context.Context, tpl *rest.WorkspaceTemplate) error {
+	store := docstore.NewDocStoreClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_DOCSTORE, defaults.NewClient())
+	data, _ := json.Marshal(tpl)
+	_, e := store.PutDocument(ctx, &docstore.PutDocumentRequest{
+		StoreID:    common.DOCSTORE_ID_WORKSPACE_TEMPLATES,
+		DocumentID: tpl.Uuid,
+		Document: &docstore.Document{
+			ID:   tpl.Uuid,
+			Type: docstore.DocumentType_JSON,
+			Data: string(data),
+		},
+	})
+	return e
+}
+
+// LoadTemplate loads a template from the docstore.
+func LoadTemplate(ctx context.Context, uuid string) (*rest.WorkspaceTemplate, error) {
+	store := docstore.NewDocStoreClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_DOCSTORE, defaults.NewClient())
+	resp, e := store.GetDocument(ctx, &docstore.GetDocumentRequest{StoreID: common.DOCSTORE_ID_WORKSPACE_TEMPLATES, DocumentID: uuid})
+	if e != nil || resp.Document == nil || resp.Document.Data == "" {
+		return nil, errors.NotFound(common.SERVICE_WORKSPACE, "Cannot find workspace template %s", uuid)
+	}
+	var tpl *rest.WorkspaceTemplate
+	if e := json.Unmarshal([]byte(resp.Document.Data), &tpl); e != nil {
+		return nil, e
+	}
+	return tpl, nil
+}
+
+// ListTemplates lists all templates sorted by label.
+func ListTemplates(ctx context.Context) ([]*rest.WorkspaceTemplate, error) {
+	store := docstore.NewDocStoreClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_DOCSTORE, defaults.NewClient())
+	stream, e := store.ListDocuments(ctx, &docstore.ListDocumentsRequest{StoreID: common.DOCSTORE_ID_WORKSPACE_TEMPLATES})
+	if e != nil {
+		return nil, e
+	}
+	defer stream.Close()
+	var templates []*rest.WorkspaceTemplate
+	for {
+		resp, e := stream.Recv()
+		if e != nil {
+			break
+		}
+		if resp == nil || resp.Document == nil {
+			continue
+		}
+		var tpl *rest.WorkspaceTemplate
+		if e := json.Unmarshal([]byte(resp.Document.Data), &tpl); e == nil {
+			templates = append(templates, tpl)
+		}
+	}
+	sort.SliceStable(templates, func(i, j int) bool {
+		return templates[i].Label < templates[j].Label
+	})
+	return templates, nil
+}
+
+// DeleteTemplate removes a template from the docstore.
+func DeleteTemplate(ctx context.Context, uuid string) error {
+	store := docstore.NewDocStoreClient(common.SERVICE_GRPC_NAMESPACE_+common.SERVICE_DOCSTORE, defaults.NewClient())
+	_, e := store.DeleteDocuments(ctx, &docstore.DeleteDocumentsRequest{StoreID: common.DOCSTORE_ID_WORKSPACE_TEMPLATES, DocumentID: uuid})
+	return e
+}
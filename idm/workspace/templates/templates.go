@@ -0,0 +1,215 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+// Package templates provisions workspaces from templates for a list of users or groups, and keeps
+// them synchronized when the template changes.
+package templates
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+	"text/template"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/micro/go-micro/errors"
+
+	"github.com/pydio/cells/common"
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/rest"
+	"github.com/pydio/cells/common/utils/permissions"
+)
+
+// Workspace attributes linking an instance to its template.
+const (
+	AttributeTemplateUuid   = "TEMPLATE_UUID"
+	AttributeTemplateTarget = "TEMPLATE_TARGET"
+)
+
+// Instance is a template rendered for one target.
+type Instance struct {
+	Workspace *idm.Workspace
+	RootPaths []string
+	Acls      []*rest.WorkspaceTemplateAcl
+}
+
+// TargetKey identifies the target of an instance.
+func TargetKey(target *rest.WorkspaceTemplateTarget) string {
+	if target.UserLogin != "" {
+		return "user:" + target.UserLogin
+	}
+	return "group:" + strings.TrimRight(target.GroupPath, "/")
+}
+
+// TargetVariables computes the variables available in the patterns. Uuid, Login, GroupPath and GroupLabel
+// are read from the user or group, then each managed attribute (see permissions.ManagedUserAttributes) is
+// available with its first letter uppercased, e.g. {{.Department}} for the "department" attribute. Other
+// attributes can be edited by users, so they are not available. Explicit variables override them.
+func TargetVariables(user *idm.User, variables map[string]string) map[string]string {
+	vars := map[string]string{
+		"Uuid":       user.Uuid,
+		"Login":      user.Login,
+		"GroupPath":  user.GroupPath,
+		"GroupLabel": user.GroupLabel,
+	}
+	managed := permissions.ManagedUserAttributes()
+	for k, v := range user.Attributes {
+		if !managed[k] {
+			continue
+		}
+		if r, size := utf8.DecodeRuneInString(k); size > 0 {
+			vars[string(unicode.ToUpper(r))+k[size:]] = v
+		}
+	}
+	for k, v := range variables {
+		vars[k] = v
+	}
+	return vars
+}
+
+// Render executes a pattern with the variables, an unknown variable is an error.
+func Render(pattern string, vars map[string]string) (string, error) {
+	if !strings.Contains(pattern, "{{") {
+		return pattern, nil
+	}
+	t, e := template.New("pattern").Option("missingkey=error").Parse(pattern)
+	if e != nil {
+		return "", errors.BadRequest(common.SERVICE_WORKSPACE, "Invalid pattern %s: %s", pattern, e.Error())
+	}
+	var b bytes.Buffer
+	if e := t.Execute(&b, vars); e != nil {
+		return "", errors.BadRequest(common.SERVICE_WORKSPACE, "Cannot render %s: %s", pattern, e.Error())
+	}
+	return b.String(), nil
+}
+
+// checkVariables refuses values that could make a rendered root path leave its parent folder: values cannot
+// contain "/" or "..", except GroupPath that can only contain "/".
+func checkVariables(vars map[string]string) error {
+	for k, v := range vars {
+		if strings.Contains(v, "..") || (k != "GroupPath" && strings.Contains(v, "/")) {
+			return errors.BadRequest(common.SERVICE_WORKSPACE, "Value of variable %s cannot contain / or ..", k)
+		}
+	}
+	return nil
+}
+
+// RenderInstance computes the workspace, its roots and its ACLs for one target.
+func RenderInstance(tpl *rest.WorkspaceTemplate, target *rest.WorkspaceTemplateTarget, vars map[string]string) (*Instance, error) {
+	if e := checkVariables(vars); e != nil {
+		return nil, e
+	}
+	ws := &idm.Workspace{}
+	if tpl.Workspace != nil {
+		ws = proto.Clone(tpl.Workspace).(*idm.Workspace)
+	}
+	ws.UUID = ""
+	ws.Scope = idm.WorkspaceScope_ADMIN
+	ws.RootUUIDs = nil
+	ws.RootNodes = nil
+	ws.PoliciesContextEditable = false
+	var e error
+	if ws.Label, e = Render(ws.Label, vars); e != nil {
+		return nil, e
+	}
+	if ws.Description, e = Render(ws.Description, vars); e != nil {
+		return nil, e
+	}
+	if ws.Slug, e = Render(ws.Slug, vars); e != nil {
+		return nil, e
+	}
+	if ws.Label == "" {
+		return nil, errors.BadRequest(common.SERVICE_WORKSPACE, "Template %s has no workspace label", tpl.Uuid)
+	}
+	targetData, _ := json.Marshal(target)
+	if e := SetAttributes(ws, map[string]interface{}{
+		AttributeTemplateUuid:   tpl.Uuid,
+		AttributeTemplateTarget: string(targetData),
+	}); e != nil {
+		return nil, e
+	}
+
+	instance := &Instance{Workspace: ws}
+	for _, pattern := range tpl.RootPathPatterns {
+		p, e := Render(pattern, vars)
+		if e != nil {
+			return nil, e
+		}
+		p = strings.Trim(path.Clean("/"+p), "/")
+		if p == "" {
+			return nil, errors.BadRequest(common.SERVICE_WORKSPACE, "Root path %s is empty", pattern)
+		}
+		instance.RootPaths = append(instance.RootPaths, p)
+	}
+	if len(instance.RootPaths) == 0 {
+		return nil, errors.BadRequest(common.SERVICE_WORKSPACE, "Template %s has no root path", tpl.Uuid)
+	}
+	for _, preset := range tpl.AclPresets {
+		roleId, e := Render(preset.RoleId, vars)
+		if e != nil {
+			return nil, e
+		}
+		if roleId == "" {
+			return nil, errors.BadRequest(common.SERVICE_WORKSPACE, "Role %s is empty", preset.RoleId)
+		}
+		instance.Acls = append(instance.Acls, &rest.WorkspaceTemplateAcl{RoleId: roleId, Actions: preset.Actions})
+	}
+	return instance, nil
+}
+
+// InstanceOf reads the template and the target of a workspace created from a template.
+func InstanceOf(ws *idm.Workspace) (templateUuid string, target *rest.WorkspaceTemplateTarget) {
+	atts := attributes(ws)
+	templateUuid, _ = atts[AttributeTemplateUuid].(string)
+	if data, ok := atts[AttributeTemplateTarget].(string); ok {
+		json.Unmarshal([]byte(data), &target)
+	}
+	return
+}
+
+// SetAttributes updates the workspace attributes, nil values are removed.
+func SetAttributes(ws *idm.Workspace, values map[string]interface{}) error {
+	atts := attributes(ws)
+	for k, v := range values {
+		if v == nil {
+			delete(atts, k)
+		} else {
+			atts[k] = v
+		}
+	}
+	data, e := json.Marshal(atts)
+	if e != nil {
+		return fmt.Errorf("cannot encode workspace attributes: %s", e.Error())
+	}
+	ws.Attributes = string(data)
+	return nil
+}
+
+func attributes(ws *idm.Workspace) map[string]interface{} {
+	atts := make(map[string]interface{})
+	if ws.Attributes != "" {
+		json.Unmarshal([]byte(ws.Attributes), &atts)
+	}
+	return atts
+}
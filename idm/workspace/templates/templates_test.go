@@ -0,0 +1,103 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package templates
+
+import (
+	"testing"
+
+	"github.com/pydio/cells/common/proto/idm"
+	"github.com/pydio/cells/common/proto/rest"
+	"github.com/pydio/cells/common/utils/permissions"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTargetVariables(t *testing.T) {
+
+	Convey("Test variables from a group", t, func() {
+		group := &idm.User{Uuid: "g-uuid", IsGroup: true, GroupPath: "/", GroupLabel: "sales", Attributes: map[string]string{"department": "Sales", "displayName": "Sales Team"}}
+		vars := TargetVariables(group, map[string]string{"Department": "Sales EMEA"})
+		So(vars["Uuid"], ShouldEqual, "g-uuid")
+		So(vars["GroupLabel"], ShouldEqual, "sales")
+		So(vars["Department"], ShouldEqual, "Sales EMEA")
+	})
+
+	Convey("Test only managed attributes are variables", t, func() {
+		user := &idm.User{Uuid: "u-uuid", Login: "john", Attributes: map[string]string{"profile": "standard", "department": "../../other"}}
+		vars := TargetVariables(user, nil)
+		So(vars["Profile"], ShouldEqual, "standard")
+		So(vars, ShouldNotContainKey, "Department")
+	})
+}
+
+func TestRenderInstance(t *testing.T) {
+
+	tpl := &rest.WorkspaceTemplate{
+		Uuid:             "departments",
+		Workspace:        &idm.Workspace{Label: "{{.Department}} Files", Slug: "dept-{{.GroupLabel}}"},
+		RootPathPatterns: []string{"pydiods1/departments/{{.Department}}/", "/pydiods1/common"},
+		AclPresets: []*rest.WorkspaceTemplateAcl{
+			{RoleId: "{{.Uuid}}", Actions: []*idm.ACLAction{permissions.AclRead, permissions.AclWrite}},
+			{RoleId: "ADMINS", Actions: []*idm.ACLAction{permissions.AclRead}},
+		},
+	}
+	target := &rest.WorkspaceTemplateTarget{GroupPath: "/sales/"}
+	vars := map[string]string{"Uuid": "g-uuid", "GroupLabel": "sales", "Department": "Sales"}
+
+	Convey("Test variables cannot escape the root paths", t, func() {
+		_, e := RenderInstance(tpl, target, map[string]string{"Uuid": "g-uuid", "GroupLabel": "sales", "Department": "../../other"})
+		So(e, ShouldNotBeNil)
+		_, e = RenderInstance(tpl, target, map[string]string{"Uuid": "g-uuid", "GroupLabel": "sales", "Department": "sales/private"})
+		So(e, ShouldNotBeNil)
+		_, e = RenderInstance(tpl, target, map[string]string{"Uuid": "g-uuid", "GroupLabel": "sales", "Department": "Sales", "GroupPath": "/sales/emea"})
+		So(e, ShouldBeNil)
+	})
+
+	Convey("Test rendering a template", t, func() {
+		instance, e := RenderInstance(tpl, target, vars)
+		So(e, ShouldBeNil)
+		So(instance.Workspace.Label, ShouldEqual, "Sales Files")
+		So(instance.Workspace.Slug, ShouldEqual, "dept-sales")
+		So(instance.Workspace.Scope, ShouldEqual, idm.WorkspaceScope_ADMIN)
+		So(instance.RootPaths, ShouldResemble, []string{"pydiods1/departments/Sales", "pydiods1/common"})
+		So(instance.Acls, ShouldHaveLength, 2)
+		So(instance.Acls[0].RoleId, ShouldEqual, "g-uuid")
+		// Template model is left untouched
+		So(tpl.Workspace.Label, ShouldEqual, "{{.Department}} Files")
+
+		tplUuid, parsed := InstanceOf(instance.Workspace)
+		So(tplUuid, ShouldEqual, "departments")
+		So(parsed, ShouldNotBeNil)
+		So(TargetKey(parsed), ShouldEqual, "group:/sales")
+	})
+
+	Convey("Test missing variables", t, func() {
+		_, e := RenderInstance(tpl, target, map[string]string{"Uuid": "g-uuid", "GroupLabel": "sales"})
+		So(e, ShouldNotBeNil)
+
+		_, e = Render("{{.Department", vars)
+		So(e, ShouldNotBeNil)
+
+		s, e := Render("static", nil)
+		So(e, ShouldBeNil)
+		So(s, ShouldEqual, "static")
+	})
+}
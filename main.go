@@ -35,6 +35,7 @@ import (
 	_ "github.com/pydio/cells/broker/activity/grpc"
 	_ "github.com/pydio/cells/broker/activity/rest"
 	_ "github.com/pydio/cells/broker/chat/grpc"
+	_ "github.com/pydio/cells/broker/chat/rest"
 	_ "github.com/pydio/cells/broker/log/grpc"
 	_ "github.com/pydio/cells/broker/log/rest"
 	_ "github.com/pydio/cells/broker/mailer/grpc"
@@ -66,6 +67,7 @@ import (
 	_ "github.com/pydio/cells/gateway/micro"
 	_ "github.com/pydio/cells/gateway/proxy"
 	_ "github.com/pydio/cells/gateway/websocket/api"
+	_ "github.com/pydio/cells/gateway/websocket/rest"
 	_ "github.com/pydio/cells/gateway/wopi"
 
 	_ "github.com/pydio/cells/data/search/grpc"
@@ -79,10 +81,13 @@ import (
 	_ "github.com/pydio/cells/idm/key/grpc"
 	_ "github.com/pydio/cells/idm/meta/grpc"
 	_ "github.com/pydio/cells/idm/meta/rest"
+	_ "github.com/pydio/cells/idm/mfa/rest"
+	_ "github.com/pydio/cells/idm/ocm/web"
 	_ "github.com/pydio/cells/idm/policy/grpc"
 	_ "github.com/pydio/cells/idm/policy/rest"
 	_ "github.com/pydio/cells/idm/role/grpc"
 	_ "github.com/pydio/cells/idm/role/rest"
+	_ "github.com/pydio/cells/idm/scim/web"
 	_ "github.com/pydio/cells/idm/share/rest"
 	_ "github.com/pydio/cells/idm/user/grpc"
 	_ "github.com/pydio/cells/idm/user/rest"
@@ -95,6 +100,8 @@ import (
 
 	// All Actions for scheduler
 	_ "github.com/pydio/cells/broker/activity/actions"
+	_ "github.com/pydio/cells/broker/chat/actions"
+	_ "github.com/pydio/cells/idm/share/actions"
 	_ "github.com/pydio/cells/scheduler/actions/archive"
 	_ "github.com/pydio/cells/scheduler/actions/changes"
 	_ "github.com/pydio/cells/scheduler/actions/cmd"
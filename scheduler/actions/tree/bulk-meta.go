@@ -0,0 +1,164 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package tree
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/micro/go-micro/client"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/common/config"
+	"github.com/pydio/cells/common/log"
+	"github.com/pydio/cells/common/proto/jobs"
+	"github.com/pydio/cells/common/utils/i18n"
+	"github.com/pydio/cells/common/utils/meta"
+	"github.com/pydio/cells/scheduler/actions"
+	"github.com/pydio/cells/scheduler/lang"
+)
+
+var (
+	bulkMetaActionName = "actions.tree.bulk-meta"
+)
+
+// BulkMetaAction applies user metadata changes to many nodes, either the same value to all the
+// nodes collected by the selector, or the rows of a CSV (path, namespace, value).
+type BulkMetaAction struct {
+	Client        client.Client
+	MetaNamespace string
+	MetaValue     string
+	Delete        bool
+	Csv           string
+	DryRun        bool
+}
+
+// GetName returns this action unique identifier
+func (c *BulkMetaAction) GetName() string {
+	return bulkMetaActionName
+}
+
+// ProvidesProgress implements ProgressProviderAction interface
+func (c *BulkMetaAction) ProvidesProgress() bool {
+	return true
+}
+
+// CanPause implements ControllableAction interface
+func (c *BulkMetaAction) CanPause() bool {
+	return true
+}
+
+// CanStop implements ControllableAction interface
+func (c *BulkMetaAction) CanStop() bool {
+	return true
+}
+
+// Init passes parameters to the action
+func (c *BulkMetaAction) Init(job *jobs.Job, cl client.Client, action *jobs.Action) error {
+
+	c.Client = cl
+	c.MetaNamespace = action.Parameters["metaName"]
+	c.MetaValue = action.Parameters["metaValue"]
+	c.Csv = action.Parameters["csv"]
+	c.Delete = action.Parameters["delete"] == "true"
+	c.DryRun = action.Parameters["dryRun"] == "true"
+	if c.Csv == "" && c.MetaNamespace == "" {
+		return fmt.Errorf("please provide either a metaName or a csv parameter")
+	}
+	if c.Csv == "" && !c.Delete && c.MetaValue == "" {
+		return fmt.Errorf("missing metaValue parameter")
+	}
+
+	return nil
+}
+
+// Run the actual action code
+func (c *BulkMetaAction) Run(ctx context.Context, channels *actions.RunnableChannels, input jobs.ActionMessage) (jobs.ActionMessage, error) {
+
+	T := lang.Bundle().GetTranslationFunc(i18n.UserLanguageFromContext(ctx, config.Default(), true))
+	applier, e := meta.NewBulkApplier(ctx, c.Client)
+	if e != nil {
+		return input.WithError(e), e
+	}
+
+	var changes []*meta.BulkChange
+	if c.Csv != "" {
+		if changes, e = meta.ReadBulkCSV(strings.NewReader(c.Csv), applier.Namespaces()); e != nil {
+			return input.WithError(e), e
+		}
+	} else {
+		if len(input.Nodes) == 0 {
+			return input.WithIgnore(), nil // Ignore
+		}
+		value := c.MetaValue
+		if c.Delete {
+			value = ""
+		}
+		changes = meta.BulkChangesForNodes(input.Nodes, c.MetaNamespace, value)
+	}
+	applier.Prepare(ctx, changes)
+
+	statusKey := "Jobs.User.BulkMetaProgress"
+	if c.DryRun {
+		statusKey = "Jobs.User.BulkMetaDryRun"
+	}
+	total := len(changes)
+	var applied, errors int
+
+loop:
+	for i, change := range changes {
+		select {
+		case <-channels.Pause:
+			<-channels.BlockUntilResume()
+		case <-channels.Stop:
+			log.TasksLogger(ctx).Info("Bulk metadata update interrupted", zap.Int("applied", applied))
+			break loop
+		default:
+		}
+		if change.Error != "" {
+			errors++
+			log.TasksLogger(ctx).Error("Cannot update metadata", zap.String("path", change.Path), zap.String("namespace", change.Namespace), zap.String("error", change.Error))
+		} else if !c.DryRun && !change.Unchanged() {
+			if er := applier.Apply(ctx, change); er != nil {
+				change.Error = er.Error()
+				errors++
+				log.TasksLogger(ctx).Error("Cannot update metadata", zap.String("path", change.Path), zap.String("namespace", change.Namespace), zap.Error(er))
+			} else {
+				applied++
+			}
+		}
+		if (i+1)%100 == 0 || i+1 == total {
+			channels.Progress <- float32(i+1) / float32(total)
+			channels.StatusMsg <- strings.Replace(T(statusKey), "%s", fmt.Sprintf("%d/%d", i+1, total), -1)
+		}
+	}
+
+	body, _ := json.Marshal(changes)
+	input.AppendOutput(&jobs.ActionOutput{
+		Success:    errors == 0,
+		StringBody: fmt.Sprintf("%d changes applied, %d errors on %d rows", applied, errors, total),
+		JsonBody:   body,
+	})
+
+	return input, nil
+}
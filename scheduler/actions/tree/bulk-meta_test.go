@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2018. Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package tree
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/common/proto/jobs"
+)
+
+func TestBulkMetaAction_GetName(t *testing.T) {
+	Convey("Test GetName", t, func() {
+		bulkAction := &BulkMetaAction{}
+		So(bulkAction.GetName(), ShouldEqual, bulkMetaActionName)
+	})
+}
+
+func TestBulkMetaAction_Init(t *testing.T) {
+	Convey("Test Init", t, func() {
+		job := &jobs.Job{}
+		bulkAction := &BulkMetaAction{}
+		e := bulkAction.Init(job, nil, &jobs.Action{Parameters: map[string]string{}})
+		So(e, ShouldNotBeNil)
+
+		e = bulkAction.Init(job, nil, &jobs.Action{Parameters: map[string]string{"metaName": "usermeta-key"}})
+		So(e, ShouldNotBeNil)
+
+		e = bulkAction.Init(job, nil, &jobs.Action{Parameters: map[string]string{"metaName": "usermeta-key", "delete": "true"}})
+		So(e, ShouldBeNil)
+		So(bulkAction.Delete, ShouldBeTrue)
+
+		bulkAction = &BulkMetaAction{}
+		e = bulkAction.Init(job, nil, &jobs.Action{Parameters: map[string]string{"csv": "path,namespace,value", "dryRun": "true"}})
+		So(e, ShouldBeNil)
+		So(bulkAction.DryRun, ShouldBeTrue)
+		So(bulkAction.CanPause(), ShouldBeTrue)
+	})
+}
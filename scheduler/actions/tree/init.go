@@ -39,6 +39,10 @@ func init() {
 		return &MetaAction{}
 	})
 
+	manager.Register(bulkMetaActionName, func() actions.ConcreteAction {
+		return &BulkMetaAction{}
+	})
+
 	manager.Register(snapshotActionName, func() actions.ConcreteAction {
 		return &SnapshotAction{}
 	})
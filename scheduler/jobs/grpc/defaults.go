@@ -121,6 +121,50 @@ func getDefaultJobs() []*jobs.Job {
 		},
 	}
 
+	chatRetentionJob := &jobs.Job{
+		ID:             "chat-retention",
+		Owner:          common.PYDIO_SYSTEM_USERNAME,
+		Label:          "Jobs.Default.ChatRetention",
+		MaxConcurrency: 1,
+		Schedule: &jobs.Schedule{
+			Iso8601Schedule: "R/2012-06-04T02:00:00.828696-07:00/PT24H",
+		},
+		Actions: []*jobs.Action{
+			{
+				ID: "broker.chat.actions.retention",
+				// Number of days messages are kept for each room type, 0 to keep them forever
+				Parameters: map[string]string{
+					"GLOBAL":    "0",
+					"WORKSPACE": "0",
+					"USER":      "0",
+					"NODE":      "0",
+				},
+			},
+		},
+	}
+
+	shareExpiryJob := &jobs.Job{
+		ID:             "share-expiry",
+		Owner:          common.PYDIO_SYSTEM_USERNAME,
+		Label:          "Jobs.Default.ShareExpiry",
+		MaxConcurrency: 1,
+		Schedule: &jobs.Schedule{
+			Iso8601Schedule: "R/2012-06-04T03:00:00.828696-07:00/PT24H",
+		},
+		Actions: []*jobs.Action{
+			{
+				ID: "actions.share.expiry",
+				// Owners are notified NotifyDays before expiration, expired shares are deleted
+				// DeleteAfterDays after being disabled (0 to keep them), ReportEmail receives a report
+				Parameters: map[string]string{
+					"NotifyDays":      "3",
+					"DeleteAfterDays": "30",
+					"ReportEmail":     "",
+				},
+			},
+		},
+	}
+
 	cleanUserDataJob := &jobs.Job{
 		ID:                "clean-user-data",
 		Owner:             common.PYDIO_SYSTEM_USERNAME,
@@ -223,6 +267,8 @@ func getDefaultJobs() []*jobs.Job {
 		thumbnailsJob,
 		cleanThumbsJob,
 		stuckTasksJob,
+		chatRetentionJob,
+		shareExpiryJob,
 		cleanUserDataJob,
 		// Testing Jobs
 		fakeLongJob,